@@ -56,7 +56,7 @@ type ArtifactList struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/artifacts
 func (s *ActionsService) ListArtifacts(ctx context.Context, owner, repo string, opts *ListOptions) (*ArtifactList, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/artifacts", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/artifacts", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -82,7 +82,7 @@ func (s *ActionsService) ListArtifacts(ctx context.Context, owner, repo string,
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/artifacts
 func (s *ActionsService) ListWorkflowRunArtifacts(ctx context.Context, owner, repo string, runID int64, opts *ListOptions) (*ArtifactList, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/artifacts", owner, repo, runID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/artifacts", PathEscape(owner), PathEscape(repo), runID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -108,7 +108,7 @@ func (s *ActionsService) ListWorkflowRunArtifacts(ctx context.Context, owner, re
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}
 func (s *ActionsService) GetArtifact(ctx context.Context, owner, repo string, artifactID int64) (*Artifact, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/artifacts/%v", owner, repo, artifactID)
+	u := fmt.Sprintf("repos/%v/%v/actions/artifacts/%v", PathEscape(owner), PathEscape(repo), artifactID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -130,7 +130,7 @@ func (s *ActionsService) GetArtifact(ctx context.Context, owner, repo string, ar
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}
 func (s *ActionsService) DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64, maxRedirects int) (*url.URL, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/artifacts/%v/zip", owner, repo, artifactID)
+	u := fmt.Sprintf("repos/%v/%v/actions/artifacts/%v/zip", PathEscape(owner), PathEscape(repo), artifactID)
 
 	resp, err := s.client.roundTripWithOptionalFollowRedirect(ctx, u, maxRedirects)
 	if err != nil {
@@ -156,7 +156,7 @@ func (s *ActionsService) DownloadArtifact(ctx context.Context, owner, repo strin
 //
 //meta:operation DELETE /repos/{owner}/{repo}/actions/artifacts/{artifact_id}
 func (s *ActionsService) DeleteArtifact(ctx context.Context, owner, repo string, artifactID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/artifacts/%v", owner, repo, artifactID)
+	u := fmt.Sprintf("repos/%v/%v/actions/artifacts/%v", PathEscape(owner), PathEscape(repo), artifactID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
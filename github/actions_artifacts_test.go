@@ -65,6 +65,8 @@ func TestActionsService_ListArtifacts_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.ListArtifacts(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.ListArtifacts(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_ListArtifacts_invalidRepo(t *testing.T) {
@@ -74,6 +76,8 @@ func TestActionsService_ListArtifacts_invalidRepo(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.ListArtifacts(ctx, "o", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.ListArtifacts(ctx, "o", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_ListArtifacts_notFound(t *testing.T) {
@@ -147,6 +151,8 @@ func TestActionsService_ListWorkflowRunArtifacts_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.ListWorkflowRunArtifacts(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.ListWorkflowRunArtifacts(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_ListWorkflowRunArtifacts_invalidRepo(t *testing.T) {
@@ -156,6 +162,8 @@ func TestActionsService_ListWorkflowRunArtifacts_invalidRepo(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.ListWorkflowRunArtifacts(ctx, "o", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.ListWorkflowRunArtifacts(ctx, "o", "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_ListWorkflowRunArtifacts_notFound(t *testing.T) {
@@ -234,6 +242,8 @@ func TestActionsService_GetArtifact_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.GetArtifact(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.GetArtifact(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_GetArtifact_invalidRepo(t *testing.T) {
@@ -243,6 +253,8 @@ func TestActionsService_GetArtifact_invalidRepo(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.GetArtifact(ctx, "o", "%", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.GetArtifact(ctx, "o", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_GetArtifact_notFound(t *testing.T) {
@@ -313,6 +325,8 @@ func TestActionsService_DownloadArtifact_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.DownloadArtifact(ctx, "%", "r", 1, 1)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.DownloadArtifact(ctx, "..", "r", 1, 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_DownloadArtifact_invalidRepo(t *testing.T) {
@@ -322,6 +336,8 @@ func TestActionsService_DownloadArtifact_invalidRepo(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.DownloadArtifact(ctx, "o", "%", 1, 1)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.DownloadArtifact(ctx, "o", "..", 1, 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_DownloadArtifact_StatusMovedPermanently_dontFollowRedirects(t *testing.T) {
@@ -400,6 +416,8 @@ func TestActionsService_DeleteArtifact_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Actions.DeleteArtifact(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, err = client.Actions.DeleteArtifact(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_DeleteArtifact_invalidRepo(t *testing.T) {
@@ -409,6 +427,8 @@ func TestActionsService_DeleteArtifact_invalidRepo(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Actions.DeleteArtifact(ctx, "o", "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Actions.DeleteArtifact(ctx, "o", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_DeleteArtifact_notFound(t *testing.T) {
@@ -81,7 +81,7 @@ type ActionsCacheListOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/caches
 func (s *ActionsService) ListCaches(ctx context.Context, owner, repo string, opts *ActionsCacheListOptions) (*ActionsCacheList, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/caches", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/caches", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -113,7 +113,7 @@ func (s *ActionsService) ListCaches(ctx context.Context, owner, repo string, opt
 //
 //meta:operation DELETE /repos/{owner}/{repo}/actions/caches
 func (s *ActionsService) DeleteCachesByKey(ctx context.Context, owner, repo, key string, ref *string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/caches", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/caches", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, ActionsCache{Key: &key, Ref: ref})
 	if err != nil {
 		return nil, err
@@ -135,7 +135,7 @@ func (s *ActionsService) DeleteCachesByKey(ctx context.Context, owner, repo, key
 //
 //meta:operation DELETE /repos/{owner}/{repo}/actions/caches/{cache_id}
 func (s *ActionsService) DeleteCachesByID(ctx context.Context, owner, repo string, cacheID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/caches/%v", owner, repo, cacheID)
+	u := fmt.Sprintf("repos/%v/%v/actions/caches/%v", PathEscape(owner), PathEscape(repo), cacheID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -154,7 +154,7 @@ func (s *ActionsService) DeleteCachesByID(ctx context.Context, owner, repo strin
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/cache/usage
 func (s *ActionsService) GetCacheUsageForRepo(ctx context.Context, owner, repo string) (*ActionsCacheUsage, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/cache/usage", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/cache/usage", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -179,7 +179,7 @@ func (s *ActionsService) GetCacheUsageForRepo(ctx context.Context, owner, repo s
 //
 //meta:operation GET /orgs/{org}/actions/cache/usage-by-repository
 func (s *ActionsService) ListCacheUsageByRepoForOrg(ctx context.Context, org string, opts *ListOptions) (*ActionsCacheUsageList, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/cache/usage-by-repository", org)
+	u := fmt.Sprintf("orgs/%v/actions/cache/usage-by-repository", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -209,7 +209,7 @@ func (s *ActionsService) ListCacheUsageByRepoForOrg(ctx context.Context, org str
 //
 //meta:operation GET /orgs/{org}/actions/cache/usage
 func (s *ActionsService) GetTotalCacheUsageForOrg(ctx context.Context, org string) (*TotalCacheUsage, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/cache/usage", org)
+	u := fmt.Sprintf("orgs/%v/actions/cache/usage", PathEscape(org))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -233,7 +233,7 @@ func (s *ActionsService) GetTotalCacheUsageForOrg(ctx context.Context, org strin
 //
 //meta:operation GET /enterprises/{enterprise}/actions/cache/usage
 func (s *ActionsService) GetTotalCacheUsageForEnterprise(ctx context.Context, enterprise string) (*TotalCacheUsage, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/cache/usage", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/cache/usage", PathEscape(enterprise))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
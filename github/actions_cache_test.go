@@ -63,6 +63,8 @@ func TestActionsService_ListCaches_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.ListCaches(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.ListCaches(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_ListCaches_invalidRepo(t *testing.T) {
@@ -72,6 +74,8 @@ func TestActionsService_ListCaches_invalidRepo(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.ListCaches(ctx, "o", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.ListCaches(ctx, "o", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_ListCaches_notFound(t *testing.T) {
@@ -129,6 +133,8 @@ func TestActionsService_DeleteCachesByKey_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Actions.DeleteCachesByKey(ctx, "%", "r", "1", String("main"))
 	testURLParseError(t, err)
+	_, err = client.Actions.DeleteCachesByKey(ctx, "..", "r", "1", String("main"))
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_DeleteCachesByKey_invalidRepo(t *testing.T) {
@@ -138,6 +144,8 @@ func TestActionsService_DeleteCachesByKey_invalidRepo(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Actions.DeleteCachesByKey(ctx, "o", "%", "1", String("main"))
 	testURLParseError(t, err)
+	_, err = client.Actions.DeleteCachesByKey(ctx, "o", "..", "1", String("main"))
+	testPathForbiddenError(t, err)
 }
 func TestActionsService_DeleteCachesByKey_notFound(t *testing.T) {
 	client, mux, _, teardown := setup()
@@ -190,6 +198,8 @@ func TestActionsService_DeleteCachesByID_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Actions.DeleteCachesByID(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, err = client.Actions.DeleteCachesByID(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_DeleteCachesByID_invalidRepo(t *testing.T) {
@@ -199,6 +209,8 @@ func TestActionsService_DeleteCachesByID_invalidRepo(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Actions.DeleteCachesByID(ctx, "o", "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Actions.DeleteCachesByID(ctx, "o", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_DeleteCachesByID_notFound(t *testing.T) {
@@ -268,6 +280,8 @@ func TestActionsService_GetCacheUsageForRepo_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.GetCacheUsageForRepo(ctx, "%", "r")
 	testURLParseError(t, err)
+	_, _, err = client.Actions.GetCacheUsageForRepo(ctx, "..", "r")
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_GetCacheUsageForRepo_invalidRepo(t *testing.T) {
@@ -277,6 +291,8 @@ func TestActionsService_GetCacheUsageForRepo_invalidRepo(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.GetCacheUsageForRepo(ctx, "o", "%")
 	testURLParseError(t, err)
+	_, _, err = client.Actions.GetCacheUsageForRepo(ctx, "o", "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_GetCacheUsageForRepo_notFound(t *testing.T) {
@@ -350,6 +366,8 @@ func TestActionsService_ListCacheUsageByRepoForOrg_invalidOrganization(t *testin
 	ctx := context.Background()
 	_, _, err := client.Actions.ListCacheUsageByRepoForOrg(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Actions.ListCacheUsageByRepoForOrg(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_ListCacheUsageByRepoForOrg_notFound(t *testing.T) {
@@ -421,6 +439,8 @@ func TestActionsService_GetCacheUsageForOrg_invalidOrganization(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Actions.GetTotalCacheUsageForOrg(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Actions.GetTotalCacheUsageForOrg(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_GetCacheUsageForOrg_notFound(t *testing.T) {
@@ -492,6 +512,8 @@ func TestActionsService_GetCacheUsageForEnterprise_invalidEnterprise(t *testing.
 	ctx := context.Background()
 	_, _, err := client.Actions.GetTotalCacheUsageForEnterprise(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Actions.GetTotalCacheUsageForEnterprise(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestActionsService_GetCacheUsageForEnterprise_notFound(t *testing.T) {
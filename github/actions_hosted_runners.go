@@ -0,0 +1,424 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// The possible values of HostedRunnerImage.Source.
+const (
+	HostedRunnerImageSourceGitHub  = "github"
+	HostedRunnerImageSourcePartner = "partner"
+	HostedRunnerImageSourceCustom  = "custom"
+)
+
+// ErrStaticIPLimitReached is returned by ActionsService.CreateHostedRunner
+// when a runner with static public IPs is requested but the organization
+// already uses all the public IP ranges it is allowed.
+var ErrStaticIPLimitReached = errors.New("the organization has reached its limit of static public IP ranges for hosted runners")
+
+// HostedRunner represents a GitHub-hosted runner, also known as a larger
+// runner, of an organization.
+type HostedRunner struct {
+	ID                 *int64                   `json:"id,omitempty"`
+	Name               *string                  `json:"name,omitempty"`
+	RunnerGroupID      *int64                   `json:"runner_group_id,omitempty"`
+	Platform           *string                  `json:"platform,omitempty"`
+	ImageDetails       *HostedRunnerImageDetail `json:"image_details,omitempty"`
+	MachineSizeDetails *HostedRunnerMachineSpec `json:"machine_size_details,omitempty"`
+	// Status is one of "Ready", "Provisioning", "Shutdown", "Deleting" or "Stuck".
+	Status          *string                 `json:"status,omitempty"`
+	MaximumRunners  *int64                  `json:"maximum_runners,omitempty"`
+	PublicIPEnabled *bool                   `json:"public_ip_enabled,omitempty"`
+	PublicIPs       []*HostedRunnerPublicIP `json:"public_ips,omitempty"`
+	LastActiveOn    *Timestamp              `json:"last_active_on,omitempty"`
+}
+
+// HostedRunners represents a list of GitHub-hosted runners.
+type HostedRunners struct {
+	TotalCount int             `json:"total_count"`
+	Runners    []*HostedRunner `json:"runners"`
+}
+
+// HostedRunnerImageDetail describes the image of a GitHub-hosted runner.
+type HostedRunnerImageDetail struct {
+	ID          *string `json:"id,omitempty"`
+	SizeGB      *int64  `json:"size_gb,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	Source      *string `json:"source,omitempty"`
+}
+
+// HostedRunnerMachineSpec describes a machine size of GitHub-hosted runners.
+type HostedRunnerMachineSpec struct {
+	ID        *string `json:"id,omitempty"`
+	CPUCores  *int    `json:"cpu_cores,omitempty"`
+	MemoryGB  *int    `json:"memory_gb,omitempty"`
+	StorageGB *int    `json:"storage_gb,omitempty"`
+}
+
+// HostedRunnerPublicIP is a static public IP range of a GitHub-hosted runner.
+type HostedRunnerPublicIP struct {
+	Enabled *bool   `json:"enabled,omitempty"`
+	Prefix  *string `json:"prefix,omitempty"`
+	// Length is the length of the prefix of the IP range, for example 28.
+	Length *int `json:"length,omitempty"`
+}
+
+// HostedRunnerImage identifies the image to create a GitHub-hosted runner
+// with: a GitHub-owned or partner image by its ID, such as "ubuntu-latest",
+// or a custom image by its ID.
+type HostedRunnerImage struct {
+	ID *string `json:"id,omitempty"`
+	// Source is one of the HostedRunnerImageSource constants.
+	Source *string `json:"source,omitempty"`
+	// Version is the version of a custom image. GitHub defaults to the
+	// latest version.
+	Version *string `json:"version,omitempty"`
+}
+
+// CreateHostedRunnerRequest specifies the parameters to
+// ActionsService.CreateHostedRunner. Name, Image, Size and RunnerGroupID are
+// required.
+type CreateHostedRunnerRequest struct {
+	Name  *string            `json:"name,omitempty"`
+	Image *HostedRunnerImage `json:"image,omitempty"`
+	// Size is the ID of a machine size, as listed by
+	// ActionsService.ListHostedRunnerMachineSpecs.
+	Size           *string `json:"size,omitempty"`
+	RunnerGroupID  *int64  `json:"runner_group_id,omitempty"`
+	MaximumRunners *int64  `json:"maximum_runners,omitempty"`
+	EnableStaticIP *bool   `json:"enable_static_ip,omitempty"`
+}
+
+// UpdateHostedRunnerRequest specifies the parameters to
+// ActionsService.UpdateHostedRunner. Unset fields are left unchanged.
+type UpdateHostedRunnerRequest struct {
+	Name           *string `json:"name,omitempty"`
+	RunnerGroupID  *int64  `json:"runner_group_id,omitempty"`
+	MaximumRunners *int64  `json:"maximum_runners,omitempty"`
+	EnableStaticIP *bool   `json:"enable_static_ip,omitempty"`
+	ImageVersion   *string `json:"image_version,omitempty"`
+}
+
+// HostedRunnerImageSpec describes an image available to GitHub-hosted runners.
+type HostedRunnerImageSpec struct {
+	ID          *string `json:"id,omitempty"`
+	Platform    *string `json:"platform,omitempty"`
+	SizeGB      *int64  `json:"size_gb,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	Source      *string `json:"source,omitempty"`
+}
+
+// HostedRunnerImages represents a list of images available to GitHub-hosted runners.
+type HostedRunnerImages struct {
+	TotalCount int                      `json:"total_count"`
+	Images     []*HostedRunnerImageSpec `json:"images"`
+}
+
+// HostedRunnerMachineSpecs represents a list of machine sizes available to
+// GitHub-hosted runners.
+type HostedRunnerMachineSpecs struct {
+	TotalCount   int                        `json:"total_count"`
+	MachineSpecs []*HostedRunnerMachineSpec `json:"machine_specs"`
+}
+
+// HostedRunnerPlatforms represents a list of platforms available to
+// GitHub-hosted runners, such as "linux-x64".
+type HostedRunnerPlatforms struct {
+	TotalCount int      `json:"total_count"`
+	Platforms  []string `json:"platforms"`
+}
+
+// HostedRunnerLimits represents the limits on the GitHub-hosted runners of
+// an organization.
+type HostedRunnerLimits struct {
+	PublicIPs *HostedRunnerPublicIPLimits `json:"public_ips,omitempty"`
+}
+
+// HostedRunnerPublicIPLimits represents the limit on, and the usage of, the
+// static public IP ranges of GitHub-hosted runners.
+type HostedRunnerPublicIPLimits struct {
+	Maximum      *int `json:"maximum,omitempty"`
+	CurrentUsage *int `json:"current_usage,omitempty"`
+}
+
+// hostedRunnerNameRE matches the names GitHub accepts for hosted runners.
+var hostedRunnerNameRE = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+
+// ListHostedRunners lists the GitHub-hosted runners of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#list-github-hosted-runners-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/hosted-runners
+func (s *ActionsService) ListHostedRunners(ctx context.Context, org string, opts *ListOptions) (*HostedRunners, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners", PathEscape(org))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runners := &HostedRunners{}
+	resp, err := s.client.Do(ctx, req, &runners)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runners, resp, nil
+}
+
+// CreateHostedRunner creates a GitHub-hosted runner for an organization.
+//
+// The request is validated before it is sent: it must have a valid name, an
+// image with an ID and a known source if any, a size and a runner group. If
+// EnableStaticIP is set, the limits of the organization are fetched first, and
+// ErrStaticIPLimitReached is returned if all its static public IP ranges are
+// in use, along with the response of that request.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#create-a-github-hosted-runner-for-an-organization
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#get-limits-on-github-hosted-runners-for-an-organization
+//
+//meta:operation POST /orgs/{org}/actions/hosted-runners
+//meta:operation GET /orgs/{org}/actions/hosted-runners/limits
+func (s *ActionsService) CreateHostedRunner(ctx context.Context, org string, request *CreateHostedRunnerRequest) (*HostedRunner, *Response, error) {
+	if err := request.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if request.GetEnableStaticIP() {
+		limits, resp, err := s.GetHostedRunnerLimits(ctx, org)
+		if err != nil {
+			return nil, resp, err
+		}
+		if ips := limits.GetPublicIPs(); ips != nil && ips.GetCurrentUsage() >= ips.GetMaximum() {
+			return nil, resp, fmt.Errorf("%w: %v of %v in use", ErrStaticIPLimitReached, ips.GetCurrentUsage(), ips.GetMaximum())
+		}
+	}
+
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners", PathEscape(org))
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+func (r *CreateHostedRunnerRequest) validate() error {
+	if r == nil {
+		return errors.New("hosted runner request must be provided")
+	}
+	if !hostedRunnerNameRE.MatchString(r.GetName()) {
+		return fmt.Errorf("invalid hosted runner name %q: it must have 1 to 64 letters, digits, '.', '-' or '_'", r.GetName())
+	}
+	if r.GetImage().GetID() == "" {
+		return errors.New("hosted runner image ID must be provided")
+	}
+	switch source := r.GetImage().GetSource(); source {
+	case "", HostedRunnerImageSourceGitHub, HostedRunnerImageSourcePartner, HostedRunnerImageSourceCustom:
+	default:
+		return fmt.Errorf("invalid hosted runner image source %q, want %q, %q or %q", source, HostedRunnerImageSourceGitHub, HostedRunnerImageSourcePartner, HostedRunnerImageSourceCustom)
+	}
+	if r.GetSize() == "" {
+		return errors.New("hosted runner size must be provided")
+	}
+	if r.RunnerGroupID == nil {
+		return errors.New("hosted runner group ID must be provided")
+	}
+	if r.MaximumRunners != nil && *r.MaximumRunners < 1 {
+		return fmt.Errorf("invalid hosted runner maximum runners %v, want at least 1", *r.MaximumRunners)
+	}
+	return nil
+}
+
+// GetHostedRunner gets a GitHub-hosted runner of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#get-a-github-hosted-runner-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/hosted-runners/{hosted_runner_id}
+func (s *ActionsService) GetHostedRunner(ctx context.Context, org string, runnerID int64) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/%v", PathEscape(org), runnerID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// UpdateHostedRunner updates a GitHub-hosted runner of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#update-a-github-hosted-runner-for-an-organization
+//
+//meta:operation PATCH /orgs/{org}/actions/hosted-runners/{hosted_runner_id}
+func (s *ActionsService) UpdateHostedRunner(ctx context.Context, org string, runnerID int64, request *UpdateHostedRunnerRequest) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/%v", PathEscape(org), runnerID)
+	req, err := s.client.NewRequest("PATCH", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// DeleteHostedRunner deletes a GitHub-hosted runner of an organization.
+// GitHub responds with 202 Accepted and the runner, whose status becomes
+// "Deleting"; that response is not reported as an *AcceptedError.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#delete-a-github-hosted-runner-for-an-organization
+//
+//meta:operation DELETE /orgs/{org}/actions/hosted-runners/{hosted_runner_id}
+func (s *ActionsService) DeleteHostedRunner(ctx context.Context, org string, runnerID int64) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/%v", PathEscape(org), runnerID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	var acceptedError *AcceptedError
+	if errors.As(err, &acceptedError) {
+		if err := json.Unmarshal(acceptedError.Raw, runner); err != nil {
+			return nil, resp, err
+		}
+		return runner, resp, nil
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// ListHostedRunnerGitHubOwnedImages lists the GitHub-owned images available to
+// the GitHub-hosted runners of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#get-github-owned-images-for-github-hosted-runners-in-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/hosted-runners/images/github-owned
+func (s *ActionsService) ListHostedRunnerGitHubOwnedImages(ctx context.Context, org string) (*HostedRunnerImages, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/images/github-owned", PathEscape(org))
+	return s.listHostedRunnerImages(ctx, u)
+}
+
+// ListHostedRunnerPartnerImages lists the partner images available to the
+// GitHub-hosted runners of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#get-partner-images-for-github-hosted-runners-in-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/hosted-runners/images/partner
+func (s *ActionsService) ListHostedRunnerPartnerImages(ctx context.Context, org string) (*HostedRunnerImages, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/images/partner", PathEscape(org))
+	return s.listHostedRunnerImages(ctx, u)
+}
+
+func (s *ActionsService) listHostedRunnerImages(ctx context.Context, u string) (*HostedRunnerImages, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	images := new(HostedRunnerImages)
+	resp, err := s.client.Do(ctx, req, images)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return images, resp, nil
+}
+
+// GetHostedRunnerLimits gets the limits on the GitHub-hosted runners of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#get-limits-on-github-hosted-runners-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/hosted-runners/limits
+func (s *ActionsService) GetHostedRunnerLimits(ctx context.Context, org string) (*HostedRunnerLimits, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/limits", PathEscape(org))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	limits := new(HostedRunnerLimits)
+	resp, err := s.client.Do(ctx, req, limits)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return limits, resp, nil
+}
+
+// ListHostedRunnerMachineSpecs lists the machine sizes available to the
+// GitHub-hosted runners of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#get-github-hosted-runners-machine-specs-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/hosted-runners/machine-sizes
+func (s *ActionsService) ListHostedRunnerMachineSpecs(ctx context.Context, org string) (*HostedRunnerMachineSpecs, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/machine-sizes", PathEscape(org))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	specs := new(HostedRunnerMachineSpecs)
+	resp, err := s.client.Do(ctx, req, specs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return specs, resp, nil
+}
+
+// ListHostedRunnerPlatforms lists the platforms available to the
+// GitHub-hosted runners of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#get-platforms-for-github-hosted-runners-in-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/hosted-runners/platforms
+func (s *ActionsService) ListHostedRunnerPlatforms(ctx context.Context, org string) (*HostedRunnerPlatforms, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/platforms", PathEscape(org))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	platforms := new(HostedRunnerPlatforms)
+	resp, err := s.client.Do(ctx, req, platforms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return platforms, resp, nil
+}
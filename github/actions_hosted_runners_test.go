@@ -0,0 +1,568 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestActionsService_ListHostedRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"runners":[{"id":5,"name":"r","runner_group_id":2,"platform":"linux-x64","image_details":{"id":"ubuntu-latest","size_gb":86,"display_name":"Ubuntu Latest","source":"github"},"machine_size_details":{"id":"4-core","cpu_cores":4,"memory_gb":16,"storage_gb":150},"status":"Ready","maximum_runners":10,"public_ip_enabled":true,"public_ips":[{"enabled":true,"prefix":"20.80.208.150","length":31}],"last_active_on":`+referenceTimeStr+`}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	runners, _, err := client.Actions.ListHostedRunners(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Actions.ListHostedRunners returned error: %v", err)
+	}
+
+	want := &HostedRunners{
+		TotalCount: 1,
+		Runners: []*HostedRunner{{
+			ID:            Int64(5),
+			Name:          String("r"),
+			RunnerGroupID: Int64(2),
+			Platform:      String("linux-x64"),
+			ImageDetails: &HostedRunnerImageDetail{
+				ID:          String("ubuntu-latest"),
+				SizeGB:      Int64(86),
+				DisplayName: String("Ubuntu Latest"),
+				Source:      String("github"),
+			},
+			MachineSizeDetails: &HostedRunnerMachineSpec{
+				ID:        String("4-core"),
+				CPUCores:  Int(4),
+				MemoryGB:  Int(16),
+				StorageGB: Int(150),
+			},
+			Status:          String("Ready"),
+			MaximumRunners:  Int64(10),
+			PublicIPEnabled: Bool(true),
+			PublicIPs: []*HostedRunnerPublicIP{
+				{Enabled: Bool(true), Prefix: String("20.80.208.150"), Length: Int(31)},
+			},
+			LastActiveOn: &Timestamp{referenceTime},
+		}},
+	}
+	if !cmp.Equal(runners, want) {
+		t.Errorf("Actions.ListHostedRunners returned %+v, want %+v", runners, want)
+	}
+
+	const methodName = "ListHostedRunners"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.ListHostedRunners(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.ListHostedRunners(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_CreateHostedRunner(t *testing.T) {
+	tests := map[string]struct {
+		request  *CreateHostedRunnerRequest
+		wantBody string
+	}{
+		"image by ID": {
+			request: &CreateHostedRunnerRequest{
+				Name:          String("r"),
+				Image:         &HostedRunnerImage{ID: String("ubuntu-latest"), Source: String(HostedRunnerImageSourceGitHub)},
+				Size:          String("4-core"),
+				RunnerGroupID: Int64(2),
+			},
+			wantBody: `{"name":"r","image":{"id":"ubuntu-latest","source":"github"},"size":"4-core","runner_group_id":2}` + "\n",
+		},
+		"custom image by source": {
+			request: &CreateHostedRunnerRequest{
+				Name:           String("r"),
+				Image:          &HostedRunnerImage{ID: String("123"), Source: String(HostedRunnerImageSourceCustom), Version: String("1.0.0")},
+				Size:           String("4-core"),
+				RunnerGroupID:  Int64(2),
+				MaximumRunners: Int64(10),
+			},
+			wantBody: `{"name":"r","image":{"id":"123","source":"custom","version":"1.0.0"},"size":"4-core","runner_group_id":2,"maximum_runners":10}` + "\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/orgs/o/actions/hosted-runners", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testBody(t, r, test.wantBody)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"id":5,"name":"r","status":"Provisioning"}`)
+			})
+
+			ctx := context.Background()
+			runner, _, err := client.Actions.CreateHostedRunner(ctx, "o", test.request)
+			if err != nil {
+				t.Errorf("Actions.CreateHostedRunner returned error: %v", err)
+			}
+
+			want := &HostedRunner{ID: Int64(5), Name: String("r"), Status: String("Provisioning")}
+			if !cmp.Equal(runner, want) {
+				t.Errorf("Actions.CreateHostedRunner returned %+v, want %+v", runner, want)
+			}
+
+			const methodName = "CreateHostedRunner"
+			testBadOptions(t, methodName, func() (err error) {
+				_, _, err = client.Actions.CreateHostedRunner(ctx, "\n", test.request)
+				return err
+			})
+
+			testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+				got, resp, err := client.Actions.CreateHostedRunner(ctx, "o", test.request)
+				if got != nil {
+					t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+				}
+				return resp, err
+			})
+		})
+	}
+}
+
+func TestActionsService_CreateHostedRunner_invalid(t *testing.T) {
+	valid := func() *CreateHostedRunnerRequest {
+		return &CreateHostedRunnerRequest{
+			Name:          String("r"),
+			Image:         &HostedRunnerImage{ID: String("ubuntu-latest")},
+			Size:          String("4-core"),
+			RunnerGroupID: Int64(2),
+		}
+	}
+
+	tests := map[string]func(*CreateHostedRunnerRequest) *CreateHostedRunnerRequest{
+		"nil request": func(*CreateHostedRunnerRequest) *CreateHostedRunnerRequest { return nil },
+		"missing name": func(r *CreateHostedRunnerRequest) *CreateHostedRunnerRequest {
+			r.Name = nil
+			return r
+		},
+		"invalid name": func(r *CreateHostedRunnerRequest) *CreateHostedRunnerRequest {
+			r.Name = String("my runner")
+			return r
+		},
+		"missing image": func(r *CreateHostedRunnerRequest) *CreateHostedRunnerRequest {
+			r.Image = nil
+			return r
+		},
+		"invalid image source": func(r *CreateHostedRunnerRequest) *CreateHostedRunnerRequest {
+			r.Image.Source = String("docker")
+			return r
+		},
+		"missing size": func(r *CreateHostedRunnerRequest) *CreateHostedRunnerRequest {
+			r.Size = nil
+			return r
+		},
+		"missing runner group": func(r *CreateHostedRunnerRequest) *CreateHostedRunnerRequest {
+			r.RunnerGroupID = nil
+			return r
+		},
+		"invalid maximum runners": func(r *CreateHostedRunnerRequest) *CreateHostedRunnerRequest {
+			r.MaximumRunners = Int64(0)
+			return r
+		},
+	}
+
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, _, _, teardown := setup()
+			defer teardown()
+
+			ctx := context.Background()
+			_, resp, err := client.Actions.CreateHostedRunner(ctx, "o", modify(valid()))
+			if err == nil {
+				t.Error("Actions.CreateHostedRunner returned nil error, want an error")
+			}
+			if resp != nil {
+				t.Errorf("Actions.CreateHostedRunner returned response %+v, want nil", resp)
+			}
+		})
+	}
+}
+
+func TestActionsService_CreateHostedRunner_staticIP(t *testing.T) {
+	tests := map[string]struct {
+		limits      string
+		wantCreated bool
+	}{
+		"under limit": {
+			limits:      `{"public_ips":{"maximum":50,"current_usage":17}}`,
+			wantCreated: true,
+		},
+		"at limit": {
+			limits: `{"public_ips":{"maximum":50,"current_usage":50}}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/orgs/o/actions/hosted-runners/limits", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprint(w, test.limits)
+			})
+			created := false
+			mux.HandleFunc("/orgs/o/actions/hosted-runners", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testBody(t, r, `{"name":"r","image":{"id":"ubuntu-latest"},"size":"4-core","runner_group_id":2,"enable_static_ip":true}`+"\n")
+				created = true
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"id":5,"public_ip_enabled":true}`)
+			})
+
+			request := &CreateHostedRunnerRequest{
+				Name:           String("r"),
+				Image:          &HostedRunnerImage{ID: String("ubuntu-latest")},
+				Size:           String("4-core"),
+				RunnerGroupID:  Int64(2),
+				EnableStaticIP: Bool(true),
+			}
+			ctx := context.Background()
+			runner, resp, err := client.Actions.CreateHostedRunner(ctx, "o", request)
+
+			if created != test.wantCreated {
+				t.Errorf("Actions.CreateHostedRunner created runner = %v, want %v", created, test.wantCreated)
+			}
+			if test.wantCreated {
+				if err != nil {
+					t.Errorf("Actions.CreateHostedRunner returned error: %v", err)
+				}
+				want := &HostedRunner{ID: Int64(5), PublicIPEnabled: Bool(true)}
+				if !cmp.Equal(runner, want) {
+					t.Errorf("Actions.CreateHostedRunner returned %+v, want %+v", runner, want)
+				}
+				return
+			}
+			if !errors.Is(err, ErrStaticIPLimitReached) {
+				t.Errorf("Actions.CreateHostedRunner returned error %v, want %v", err, ErrStaticIPLimitReached)
+			}
+			if runner != nil {
+				t.Errorf("Actions.CreateHostedRunner returned %+v, want nil", runner)
+			}
+			if resp == nil {
+				t.Error("Actions.CreateHostedRunner returned nil response, want the limits response")
+			}
+		})
+	}
+}
+
+func TestActionsService_GetHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":5,"name":"r"}`)
+	})
+
+	ctx := context.Background()
+	runner, _, err := client.Actions.GetHostedRunner(ctx, "o", 5)
+	if err != nil {
+		t.Errorf("Actions.GetHostedRunner returned error: %v", err)
+	}
+
+	want := &HostedRunner{ID: Int64(5), Name: String("r")}
+	if !cmp.Equal(runner, want) {
+		t.Errorf("Actions.GetHostedRunner returned %+v, want %+v", runner, want)
+	}
+
+	const methodName = "GetHostedRunner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.GetHostedRunner(ctx, "\n", 5)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.GetHostedRunner(ctx, "o", 5)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_UpdateHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateHostedRunnerRequest{
+		Name:           String("n"),
+		MaximumRunners: Int64(20),
+		ImageVersion:   String("1.1.0"),
+	}
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/5", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateHostedRunnerRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, "PATCH")
+		if !cmp.Equal(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		fmt.Fprint(w, `{"id":5,"name":"n","maximum_runners":20}`)
+	})
+
+	ctx := context.Background()
+	runner, _, err := client.Actions.UpdateHostedRunner(ctx, "o", 5, input)
+	if err != nil {
+		t.Errorf("Actions.UpdateHostedRunner returned error: %v", err)
+	}
+
+	want := &HostedRunner{ID: Int64(5), Name: String("n"), MaximumRunners: Int64(20)}
+	if !cmp.Equal(runner, want) {
+		t.Errorf("Actions.UpdateHostedRunner returned %+v, want %+v", runner, want)
+	}
+
+	const methodName = "UpdateHostedRunner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.UpdateHostedRunner(ctx, "\n", 5, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.UpdateHostedRunner(ctx, "o", 5, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_DeleteHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":5,"status":"Deleting"}`)
+	})
+
+	ctx := context.Background()
+	runner, _, err := client.Actions.DeleteHostedRunner(ctx, "o", 5)
+	if err != nil {
+		t.Errorf("Actions.DeleteHostedRunner returned error: %v", err)
+	}
+
+	want := &HostedRunner{ID: Int64(5), Status: String("Deleting")}
+	if !cmp.Equal(runner, want) {
+		t.Errorf("Actions.DeleteHostedRunner returned %+v, want %+v", runner, want)
+	}
+
+	const methodName = "DeleteHostedRunner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.DeleteHostedRunner(ctx, "\n", 5)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.DeleteHostedRunner(ctx, "o", 5)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_ListHostedRunnerImages(t *testing.T) {
+	tests := map[string]struct {
+		path string
+		list func(*ActionsService) func(ctx context.Context, org string) (*HostedRunnerImages, *Response, error)
+	}{
+		"ListHostedRunnerGitHubOwnedImages": {
+			path: "/orgs/o/actions/hosted-runners/images/github-owned",
+			list: func(s *ActionsService) func(context.Context, string) (*HostedRunnerImages, *Response, error) {
+				return s.ListHostedRunnerGitHubOwnedImages
+			},
+		},
+		"ListHostedRunnerPartnerImages": {
+			path: "/orgs/o/actions/hosted-runners/images/partner",
+			list: func(s *ActionsService) func(context.Context, string) (*HostedRunnerImages, *Response, error) {
+				return s.ListHostedRunnerPartnerImages
+			},
+		},
+	}
+
+	for methodName, test := range tests {
+		t.Run(methodName, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc(test.path, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprint(w, `{"total_count":1,"images":[{"id":"ubuntu-20.04","platform":"linux-x64","size_gb":86,"display_name":"20.04","source":"github"}]}`)
+			})
+
+			list := test.list(client.Actions)
+			ctx := context.Background()
+			images, _, err := list(ctx, "o")
+			if err != nil {
+				t.Errorf("Actions.%v returned error: %v", methodName, err)
+			}
+
+			want := &HostedRunnerImages{
+				TotalCount: 1,
+				Images: []*HostedRunnerImageSpec{{
+					ID:          String("ubuntu-20.04"),
+					Platform:    String("linux-x64"),
+					SizeGB:      Int64(86),
+					DisplayName: String("20.04"),
+					Source:      String("github"),
+				}},
+			}
+			if !cmp.Equal(images, want) {
+				t.Errorf("Actions.%v returned %+v, want %+v", methodName, images, want)
+			}
+
+			testBadOptions(t, methodName, func() (err error) {
+				_, _, err = list(ctx, "\n")
+				return err
+			})
+
+			testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+				got, resp, err := list(ctx, "o")
+				if got != nil {
+					t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+				}
+				return resp, err
+			})
+		})
+	}
+}
+
+func TestActionsService_GetHostedRunnerLimits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"public_ips":{"maximum":50,"current_usage":17}}`)
+	})
+
+	ctx := context.Background()
+	limits, _, err := client.Actions.GetHostedRunnerLimits(ctx, "o")
+	if err != nil {
+		t.Errorf("Actions.GetHostedRunnerLimits returned error: %v", err)
+	}
+
+	want := &HostedRunnerLimits{PublicIPs: &HostedRunnerPublicIPLimits{Maximum: Int(50), CurrentUsage: Int(17)}}
+	if !cmp.Equal(limits, want) {
+		t.Errorf("Actions.GetHostedRunnerLimits returned %+v, want %+v", limits, want)
+	}
+
+	const methodName = "GetHostedRunnerLimits"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.GetHostedRunnerLimits(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.GetHostedRunnerLimits(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_ListHostedRunnerMachineSpecs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/machine-sizes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"machine_specs":[{"id":"4-core","cpu_cores":4,"memory_gb":16,"storage_gb":150}]}`)
+	})
+
+	ctx := context.Background()
+	specs, _, err := client.Actions.ListHostedRunnerMachineSpecs(ctx, "o")
+	if err != nil {
+		t.Errorf("Actions.ListHostedRunnerMachineSpecs returned error: %v", err)
+	}
+
+	want := &HostedRunnerMachineSpecs{
+		TotalCount: 1,
+		MachineSpecs: []*HostedRunnerMachineSpec{
+			{ID: String("4-core"), CPUCores: Int(4), MemoryGB: Int(16), StorageGB: Int(150)},
+		},
+	}
+	if !cmp.Equal(specs, want) {
+		t.Errorf("Actions.ListHostedRunnerMachineSpecs returned %+v, want %+v", specs, want)
+	}
+
+	const methodName = "ListHostedRunnerMachineSpecs"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.ListHostedRunnerMachineSpecs(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.ListHostedRunnerMachineSpecs(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_ListHostedRunnerPlatforms(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/platforms", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":2,"platforms":["linux-x64","win-x64"]}`)
+	})
+
+	ctx := context.Background()
+	platforms, _, err := client.Actions.ListHostedRunnerPlatforms(ctx, "o")
+	if err != nil {
+		t.Errorf("Actions.ListHostedRunnerPlatforms returned error: %v", err)
+	}
+
+	want := &HostedRunnerPlatforms{TotalCount: 2, Platforms: []string{"linux-x64", "win-x64"}}
+	if !cmp.Equal(platforms, want) {
+		t.Errorf("Actions.ListHostedRunnerPlatforms returned %+v, want %+v", platforms, want)
+	}
+
+	const methodName = "ListHostedRunnerPlatforms"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.ListHostedRunnerPlatforms(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.ListHostedRunnerPlatforms(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -22,7 +22,7 @@ type OIDCSubjectClaimCustomTemplate struct {
 //
 //meta:operation GET /orgs/{org}/actions/oidc/customization/sub
 func (s *ActionsService) GetOrgOIDCSubjectClaimCustomTemplate(ctx context.Context, org string) (*OIDCSubjectClaimCustomTemplate, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/oidc/customization/sub", org)
+	u := fmt.Sprintf("orgs/%v/actions/oidc/customization/sub", PathEscape(org))
 	return s.getOIDCSubjectClaimCustomTemplate(ctx, u)
 }
 
@@ -32,7 +32,7 @@ func (s *ActionsService) GetOrgOIDCSubjectClaimCustomTemplate(ctx context.Contex
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/oidc/customization/sub
 func (s *ActionsService) GetRepoOIDCSubjectClaimCustomTemplate(ctx context.Context, owner, repo string) (*OIDCSubjectClaimCustomTemplate, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/oidc/customization/sub", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/oidc/customization/sub", PathEscape(owner), PathEscape(repo))
 	return s.getOIDCSubjectClaimCustomTemplate(ctx, u)
 }
 
@@ -57,7 +57,7 @@ func (s *ActionsService) getOIDCSubjectClaimCustomTemplate(ctx context.Context,
 //
 //meta:operation PUT /orgs/{org}/actions/oidc/customization/sub
 func (s *ActionsService) SetOrgOIDCSubjectClaimCustomTemplate(ctx context.Context, org string, template *OIDCSubjectClaimCustomTemplate) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/oidc/customization/sub", org)
+	u := fmt.Sprintf("orgs/%v/actions/oidc/customization/sub", PathEscape(org))
 	return s.setOIDCSubjectClaimCustomTemplate(ctx, u, template)
 }
 
@@ -67,7 +67,7 @@ func (s *ActionsService) SetOrgOIDCSubjectClaimCustomTemplate(ctx context.Contex
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/oidc/customization/sub
 func (s *ActionsService) SetRepoOIDCSubjectClaimCustomTemplate(ctx context.Context, owner, repo string, template *OIDCSubjectClaimCustomTemplate) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/oidc/customization/sub", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/oidc/customization/sub", PathEscape(owner), PathEscape(repo))
 	return s.setOIDCSubjectClaimCustomTemplate(ctx, u, template)
 }
 
@@ -43,7 +43,7 @@ type DefaultWorkflowPermissionEnterprise struct {
 //
 //meta:operation GET /enterprises/{enterprise}/actions/permissions
 func (s *ActionsService) GetActionsPermissionsInEnterprise(ctx context.Context, enterprise string) (*ActionsPermissionsEnterprise, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions", PathEscape(enterprise))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -65,7 +65,7 @@ func (s *ActionsService) GetActionsPermissionsInEnterprise(ctx context.Context,
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/permissions
 func (s *ActionsService) EditActionsPermissionsInEnterprise(ctx context.Context, enterprise string, actionsPermissionsEnterprise ActionsPermissionsEnterprise) (*ActionsPermissionsEnterprise, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions", PathEscape(enterprise))
 	req, err := s.client.NewRequest("PUT", u, actionsPermissionsEnterprise)
 	if err != nil {
 		return nil, nil, err
@@ -86,7 +86,7 @@ func (s *ActionsService) EditActionsPermissionsInEnterprise(ctx context.Context,
 //
 //meta:operation GET /enterprises/{enterprise}/actions/permissions/organizations
 func (s *ActionsService) ListEnabledOrgsInEnterprise(ctx context.Context, owner string, opts *ListOptions) (*ActionsEnabledOnEnterpriseRepos, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations", owner)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations", PathEscape(owner))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -112,7 +112,7 @@ func (s *ActionsService) ListEnabledOrgsInEnterprise(ctx context.Context, owner
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/permissions/organizations
 func (s *ActionsService) SetEnabledOrgsInEnterprise(ctx context.Context, owner string, organizationIDs []int64) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations", owner)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations", PathEscape(owner))
 
 	req, err := s.client.NewRequest("PUT", u, struct {
 		IDs []int64 `json:"selected_organization_ids"`
@@ -135,7 +135,7 @@ func (s *ActionsService) SetEnabledOrgsInEnterprise(ctx context.Context, owner s
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/permissions/organizations/{org_id}
 func (s *ActionsService) AddEnabledOrgInEnterprise(ctx context.Context, owner string, organizationID int64) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations/%v", owner, organizationID)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations/%v", PathEscape(owner), organizationID)
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -156,7 +156,7 @@ func (s *ActionsService) AddEnabledOrgInEnterprise(ctx context.Context, owner st
 //
 //meta:operation DELETE /enterprises/{enterprise}/actions/permissions/organizations/{org_id}
 func (s *ActionsService) RemoveEnabledOrgInEnterprise(ctx context.Context, owner string, organizationID int64) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations/%v", owner, organizationID)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations/%v", PathEscape(owner), organizationID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -177,7 +177,7 @@ func (s *ActionsService) RemoveEnabledOrgInEnterprise(ctx context.Context, owner
 //
 //meta:operation GET /enterprises/{enterprise}/actions/permissions/selected-actions
 func (s *ActionsService) GetActionsAllowedInEnterprise(ctx context.Context, enterprise string) (*ActionsAllowed, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions/selected-actions", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/selected-actions", PathEscape(enterprise))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -199,7 +199,7 @@ func (s *ActionsService) GetActionsAllowedInEnterprise(ctx context.Context, ente
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/permissions/selected-actions
 func (s *ActionsService) EditActionsAllowedInEnterprise(ctx context.Context, enterprise string, actionsAllowed ActionsAllowed) (*ActionsAllowed, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions/selected-actions", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/selected-actions", PathEscape(enterprise))
 	req, err := s.client.NewRequest("PUT", u, actionsAllowed)
 	if err != nil {
 		return nil, nil, err
@@ -220,7 +220,7 @@ func (s *ActionsService) EditActionsAllowedInEnterprise(ctx context.Context, ent
 //
 //meta:operation GET /enterprises/{enterprise}/actions/permissions/workflow
 func (s *ActionsService) GetDefaultWorkflowPermissionsInEnterprise(ctx context.Context, enterprise string) (*DefaultWorkflowPermissionEnterprise, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions/workflow", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/workflow", PathEscape(enterprise))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -242,7 +242,7 @@ func (s *ActionsService) GetDefaultWorkflowPermissionsInEnterprise(ctx context.C
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/permissions/workflow
 func (s *ActionsService) EditDefaultWorkflowPermissionsInEnterprise(ctx context.Context, enterprise string, permissions DefaultWorkflowPermissionEnterprise) (*DefaultWorkflowPermissionEnterprise, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/permissions/workflow", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/workflow", PathEscape(enterprise))
 	req, err := s.client.NewRequest("PUT", u, permissions)
 	if err != nil {
 		return nil, nil, err
@@ -56,7 +56,7 @@ type DefaultWorkflowPermissionOrganization struct {
 //
 //meta:operation GET /orgs/{org}/actions/permissions
 func (s *ActionsService) GetActionsPermissions(ctx context.Context, org string) (*ActionsPermissions, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions", org)
+	u := fmt.Sprintf("orgs/%v/actions/permissions", PathEscape(org))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -78,7 +78,7 @@ func (s *ActionsService) GetActionsPermissions(ctx context.Context, org string)
 //
 //meta:operation PUT /orgs/{org}/actions/permissions
 func (s *ActionsService) EditActionsPermissions(ctx context.Context, org string, actionsPermissions ActionsPermissions) (*ActionsPermissions, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions", org)
+	u := fmt.Sprintf("orgs/%v/actions/permissions", PathEscape(org))
 	req, err := s.client.NewRequest("PUT", u, actionsPermissions)
 	if err != nil {
 		return nil, nil, err
@@ -99,7 +99,7 @@ func (s *ActionsService) EditActionsPermissions(ctx context.Context, org string,
 //
 //meta:operation GET /orgs/{org}/actions/permissions/repositories
 func (s *ActionsService) ListEnabledReposInOrg(ctx context.Context, owner string, opts *ListOptions) (*ActionsEnabledOnOrgRepos, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories", owner)
+	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories", PathEscape(owner))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -125,7 +125,7 @@ func (s *ActionsService) ListEnabledReposInOrg(ctx context.Context, owner string
 //
 //meta:operation PUT /orgs/{org}/actions/permissions/repositories
 func (s *ActionsService) SetEnabledReposInOrg(ctx context.Context, owner string, repositoryIDs []int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories", owner)
+	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories", PathEscape(owner))
 
 	req, err := s.client.NewRequest("PUT", u, struct {
 		IDs []int64 `json:"selected_repository_ids"`
@@ -148,7 +148,7 @@ func (s *ActionsService) SetEnabledReposInOrg(ctx context.Context, owner string,
 //
 //meta:operation PUT /orgs/{org}/actions/permissions/repositories/{repository_id}
 func (s *ActionsService) AddEnabledReposInOrg(ctx context.Context, owner string, repositoryID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories/%v", owner, repositoryID)
+	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories/%v", PathEscape(owner), repositoryID)
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -169,7 +169,7 @@ func (s *ActionsService) AddEnabledReposInOrg(ctx context.Context, owner string,
 //
 //meta:operation DELETE /orgs/{org}/actions/permissions/repositories/{repository_id}
 func (s *ActionsService) RemoveEnabledReposInOrg(ctx context.Context, owner string, repositoryID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories/%v", owner, repositoryID)
+	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories/%v", PathEscape(owner), repositoryID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -190,7 +190,7 @@ func (s *ActionsService) RemoveEnabledReposInOrg(ctx context.Context, owner stri
 //
 //meta:operation GET /orgs/{org}/actions/permissions/selected-actions
 func (s *ActionsService) GetActionsAllowed(ctx context.Context, org string) (*ActionsAllowed, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions/selected-actions", org)
+	u := fmt.Sprintf("orgs/%v/actions/permissions/selected-actions", PathEscape(org))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -212,7 +212,7 @@ func (s *ActionsService) GetActionsAllowed(ctx context.Context, org string) (*Ac
 //
 //meta:operation PUT /orgs/{org}/actions/permissions/selected-actions
 func (s *ActionsService) EditActionsAllowed(ctx context.Context, org string, actionsAllowed ActionsAllowed) (*ActionsAllowed, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions/selected-actions", org)
+	u := fmt.Sprintf("orgs/%v/actions/permissions/selected-actions", PathEscape(org))
 	req, err := s.client.NewRequest("PUT", u, actionsAllowed)
 	if err != nil {
 		return nil, nil, err
@@ -233,7 +233,7 @@ func (s *ActionsService) EditActionsAllowed(ctx context.Context, org string, act
 //
 //meta:operation GET /orgs/{org}/actions/permissions/workflow
 func (s *ActionsService) GetDefaultWorkflowPermissionsInOrganization(ctx context.Context, org string) (*DefaultWorkflowPermissionOrganization, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions/workflow", org)
+	u := fmt.Sprintf("orgs/%v/actions/permissions/workflow", PathEscape(org))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -255,7 +255,7 @@ func (s *ActionsService) GetDefaultWorkflowPermissionsInOrganization(ctx context
 //
 //meta:operation PUT /orgs/{org}/actions/permissions/workflow
 func (s *ActionsService) EditDefaultWorkflowPermissionsInOrganization(ctx context.Context, org string, permissions DefaultWorkflowPermissionOrganization) (*DefaultWorkflowPermissionOrganization, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/permissions/workflow", org)
+	u := fmt.Sprintf("orgs/%v/actions/permissions/workflow", PathEscape(org))
 	req, err := s.client.NewRequest("PUT", u, permissions)
 	if err != nil {
 		return nil, nil, err
@@ -71,7 +71,7 @@ type RepoRequiredWorkflows struct {
 //
 //meta:operation GET /orgs/{org}/actions/required_workflows
 func (s *ActionsService) ListOrgRequiredWorkflows(ctx context.Context, org string, opts *ListOptions) (*OrgRequiredWorkflows, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/required_workflows", org)
+	url := fmt.Sprintf("orgs/%v/actions/required_workflows", PathEscape(org))
 	u, err := addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
@@ -97,7 +97,7 @@ func (s *ActionsService) ListOrgRequiredWorkflows(ctx context.Context, org strin
 //
 //meta:operation POST /orgs/{org}/actions/required_workflows
 func (s *ActionsService) CreateRequiredWorkflow(ctx context.Context, org string, createRequiredWorkflowOptions *CreateUpdateRequiredWorkflowOptions) (*OrgRequiredWorkflow, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/required_workflows", org)
+	url := fmt.Sprintf("orgs/%v/actions/required_workflows", PathEscape(org))
 	req, err := s.client.NewRequest("POST", url, createRequiredWorkflowOptions)
 	if err != nil {
 		return nil, nil, err
@@ -118,7 +118,7 @@ func (s *ActionsService) CreateRequiredWorkflow(ctx context.Context, org string,
 //
 //meta:operation GET /orgs/{org}/actions/required_workflows/{workflow_id}
 func (s *ActionsService) GetRequiredWorkflowByID(ctx context.Context, owner string, requiredWorkflowID int64) (*OrgRequiredWorkflow, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/required_workflows/%v", owner, requiredWorkflowID)
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows/%v", PathEscape(owner), requiredWorkflowID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -140,7 +140,7 @@ func (s *ActionsService) GetRequiredWorkflowByID(ctx context.Context, owner stri
 //
 //meta:operation PATCH /orgs/{org}/actions/required_workflows/{workflow_id}
 func (s *ActionsService) UpdateRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64, updateRequiredWorkflowOptions *CreateUpdateRequiredWorkflowOptions) (*OrgRequiredWorkflow, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v", org, requiredWorkflowID)
+	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v", PathEscape(org), requiredWorkflowID)
 	req, err := s.client.NewRequest("PATCH", url, updateRequiredWorkflowOptions)
 	if err != nil {
 		return nil, nil, err
@@ -161,7 +161,7 @@ func (s *ActionsService) UpdateRequiredWorkflow(ctx context.Context, org string,
 //
 //meta:operation DELETE /orgs/{org}/actions/required_workflows/{workflow_id}
 func (s *ActionsService) DeleteRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v", org, requiredWorkflowID)
+	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v", PathEscape(org), requiredWorkflowID)
 	req, err := s.client.NewRequest("DELETE", url, nil)
 	if err != nil {
 		return nil, err
@@ -175,7 +175,7 @@ func (s *ActionsService) DeleteRequiredWorkflow(ctx context.Context, org string,
 //
 //meta:operation GET /orgs/{org}/actions/required_workflows/{workflow_id}/repositories
 func (s *ActionsService) ListRequiredWorkflowSelectedRepos(ctx context.Context, org string, requiredWorkflowID int64, opts *ListOptions) (*RequiredWorkflowSelectedRepos, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories", org, requiredWorkflowID)
+	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories", PathEscape(org), requiredWorkflowID)
 	u, err := addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
@@ -203,7 +203,7 @@ func (s *ActionsService) SetRequiredWorkflowSelectedRepos(ctx context.Context, o
 	type repoIDs struct {
 		SelectedIDs SelectedRepoIDs `json:"selected_repository_ids"`
 	}
-	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories", org, requiredWorkflowID)
+	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories", PathEscape(org), requiredWorkflowID)
 	req, err := s.client.NewRequest("PUT", url, repoIDs{SelectedIDs: ids})
 	if err != nil {
 		return nil, err
@@ -218,7 +218,7 @@ func (s *ActionsService) SetRequiredWorkflowSelectedRepos(ctx context.Context, o
 //
 //meta:operation PUT /orgs/{org}/actions/required_workflows/{workflow_id}/repositories/{repository_id}
 func (s *ActionsService) AddRepoToRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID, repoID int64) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories/%v", org, requiredWorkflowID, repoID)
+	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories/%v", PathEscape(org), requiredWorkflowID, repoID)
 	req, err := s.client.NewRequest("PUT", url, nil)
 	if err != nil {
 		return nil, err
@@ -232,7 +232,7 @@ func (s *ActionsService) AddRepoToRequiredWorkflow(ctx context.Context, org stri
 //
 //meta:operation DELETE /orgs/{org}/actions/required_workflows/{workflow_id}/repositories/{repository_id}
 func (s *ActionsService) RemoveRepoFromRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID, repoID int64) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories/%v", org, requiredWorkflowID, repoID)
+	url := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories/%v", PathEscape(org), requiredWorkflowID, repoID)
 	req, err := s.client.NewRequest("DELETE", url, nil)
 	if err != nil {
 		return nil, err
@@ -246,7 +246,7 @@ func (s *ActionsService) RemoveRepoFromRequiredWorkflow(ctx context.Context, org
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/required_workflows
 func (s *ActionsService) ListRepoRequiredWorkflows(ctx context.Context, owner, repo string, opts *ListOptions) (*RepoRequiredWorkflows, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/required_workflows", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/actions/required_workflows", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
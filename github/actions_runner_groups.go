@@ -84,7 +84,7 @@ type ListOrgRunnerGroupOptions struct {
 //
 //meta:operation GET /orgs/{org}/actions/runner-groups
 func (s *ActionsService) ListOrganizationRunnerGroups(ctx context.Context, org string, opts *ListOrgRunnerGroupOptions) (*RunnerGroups, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups", org)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -110,7 +110,7 @@ func (s *ActionsService) ListOrganizationRunnerGroups(ctx context.Context, org s
 //
 //meta:operation GET /orgs/{org}/actions/runner-groups/{runner_group_id}
 func (s *ActionsService) GetOrganizationRunnerGroup(ctx context.Context, org string, groupID int64) (*RunnerGroup, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v", org, groupID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v", PathEscape(org), groupID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -131,7 +131,7 @@ func (s *ActionsService) GetOrganizationRunnerGroup(ctx context.Context, org str
 //
 //meta:operation DELETE /orgs/{org}/actions/runner-groups/{runner_group_id}
 func (s *ActionsService) DeleteOrganizationRunnerGroup(ctx context.Context, org string, groupID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v", org, groupID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v", PathEscape(org), groupID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -147,7 +147,7 @@ func (s *ActionsService) DeleteOrganizationRunnerGroup(ctx context.Context, org
 //
 //meta:operation POST /orgs/{org}/actions/runner-groups
 func (s *ActionsService) CreateOrganizationRunnerGroup(ctx context.Context, org string, createReq CreateRunnerGroupRequest) (*RunnerGroup, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups", org)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups", PathEscape(org))
 	req, err := s.client.NewRequest("POST", u, createReq)
 	if err != nil {
 		return nil, nil, err
@@ -168,7 +168,7 @@ func (s *ActionsService) CreateOrganizationRunnerGroup(ctx context.Context, org
 //
 //meta:operation PATCH /orgs/{org}/actions/runner-groups/{runner_group_id}
 func (s *ActionsService) UpdateOrganizationRunnerGroup(ctx context.Context, org string, groupID int64, updateReq UpdateRunnerGroupRequest) (*RunnerGroup, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v", org, groupID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v", PathEscape(org), groupID)
 	req, err := s.client.NewRequest("PATCH", u, updateReq)
 	if err != nil {
 		return nil, nil, err
@@ -189,7 +189,7 @@ func (s *ActionsService) UpdateOrganizationRunnerGroup(ctx context.Context, org
 //
 //meta:operation GET /orgs/{org}/actions/runner-groups/{runner_group_id}/repositories
 func (s *ActionsService) ListRepositoryAccessRunnerGroup(ctx context.Context, org string, groupID int64, opts *ListOptions) (*ListRepositories, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories", org, groupID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories", PathEscape(org), groupID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -216,7 +216,7 @@ func (s *ActionsService) ListRepositoryAccessRunnerGroup(ctx context.Context, or
 //
 //meta:operation PUT /orgs/{org}/actions/runner-groups/{runner_group_id}/repositories
 func (s *ActionsService) SetRepositoryAccessRunnerGroup(ctx context.Context, org string, groupID int64, ids SetRepoAccessRunnerGroupRequest) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories", org, groupID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories", PathEscape(org), groupID)
 
 	req, err := s.client.NewRequest("PUT", u, ids)
 	if err != nil {
@@ -233,7 +233,7 @@ func (s *ActionsService) SetRepositoryAccessRunnerGroup(ctx context.Context, org
 //
 //meta:operation PUT /orgs/{org}/actions/runner-groups/{runner_group_id}/repositories/{repository_id}
 func (s *ActionsService) AddRepositoryAccessRunnerGroup(ctx context.Context, org string, groupID, repoID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories/%v", org, groupID, repoID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories/%v", PathEscape(org), groupID, repoID)
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -250,7 +250,7 @@ func (s *ActionsService) AddRepositoryAccessRunnerGroup(ctx context.Context, org
 //
 //meta:operation DELETE /orgs/{org}/actions/runner-groups/{runner_group_id}/repositories/{repository_id}
 func (s *ActionsService) RemoveRepositoryAccessRunnerGroup(ctx context.Context, org string, groupID, repoID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories/%v", org, groupID, repoID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories/%v", PathEscape(org), groupID, repoID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -266,7 +266,7 @@ func (s *ActionsService) RemoveRepositoryAccessRunnerGroup(ctx context.Context,
 //
 //meta:operation GET /orgs/{org}/actions/runner-groups/{runner_group_id}/runners
 func (s *ActionsService) ListRunnerGroupRunners(ctx context.Context, org string, groupID int64, opts *ListOptions) (*Runners, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/runners", org, groupID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/runners", PathEscape(org), groupID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -293,7 +293,7 @@ func (s *ActionsService) ListRunnerGroupRunners(ctx context.Context, org string,
 //
 //meta:operation PUT /orgs/{org}/actions/runner-groups/{runner_group_id}/runners
 func (s *ActionsService) SetRunnerGroupRunners(ctx context.Context, org string, groupID int64, ids SetRunnerGroupRunnersRequest) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/runners", org, groupID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/runners", PathEscape(org), groupID)
 
 	req, err := s.client.NewRequest("PUT", u, ids)
 	if err != nil {
@@ -309,7 +309,7 @@ func (s *ActionsService) SetRunnerGroupRunners(ctx context.Context, org string,
 //
 //meta:operation PUT /orgs/{org}/actions/runner-groups/{runner_group_id}/runners/{runner_id}
 func (s *ActionsService) AddRunnerGroupRunners(ctx context.Context, org string, groupID, runnerID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/runners/%v", org, groupID, runnerID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/runners/%v", PathEscape(org), groupID, runnerID)
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -326,7 +326,7 @@ func (s *ActionsService) AddRunnerGroupRunners(ctx context.Context, org string,
 //
 //meta:operation DELETE /orgs/{org}/actions/runner-groups/{runner_group_id}/runners/{runner_id}
 func (s *ActionsService) RemoveRunnerGroupRunners(ctx context.Context, org string, groupID, runnerID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/runners/%v", org, groupID, runnerID)
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/runners/%v", PathEscape(org), groupID, runnerID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -26,7 +26,7 @@ type RunnerApplicationDownload struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runners/downloads
 func (s *ActionsService) ListRunnerApplicationDownloads(ctx context.Context, owner, repo string) ([]*RunnerApplicationDownload, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runners/downloads", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/downloads", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -64,7 +64,7 @@ type JITRunnerConfig struct {
 //
 //meta:operation POST /orgs/{org}/actions/runners/generate-jitconfig
 func (s *ActionsService) GenerateOrgJITConfig(ctx context.Context, org string, request *GenerateJITConfigRequest) (*JITRunnerConfig, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runners/generate-jitconfig", org)
+	u := fmt.Sprintf("orgs/%v/actions/runners/generate-jitconfig", PathEscape(org))
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
 		return nil, nil, err
@@ -85,7 +85,7 @@ func (s *ActionsService) GenerateOrgJITConfig(ctx context.Context, org string, r
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/runners/generate-jitconfig
 func (s *ActionsService) GenerateRepoJITConfig(ctx context.Context, owner, repo string, request *GenerateJITConfigRequest) (*JITRunnerConfig, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runners/generate-jitconfig", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/generate-jitconfig", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
 		return nil, nil, err
@@ -112,7 +112,7 @@ type RegistrationToken struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/runners/registration-token
 func (s *ActionsService) CreateRegistrationToken(ctx context.Context, owner, repo string) (*RegistrationToken, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runners/registration-token", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/registration-token", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
@@ -163,7 +163,7 @@ type ListRunnersOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runners
 func (s *ActionsService) ListRunners(ctx context.Context, owner, repo string, opts *ListRunnersOptions) (*Runners, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runners", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/runners", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -189,7 +189,7 @@ func (s *ActionsService) ListRunners(ctx context.Context, owner, repo string, op
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runners/{runner_id}
 func (s *ActionsService) GetRunner(ctx context.Context, owner, repo string, runnerID int64) (*Runner, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runners/%v", owner, repo, runnerID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/%v", PathEscape(owner), PathEscape(repo), runnerID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -216,7 +216,7 @@ type RemoveToken struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/runners/remove-token
 func (s *ActionsService) CreateRemoveToken(ctx context.Context, owner, repo string) (*RemoveToken, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runners/remove-token", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/remove-token", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
@@ -238,7 +238,7 @@ func (s *ActionsService) CreateRemoveToken(ctx context.Context, owner, repo stri
 //
 //meta:operation DELETE /repos/{owner}/{repo}/actions/runners/{runner_id}
 func (s *ActionsService) RemoveRunner(ctx context.Context, owner, repo string, runnerID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runners/%v", owner, repo, runnerID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/%v", PathEscape(owner), PathEscape(repo), runnerID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -254,7 +254,7 @@ func (s *ActionsService) RemoveRunner(ctx context.Context, owner, repo string, r
 //
 //meta:operation GET /orgs/{org}/actions/runners/downloads
 func (s *ActionsService) ListOrganizationRunnerApplicationDownloads(ctx context.Context, org string) ([]*RunnerApplicationDownload, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runners/downloads", org)
+	u := fmt.Sprintf("orgs/%v/actions/runners/downloads", PathEscape(org))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -275,7 +275,7 @@ func (s *ActionsService) ListOrganizationRunnerApplicationDownloads(ctx context.
 //
 //meta:operation POST /orgs/{org}/actions/runners/registration-token
 func (s *ActionsService) CreateOrganizationRegistrationToken(ctx context.Context, org string) (*RegistrationToken, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runners/registration-token", org)
+	u := fmt.Sprintf("orgs/%v/actions/runners/registration-token", PathEscape(org))
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
@@ -297,7 +297,7 @@ func (s *ActionsService) CreateOrganizationRegistrationToken(ctx context.Context
 //
 //meta:operation GET /orgs/{org}/actions/runners
 func (s *ActionsService) ListOrganizationRunners(ctx context.Context, org string, opts *ListRunnersOptions) (*Runners, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runners", org)
+	u := fmt.Sprintf("orgs/%v/actions/runners", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -323,7 +323,7 @@ func (s *ActionsService) ListOrganizationRunners(ctx context.Context, org string
 //
 //meta:operation GET /orgs/{org}/actions/runners/{runner_id}
 func (s *ActionsService) GetOrganizationRunner(ctx context.Context, org string, runnerID int64) (*Runner, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runners/%v", org, runnerID)
+	u := fmt.Sprintf("orgs/%v/actions/runners/%v", PathEscape(org), runnerID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -344,7 +344,7 @@ func (s *ActionsService) GetOrganizationRunner(ctx context.Context, org string,
 //
 //meta:operation POST /orgs/{org}/actions/runners/remove-token
 func (s *ActionsService) CreateOrganizationRemoveToken(ctx context.Context, org string) (*RemoveToken, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runners/remove-token", org)
+	u := fmt.Sprintf("orgs/%v/actions/runners/remove-token", PathEscape(org))
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
@@ -366,7 +366,7 @@ func (s *ActionsService) CreateOrganizationRemoveToken(ctx context.Context, org
 //
 //meta:operation DELETE /orgs/{org}/actions/runners/{runner_id}
 func (s *ActionsService) RemoveOrganizationRunner(ctx context.Context, org string, runnerID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/actions/runners/%v", org, runnerID)
+	u := fmt.Sprintf("orgs/%v/actions/runners/%v", PathEscape(org), runnerID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 )
 
@@ -68,7 +69,7 @@ func (s *ActionsService) getPublicKey(ctx context.Context, url string) (*PublicK
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/secrets/public-key
 func (s *ActionsService) GetRepoPublicKey(ctx context.Context, owner, repo string) (*PublicKey, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/secrets/public-key", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/actions/secrets/public-key", PathEscape(owner), PathEscape(repo))
 	return s.getPublicKey(ctx, url)
 }
 
@@ -78,7 +79,7 @@ func (s *ActionsService) GetRepoPublicKey(ctx context.Context, owner, repo strin
 //
 //meta:operation GET /orgs/{org}/actions/secrets/public-key
 func (s *ActionsService) GetOrgPublicKey(ctx context.Context, org string) (*PublicKey, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/secrets/public-key", org)
+	url := fmt.Sprintf("orgs/%v/actions/secrets/public-key", PathEscape(org))
 	return s.getPublicKey(ctx, url)
 }
 
@@ -88,7 +89,7 @@ func (s *ActionsService) GetOrgPublicKey(ctx context.Context, org string) (*Publ
 //
 //meta:operation GET /repositories/{repository_id}/environments/{environment_name}/secrets/public-key
 func (s *ActionsService) GetEnvPublicKey(ctx context.Context, repoID int, env string) (*PublicKey, *Response, error) {
-	url := fmt.Sprintf("repositories/%v/environments/%v/secrets/public-key", repoID, env)
+	url := fmt.Sprintf("repositories/%v/environments/%v/secrets/public-key", repoID, url.PathEscape(env))
 	return s.getPublicKey(ctx, url)
 }
 
@@ -134,7 +135,7 @@ func (s *ActionsService) listSecrets(ctx context.Context, url string, opts *List
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/secrets
 func (s *ActionsService) ListRepoSecrets(ctx context.Context, owner, repo string, opts *ListOptions) (*Secrets, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/secrets", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/actions/secrets", PathEscape(owner), PathEscape(repo))
 	return s.listSecrets(ctx, url, opts)
 }
 
@@ -145,7 +146,7 @@ func (s *ActionsService) ListRepoSecrets(ctx context.Context, owner, repo string
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/organization-secrets
 func (s *ActionsService) ListRepoOrgSecrets(ctx context.Context, owner, repo string, opts *ListOptions) (*Secrets, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/organization-secrets", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/actions/organization-secrets", PathEscape(owner), PathEscape(repo))
 	return s.listSecrets(ctx, url, opts)
 }
 
@@ -156,7 +157,7 @@ func (s *ActionsService) ListRepoOrgSecrets(ctx context.Context, owner, repo str
 //
 //meta:operation GET /orgs/{org}/actions/secrets
 func (s *ActionsService) ListOrgSecrets(ctx context.Context, org string, opts *ListOptions) (*Secrets, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/secrets", org)
+	url := fmt.Sprintf("orgs/%v/actions/secrets", PathEscape(org))
 	return s.listSecrets(ctx, url, opts)
 }
 
@@ -166,7 +167,7 @@ func (s *ActionsService) ListOrgSecrets(ctx context.Context, org string, opts *L
 //
 //meta:operation GET /repositories/{repository_id}/environments/{environment_name}/secrets
 func (s *ActionsService) ListEnvSecrets(ctx context.Context, repoID int, env string, opts *ListOptions) (*Secrets, *Response, error) {
-	url := fmt.Sprintf("repositories/%v/environments/%v/secrets", repoID, env)
+	url := fmt.Sprintf("repositories/%v/environments/%v/secrets", repoID, url.PathEscape(env))
 	return s.listSecrets(ctx, url, opts)
 }
 
@@ -191,7 +192,7 @@ func (s *ActionsService) getSecret(ctx context.Context, url string) (*Secret, *R
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/secrets/{secret_name}
 func (s *ActionsService) GetRepoSecret(ctx context.Context, owner, repo, name string) (*Secret, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", owner, repo, name)
+	url := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	return s.getSecret(ctx, url)
 }
 
@@ -201,7 +202,7 @@ func (s *ActionsService) GetRepoSecret(ctx context.Context, owner, repo, name st
 //
 //meta:operation GET /orgs/{org}/actions/secrets/{secret_name}
 func (s *ActionsService) GetOrgSecret(ctx context.Context, org, name string) (*Secret, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/secrets/%v", org, name)
+	url := fmt.Sprintf("orgs/%v/actions/secrets/%v", PathEscape(org), PathEscape(name))
 	return s.getSecret(ctx, url)
 }
 
@@ -211,7 +212,7 @@ func (s *ActionsService) GetOrgSecret(ctx context.Context, org, name string) (*S
 //
 //meta:operation GET /repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}
 func (s *ActionsService) GetEnvSecret(ctx context.Context, repoID int, env, secretName string) (*Secret, *Response, error) {
-	url := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, env, secretName)
+	url := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, url.PathEscape(env), PathEscape(secretName))
 	return s.getSecret(ctx, url)
 }
 
@@ -246,7 +247,7 @@ func (s *ActionsService) putSecret(ctx context.Context, url string, eSecret *Enc
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/secrets/{secret_name}
 func (s *ActionsService) CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *EncryptedSecret) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", owner, repo, eSecret.Name)
+	url := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", PathEscape(owner), PathEscape(repo), PathEscape(eSecret.Name))
 	return s.putSecret(ctx, url, eSecret)
 }
 
@@ -256,7 +257,7 @@ func (s *ActionsService) CreateOrUpdateRepoSecret(ctx context.Context, owner, re
 //
 //meta:operation PUT /orgs/{org}/actions/secrets/{secret_name}
 func (s *ActionsService) CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *EncryptedSecret) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/secrets/%v", org, eSecret.Name)
+	url := fmt.Sprintf("orgs/%v/actions/secrets/%v", PathEscape(org), PathEscape(eSecret.Name))
 	return s.putSecret(ctx, url, eSecret)
 }
 
@@ -266,7 +267,7 @@ func (s *ActionsService) CreateOrUpdateOrgSecret(ctx context.Context, org string
 //
 //meta:operation PUT /repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}
 func (s *ActionsService) CreateOrUpdateEnvSecret(ctx context.Context, repoID int, env string, eSecret *EncryptedSecret) (*Response, error) {
-	url := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, env, eSecret.Name)
+	url := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, url.PathEscape(env), PathEscape(eSecret.Name))
 	return s.putSecret(ctx, url, eSecret)
 }
 
@@ -285,7 +286,7 @@ func (s *ActionsService) deleteSecret(ctx context.Context, url string) (*Respons
 //
 //meta:operation DELETE /repos/{owner}/{repo}/actions/secrets/{secret_name}
 func (s *ActionsService) DeleteRepoSecret(ctx context.Context, owner, repo, name string) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", owner, repo, name)
+	url := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	return s.deleteSecret(ctx, url)
 }
 
@@ -295,7 +296,7 @@ func (s *ActionsService) DeleteRepoSecret(ctx context.Context, owner, repo, name
 //
 //meta:operation DELETE /orgs/{org}/actions/secrets/{secret_name}
 func (s *ActionsService) DeleteOrgSecret(ctx context.Context, org, name string) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/secrets/%v", org, name)
+	url := fmt.Sprintf("orgs/%v/actions/secrets/%v", PathEscape(org), PathEscape(name))
 	return s.deleteSecret(ctx, url)
 }
 
@@ -305,7 +306,7 @@ func (s *ActionsService) DeleteOrgSecret(ctx context.Context, org, name string)
 //
 //meta:operation DELETE /repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}
 func (s *ActionsService) DeleteEnvSecret(ctx context.Context, repoID int, env, secretName string) (*Response, error) {
-	url := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, env, secretName)
+	url := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, url.PathEscape(env), PathEscape(secretName))
 	return s.deleteSecret(ctx, url)
 }
 
@@ -341,7 +342,7 @@ func (s *ActionsService) listSelectedReposForSecret(ctx context.Context, url str
 //
 //meta:operation GET /orgs/{org}/actions/secrets/{secret_name}/repositories
 func (s *ActionsService) ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/secrets/%v/repositories", org, name)
+	url := fmt.Sprintf("orgs/%v/actions/secrets/%v/repositories", PathEscape(org), PathEscape(name))
 	return s.listSelectedReposForSecret(ctx, url, opts)
 }
 
@@ -364,7 +365,7 @@ func (s *ActionsService) setSelectedReposForSecret(ctx context.Context, url stri
 //
 //meta:operation PUT /orgs/{org}/actions/secrets/{secret_name}/repositories
 func (s *ActionsService) SetSelectedReposForOrgSecret(ctx context.Context, org, name string, ids SelectedRepoIDs) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/secrets/%v/repositories", org, name)
+	url := fmt.Sprintf("orgs/%v/actions/secrets/%v/repositories", PathEscape(org), PathEscape(name))
 	return s.setSelectedReposForSecret(ctx, url, ids)
 }
 
@@ -383,7 +384,7 @@ func (s *ActionsService) addSelectedRepoToSecret(ctx context.Context, url string
 //
 //meta:operation PUT /orgs/{org}/actions/secrets/{secret_name}/repositories/{repository_id}
 func (s *ActionsService) AddSelectedRepoToOrgSecret(ctx context.Context, org, name string, repo *Repository) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/secrets/%v/repositories/%v", org, name, *repo.ID)
+	url := fmt.Sprintf("orgs/%v/actions/secrets/%v/repositories/%v", PathEscape(org), PathEscape(name), *repo.ID)
 	return s.addSelectedRepoToSecret(ctx, url)
 }
 
@@ -402,6 +403,6 @@ func (s *ActionsService) removeSelectedRepoFromSecret(ctx context.Context, url s
 //
 //meta:operation DELETE /orgs/{org}/actions/secrets/{secret_name}/repositories/{repository_id}
 func (s *ActionsService) RemoveSelectedRepoFromOrgSecret(ctx context.Context, org, name string, repo *Repository) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/secrets/%v/repositories/%v", org, name, *repo.ID)
+	url := fmt.Sprintf("orgs/%v/actions/secrets/%v/repositories/%v", PathEscape(org), PathEscape(name), *repo.ID)
 	return s.removeSelectedRepoFromSecret(ctx, url)
 }
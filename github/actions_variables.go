@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // ActionsVariable represents a repository action variable.
@@ -55,7 +56,7 @@ func (s *ActionsService) listVariables(ctx context.Context, url string, opts *Li
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/variables
 func (s *ActionsService) ListRepoVariables(ctx context.Context, owner, repo string, opts *ListOptions) (*ActionsVariables, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/variables", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/actions/variables", PathEscape(owner), PathEscape(repo))
 	return s.listVariables(ctx, url, opts)
 }
 
@@ -65,7 +66,7 @@ func (s *ActionsService) ListRepoVariables(ctx context.Context, owner, repo stri
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/organization-variables
 func (s *ActionsService) ListRepoOrgVariables(ctx context.Context, owner, repo string, opts *ListOptions) (*ActionsVariables, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/organization-variables", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/actions/organization-variables", PathEscape(owner), PathEscape(repo))
 	return s.listVariables(ctx, url, opts)
 }
 
@@ -75,7 +76,7 @@ func (s *ActionsService) ListRepoOrgVariables(ctx context.Context, owner, repo s
 //
 //meta:operation GET /orgs/{org}/actions/variables
 func (s *ActionsService) ListOrgVariables(ctx context.Context, org string, opts *ListOptions) (*ActionsVariables, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/variables", org)
+	url := fmt.Sprintf("orgs/%v/actions/variables", PathEscape(org))
 	return s.listVariables(ctx, url, opts)
 }
 
@@ -85,7 +86,7 @@ func (s *ActionsService) ListOrgVariables(ctx context.Context, org string, opts
 //
 //meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}/variables
 func (s *ActionsService) ListEnvVariables(ctx context.Context, owner, repo, env string, opts *ListOptions) (*ActionsVariables, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables", owner, repo, env)
+	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables", PathEscape(owner), PathEscape(repo), url.PathEscape(env))
 	return s.listVariables(ctx, url, opts)
 }
 
@@ -110,7 +111,7 @@ func (s *ActionsService) getVariable(ctx context.Context, url string) (*ActionsV
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/variables/{name}
 func (s *ActionsService) GetRepoVariable(ctx context.Context, owner, repo, name string) (*ActionsVariable, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/variables/%v", owner, repo, name)
+	url := fmt.Sprintf("repos/%v/%v/actions/variables/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	return s.getVariable(ctx, url)
 }
 
@@ -120,7 +121,7 @@ func (s *ActionsService) GetRepoVariable(ctx context.Context, owner, repo, name
 //
 //meta:operation GET /orgs/{org}/actions/variables/{name}
 func (s *ActionsService) GetOrgVariable(ctx context.Context, org, name string) (*ActionsVariable, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/variables/%v", org, name)
+	url := fmt.Sprintf("orgs/%v/actions/variables/%v", PathEscape(org), PathEscape(name))
 	return s.getVariable(ctx, url)
 }
 
@@ -130,7 +131,7 @@ func (s *ActionsService) GetOrgVariable(ctx context.Context, org, name string) (
 //
 //meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}/variables/{name}
 func (s *ActionsService) GetEnvVariable(ctx context.Context, owner, repo, env, variableName string) (*ActionsVariable, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables/%v", owner, repo, env, variableName)
+	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables/%v", PathEscape(owner), PathEscape(repo), url.PathEscape(env), PathEscape(variableName))
 	return s.getVariable(ctx, url)
 }
 
@@ -148,7 +149,7 @@ func (s *ActionsService) postVariable(ctx context.Context, url string, variable
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/variables
 func (s *ActionsService) CreateRepoVariable(ctx context.Context, owner, repo string, variable *ActionsVariable) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/variables", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/actions/variables", PathEscape(owner), PathEscape(repo))
 	return s.postVariable(ctx, url, variable)
 }
 
@@ -158,7 +159,7 @@ func (s *ActionsService) CreateRepoVariable(ctx context.Context, owner, repo str
 //
 //meta:operation POST /orgs/{org}/actions/variables
 func (s *ActionsService) CreateOrgVariable(ctx context.Context, org string, variable *ActionsVariable) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/variables", org)
+	url := fmt.Sprintf("orgs/%v/actions/variables", PathEscape(org))
 	return s.postVariable(ctx, url, variable)
 }
 
@@ -168,7 +169,7 @@ func (s *ActionsService) CreateOrgVariable(ctx context.Context, org string, vari
 //
 //meta:operation POST /repos/{owner}/{repo}/environments/{environment_name}/variables
 func (s *ActionsService) CreateEnvVariable(ctx context.Context, owner, repo, env string, variable *ActionsVariable) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables", owner, repo, env)
+	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables", PathEscape(owner), PathEscape(repo), url.PathEscape(env))
 	return s.postVariable(ctx, url, variable)
 }
 
@@ -186,7 +187,7 @@ func (s *ActionsService) patchVariable(ctx context.Context, url string, variable
 //
 //meta:operation PATCH /repos/{owner}/{repo}/actions/variables/{name}
 func (s *ActionsService) UpdateRepoVariable(ctx context.Context, owner, repo string, variable *ActionsVariable) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/variables/%v", owner, repo, variable.Name)
+	url := fmt.Sprintf("repos/%v/%v/actions/variables/%v", PathEscape(owner), PathEscape(repo), PathEscape(variable.Name))
 	return s.patchVariable(ctx, url, variable)
 }
 
@@ -196,7 +197,7 @@ func (s *ActionsService) UpdateRepoVariable(ctx context.Context, owner, repo str
 //
 //meta:operation PATCH /orgs/{org}/actions/variables/{name}
 func (s *ActionsService) UpdateOrgVariable(ctx context.Context, org string, variable *ActionsVariable) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/variables/%v", org, variable.Name)
+	url := fmt.Sprintf("orgs/%v/actions/variables/%v", PathEscape(org), PathEscape(variable.Name))
 	return s.patchVariable(ctx, url, variable)
 }
 
@@ -206,7 +207,7 @@ func (s *ActionsService) UpdateOrgVariable(ctx context.Context, org string, vari
 //
 //meta:operation PATCH /repos/{owner}/{repo}/environments/{environment_name}/variables/{name}
 func (s *ActionsService) UpdateEnvVariable(ctx context.Context, owner, repo, env string, variable *ActionsVariable) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables/%v", owner, repo, env, variable.Name)
+	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables/%v", PathEscape(owner), PathEscape(repo), url.PathEscape(env), PathEscape(variable.Name))
 	return s.patchVariable(ctx, url, variable)
 }
 
@@ -225,7 +226,7 @@ func (s *ActionsService) deleteVariable(ctx context.Context, url string) (*Respo
 //
 //meta:operation DELETE /repos/{owner}/{repo}/actions/variables/{name}
 func (s *ActionsService) DeleteRepoVariable(ctx context.Context, owner, repo, name string) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/actions/variables/%v", owner, repo, name)
+	url := fmt.Sprintf("repos/%v/%v/actions/variables/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	return s.deleteVariable(ctx, url)
 }
 
@@ -235,7 +236,7 @@ func (s *ActionsService) DeleteRepoVariable(ctx context.Context, owner, repo, na
 //
 //meta:operation DELETE /orgs/{org}/actions/variables/{name}
 func (s *ActionsService) DeleteOrgVariable(ctx context.Context, org, name string) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/variables/%v", org, name)
+	url := fmt.Sprintf("orgs/%v/actions/variables/%v", PathEscape(org), PathEscape(name))
 	return s.deleteVariable(ctx, url)
 }
 
@@ -245,7 +246,7 @@ func (s *ActionsService) DeleteOrgVariable(ctx context.Context, org, name string
 //
 //meta:operation DELETE /repos/{owner}/{repo}/environments/{environment_name}/variables/{name}
 func (s *ActionsService) DeleteEnvVariable(ctx context.Context, owner, repo, env, variableName string) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables/%v", owner, repo, env, variableName)
+	url := fmt.Sprintf("repos/%v/%v/environments/%v/variables/%v", PathEscape(owner), PathEscape(repo), url.PathEscape(env), PathEscape(variableName))
 	return s.deleteVariable(ctx, url)
 }
 
@@ -275,7 +276,7 @@ func (s *ActionsService) listSelectedReposForVariable(ctx context.Context, url s
 //
 //meta:operation GET /orgs/{org}/actions/variables/{name}/repositories
 func (s *ActionsService) ListSelectedReposForOrgVariable(ctx context.Context, org, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/variables/%v/repositories", org, name)
+	url := fmt.Sprintf("orgs/%v/actions/variables/%v/repositories", PathEscape(org), PathEscape(name))
 	return s.listSelectedReposForVariable(ctx, url, opts)
 }
 
@@ -298,7 +299,7 @@ func (s *ActionsService) setSelectedReposForVariable(ctx context.Context, url st
 //
 //meta:operation PUT /orgs/{org}/actions/variables/{name}/repositories
 func (s *ActionsService) SetSelectedReposForOrgVariable(ctx context.Context, org, name string, ids SelectedRepoIDs) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/variables/%v/repositories", org, name)
+	url := fmt.Sprintf("orgs/%v/actions/variables/%v/repositories", PathEscape(org), PathEscape(name))
 	return s.setSelectedReposForVariable(ctx, url, ids)
 }
 
@@ -317,7 +318,7 @@ func (s *ActionsService) addSelectedRepoToVariable(ctx context.Context, url stri
 //
 //meta:operation PUT /orgs/{org}/actions/variables/{name}/repositories/{repository_id}
 func (s *ActionsService) AddSelectedRepoToOrgVariable(ctx context.Context, org, name string, repo *Repository) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/variables/%v/repositories/%v", org, name, *repo.ID)
+	url := fmt.Sprintf("orgs/%v/actions/variables/%v/repositories/%v", PathEscape(org), PathEscape(name), *repo.ID)
 	return s.addSelectedRepoToVariable(ctx, url)
 }
 
@@ -336,6 +337,6 @@ func (s *ActionsService) removeSelectedRepoFromVariable(ctx context.Context, url
 //
 //meta:operation DELETE /orgs/{org}/actions/variables/{name}/repositories/{repository_id}
 func (s *ActionsService) RemoveSelectedRepoFromOrgVariable(ctx context.Context, org, name string, repo *Repository) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/actions/variables/%v/repositories/%v", org, name, *repo.ID)
+	url := fmt.Sprintf("orgs/%v/actions/variables/%v/repositories/%v", PathEscape(org), PathEscape(name), *repo.ID)
 	return s.removeSelectedRepoFromVariable(ctx, url)
 }
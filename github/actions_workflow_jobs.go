@@ -74,7 +74,7 @@ type ListWorkflowJobsOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/jobs
 func (s *ActionsService) ListWorkflowJobs(ctx context.Context, owner, repo string, runID int64, opts *ListWorkflowJobsOptions) (*Jobs, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/actions/runs/%v/jobs", owner, repo, runID)
+	u := fmt.Sprintf("repos/%s/%s/actions/runs/%v/jobs", PathEscape(owner), PathEscape(repo), runID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -100,7 +100,7 @@ func (s *ActionsService) ListWorkflowJobs(ctx context.Context, owner, repo strin
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/jobs
 func (s *ActionsService) ListWorkflowJobsAttempt(ctx context.Context, owner, repo string, runID, attemptNumber int64, opts *ListOptions) (*Jobs, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/actions/runs/%v/attempts/%v/jobs", owner, repo, runID, attemptNumber)
+	u := fmt.Sprintf("repos/%s/%s/actions/runs/%v/attempts/%v/jobs", PathEscape(owner), PathEscape(repo), runID, attemptNumber)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -126,7 +126,7 @@ func (s *ActionsService) ListWorkflowJobsAttempt(ctx context.Context, owner, rep
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/jobs/{job_id}
 func (s *ActionsService) GetWorkflowJobByID(ctx context.Context, owner, repo string, jobID int64) (*WorkflowJob, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/jobs/%v", owner, repo, jobID)
+	u := fmt.Sprintf("repos/%v/%v/actions/jobs/%v", PathEscape(owner), PathEscape(repo), jobID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -148,7 +148,7 @@ func (s *ActionsService) GetWorkflowJobByID(ctx context.Context, owner, repo str
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/jobs/{job_id}/logs
 func (s *ActionsService) GetWorkflowJobLogs(ctx context.Context, owner, repo string, jobID int64, maxRedirects int) (*url.URL, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/jobs/%v/logs", owner, repo, jobID)
+	u := fmt.Sprintf("repos/%v/%v/actions/jobs/%v/logs", PathEscape(owner), PathEscape(repo), jobID)
 
 	resp, err := s.client.roundTripWithOptionalFollowRedirect(ctx, u, maxRedirects)
 	if err != nil {
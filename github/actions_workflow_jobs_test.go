@@ -182,6 +182,68 @@ func TestActionsService_GetWorkflowJobByID(t *testing.T) {
 	})
 }
 
+func TestActionsService_GetWorkflowJobByID_stepsAndRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/jobs/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"status": "completed",
+			"conclusion": "success",
+			"created_at": "2024-01-02T15:00:00Z",
+			"started_at": "2024-01-02T15:01:00Z",
+			"completed_at": "2024-01-02T15:05:00Z",
+			"labels": ["self-hosted", "linux", "x64"],
+			"runner_id": 7,
+			"runner_name": "pool-a-1",
+			"runner_group_id": 2,
+			"runner_group_name": "pool-a",
+			"steps": [
+				{"name": "Set up job", "status": "completed", "conclusion": "success", "number": 1, "started_at": "2024-01-02T15:01:00Z", "completed_at": "2024-01-02T15:01:10Z"},
+				{"name": "Checkout", "status": "completed", "conclusion": "success", "number": 2, "started_at": "2024-01-02T15:01:10Z", "completed_at": "2024-01-02T15:01:20Z"},
+				{"name": "Deploy", "status": "completed", "conclusion": "skipped", "number": 3, "started_at": null, "completed_at": null},
+				{"name": "Test", "status": "completed", "conclusion": "success", "number": 4, "started_at": "2024-01-02T15:01:20Z", "completed_at": "2024-01-02T15:04:50Z"},
+				{"name": "Complete job", "status": "completed", "conclusion": "success", "number": 5, "started_at": "2024-01-02T15:04:50Z", "completed_at": "2024-01-02T15:05:00Z"}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	job, _, err := client.Actions.GetWorkflowJobByID(ctx, "o", "r", 1)
+	if err != nil {
+		t.Fatalf("Actions.GetWorkflowJobByID returned error: %v", err)
+	}
+
+	at := func(min, sec int) *Timestamp {
+		return &Timestamp{time.Date(2024, time.January, 2, 15, min, sec, 0, time.UTC)}
+	}
+	want := &WorkflowJob{
+		ID:              Int64(1),
+		Status:          String("completed"),
+		Conclusion:      String("success"),
+		CreatedAt:       at(0, 0),
+		StartedAt:       at(1, 0),
+		CompletedAt:     at(5, 0),
+		Labels:          []string{"self-hosted", "linux", "x64"},
+		RunnerID:        Int64(7),
+		RunnerName:      String("pool-a-1"),
+		RunnerGroupID:   Int64(2),
+		RunnerGroupName: String("pool-a"),
+		Steps: []*TaskStep{
+			{Name: String("Set up job"), Status: String("completed"), Conclusion: String("success"), Number: Int64(1), StartedAt: at(1, 0), CompletedAt: at(1, 10)},
+			{Name: String("Checkout"), Status: String("completed"), Conclusion: String("success"), Number: Int64(2), StartedAt: at(1, 10), CompletedAt: at(1, 20)},
+			{Name: String("Deploy"), Status: String("completed"), Conclusion: String("skipped"), Number: Int64(3)},
+			{Name: String("Test"), Status: String("completed"), Conclusion: String("success"), Number: Int64(4), StartedAt: at(1, 20), CompletedAt: at(4, 50)},
+			{Name: String("Complete job"), Status: String("completed"), Conclusion: String("success"), Number: Int64(5), StartedAt: at(4, 50), CompletedAt: at(5, 0)},
+		},
+	}
+	if !cmp.Equal(job, want) {
+		t.Errorf("Actions.GetWorkflowJobByID returned %+v, want %+v", job, want)
+	}
+}
+
 func TestActionsService_GetWorkflowJobLogs(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -7,9 +7,12 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strings"
 )
 
 // WorkflowRun represents a repository action workflow run.
@@ -56,12 +59,35 @@ type WorkflowRuns struct {
 	WorkflowRuns []*WorkflowRun `json:"workflow_runs,omitempty"`
 }
 
+// The statuses and conclusions that workflow runs can be filtered by, with
+// ListWorkflowRunsOptions.Status.
+const (
+	WorkflowRunStatusActionRequired = "action_required"
+	WorkflowRunStatusCancelled      = "cancelled"
+	WorkflowRunStatusCompleted      = "completed"
+	WorkflowRunStatusFailure        = "failure"
+	WorkflowRunStatusInProgress     = "in_progress"
+	WorkflowRunStatusNeutral        = "neutral"
+	WorkflowRunStatusPending        = "pending"
+	WorkflowRunStatusQueued         = "queued"
+	WorkflowRunStatusRequested      = "requested"
+	WorkflowRunStatusSkipped        = "skipped"
+	WorkflowRunStatusStale          = "stale"
+	WorkflowRunStatusSuccess        = "success"
+	WorkflowRunStatusTimedOut       = "timed_out"
+	WorkflowRunStatusWaiting        = "waiting"
+)
+
 // ListWorkflowRunsOptions specifies optional parameters to ListWorkflowRuns.
 type ListWorkflowRunsOptions struct {
-	Actor               string `url:"actor,omitempty"`
-	Branch              string `url:"branch,omitempty"`
-	Event               string `url:"event,omitempty"`
-	Status              string `url:"status,omitempty"`
+	Actor  string `url:"actor,omitempty"`
+	Branch string `url:"branch,omitempty"`
+	Event  string `url:"event,omitempty"`
+	// Status is one of the WorkflowRunStatus constants.
+	Status string `url:"status,omitempty"`
+	// Created limits the runs to those created in a date range, using the
+	// search syntax for dates such as ">=2024-01-01" or
+	// "2024-01-01..2024-01-31". See ValidateCreated.
 	Created             string `url:"created,omitempty"`
 	HeadSHA             string `url:"head_sha,omitempty"`
 	ExcludePullRequests bool   `url:"exclude_pull_requests,omitempty"`
@@ -69,6 +95,50 @@ type ListWorkflowRunsOptions struct {
 	ListOptions
 }
 
+// ErrInvalidCreatedRange is wrapped by the error returned by
+// ListWorkflowRunsOptions.ValidateCreated for malformed date ranges.
+var ErrInvalidCreatedRange = errors.New("invalid created date range")
+
+// searchDateRE matches a date or date-time of the search syntax, such as
+// "2024-01-01" or "2024-01-01T10:00:00+02:00".
+var searchDateRE = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(T\d{2}:\d{2}(:\d{2})?(Z|[+-]\d{2}:\d{2})?)?$`)
+
+// ValidateCreated loosely checks that Created is empty or follows the search
+// syntax for dates: a date optionally prefixed with >, >=, < or <=, or a
+// range of two dates separated by "..", either of which may be "*". It only
+// catches obviously malformed values, which GitHub would otherwise silently
+// ignore or reject; the list methods do not call it, so callers can decide
+// whether to treat its error as fatal.
+func (o *ListWorkflowRunsOptions) ValidateCreated() error {
+	if o == nil || o.Created == "" {
+		return nil
+	}
+
+	created := o.Created
+	if before, after, ok := strings.Cut(created, ".."); ok {
+		if before == "*" && after == "*" {
+			return fmt.Errorf("%w %q: at least one end of the range must be a date", ErrInvalidCreatedRange, created)
+		}
+		for _, d := range []string{before, after} {
+			if d != "*" && !searchDateRE.MatchString(d) {
+				return fmt.Errorf("%w %q: %q is not a date", ErrInvalidCreatedRange, created, d)
+			}
+		}
+		return nil
+	}
+
+	for _, op := range []string{">=", "<=", ">", "<"} {
+		if strings.HasPrefix(created, op) {
+			created = strings.TrimPrefix(created, op)
+			break
+		}
+	}
+	if !searchDateRE.MatchString(created) {
+		return fmt.Errorf("%w %q: %q is not a date", ErrInvalidCreatedRange, o.Created, created)
+	}
+	return nil
+}
+
 // WorkflowRunUsage represents a usage of a specific workflow run.
 type WorkflowRunUsage struct {
 	Billable      *WorkflowRunBillMap `json:"billable,omitempty"`
@@ -97,6 +167,13 @@ type WorkflowRunAttemptOptions struct {
 	ExcludePullRequests *bool `url:"exclude_pull_requests,omitempty"`
 }
 
+// RerunOptions specifies optional body parameters to RerunWorkflowByID,
+// RerunFailedJobsByID and RerunJobByID.
+type RerunOptions struct {
+	// EnableDebugLogging enables debug logging for the re-run.
+	EnableDebugLogging bool `json:"enable_debug_logging,omitempty"`
+}
+
 // PendingDeploymentsRequest specifies body parameters to PendingDeployments.
 type PendingDeploymentsRequest struct {
 	EnvironmentIDs []int64 `json:"environment_ids"`
@@ -105,6 +182,14 @@ type PendingDeploymentsRequest struct {
 	Comment string `json:"comment"`
 }
 
+// ReviewCustomDeploymentProtectionRuleRequest specifies body parameters to ReviewCustomDeploymentProtectionRule.
+type ReviewCustomDeploymentProtectionRuleRequest struct {
+	EnvironmentName string `json:"environment_name"`
+	// State can be one of: "approved", "rejected".
+	State   string `json:"state"`
+	Comment string `json:"comment"`
+}
+
 type ReferencedWorkflow struct {
 	Path *string `json:"path,omitempty"`
 	SHA  *string `json:"sha,omitempty"`
@@ -137,7 +222,7 @@ func (s *ActionsService) listWorkflowRuns(ctx context.Context, endpoint string,
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs
 func (s *ActionsService) ListWorkflowRunsByID(ctx context.Context, owner, repo string, workflowID int64, opts *ListWorkflowRunsOptions) (*WorkflowRuns, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/actions/workflows/%v/runs", owner, repo, workflowID)
+	u := fmt.Sprintf("repos/%s/%s/actions/workflows/%v/runs", PathEscape(owner), PathEscape(repo), workflowID)
 	return s.listWorkflowRuns(ctx, u, opts)
 }
 
@@ -147,7 +232,7 @@ func (s *ActionsService) ListWorkflowRunsByID(ctx context.Context, owner, repo s
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs
 func (s *ActionsService) ListWorkflowRunsByFileName(ctx context.Context, owner, repo, workflowFileName string, opts *ListWorkflowRunsOptions) (*WorkflowRuns, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/actions/workflows/%v/runs", owner, repo, workflowFileName)
+	u := fmt.Sprintf("repos/%s/%s/actions/workflows/%v/runs", PathEscape(owner), PathEscape(repo), PathEscape(workflowFileName))
 	return s.listWorkflowRuns(ctx, u, opts)
 }
 
@@ -157,7 +242,7 @@ func (s *ActionsService) ListWorkflowRunsByFileName(ctx context.Context, owner,
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runs
 func (s *ActionsService) ListRepositoryWorkflowRuns(ctx context.Context, owner, repo string, opts *ListWorkflowRunsOptions) (*WorkflowRuns, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/actions/runs", owner, repo)
+	u := fmt.Sprintf("repos/%s/%s/actions/runs", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -183,7 +268,7 @@ func (s *ActionsService) ListRepositoryWorkflowRuns(ctx context.Context, owner,
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}
 func (s *ActionsService) GetWorkflowRunByID(ctx context.Context, owner, repo string, runID int64) (*WorkflowRun, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v", owner, repo, runID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v", PathEscape(owner), PathEscape(repo), runID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -205,7 +290,7 @@ func (s *ActionsService) GetWorkflowRunByID(ctx context.Context, owner, repo str
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}
 func (s *ActionsService) GetWorkflowRunAttempt(ctx context.Context, owner, repo string, runID int64, attemptNumber int, opts *WorkflowRunAttemptOptions) (*WorkflowRun, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/attempts/%v", owner, repo, runID, attemptNumber)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/attempts/%v", PathEscape(owner), PathEscape(repo), runID, attemptNumber)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -231,7 +316,7 @@ func (s *ActionsService) GetWorkflowRunAttempt(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/logs
 func (s *ActionsService) GetWorkflowRunAttemptLogs(ctx context.Context, owner, repo string, runID int64, attemptNumber int, maxRedirects int) (*url.URL, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/attempts/%v/logs", owner, repo, runID, attemptNumber)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/attempts/%v/logs", PathEscape(owner), PathEscape(repo), runID, attemptNumber)
 
 	resp, err := s.client.roundTripWithOptionalFollowRedirect(ctx, u, maxRedirects)
 	if err != nil {
@@ -252,10 +337,14 @@ func (s *ActionsService) GetWorkflowRunAttemptLogs(ctx context.Context, owner, r
 // GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#re-run-a-workflow
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun
-func (s *ActionsService) RerunWorkflowByID(ctx context.Context, owner, repo string, runID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/rerun", owner, repo, runID)
+func (s *ActionsService) RerunWorkflowByID(ctx context.Context, owner, repo string, runID int64, opts *RerunOptions) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/rerun", PathEscape(owner), PathEscape(repo), runID)
 
-	req, err := s.client.NewRequest("POST", u, nil)
+	var body interface{}
+	if opts != nil {
+		body = opts
+	}
+	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, err
 	}
@@ -268,10 +357,14 @@ func (s *ActionsService) RerunWorkflowByID(ctx context.Context, owner, repo stri
 // GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#re-run-failed-jobs-from-a-workflow-run
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs
-func (s *ActionsService) RerunFailedJobsByID(ctx context.Context, owner, repo string, runID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/rerun-failed-jobs", owner, repo, runID)
+func (s *ActionsService) RerunFailedJobsByID(ctx context.Context, owner, repo string, runID int64, opts *RerunOptions) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/rerun-failed-jobs", PathEscape(owner), PathEscape(repo), runID)
 
-	req, err := s.client.NewRequest("POST", u, nil)
+	var body interface{}
+	if opts != nil {
+		body = opts
+	}
+	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, err
 	}
@@ -284,10 +377,14 @@ func (s *ActionsService) RerunFailedJobsByID(ctx context.Context, owner, repo st
 // GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#re-run-a-job-from-a-workflow-run
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/jobs/{job_id}/rerun
-func (s *ActionsService) RerunJobByID(ctx context.Context, owner, repo string, jobID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/jobs/%v/rerun", owner, repo, jobID)
+func (s *ActionsService) RerunJobByID(ctx context.Context, owner, repo string, jobID int64, opts *RerunOptions) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/jobs/%v/rerun", PathEscape(owner), PathEscape(repo), jobID)
 
-	req, err := s.client.NewRequest("POST", u, nil)
+	var body interface{}
+	if opts != nil {
+		body = opts
+	}
+	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +398,7 @@ func (s *ActionsService) RerunJobByID(ctx context.Context, owner, repo string, j
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/runs/{run_id}/cancel
 func (s *ActionsService) CancelWorkflowRunByID(ctx context.Context, owner, repo string, runID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/cancel", owner, repo, runID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/cancel", PathEscape(owner), PathEscape(repo), runID)
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
@@ -317,7 +414,7 @@ func (s *ActionsService) CancelWorkflowRunByID(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/logs
 func (s *ActionsService) GetWorkflowRunLogs(ctx context.Context, owner, repo string, runID int64, maxRedirects int) (*url.URL, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/logs", owner, repo, runID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/logs", PathEscape(owner), PathEscape(repo), runID)
 
 	resp, err := s.client.roundTripWithOptionalFollowRedirect(ctx, u, maxRedirects)
 	if err != nil {
@@ -339,7 +436,7 @@ func (s *ActionsService) GetWorkflowRunLogs(ctx context.Context, owner, repo str
 //
 //meta:operation DELETE /repos/{owner}/{repo}/actions/runs/{run_id}
 func (s *ActionsService) DeleteWorkflowRun(ctx context.Context, owner, repo string, runID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v", owner, repo, runID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v", PathEscape(owner), PathEscape(repo), runID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -355,7 +452,7 @@ func (s *ActionsService) DeleteWorkflowRun(ctx context.Context, owner, repo stri
 //
 //meta:operation DELETE /repos/{owner}/{repo}/actions/runs/{run_id}/logs
 func (s *ActionsService) DeleteWorkflowRunLogs(ctx context.Context, owner, repo string, runID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/logs", owner, repo, runID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/logs", PathEscape(owner), PathEscape(repo), runID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -371,7 +468,7 @@ func (s *ActionsService) DeleteWorkflowRunLogs(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/timing
 func (s *ActionsService) GetWorkflowRunUsageByID(ctx context.Context, owner, repo string, runID int64) (*WorkflowRunUsage, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/timing", owner, repo, runID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/timing", PathEscape(owner), PathEscape(repo), runID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -393,7 +490,7 @@ func (s *ActionsService) GetWorkflowRunUsageByID(ctx context.Context, owner, rep
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/runs/{run_id}/pending_deployments
 func (s *ActionsService) PendingDeployments(ctx context.Context, owner, repo string, runID int64, request *PendingDeploymentsRequest) ([]*Deployment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/pending_deployments", owner, repo, runID)
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/pending_deployments", PathEscape(owner), PathEscape(repo), runID)
 
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
@@ -408,3 +505,21 @@ func (s *ActionsService) PendingDeployments(ctx context.Context, owner, repo str
 
 	return deployments, resp, nil
 }
+
+// ReviewCustomDeploymentProtectionRule approves or rejects custom deployment protection rules provided by a GitHub App for a workflow run.
+// It is meant to be called by the App in response to a DeploymentProtectionRuleEvent,
+// whose DeploymentCallbackURL points to this endpoint.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#review-custom-deployment-protection-rules-for-a-workflow-run
+//
+//meta:operation POST /repos/{owner}/{repo}/actions/runs/{run_id}/deployment_protection_rule
+func (s *ActionsService) ReviewCustomDeploymentProtectionRule(ctx context.Context, owner, repo string, runID int64, request *ReviewCustomDeploymentProtectionRuleRequest) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/deployment_protection_rule", PathEscape(owner), PathEscape(repo), runID)
+
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
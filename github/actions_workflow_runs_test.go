@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -277,11 +278,12 @@ func TestActionsService_RerunWorkflowRunByID(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/actions/runs/3434/rerun", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
+		testBody(t, r, "")
 		w.WriteHeader(http.StatusCreated)
 	})
 
 	ctx := context.Background()
-	resp, err := client.Actions.RerunWorkflowByID(ctx, "o", "r", 3434)
+	resp, err := client.Actions.RerunWorkflowByID(ctx, "o", "r", 3434, nil)
 	if err != nil {
 		t.Errorf("Actions.RerunWorkflowByID returned error: %v", err)
 	}
@@ -291,12 +293,12 @@ func TestActionsService_RerunWorkflowRunByID(t *testing.T) {
 
 	const methodName = "RerunWorkflowByID"
 	testBadOptions(t, methodName, func() (err error) {
-		_, err = client.Actions.RerunWorkflowByID(ctx, "\n", "\n", 3434)
+		_, err = client.Actions.RerunWorkflowByID(ctx, "\n", "\n", 3434, nil)
 		return err
 	})
 
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		return client.Actions.RerunWorkflowByID(ctx, "o", "r", 3434)
+		return client.Actions.RerunWorkflowByID(ctx, "o", "r", 3434, nil)
 	})
 }
 
@@ -306,11 +308,12 @@ func TestActionsService_RerunFailedJobsByID(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/actions/runs/3434/rerun-failed-jobs", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
+		testBody(t, r, "")
 		w.WriteHeader(http.StatusCreated)
 	})
 
 	ctx := context.Background()
-	resp, err := client.Actions.RerunFailedJobsByID(ctx, "o", "r", 3434)
+	resp, err := client.Actions.RerunFailedJobsByID(ctx, "o", "r", 3434, nil)
 	if err != nil {
 		t.Errorf("Actions.RerunFailedJobsByID returned error: %v", err)
 	}
@@ -320,26 +323,44 @@ func TestActionsService_RerunFailedJobsByID(t *testing.T) {
 
 	const methodName = "RerunFailedJobsByID"
 	testBadOptions(t, methodName, func() (err error) {
-		_, err = client.Actions.RerunFailedJobsByID(ctx, "\n", "\n", 3434)
+		_, err = client.Actions.RerunFailedJobsByID(ctx, "\n", "\n", 3434, nil)
 		return err
 	})
 
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		return client.Actions.RerunFailedJobsByID(ctx, "o", "r", 3434)
+		return client.Actions.RerunFailedJobsByID(ctx, "o", "r", 3434, nil)
 	})
 }
 
+func TestActionsService_RerunFailedJobsByID_debugLogging(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/3434/rerun-failed-jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"enable_debug_logging":true}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.RerunFailedJobsByID(ctx, "o", "r", 3434, &RerunOptions{EnableDebugLogging: true})
+	if err != nil {
+		t.Errorf("Actions.RerunFailedJobsByID returned error: %v", err)
+	}
+}
+
 func TestActionsService_RerunJobByID(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
 	mux.HandleFunc("/repos/o/r/actions/jobs/3434/rerun", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
+		testBody(t, r, "")
 		w.WriteHeader(http.StatusCreated)
 	})
 
 	ctx := context.Background()
-	resp, err := client.Actions.RerunJobByID(ctx, "o", "r", 3434)
+	resp, err := client.Actions.RerunJobByID(ctx, "o", "r", 3434, nil)
 	if err != nil {
 		t.Errorf("Actions.RerunJobByID returned error: %v", err)
 	}
@@ -349,12 +370,12 @@ func TestActionsService_RerunJobByID(t *testing.T) {
 
 	const methodName = "RerunJobByID"
 	testBadOptions(t, methodName, func() (err error) {
-		_, err = client.Actions.RerunJobByID(ctx, "\n", "\n", 3434)
+		_, err = client.Actions.RerunJobByID(ctx, "\n", "\n", 3434, nil)
 		return err
 	})
 
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		return client.Actions.RerunJobByID(ctx, "o", "r", 3434)
+		return client.Actions.RerunJobByID(ctx, "o", "r", 3434, nil)
 	})
 }
 
@@ -519,6 +540,94 @@ func TestActionService_ListRepositoryWorkflowRuns(t *testing.T) {
 	})
 }
 
+func TestActionService_ListRepositoryWorkflowRuns_filters(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"actor":                 "octocat",
+			"branch":                "main",
+			"event":                 "push",
+			"status":                "waiting",
+			"created":               "2024-01-01..2024-01-31",
+			"head_sha":              "abc123",
+			"exclude_pull_requests": "true",
+			"check_suite_id":        "42",
+			"per_page":              "100",
+		})
+		fmt.Fprint(w, `{"total_count":1,"workflow_runs":[{"id":1,"status":"waiting"}]}`)
+	})
+
+	opts := &ListWorkflowRunsOptions{
+		Actor:               "octocat",
+		Branch:              "main",
+		Event:               "push",
+		Status:              WorkflowRunStatusWaiting,
+		Created:             "2024-01-01..2024-01-31",
+		HeadSHA:             "abc123",
+		ExcludePullRequests: true,
+		CheckSuiteID:        42,
+		ListOptions:         ListOptions{PerPage: 100},
+	}
+	if err := opts.ValidateCreated(); err != nil {
+		t.Fatalf("ValidateCreated returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	runs, _, err := client.Actions.ListRepositoryWorkflowRuns(ctx, "o", "r", opts)
+	if err != nil {
+		t.Fatalf("Actions.ListRepositoryWorkflowRuns returned error: %v", err)
+	}
+
+	want := &WorkflowRuns{TotalCount: Int(1), WorkflowRuns: []*WorkflowRun{{ID: Int64(1), Status: String(WorkflowRunStatusWaiting)}}}
+	if !cmp.Equal(runs, want) {
+		t.Errorf("Actions.ListRepositoryWorkflowRuns returned %+v, want %+v", runs, want)
+	}
+}
+
+func TestListWorkflowRunsOptions_ValidateCreated(t *testing.T) {
+	valid := []string{
+		"",
+		"2024-01-01",
+		">2024-01-01",
+		">=2024-01-01T10:00:00Z",
+		"<2024-01-01T10:00",
+		"<=2024-01-01T10:00:00+02:00",
+		"2024-01-01..2024-01-31",
+		"2024-01-01..*",
+		"*..2024-01-31",
+	}
+	for _, created := range valid {
+		opts := &ListWorkflowRunsOptions{Created: created}
+		if err := opts.ValidateCreated(); err != nil {
+			t.Errorf("ValidateCreated(%q) returned error: %v", created, err)
+		}
+	}
+
+	invalid := []string{
+		"yesterday",
+		"2024/01/01",
+		"=>2024-01-01",
+		"2024-01-01...2024-01-31",
+		"2024-01-01..",
+		"*..*",
+		"24-1-1",
+	}
+	for _, created := range invalid {
+		opts := &ListWorkflowRunsOptions{Created: created}
+		if err := opts.ValidateCreated(); !errors.Is(err, ErrInvalidCreatedRange) {
+			t.Errorf("ValidateCreated(%q) returned error %v, want ErrInvalidCreatedRange", created, err)
+		}
+	}
+
+	var nilOpts *ListWorkflowRunsOptions
+	if err := nilOpts.ValidateCreated(); err != nil {
+		t.Errorf("ValidateCreated on nil options returned error: %v", err)
+	}
+}
+
 func TestActionService_DeleteWorkflowRun(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1296,3 +1405,82 @@ func TestActionService_PendingDeployments(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestActionService_ReviewCustomDeploymentProtectionRule(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &ReviewCustomDeploymentProtectionRuleRequest{EnvironmentName: "production", State: "rejected", Comment: "tests failed"}
+
+	mux.HandleFunc("/repos/o/r/actions/runs/1/deployment_protection_rule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"environment_name":"production","state":"rejected","comment":"tests failed"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Actions.ReviewCustomDeploymentProtectionRule(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Actions.ReviewCustomDeploymentProtectionRule returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Actions.ReviewCustomDeploymentProtectionRule returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+
+	const methodName = "ReviewCustomDeploymentProtectionRule"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Actions.ReviewCustomDeploymentProtectionRule(ctx, "\n", "\n", 1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Actions.ReviewCustomDeploymentProtectionRule(ctx, "o", "r", 1, input)
+	})
+}
+
+func TestDeploymentProtectionRuleEvent_decode(t *testing.T) {
+	payload := `{
+		"action": "requested",
+		"environment": "production",
+		"event": "push",
+		"deployment_callback_url": "https://api.github.com/repos/o/r/actions/runs/1/deployment_protection_rule",
+		"deployment": {"id": 2, "ref": "main", "environment": "production"},
+		"repository": {"id": 3, "name": "r", "owner": {"login": "o"}},
+		"installation": {"id": 4}
+	}`
+
+	event, err := ParseWebHook("deployment_protection_rule", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+
+	want := &DeploymentProtectionRuleEvent{
+		Action:                String("requested"),
+		Environment:           String("production"),
+		Event:                 String("push"),
+		DeploymentCallbackURL: String("https://api.github.com/repos/o/r/actions/runs/1/deployment_protection_rule"),
+		Deployment:            &Deployment{ID: Int64(2), Ref: String("main"), Environment: String("production")},
+		Repo:                  &Repository{ID: Int64(3), Name: String("r"), Owner: &User{Login: String("o")}},
+		Installation:          &Installation{ID: Int64(4)},
+	}
+	if !cmp.Equal(event, want) {
+		t.Errorf("ParseWebHook returned %+v, want %+v", event, want)
+	}
+}
+
+func TestActionsService_RerunJobByID_debugLogging(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/jobs/3434/rerun", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"enable_debug_logging":true}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.RerunJobByID(ctx, "o", "r", 3434, &RerunOptions{EnableDebugLogging: true})
+	if err != nil {
+		t.Errorf("Actions.RerunJobByID returned error: %v", err)
+	}
+}
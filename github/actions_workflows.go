@@ -62,7 +62,7 @@ type CreateWorkflowDispatchEventRequest struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/workflows
 func (s *ActionsService) ListWorkflows(ctx context.Context, owner, repo string, opts *ListOptions) (*Workflows, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/actions/workflows", owner, repo)
+	u := fmt.Sprintf("repos/%s/%s/actions/workflows", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -88,7 +88,7 @@ func (s *ActionsService) ListWorkflows(ctx context.Context, owner, repo string,
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}
 func (s *ActionsService) GetWorkflowByID(ctx context.Context, owner, repo string, workflowID int64) (*Workflow, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v", owner, repo, workflowID)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v", PathEscape(owner), PathEscape(repo), workflowID)
 
 	return s.getWorkflow(ctx, u)
 }
@@ -99,7 +99,7 @@ func (s *ActionsService) GetWorkflowByID(ctx context.Context, owner, repo string
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}
 func (s *ActionsService) GetWorkflowByFileName(ctx context.Context, owner, repo, workflowFileName string) (*Workflow, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v", owner, repo, workflowFileName)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v", PathEscape(owner), PathEscape(repo), PathEscape(workflowFileName))
 
 	return s.getWorkflow(ctx, u)
 }
@@ -125,7 +125,7 @@ func (s *ActionsService) getWorkflow(ctx context.Context, url string) (*Workflow
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/timing
 func (s *ActionsService) GetWorkflowUsageByID(ctx context.Context, owner, repo string, workflowID int64) (*WorkflowUsage, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/timing", owner, repo, workflowID)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/timing", PathEscape(owner), PathEscape(repo), workflowID)
 
 	return s.getWorkflowUsage(ctx, u)
 }
@@ -136,7 +136,7 @@ func (s *ActionsService) GetWorkflowUsageByID(ctx context.Context, owner, repo s
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/timing
 func (s *ActionsService) GetWorkflowUsageByFileName(ctx context.Context, owner, repo, workflowFileName string) (*WorkflowUsage, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/timing", owner, repo, workflowFileName)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/timing", PathEscape(owner), PathEscape(repo), PathEscape(workflowFileName))
 
 	return s.getWorkflowUsage(ctx, u)
 }
@@ -162,7 +162,7 @@ func (s *ActionsService) getWorkflowUsage(ctx context.Context, url string) (*Wor
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches
 func (s *ActionsService) CreateWorkflowDispatchEventByID(ctx context.Context, owner, repo string, workflowID int64, event CreateWorkflowDispatchEventRequest) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/dispatches", owner, repo, workflowID)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/dispatches", PathEscape(owner), PathEscape(repo), workflowID)
 
 	return s.createWorkflowDispatchEvent(ctx, u, &event)
 }
@@ -173,7 +173,7 @@ func (s *ActionsService) CreateWorkflowDispatchEventByID(ctx context.Context, ow
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches
 func (s *ActionsService) CreateWorkflowDispatchEventByFileName(ctx context.Context, owner, repo, workflowFileName string, event CreateWorkflowDispatchEventRequest) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/dispatches", owner, repo, workflowFileName)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/dispatches", PathEscape(owner), PathEscape(repo), PathEscape(workflowFileName))
 
 	return s.createWorkflowDispatchEvent(ctx, u, &event)
 }
@@ -193,7 +193,7 @@ func (s *ActionsService) createWorkflowDispatchEvent(ctx context.Context, url st
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/workflows/{workflow_id}/enable
 func (s *ActionsService) EnableWorkflowByID(ctx context.Context, owner, repo string, workflowID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/enable", owner, repo, workflowID)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/enable", PathEscape(owner), PathEscape(repo), workflowID)
 	return s.doNewPutRequest(ctx, u)
 }
 
@@ -203,7 +203,7 @@ func (s *ActionsService) EnableWorkflowByID(ctx context.Context, owner, repo str
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/workflows/{workflow_id}/enable
 func (s *ActionsService) EnableWorkflowByFileName(ctx context.Context, owner, repo, workflowFileName string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/enable", owner, repo, workflowFileName)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/enable", PathEscape(owner), PathEscape(repo), PathEscape(workflowFileName))
 	return s.doNewPutRequest(ctx, u)
 }
 
@@ -213,7 +213,7 @@ func (s *ActionsService) EnableWorkflowByFileName(ctx context.Context, owner, re
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/workflows/{workflow_id}/disable
 func (s *ActionsService) DisableWorkflowByID(ctx context.Context, owner, repo string, workflowID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/disable", owner, repo, workflowID)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/disable", PathEscape(owner), PathEscape(repo), workflowID)
 	return s.doNewPutRequest(ctx, u)
 }
 
@@ -223,7 +223,7 @@ func (s *ActionsService) DisableWorkflowByID(ctx context.Context, owner, repo st
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/workflows/{workflow_id}/disable
 func (s *ActionsService) DisableWorkflowByFileName(ctx context.Context, owner, repo, workflowFileName string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/disable", owner, repo, workflowFileName)
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/disable", PathEscape(owner), PathEscape(repo), PathEscape(workflowFileName))
 	return s.doNewPutRequest(ctx, u)
 }
 
@@ -41,7 +41,7 @@ func (s *ActivityService) ListEvents(ctx context.Context, opts *ListOptions) ([]
 //
 //meta:operation GET /repos/{owner}/{repo}/events
 func (s *ActivityService) ListRepositoryEvents(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Event, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/events", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/events", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -67,7 +67,7 @@ func (s *ActivityService) ListRepositoryEvents(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/events
 func (s *ActivityService) ListIssueEventsForRepository(ctx context.Context, owner, repo string, opts *ListOptions) ([]*IssueEvent, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/events", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/issues/events", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -93,7 +93,7 @@ func (s *ActivityService) ListIssueEventsForRepository(ctx context.Context, owne
 //
 //meta:operation GET /networks/{owner}/{repo}/events
 func (s *ActivityService) ListEventsForRepoNetwork(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Event, *Response, error) {
-	u := fmt.Sprintf("networks/%v/%v/events", owner, repo)
+	u := fmt.Sprintf("networks/%v/%v/events", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -119,7 +119,7 @@ func (s *ActivityService) ListEventsForRepoNetwork(ctx context.Context, owner, r
 //
 //meta:operation GET /orgs/{org}/events
 func (s *ActivityService) ListEventsForOrganization(ctx context.Context, org string, opts *ListOptions) ([]*Event, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/events", org)
+	u := fmt.Sprintf("orgs/%v/events", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -150,9 +150,9 @@ func (s *ActivityService) ListEventsForOrganization(ctx context.Context, org str
 func (s *ActivityService) ListEventsPerformedByUser(ctx context.Context, user string, publicOnly bool, opts *ListOptions) ([]*Event, *Response, error) {
 	var u string
 	if publicOnly {
-		u = fmt.Sprintf("users/%v/events/public", user)
+		u = fmt.Sprintf("users/%v/events/public", PathEscape(user))
 	} else {
-		u = fmt.Sprintf("users/%v/events", user)
+		u = fmt.Sprintf("users/%v/events", PathEscape(user))
 	}
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -184,9 +184,9 @@ func (s *ActivityService) ListEventsPerformedByUser(ctx context.Context, user st
 func (s *ActivityService) ListEventsReceivedByUser(ctx context.Context, user string, publicOnly bool, opts *ListOptions) ([]*Event, *Response, error) {
 	var u string
 	if publicOnly {
-		u = fmt.Sprintf("users/%v/received_events/public", user)
+		u = fmt.Sprintf("users/%v/received_events/public", PathEscape(user))
 	} else {
-		u = fmt.Sprintf("users/%v/received_events", user)
+		u = fmt.Sprintf("users/%v/received_events", PathEscape(user))
 	}
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -214,7 +214,7 @@ func (s *ActivityService) ListEventsReceivedByUser(ctx context.Context, user str
 //
 //meta:operation GET /users/{username}/events/orgs/{org}
 func (s *ActivityService) ListUserEventsForOrganization(ctx context.Context, org, user string, opts *ListOptions) ([]*Event, *Response, error) {
-	u := fmt.Sprintf("users/%v/events/orgs/%v", user, org)
+	u := fmt.Sprintf("users/%v/events/orgs/%v", PathEscape(user), PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
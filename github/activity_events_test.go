@@ -95,6 +95,8 @@ func TestActivityService_ListRepositoryEvents_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Activity.ListRepositoryEvents(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Activity.ListRepositoryEvents(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActivityService_ListIssueEventsForRepository(t *testing.T) {
@@ -143,6 +145,8 @@ func TestActivityService_ListIssueEventsForRepository_invalidOwner(t *testing.T)
 	ctx := context.Background()
 	_, _, err := client.Activity.ListIssueEventsForRepository(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Activity.ListIssueEventsForRepository(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActivityService_ListEventsForRepoNetwork(t *testing.T) {
@@ -191,6 +195,8 @@ func TestActivityService_ListEventsForRepoNetwork_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Activity.ListEventsForRepoNetwork(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Activity.ListEventsForRepoNetwork(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActivityService_ListEventsForOrganization(t *testing.T) {
@@ -239,6 +245,8 @@ func TestActivityService_ListEventsForOrganization_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Activity.ListEventsForOrganization(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Activity.ListEventsForOrganization(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActivityService_ListEventsPerformedByUser_all(t *testing.T) {
@@ -308,6 +316,8 @@ func TestActivityService_ListEventsPerformedByUser_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Activity.ListEventsPerformedByUser(ctx, "%", false, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Activity.ListEventsPerformedByUser(ctx, "..", false, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActivityService_ListEventsReceivedByUser_all(t *testing.T) {
@@ -377,6 +387,8 @@ func TestActivityService_ListEventsReceivedByUser_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Activity.ListEventsReceivedByUser(ctx, "%", false, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Activity.ListEventsReceivedByUser(ctx, "..", false, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActivityService_ListUserEventsForOrganization(t *testing.T) {
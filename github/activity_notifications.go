@@ -80,7 +80,7 @@ func (s *ActivityService) ListNotifications(ctx context.Context, opts *Notificat
 //
 //meta:operation GET /repos/{owner}/{repo}/notifications
 func (s *ActivityService) ListRepositoryNotifications(ctx context.Context, owner, repo string, opts *NotificationListOptions) ([]*Notification, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/notifications", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/notifications", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -104,6 +104,40 @@ type markReadOptions struct {
 	LastReadAt Timestamp `json:"last_read_at,omitempty"`
 }
 
+// ListNotificationsIfModified lists notifications for the authenticated user
+// like ListNotifications, unless none changed since lastModified, typically
+// the Response.LastModified of a previous response. A zero lastModified sends
+// an unconditional request. If nothing changed, GitHub responds with 304 Not
+// Modified, which does not count against the rate limit and is returned as an
+// *ErrorResponse, as with WithIfModifiedSince. The X-Poll-Interval header of
+// the response gives the number of seconds to wait before polling again.
+//
+// A caching transport, such as github.com/gregjones/httpcache, makes requests
+// conditional by itself; use ListNotifications with such a transport.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#list-notifications-for-the-authenticated-user
+//
+//meta:operation GET /notifications
+func (s *ActivityService) ListNotificationsIfModified(ctx context.Context, opts *NotificationListOptions, lastModified time.Time) ([]*Notification, *Response, error) {
+	u, err := addOptions("notifications", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil, WithIfModifiedSince(lastModified))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var notifications []*Notification
+	resp, err := s.client.Do(ctx, req, &notifications)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return notifications, resp, nil
+}
+
 // MarkNotificationsRead marks all notifications up to lastRead as read.
 //
 // GitHub API docs: https://docs.github.com/rest/activity/notifications#mark-notifications-as-read
@@ -131,7 +165,7 @@ func (s *ActivityService) MarkRepositoryNotificationsRead(ctx context.Context, o
 	opts := &markReadOptions{
 		LastReadAt: lastRead,
 	}
-	u := fmt.Sprintf("repos/%v/%v/notifications", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/notifications", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, err
@@ -146,7 +180,7 @@ func (s *ActivityService) MarkRepositoryNotificationsRead(ctx context.Context, o
 //
 //meta:operation GET /notifications/threads/{thread_id}
 func (s *ActivityService) GetThread(ctx context.Context, id string) (*Notification, *Response, error) {
-	u := fmt.Sprintf("notifications/threads/%v", id)
+	u := fmt.Sprintf("notifications/threads/%v", PathEscape(id))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -168,7 +202,7 @@ func (s *ActivityService) GetThread(ctx context.Context, id string) (*Notificati
 //
 //meta:operation PATCH /notifications/threads/{thread_id}
 func (s *ActivityService) MarkThreadRead(ctx context.Context, id string) (*Response, error) {
-	u := fmt.Sprintf("notifications/threads/%v", id)
+	u := fmt.Sprintf("notifications/threads/%v", PathEscape(id))
 
 	req, err := s.client.NewRequest("PATCH", u, nil)
 	if err != nil {
@@ -178,6 +212,23 @@ func (s *ActivityService) MarkThreadRead(ctx context.Context, id string) (*Respo
 	return s.client.Do(ctx, req, nil)
 }
 
+// MarkThreadDone marks the specified thread as done, which removes it from
+// the authenticated user's inbox.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#mark-a-thread-as-done
+//
+//meta:operation DELETE /notifications/threads/{thread_id}
+func (s *ActivityService) MarkThreadDone(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("notifications/threads/%v", PathEscape(id))
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // GetThreadSubscription checks to see if the authenticated user is subscribed
 // to a thread.
 //
@@ -185,7 +236,7 @@ func (s *ActivityService) MarkThreadRead(ctx context.Context, id string) (*Respo
 //
 //meta:operation GET /notifications/threads/{thread_id}/subscription
 func (s *ActivityService) GetThreadSubscription(ctx context.Context, id string) (*Subscription, *Response, error) {
-	u := fmt.Sprintf("notifications/threads/%v/subscription", id)
+	u := fmt.Sprintf("notifications/threads/%v/subscription", PathEscape(id))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -201,16 +252,30 @@ func (s *ActivityService) GetThreadSubscription(ctx context.Context, id string)
 	return sub, resp, nil
 }
 
+// threadSubscriptionRequest is the subset of Subscription accepted by
+// SetThreadSubscription.
+type threadSubscriptionRequest struct {
+	Ignored *bool `json:"ignored,omitempty"`
+}
+
 // SetThreadSubscription sets the subscription for the specified thread for the
 // authenticated user.
 //
+// Only subscription.Ignored is used. Set it to true to mute all future
+// notifications of the thread, until its subscription is deleted, and to
+// false to subscribe to the thread.
+//
 // GitHub API docs: https://docs.github.com/rest/activity/notifications#set-a-thread-subscription
 //
 //meta:operation PUT /notifications/threads/{thread_id}/subscription
 func (s *ActivityService) SetThreadSubscription(ctx context.Context, id string, subscription *Subscription) (*Subscription, *Response, error) {
-	u := fmt.Sprintf("notifications/threads/%v/subscription", id)
+	u := fmt.Sprintf("notifications/threads/%v/subscription", PathEscape(id))
 
-	req, err := s.client.NewRequest("PUT", u, subscription)
+	body := &threadSubscriptionRequest{}
+	if subscription != nil {
+		body.Ignored = subscription.Ignored
+	}
+	req, err := s.client.NewRequest("PUT", u, body)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -231,7 +296,7 @@ func (s *ActivityService) SetThreadSubscription(ctx context.Context, id string,
 //
 //meta:operation DELETE /notifications/threads/{thread_id}/subscription
 func (s *ActivityService) DeleteThreadSubscription(ctx context.Context, id string) (*Response, error) {
-	u := fmt.Sprintf("notifications/threads/%v/subscription", id)
+	u := fmt.Sprintf("notifications/threads/%v/subscription", PathEscape(id))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
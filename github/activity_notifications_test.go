@@ -6,10 +6,14 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
 	"testing"
 	"time"
 
@@ -94,6 +98,149 @@ func TestActivityService_ListRepositoryNotifications(t *testing.T) {
 	})
 }
 
+func TestActivityService_ListNotificationsIfModified(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const lastModified = "Thu, 25 Oct 2012 15:16:27 GMT"
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"all": "true"})
+		w.Header().Set("X-Poll-Interval", "60")
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		fmt.Fprint(w, `[{"id":"1"}]`)
+	})
+
+	opt := &NotificationListOptions{All: true}
+	ctx := context.Background()
+	notifications, resp, err := client.Activity.ListNotificationsIfModified(ctx, opt, time.Time{})
+	if err != nil {
+		t.Fatalf("Activity.ListNotificationsIfModified returned error: %v", err)
+	}
+	want := []*Notification{{ID: String("1")}}
+	if !cmp.Equal(notifications, want) {
+		t.Errorf("Activity.ListNotificationsIfModified returned %+v, want %+v", notifications, want)
+	}
+
+	notifications, resp, err = client.Activity.ListNotificationsIfModified(ctx, opt, resp.LastModified)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Activity.ListNotificationsIfModified returned error %v, want an *ErrorResponse", err)
+	}
+	if notifications != nil {
+		t.Errorf("Activity.ListNotificationsIfModified returned %+v, want nil", notifications)
+	}
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Activity.ListNotificationsIfModified returned status %v, want %v", resp.StatusCode, http.StatusNotModified)
+	}
+	if got := resp.Header.Get("X-Poll-Interval"); got != "60" {
+		t.Errorf("Activity.ListNotificationsIfModified returned X-Poll-Interval %q, want 60", got)
+	}
+
+	const methodName = "ListNotificationsIfModified"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Activity.ListNotificationsIfModified(ctx, opt, time.Time{})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActivityService_ListNotificationsIfModified_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message":"Requires authentication"}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Activity.ListNotificationsIfModified(ctx, nil, time.Date(2012, time.October, 25, 15, 16, 27, 0, time.UTC)); err == nil {
+		t.Error("Activity.ListNotificationsIfModified returned no error")
+	}
+}
+
+// lastModifiedCache is a minimal caching transport that revalidates cached
+// responses with If-Modified-Since, like github.com/gregjones/httpcache.
+type lastModifiedCache struct {
+	mu           sync.Mutex
+	body         []byte
+	lastModified string
+	hits         int
+}
+
+func (c *lastModifiedCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	req = req.Clone(req.Context())
+	if c.lastModified != "" {
+		req.Header.Set("If-Modified-Since", c.lastModified)
+	}
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		c.hits++
+		resp.StatusCode = http.StatusOK
+		resp.Body = io.NopCloser(bytes.NewReader(c.body))
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	c.body = body
+	c.lastModified = resp.Header.Get("Last-Modified")
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func TestActivityService_ListNotifications_conditionalRequestCache(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	cache := &lastModifiedCache{}
+	client.client.Transport = cache
+
+	const lastModified = "Thu, 25 Oct 2012 15:16:27 GMT"
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		fmt.Fprint(w, `[{"id":"1","unread":true}]`)
+	})
+
+	ctx := context.Background()
+	want := []*Notification{{ID: String("1"), Unread: Bool(true)}}
+	for i := 0; i < 2; i++ {
+		notifications, _, err := client.Activity.ListNotifications(ctx, nil)
+		if err != nil {
+			t.Fatalf("Activity.ListNotifications returned error: %v", err)
+		}
+		if !cmp.Equal(notifications, want) {
+			t.Errorf("Activity.ListNotifications returned %+v, want %+v", notifications, want)
+		}
+	}
+	if cache.hits != 1 {
+		t.Errorf("cache served %v responses, want 1", cache.hits)
+	}
+}
+
 func TestActivityService_MarkNotificationsRead(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -208,6 +355,35 @@ func TestActivityService_MarkThreadRead(t *testing.T) {
 	})
 }
 
+func TestActivityService_MarkThreadDone(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/threads/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Activity.MarkThreadDone(ctx, "1")
+	if err != nil {
+		t.Errorf("Activity.MarkThreadDone returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Activity.MarkThreadDone returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+
+	const methodName = "MarkThreadDone"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Activity.MarkThreadDone(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Activity.MarkThreadDone(ctx, "1")
+	})
+}
+
 func TestActivityService_GetThreadSubscription(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -247,7 +423,7 @@ func TestActivityService_SetThreadSubscription(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &Subscription{Subscribed: Bool(true)}
+	input := &Subscription{Ignored: Bool(true)}
 
 	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
 		v := new(Subscription)
@@ -287,6 +463,33 @@ func TestActivityService_SetThreadSubscription(t *testing.T) {
 	})
 }
 
+func TestActivityService_SetThreadSubscription_onlyIgnored(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"ignored":false}`+"\n")
+		fmt.Fprint(w, `{"subscribed":true,"ignored":false}`)
+	})
+
+	input := &Subscription{
+		Subscribed: Bool(true),
+		Ignored:    Bool(false),
+		Reason:     String("r"),
+		ThreadURL:  String("u"),
+	}
+	ctx := context.Background()
+	sub, _, err := client.Activity.SetThreadSubscription(ctx, "1", input)
+	if err != nil {
+		t.Errorf("Activity.SetThreadSubscription returned error: %v", err)
+	}
+	want := &Subscription{Subscribed: Bool(true), Ignored: Bool(false)}
+	if !cmp.Equal(sub, want) {
+		t.Errorf("Activity.SetThreadSubscription returned %+v, want %+v", sub, want)
+	}
+}
+
 func TestActivityService_DeleteThreadSubscription(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
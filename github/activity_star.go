@@ -23,13 +23,24 @@ type Stargazer struct {
 	User      *User      `json:"user,omitempty"`
 }
 
+// ListStargazersOptions specifies the optional parameters to the
+// ActivityService.ListStargazers method.
+type ListStargazersOptions struct {
+	// WithTimestamps requests the star media type, so that StarredAt is set
+	// on the returned stargazers. It defaults to true; if it is false, only
+	// User is set.
+	WithTimestamps *bool `url:"-"`
+
+	ListOptions
+}
+
 // ListStargazers lists people who have starred the specified repo.
 //
 // GitHub API docs: https://docs.github.com/rest/activity/starring#list-stargazers
 //
 //meta:operation GET /repos/{owner}/{repo}/stargazers
-func (s *ActivityService) ListStargazers(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Stargazer, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/stargazers", owner, repo)
+func (s *ActivityService) ListStargazers(ctx context.Context, owner, repo string, opts *ListStargazersOptions) ([]*Stargazer, *Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/stargazers", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -40,18 +51,67 @@ func (s *ActivityService) ListStargazers(ctx context.Context, owner, repo string
 		return nil, nil, err
 	}
 
-	// TODO: remove custom Accept header when this API fully launches
-	req.Header.Set("Accept", mediaTypeStarringPreview)
+	if opts == nil || opts.WithTimestamps == nil || *opts.WithTimestamps {
+		// TODO: remove custom Accept header when this API fully launches
+		req.Header.Set("Accept", mediaTypeStarringPreview)
+
+		var stargazers []*Stargazer
+		resp, err := s.client.Do(ctx, req, &stargazers)
+		if err != nil {
+			return nil, resp, err
+		}
 
-	var stargazers []*Stargazer
-	resp, err := s.client.Do(ctx, req, &stargazers)
+		return stargazers, resp, nil
+	}
+
+	var users []*User
+	resp, err := s.client.Do(ctx, req, &users)
 	if err != nil {
 		return nil, resp, err
 	}
 
+	stargazers := make([]*Stargazer, 0, len(users))
+	for _, user := range users {
+		stargazers = append(stargazers, &Stargazer{User: user})
+	}
 	return stargazers, resp, nil
 }
 
+// ExportStargazers calls fn for each person who has starred the specified
+// repo, in the order they starred it, fetching as many pages as needed. It
+// pauses whenever it is rate limited, until ctx is done. If fn returns an
+// error, ExportStargazers stops and returns that error. StarredAt is set on
+// every stargazer.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/starring#list-stargazers
+//
+//meta:operation GET /repos/{owner}/{repo}/stargazers
+func (s *ActivityService) ExportStargazers(ctx context.Context, owner, repo string, fn func(*Stargazer) error) error {
+	opts := &ListStargazersOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		stargazers, resp, err := s.ListStargazers(ctx, owner, repo, opts)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return werr
+		} else if retry {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, stargazer := range stargazers {
+			if err := fn(stargazer); err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // ActivityListStarredOptions specifies the optional parameters to the
 // ActivityService.ListStarred method.
 type ActivityListStarredOptions struct {
@@ -63,6 +123,11 @@ type ActivityListStarredOptions struct {
 	// Default is "asc" when sort is "full_name", otherwise default is "desc".
 	Direction string `url:"direction,omitempty"`
 
+	// WithTimestamps requests the star media type, so that StarredAt is set
+	// on the returned repositories. It defaults to true; if it is false, only
+	// Repository is set.
+	WithTimestamps *bool `url:"-"`
+
 	ListOptions
 }
 
@@ -77,7 +142,7 @@ type ActivityListStarredOptions struct {
 func (s *ActivityService) ListStarred(ctx context.Context, user string, opts *ActivityListStarredOptions) ([]*StarredRepository, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/starred", user)
+		u = fmt.Sprintf("users/%v/starred", PathEscape(user))
 	} else {
 		u = "user/starred"
 	}
@@ -91,16 +156,33 @@ func (s *ActivityService) ListStarred(ctx context.Context, user string, opts *Ac
 		return nil, nil, err
 	}
 
-	// TODO: remove custom Accept header when APIs fully launch
-	acceptHeaders := []string{mediaTypeStarringPreview, mediaTypeTopicsPreview}
-	req.Header.Set("Accept", strings.Join(acceptHeaders, ", "))
+	if opts == nil || opts.WithTimestamps == nil || *opts.WithTimestamps {
+		// TODO: remove custom Accept header when APIs fully launch
+		acceptHeaders := []string{mediaTypeStarringPreview, mediaTypeTopicsPreview}
+		req.Header.Set("Accept", strings.Join(acceptHeaders, ", "))
+
+		var repos []*StarredRepository
+		resp, err := s.client.Do(ctx, req, &repos)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		return repos, resp, nil
+	}
+
+	// TODO: remove custom Accept header when this API fully launches
+	req.Header.Set("Accept", mediaTypeTopicsPreview)
 
-	var repos []*StarredRepository
-	resp, err := s.client.Do(ctx, req, &repos)
+	var repositories []*Repository
+	resp, err := s.client.Do(ctx, req, &repositories)
 	if err != nil {
 		return nil, resp, err
 	}
 
+	repos := make([]*StarredRepository, 0, len(repositories))
+	for _, repository := range repositories {
+		repos = append(repos, &StarredRepository{Repository: repository})
+	}
 	return repos, resp, nil
 }
 
@@ -110,7 +192,7 @@ func (s *ActivityService) ListStarred(ctx context.Context, user string, opts *Ac
 //
 //meta:operation GET /user/starred/{owner}/{repo}
 func (s *ActivityService) IsStarred(ctx context.Context, owner, repo string) (bool, *Response, error) {
-	u := fmt.Sprintf("user/starred/%v/%v", owner, repo)
+	u := fmt.Sprintf("user/starred/%v/%v", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return false, nil, err
@@ -121,13 +203,117 @@ func (s *ActivityService) IsStarred(ctx context.Context, owner, repo string) (bo
 	return starred, resp, err
 }
 
+// AreStarred checks which of repos, given by their full names such as
+// "google/go-github", are starred by the authenticated user. Names are
+// matched case-insensitively. It pauses whenever it is rate limited, until
+// ctx is done.
+//
+// AreStarred batches the checks by listing the repositories starred by the
+// authenticated user, 100 per request, unless checking the remaining repos
+// one by one takes fewer requests.
+//
+// When the client uses a caching transport, such as
+// github.com/gregjones/httpcache, repeated checks are conditional requests
+// that don't count against the rate limit if the answer hasn't changed.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/starring#check-if-a-repository-is-starred-by-the-authenticated-user
+// GitHub API docs: https://docs.github.com/rest/activity/starring#list-repositories-starred-by-the-authenticated-user
+//
+//meta:operation GET /user/starred
+//meta:operation GET /user/starred/{owner}/{repo}
+func (s *ActivityService) AreStarred(ctx context.Context, repos []string) (map[string]bool, error) {
+	starred := make(map[string]bool, len(repos))
+	pending := make(map[string][]string, len(repos)) // Requested names by lower-cased full name.
+	for _, fullName := range repos {
+		owner, repo, ok := strings.Cut(fullName, "/")
+		if !ok || owner == "" || repo == "" {
+			return nil, fmt.Errorf("invalid repository name %q, want owner/repo", fullName)
+		}
+		key := strings.ToLower(fullName)
+		pending[key] = append(pending[key], fullName)
+	}
+
+	for page := 1; len(pending) > 0; {
+		fullNames, resp, err := s.starredPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, fullName := range fullNames {
+			for _, name := range pending[fullName] {
+				starred[name] = true
+			}
+			delete(pending, fullName)
+		}
+
+		if resp.NextPage == 0 {
+			for _, names := range pending {
+				for _, name := range names {
+					starred[name] = false
+				}
+			}
+			break
+		}
+		if resp.LastPage-page >= len(pending) {
+			for _, names := range pending {
+				owner, repo, _ := strings.Cut(names[0], "/")
+				isStarred, err := s.isStarred(ctx, owner, repo)
+				if err != nil {
+					return nil, err
+				}
+				for _, name := range names {
+					starred[name] = isStarred
+				}
+			}
+			break
+		}
+		page = resp.NextPage
+	}
+	return starred, nil
+}
+
+// starredPage lists the lower-cased full names of the given page of the
+// repositories starred by the authenticated user, waiting out rate limits.
+func (s *ActivityService) starredPage(ctx context.Context, page int) ([]string, *Response, error) {
+	opts := &ActivityListStarredOptions{WithTimestamps: Bool(false), ListOptions: ListOptions{Page: page, PerPage: 100}}
+	for {
+		repos, resp, err := s.ListStarred(ctx, "", opts)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return nil, nil, werr
+		} else if retry {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		fullNames := make([]string, 0, len(repos))
+		for _, repo := range repos {
+			fullNames = append(fullNames, strings.ToLower(repo.GetRepository().GetFullName()))
+		}
+		return fullNames, resp, nil
+	}
+}
+
+// isStarred is IsStarred, waiting out rate limits.
+func (s *ActivityService) isStarred(ctx context.Context, owner, repo string) (bool, error) {
+	for {
+		isStarred, _, err := s.IsStarred(ctx, owner, repo)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return false, werr
+		} else if retry {
+			continue
+		}
+		return isStarred, err
+	}
+}
+
 // Star a repository as the authenticated user.
 //
 // GitHub API docs: https://docs.github.com/rest/activity/starring#star-a-repository-for-the-authenticated-user
 //
 //meta:operation PUT /user/starred/{owner}/{repo}
 func (s *ActivityService) Star(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("user/starred/%v/%v", owner, repo)
+	u := fmt.Sprintf("user/starred/%v/%v", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
@@ -142,7 +328,7 @@ func (s *ActivityService) Star(ctx context.Context, owner, repo string) (*Respon
 //
 //meta:operation DELETE /user/starred/{owner}/{repo}
 func (s *ActivityService) Unstar(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("user/starred/%v/%v", owner, repo)
+	u := fmt.Sprintf("user/starred/%v/%v", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -31,7 +32,7 @@ func TestActivityService_ListStargazers(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	stargazers, _, err := client.Activity.ListStargazers(ctx, "o", "r", &ListOptions{Page: 2})
+	stargazers, _, err := client.Activity.ListStargazers(ctx, "o", "r", &ListStargazersOptions{ListOptions: ListOptions{Page: 2}})
 	if err != nil {
 		t.Errorf("Activity.ListStargazers returned error: %v", err)
 	}
@@ -43,12 +44,12 @@ func TestActivityService_ListStargazers(t *testing.T) {
 
 	const methodName = "ListStargazers"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Activity.ListStargazers(ctx, "\n", "\n", &ListOptions{Page: 2})
+		_, _, err = client.Activity.ListStargazers(ctx, "\n", "\n", &ListStargazersOptions{ListOptions: ListOptions{Page: 2}})
 		return err
 	})
 
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		got, resp, err := client.Activity.ListStargazers(ctx, "o", "r", &ListOptions{Page: 2})
+		got, resp, err := client.Activity.ListStargazers(ctx, "o", "r", &ListStargazersOptions{ListOptions: ListOptions{Page: 2}})
 		if got != nil {
 			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
 		}
@@ -56,6 +57,118 @@ func TestActivityService_ListStargazers(t *testing.T) {
 	})
 }
 
+func TestActivityService_ListStargazers_withoutTimestamps(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3)
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListStargazersOptions{WithTimestamps: Bool(false)}
+	stargazers, _, err := client.Activity.ListStargazers(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Activity.ListStargazers returned error: %v", err)
+	}
+
+	want := []*Stargazer{{User: &User{ID: Int64(1)}}}
+	if !cmp.Equal(stargazers, want) {
+		t.Errorf("Activity.ListStargazers returned %+v, want %+v", stargazers, want)
+	}
+}
+
+func TestActivityService_ExportStargazers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeStarringPreview)
+		requests++
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repositories/1/stargazers?per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"starred_at":"2002-02-10T15:30:00Z","user":{"id":1}},{"starred_at":"2002-02-11T15:30:00Z","user":{"id":2}}]`)
+		case "2":
+			if requests == 2 {
+				w.Header().Set(headerRetryAfter, "0")
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit.","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`)
+				return
+			}
+			fmt.Fprint(w, `[{"starred_at":"2002-02-12T15:30:00Z","user":{"id":3}}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	var ids []int64
+	var starredAt []time.Time
+	err := client.Activity.ExportStargazers(ctx, "o", "r", func(stargazer *Stargazer) error {
+		ids = append(ids, stargazer.GetUser().GetID())
+		starredAt = append(starredAt, stargazer.GetStarredAt().Time)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Activity.ExportStargazers returned error: %v", err)
+	}
+	if want := []int64{1, 2, 3}; !cmp.Equal(ids, want) {
+		t.Errorf("Activity.ExportStargazers visited %v, want %v", ids, want)
+	}
+	wantStarredAt := []time.Time{
+		time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC),
+		time.Date(2002, time.February, 11, 15, 30, 0, 0, time.UTC),
+		time.Date(2002, time.February, 12, 15, 30, 0, 0, time.UTC),
+	}
+	if !cmp.Equal(starredAt, wantStarredAt) {
+		t.Errorf("Activity.ExportStargazers visited stars at %v, want %v", starredAt, wantStarredAt)
+	}
+	if requests != 3 {
+		t.Errorf("Activity.ExportStargazers made %v requests, want 3", requests)
+	}
+}
+
+func TestActivityService_ExportStargazers_stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://api.github.com/repositories/1/stargazers?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"user":{"id":1}},{"user":{"id":2}}]`)
+	})
+
+	ctx := context.Background()
+	wantErr := errors.New("stop")
+	visited := 0
+	err := client.Activity.ExportStargazers(ctx, "o", "r", func(*Stargazer) error {
+		visited++
+		return wantErr
+	})
+	if err != wantErr {
+		t.Errorf("Activity.ExportStargazers returned error %v, want %v", err, wantErr)
+	}
+	if visited != 1 {
+		t.Errorf("Activity.ExportStargazers visited %v stargazers, want 1", visited)
+	}
+}
+
+func TestActivityService_ExportStargazers_error(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	err := client.Activity.ExportStargazers(ctx, "\n", "\n", func(*Stargazer) error { return nil })
+	if err == nil {
+		t.Error("Activity.ExportStargazers returned no error")
+	}
+}
+
 func TestActivityService_ListStarred_authenticatedUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -92,6 +205,29 @@ func TestActivityService_ListStarred_authenticatedUser(t *testing.T) {
 	})
 }
 
+func TestActivityService_ListStarred_withoutTimestamps(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ActivityListStarredOptions{WithTimestamps: Bool(false)}
+	repos, _, err := client.Activity.ListStarred(ctx, "", opts)
+	if err != nil {
+		t.Errorf("Activity.ListStarred returned error: %v", err)
+	}
+
+	want := []*StarredRepository{{Repository: &Repository{ID: Int64(1)}}}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Activity.ListStarred returned %+v, want %+v", repos, want)
+	}
+}
+
 func TestActivityService_ListStarred_specifiedUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -107,7 +243,7 @@ func TestActivityService_ListStarred_specifiedUser(t *testing.T) {
 		fmt.Fprint(w, `[{"starred_at":"2002-02-10T15:30:00Z","repo":{"id":2}}]`)
 	})
 
-	opt := &ActivityListStarredOptions{"created", "asc", ListOptions{Page: 2}}
+	opt := &ActivityListStarredOptions{Sort: "created", Direction: "asc", WithTimestamps: Bool(true), ListOptions: ListOptions{Page: 2}}
 	ctx := context.Background()
 	repos, _, err := client.Activity.ListStarred(ctx, "u", opt)
 	if err != nil {
@@ -141,6 +277,8 @@ func TestActivityService_ListStarred_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Activity.ListStarred(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Activity.ListStarred(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestActivityService_IsStarred_hasStar(t *testing.T) {
@@ -216,6 +354,117 @@ func TestActivityService_IsStarred_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Activity.IsStarred(ctx, "%", "%")
 	testURLParseError(t, err)
+	_, _, err = client.Activity.IsStarred(ctx, "..", "..")
+	testPathForbiddenError(t, err)
+}
+
+func TestActivityService_AreStarred(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		requests++
+		switch r.FormValue("page") {
+		case "1":
+			testFormValues(t, r, values{"page": "1", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/user/starred?page=2>; rel="next", <https://api.github.com/user/starred?page=2>; rel="last"`)
+			fmt.Fprint(w, `[{"full_name":"o/a"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"full_name":"O/B"},{"full_name":"o/c"}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	starred, err := client.Activity.AreStarred(ctx, []string{"o/a", "o/b", "o/d", "o/x", "o/y"})
+	if err != nil {
+		t.Errorf("Activity.AreStarred returned error: %v", err)
+	}
+	want := map[string]bool{"o/a": true, "o/b": true, "o/d": false, "o/x": false, "o/y": false}
+	if !cmp.Equal(starred, want) {
+		t.Errorf("Activity.AreStarred returned %+v, want %+v", starred, want)
+	}
+	if requests != 2 {
+		t.Errorf("Activity.AreStarred made %v requests, want 2", requests)
+	}
+}
+
+func TestActivityService_AreStarred_checkEach(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1", "per_page": "100"})
+		w.Header().Set("Link", `<https://api.github.com/user/starred?page=2>; rel="next", <https://api.github.com/user/starred?page=50>; rel="last"`)
+		fmt.Fprint(w, `[{"full_name":"o/a"}]`)
+	})
+	mux.HandleFunc("/user/starred/o/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/user/starred/o/unstarred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	starred, err := client.Activity.AreStarred(ctx, []string{"o/a", "o/starred", "o/unstarred"})
+	if err != nil {
+		t.Errorf("Activity.AreStarred returned error: %v", err)
+	}
+	want := map[string]bool{"o/a": true, "o/starred": true, "o/unstarred": false}
+	if !cmp.Equal(starred, want) {
+		t.Errorf("Activity.AreStarred returned %+v, want %+v", starred, want)
+	}
+}
+
+func TestActivityService_AreStarred_rateLimited(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"API rate limit exceeded for xxx.xxx.xxx.xxx.","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api"}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Activity.AreStarred(ctx, []string{"o/r"}); err == nil {
+		t.Error("Activity.AreStarred returned no error")
+	}
+}
+
+func TestActivityService_AreStarred_invalidName(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, name := range []string{"r", "/r", "o/"} {
+		if _, err := client.Activity.AreStarred(ctx, []string{name}); err == nil {
+			t.Errorf("Activity.AreStarred(%q) returned no error", name)
+		}
+	}
+}
+
+func TestActivityService_AreStarred_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Activity.AreStarred(ctx, []string{"o/r"}); err == nil {
+		t.Error("Activity.AreStarred returned no error")
+	}
 }
 
 func TestActivityService_Star(t *testing.T) {
@@ -250,6 +499,8 @@ func TestActivityService_Star_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Activity.Star(ctx, "%", "%")
 	testURLParseError(t, err)
+	_, err = client.Activity.Star(ctx, "..", "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestActivityService_Unstar(t *testing.T) {
@@ -284,6 +535,8 @@ func TestActivityService_Unstar_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Activity.Unstar(ctx, "%", "%")
 	testURLParseError(t, err)
+	_, err = client.Activity.Unstar(ctx, "..", "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestStarredRepository_Marshal(t *testing.T) {
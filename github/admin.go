@@ -86,7 +86,7 @@ func (m Enterprise) String() string {
 //
 //meta:operation PATCH /admin/ldap/users/{username}/mapping
 func (s *AdminService) UpdateUserLDAPMapping(ctx context.Context, user string, mapping *UserLDAPMapping) (*UserLDAPMapping, *Response, error) {
-	u := fmt.Sprintf("admin/ldap/users/%v/mapping", user)
+	u := fmt.Sprintf("admin/ldap/users/%v/mapping", PathEscape(user))
 	req, err := s.client.NewRequest("PATCH", u, mapping)
 	if err != nil {
 		return nil, nil, err
@@ -74,7 +74,7 @@ func (s *AdminService) RenameOrg(ctx context.Context, org *Organization, newName
 //
 //meta:operation PATCH /admin/organizations/{org}
 func (s *AdminService) RenameOrgByName(ctx context.Context, org, newName string) (*RenameOrgResponse, *Response, error) {
-	u := fmt.Sprintf("admin/organizations/%v", org)
+	u := fmt.Sprintf("admin/organizations/%v", PathEscape(org))
 
 	orgReq := &renameOrgRequest{
 		Login: &newName,
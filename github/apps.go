@@ -7,7 +7,13 @@ package github
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
 )
 
 // AppsService provides access to the installation related functions
@@ -115,6 +121,71 @@ type InstallationPermissions struct {
 	TeamDiscussions               *string `json:"team_discussions,omitempty"`
 	VulnerabilityAlerts           *string `json:"vulnerability_alerts,omitempty"`
 	Workflows                     *string `json:"workflows,omitempty"`
+
+	// AdditionalPermissions holds the permissions that GitHub returned but
+	// that have no field above, by name, such as newly added permissions.
+	// They are sent back along with the other permissions.
+	AdditionalPermissions map[string]string `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *InstallationPermissions) UnmarshalJSON(data []byte) error {
+	type permissionsAlias InstallationPermissions
+	var v permissionsAlias
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	rawDefinedFields, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	definedFields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(rawDefinedFields, &definedFields); err != nil {
+		return err
+	}
+
+	var all map[string]interface{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for key, val := range all {
+		if _, ok := definedFields[key]; ok {
+			continue
+		}
+		if level, ok := val.(string); ok {
+			if v.AdditionalPermissions == nil {
+				v.AdditionalPermissions = map[string]string{}
+			}
+			v.AdditionalPermissions[key] = level
+		}
+	}
+
+	*p = InstallationPermissions(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p InstallationPermissions) MarshalJSON() ([]byte, error) {
+	type permissionsAlias InstallationPermissions
+	defBytes, err := json.Marshal(permissionsAlias(p))
+	if err != nil {
+		return nil, err
+	}
+	if len(p.AdditionalPermissions) == 0 {
+		return defBytes, nil
+	}
+	resMap := map[string]interface{}{}
+	if err := json.Unmarshal(defBytes, &resMap); err != nil {
+		return nil, err
+	}
+	for key, val := range p.AdditionalPermissions {
+		if _, ok := resMap[key]; ok {
+			return nil, fmt.Errorf("unexpected permission in AdditionalPermissions: %v", key)
+		}
+		resMap[key] = val
+	}
+	return json.Marshal(resMap)
 }
 
 // InstallationRequest represents a pending GitHub App installation request.
@@ -183,7 +254,7 @@ func (i Installation) String() string {
 func (s *AppsService) Get(ctx context.Context, appSlug string) (*App, *Response, error) {
 	var u string
 	if appSlug != "" {
-		u = fmt.Sprintf("apps/%v", appSlug)
+		u = fmt.Sprintf("apps/%v", PathEscape(appSlug))
 	} else {
 		u = "app"
 	}
@@ -252,6 +323,45 @@ func (s *AppsService) ListInstallations(ctx context.Context, opts *ListOptions)
 	return i, resp, nil
 }
 
+// ListInstallationsOptions specifies the optional parameters to the
+// AppsService.ListInstallationsWithOptions method.
+type ListInstallationsOptions struct {
+	// Since only returns installations that were updated after the given time.
+	Since time.Time `url:"since,omitempty"`
+
+	// Outdated filters installations by whether they are on an outdated
+	// version of the app's permissions.
+	Outdated string `url:"outdated,omitempty"`
+
+	ListOptions
+}
+
+// ListInstallationsWithOptions lists the installations that the current GitHub App has,
+// with support for the since and outdated filters.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/apps#list-installations-for-the-authenticated-app
+//
+//meta:operation GET /app/installations
+func (s *AppsService) ListInstallationsWithOptions(ctx context.Context, opts *ListInstallationsOptions) ([]*Installation, *Response, error) {
+	u, err := addOptions("app/installations", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var i []*Installation
+	resp, err := s.client.Do(ctx, req, &i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
+
 // GetInstallation returns the specified installation.
 //
 // GitHub API docs: https://docs.github.com/rest/apps/apps#get-an-installation-for-the-authenticated-app
@@ -267,6 +377,90 @@ func (s *AppsService) GetInstallation(ctx context.Context, id int64) (*Installat
 //
 //meta:operation GET /user/installations
 func (s *AppsService) ListUserInstallations(ctx context.Context, opts *ListOptions) ([]*Installation, *Response, error) {
+	i, resp, err := s.listUserInstallations(ctx, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i.Installations, resp, nil
+}
+
+// ListUserInstallationsOptions specifies the optional parameters to the
+// AppsService.ListUserInstallationsWithOptions method.
+type ListUserInstallationsOptions struct {
+	// AppSlug only returns the installations of the app with the given slug.
+	// GitHub doesn't support this filter, so it is applied to each page of
+	// results once received: a page can hold fewer installations than
+	// PerPage, or none, and NextPage must still be followed to list them all.
+	AppSlug string `url:"-"`
+
+	ListOptions
+}
+
+// ListUserInstallationsWithOptions lists installations that are accessible
+// to the authenticated user, with support for filtering them by app.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/installations#list-app-installations-accessible-to-the-user-access-token
+//
+//meta:operation GET /user/installations
+func (s *AppsService) ListUserInstallationsWithOptions(ctx context.Context, opts *ListUserInstallationsOptions) ([]*Installation, *Response, error) {
+	i, resp, err := s.listUserInstallations(ctx, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if opts == nil || opts.AppSlug == "" {
+		return i.Installations, resp, nil
+	}
+	installations := []*Installation{}
+	for _, inst := range i.Installations {
+		if inst.GetAppSlug() == opts.AppSlug {
+			installations = append(installations, inst)
+		}
+	}
+
+	return installations, resp, nil
+}
+
+// GetUserInstallationPermissions returns the permissions of an installation
+// accessible to the authenticated user. GitHub has no endpoint for a single
+// installation with a user access token, so the installations of the user are
+// listed until the installation is found; an error is returned if it isn't.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/installations#list-app-installations-accessible-to-the-user-access-token
+//
+//meta:operation GET /user/installations
+func (s *AppsService) GetUserInstallationPermissions(ctx context.Context, id int64) (*InstallationPermissions, *Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	for {
+		i, resp, err := s.listUserInstallations(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, inst := range i.Installations {
+			if inst.GetID() == id {
+				perms := inst.Permissions
+				if perms == nil {
+					perms = &InstallationPermissions{}
+				}
+				return perms, resp, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, resp, fmt.Errorf("installation %v is not accessible to the authenticated user", id)
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+type userInstallations struct {
+	TotalCount    *int            `json:"total_count,omitempty"`
+	Installations []*Installation `json:"installations"`
+}
+
+func (s *AppsService) listUserInstallations(ctx context.Context, opts interface{}) (*userInstallations, *Response, error) {
 	u, err := addOptions("user/installations", opts)
 	if err != nil {
 		return nil, nil, err
@@ -277,15 +471,13 @@ func (s *AppsService) ListUserInstallations(ctx context.Context, opts *ListOptio
 		return nil, nil, err
 	}
 
-	var i struct {
-		Installations []*Installation `json:"installations"`
-	}
-	resp, err := s.client.Do(ctx, req, &i)
+	i := new(userInstallations)
+	resp, err := s.client.Do(ctx, req, i)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return i.Installations, resp, nil
+	return i, resp, nil
 }
 
 // SuspendInstallation suspends the specified installation.
@@ -382,6 +574,101 @@ func (s *AppsService) CreateInstallationTokenListRepos(ctx context.Context, id i
 	return t, resp, nil
 }
 
+// RepositoryCloneToken is a short-lived installation token scoped to read the
+// contents of a single repository, along with an HTTPS clone URL that has the
+// token embedded as its credential.
+//
+// String, GoString and LogValue redact the token, so a RepositoryCloneToken
+// can be formatted or logged safely. Token and CloneURL themselves are not
+// redacted and should be handled as secrets.
+type RepositoryCloneToken struct {
+	Token     string
+	CloneURL  string
+	ExpiresAt Timestamp
+}
+
+// redactedCloneURL returns the clone URL with the token replaced.
+func (t RepositoryCloneToken) redactedCloneURL() string {
+	u, err := url.Parse(t.CloneURL)
+	if err != nil {
+		return ""
+	}
+	return u.Redacted()
+}
+
+func (t RepositoryCloneToken) String() string {
+	return fmt.Sprintf("github.RepositoryCloneToken{Token:REDACTED, CloneURL:%q, ExpiresAt:%v}", t.redactedCloneURL(), t.ExpiresAt)
+}
+
+// GoString implements fmt.GoStringer so that %#v is redacted too.
+func (t RepositoryCloneToken) GoString() string {
+	return t.String()
+}
+
+// LogValue implements slog.LogValuer so that the token is redacted when
+// logged with log/slog.
+func (t RepositoryCloneToken) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("token", "REDACTED"),
+		slog.String("clone_url", t.redactedCloneURL()),
+		slog.Time("expires_at", t.ExpiresAt.Time),
+	)
+}
+
+// CreateRepositoryCloneToken creates an installation token that can only read
+// the contents of owner/repo, and returns it along with an HTTPS clone URL
+// that authenticates as x-access-token with that token. This is useful for
+// cloning private repositories from CI systems. The installation must have
+// access to the repository.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/apps#create-an-installation-access-token-for-an-app
+//
+//meta:operation POST /app/installations/{installation_id}/access_tokens
+func (s *AppsService) CreateRepositoryCloneToken(ctx context.Context, id int64, owner, repo string) (*RepositoryCloneToken, *Response, error) {
+	opts := &InstallationTokenOptions{
+		Repositories: []string{repo},
+		Permissions:  &InstallationPermissions{Contents: String("read")},
+	}
+	t, resp, err := s.CreateInstallationToken(ctx, id, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	if t.GetToken() == "" {
+		return nil, resp, errors.New("installation token response did not include a token")
+	}
+
+	cloneURL := s.client.defaultCloneURL(owner, repo)
+	for _, r := range t.Repositories {
+		if strings.EqualFold(r.GetName(), repo) && r.GetCloneURL() != "" {
+			cloneURL = r.GetCloneURL()
+			break
+		}
+	}
+	u, err := url.Parse(cloneURL)
+	if err != nil {
+		return nil, resp, err
+	}
+	u.User = url.UserPassword("x-access-token", t.GetToken())
+
+	return &RepositoryCloneToken{
+		Token:     t.GetToken(),
+		CloneURL:  u.String(),
+		ExpiresAt: t.GetExpiresAt(),
+	}, resp, nil
+}
+
+// defaultCloneURL guesses the HTTPS clone URL of owner/repo from the client's
+// BaseURL, for when the API doesn't return one. On GitHub Enterprise Server
+// the API is served from the same host as the repositories.
+func (c *Client) defaultCloneURL(owner, repo string) string {
+	host := c.BaseURL.Host
+	if host == "api.github.com" {
+		host = "github.com"
+	}
+	u := url.URL{Scheme: c.BaseURL.Scheme, Host: host, Path: fmt.Sprintf("/%v/%v.git", owner, repo)}
+	return u.String()
+}
+
 // CreateAttachment creates a new attachment on user comment containing a url.
 //
 // GitHub API docs: https://docs.github.com/enterprise-server@3.3/rest/reference/apps#create-a-content-attachment
@@ -413,7 +700,7 @@ func (s *AppsService) CreateAttachment(ctx context.Context, contentReferenceID i
 //
 //meta:operation GET /orgs/{org}/installation
 func (s *AppsService) FindOrganizationInstallation(ctx context.Context, org string) (*Installation, *Response, error) {
-	return s.getInstallation(ctx, fmt.Sprintf("orgs/%v/installation", org))
+	return s.getInstallation(ctx, fmt.Sprintf("orgs/%v/installation", PathEscape(org)))
 }
 
 // FindRepositoryInstallation finds the repository's installation information.
@@ -422,7 +709,7 @@ func (s *AppsService) FindOrganizationInstallation(ctx context.Context, org stri
 //
 //meta:operation GET /repos/{owner}/{repo}/installation
 func (s *AppsService) FindRepositoryInstallation(ctx context.Context, owner, repo string) (*Installation, *Response, error) {
-	return s.getInstallation(ctx, fmt.Sprintf("repos/%v/%v/installation", owner, repo))
+	return s.getInstallation(ctx, fmt.Sprintf("repos/%v/%v/installation", PathEscape(owner), PathEscape(repo)))
 }
 
 // FindRepositoryInstallationByID finds the repository's installation information.
@@ -440,7 +727,7 @@ func (s *AppsService) FindRepositoryInstallationByID(ctx context.Context, id int
 //
 //meta:operation GET /users/{username}/installation
 func (s *AppsService) FindUserInstallation(ctx context.Context, user string) (*Installation, *Response, error) {
-	return s.getInstallation(ctx, fmt.Sprintf("users/%v/installation", user))
+	return s.getInstallation(ctx, fmt.Sprintf("users/%v/installation", PathEscape(user)))
 }
 
 func (s *AppsService) getInstallation(ctx context.Context, url string) (*Installation, *Response, error) {
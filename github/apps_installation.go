@@ -15,6 +15,8 @@ import (
 type ListRepositories struct {
 	TotalCount   *int          `json:"total_count,omitempty"`
 	Repositories []*Repository `json:"repositories"`
+	// RepositorySelection is "all" or "selected".
+	RepositorySelection *string `json:"repository_selection,omitempty"`
 }
 
 // ListRepos lists the repositories that are accessible to the authenticated installation.
@@ -86,7 +88,9 @@ func (s *AppsService) ListUserRepos(ctx context.Context, id int64, opts *ListOpt
 	return r, resp, nil
 }
 
-// AddRepository adds a single repository to an installation.
+// AddRepository adds a single repository to an installation. GitHub
+// responds with 204 No Content, in which case the returned Repository is
+// empty.
 //
 // GitHub API docs: https://docs.github.com/rest/apps/installations#add-a-repository-to-an-app-installation
 //
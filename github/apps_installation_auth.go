@@ -0,0 +1,80 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// installationTokenExpiryMargin is how long before its expiration an
+// installation token is renewed.
+const installationTokenExpiryMargin = time.Minute
+
+// installationTokenSource creates and caches the installation tokens of an
+// installation.
+type installationTokenSource struct {
+	apps *AppsService
+	id   int64
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func (s *installationTokenSource) roundTripper(transport http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(
+		func(req *http.Request) (*http.Response, error) {
+			token, err := s.get(req)
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			return transport.RoundTrip(req)
+		},
+	)
+}
+
+// get returns the cached token, or a new one if it is about to expire.
+func (s *installationTokenSource) get(req *http.Request) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Until(s.expiresAt) > installationTokenExpiryMargin {
+		return s.token, nil
+	}
+	t, _, err := s.apps.CreateInstallationToken(req.Context(), s.id, nil)
+	if err != nil {
+		return "", fmt.Errorf("creating a token for installation %v: %w", s.id, err)
+	}
+	if t.GetToken() == "" {
+		return "", errors.New("installation token response did not include a token")
+	}
+	s.token = t.GetToken()
+	s.expiresAt = t.GetExpiresAt().Time
+	return s.token, nil
+}
+
+// WithInstallationAuth returns a copy of the client authenticated as the
+// installation id of a GitHub App. The installation tokens are created with
+// apps, which must belong to a client authenticated as the app, such as with
+// a JWT, when the first request is made, and renewed shortly before they
+// expire. The client itself should not be authenticated otherwise.
+func (c *Client) WithInstallationAuth(apps *AppsService, id int64) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	transport := c2.client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	source := &installationTokenSource{apps: apps, id: id}
+	c2.client.Transport = source.roundTripper(transport)
+	return c2
+}
@@ -72,7 +72,7 @@ func TestAppsService_ListUserRepos(t *testing.T) {
 			"page":     "1",
 			"per_page": "2",
 		})
-		fmt.Fprint(w, `{"total_count":1,"repositories": [{"id":1}]}`)
+		fmt.Fprint(w, `{"total_count":1,"repository_selection":"selected","repositories": [{"id":1}]}`)
 	})
 
 	opt := &ListOptions{Page: 1, PerPage: 2}
@@ -82,7 +82,7 @@ func TestAppsService_ListUserRepos(t *testing.T) {
 		t.Errorf("Apps.ListUserRepos returned error: %v", err)
 	}
 
-	want := &ListRepositories{TotalCount: Int(1), Repositories: []*Repository{{ID: Int64(1)}}}
+	want := &ListRepositories{TotalCount: Int(1), RepositorySelection: String("selected"), Repositories: []*Repository{{ID: Int64(1)}}}
 	if !cmp.Equal(repositories, want) {
 		t.Errorf("Apps.ListUserRepos returned %+v, want %+v", repositories, want)
 	}
@@ -132,6 +132,30 @@ func TestAppsService_AddRepository(t *testing.T) {
 	})
 }
 
+func TestAppsService_AddRepository_noContent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/installations/1/repositories/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	repo, resp, err := client.Apps.AddRepository(ctx, 1, 1)
+	if err != nil {
+		t.Errorf("Apps.AddRepository returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Apps.AddRepository returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+
+	want := &Repository{}
+	if !cmp.Equal(repo, want) {
+		t.Errorf("AddRepository returned %+v, want %+v", repo, want)
+	}
+}
+
 func TestAppsService_RemoveRepository(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -7,9 +7,19 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
+// ErrManifestCodeExpired is wrapped by the error CompleteAppManifest returns
+// when GitHub does not recognize the manifest code. Manifest codes are single
+// use and expire shortly after they are issued.
+var ErrManifestCodeExpired = errors.New("app manifest code is invalid or has expired")
+
+// redactedSecret replaces secret values in string representations.
+const redactedSecret = "REDACTED"
+
 // AppConfig describes the configuration of a GitHub App.
 type AppConfig struct {
 	ID            *int64     `json:"id,omitempty"`
@@ -28,14 +38,32 @@ type AppConfig struct {
 	PEM           *string    `json:"pem,omitempty"`
 }
 
+// String returns a string representation of the AppConfig with the
+// ClientSecret, WebhookSecret and PEM values redacted, so that the
+// configuration can be logged without leaking credentials.
+func (a AppConfig) String() string {
+	redact := func(v *string) *string {
+		if v == nil || *v == "" {
+			return v
+		}
+		return String(redactedSecret)
+	}
+	a.ClientSecret = redact(a.ClientSecret)
+	a.WebhookSecret = redact(a.WebhookSecret)
+	a.PEM = redact(a.PEM)
+	return Stringify(a)
+}
+
 // CompleteAppManifest completes the App manifest handshake flow for the given
-// code.
+// code. The returned AppConfig contains the app's credentials, which GitHub
+// only returns once. If the code has expired or was already used, the
+// returned error wraps both ErrManifestCodeExpired and the *ErrorResponse.
 //
 // GitHub API docs: https://docs.github.com/rest/apps/apps#create-a-github-app-from-a-manifest
 //
 //meta:operation POST /app-manifests/{code}/conversions
 func (s *AppsService) CompleteAppManifest(ctx context.Context, code string) (*AppConfig, *Response, error) {
-	u := fmt.Sprintf("app-manifests/%s/conversions", code)
+	u := fmt.Sprintf("app-manifests/%s/conversions", PathEscape(code))
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -44,6 +72,9 @@ func (s *AppsService) CompleteAppManifest(ctx context.Context, code string) (*Ap
 	cfg := new(AppConfig)
 	resp, err := s.client.Do(ctx, req, cfg)
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			err = fmt.Errorf("%w: %w", ErrManifestCodeExpired, err)
+		}
 		return nil, resp, err
 	}
 
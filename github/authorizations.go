@@ -147,7 +147,7 @@ func (a AuthorizationUpdateRequest) String() string {
 //
 //meta:operation POST /applications/{client_id}/token
 func (s *AuthorizationsService) Check(ctx context.Context, clientID, accessToken string) (*Authorization, *Response, error) {
-	u := fmt.Sprintf("applications/%v/token", clientID)
+	u := fmt.Sprintf("applications/%v/token", PathEscape(clientID))
 
 	reqBody := &struct {
 		AccessToken string `json:"access_token"`
@@ -182,7 +182,7 @@ func (s *AuthorizationsService) Check(ctx context.Context, clientID, accessToken
 //
 //meta:operation PATCH /applications/{client_id}/token
 func (s *AuthorizationsService) Reset(ctx context.Context, clientID, accessToken string) (*Authorization, *Response, error) {
-	u := fmt.Sprintf("applications/%v/token", clientID)
+	u := fmt.Sprintf("applications/%v/token", PathEscape(clientID))
 
 	reqBody := &struct {
 		AccessToken string `json:"access_token"`
@@ -213,7 +213,7 @@ func (s *AuthorizationsService) Reset(ctx context.Context, clientID, accessToken
 //
 //meta:operation DELETE /applications/{client_id}/token
 func (s *AuthorizationsService) Revoke(ctx context.Context, clientID, accessToken string) (*Response, error) {
-	u := fmt.Sprintf("applications/%v/token", clientID)
+	u := fmt.Sprintf("applications/%v/token", PathEscape(clientID))
 
 	reqBody := &struct {
 		AccessToken string `json:"access_token"`
@@ -236,7 +236,7 @@ func (s *AuthorizationsService) Revoke(ctx context.Context, clientID, accessToke
 //
 //meta:operation DELETE /applications/{client_id}/grant
 func (s *AuthorizationsService) DeleteGrant(ctx context.Context, clientID, accessToken string) (*Response, error) {
-	u := fmt.Sprintf("applications/%v/grant", clientID)
+	u := fmt.Sprintf("applications/%v/grant", PathEscape(clientID))
 
 	reqBody := &struct {
 		AccessToken string `json:"access_token"`
@@ -261,7 +261,7 @@ func (s *AuthorizationsService) DeleteGrant(ctx context.Context, clientID, acces
 //
 //meta:operation POST /admin/users/{username}/authorizations
 func (s *AuthorizationsService) CreateImpersonation(ctx context.Context, username string, authReq *AuthorizationRequest) (*Authorization, *Response, error) {
-	u := fmt.Sprintf("admin/users/%v/authorizations", username)
+	u := fmt.Sprintf("admin/users/%v/authorizations", PathEscape(username))
 	req, err := s.client.NewRequest("POST", u, authReq)
 	if err != nil {
 		return nil, nil, err
@@ -283,7 +283,7 @@ func (s *AuthorizationsService) CreateImpersonation(ctx context.Context, usernam
 //
 //meta:operation DELETE /admin/users/{username}/authorizations
 func (s *AuthorizationsService) DeleteImpersonation(ctx context.Context, username string) (*Response, error) {
-	u := fmt.Sprintf("admin/users/%v/authorizations", username)
+	u := fmt.Sprintf("admin/users/%v/authorizations", PathEscape(username))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
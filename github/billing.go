@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"sort"
 )
 
 // BillingService provides access to the billing related functions
@@ -60,13 +61,100 @@ type AdvancedSecurityCommittersBreakdown struct {
 	LastPushedDate *string `json:"last_pushed_date,omitempty"`
 }
 
+// UsageReportOptions specifies optional parameters to the
+// BillingService.GetUsageReportOrg and BillingService.GetUsageReportEnterprise
+// methods. If no filter is set, usage for the current month is returned.
+type UsageReportOptions struct {
+	// Year filters usage to a year, such as 2024. Defaults to the current year.
+	Year *int `url:"year,omitempty"`
+	// Month filters usage to a month of Year, from 1 to 12.
+	Month *int `url:"month,omitempty"`
+	// Day filters usage to a day of Month, from 1 to 31.
+	Day *int `url:"day,omitempty"`
+	// Hour filters usage to an hour of Day, from 0 to 23.
+	Hour *int `url:"hour,omitempty"`
+}
+
+// UsageReport represents the usage of billable products on the enhanced
+// billing platform.
+type UsageReport struct {
+	UsageItems []*UsageItem `json:"usageItems,omitempty"`
+}
+
+// UsageItem represents a line item of a UsageReport: the usage of a product
+// SKU on a given date.
+type UsageItem struct {
+	Date             *string  `json:"date,omitempty"`
+	Product          *string  `json:"product,omitempty"`
+	SKU              *string  `json:"sku,omitempty"`
+	Quantity         *float64 `json:"quantity,omitempty"`
+	UnitType         *string  `json:"unitType,omitempty"`
+	PricePerUnit     *float64 `json:"pricePerUnit,omitempty"`
+	GrossAmount      *float64 `json:"grossAmount,omitempty"`
+	DiscountAmount   *float64 `json:"discountAmount,omitempty"`
+	NetAmount        *float64 `json:"netAmount,omitempty"`
+	OrganizationName *string  `json:"organizationName,omitempty"`
+	RepositoryName   *string  `json:"repositoryName,omitempty"`
+}
+
+// UsageSummary is the total usage of a product, measured in one unit type,
+// by a repository.
+type UsageSummary struct {
+	RepositoryName string
+	Product        string
+	UnitType       string
+	Quantity       float64
+	GrossAmount    float64
+	DiscountAmount float64
+	NetAmount      float64
+}
+
+// SummarizeByRepository totals the usage items of r by repository and
+// product. Items of the same product measured in different unit types, such
+// as minutes and gigabyte hours, are totaled separately. Usage not attributed
+// to a repository has an empty RepositoryName. The summaries are sorted by
+// repository, product and unit type.
+func (r *UsageReport) SummarizeByRepository() []*UsageSummary {
+	type key struct{ repo, product, unitType string }
+
+	totals := make(map[key]*UsageSummary)
+	for _, item := range r.UsageItems {
+		k := key{item.GetRepositoryName(), item.GetProduct(), item.GetUnitType()}
+		sum, ok := totals[k]
+		if !ok {
+			sum = &UsageSummary{RepositoryName: k.repo, Product: k.product, UnitType: k.unitType}
+			totals[k] = sum
+		}
+		sum.Quantity += float64Value(item.Quantity)
+		sum.GrossAmount += float64Value(item.GrossAmount)
+		sum.DiscountAmount += float64Value(item.DiscountAmount)
+		sum.NetAmount += float64Value(item.NetAmount)
+	}
+
+	summaries := make([]*UsageSummary, 0, len(totals))
+	for _, sum := range totals {
+		summaries = append(summaries, sum)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		a, b := summaries[i], summaries[j]
+		if a.RepositoryName != b.RepositoryName {
+			return a.RepositoryName < b.RepositoryName
+		}
+		if a.Product != b.Product {
+			return a.Product < b.Product
+		}
+		return a.UnitType < b.UnitType
+	})
+	return summaries
+}
+
 // GetActionsBillingOrg returns the summary of the free and paid GitHub Actions minutes used for an Org.
 //
 // GitHub API docs: https://docs.github.com/rest/billing/billing#get-github-actions-billing-for-an-organization
 //
 //meta:operation GET /orgs/{org}/settings/billing/actions
 func (s *BillingService) GetActionsBillingOrg(ctx context.Context, org string) (*ActionBilling, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/settings/billing/actions", org)
+	u := fmt.Sprintf("orgs/%v/settings/billing/actions", PathEscape(org))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -87,7 +175,7 @@ func (s *BillingService) GetActionsBillingOrg(ctx context.Context, org string) (
 //
 //meta:operation GET /orgs/{org}/settings/billing/packages
 func (s *BillingService) GetPackagesBillingOrg(ctx context.Context, org string) (*PackageBilling, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/settings/billing/packages", org)
+	u := fmt.Sprintf("orgs/%v/settings/billing/packages", PathEscape(org))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -109,7 +197,7 @@ func (s *BillingService) GetPackagesBillingOrg(ctx context.Context, org string)
 //
 //meta:operation GET /orgs/{org}/settings/billing/shared-storage
 func (s *BillingService) GetStorageBillingOrg(ctx context.Context, org string) (*StorageBilling, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/settings/billing/shared-storage", org)
+	u := fmt.Sprintf("orgs/%v/settings/billing/shared-storage", PathEscape(org))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -130,7 +218,7 @@ func (s *BillingService) GetStorageBillingOrg(ctx context.Context, org string) (
 //
 //meta:operation GET /orgs/{org}/settings/billing/advanced-security
 func (s *BillingService) GetAdvancedSecurityActiveCommittersOrg(ctx context.Context, org string, opts *ListOptions) (*ActiveCommitters, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/settings/billing/advanced-security", org)
+	u := fmt.Sprintf("orgs/%v/settings/billing/advanced-security", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -156,7 +244,7 @@ func (s *BillingService) GetAdvancedSecurityActiveCommittersOrg(ctx context.Cont
 //
 //meta:operation GET /users/{username}/settings/billing/actions
 func (s *BillingService) GetActionsBillingUser(ctx context.Context, user string) (*ActionBilling, *Response, error) {
-	u := fmt.Sprintf("users/%v/settings/billing/actions", user)
+	u := fmt.Sprintf("users/%v/settings/billing/actions", PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -177,7 +265,7 @@ func (s *BillingService) GetActionsBillingUser(ctx context.Context, user string)
 //
 //meta:operation GET /users/{username}/settings/billing/packages
 func (s *BillingService) GetPackagesBillingUser(ctx context.Context, user string) (*PackageBilling, *Response, error) {
-	u := fmt.Sprintf("users/%v/settings/billing/packages", user)
+	u := fmt.Sprintf("users/%v/settings/billing/packages", PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -199,7 +287,7 @@ func (s *BillingService) GetPackagesBillingUser(ctx context.Context, user string
 //
 //meta:operation GET /users/{username}/settings/billing/shared-storage
 func (s *BillingService) GetStorageBillingUser(ctx context.Context, user string) (*StorageBilling, *Response, error) {
-	u := fmt.Sprintf("users/%v/settings/billing/shared-storage", user)
+	u := fmt.Sprintf("users/%v/settings/billing/shared-storage", PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -213,3 +301,54 @@ func (s *BillingService) GetStorageBillingUser(ctx context.Context, user string)
 
 	return storageUserBilling, resp, nil
 }
+
+// GetUsageReportOrg returns a report of the usage of billable products by an
+// organization on the enhanced billing platform. It replaces the per-product
+// billing endpoints for accounts that have moved to the platform.
+//
+// GitHub API docs: https://docs.github.com/rest/billing/enhanced-billing#get-billing-usage-report-for-an-organization
+//
+//meta:operation GET /organizations/{org}/settings/billing/usage
+func (s *BillingService) GetUsageReportOrg(ctx context.Context, org string, opts *UsageReportOptions) (*UsageReport, *Response, error) {
+	u := fmt.Sprintf("organizations/%v/settings/billing/usage", PathEscape(org))
+	return s.getUsageReport(ctx, u, opts)
+}
+
+// GetUsageReportEnterprise returns a report of the usage of billable products
+// by an enterprise on the enhanced billing platform.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/billing#get-billing-usage-report-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/settings/billing/usage
+func (s *BillingService) GetUsageReportEnterprise(ctx context.Context, enterprise string, opts *UsageReportOptions) (*UsageReport, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/settings/billing/usage", PathEscape(enterprise))
+	return s.getUsageReport(ctx, u, opts)
+}
+
+func (s *BillingService) getUsageReport(ctx context.Context, u string, opts *UsageReportOptions) (*UsageReport, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usageReport := new(UsageReport)
+	resp, err := s.client.Do(ctx, req, usageReport)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usageReport, resp, nil
+}
+
+// float64Value returns the value v points to, or 0 if v is nil.
+func float64Value(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -74,6 +75,8 @@ func TestBillingService_GetActionsBillingOrg_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Billing.GetActionsBillingOrg(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Billing.GetActionsBillingOrg(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestBillingService_GetPackagesBillingOrg(t *testing.T) {
@@ -126,6 +129,8 @@ func TestBillingService_GetPackagesBillingOrg_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Billing.GetPackagesBillingOrg(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Billing.GetPackagesBillingOrg(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestBillingService_GetStorageBillingOrg(t *testing.T) {
@@ -178,6 +183,8 @@ func TestBillingService_GetStorageBillingOrg_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Billing.GetStorageBillingOrg(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Billing.GetStorageBillingOrg(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestBillingService_GetActionsBillingUser(t *testing.T) {
@@ -240,6 +247,8 @@ func TestBillingService_GetActionsBillingUser_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Billing.GetActionsBillingUser(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Billing.GetActionsBillingUser(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestBillingService_GetPackagesBillingUser(t *testing.T) {
@@ -292,6 +301,8 @@ func TestBillingService_GetPackagesBillingUser_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Billing.GetPackagesBillingUser(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Billing.GetPackagesBillingUser(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestBillingService_GetStorageBillingUser(t *testing.T) {
@@ -344,6 +355,8 @@ func TestBillingService_GetStorageBillingUser_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Billing.GetStorageBillingUser(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Billing.GetStorageBillingUser(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestMinutesUsedBreakdown_Marshal(t *testing.T) {
@@ -499,4 +512,226 @@ func TestBillingService_GetAdvancedSecurityActiveCommittersOrg_invalidOrg(t *tes
 	ctx := context.Background()
 	_, _, err := client.Billing.GetAdvancedSecurityActiveCommittersOrg(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Billing.GetAdvancedSecurityActiveCommittersOrg(ctx, "..", nil)
+	testPathForbiddenError(t, err)
+}
+
+const usageReportJSON = `{
+	"usageItems": [
+		{
+			"date": "2024-05-01T00:00:00Z",
+			"product": "Actions",
+			"sku": "Actions Linux",
+			"quantity": 120,
+			"unitType": "minutes",
+			"pricePerUnit": 0.008,
+			"grossAmount": 1,
+			"discountAmount": 1,
+			"netAmount": 0,
+			"organizationName": "o",
+			"repositoryName": "o/r1"
+		},
+		{
+			"date": "2024-05-02T00:00:00Z",
+			"product": "Actions",
+			"sku": "Actions macOS 3-core",
+			"quantity": 10,
+			"unitType": "minutes",
+			"pricePerUnit": 0.08,
+			"grossAmount": 0.75,
+			"discountAmount": 0,
+			"netAmount": 0.75,
+			"organizationName": "o",
+			"repositoryName": "o/r1"
+		},
+		{
+			"date": "2024-05-01T00:00:00Z",
+			"product": "Actions",
+			"sku": "Actions Storage",
+			"quantity": 0.5,
+			"unitType": "GigabyteHours",
+			"pricePerUnit": 0.00033,
+			"grossAmount": 0.125,
+			"discountAmount": 0,
+			"netAmount": 0.125,
+			"organizationName": "o",
+			"repositoryName": "o/r1"
+		},
+		{
+			"date": "2024-05-01T00:00:00Z",
+			"product": "Actions",
+			"sku": "Actions Linux",
+			"quantity": 30,
+			"unitType": "minutes",
+			"pricePerUnit": 0.008,
+			"grossAmount": 0.24,
+			"discountAmount": 0,
+			"netAmount": 0.24,
+			"organizationName": "o",
+			"repositoryName": "o/r2"
+		},
+		{
+			"date": "2024-05-01T00:00:00Z",
+			"product": "GitHub Advanced Security",
+			"sku": "GitHub Advanced Security",
+			"quantity": 3,
+			"unitType": "UserMonths",
+			"pricePerUnit": 49,
+			"grossAmount": 147,
+			"discountAmount": 0,
+			"netAmount": 147,
+			"organizationName": "o",
+			"repositoryName": "o/r2"
+		}
+	]
+}`
+
+func TestBillingService_GetUsageReportOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/organizations/o/settings/billing/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"year": "2024", "month": "5", "day": "1", "hour": "0"})
+		fmt.Fprint(w, usageReportJSON)
+	})
+
+	ctx := context.Background()
+	opts := &UsageReportOptions{Year: Int(2024), Month: Int(5), Day: Int(1), Hour: Int(0)}
+	report, _, err := client.Billing.GetUsageReportOrg(ctx, "o", opts)
+	if err != nil {
+		t.Fatalf("Billing.GetUsageReportOrg returned error: %v", err)
+	}
+
+	if got, want := len(report.UsageItems), 5; got != want {
+		t.Fatalf("Billing.GetUsageReportOrg returned %v usage items, want %v", got, want)
+	}
+	wantItem := &UsageItem{
+		Date:             String("2024-05-01T00:00:00Z"),
+		Product:          String("GitHub Advanced Security"),
+		SKU:              String("GitHub Advanced Security"),
+		Quantity:         Float64(3),
+		UnitType:         String("UserMonths"),
+		PricePerUnit:     Float64(49),
+		GrossAmount:      Float64(147),
+		DiscountAmount:   Float64(0),
+		NetAmount:        Float64(147),
+		OrganizationName: String("o"),
+		RepositoryName:   String("o/r2"),
+	}
+	if !cmp.Equal(report.UsageItems[4], wantItem) {
+		t.Errorf("Billing.GetUsageReportOrg returned %+v, want %+v", report.UsageItems[4], wantItem)
+	}
+
+	const methodName = "GetUsageReportOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Billing.GetUsageReportOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Billing.GetUsageReportOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestBillingService_GetUsageReportEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/settings/billing/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{})
+		fmt.Fprint(w, `{"usageItems":[{"product":"Actions","quantity":1,"unitType":"minutes"}]}`)
+	})
+
+	ctx := context.Background()
+	report, _, err := client.Billing.GetUsageReportEnterprise(ctx, "e", nil)
+	if err != nil {
+		t.Fatalf("Billing.GetUsageReportEnterprise returned error: %v", err)
+	}
+
+	want := &UsageReport{UsageItems: []*UsageItem{{Product: String("Actions"), Quantity: Float64(1), UnitType: String("minutes")}}}
+	if !cmp.Equal(report, want) {
+		t.Errorf("Billing.GetUsageReportEnterprise returned %+v, want %+v", report, want)
+	}
+
+	const methodName = "GetUsageReportEnterprise"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Billing.GetUsageReportEnterprise(ctx, "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Billing.GetUsageReportEnterprise(ctx, "e", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsageReport_SummarizeByRepository(t *testing.T) {
+	var report UsageReport
+	assertNilError(t, json.Unmarshal([]byte(usageReportJSON), &report))
+
+	got := report.SummarizeByRepository()
+	want := []*UsageSummary{
+		{RepositoryName: "o/r1", Product: "Actions", UnitType: "GigabyteHours", Quantity: 0.5, GrossAmount: 0.125, NetAmount: 0.125},
+		{RepositoryName: "o/r1", Product: "Actions", UnitType: "minutes", Quantity: 130, GrossAmount: 1.75, DiscountAmount: 1, NetAmount: 0.75},
+		{RepositoryName: "o/r2", Product: "Actions", UnitType: "minutes", Quantity: 30, GrossAmount: 0.24, NetAmount: 0.24},
+		{RepositoryName: "o/r2", Product: "GitHub Advanced Security", UnitType: "UserMonths", Quantity: 3, GrossAmount: 147, NetAmount: 147},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("SummarizeByRepository returned %+v, want %+v", got, want)
+	}
+
+	if got := (&UsageReport{}).SummarizeByRepository(); len(got) != 0 {
+		t.Errorf("SummarizeByRepository of an empty report returned %+v, want none", got)
+	}
+}
+
+func TestUsageReport_Marshal(t *testing.T) {
+	testJSONMarshal(t, &UsageReport{}, "{}")
+
+	u := &UsageReport{
+		UsageItems: []*UsageItem{
+			{
+				Date:             String("2024-05-01T00:00:00Z"),
+				Product:          String("Actions"),
+				SKU:              String("Actions Linux"),
+				Quantity:         Float64(100),
+				UnitType:         String("minutes"),
+				PricePerUnit:     Float64(0.008),
+				GrossAmount:      Float64(0.8),
+				DiscountAmount:   Float64(0),
+				NetAmount:        Float64(0.8),
+				OrganizationName: String("o"),
+				RepositoryName:   String("o/r"),
+			},
+		},
+	}
+
+	want := `{
+		"usageItems": [
+			{
+				"date": "2024-05-01T00:00:00Z",
+				"product": "Actions",
+				"sku": "Actions Linux",
+				"quantity": 100,
+				"unitType": "minutes",
+				"pricePerUnit": 0.008,
+				"grossAmount": 0.8,
+				"discountAmount": 0,
+				"netAmount": 0.8,
+				"organizationName": "o",
+				"repositoryName": "o/r"
+			}
+		]
+	}`
+
+	testJSONMarshal(t, u, want)
 }
@@ -58,6 +58,7 @@ type CheckRunAnnotation struct {
 	Message         *string `json:"message,omitempty"`
 	Title           *string `json:"title,omitempty"`
 	RawDetails      *string `json:"raw_details,omitempty"`
+	BlobHRef        *string `json:"blob_href,omitempty"`
 }
 
 // CheckRunImage represents an image object for a CheckRun output.
@@ -102,7 +103,7 @@ func (c CheckSuite) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/check-runs/{check_run_id}
 func (s *ChecksService) GetCheckRun(ctx context.Context, owner, repo string, checkRunID int64) (*CheckRun, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-runs/%v", owner, repo, checkRunID)
+	u := fmt.Sprintf("repos/%v/%v/check-runs/%v", PathEscape(owner), PathEscape(repo), checkRunID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -125,7 +126,7 @@ func (s *ChecksService) GetCheckRun(ctx context.Context, owner, repo string, che
 //
 //meta:operation GET /repos/{owner}/{repo}/check-suites/{check_suite_id}
 func (s *ChecksService) GetCheckSuite(ctx context.Context, owner, repo string, checkSuiteID int64) (*CheckSuite, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-suites/%v", owner, repo, checkSuiteID)
+	u := fmt.Sprintf("repos/%v/%v/check-suites/%v", PathEscape(owner), PathEscape(repo), checkSuiteID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -169,7 +170,7 @@ type CheckRunAction struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/check-runs
 func (s *ChecksService) CreateCheckRun(ctx context.Context, owner, repo string, opts CreateCheckRunOptions) (*CheckRun, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-runs", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/check-runs", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -204,7 +205,7 @@ type UpdateCheckRunOptions struct {
 //
 //meta:operation PATCH /repos/{owner}/{repo}/check-runs/{check_run_id}
 func (s *ChecksService) UpdateCheckRun(ctx context.Context, owner, repo string, checkRunID int64, opts UpdateCheckRunOptions) (*CheckRun, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-runs/%v", owner, repo, checkRunID)
+	u := fmt.Sprintf("repos/%v/%v/check-runs/%v", PathEscape(owner), PathEscape(repo), checkRunID)
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -227,7 +228,7 @@ func (s *ChecksService) UpdateCheckRun(ctx context.Context, owner, repo string,
 //
 //meta:operation GET /repos/{owner}/{repo}/check-runs/{check_run_id}/annotations
 func (s *ChecksService) ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64, opts *ListOptions) ([]*CheckRunAnnotation, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-runs/%v/annotations", owner, repo, checkRunID)
+	u := fmt.Sprintf("repos/%v/%v/check-runs/%v/annotations", PathEscape(owner), PathEscape(repo), checkRunID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -249,6 +250,49 @@ func (s *ChecksService) ListCheckRunAnnotations(ctx context.Context, owner, repo
 	return checkRunAnnotations, resp, nil
 }
 
+// ForEachCheckRunAnnotation calls fn for each annotation of a check run,
+// fetching as many pages as needed without holding more than one page in
+// memory. opts may set the page to start from and the page size, which
+// defaults to 100. It pauses whenever it is rate limited, until ctx is done.
+// If fn returns an error, ForEachCheckRunAnnotation stops and returns that
+// error.
+//
+// GitHub API docs: https://docs.github.com/rest/checks/runs#list-check-run-annotations
+//
+//meta:operation GET /repos/{owner}/{repo}/check-runs/{check_run_id}/annotations
+func (s *ChecksService) ForEachCheckRunAnnotation(ctx context.Context, owner, repo string, checkRunID int64, opts *ListOptions, fn func(*CheckRunAnnotation) error) error {
+	pageOpts := &ListOptions{PerPage: 100}
+	if opts != nil {
+		pageOpts.Page = opts.Page
+		if opts.PerPage != 0 {
+			pageOpts.PerPage = opts.PerPage
+		}
+	}
+
+	for {
+		annotations, resp, err := s.ListCheckRunAnnotations(ctx, owner, repo, checkRunID, pageOpts)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return werr
+		} else if retry {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, annotation := range annotations {
+			if err := fn(annotation); err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil
+		}
+		pageOpts.Page = resp.NextPage
+	}
+}
+
 // ListCheckRunsOptions represents parameters to list check runs.
 type ListCheckRunsOptions struct {
 	CheckName *string `url:"check_name,omitempty"` // Returns check runs with the specified name.
@@ -271,7 +315,7 @@ type ListCheckRunsResults struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-runs
 func (s *ChecksService) ListCheckRunsForRef(ctx context.Context, owner, repo, ref string, opts *ListCheckRunsOptions) (*ListCheckRunsResults, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v/check-runs", owner, repo, refURLEscape(ref))
+	u := fmt.Sprintf("repos/%v/%v/commits/%v/check-runs", PathEscape(owner), PathEscape(repo), refURLEscape(ref))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -299,7 +343,7 @@ func (s *ChecksService) ListCheckRunsForRef(ctx context.Context, owner, repo, re
 //
 //meta:operation GET /repos/{owner}/{repo}/check-suites/{check_suite_id}/check-runs
 func (s *ChecksService) ListCheckRunsCheckSuite(ctx context.Context, owner, repo string, checkSuiteID int64, opts *ListCheckRunsOptions) (*ListCheckRunsResults, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-suites/%v/check-runs", owner, repo, checkSuiteID)
+	u := fmt.Sprintf("repos/%v/%v/check-suites/%v/check-runs", PathEscape(owner), PathEscape(repo), checkSuiteID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -327,7 +371,7 @@ func (s *ChecksService) ListCheckRunsCheckSuite(ctx context.Context, owner, repo
 //
 //meta:operation POST /repos/{owner}/{repo}/check-runs/{check_run_id}/rerequest
 func (s *ChecksService) ReRequestCheckRun(ctx context.Context, owner, repo string, checkRunID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-runs/%v/rerequest", owner, repo, checkRunID)
+	u := fmt.Sprintf("repos/%v/%v/check-runs/%v/rerequest", PathEscape(owner), PathEscape(repo), checkRunID)
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
@@ -359,7 +403,7 @@ type ListCheckSuiteResults struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-suites
 func (s *ChecksService) ListCheckSuitesForRef(ctx context.Context, owner, repo, ref string, opts *ListCheckSuiteOptions) (*ListCheckSuiteResults, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v/check-suites", owner, repo, refURLEscape(ref))
+	u := fmt.Sprintf("repos/%v/%v/commits/%v/check-suites", PathEscape(owner), PathEscape(repo), refURLEscape(ref))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -409,7 +453,7 @@ type PreferenceList struct {
 //
 //meta:operation PATCH /repos/{owner}/{repo}/check-suites/preferences
 func (s *ChecksService) SetCheckSuitePreferences(ctx context.Context, owner, repo string, opts CheckSuitePreferenceOptions) (*CheckSuitePreferenceResults, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-suites/preferences", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/check-suites/preferences", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -438,7 +482,7 @@ type CreateCheckSuiteOptions struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/check-suites
 func (s *ChecksService) CreateCheckSuite(ctx context.Context, owner, repo string, opts CreateCheckSuiteOptions) (*CheckSuite, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-suites", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/check-suites", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -461,7 +505,7 @@ func (s *ChecksService) CreateCheckSuite(ctx context.Context, owner, repo string
 //
 //meta:operation POST /repos/{owner}/{repo}/check-suites/{check_suite_id}/rerequest
 func (s *ChecksService) ReRequestCheckSuite(ctx context.Context, owner, repo string, checkSuiteID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/check-suites/%v/rerequest", owner, repo, checkSuiteID)
+	u := fmt.Sprintf("repos/%v/%v/check-suites/%v/rerequest", PathEscape(owner), PathEscape(repo), checkSuiteID)
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
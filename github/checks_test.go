@@ -7,8 +7,11 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -241,6 +244,91 @@ func TestChecksService_ListCheckRunAnnotations(t *testing.T) {
 	})
 }
 
+func TestChecksService_ForEachCheckRunAnnotation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const total, perPage = 120, 50
+	requests := 0
+	mux.HandleFunc("/repos/o/r/check-runs/1/annotations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeCheckRunsPreview)
+		requests++
+
+		page := 1
+		want := values{"per_page": "50"}
+		if p := r.FormValue("page"); p != "" {
+			page, _ = strconv.Atoi(p)
+			want["page"] = p
+		}
+		testFormValues(t, r, want)
+
+		var annotations []string
+		for i := (page-1)*perPage + 1; i <= page*perPage && i <= total; i++ {
+			annotations = append(annotations, fmt.Sprintf(`{"path":"f%v","raw_details":"d%v","blob_href":"https://github.com/o/r/blob/sha/f%v"}`, i, i, i))
+		}
+		if page*perPage < total {
+			w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/o/r/check-runs/1/annotations?per_page=50&page=%v>; rel="next"`, page+1))
+		}
+		fmt.Fprintf(w, "[%v]", strings.Join(annotations, ","))
+	})
+
+	ctx := context.Background()
+	count := 0
+	err := client.Checks.ForEachCheckRunAnnotation(ctx, "o", "r", 1, &ListOptions{PerPage: perPage}, func(annotation *CheckRunAnnotation) error {
+		count++
+		if got, want := annotation.GetPath(), fmt.Sprintf("f%v", count); got != want {
+			t.Errorf("annotation %v Path = %q, want %q", count, got, want)
+		}
+		if got, want := annotation.GetRawDetails(), fmt.Sprintf("d%v", count); got != want {
+			t.Errorf("annotation %v RawDetails = %q, want %q", count, got, want)
+		}
+		if got, want := annotation.GetBlobHRef(), fmt.Sprintf("https://github.com/o/r/blob/sha/f%v", count); got != want {
+			t.Errorf("annotation %v BlobHRef = %q, want %q", count, got, want)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Checks.ForEachCheckRunAnnotation returned error: %v", err)
+	}
+	if count != total {
+		t.Errorf("Checks.ForEachCheckRunAnnotation visited %v annotations, want %v", count, total)
+	}
+	if requests != 3 {
+		t.Errorf("Checks.ForEachCheckRunAnnotation made %v requests, want 3", requests)
+	}
+}
+
+func TestChecksService_ForEachCheckRunAnnotation_stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/check-runs/1/annotations", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": "100"})
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/check-runs/1/annotations?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"path":"a"},{"path":"b"}]`)
+	})
+
+	ctx := context.Background()
+	stop := errors.New("stop")
+	var paths []string
+	err := client.Checks.ForEachCheckRunAnnotation(ctx, "o", "r", 1, nil, func(annotation *CheckRunAnnotation) error {
+		paths = append(paths, annotation.GetPath())
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("Checks.ForEachCheckRunAnnotation returned error %v, want %v", err, stop)
+	}
+	if want := []string{"a"}; !cmp.Equal(paths, want) {
+		t.Errorf("Checks.ForEachCheckRunAnnotation visited %v, want %v", paths, want)
+	}
+
+	const methodName = "ForEachCheckRunAnnotation"
+	testBadOptions(t, methodName, func() error {
+		return client.Checks.ForEachCheckRunAnnotation(ctx, "\n", "\n", -1, nil, func(*CheckRunAnnotation) error { return nil })
+	})
+}
+
 func TestChecksService_UpdateCheckRun(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -968,6 +1056,7 @@ func TestCheckRunAnnotation_Marshal(t *testing.T) {
 		Message:         String("m"),
 		Title:           String("t"),
 		RawDetails:      String("rd"),
+		BlobHRef:        String("bh"),
 	}
 
 	want := `{
@@ -979,7 +1068,8 @@ func TestCheckRunAnnotation_Marshal(t *testing.T) {
 		"annotation_level": "al",
 		"message": "m",
 		"title": "t",
-		"raw_details": "rd"
+		"raw_details": "rd",
+		"blob_href": "bh"
 	}`
 
 	testJSONMarshal(t, u, want)
@@ -241,7 +241,7 @@ type SarifID struct {
 //
 //meta:operation GET /orgs/{org}/code-scanning/alerts
 func (s *CodeScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *AlertListOptions) ([]*Alert, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/code-scanning/alerts", org)
+	u := fmt.Sprintf("orgs/%v/code-scanning/alerts", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -271,7 +271,7 @@ func (s *CodeScanningService) ListAlertsForOrg(ctx context.Context, org string,
 //
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/alerts
 func (s *CodeScanningService) ListAlertsForRepo(ctx context.Context, owner, repo string, opts *AlertListOptions) ([]*Alert, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -302,7 +302,7 @@ func (s *CodeScanningService) ListAlertsForRepo(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}
 func (s *CodeScanningService) GetAlert(ctx context.Context, owner, repo string, id int64) (*Alert, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -329,7 +329,7 @@ func (s *CodeScanningService) GetAlert(ctx context.Context, owner, repo string,
 //
 //meta:operation PATCH /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}
 func (s *CodeScanningService) UpdateAlert(ctx context.Context, owner, repo string, id int64, stateInfo *CodeScanningAlertState) (*Alert, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("PATCH", u, stateInfo)
 	if err != nil {
@@ -354,7 +354,7 @@ func (s *CodeScanningService) UpdateAlert(ctx context.Context, owner, repo strin
 //
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/instances
 func (s *CodeScanningService) ListAlertInstances(ctx context.Context, owner, repo string, id int64, opts *AlertInstancesListOptions) ([]*MostRecentInstance, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v/instances", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v/instances", PathEscape(owner), PathEscape(repo), id)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -384,7 +384,7 @@ func (s *CodeScanningService) ListAlertInstances(ctx context.Context, owner, rep
 //
 //meta:operation POST /repos/{owner}/{repo}/code-scanning/sarifs
 func (s *CodeScanningService) UploadSarif(ctx context.Context, owner, repo string, sarif *SarifAnalysis) (*SarifID, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, sarif)
 	if err != nil {
@@ -428,7 +428,7 @@ type SARIFUpload struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/sarifs/{sarif_id}
 func (s *CodeScanningService) GetSARIF(ctx context.Context, owner, repo, sarifID string) (*SARIFUpload, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs/%v", owner, repo, sarifID)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs/%v", PathEscape(owner), PathEscape(repo), PathEscape(sarifID))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -454,7 +454,7 @@ func (s *CodeScanningService) GetSARIF(ctx context.Context, owner, repo, sarifID
 //
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/analyses
 func (s *CodeScanningService) ListAnalysesForRepo(ctx context.Context, owner, repo string, opts *AnalysesListOptions) ([]*ScanningAnalysis, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -485,7 +485,7 @@ func (s *CodeScanningService) ListAnalysesForRepo(ctx context.Context, owner, re
 //
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}
 func (s *CodeScanningService) GetAnalysis(ctx context.Context, owner, repo string, id int64) (*ScanningAnalysis, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses/%v", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -520,7 +520,7 @@ type DeleteAnalysis struct {
 //
 //meta:operation DELETE /repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}
 func (s *CodeScanningService) DeleteAnalysis(ctx context.Context, owner, repo string, id int64) (*DeleteAnalysis, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses/%v", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -545,7 +545,7 @@ func (s *CodeScanningService) DeleteAnalysis(ctx context.Context, owner, repo st
 //
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/codeql/databases
 func (s *CodeScanningService) ListCodeQLDatabases(ctx context.Context, owner, repo string) ([]*CodeQLDatabase, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/codeql/databases", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/codeql/databases", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -570,7 +570,7 @@ func (s *CodeScanningService) ListCodeQLDatabases(ctx context.Context, owner, re
 //
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/codeql/databases/{language}
 func (s *CodeScanningService) GetCodeQLDatabase(ctx context.Context, owner, repo, language string) (*CodeQLDatabase, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/code-scanning/codeql/databases/%v", owner, repo, language)
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/codeql/databases/%v", PathEscape(owner), PathEscape(repo), PathEscape(language))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -604,7 +604,7 @@ type DefaultSetupConfiguration struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/code-scanning/default-setup
 func (s *CodeScanningService) GetDefaultSetupConfiguration(ctx context.Context, owner, repo string) (*DefaultSetupConfiguration, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/code-scanning/default-setup", owner, repo)
+	u := fmt.Sprintf("repos/%s/%s/code-scanning/default-setup", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -647,7 +647,7 @@ type UpdateDefaultSetupConfigurationResponse struct {
 //
 //meta:operation PATCH /repos/{owner}/{repo}/code-scanning/default-setup
 func (s *CodeScanningService) UpdateDefaultSetupConfiguration(ctx context.Context, owner, repo string, options *UpdateDefaultSetupConfigurationOptions) (*UpdateDefaultSetupConfigurationResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/code-scanning/default-setup", owner, repo)
+	u := fmt.Sprintf("repos/%s/%s/code-scanning/default-setup", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("PATCH", u, options)
 	if err != nil {
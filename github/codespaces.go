@@ -94,7 +94,7 @@ type ListCodespaces struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/codespaces
 func (s *CodespacesService) ListInRepo(ctx context.Context, owner, repo string, opts *ListOptions) (*ListCodespaces, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/codespaces", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/codespaces", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -180,7 +180,7 @@ type CreateCodespaceOptions struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/codespaces
 func (s *CodespacesService) CreateInRepo(ctx context.Context, owner, repo string, request *CreateCodespaceOptions) (*Codespace, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/codespaces", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/codespaces", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
@@ -205,7 +205,7 @@ func (s *CodespacesService) CreateInRepo(ctx context.Context, owner, repo string
 //
 //meta:operation POST /user/codespaces/{codespace_name}/start
 func (s *CodespacesService) Start(ctx context.Context, codespaceName string) (*Codespace, *Response, error) {
-	u := fmt.Sprintf("user/codespaces/%v/start", codespaceName)
+	u := fmt.Sprintf("user/codespaces/%v/start", PathEscape(codespaceName))
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
@@ -230,7 +230,7 @@ func (s *CodespacesService) Start(ctx context.Context, codespaceName string) (*C
 //
 //meta:operation POST /user/codespaces/{codespace_name}/stop
 func (s *CodespacesService) Stop(ctx context.Context, codespaceName string) (*Codespace, *Response, error) {
-	u := fmt.Sprintf("user/codespaces/%v/stop", codespaceName)
+	u := fmt.Sprintf("user/codespaces/%v/stop", PathEscape(codespaceName))
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
@@ -255,7 +255,7 @@ func (s *CodespacesService) Stop(ctx context.Context, codespaceName string) (*Co
 //
 //meta:operation DELETE /user/codespaces/{codespace_name}
 func (s *CodespacesService) Delete(ctx context.Context, codespaceName string) (*Response, error) {
-	u := fmt.Sprintf("user/codespaces/%v", codespaceName)
+	u := fmt.Sprintf("user/codespaces/%v", PathEscape(codespaceName))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
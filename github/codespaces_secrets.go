@@ -35,7 +35,7 @@ func (s *CodespacesService) ListUserSecrets(ctx context.Context, opts *ListOptio
 //
 //meta:operation GET /orgs/{org}/codespaces/secrets
 func (s *CodespacesService) ListOrgSecrets(ctx context.Context, org string, opts *ListOptions) (*Secrets, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/codespaces/secrets", org)
+	u := fmt.Sprintf("orgs/%v/codespaces/secrets", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -51,7 +51,7 @@ func (s *CodespacesService) ListOrgSecrets(ctx context.Context, org string, opts
 //
 //meta:operation GET /repos/{owner}/{repo}/codespaces/secrets
 func (s *CodespacesService) ListRepoSecrets(ctx context.Context, owner, repo string, opts *ListOptions) (*Secrets, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/codespaces/secrets", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/codespaces/secrets", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -95,7 +95,7 @@ func (s *CodespacesService) GetUserPublicKey(ctx context.Context) (*PublicKey, *
 //
 //meta:operation GET /orgs/{org}/codespaces/secrets/public-key
 func (s *CodespacesService) GetOrgPublicKey(ctx context.Context, org string) (*PublicKey, *Response, error) {
-	return s.getPublicKey(ctx, fmt.Sprintf("orgs/%v/codespaces/secrets/public-key", org))
+	return s.getPublicKey(ctx, fmt.Sprintf("orgs/%v/codespaces/secrets/public-key", PathEscape(org)))
 }
 
 // GetRepoPublicKey gets the repo public key for encrypting codespace secrets
@@ -106,7 +106,7 @@ func (s *CodespacesService) GetOrgPublicKey(ctx context.Context, org string) (*P
 //
 //meta:operation GET /repos/{owner}/{repo}/codespaces/secrets/public-key
 func (s *CodespacesService) GetRepoPublicKey(ctx context.Context, owner, repo string) (*PublicKey, *Response, error) {
-	return s.getPublicKey(ctx, fmt.Sprintf("repos/%v/%v/codespaces/secrets/public-key", owner, repo))
+	return s.getPublicKey(ctx, fmt.Sprintf("repos/%v/%v/codespaces/secrets/public-key", PathEscape(owner), PathEscape(repo)))
 }
 
 func (s *CodespacesService) getPublicKey(ctx context.Context, url string) (*PublicKey, *Response, error) {
@@ -134,7 +134,7 @@ func (s *CodespacesService) getPublicKey(ctx context.Context, url string) (*Publ
 //
 //meta:operation GET /user/codespaces/secrets/{secret_name}
 func (s *CodespacesService) GetUserSecret(ctx context.Context, name string) (*Secret, *Response, error) {
-	u := fmt.Sprintf("user/codespaces/secrets/%v", name)
+	u := fmt.Sprintf("user/codespaces/secrets/%v", PathEscape(name))
 	return s.getSecret(ctx, u)
 }
 
@@ -146,7 +146,7 @@ func (s *CodespacesService) GetUserSecret(ctx context.Context, name string) (*Se
 //
 //meta:operation GET /orgs/{org}/codespaces/secrets/{secret_name}
 func (s *CodespacesService) GetOrgSecret(ctx context.Context, org, name string) (*Secret, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v", org, name)
+	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v", PathEscape(org), PathEscape(name))
 	return s.getSecret(ctx, u)
 }
 
@@ -158,7 +158,7 @@ func (s *CodespacesService) GetOrgSecret(ctx context.Context, org, name string)
 //
 //meta:operation GET /repos/{owner}/{repo}/codespaces/secrets/{secret_name}
 func (s *CodespacesService) GetRepoSecret(ctx context.Context, owner, repo, name string) (*Secret, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/codespaces/secrets/%v", owner, repo, name)
+	u := fmt.Sprintf("repos/%v/%v/codespaces/secrets/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	return s.getSecret(ctx, u)
 }
 
@@ -187,7 +187,7 @@ func (s *CodespacesService) getSecret(ctx context.Context, url string) (*Secret,
 //
 //meta:operation PUT /user/codespaces/secrets/{secret_name}
 func (s *CodespacesService) CreateOrUpdateUserSecret(ctx context.Context, eSecret *EncryptedSecret) (*Response, error) {
-	u := fmt.Sprintf("user/codespaces/secrets/%v", eSecret.Name)
+	u := fmt.Sprintf("user/codespaces/secrets/%v", PathEscape(eSecret.Name))
 	return s.createOrUpdateSecret(ctx, u, eSecret)
 }
 
@@ -199,7 +199,7 @@ func (s *CodespacesService) CreateOrUpdateUserSecret(ctx context.Context, eSecre
 //
 //meta:operation PUT /orgs/{org}/codespaces/secrets/{secret_name}
 func (s *CodespacesService) CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *EncryptedSecret) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v", org, eSecret.Name)
+	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v", PathEscape(org), PathEscape(eSecret.Name))
 	return s.createOrUpdateSecret(ctx, u, eSecret)
 }
 
@@ -211,7 +211,7 @@ func (s *CodespacesService) CreateOrUpdateOrgSecret(ctx context.Context, org str
 //
 //meta:operation PUT /repos/{owner}/{repo}/codespaces/secrets/{secret_name}
 func (s *CodespacesService) CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *EncryptedSecret) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/codespaces/secrets/%v", owner, repo, eSecret.Name)
+	u := fmt.Sprintf("repos/%v/%v/codespaces/secrets/%v", PathEscape(owner), PathEscape(repo), PathEscape(eSecret.Name))
 	return s.createOrUpdateSecret(ctx, u, eSecret)
 }
 
@@ -239,7 +239,7 @@ func (s *CodespacesService) createOrUpdateSecret(ctx context.Context, url string
 //
 //meta:operation DELETE /user/codespaces/secrets/{secret_name}
 func (s *CodespacesService) DeleteUserSecret(ctx context.Context, name string) (*Response, error) {
-	u := fmt.Sprintf("user/codespaces/secrets/%v", name)
+	u := fmt.Sprintf("user/codespaces/secrets/%v", PathEscape(name))
 	return s.deleteSecret(ctx, u)
 }
 
@@ -251,7 +251,7 @@ func (s *CodespacesService) DeleteUserSecret(ctx context.Context, name string) (
 //
 //meta:operation DELETE /orgs/{org}/codespaces/secrets/{secret_name}
 func (s *CodespacesService) DeleteOrgSecret(ctx context.Context, org, name string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v", org, name)
+	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v", PathEscape(org), PathEscape(name))
 	return s.deleteSecret(ctx, u)
 }
 
@@ -263,7 +263,7 @@ func (s *CodespacesService) DeleteOrgSecret(ctx context.Context, org, name strin
 //
 //meta:operation DELETE /repos/{owner}/{repo}/codespaces/secrets/{secret_name}
 func (s *CodespacesService) DeleteRepoSecret(ctx context.Context, owner, repo, name string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/codespaces/secrets/%v", owner, repo, name)
+	u := fmt.Sprintf("repos/%v/%v/codespaces/secrets/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	return s.deleteSecret(ctx, u)
 }
 
@@ -290,7 +290,7 @@ func (s *CodespacesService) deleteSecret(ctx context.Context, url string) (*Resp
 //
 //meta:operation GET /user/codespaces/secrets/{secret_name}/repositories
 func (s *CodespacesService) ListSelectedReposForUserSecret(ctx context.Context, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
-	u := fmt.Sprintf("user/codespaces/secrets/%v/repositories", name)
+	u := fmt.Sprintf("user/codespaces/secrets/%v/repositories", PathEscape(name))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -307,7 +307,7 @@ func (s *CodespacesService) ListSelectedReposForUserSecret(ctx context.Context,
 //
 //meta:operation GET /orgs/{org}/codespaces/secrets/{secret_name}/repositories
 func (s *CodespacesService) ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories", org, name)
+	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories", PathEscape(org), PathEscape(name))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -340,7 +340,7 @@ func (s *CodespacesService) listSelectedReposForSecret(ctx context.Context, url
 //
 //meta:operation PUT /user/codespaces/secrets/{secret_name}/repositories
 func (s *CodespacesService) SetSelectedReposForUserSecret(ctx context.Context, name string, ids SelectedRepoIDs) (*Response, error) {
-	u := fmt.Sprintf("user/codespaces/secrets/%v/repositories", name)
+	u := fmt.Sprintf("user/codespaces/secrets/%v/repositories", PathEscape(name))
 	return s.setSelectedRepoForSecret(ctx, u, ids)
 }
 
@@ -352,7 +352,7 @@ func (s *CodespacesService) SetSelectedReposForUserSecret(ctx context.Context, n
 //
 //meta:operation PUT /orgs/{org}/codespaces/secrets/{secret_name}/repositories
 func (s *CodespacesService) SetSelectedReposForOrgSecret(ctx context.Context, org, name string, ids SelectedRepoIDs) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories", org, name)
+	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories", PathEscape(org), PathEscape(name))
 	return s.setSelectedRepoForSecret(ctx, u, ids)
 }
 
@@ -382,7 +382,7 @@ func (s *CodespacesService) setSelectedRepoForSecret(ctx context.Context, url st
 //
 //meta:operation PUT /user/codespaces/secrets/{secret_name}/repositories/{repository_id}
 func (s *CodespacesService) AddSelectedRepoToUserSecret(ctx context.Context, name string, repo *Repository) (*Response, error) {
-	u := fmt.Sprintf("user/codespaces/secrets/%v/repositories/%v", name, *repo.ID)
+	u := fmt.Sprintf("user/codespaces/secrets/%v/repositories/%v", PathEscape(name), *repo.ID)
 	return s.addSelectedRepoToSecret(ctx, u)
 }
 
@@ -394,7 +394,7 @@ func (s *CodespacesService) AddSelectedRepoToUserSecret(ctx context.Context, nam
 //
 //meta:operation PUT /orgs/{org}/codespaces/secrets/{secret_name}/repositories/{repository_id}
 func (s *CodespacesService) AddSelectedRepoToOrgSecret(ctx context.Context, org, name string, repo *Repository) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories/%v", org, name, *repo.ID)
+	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories/%v", PathEscape(org), PathEscape(name), *repo.ID)
 	return s.addSelectedRepoToSecret(ctx, u)
 }
 
@@ -420,7 +420,7 @@ func (s *CodespacesService) addSelectedRepoToSecret(ctx context.Context, url str
 //
 //meta:operation DELETE /user/codespaces/secrets/{secret_name}/repositories/{repository_id}
 func (s *CodespacesService) RemoveSelectedRepoFromUserSecret(ctx context.Context, name string, repo *Repository) (*Response, error) {
-	u := fmt.Sprintf("user/codespaces/secrets/%v/repositories/%v", name, *repo.ID)
+	u := fmt.Sprintf("user/codespaces/secrets/%v/repositories/%v", PathEscape(name), *repo.ID)
 	return s.removeSelectedRepoFromSecret(ctx, u)
 }
 
@@ -432,7 +432,7 @@ func (s *CodespacesService) RemoveSelectedRepoFromUserSecret(ctx context.Context
 //
 //meta:operation DELETE /orgs/{org}/codespaces/secrets/{secret_name}/repositories/{repository_id}
 func (s *CodespacesService) RemoveSelectedRepoFromOrgSecret(ctx context.Context, org, name string, repo *Repository) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories/%v", org, name, *repo.ID)
+	u := fmt.Sprintf("orgs/%v/codespaces/secrets/%v/repositories/%v", PathEscape(org), PathEscape(name), *repo.ID)
 	return s.removeSelectedRepoFromSecret(ctx, u)
 }
 
@@ -0,0 +1,141 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// CommentSubjectType is a type of comment that can be minimized with
+// Client.MinimizeComment.
+type CommentSubjectType string
+
+// The types of comments that can be minimized.
+const (
+	CommentSubjectCommitComment            CommentSubjectType = "commit_comment"
+	CommentSubjectIssueComment             CommentSubjectType = "issue_comment"
+	CommentSubjectPullRequestReviewComment CommentSubjectType = "pull_request_review_comment"
+	CommentSubjectGistComment              CommentSubjectType = "gist_comment"
+	CommentSubjectDiscussionComment        CommentSubjectType = "discussion_comment"
+)
+
+func (t CommentSubjectType) valid() bool {
+	switch t {
+	case CommentSubjectCommitComment, CommentSubjectIssueComment, CommentSubjectPullRequestReviewComment,
+		CommentSubjectGistComment, CommentSubjectDiscussionComment:
+		return true
+	}
+	return false
+}
+
+// CommentClassifier is the reason a comment is minimized for.
+type CommentClassifier string
+
+// The reasons comments can be minimized for.
+const (
+	CommentClassifierSpam      CommentClassifier = "SPAM"
+	CommentClassifierAbuse     CommentClassifier = "ABUSE"
+	CommentClassifierOffTopic  CommentClassifier = "OFF_TOPIC"
+	CommentClassifierOutdated  CommentClassifier = "OUTDATED"
+	CommentClassifierDuplicate CommentClassifier = "DUPLICATE"
+	CommentClassifierResolved  CommentClassifier = "RESOLVED"
+)
+
+func (c CommentClassifier) valid() bool {
+	switch c {
+	case CommentClassifierSpam, CommentClassifierAbuse, CommentClassifierOffTopic,
+		CommentClassifierOutdated, CommentClassifierDuplicate, CommentClassifierResolved:
+		return true
+	}
+	return false
+}
+
+// ErrInvalidCommentMinimization is returned by Client.MinimizeComment and
+// Client.UnminimizeComment, before any request is made, for an unknown
+// subject type or classifier, or an empty node ID.
+var ErrInvalidCommentMinimization = errors.New("invalid comment minimization")
+
+// MinimizedComment is the state of a comment after Client.MinimizeComment or
+// Client.UnminimizeComment.
+type MinimizedComment struct {
+	IsMinimized bool `json:"isMinimized"`
+	// MinimizedReason is the lower case classifier the comment is minimized
+	// with, such as "spam", or empty if it is not minimized.
+	MinimizedReason string `json:"minimizedReason"`
+}
+
+const minimizeCommentMutation = `mutation($input: MinimizeCommentInput!) {
+  minimizeComment(input: $input) { minimizedComment { isMinimized minimizedReason } }
+}`
+
+const unminimizeCommentMutation = `mutation($input: UnminimizeCommentInput!) {
+  unminimizeComment(input: $input) { unminimizedComment { isMinimized minimizedReason } }
+}`
+
+func validateCommentSubject(subjectType CommentSubjectType, nodeID string) error {
+	if !subjectType.valid() {
+		return fmt.Errorf("%w: unknown comment subject type %q", ErrInvalidCommentMinimization, subjectType)
+	}
+	if nodeID == "" {
+		return fmt.Errorf("%w: empty node ID", ErrInvalidCommentMinimization)
+	}
+	return nil
+}
+
+// MinimizeComment hides the comment of the given type with the given node
+// ID, such as IssueComment.NodeID, for the given reason. The REST API has no
+// endpoint to minimize any type of comment, so all of them are minimized
+// through the GraphQL API; subjectType is only validated. It requires the
+// same permissions as hiding the comment on GitHub.
+//
+// GitHub API docs: https://docs.github.com/graphql/reference/mutations#minimizecomment
+func (c *Client) MinimizeComment(ctx context.Context, subjectType CommentSubjectType, nodeID string, classifier CommentClassifier) (*MinimizedComment, *Response, error) {
+	if err := validateCommentSubject(subjectType, nodeID); err != nil {
+		return nil, nil, err
+	}
+	if !classifier.valid() {
+		return nil, nil, fmt.Errorf("%w: unknown classifier %q", ErrInvalidCommentMinimization, classifier)
+	}
+
+	var result struct {
+		MinimizeComment struct {
+			MinimizedComment *MinimizedComment `json:"minimizedComment"`
+		} `json:"minimizeComment"`
+	}
+	input := map[string]interface{}{"subjectId": nodeID, "classifier": classifier}
+	resp, err := c.GraphQL(ctx, minimizeCommentMutation, map[string]interface{}{"input": input}, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.MinimizeComment.MinimizedComment, resp, nil
+}
+
+// UnminimizeComment shows again the comment of the given type with the given
+// node ID, hidden by MinimizeComment. Like MinimizeComment, it uses the
+// GraphQL API for all types of comments.
+//
+// GitHub API docs: https://docs.github.com/graphql/reference/mutations#unminimizecomment
+func (c *Client) UnminimizeComment(ctx context.Context, subjectType CommentSubjectType, nodeID string) (*MinimizedComment, *Response, error) {
+	if err := validateCommentSubject(subjectType, nodeID); err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		UnminimizeComment struct {
+			UnminimizedComment *MinimizedComment `json:"unminimizedComment"`
+		} `json:"unminimizeComment"`
+	}
+	input := map[string]interface{}{"subjectId": nodeID}
+	resp, err := c.GraphQL(ctx, unminimizeCommentMutation, map[string]interface{}{"input": input}, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.UnminimizeComment.UnminimizedComment, resp, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testGraphQLRequest checks that r is a GraphQL request whose query contains
+// operation and whose variables are wantVariables.
+func testGraphQLRequest(t *testing.T, r *http.Request, operation string, wantVariables map[string]interface{}) {
+	t.Helper()
+	testMethod(t, r, "POST")
+
+	var body struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding the GraphQL request body returned error: %v", err)
+	}
+	if !strings.Contains(body.Query, operation) {
+		t.Errorf("GraphQL query is %q, want it to contain %q", body.Query, operation)
+	}
+	if !cmp.Equal(body.Variables, wantVariables) {
+		t.Errorf("GraphQL variables are %v, want %v", body.Variables, wantVariables)
+	}
+}
+
+func TestClient_MinimizeComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "minimizeComment(input: $input)", map[string]interface{}{
+			"input": map[string]interface{}{"subjectId": "IC_1", "classifier": "OFF_TOPIC"},
+		})
+		fmt.Fprint(w, `{"data":{"minimizeComment":{"minimizedComment":{"isMinimized":true,"minimizedReason":"off-topic"}}}}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.MinimizeComment(ctx, CommentSubjectIssueComment, "IC_1", CommentClassifierOffTopic)
+	if err != nil {
+		t.Errorf("MinimizeComment returned error: %v", err)
+	}
+	want := &MinimizedComment{IsMinimized: true, MinimizedReason: "off-topic"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("MinimizeComment returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "MinimizeComment"
+	testNewRequestAndDoFailureCategory(t, methodName, client, GraphqlCategory, func() (*Response, error) {
+		got, resp, err := client.MinimizeComment(ctx, CommentSubjectIssueComment, "IC_1", CommentClassifierOffTopic)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestClient_UnminimizeComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "unminimizeComment(input: $input)", map[string]interface{}{
+			"input": map[string]interface{}{"subjectId": "PRRC_1"},
+		})
+		fmt.Fprint(w, `{"data":{"unminimizeComment":{"unminimizedComment":{"isMinimized":false,"minimizedReason":null}}}}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.UnminimizeComment(ctx, CommentSubjectPullRequestReviewComment, "PRRC_1")
+	if err != nil {
+		t.Errorf("UnminimizeComment returned error: %v", err)
+	}
+	want := &MinimizedComment{}
+	if !cmp.Equal(got, want) {
+		t.Errorf("UnminimizeComment returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "UnminimizeComment"
+	testNewRequestAndDoFailureCategory(t, methodName, client, GraphqlCategory, func() (*Response, error) {
+		got, resp, err := client.UnminimizeComment(ctx, CommentSubjectPullRequestReviewComment, "PRRC_1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestClient_MinimizeComment_graphQLError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"minimizeComment":null},"errors":[{"type":"FORBIDDEN","message":"Resource not accessible by integration"}]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.MinimizeComment(ctx, CommentSubjectCommitComment, "CC_1", CommentClassifierSpam)
+	var gqlErr *GraphQLErrors
+	if !errors.As(err, &gqlErr) {
+		t.Errorf("MinimizeComment returned error %v, want *GraphQLErrors", err)
+	}
+	if got != nil {
+		t.Errorf("MinimizeComment returned %+v, want nil", got)
+	}
+}
+
+func TestClient_MinimizeComment_invalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("MinimizeComment sent a request for invalid arguments")
+	})
+
+	tests := map[string]struct {
+		subjectType CommentSubjectType
+		nodeID      string
+		classifier  CommentClassifier
+	}{
+		"unknown subject type": {"review", "IC_1", CommentClassifierSpam},
+		"empty node ID":        {CommentSubjectIssueComment, "", CommentClassifierSpam},
+		"unknown classifier":   {CommentSubjectIssueComment, "IC_1", "RUDE"},
+		"lower case":           {CommentSubjectIssueComment, "IC_1", "spam"},
+		"empty classifier":     {CommentSubjectGistComment, "GC_1", ""},
+	}
+
+	ctx := context.Background()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, resp, err := client.MinimizeComment(ctx, tc.subjectType, tc.nodeID, tc.classifier)
+			if !errors.Is(err, ErrInvalidCommentMinimization) {
+				t.Errorf("MinimizeComment returned error %v, want ErrInvalidCommentMinimization", err)
+			}
+			if resp != nil {
+				t.Errorf("MinimizeComment returned response %v, want nil", resp)
+			}
+		})
+	}
+
+	if _, _, err := client.UnminimizeComment(ctx, CommentSubjectDiscussionComment, ""); !errors.Is(err, ErrInvalidCommentMinimization) {
+		t.Errorf("UnminimizeComment returned error %v, want ErrInvalidCommentMinimization", err)
+	}
+}
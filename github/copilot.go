@@ -136,7 +136,7 @@ func (cp *CopilotSeatDetails) GetOrganization() (*Organization, bool) {
 //
 //meta:operation GET /orgs/{org}/copilot/billing
 func (s *CopilotService) GetCopilotBilling(ctx context.Context, org string) (*CopilotOrganizationDetails, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/copilot/billing", org)
+	u := fmt.Sprintf("orgs/%v/copilot/billing", PathEscape(org))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -160,7 +160,7 @@ func (s *CopilotService) GetCopilotBilling(ctx context.Context, org string) (*Co
 //
 //meta:operation GET /orgs/{org}/copilot/billing/seats
 func (s *CopilotService) ListCopilotSeats(ctx context.Context, org string, opts *ListOptions) (*ListCopilotSeatsResponse, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/copilot/billing/seats", org)
+	u := fmt.Sprintf("orgs/%v/copilot/billing/seats", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -186,7 +186,7 @@ func (s *CopilotService) ListCopilotSeats(ctx context.Context, org string, opts
 //
 //meta:operation POST /orgs/{org}/copilot/billing/selected_teams
 func (s *CopilotService) AddCopilotTeams(ctx context.Context, org string, teamNames []string) (*SeatAssignments, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_teams", org)
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_teams", PathEscape(org))
 
 	body := struct {
 		SelectedTeams []string `json:"selected_teams"`
@@ -214,7 +214,7 @@ func (s *CopilotService) AddCopilotTeams(ctx context.Context, org string, teamNa
 //
 //meta:operation DELETE /orgs/{org}/copilot/billing/selected_teams
 func (s *CopilotService) RemoveCopilotTeams(ctx context.Context, org string, teamNames []string) (*SeatCancellations, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_teams", org)
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_teams", PathEscape(org))
 
 	body := struct {
 		SelectedTeams []string `json:"selected_teams"`
@@ -242,7 +242,7 @@ func (s *CopilotService) RemoveCopilotTeams(ctx context.Context, org string, tea
 //
 //meta:operation POST /orgs/{org}/copilot/billing/selected_users
 func (s *CopilotService) AddCopilotUsers(ctx context.Context, org string, users []string) (*SeatAssignments, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_users", org)
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_users", PathEscape(org))
 
 	body := struct {
 		SelectedUsernames []string `json:"selected_usernames"`
@@ -270,7 +270,7 @@ func (s *CopilotService) AddCopilotUsers(ctx context.Context, org string, users
 //
 //meta:operation DELETE /orgs/{org}/copilot/billing/selected_users
 func (s *CopilotService) RemoveCopilotUsers(ctx context.Context, org string, users []string) (*SeatCancellations, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_users", org)
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_users", PathEscape(org))
 
 	body := struct {
 		SelectedUsernames []string `json:"selected_usernames"`
@@ -298,7 +298,7 @@ func (s *CopilotService) RemoveCopilotUsers(ctx context.Context, org string, use
 //
 //meta:operation GET /orgs/{org}/members/{username}/copilot
 func (s *CopilotService) GetSeatDetails(ctx context.Context, org, user string) (*CopilotSeatDetails, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/members/%v/copilot", org, user)
+	u := fmt.Sprintf("orgs/%v/members/%v/copilot", PathEscape(org), PathEscape(user))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
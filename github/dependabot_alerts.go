@@ -23,6 +23,13 @@ type AdvisoryCVSS struct {
 	VectorString *string  `json:"vector_string,omitempty"`
 }
 
+// AdvisoryCVSSSeverities represents the CVSS scores of an advisory in each
+// version of the Common Vulnerability Scoring System.
+type AdvisoryCVSSSeverities struct {
+	CVSSV3 *AdvisoryCVSS `json:"cvss_v3,omitempty"`
+	CVSSV4 *AdvisoryCVSS `json:"cvss_v4,omitempty"`
+}
+
 // AdvisoryCWEs represent the advisory pertaining to Common Weakness Enumeration.
 type AdvisoryCWEs struct {
 	CWEID *string `json:"cwe_id,omitempty"`
@@ -119,7 +126,7 @@ func (s *DependabotService) listAlerts(ctx context.Context, url string, opts *Li
 //
 //meta:operation GET /repos/{owner}/{repo}/dependabot/alerts
 func (s *DependabotService) ListRepoAlerts(ctx context.Context, owner, repo string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/dependabot/alerts", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/dependabot/alerts", PathEscape(owner), PathEscape(repo))
 	return s.listAlerts(ctx, url, opts)
 }
 
@@ -129,7 +136,7 @@ func (s *DependabotService) ListRepoAlerts(ctx context.Context, owner, repo stri
 //
 //meta:operation GET /orgs/{org}/dependabot/alerts
 func (s *DependabotService) ListOrgAlerts(ctx context.Context, org string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/dependabot/alerts", org)
+	url := fmt.Sprintf("orgs/%v/dependabot/alerts", PathEscape(org))
 	return s.listAlerts(ctx, url, opts)
 }
 
@@ -139,7 +146,7 @@ func (s *DependabotService) ListOrgAlerts(ctx context.Context, org string, opts
 //
 //meta:operation GET /repos/{owner}/{repo}/dependabot/alerts/{alert_number}
 func (s *DependabotService) GetRepoAlert(ctx context.Context, owner, repo string, number int) (*DependabotAlert, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+	url := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, nil, err
@@ -160,7 +167,7 @@ func (s *DependabotService) GetRepoAlert(ctx context.Context, owner, repo string
 //
 //meta:operation PATCH /repos/{owner}/{repo}/dependabot/alerts/{alert_number}
 func (s *DependabotService) UpdateAlert(ctx context.Context, owner, repo string, number int, stateInfo *DependabotAlertState) (*DependabotAlert, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+	url := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("PATCH", url, stateInfo)
 	if err != nil {
 		return nil, nil, err
@@ -31,7 +31,7 @@ func (s *DependabotService) getPublicKey(ctx context.Context, url string) (*Publ
 //
 //meta:operation GET /repos/{owner}/{repo}/dependabot/secrets/public-key
 func (s *DependabotService) GetRepoPublicKey(ctx context.Context, owner, repo string) (*PublicKey, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets/public-key", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets/public-key", PathEscape(owner), PathEscape(repo))
 	return s.getPublicKey(ctx, url)
 }
 
@@ -41,7 +41,7 @@ func (s *DependabotService) GetRepoPublicKey(ctx context.Context, owner, repo st
 //
 //meta:operation GET /orgs/{org}/dependabot/secrets/public-key
 func (s *DependabotService) GetOrgPublicKey(ctx context.Context, org string) (*PublicKey, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/dependabot/secrets/public-key", org)
+	url := fmt.Sprintf("orgs/%v/dependabot/secrets/public-key", PathEscape(org))
 	return s.getPublicKey(ctx, url)
 }
 
@@ -72,7 +72,7 @@ func (s *DependabotService) listSecrets(ctx context.Context, url string, opts *L
 //
 //meta:operation GET /repos/{owner}/{repo}/dependabot/secrets
 func (s *DependabotService) ListRepoSecrets(ctx context.Context, owner, repo string, opts *ListOptions) (*Secrets, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets", PathEscape(owner), PathEscape(repo))
 	return s.listSecrets(ctx, url, opts)
 }
 
@@ -83,7 +83,7 @@ func (s *DependabotService) ListRepoSecrets(ctx context.Context, owner, repo str
 //
 //meta:operation GET /orgs/{org}/dependabot/secrets
 func (s *DependabotService) ListOrgSecrets(ctx context.Context, org string, opts *ListOptions) (*Secrets, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/dependabot/secrets", org)
+	url := fmt.Sprintf("orgs/%v/dependabot/secrets", PathEscape(org))
 	return s.listSecrets(ctx, url, opts)
 }
 
@@ -108,7 +108,7 @@ func (s *DependabotService) getSecret(ctx context.Context, url string) (*Secret,
 //
 //meta:operation GET /repos/{owner}/{repo}/dependabot/secrets/{secret_name}
 func (s *DependabotService) GetRepoSecret(ctx context.Context, owner, repo, name string) (*Secret, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets/%v", owner, repo, name)
+	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	return s.getSecret(ctx, url)
 }
 
@@ -118,7 +118,7 @@ func (s *DependabotService) GetRepoSecret(ctx context.Context, owner, repo, name
 //
 //meta:operation GET /orgs/{org}/dependabot/secrets/{secret_name}
 func (s *DependabotService) GetOrgSecret(ctx context.Context, org, name string) (*Secret, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v", org, name)
+	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v", PathEscape(org), PathEscape(name))
 	return s.getSecret(ctx, url)
 }
 
@@ -150,7 +150,7 @@ func (s *DependabotService) putSecret(ctx context.Context, url string, eSecret *
 //
 //meta:operation PUT /repos/{owner}/{repo}/dependabot/secrets/{secret_name}
 func (s *DependabotService) CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *DependabotEncryptedSecret) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets/%v", owner, repo, eSecret.Name)
+	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets/%v", PathEscape(owner), PathEscape(repo), PathEscape(eSecret.Name))
 	return s.putSecret(ctx, url, eSecret)
 }
 
@@ -172,7 +172,7 @@ func (s *DependabotService) CreateOrUpdateOrgSecret(ctx context.Context, org str
 		SelectedRepositoryIDs:     repoIDs,
 	}
 
-	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v", org, eSecret.Name)
+	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v", PathEscape(org), PathEscape(eSecret.Name))
 	req, err := s.client.NewRequest("PUT", url, params)
 	if err != nil {
 		return nil, err
@@ -196,7 +196,7 @@ func (s *DependabotService) deleteSecret(ctx context.Context, url string) (*Resp
 //
 //meta:operation DELETE /repos/{owner}/{repo}/dependabot/secrets/{secret_name}
 func (s *DependabotService) DeleteRepoSecret(ctx context.Context, owner, repo, name string) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets/%v", owner, repo, name)
+	url := fmt.Sprintf("repos/%v/%v/dependabot/secrets/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	return s.deleteSecret(ctx, url)
 }
 
@@ -206,7 +206,7 @@ func (s *DependabotService) DeleteRepoSecret(ctx context.Context, owner, repo, n
 //
 //meta:operation DELETE /orgs/{org}/dependabot/secrets/{secret_name}
 func (s *DependabotService) DeleteOrgSecret(ctx context.Context, org, name string) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v", org, name)
+	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v", PathEscape(org), PathEscape(name))
 	return s.deleteSecret(ctx, url)
 }
 
@@ -216,7 +216,7 @@ func (s *DependabotService) DeleteOrgSecret(ctx context.Context, org, name strin
 //
 //meta:operation GET /orgs/{org}/dependabot/secrets/{secret_name}/repositories
 func (s *DependabotService) ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opts *ListOptions) (*SelectedReposList, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v/repositories", org, name)
+	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v/repositories", PathEscape(org), PathEscape(name))
 	u, err := addOptions(url, opts)
 	if err != nil {
 		return nil, nil, err
@@ -245,7 +245,7 @@ type DependabotSecretsSelectedRepoIDs []int64
 //
 //meta:operation PUT /orgs/{org}/dependabot/secrets/{secret_name}/repositories
 func (s *DependabotService) SetSelectedReposForOrgSecret(ctx context.Context, org, name string, ids DependabotSecretsSelectedRepoIDs) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v/repositories", org, name)
+	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v/repositories", PathEscape(org), PathEscape(name))
 	type repoIDs struct {
 		SelectedIDs DependabotSecretsSelectedRepoIDs `json:"selected_repository_ids"`
 	}
@@ -264,7 +264,7 @@ func (s *DependabotService) SetSelectedReposForOrgSecret(ctx context.Context, or
 //
 //meta:operation PUT /orgs/{org}/dependabot/secrets/{secret_name}/repositories/{repository_id}
 func (s *DependabotService) AddSelectedRepoToOrgSecret(ctx context.Context, org, name string, repo *Repository) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v/repositories/%v", org, name, *repo.ID)
+	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v/repositories/%v", PathEscape(org), PathEscape(name), *repo.ID)
 	req, err := s.client.NewRequest("PUT", url, nil)
 	if err != nil {
 		return nil, err
@@ -279,7 +279,7 @@ func (s *DependabotService) AddSelectedRepoToOrgSecret(ctx context.Context, org,
 //
 //meta:operation DELETE /orgs/{org}/dependabot/secrets/{secret_name}/repositories/{repository_id}
 func (s *DependabotService) RemoveSelectedRepoFromOrgSecret(ctx context.Context, org, name string, repo *Repository) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v/repositories/%v", org, name, *repo.ID)
+	url := fmt.Sprintf("orgs/%v/dependabot/secrets/%v/repositories/%v", PathEscape(org), PathEscape(name), *repo.ID)
 	req, err := s.client.NewRequest("DELETE", url, nil)
 	if err != nil {
 		return nil, err
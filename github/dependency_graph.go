@@ -65,7 +65,7 @@ func (s SBOM) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/dependency-graph/sbom
 func (s *DependencyGraphService) GetSBOM(ctx context.Context, owner, repo string) (*SBOM, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/dependency-graph/sbom", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/sbom", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
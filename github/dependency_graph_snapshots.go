@@ -96,7 +96,7 @@ type DependencyGraphSnapshotCreationData struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/dependency-graph/snapshots
 func (s *DependencyGraphService) CreateSnapshot(ctx context.Context, owner, repo string, dependencyGraphSnapshot *DependencyGraphSnapshot) (*DependencyGraphSnapshotCreationData, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/dependency-graph/snapshots", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/dependency-graph/snapshots", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", url, dependencyGraphSnapshot)
 	if err != nil {
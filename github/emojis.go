@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"sort"
 )
 
 // EmojisService provides access to emoji-related functions in the GitHub API.
@@ -32,6 +33,20 @@ func (s *EmojisService) List(ctx context.Context) (map[string]string, *Response,
 	return emoji, resp, nil
 }
 
+// EmojiShortcodes inverts the map of emoji names to image URLs returned by
+// EmojisService.List, for looking up emojis by URL. Each URL maps to the
+// sorted names of the emojis it renders, as some emojis have aliases.
+func EmojiShortcodes(emojis map[string]string) map[string][]string {
+	shortcodes := make(map[string][]string, len(emojis))
+	for name, url := range emojis {
+		shortcodes[url] = append(shortcodes[url], name)
+	}
+	for _, names := range shortcodes {
+		sort.Strings(names)
+	}
+	return shortcodes
+}
+
 // ListEmojis returns the emojis available to use on GitHub.
 //
 // Deprecated: Use EmojisService.List instead
@@ -43,3 +43,56 @@ func TestEmojisService_List(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestEmojisService_List_conditionalRequestCache(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	cache := &conditionalCache{}
+	client.client.Transport = cache
+
+	const etag = `"abc"`
+	mux.HandleFunc("/emojis", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		fmt.Fprint(w, `{"+1": "+1.png"}`)
+	})
+
+	ctx := context.Background()
+	want := map[string]string{"+1": "+1.png"}
+	for i := 0; i < 2; i++ {
+		emoji, _, err := client.Emojis.List(ctx)
+		if err != nil {
+			t.Fatalf("Emojis.List returned error: %v", err)
+		}
+		if !cmp.Equal(emoji, want) {
+			t.Errorf("Emojis.List returned %+v, want %+v", emoji, want)
+		}
+	}
+	if cache.hits != 1 {
+		t.Errorf("cache served %v responses, want 1", cache.hits)
+	}
+}
+
+func TestEmojiShortcodes(t *testing.T) {
+	emojis := map[string]string{
+		"+1":       "https://github.githubassets.com/images/icons/emoji/unicode/1f44d.png",
+		"thumbsup": "https://github.githubassets.com/images/icons/emoji/unicode/1f44d.png",
+		"octocat":  "https://github.githubassets.com/images/icons/emoji/octocat.png",
+	}
+
+	want := map[string][]string{
+		"https://github.githubassets.com/images/icons/emoji/unicode/1f44d.png": {"+1", "thumbsup"},
+		"https://github.githubassets.com/images/icons/emoji/octocat.png":       {"octocat"},
+	}
+	if got := EmojiShortcodes(emojis); !cmp.Equal(got, want) {
+		t.Errorf("EmojiShortcodes returned %+v, want %+v", got, want)
+	}
+
+	if got := EmojiShortcodes(nil); len(got) != 0 {
+		t.Errorf("EmojiShortcodes(nil) returned %+v, want empty", got)
+	}
+}
@@ -83,7 +83,7 @@ type ListEnterpriseRunnerGroupOptions struct {
 //
 //meta:operation GET /enterprises/{enterprise}/actions/runner-groups
 func (s *EnterpriseService) ListRunnerGroups(ctx context.Context, enterprise string, opts *ListEnterpriseRunnerGroupOptions) (*EnterpriseRunnerGroups, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups", PathEscape(enterprise))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -109,7 +109,7 @@ func (s *EnterpriseService) ListRunnerGroups(ctx context.Context, enterprise str
 //
 //meta:operation GET /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}
 func (s *EnterpriseService) GetEnterpriseRunnerGroup(ctx context.Context, enterprise string, groupID int64) (*EnterpriseRunnerGroup, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v", enterprise, groupID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v", PathEscape(enterprise), groupID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -130,7 +130,7 @@ func (s *EnterpriseService) GetEnterpriseRunnerGroup(ctx context.Context, enterp
 //
 //meta:operation DELETE /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}
 func (s *EnterpriseService) DeleteEnterpriseRunnerGroup(ctx context.Context, enterprise string, groupID int64) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v", enterprise, groupID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v", PathEscape(enterprise), groupID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -146,7 +146,7 @@ func (s *EnterpriseService) DeleteEnterpriseRunnerGroup(ctx context.Context, ent
 //
 //meta:operation POST /enterprises/{enterprise}/actions/runner-groups
 func (s *EnterpriseService) CreateEnterpriseRunnerGroup(ctx context.Context, enterprise string, createReq CreateEnterpriseRunnerGroupRequest) (*EnterpriseRunnerGroup, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups", PathEscape(enterprise))
 	req, err := s.client.NewRequest("POST", u, createReq)
 	if err != nil {
 		return nil, nil, err
@@ -167,7 +167,7 @@ func (s *EnterpriseService) CreateEnterpriseRunnerGroup(ctx context.Context, ent
 //
 //meta:operation PATCH /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}
 func (s *EnterpriseService) UpdateEnterpriseRunnerGroup(ctx context.Context, enterprise string, groupID int64, updateReq UpdateEnterpriseRunnerGroupRequest) (*EnterpriseRunnerGroup, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v", enterprise, groupID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v", PathEscape(enterprise), groupID)
 	req, err := s.client.NewRequest("PATCH", u, updateReq)
 	if err != nil {
 		return nil, nil, err
@@ -188,7 +188,7 @@ func (s *EnterpriseService) UpdateEnterpriseRunnerGroup(ctx context.Context, ent
 //
 //meta:operation GET /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations
 func (s *EnterpriseService) ListOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID int64, opts *ListOptions) (*ListOrganizations, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/organizations", enterprise, groupID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/organizations", PathEscape(enterprise), groupID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -215,7 +215,7 @@ func (s *EnterpriseService) ListOrganizationAccessRunnerGroup(ctx context.Contex
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations
 func (s *EnterpriseService) SetOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID int64, ids SetOrgAccessRunnerGroupRequest) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/organizations", enterprise, groupID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/organizations", PathEscape(enterprise), groupID)
 
 	req, err := s.client.NewRequest("PUT", u, ids)
 	if err != nil {
@@ -232,7 +232,7 @@ func (s *EnterpriseService) SetOrganizationAccessRunnerGroup(ctx context.Context
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations/{org_id}
 func (s *EnterpriseService) AddOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID, orgID int64) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/organizations/%v", enterprise, groupID, orgID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/organizations/%v", PathEscape(enterprise), groupID, orgID)
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -249,7 +249,7 @@ func (s *EnterpriseService) AddOrganizationAccessRunnerGroup(ctx context.Context
 //
 //meta:operation DELETE /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations/{org_id}
 func (s *EnterpriseService) RemoveOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID, orgID int64) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/organizations/%v", enterprise, groupID, orgID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/organizations/%v", PathEscape(enterprise), groupID, orgID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -265,7 +265,7 @@ func (s *EnterpriseService) RemoveOrganizationAccessRunnerGroup(ctx context.Cont
 //
 //meta:operation GET /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners
 func (s *EnterpriseService) ListRunnerGroupRunners(ctx context.Context, enterprise string, groupID int64, opts *ListOptions) (*Runners, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/runners", enterprise, groupID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/runners", PathEscape(enterprise), groupID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -292,7 +292,7 @@ func (s *EnterpriseService) ListRunnerGroupRunners(ctx context.Context, enterpri
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners
 func (s *EnterpriseService) SetRunnerGroupRunners(ctx context.Context, enterprise string, groupID int64, ids SetRunnerGroupRunnersRequest) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/runners", enterprise, groupID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/runners", PathEscape(enterprise), groupID)
 
 	req, err := s.client.NewRequest("PUT", u, ids)
 	if err != nil {
@@ -308,7 +308,7 @@ func (s *EnterpriseService) SetRunnerGroupRunners(ctx context.Context, enterpris
 //
 //meta:operation PUT /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners/{runner_id}
 func (s *EnterpriseService) AddRunnerGroupRunners(ctx context.Context, enterprise string, groupID, runnerID int64) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/runners/%v", enterprise, groupID, runnerID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/runners/%v", PathEscape(enterprise), groupID, runnerID)
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -325,7 +325,7 @@ func (s *EnterpriseService) AddRunnerGroupRunners(ctx context.Context, enterpris
 //
 //meta:operation DELETE /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners/{runner_id}
 func (s *EnterpriseService) RemoveRunnerGroupRunners(ctx context.Context, enterprise string, groupID, runnerID int64) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/runners/%v", enterprise, groupID, runnerID)
+	u := fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/runners/%v", PathEscape(enterprise), groupID, runnerID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -406,6 +407,71 @@ func TestEnterpriseService_RemoveOrganizationAccessRunnerGroup(t *testing.T) {
 	})
 }
 
+func TestEnterpriseService_RunnerGroupSelectedVisibilityFlow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var selected []int64
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"visibility":"selected"}`+"\n")
+		fmt.Fprint(w, `{"id":2,"name":"pool","visibility":"selected","selected_organizations_url":"https://api.github.com/enterprises/e/actions/runner-groups/2/organizations"}`)
+	})
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/organizations", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			v := new(SetOrgAccessRunnerGroupRequest)
+			assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+			selected = v.SelectedOrganizationIDs
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			orgs := make([]*Organization, 0, len(selected))
+			for _, id := range selected {
+				orgs = append(orgs, &Organization{ID: Int64(id)})
+			}
+			assertNilError(t, json.NewEncoder(w).Encode(&ListOrganizations{TotalCount: Int(len(orgs)), Organizations: orgs}))
+		default:
+			t.Errorf("Request method: %v, want PUT or GET", r.Method)
+		}
+	})
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/organizations/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		var kept []int64
+		for _, id := range selected {
+			if id != 3 {
+				kept = append(kept, id)
+			}
+		}
+		selected = kept
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.Enterprise.UpdateEnterpriseRunnerGroup(ctx, "e", 2, UpdateEnterpriseRunnerGroupRequest{Visibility: String("selected")})
+	if err != nil {
+		t.Fatalf("Enterprise.UpdateEnterpriseRunnerGroup returned error: %v", err)
+	}
+	if got, want := group.GetVisibility(), "selected"; got != want {
+		t.Errorf("Enterprise.UpdateEnterpriseRunnerGroup returned visibility %q, want %q", got, want)
+	}
+
+	if _, err := client.Enterprise.SetOrganizationAccessRunnerGroup(ctx, "e", 2, SetOrgAccessRunnerGroupRequest{SelectedOrganizationIDs: []int64{1, 3}}); err != nil {
+		t.Fatalf("Enterprise.SetOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+	if _, err := client.Enterprise.RemoveOrganizationAccessRunnerGroup(ctx, "e", 2, 3); err != nil {
+		t.Fatalf("Enterprise.RemoveOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+
+	orgs, _, err := client.Enterprise.ListOrganizationAccessRunnerGroup(ctx, "e", 2, nil)
+	if err != nil {
+		t.Fatalf("Enterprise.ListOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+	want := &ListOrganizations{TotalCount: Int(1), Organizations: []*Organization{{ID: Int64(1)}}}
+	if !cmp.Equal(orgs, want) {
+		t.Errorf("Enterprise.ListOrganizationAccessRunnerGroup returned %+v, want %+v", orgs, want)
+	}
+}
+
 func TestEnterpriseService_ListEnterpriseRunnerGroupRunners(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
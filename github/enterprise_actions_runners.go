@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /enterprises/{enterprise}/actions/runners/downloads
 func (s *EnterpriseService) ListRunnerApplicationDownloads(ctx context.Context, enterprise string) ([]*RunnerApplicationDownload, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runners/downloads", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/runners/downloads", PathEscape(enterprise))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -37,7 +37,7 @@ func (s *EnterpriseService) ListRunnerApplicationDownloads(ctx context.Context,
 //
 //meta:operation POST /enterprises/{enterprise}/actions/runners/generate-jitconfig
 func (s *EnterpriseService) GenerateEnterpriseJITConfig(ctx context.Context, enterprise string, request *GenerateJITConfigRequest) (*JITRunnerConfig, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runners/generate-jitconfig", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/runners/generate-jitconfig", PathEscape(enterprise))
 
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
@@ -59,7 +59,7 @@ func (s *EnterpriseService) GenerateEnterpriseJITConfig(ctx context.Context, ent
 //
 //meta:operation POST /enterprises/{enterprise}/actions/runners/registration-token
 func (s *EnterpriseService) CreateRegistrationToken(ctx context.Context, enterprise string) (*RegistrationToken, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runners/registration-token", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/runners/registration-token", PathEscape(enterprise))
 
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
@@ -81,7 +81,7 @@ func (s *EnterpriseService) CreateRegistrationToken(ctx context.Context, enterpr
 //
 //meta:operation GET /enterprises/{enterprise}/actions/runners
 func (s *EnterpriseService) ListRunners(ctx context.Context, enterprise string, opts *ListOptions) (*Runners, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runners", enterprise)
+	u := fmt.Sprintf("enterprises/%v/actions/runners", PathEscape(enterprise))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -101,13 +101,56 @@ func (s *EnterpriseService) ListRunners(ctx context.Context, enterprise string,
 	return runners, resp, nil
 }
 
+// GetRunner gets a specific self-hosted runner for an enterprise using its runner ID.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runners#get-a-self-hosted-runner-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/runners/{runner_id}
+func (s *EnterpriseService) GetRunner(ctx context.Context, enterprise string, runnerID int64) (*Runner, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/runners/%v", PathEscape(enterprise), runnerID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(Runner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// CreateRemoveToken creates a token that can be used to remove a self-hosted runner from an enterprise.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runners#create-a-remove-token-for-an-enterprise
+//
+//meta:operation POST /enterprises/{enterprise}/actions/runners/remove-token
+func (s *EnterpriseService) CreateRemoveToken(ctx context.Context, enterprise string) (*RemoveToken, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/runners/remove-token", PathEscape(enterprise))
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	removeToken := new(RemoveToken)
+	resp, err := s.client.Do(ctx, req, removeToken)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return removeToken, resp, nil
+}
+
 // RemoveRunner forces the removal of a self-hosted runner from an enterprise using the runner id.
 //
 // GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runners#delete-a-self-hosted-runner-from-an-enterprise
 //
 //meta:operation DELETE /enterprises/{enterprise}/actions/runners/{runner_id}
 func (s *EnterpriseService) RemoveRunner(ctx context.Context, enterprise string, runnerID int64) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/actions/runners/%v", enterprise, runnerID)
+	u := fmt.Sprintf("enterprises/%v/actions/runners/%v", PathEscape(enterprise), runnerID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -0,0 +1,85 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetAnnouncement gets the announcement banner of an enterprise. An
+// enterprise without a banner returns an Announcement with a nil Announcement
+// field.
+//
+// This endpoint is only available on GitHub Enterprise Cloud.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/announcement-banners/enterprises#get-announcement-banner-for-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/announcement
+func (s *EnterpriseService) GetAnnouncement(ctx context.Context, enterprise string) (*Announcement, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/announcement", PathEscape(enterprise))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	announcement := new(Announcement)
+	resp, err := s.client.Do(ctx, req, announcement)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return announcement, resp, nil
+}
+
+// SetAnnouncement sets the announcement banner of an enterprise. Setting an
+// announcement with empty text removes the banner instead, as with
+// RemoveAnnouncement, and returns a nil Announcement.
+//
+// This endpoint is only available on GitHub Enterprise Cloud.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/announcement-banners/enterprises#set-announcement-banner-for-enterprise
+//
+//meta:operation PATCH /enterprises/{enterprise}/announcement
+func (s *EnterpriseService) SetAnnouncement(ctx context.Context, enterprise string, announcement *Announcement) (*Announcement, *Response, error) {
+	if announcement.GetAnnouncement() == "" {
+		resp, err := s.RemoveAnnouncement(ctx, enterprise)
+		return nil, resp, err
+	}
+
+	u := fmt.Sprintf("enterprises/%v/announcement", PathEscape(enterprise))
+	req, err := s.client.NewRequest("PATCH", u, announcement)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(Announcement)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// RemoveAnnouncement removes the announcement banner of an enterprise.
+//
+// This endpoint is only available on GitHub Enterprise Cloud.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/announcement-banners/enterprises#remove-announcement-banner-from-enterprise
+//
+//meta:operation DELETE /enterprises/{enterprise}/announcement
+func (s *EnterpriseService) RemoveAnnouncement(ctx context.Context, enterprise string) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/announcement", PathEscape(enterprise))
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
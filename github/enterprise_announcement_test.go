@@ -0,0 +1,133 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnterpriseService_GetAnnouncement(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"announcement":"a","expires_at":null,"user_dismissible":true}`)
+	})
+
+	ctx := context.Background()
+	announcement, _, err := client.Enterprise.GetAnnouncement(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetAnnouncement returned error: %v", err)
+	}
+
+	want := &Announcement{Announcement: String("a"), UserDismissible: Bool(true)}
+	if !cmp.Equal(announcement, want) {
+		t.Errorf("Enterprise.GetAnnouncement returned %+v, want %+v", announcement, want)
+	}
+
+	const methodName = "GetAnnouncement"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetAnnouncement(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetAnnouncement(ctx, "e")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_SetAnnouncement(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Announcement{Announcement: String("a"), ExpiresAt: &Timestamp{referenceTime}}
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"announcement":"a","expires_at":`+referenceTimeStr+`}`+"\n")
+		fmt.Fprint(w, `{"announcement":"a","expires_at":`+referenceTimeStr+`,"user_dismissible":false}`)
+	})
+
+	ctx := context.Background()
+	announcement, _, err := client.Enterprise.SetAnnouncement(ctx, "e", input)
+	if err != nil {
+		t.Errorf("Enterprise.SetAnnouncement returned error: %v", err)
+	}
+
+	want := &Announcement{Announcement: String("a"), ExpiresAt: &Timestamp{referenceTime}, UserDismissible: Bool(false)}
+	if !cmp.Equal(announcement, want) {
+		t.Errorf("Enterprise.SetAnnouncement returned %+v, want %+v", announcement, want)
+	}
+
+	const methodName = "SetAnnouncement"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.SetAnnouncement(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.SetAnnouncement(ctx, "e", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_SetAnnouncement_empty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	announcement, _, err := client.Enterprise.SetAnnouncement(ctx, "e", &Announcement{Announcement: String("")})
+	if err != nil {
+		t.Errorf("Enterprise.SetAnnouncement returned error: %v", err)
+	}
+	if announcement != nil {
+		t.Errorf("Enterprise.SetAnnouncement returned %+v, want nil", announcement)
+	}
+}
+
+func TestEnterpriseService_RemoveAnnouncement(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.RemoveAnnouncement(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.RemoveAnnouncement returned error: %v", err)
+	}
+
+	const methodName = "RemoveAnnouncement"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Enterprise.RemoveAnnouncement(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.RemoveAnnouncement(ctx, "e")
+	})
+}
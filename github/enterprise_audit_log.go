@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /enterprises/{enterprise}/audit-log
 func (s *EnterpriseService) GetAuditLog(ctx context.Context, enterprise string, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/audit-log", enterprise)
+	u := fmt.Sprintf("enterprises/%v/audit-log", PathEscape(enterprise))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
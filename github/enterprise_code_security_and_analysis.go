@@ -25,7 +25,7 @@ type EnterpriseSecurityAnalysisSettings struct {
 //
 //meta:operation GET /enterprises/{enterprise}/code_security_and_analysis
 func (s *EnterpriseService) GetCodeSecurityAndAnalysis(ctx context.Context, enterprise string) (*EnterpriseSecurityAnalysisSettings, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/code_security_and_analysis", enterprise)
+	u := fmt.Sprintf("enterprises/%v/code_security_and_analysis", PathEscape(enterprise))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -47,7 +47,7 @@ func (s *EnterpriseService) GetCodeSecurityAndAnalysis(ctx context.Context, ente
 //
 //meta:operation PATCH /enterprises/{enterprise}/code_security_and_analysis
 func (s *EnterpriseService) UpdateCodeSecurityAndAnalysis(ctx context.Context, enterprise string, settings *EnterpriseSecurityAnalysisSettings) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/code_security_and_analysis", enterprise)
+	u := fmt.Sprintf("enterprises/%v/code_security_and_analysis", PathEscape(enterprise))
 	req, err := s.client.NewRequest("PATCH", u, settings)
 	if err != nil {
 		return nil, err
@@ -70,7 +70,7 @@ func (s *EnterpriseService) UpdateCodeSecurityAndAnalysis(ctx context.Context, e
 //
 //meta:operation POST /enterprises/{enterprise}/{security_product}/{enablement}
 func (s *EnterpriseService) EnableDisableSecurityFeature(ctx context.Context, enterprise, securityProduct, enablement string) (*Response, error) {
-	u := fmt.Sprintf("enterprises/%v/%v/%v", enterprise, securityProduct, enablement)
+	u := fmt.Sprintf("enterprises/%v/%v/%v", PathEscape(enterprise), PathEscape(securityProduct), PathEscape(enablement))
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,117 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ConsumedLicenses represents the licenses consumed by an enterprise, and the
+// users consuming them.
+type ConsumedLicenses struct {
+	TotalSeatsConsumed  int            `json:"total_seats_consumed"`
+	TotalSeatsPurchased int            `json:"total_seats_purchased"`
+	Users               []*UserLicense `json:"users,omitempty"`
+}
+
+// UserLicense represents a user consuming a license of an enterprise. A user
+// can have a GitHub.com account, GitHub Enterprise Server accounts, or both;
+// the fields of the kinds of accounts the user doesn't have are unset.
+type UserLicense struct {
+	GitHubComLogin                  *string  `json:"github_com_login,omitempty"`
+	GitHubComName                   *string  `json:"github_com_name,omitempty"`
+	GitHubComUser                   *bool    `json:"github_com_user,omitempty"`
+	GitHubComProfile                *string  `json:"github_com_profile,omitempty"`
+	GitHubComMemberRoles            []string `json:"github_com_member_roles,omitempty"`
+	GitHubComEnterpriseRoles        []string `json:"github_com_enterprise_roles,omitempty"`
+	GitHubComVerifiedDomainEmails   []string `json:"github_com_verified_domain_emails,omitempty"`
+	GitHubComSAMLNameID             *string  `json:"github_com_saml_name_id,omitempty"`
+	GitHubComOrgsWithPendingInvites []string `json:"github_com_orgs_with_pending_invites,omitempty"`
+	GitHubComTwoFactorAuth          *bool    `json:"github_com_two_factor_auth,omitempty"`
+	// EnterpriseServerUserIDs are the IDs of the server accounts of the user,
+	// in the "hostname:id" form.
+	EnterpriseServerUserIDs       []string `json:"enterprise_server_user_ids,omitempty"`
+	EnterpriseServerUser          *bool    `json:"enterprise_server_user,omitempty"`
+	EnterpriseServerEmails        []string `json:"enterprise_server_emails,omitempty"`
+	VisualStudioSubscriptionUser  *bool    `json:"visual_studio_subscription_user,omitempty"`
+	VisualStudioLicenseStatus     *string  `json:"visual_studio_license_status,omitempty"`
+	VisualStudioSubscriptionEmail *string  `json:"visual_studio_subscription_email,omitempty"`
+	LicenseType                   *string  `json:"license_type,omitempty"`
+	TotalUserAccounts             *int     `json:"total_user_accounts,omitempty"`
+}
+
+// LicenseSyncStatus represents the status of the license synchronization of
+// the GitHub Enterprise Server instances connected to an enterprise.
+type LicenseSyncStatus struct {
+	ServerInstances []*LicenseSyncServerInstance `json:"server_instances,omitempty"`
+}
+
+// LicenseSyncServerInstance represents a GitHub Enterprise Server instance
+// and its last license synchronization.
+type LicenseSyncServerInstance struct {
+	ServerID *string          `json:"server_id,omitempty"`
+	Hostname *string          `json:"hostname,omitempty"`
+	LastSync *LicenseSyncInfo `json:"last_sync,omitempty"`
+}
+
+// LicenseSyncInfo represents a license synchronization of a GitHub Enterprise
+// Server instance.
+type LicenseSyncInfo struct {
+	Date   *Timestamp `json:"date,omitempty"`
+	Status *string    `json:"status,omitempty"`
+	Error  *string    `json:"error,omitempty"`
+}
+
+// GetConsumedLicenses lists the licenses consumed by an enterprise, across
+// GitHub.com and the GitHub Enterprise Server instances it syncs license
+// usage from.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/license#list-enterprise-consumed-licenses
+//
+//meta:operation GET /enterprises/{enterprise}/consumed-licenses
+func (s *EnterpriseService) GetConsumedLicenses(ctx context.Context, enterprise string, opts *ListOptions) (*ConsumedLicenses, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/consumed-licenses", PathEscape(enterprise))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	licenses := new(ConsumedLicenses)
+	resp, err := s.client.Do(ctx, req, licenses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return licenses, resp, nil
+}
+
+// GetLicenseSyncStatus gets the license sync status of the GitHub Enterprise
+// Server instances connected to an enterprise through GitHub Connect.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/license#get-a-license-sync-status
+//
+//meta:operation GET /enterprises/{enterprise}/license-sync-status
+func (s *EnterpriseService) GetLicenseSyncStatus(ctx context.Context, enterprise string) (*LicenseSyncStatus, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/license-sync-status", PathEscape(enterprise))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(LicenseSyncStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
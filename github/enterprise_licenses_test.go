@@ -0,0 +1,177 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnterpriseService_GetConsumedLicenses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/consumed-licenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{
+			"total_seats_consumed": 2,
+			"total_seats_purchased": 10,
+			"users": [
+				{
+					"github_com_login": "monalisa",
+					"github_com_name": "Mona Lisa",
+					"github_com_user": true,
+					"github_com_profile": "https://github.com/monalisa",
+					"github_com_member_roles": ["org1:Owner"],
+					"github_com_enterprise_roles": ["owner"],
+					"github_com_verified_domain_emails": ["monalisa@example.com"],
+					"github_com_saml_name_id": "monalisa@example.com",
+					"github_com_orgs_with_pending_invites": [],
+					"github_com_two_factor_auth": true,
+					"enterprise_server_user_ids": [],
+					"enterprise_server_user": false,
+					"enterprise_server_emails": [],
+					"visual_studio_subscription_user": false,
+					"visual_studio_license_status": null,
+					"visual_studio_subscription_email": null,
+					"license_type": "enterprise",
+					"total_user_accounts": 1
+				},
+				{
+					"github_com_login": null,
+					"github_com_user": false,
+					"github_com_two_factor_auth": null,
+					"enterprise_server_user_ids": ["ghes.example.com:123", "ghes2.example.com:222"],
+					"enterprise_server_user": true,
+					"enterprise_server_emails": ["octocat@example.com"],
+					"visual_studio_subscription_user": false,
+					"license_type": "enterprise",
+					"total_user_accounts": 2
+				}
+			]
+		}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	licenses, _, err := client.Enterprise.GetConsumedLicenses(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.GetConsumedLicenses returned error: %v", err)
+	}
+
+	want := &ConsumedLicenses{
+		TotalSeatsConsumed:  2,
+		TotalSeatsPurchased: 10,
+		Users: []*UserLicense{
+			{
+				GitHubComLogin:                  String("monalisa"),
+				GitHubComName:                   String("Mona Lisa"),
+				GitHubComUser:                   Bool(true),
+				GitHubComProfile:                String("https://github.com/monalisa"),
+				GitHubComMemberRoles:            []string{"org1:Owner"},
+				GitHubComEnterpriseRoles:        []string{"owner"},
+				GitHubComVerifiedDomainEmails:   []string{"monalisa@example.com"},
+				GitHubComSAMLNameID:             String("monalisa@example.com"),
+				GitHubComOrgsWithPendingInvites: []string{},
+				GitHubComTwoFactorAuth:          Bool(true),
+				EnterpriseServerUserIDs:         []string{},
+				EnterpriseServerUser:            Bool(false),
+				EnterpriseServerEmails:          []string{},
+				VisualStudioSubscriptionUser:    Bool(false),
+				LicenseType:                     String("enterprise"),
+				TotalUserAccounts:               Int(1),
+			},
+			{
+				GitHubComUser:                Bool(false),
+				EnterpriseServerUserIDs:      []string{"ghes.example.com:123", "ghes2.example.com:222"},
+				EnterpriseServerUser:         Bool(true),
+				EnterpriseServerEmails:       []string{"octocat@example.com"},
+				VisualStudioSubscriptionUser: Bool(false),
+				LicenseType:                  String("enterprise"),
+				TotalUserAccounts:            Int(2),
+			},
+		},
+	}
+	if !cmp.Equal(licenses, want) {
+		t.Errorf("Enterprise.GetConsumedLicenses returned %+v, want %+v", licenses, want)
+	}
+
+	const methodName = "GetConsumedLicenses"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetConsumedLicenses(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetConsumedLicenses(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_GetLicenseSyncStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/license-sync-status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"server_instances": [
+				{
+					"server_id": "deadbeef",
+					"hostname": "ghes.example.com",
+					"last_sync": {
+						"date": `+referenceTimeStr+`,
+						"status": "success",
+						"error": ""
+					}
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	status, _, err := client.Enterprise.GetLicenseSyncStatus(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetLicenseSyncStatus returned error: %v", err)
+	}
+
+	want := &LicenseSyncStatus{
+		ServerInstances: []*LicenseSyncServerInstance{{
+			ServerID: String("deadbeef"),
+			Hostname: String("ghes.example.com"),
+			LastSync: &LicenseSyncInfo{
+				Date:   &Timestamp{referenceTime},
+				Status: String("success"),
+				Error:  String(""),
+			},
+		}},
+	}
+	if !cmp.Equal(status, want) {
+		t.Errorf("Enterprise.GetLicenseSyncStatus returned %+v, want %+v", status, want)
+	}
+
+	const methodName = "GetLicenseSyncStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetLicenseSyncStatus(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetLicenseSyncStatus(ctx, "e")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
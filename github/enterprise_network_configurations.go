@@ -0,0 +1,78 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListNetworkConfigurations lists the hosted compute network configurations of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/network-configurations#list-hosted-compute-network-configurations-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/network-configurations
+func (s *EnterpriseService) ListNetworkConfigurations(ctx context.Context, enterprise string, opts *ListOptions) (*NetworkConfigurations, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/network-configurations", PathEscape(enterprise))
+	return listNetworkConfigurations(ctx, s.client, u, opts)
+}
+
+// CreateNetworkConfiguration creates a hosted compute network configuration for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/network-configurations#create-a-hosted-compute-network-configuration-for-an-enterprise
+//
+//meta:operation POST /enterprises/{enterprise}/network-configurations
+func (s *EnterpriseService) CreateNetworkConfiguration(ctx context.Context, enterprise string, config *NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/network-configurations", PathEscape(enterprise))
+	return doNetworkConfiguration(ctx, s.client, "POST", u, config)
+}
+
+// GetNetworkConfiguration gets a hosted compute network configuration of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/network-configurations#get-a-hosted-compute-network-configuration-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/network-configurations/{network_configuration_id}
+func (s *EnterpriseService) GetNetworkConfiguration(ctx context.Context, enterprise, networkConfigurationID string) (*NetworkConfiguration, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/network-configurations/%v", PathEscape(enterprise), PathEscape(networkConfigurationID))
+	return doNetworkConfiguration(ctx, s.client, "GET", u, nil)
+}
+
+// UpdateNetworkConfiguration updates a hosted compute network configuration of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/network-configurations#update-a-hosted-compute-network-configuration-for-an-enterprise
+//
+//meta:operation PATCH /enterprises/{enterprise}/network-configurations/{network_configuration_id}
+func (s *EnterpriseService) UpdateNetworkConfiguration(ctx context.Context, enterprise, networkConfigurationID string, config *NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/network-configurations/%v", PathEscape(enterprise), PathEscape(networkConfigurationID))
+	return doNetworkConfiguration(ctx, s.client, "PATCH", u, config)
+}
+
+// DeleteNetworkConfiguration deletes a hosted compute network configuration
+// of an enterprise. GitHub responds with 409 Conflict if the configuration is
+// still in use; that error is returned as is.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/network-configurations#delete-a-hosted-compute-network-configuration-from-an-enterprise
+//
+//meta:operation DELETE /enterprises/{enterprise}/network-configurations/{network_configuration_id}
+func (s *EnterpriseService) DeleteNetworkConfiguration(ctx context.Context, enterprise, networkConfigurationID string) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/network-configurations/%v", PathEscape(enterprise), PathEscape(networkConfigurationID))
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetNetworkSettings gets a hosted compute network settings resource of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/network-configurations#get-a-hosted-compute-network-settings-resource-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/network-settings/{network_settings_id}
+func (s *EnterpriseService) GetNetworkSettings(ctx context.Context, enterprise, networkSettingsID string) (*NetworkSettings, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/network-settings/%v", PathEscape(enterprise), PathEscape(networkSettingsID))
+	return getNetworkSettings(ctx, s.client, u)
+}
@@ -0,0 +1,224 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnterpriseService_ListNetworkConfigurations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count": 1, "network_configurations": [{"id": "c", "compute_service": "codespaces"}]}`)
+	})
+
+	opts := &ListOptions{Page: 2}
+	ctx := context.Background()
+	configs, _, err := client.Enterprise.ListNetworkConfigurations(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.ListNetworkConfigurations returned error: %v", err)
+	}
+
+	want := &NetworkConfigurations{
+		TotalCount:            Int64(1),
+		NetworkConfigurations: []*NetworkConfiguration{{ID: String("c"), ComputeService: String(ComputeServiceCodespaces)}},
+	}
+	if !cmp.Equal(configs, want) {
+		t.Errorf("Enterprise.ListNetworkConfigurations returned %+v, want %+v", configs, want)
+	}
+
+	const methodName = "ListNetworkConfigurations"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.ListNetworkConfigurations(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.ListNetworkConfigurations(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_CreateNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"n","network_settings_ids":["s"]}`+"\n")
+		fmt.Fprint(w, `{"id": "c", "name": "n", "compute_service": "none"}`)
+	})
+
+	config := &NetworkConfigurationRequest{Name: String("n"), NetworkSettingsIDs: []string{"s"}}
+	ctx := context.Background()
+	got, _, err := client.Enterprise.CreateNetworkConfiguration(ctx, "e", config)
+	if err != nil {
+		t.Errorf("Enterprise.CreateNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("c"), Name: String("n"), ComputeService: String(ComputeServiceNone)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Enterprise.CreateNetworkConfiguration returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "CreateNetworkConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.CreateNetworkConfiguration(ctx, "\n", config)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.CreateNetworkConfiguration(ctx, "e", config)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_GetNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-configurations/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": "c"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Enterprise.GetNetworkConfiguration(ctx, "e", "c")
+	if err != nil {
+		t.Errorf("Enterprise.GetNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("c")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Enterprise.GetNetworkConfiguration returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetNetworkConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetNetworkConfiguration(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetNetworkConfiguration(ctx, "e", "c")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_UpdateNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-configurations/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"name":"renamed"}`+"\n")
+		fmt.Fprint(w, `{"id": "c", "name": "renamed"}`)
+	})
+
+	config := &NetworkConfigurationRequest{Name: String("renamed")}
+	ctx := context.Background()
+	got, _, err := client.Enterprise.UpdateNetworkConfiguration(ctx, "e", "c", config)
+	if err != nil {
+		t.Errorf("Enterprise.UpdateNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("c"), Name: String("renamed")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Enterprise.UpdateNetworkConfiguration returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "UpdateNetworkConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.UpdateNetworkConfiguration(ctx, "\n", "\n", config)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.UpdateNetworkConfiguration(ctx, "e", "c", config)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_DeleteNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-configurations/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Enterprise.DeleteNetworkConfiguration(ctx, "e", "c"); err != nil {
+		t.Errorf("Enterprise.DeleteNetworkConfiguration returned error: %v", err)
+	}
+
+	const methodName = "DeleteNetworkConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Enterprise.DeleteNetworkConfiguration(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.DeleteNetworkConfiguration(ctx, "e", "c")
+	})
+}
+
+func TestEnterpriseService_GetNetworkSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-settings/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": "s", "region": "eastus"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Enterprise.GetNetworkSettings(ctx, "e", "s")
+	if err != nil {
+		t.Errorf("Enterprise.GetNetworkSettings returned error: %v", err)
+	}
+
+	want := &NetworkSettings{ID: String("s"), Region: String("eastus")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Enterprise.GetNetworkSettings returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetNetworkSettings"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetNetworkSettings(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetNetworkSettings(ctx, "e", "s")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,208 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testEnterpriseRulesetJSON = `{
+	"id": 84,
+	"name": "prod push rules",
+	"target": "branch",
+	"source_type": "Enterprise",
+	"source": "e",
+	"enforcement": "active",
+	"conditions": {
+		"organization_name": {"include": ["prod-*"], "exclude": ["prod-sandbox"]},
+		"repository_property": {"include": [{"name": "tier", "property_values": ["critical"], "source": "custom"}], "exclude": []},
+		"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}
+	},
+	"rules": [{"type": "deletion"}, {"type": "non_fast_forward"}]
+}`
+
+func testEnterpriseRuleset() *Ruleset {
+	return &Ruleset{
+		ID:          Int64(84),
+		Name:        "prod push rules",
+		Target:      String("branch"),
+		SourceType:  String("Enterprise"),
+		Source:      "e",
+		Enforcement: "active",
+		Conditions: &RulesetConditions{
+			OrganizationName: &RulesetOrganizationNamesConditionParameters{
+				Include: []string{"prod-*"},
+				Exclude: []string{"prod-sandbox"},
+			},
+			RepositoryProperty: &RulesetRepositoryPropertyConditionParameters{
+				Include: []*RulesetRepositoryPropertyTargetParameters{{Name: "tier", Values: []string{"critical"}, Source: String("custom")}},
+				Exclude: []*RulesetRepositoryPropertyTargetParameters{},
+			},
+			RefName: &RulesetRefConditionParameters{
+				Include: []string{"~DEFAULT_BRANCH"},
+				Exclude: []string{},
+			},
+		},
+		Rules: []*RepositoryRule{NewDeletionRule(), NewNonFastForwardRule()},
+	}
+}
+
+func TestEnterpriseService_CreateEnterpriseRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"prod push rules","target":"branch","source":"","enforcement":"active","conditions":{"ref_name":{"include":["~DEFAULT_BRANCH"],"exclude":[]},"organization_name":{"include":["prod-*"],"exclude":["prod-sandbox"]}},"rules":[{"type":"deletion","ruleset_source_type":"","ruleset_source":"","ruleset_id":0}]}`+"\n")
+		fmt.Fprint(w, testEnterpriseRulesetJSON)
+	})
+
+	ctx := context.Background()
+	rs := &Ruleset{
+		Name:        "prod push rules",
+		Target:      String("branch"),
+		Enforcement: "active",
+		Conditions: &RulesetConditions{
+			OrganizationName: &RulesetOrganizationNamesConditionParameters{
+				Include: []string{"prod-*"},
+				Exclude: []string{"prod-sandbox"},
+			},
+			RefName: &RulesetRefConditionParameters{
+				Include: []string{"~DEFAULT_BRANCH"},
+				Exclude: []string{},
+			},
+		},
+		Rules: []*RepositoryRule{NewDeletionRule()},
+	}
+	ruleset, _, err := client.Enterprise.CreateEnterpriseRuleset(ctx, "e", rs)
+	if err != nil {
+		t.Errorf("Enterprise.CreateEnterpriseRuleset returned error: %v", err)
+	}
+
+	if want := testEnterpriseRuleset(); !cmp.Equal(ruleset, want) {
+		t.Errorf("Enterprise.CreateEnterpriseRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "CreateEnterpriseRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.CreateEnterpriseRuleset(ctx, "\n", rs)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.CreateEnterpriseRuleset(ctx, "e", rs)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_GetEnterpriseRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/rulesets/84", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, testEnterpriseRulesetJSON)
+	})
+
+	ctx := context.Background()
+	ruleset, _, err := client.Enterprise.GetEnterpriseRuleset(ctx, "e", 84)
+	if err != nil {
+		t.Errorf("Enterprise.GetEnterpriseRuleset returned error: %v", err)
+	}
+
+	if want := testEnterpriseRuleset(); !cmp.Equal(ruleset, want) {
+		t.Errorf("Enterprise.GetEnterpriseRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "GetEnterpriseRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetEnterpriseRuleset(ctx, "\n", 84)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetEnterpriseRuleset(ctx, "e", 84)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_UpdateEnterpriseRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/rulesets/84", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"prod push rules","source":"","enforcement":"evaluate","conditions":{"organization_id":{"organization_ids":[1,2]}}}`+"\n")
+		fmt.Fprint(w, `{"id":84,"name":"prod push rules","enforcement":"evaluate"}`)
+	})
+
+	ctx := context.Background()
+	rs := &Ruleset{
+		Name:        "prod push rules",
+		Enforcement: "evaluate",
+		Conditions: &RulesetConditions{
+			OrganizationID: &RulesetOrganizationIDsConditionParameters{OrganizationIDs: []int64{1, 2}},
+		},
+	}
+	ruleset, _, err := client.Enterprise.UpdateEnterpriseRuleset(ctx, "e", 84, rs)
+	if err != nil {
+		t.Errorf("Enterprise.UpdateEnterpriseRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{ID: Int64(84), Name: "prod push rules", Enforcement: "evaluate"}
+	if !cmp.Equal(ruleset, want) {
+		t.Errorf("Enterprise.UpdateEnterpriseRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "UpdateEnterpriseRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.UpdateEnterpriseRuleset(ctx, "\n", 84, rs)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.UpdateEnterpriseRuleset(ctx, "e", 84, rs)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_DeleteEnterpriseRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/rulesets/84", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	if _, err := client.Enterprise.DeleteEnterpriseRuleset(ctx, "e", 84); err != nil {
+		t.Errorf("Enterprise.DeleteEnterpriseRuleset returned error: %v", err)
+	}
+
+	const methodName = "DeleteEnterpriseRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Enterprise.DeleteEnterpriseRuleset(ctx, "\n", 84)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.DeleteEnterpriseRuleset(ctx, "e", 84)
+	})
+}
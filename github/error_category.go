@@ -0,0 +1,158 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrorCategory is a coarse classification of a failed request, suitable as
+// a metrics label.
+type ErrorCategory string
+
+// The possible values of ErrorCategory.
+const (
+	ErrorCategoryNotFound             ErrorCategory = "not_found"
+	ErrorCategoryRateLimited          ErrorCategory = "rate_limited"
+	ErrorCategorySecondaryRateLimited ErrorCategory = "secondary_rate_limited"
+	ErrorCategoryForbidden            ErrorCategory = "forbidden"
+	ErrorCategoryValidation           ErrorCategory = "validation"
+	ErrorCategoryConflict             ErrorCategory = "conflict"
+	ErrorCategoryServerError          ErrorCategory = "server_error"
+	ErrorCategoryOther                ErrorCategory = "other"
+)
+
+// conflictMessages are the messages of 422 responses without an errors array
+// that report a conflict with the current state of the resource rather than
+// an invalid request.
+var conflictMessages = []string{
+	"already exists",
+	"is not a fast forward",
+	"merge conflict",
+}
+
+// Category returns the category of the error, derived from the status code,
+// the rate limit headers and the documented messages of the response:
+//
+//   - 404 and 410 are ErrorCategoryNotFound.
+//   - 403 and 429 are ErrorCategoryRateLimited when no primary rate limit
+//     requests remain, ErrorCategorySecondaryRateLimited when the response
+//     points to the secondary rate limit documentation or has a Retry-After
+//     header, and otherwise ErrorCategoryForbidden for 403 and
+//     ErrorCategorySecondaryRateLimited for 429.
+//   - 401 is ErrorCategoryForbidden.
+//   - 409 is ErrorCategoryConflict, and so is a 422 whose errors all have
+//     the "already_exists" code or, without errors, whose message reports
+//     such a conflict. Other 422 are ErrorCategoryValidation.
+//   - 5xx are ErrorCategoryServerError.
+//
+// Anything else, including an error without a response, is
+// ErrorCategoryOther.
+func (r *ErrorResponse) Category() ErrorCategory {
+	if r == nil || r.Response == nil {
+		return ErrorCategoryOther
+	}
+
+	switch code := r.Response.StatusCode; {
+	case code == http.StatusNotFound || code == http.StatusGone:
+		return ErrorCategoryNotFound
+	case code == http.StatusForbidden || code == http.StatusTooManyRequests:
+		switch {
+		case r.Response.Header.Get(headerRateRemaining) == "0":
+			return ErrorCategoryRateLimited
+		case isSecondaryRateLimitDocumentation(r.DocumentationURL),
+			r.Response.Header.Get(headerRetryAfter) != "",
+			code == http.StatusTooManyRequests:
+			return ErrorCategorySecondaryRateLimited
+		}
+		return ErrorCategoryForbidden
+	case code == http.StatusUnauthorized:
+		return ErrorCategoryForbidden
+	case code == http.StatusConflict:
+		return ErrorCategoryConflict
+	case code == http.StatusUnprocessableEntity:
+		if r.isConflict() {
+			return ErrorCategoryConflict
+		}
+		return ErrorCategoryValidation
+	case code >= 500 && code <= 599:
+		return ErrorCategoryServerError
+	}
+	return ErrorCategoryOther
+}
+
+// RetryAfter returns how long to wait before retrying the request, for
+// errors in the ErrorCategoryRateLimited and ErrorCategorySecondaryRateLimited
+// categories that carry that information in their headers. It returns nil
+// otherwise.
+func (r *ErrorResponse) RetryAfter() *time.Duration {
+	switch r.Category() {
+	case ErrorCategoryRateLimited:
+		if rate := parseRate(r.Response); !rate.Reset.IsZero() {
+			d := time.Until(rate.Reset.Time)
+			return &d
+		}
+	case ErrorCategorySecondaryRateLimited:
+		return parseSecondaryRate(r.Response)
+	}
+	return nil
+}
+
+func (r *ErrorResponse) isConflict() bool {
+	if len(r.Errors) > 0 {
+		for _, e := range r.Errors {
+			if e.Code != "already_exists" {
+				return false
+			}
+		}
+		return true
+	}
+
+	msg := strings.ToLower(r.Message)
+	for _, m := range conflictMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSecondaryRateLimitDocumentation(url string) bool {
+	return strings.HasSuffix(url, "#abuse-rate-limits") || strings.HasSuffix(url, "secondary-rate-limits")
+}
+
+// CategorizeError returns the category of an error returned by a method of
+// the client, and how long to wait before retrying when it is known. It
+// handles the error types returned by CheckResponse: RateLimitError and
+// AbuseRateLimitError, which CheckResponse returns instead of an
+// ErrorResponse for rate limited requests, TwoFactorAuthError and
+// ErrorResponse. Any other non-nil error, such as a network error, is
+// ErrorCategoryOther. A nil error has an empty category.
+func CategorizeError(err error) (category ErrorCategory, retryAfter *time.Duration) {
+	var (
+		rateLimitErr *RateLimitError
+		abuseErr     *AbuseRateLimitError
+		twoFactorErr *TwoFactorAuthError
+		errResp      *ErrorResponse
+	)
+	switch {
+	case err == nil:
+		return "", nil
+	case errors.As(err, &rateLimitErr):
+		d := time.Until(rateLimitErr.Rate.Reset.Time)
+		return ErrorCategoryRateLimited, &d
+	case errors.As(err, &abuseErr):
+		return ErrorCategorySecondaryRateLimited, abuseErr.RetryAfter
+	case errors.As(err, &twoFactorErr):
+		return ErrorCategoryForbidden, nil
+	case errors.As(err, &errResp):
+		return errResp.Category(), errResp.RetryAfter()
+	}
+	return ErrorCategoryOther, nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newCategoryTestResponse(code int, header http.Header, body string) *http.Response {
+	h := http.Header{}
+	for k, v := range header {
+		for _, v := range v {
+			h.Add(k, v)
+		}
+	}
+	return &http.Response{
+		Request:    &http.Request{},
+		StatusCode: code,
+		Header:     h,
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func TestErrorResponse_Category(t *testing.T) {
+	tests := map[string]struct {
+		code   int
+		header http.Header
+		body   string
+		want   ErrorCategory
+	}{
+		"404":                                  {code: 404, want: ErrorCategoryNotFound},
+		"410":                                  {code: 410, want: ErrorCategoryNotFound},
+		"403 plain":                            {code: 403, body: `{"message":"Must have admin rights to Repository."}`, want: ErrorCategoryForbidden},
+		"403 with rate limit headers":          {code: 403, header: http.Header{headerRateRemaining: {"0"}}, want: ErrorCategoryRateLimited},
+		"403 with remaining rate limit":        {code: 403, header: http.Header{headerRateRemaining: {"42"}}, want: ErrorCategoryForbidden},
+		"403 secondary rate limit docs":        {code: 403, body: `{"documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`, want: ErrorCategorySecondaryRateLimited},
+		"403 with retry-after":                 {code: 403, header: http.Header{headerRetryAfter: {"30"}}, want: ErrorCategorySecondaryRateLimited},
+		"429":                                  {code: 429, want: ErrorCategorySecondaryRateLimited},
+		"429 with rate limit headers":          {code: 429, header: http.Header{headerRateRemaining: {"0"}}, want: ErrorCategoryRateLimited},
+		"401":                                  {code: 401, want: ErrorCategoryForbidden},
+		"409":                                  {code: 409, want: ErrorCategoryConflict},
+		"422 with errors array":                {code: 422, body: `{"message":"Validation Failed","errors":[{"resource":"Issue","field":"title","code":"missing_field"}]}`, want: ErrorCategoryValidation},
+		"422 with already_exists errors":       {code: 422, body: `{"message":"Validation Failed","errors":[{"resource":"Label","field":"name","code":"already_exists"}]}`, want: ErrorCategoryConflict},
+		"422 with mixed errors":                {code: 422, body: `{"message":"Validation Failed","errors":[{"code":"already_exists"},{"code":"invalid"}]}`, want: ErrorCategoryValidation},
+		"422 without errors":                   {code: 422, body: `{"message":"Invalid request."}`, want: ErrorCategoryValidation},
+		"422 without errors, ref exists":       {code: 422, body: `{"message":"Reference already exists"}`, want: ErrorCategoryConflict},
+		"422 without errors, not fast forward": {code: 422, body: `{"message":"Update is not a fast forward"}`, want: ErrorCategoryConflict},
+		"500":                                  {code: 500, want: ErrorCategoryServerError},
+		"502":                                  {code: 502, want: ErrorCategoryServerError},
+		"400":                                  {code: 400, want: ErrorCategoryOther},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			errResp := &ErrorResponse{Response: newCategoryTestResponse(test.code, test.header, test.body)}
+			if test.body != "" {
+				if err := json.Unmarshal([]byte(test.body), errResp); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := errResp.Category(); got != test.want {
+				t.Errorf("Category = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestErrorResponse_Category_noResponse(t *testing.T) {
+	if got := (&ErrorResponse{}).Category(); got != ErrorCategoryOther {
+		t.Errorf("Category = %q, want %q", got, ErrorCategoryOther)
+	}
+	if got := (*ErrorResponse)(nil).Category(); got != ErrorCategoryOther {
+		t.Errorf("Category of nil = %q, want %q", got, ErrorCategoryOther)
+	}
+	if got := (&ErrorResponse{}).RetryAfter(); got != nil {
+		t.Errorf("RetryAfter = %v, want nil", *got)
+	}
+}
+
+func TestErrorResponse_RetryAfter(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Unix()
+	tests := map[string]struct {
+		code   int
+		header http.Header
+		min    time.Duration
+		max    time.Duration
+		want   bool
+	}{
+		"rate limited": {
+			code:   403,
+			header: http.Header{headerRateRemaining: {"0"}, headerRateReset: {strconv.FormatInt(reset, 10)}},
+			min:    59 * time.Minute,
+			max:    time.Hour,
+			want:   true,
+		},
+		"secondary rate limited": {
+			code:   403,
+			header: http.Header{headerRetryAfter: {"30"}},
+			min:    30 * time.Second,
+			max:    30 * time.Second,
+			want:   true,
+		},
+		"secondary rate limited without hint": {code: 429},
+		"forbidden":                           {code: 403},
+		"server error":                        {code: 503, header: http.Header{headerRetryAfter: {"30"}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			errResp := &ErrorResponse{Response: newCategoryTestResponse(test.code, test.header, "")}
+			got := errResp.RetryAfter()
+			if !test.want {
+				if got != nil {
+					t.Errorf("RetryAfter = %v, want nil", *got)
+				}
+				return
+			}
+			if got == nil || *got < test.min || *got > test.max {
+				t.Errorf("RetryAfter = %v, want between %v and %v", got, test.min, test.max)
+			}
+		})
+	}
+}
+
+func TestCategorizeError(t *testing.T) {
+	retryAfter := 30 * time.Second
+	reset := Timestamp{time.Now().Add(time.Hour)}
+
+	tests := map[string]struct {
+		err            error
+		want           ErrorCategory
+		wantRetryAfter bool
+	}{
+		"nil":                  {err: nil, want: ""},
+		"rate limit":           {err: &RateLimitError{Rate: Rate{Reset: reset}}, want: ErrorCategoryRateLimited, wantRetryAfter: true},
+		"abuse rate limit":     {err: &AbuseRateLimitError{RetryAfter: &retryAfter}, want: ErrorCategorySecondaryRateLimited, wantRetryAfter: true},
+		"abuse without hint":   {err: &AbuseRateLimitError{}, want: ErrorCategorySecondaryRateLimited},
+		"two factor":           {err: &TwoFactorAuthError{}, want: ErrorCategoryForbidden},
+		"error response":       {err: &ErrorResponse{Response: newCategoryTestResponse(404, nil, "")}, want: ErrorCategoryNotFound},
+		"wrapped":              {err: fmt.Errorf("getting repo: %w", &ErrorResponse{Response: newCategoryTestResponse(500, nil, "")}), want: ErrorCategoryServerError},
+		"network error":        {err: errors.New("connection refused"), want: ErrorCategoryOther},
+		"accepted, still busy": {err: &AcceptedError{}, want: ErrorCategoryOther},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, retryAfter := CategorizeError(test.err)
+			if got != test.want {
+				t.Errorf("CategorizeError category = %q, want %q", got, test.want)
+			}
+			if (retryAfter != nil) != test.wantRetryAfter {
+				t.Errorf("CategorizeError retryAfter = %v, want set: %v", retryAfter, test.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestCategorizeError_checkResponse(t *testing.T) {
+	// CheckResponse turns rate limited 403 into dedicated error types, which
+	// must keep their category.
+	tests := map[string]struct {
+		header http.Header
+		body   string
+		want   ErrorCategory
+	}{
+		"primary": {
+			header: http.Header{headerRateRemaining: {"0"}, headerRateReset: {strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)}},
+			want:   ErrorCategoryRateLimited,
+		},
+		"secondary": {
+			header: http.Header{headerRetryAfter: {"10"}},
+			body:   `{"documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`,
+			want:   ErrorCategorySecondaryRateLimited,
+		},
+		"plain": {
+			body: `{"message":"Resource not accessible by integration"}`,
+			want: ErrorCategoryForbidden,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := CheckResponse(newCategoryTestResponse(http.StatusForbidden, test.header, test.body))
+			if got, _ := CategorizeError(err); got != test.want {
+				t.Errorf("CategorizeError(%T) = %q, want %q", err, got, test.want)
+			}
+		})
+	}
+}
@@ -119,6 +119,43 @@ type CreateEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// CustomPropertyEvent represents a created, deleted or updated custom property definition.
+// The Webhook event name is "custom_property".
+//
+// Note: this is related to custom property configuration at the enterprise or organization level.
+// See CustomPropertyValuesEvent for activity related to custom property values for a repository.
+//
+// GitHub API docs: https://docs.github.com/webhooks/webhook-events-and-payloads#custom_property
+type CustomPropertyEvent struct {
+	// Action possible values are: "created", "deleted", "updated".
+	Action     *string         `json:"action,omitempty"`
+	Definition *CustomProperty `json:"definition,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
+// CustomPropertyValuesEvent represents any activity related to custom property values for a repository.
+// The Webhook event name is "custom_property_values".
+//
+// GitHub API docs: https://docs.github.com/webhooks/webhook-events-and-payloads#custom_property_values
+type CustomPropertyValuesEvent struct {
+	// Action possible values are: "updated".
+	Action            *string                `json:"action,omitempty"`
+	NewPropertyValues []*CustomPropertyValue `json:"new_property_values,omitempty"`
+	OldPropertyValues []*CustomPropertyValue `json:"old_property_values,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // DeleteEvent represents a deleted branch or tag.
 // The Webhook event name is "delete".
 //
@@ -252,14 +289,16 @@ type DeploymentStatusEvent struct {
 // GitHub API docs: https://docs.github.com/webhooks-and-events/webhooks/webhook-events-and-payloads#discussion_comment
 type DiscussionCommentEvent struct {
 	// Action is the action that was performed on the comment.
-	// Possible values are: "created", "edited", "deleted". ** check what all can be added
-	Action       *string            `json:"action,omitempty"`
-	Discussion   *Discussion        `json:"discussion,omitempty"`
-	Comment      *CommentDiscussion `json:"comment,omitempty"`
-	Repo         *Repository        `json:"repository,omitempty"`
-	Org          *Organization      `json:"organization,omitempty"`
-	Sender       *User              `json:"sender,omitempty"`
-	Installation *Installation      `json:"installation,omitempty"`
+	// Possible values are: "created", "edited", "deleted".
+	Action     *string            `json:"action,omitempty"`
+	Discussion *Discussion        `json:"discussion,omitempty"`
+	Comment    *CommentDiscussion `json:"comment,omitempty"`
+	// Changes is only populated for the "edited" action.
+	Changes      *EditChange   `json:"changes,omitempty"`
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
 }
 
 // CommentDiscussion represents a comment in a GitHub DiscussionCommentEvent.
@@ -287,8 +326,16 @@ type DiscussionEvent struct {
 	// Action is the action that was performed. Possible values are:
 	// created, edited, deleted, pinned, unpinned, locked, unlocked,
 	// transferred, category_changed, answered, or unanswered.
-	Action       *string       `json:"action,omitempty"`
-	Discussion   *Discussion   `json:"discussion,omitempty"`
+	Action     *string     `json:"action,omitempty"`
+	Discussion *Discussion `json:"discussion,omitempty"`
+	// Answer is the comment chosen as the answer. It is only populated for
+	// the "answered" action.
+	Answer *CommentDiscussion `json:"answer,omitempty"`
+	// OldAnswer is the comment that was unmarked as the answer. It is only
+	// populated for the "unanswered" action.
+	OldAnswer *CommentDiscussion `json:"old_answer,omitempty"`
+	// Changes is only populated for the "edited" action.
+	Changes      *EditChange   `json:"changes,omitempty"`
 	Repo         *Repository   `json:"repository,omitempty"`
 	Org          *Organization `json:"organization,omitempty"`
 	Sender       *User         `json:"sender,omitempty"`
@@ -301,7 +348,7 @@ type Discussion struct {
 	DiscussionCategory *DiscussionCategory `json:"category,omitempty"`
 	AnswerHTMLURL      *string             `json:"answer_html_url,omitempty"`
 	AnswerChosenAt     *Timestamp          `json:"answer_chosen_at,omitempty"`
-	AnswerChosenBy     *string             `json:"answer_chosen_by,omitempty"`
+	AnswerChosenBy     *User               `json:"answer_chosen_by,omitempty"`
 	HTMLURL            *string             `json:"html_url,omitempty"`
 	ID                 *int64              `json:"id,omitempty"`
 	NodeID             *string             `json:"node_id,omitempty"`
@@ -316,6 +363,10 @@ type Discussion struct {
 	AuthorAssociation  *string             `json:"author_association,omitempty"`
 	ActiveLockReason   *string             `json:"active_lock_reason,omitempty"`
 	Body               *string             `json:"body,omitempty"`
+	StateReason        *string             `json:"state_reason,omitempty"`
+	Labels             []*Label            `json:"labels,omitempty"`
+	Reactions          *Reactions          `json:"reactions,omitempty"`
+	TimelineURL        *string             `json:"timeline_url,omitempty"`
 }
 
 // DiscussionCategory represents a discussion category in a GitHub DiscussionEvent.
@@ -396,6 +447,7 @@ type EditChange struct {
 	Owner         *EditOwner         `json:"owner,omitempty"`
 	DefaultBranch *EditDefaultBranch `json:"default_branch,omitempty"`
 	Topics        *EditTopics        `json:"topics,omitempty"`
+	StateReason   *EditStateReason   `json:"state_reason,omitempty"`
 }
 
 // EditTitle represents a pull-request title change.
@@ -455,6 +507,11 @@ type EditDefaultBranch struct {
 	From *string `json:"from,omitempty"`
 }
 
+// EditStateReason represents a change of issue state reason.
+type EditStateReason struct {
+	From *string `json:"from,omitempty"`
+}
+
 // ProjectChange represents the changes when a project has been edited.
 type ProjectChange struct {
 	Name *ProjectName `json:"name,omitempty"`
@@ -622,6 +679,27 @@ type IssueCommentEvent struct {
 	Organization *Organization `json:"organization,omitempty"`
 }
 
+// IssueDependenciesEvent is triggered when an issue is marked as blocked by,
+// or no longer blocked by, another issue.
+// The Webhook event name is "issue_dependencies".
+//
+// GitHub API docs: https://docs.github.com/webhooks/webhook-events-and-payloads#issue_dependencies
+type IssueDependenciesEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// "blocked_by_added", "blocked_by_removed", "blocking_added", "blocking_removed".
+	Action            *string     `json:"action,omitempty"`
+	BlockedIssue      *Issue      `json:"blocked_issue,omitempty"`
+	BlockedIssueRepo  *Repository `json:"blocked_issue_repo,omitempty"`
+	BlockingIssue     *Issue      `json:"blocking_issue,omitempty"`
+	BlockingIssueRepo *Repository `json:"blocking_issue_repo,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // IssuesEvent is triggered when an issue is opened, edited, deleted, transferred,
 // pinned, unpinned, closed, reopened, assigned, unassigned, labeled, unlabeled,
 // locked, unlocked, milestoned, or demilestoned.
@@ -928,6 +1006,14 @@ type PersonalAccessTokenRequest struct {
 	ID    *int64 `json:"id,omitempty"`
 	Owner *User  `json:"owner,omitempty"`
 
+	// Reason given by the owner for requesting access.
+	Reason *string `json:"reason,omitempty"`
+
+	// Permissions requested, categorized by type of permission. Only
+	// populated when listing requests with
+	// OrganizationsService.ListPersonalAccessTokenRequests.
+	Permissions *PersonalAccessTokenPermissions `json:"permissions,omitempty"`
+
 	// New requested permissions, categorized by type of permission.
 	PermissionsAdded *PersonalAccessTokenPermissions `json:"permissions_added,omitempty"`
 
@@ -951,9 +1037,17 @@ type PersonalAccessTokenRequest struct {
 	// This field is only populated when repository_selection is subset.
 	Repositories []*Repository `json:"repositories,omitempty"`
 
+	// URL of the list of repositories the token is requesting access to.
+	RepositoriesURL *string `json:"repositories_url,omitempty"`
+
 	// Date and time when the request for access was created.
 	CreatedAt *Timestamp `json:"created_at,omitempty"`
 
+	// Unique identifier and name of the associated fine-grained personal
+	// access token.
+	TokenID   *int64  `json:"token_id,omitempty"`
+	TokenName *string `json:"token_name,omitempty"`
+
 	// Whether the associated fine-grained personal access token has expired.
 	TokenExpired *bool `json:"token_expired,omitempty"`
 
@@ -1093,7 +1187,21 @@ type ProjectV2ItemEvent struct {
 
 // ProjectV2ItemChange represents a project v2 item change.
 type ProjectV2ItemChange struct {
-	ArchivedAt *ArchivedAt `json:"archived_at,omitempty"`
+	ArchivedAt *ArchivedAt                    `json:"archived_at,omitempty"`
+	FieldValue *ProjectV2ItemFieldValueChange `json:"field_value,omitempty"`
+}
+
+// ProjectV2ItemFieldValueChange represents a change of the value of one of
+// the fields of a project v2 item.
+type ProjectV2ItemFieldValueChange struct {
+	FieldNodeID *string `json:"field_node_id,omitempty"`
+	// FieldType is the type of the field. Possible values are: "single_select",
+	// "iteration", "text", "number", "date".
+	FieldType     *string                  `json:"field_type,omitempty"`
+	FieldName     *string                  `json:"field_name,omitempty"`
+	ProjectNumber *int                     `json:"project_number,omitempty"`
+	From          *ProjectV2ItemFieldValue `json:"from,omitempty"`
+	To            *ProjectV2ItemFieldValue `json:"to,omitempty"`
 }
 
 // ArchivedAt represents an archiving date change.
@@ -1104,15 +1212,68 @@ type ArchivedAt struct {
 
 // ProjectV2Item represents an item belonging to a project.
 type ProjectV2Item struct {
+	ID            *int64  `json:"id,omitempty"`
+	NodeID        *string `json:"node_id,omitempty"`
+	ProjectNodeID *string `json:"project_node_id,omitempty"`
+	ContentNodeID *string `json:"content_node_id,omitempty"`
+	// ContentType is the type of the item's content, one of the
+	// ProjectItemType constants.
+	ContentType *string    `json:"content_type,omitempty"`
+	Creator     *User      `json:"creator,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
+	ArchivedAt  *Timestamp `json:"archived_at,omitempty"`
+	// Content and Fields are only returned by the REST API. Fields is nil if
+	// no field values were requested, and empty if none of the requested
+	// fields has a value.
+	Content *ProjectV2ItemContent `json:"content,omitempty"`
+	Fields  []*ProjectV2ItemField `json:"fields,omitempty"`
+}
+
+// ProjectV2StatusUpdateEvent is triggered when there is activity relating to a status update on an organization-level project.
+// The Webhook event name is "projects_v2_status_update".
+//
+// GitHub API docs: https://docs.github.com/webhooks/webhook-events-and-payloads#projects_v2_status_update
+type ProjectV2StatusUpdateEvent struct {
+	// Action is the action that was performed. Possible values are: "created", "deleted", "edited".
+	Action                *string                      `json:"action,omitempty"`
+	Changes               *ProjectV2StatusUpdateChange `json:"changes,omitempty"`
+	ProjectV2StatusUpdate *ProjectV2StatusUpdate       `json:"projects_v2_status_update,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Installation *Installation `json:"installation,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
+// ProjectV2StatusUpdate represents a status update posted on a project.
+type ProjectV2StatusUpdate struct {
 	ID            *int64     `json:"id,omitempty"`
 	NodeID        *string    `json:"node_id,omitempty"`
 	ProjectNodeID *string    `json:"project_node_id,omitempty"`
-	ContentNodeID *string    `json:"content_node_id,omitempty"`
-	ContentType   *string    `json:"content_type,omitempty"`
 	Creator       *User      `json:"creator,omitempty"`
 	CreatedAt     *Timestamp `json:"created_at,omitempty"`
 	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
-	ArchivedAt    *Timestamp `json:"archived_at,omitempty"`
+	// Status is the current status of the project. Possible values are:
+	// "INACTIVE", "ON_TRACK", "AT_RISK", "OFF_TRACK", "COMPLETE".
+	Status     *string `json:"status,omitempty"`
+	StartDate  *string `json:"start_date,omitempty"`
+	TargetDate *string `json:"target_date,omitempty"`
+	Body       *string `json:"body,omitempty"`
+}
+
+// ProjectV2StatusUpdateChange represents the changes made to a project status update.
+type ProjectV2StatusUpdateChange struct {
+	Body       *StringChange `json:"body,omitempty"`
+	Status     *StringChange `json:"status,omitempty"`
+	StartDate  *StringChange `json:"start_date,omitempty"`
+	TargetDate *StringChange `json:"target_date,omitempty"`
+}
+
+// StringChange represents a change of a string value.
+type StringChange struct {
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
 }
 
 // PublicEvent is triggered when a private repository is open sourced.
@@ -1394,6 +1555,25 @@ type PushEventRepoOwner struct {
 	Email *string `json:"email,omitempty"`
 }
 
+// RegistryPackageEvent represents activity related to GitHub Packages.
+// The Webhook event name is "registry_package".
+//
+// This event is superseded by PackageEvent but is still sent by GitHub.
+//
+// GitHub API docs: https://docs.github.com/webhooks/webhook-events-and-payloads#registry_package
+type RegistryPackageEvent struct {
+	// Action is the action that was performed. Possible values are: "published", "updated".
+	Action          *string  `json:"action,omitempty"`
+	RegistryPackage *Package `json:"registry_package,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repository   *Repository   `json:"repository,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // ReleaseEvent is triggered when a release is published, unpublished, created,
 // edited, deleted, or prereleased.
 // The Webhook event name is "release".
@@ -1525,6 +1705,23 @@ type SecretScanningAlertEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// SecretScanningAlertLocationEvent is triggered when there is activity relating to the locations of a secret in a secret scanning alert.
+// The Webhook event name is "secret_scanning_alert_location".
+//
+// GitHub API docs: https://docs.github.com/webhooks/webhook-events-and-payloads#secret_scanning_alert_location
+type SecretScanningAlertLocationEvent struct {
+	// Action is the action that was performed. Possible value is: "created".
+	Action   *string                      `json:"action,omitempty"`
+	Alert    *SecretScanningAlert         `json:"alert,omitempty"`
+	Location *SecretScanningAlertLocation `json:"location,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Repo         *Repository   `json:"repository,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // SecurityAndAnalysisEvent is triggered when code security and analysis features
 // are enabled or disabled for a repository.
 //
@@ -1599,6 +1796,28 @@ type StatusEvent struct {
 	Org *Organization `json:"organization,omitempty"`
 }
 
+// SubIssuesEvent is triggered when a sub-issue or parent issue is added to or removed from an issue.
+// The Webhook event name is "sub_issues".
+//
+// GitHub API docs: https://docs.github.com/webhooks/webhook-events-and-payloads#sub_issues
+type SubIssuesEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// "sub_issue_added", "sub_issue_removed", "parent_issue_added", "parent_issue_removed".
+	Action          *string     `json:"action,omitempty"`
+	ParentIssueID   *int64      `json:"parent_issue_id,omitempty"`
+	ParentIssue     *Issue      `json:"parent_issue,omitempty"`
+	ParentIssueRepo *Repository `json:"parent_issue_repo,omitempty"`
+	SubIssueID      *int64      `json:"sub_issue_id,omitempty"`
+	SubIssue        *Issue      `json:"sub_issue,omitempty"`
+	SubIssueRepo    *Repository `json:"sub_issue_repo,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // TeamEvent is triggered when an organization's team is created, modified or deleted.
 // The Webhook event name is "team".
 //
@@ -1727,6 +1946,7 @@ type WorkflowRunEvent struct {
 // GitHub API docs: https://docs.github.com/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#security_advisory
 type SecurityAdvisory struct {
 	CVSS               *AdvisoryCVSS                 `json:"cvss,omitempty"`
+	CVSSSeverities     *AdvisoryCVSSSeverities       `json:"cvss_severities,omitempty"`
 	CWEs               []*AdvisoryCWEs               `json:"cwes,omitempty"`
 	GHSAID             *string                       `json:"ghsa_id,omitempty"`
 	Summary            *string                       `json:"summary,omitempty"`
@@ -8,6 +8,8 @@ package github
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestEditChange_Marshal_TitleChange(t *testing.T) {
@@ -50,6 +52,24 @@ func TestEditChange_Marshal_BodyChange(t *testing.T) {
 	testJSONMarshal(t, u, want)
 }
 
+func TestEditChange_Marshal_StateReasonChange(t *testing.T) {
+	testJSONMarshal(t, &EditChange{}, "{}")
+
+	u := &EditChange{
+		StateReason: &EditStateReason{
+			From: String("not_planned"),
+		},
+	}
+
+	want := `{
+		"state_reason": {
+			"from": "not_planned"
+		  }
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
 func TestEditChange_Marshal_BaseChange(t *testing.T) {
 	testJSONMarshal(t, &EditChange{}, "{}")
 
@@ -7138,6 +7158,103 @@ func TestDiscussionCommentEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 }
 
+func TestDiscussionEvent_answered(t *testing.T) {
+	payload := `{
+		"action": "answered",
+		"discussion": {
+			"id": 1,
+			"number": 9,
+			"title": "How do I?",
+			"state": "open",
+			"state_reason": null,
+			"category": {
+				"id": 2,
+				"emoji": ":pray:",
+				"name": "Q&A",
+				"slug": "q-a",
+				"is_answerable": true
+			},
+			"answer_html_url": "https://github.com/o/r/discussions/9#discussioncomment-3",
+			"answer_chosen_at": "2006-01-02T15:04:05Z",
+			"answer_chosen_by": {"login": "maintainer", "id": 4},
+			"labels": [{"name": "question"}],
+			"reactions": {"total_count": 1, "+1": 1},
+			"timeline_url": "https://api.github.com/repos/o/r/discussions/9/timeline"
+		},
+		"answer": {
+			"id": 3,
+			"discussion_id": 1,
+			"parent_id": null,
+			"body": "Like this.",
+			"user": {"login": "helper"}
+		}
+	}`
+
+	event, err := ParseWebHook("discussion", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+
+	want := &DiscussionEvent{
+		Action: String("answered"),
+		Discussion: &Discussion{
+			ID:     Int64(1),
+			Number: Int(9),
+			Title:  String("How do I?"),
+			State:  String("open"),
+			DiscussionCategory: &DiscussionCategory{
+				ID:           Int64(2),
+				Emoji:        String(":pray:"),
+				Name:         String("Q&A"),
+				Slug:         String("q-a"),
+				IsAnswerable: Bool(true),
+			},
+			AnswerHTMLURL:  String("https://github.com/o/r/discussions/9#discussioncomment-3"),
+			AnswerChosenAt: &Timestamp{referenceTime},
+			AnswerChosenBy: &User{Login: String("maintainer"), ID: Int64(4)},
+			Labels:         []*Label{{Name: String("question")}},
+			Reactions:      &Reactions{TotalCount: Int(1), PlusOne: Int(1)},
+			TimelineURL:    String("https://api.github.com/repos/o/r/discussions/9/timeline"),
+		},
+		Answer: &CommentDiscussion{
+			ID:           Int64(3),
+			DiscussionID: Int64(1),
+			Body:         String("Like this."),
+			User:         &User{Login: String("helper")},
+		},
+	}
+	if !cmp.Equal(event, want) {
+		t.Errorf("ParseWebHook returned %+v, want %+v", event, want)
+	}
+}
+
+func TestDiscussionCommentEvent_edited(t *testing.T) {
+	payload := `{
+		"action": "edited",
+		"changes": {"body": {"from": "Old"}},
+		"discussion": {"id": 1, "category": {"id": 2, "emoji": "💬", "name": "General"}},
+		"comment": {"id": 3, "body": "New"}
+	}`
+
+	event, err := ParseWebHook("discussion_comment", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+
+	want := &DiscussionCommentEvent{
+		Action:  String("edited"),
+		Changes: &EditChange{Body: &EditBody{From: String("Old")}},
+		Discussion: &Discussion{
+			ID:                 Int64(1),
+			DiscussionCategory: &DiscussionCategory{ID: Int64(2), Emoji: String("💬"), Name: String("General")},
+		},
+		Comment: &CommentDiscussion{ID: Int64(3), Body: String("New")},
+	}
+	if !cmp.Equal(event, want) {
+		t.Errorf("ParseWebHook returned %+v, want %+v", event, want)
+	}
+}
+
 func TestDiscussionEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &DiscussionEvent{}, "{}")
 
@@ -7689,8 +7806,7 @@ func TestPersonalAccessTokenRequestEvent_Marshal(t *testing.T) {
 func TestPingEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PingEvent{}, "{}")
 
-	l := make(map[string]interface{})
-	l["key"] = "value"
+	l := &HookLastResponse{Code: Int(200), Status: String("active"), Message: String("OK")}
 	hookConfig := new(HookConfig)
 
 	u := &PingEvent{
@@ -7818,7 +7934,9 @@ func TestPingEvent_Marshal(t *testing.T) {
 			"test_url": "tu",
 			"ping_url": "pu",
 			"last_response": {
-				"key": "value"
+				"code": 200,
+				"status": "active",
+				"message": "OK"
 			},
 			"config": {
 				"key": "value"
@@ -12184,8 +12302,7 @@ func TestDeployKeyEvent_Marshal(t *testing.T) {
 func TestMetaEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &MetaEvent{}, "{}")
 
-	v := make(map[string]interface{})
-	v["a"] = "b"
+	v := &HookLastResponse{Code: Int(422), Status: String("misconfigured"), Message: String("Invalid HTTP Response: 404")}
 	hookConfig := &HookConfig{
 		ContentType: String("json"),
 	}
@@ -12222,7 +12339,9 @@ func TestMetaEvent_Marshal(t *testing.T) {
 			"test_url": "tu",
 			"ping_url": "pu",
 			"last_response": {
-				"a": "b"
+				"code": 422,
+				"status": "misconfigured",
+				"message": "Invalid HTTP Response: 404"
 			},
 			"config": {
 				"content_type": "json"
@@ -14984,6 +15103,14 @@ func TestProjectV2ItemEvent_Marshal(t *testing.T) {
 				From: &Timestamp{referenceTime},
 				To:   &Timestamp{referenceTime},
 			},
+			FieldValue: &ProjectV2ItemFieldValueChange{
+				FieldNodeID:   String("fnid"),
+				FieldType:     String("single_select"),
+				FieldName:     String("Status"),
+				ProjectNumber: Int(1),
+				From:          &ProjectV2ItemFieldValue{SingleSelectOption: &ProjectV2SingleSelectOption{ID: String("o1"), Name: String("Todo")}},
+				To:            &ProjectV2ItemFieldValue{SingleSelectOption: &ProjectV2SingleSelectOption{ID: String("o2"), Name: String("Done")}},
+			},
 		},
 		ProjectV2Item: &ProjectV2Item{
 			ID:            Int64(1),
@@ -15070,6 +15197,14 @@ func TestProjectV2ItemEvent_Marshal(t *testing.T) {
 			"archived_at": {
 				"from": ` + referenceTimeStr + `,
 				"to": ` + referenceTimeStr + `
+			},
+			"field_value": {
+				"field_node_id": "fnid",
+				"field_type": "single_select",
+				"field_name": "Status",
+				"project_number": 1,
+				"from": {"id": "o1", "name": "Todo"},
+				"to": {"id": "o2", "name": "Done"}
 			}
 		},
 		"projects_v2_item": {
@@ -0,0 +1,182 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+// +build ignore
+
+// gen-operations generates the registry of the REST API operations covered by
+// the service methods, from their //meta:operation directives.
+//
+// It is meant to be used by go-github contributors in conjunction with the
+// go generate tool before sending a PR to GitHub.
+// Please see the CONTRIBUTING.md file for more information.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	outputFile = "github-operations.go"
+	directive  = "//meta:operation "
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+type operation struct {
+	Method    string
+	Path      string
+	GoMethods []string
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, ok := pkgs["github"]
+	if !ok {
+		log.Fatal("package github not found")
+	}
+
+	ops := map[string]*operation{}
+	for filename, f := range pkg.Files {
+		logf("Processing %v...", filename)
+		if err := processAST(f, ops); err != nil {
+			log.Fatalf("%v: %v", filename, err)
+		}
+	}
+
+	if err := dump(ops); err != nil {
+		log.Fatal(err)
+	}
+	logf("Done.")
+}
+
+func sourceFilter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != outputFile
+}
+
+func processAST(f *ast.File, ops map[string]*operation) error {
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || fd.Doc == nil || !fd.Name.IsExported() {
+			continue
+		}
+		recv := receiverName(fd.Recv.List[0].Type)
+		if recv == "" {
+			continue
+		}
+		goMethod := recv + "." + fd.Name.Name
+
+		for _, c := range fd.Doc.List {
+			if !strings.HasPrefix(c.Text, directive) {
+				continue
+			}
+			fields := strings.Fields(strings.TrimPrefix(c.Text, directive))
+			if len(fields) != 2 {
+				return fmt.Errorf("%v: malformed directive %q", goMethod, c.Text)
+			}
+			key := fields[0] + " " + fields[1]
+			op, ok := ops[key]
+			if !ok {
+				op = &operation{Method: fields[0], Path: fields[1]}
+				ops[key] = op
+			}
+			op.GoMethods = append(op.GoMethods, goMethod)
+		}
+	}
+	return nil
+}
+
+func receiverName(expr ast.Expr) string {
+	if se, ok := expr.(*ast.StarExpr); ok {
+		expr = se.X
+	}
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+func dump(ops map[string]*operation) error {
+	var sorted []*operation
+	for _, op := range ops {
+		sort.Strings(op.GoMethods)
+		sorted = append(sorted, op)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].Method < sorted[j].Method
+	})
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, sorted); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format.Source:\n%v\n%v", buf.String(), err)
+	}
+
+	logf("Writing %v...", outputFile)
+	if err := os.Chmod(outputFile, 0644); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("os.Chmod(%q, 0644): %v", outputFile, err)
+	}
+
+	if err := os.WriteFile(outputFile, clean, 0444); err != nil {
+		return err
+	}
+
+	if err := os.Chmod(outputFile, 0444); err != nil {
+		return fmt.Errorf("os.Chmod(%q, 0444): %v", outputFile, err)
+	}
+
+	return nil
+}
+
+const source = `// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-operations; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package github
+
+var operations = []Operation{
+{{- range .}}
+	{Method: "{{.Method}}", Path: "{{.Path}}", GoMethods: []string{ {{- range $i, $m := .GoMethods}}{{if $i}}, {{end}}"{{$m}}"{{end -}} }},
+{{- end}}
+}
+`
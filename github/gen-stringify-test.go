@@ -43,7 +43,8 @@ var (
 	skipStructMethods = map[string]bool{}
 	// skipStructs lists structs to skip.
 	skipStructs = map[string]bool{
-		"RateLimits": true,
+		"RateLimits":           true,
+		"RepositoryCloneToken": true,
 	}
 
 	funcMap = template.FuncMap{
@@ -7,7 +7,10 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"time"
 )
 
@@ -48,6 +51,10 @@ type GistFile struct {
 	Type     *string `json:"type,omitempty"`
 	RawURL   *string `json:"raw_url,omitempty"`
 	Content  *string `json:"content,omitempty"`
+	// Truncated is true if Content only holds the start of the file, which
+	// happens for files over one megabyte. Use GistsService.FetchFullGistFile
+	// or RawURL to fetch the whole file.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 func (g GistFile) String() string {
@@ -104,7 +111,7 @@ type GistListOptions struct {
 func (s *GistsService) List(ctx context.Context, user string, opts *GistListOptions) ([]*Gist, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/gists", user)
+		u = fmt.Sprintf("users/%v/gists", PathEscape(user))
 	} else {
 		u = "gists"
 	}
@@ -183,7 +190,7 @@ func (s *GistsService) ListStarred(ctx context.Context, opts *GistListOptions) (
 //
 //meta:operation GET /gists/{gist_id}
 func (s *GistsService) Get(ctx context.Context, id string) (*Gist, *Response, error) {
-	u := fmt.Sprintf("gists/%v", id)
+	u := fmt.Sprintf("gists/%v", PathEscape(id))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -204,7 +211,7 @@ func (s *GistsService) Get(ctx context.Context, id string) (*Gist, *Response, er
 //
 //meta:operation GET /gists/{gist_id}/{sha}
 func (s *GistsService) GetRevision(ctx context.Context, id, sha string) (*Gist, *Response, error) {
-	u := fmt.Sprintf("gists/%v/%v", id, sha)
+	u := fmt.Sprintf("gists/%v/%v", PathEscape(id), sha)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -219,12 +226,16 @@ func (s *GistsService) GetRevision(ctx context.Context, id, sha string) (*Gist,
 	return gist, resp, nil
 }
 
-// Create a gist for authenticated user.
+// Create a gist for authenticated user. The gist must contain at least one file.
 //
 // GitHub API docs: https://docs.github.com/rest/gists/gists#create-a-gist
 //
 //meta:operation POST /gists
 func (s *GistsService) Create(ctx context.Context, gist *Gist) (*Gist, *Response, error) {
+	if gist == nil || len(gist.Files) == 0 {
+		return nil, nil, errors.New("gist must contain at least one file")
+	}
+
 	u := "gists"
 	req, err := s.client.NewRequest("POST", u, gist)
 	if err != nil {
@@ -240,13 +251,67 @@ func (s *GistsService) Create(ctx context.Context, gist *Gist) (*Gist, *Response
 	return g, resp, nil
 }
 
+// FetchFullGistFile fetches the file called filename of the gist with its
+// full content. GitHub truncates the content of files over one megabyte, in
+// which case the whole file is downloaded from its RawURL, without the
+// client's authentication. The returned Response is that of getting the gist.
+//
+// GitHub API docs: https://docs.github.com/rest/gists/gists#get-a-gist
+//
+//meta:operation GET /gists/{gist_id}
+func (s *GistsService) FetchFullGistFile(ctx context.Context, id string, filename GistFilename) (*GistFile, *Response, error) {
+	gist, resp, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	file, ok := gist.Files[filename]
+	if !ok {
+		return nil, resp, fmt.Errorf("gist %v has no file %q", id, filename)
+	}
+	if !file.GetTruncated() {
+		return &file, resp, nil
+	}
+	if file.GetRawURL() == "" {
+		return nil, resp, fmt.Errorf("truncated file %q has no raw URL", filename)
+	}
+
+	// The raw URL is on gist.githubusercontent.com, not the API, so it is
+	// fetched without the client's credentials, headers and rate limit
+	// handling.
+	req, err := http.NewRequestWithContext(ctx, "GET", file.GetRawURL(), nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	rawClient := &http.Client{Timeout: s.client.client.Timeout}
+	rawResp, err := rawClient.Do(req)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer rawResp.Body.Close()
+	if err := CheckResponse(rawResp); err != nil {
+		return nil, resp, err
+	}
+
+	content, err := io.ReadAll(rawResp.Body)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	file.Content = String(string(content))
+	file.Size = Int(len(content))
+	file.Truncated = Bool(false)
+	return &file, resp, nil
+}
+
 // Edit a gist.
 //
 // GitHub API docs: https://docs.github.com/rest/gists/gists#update-a-gist
 //
 //meta:operation PATCH /gists/{gist_id}
 func (s *GistsService) Edit(ctx context.Context, id string, gist *Gist) (*Gist, *Response, error) {
-	u := fmt.Sprintf("gists/%v", id)
+	u := fmt.Sprintf("gists/%v", PathEscape(id))
 	req, err := s.client.NewRequest("PATCH", u, gist)
 	if err != nil {
 		return nil, nil, err
@@ -267,7 +332,7 @@ func (s *GistsService) Edit(ctx context.Context, id string, gist *Gist) (*Gist,
 //
 //meta:operation GET /gists/{gist_id}/commits
 func (s *GistsService) ListCommits(ctx context.Context, id string, opts *ListOptions) ([]*GistCommit, *Response, error) {
-	u := fmt.Sprintf("gists/%v/commits", id)
+	u := fmt.Sprintf("gists/%v/commits", PathEscape(id))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -293,7 +358,7 @@ func (s *GistsService) ListCommits(ctx context.Context, id string, opts *ListOpt
 //
 //meta:operation DELETE /gists/{gist_id}
 func (s *GistsService) Delete(ctx context.Context, id string) (*Response, error) {
-	u := fmt.Sprintf("gists/%v", id)
+	u := fmt.Sprintf("gists/%v", PathEscape(id))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -308,7 +373,7 @@ func (s *GistsService) Delete(ctx context.Context, id string) (*Response, error)
 //
 //meta:operation PUT /gists/{gist_id}/star
 func (s *GistsService) Star(ctx context.Context, id string) (*Response, error) {
-	u := fmt.Sprintf("gists/%v/star", id)
+	u := fmt.Sprintf("gists/%v/star", PathEscape(id))
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
@@ -323,7 +388,7 @@ func (s *GistsService) Star(ctx context.Context, id string) (*Response, error) {
 //
 //meta:operation DELETE /gists/{gist_id}/star
 func (s *GistsService) Unstar(ctx context.Context, id string) (*Response, error) {
-	u := fmt.Sprintf("gists/%v/star", id)
+	u := fmt.Sprintf("gists/%v/star", PathEscape(id))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -338,7 +403,7 @@ func (s *GistsService) Unstar(ctx context.Context, id string) (*Response, error)
 //
 //meta:operation GET /gists/{gist_id}/star
 func (s *GistsService) IsStarred(ctx context.Context, id string) (bool, *Response, error) {
-	u := fmt.Sprintf("gists/%v/star", id)
+	u := fmt.Sprintf("gists/%v/star", PathEscape(id))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return false, nil, err
@@ -355,7 +420,7 @@ func (s *GistsService) IsStarred(ctx context.Context, id string) (bool, *Respons
 //
 //meta:operation POST /gists/{gist_id}/forks
 func (s *GistsService) Fork(ctx context.Context, id string) (*Gist, *Response, error) {
-	u := fmt.Sprintf("gists/%v/forks", id)
+	u := fmt.Sprintf("gists/%v/forks", PathEscape(id))
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -376,7 +441,7 @@ func (s *GistsService) Fork(ctx context.Context, id string) (*Gist, *Response, e
 //
 //meta:operation GET /gists/{gist_id}/forks
 func (s *GistsService) ListForks(ctx context.Context, id string, opts *ListOptions) ([]*GistFork, *Response, error) {
-	u := fmt.Sprintf("gists/%v/forks", id)
+	u := fmt.Sprintf("gists/%v/forks", PathEscape(id))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
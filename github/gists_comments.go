@@ -29,7 +29,7 @@ func (g GistComment) String() string {
 //
 //meta:operation GET /gists/{gist_id}/comments
 func (s *GistsService) ListComments(ctx context.Context, gistID string, opts *ListOptions) ([]*GistComment, *Response, error) {
-	u := fmt.Sprintf("gists/%v/comments", gistID)
+	u := fmt.Sprintf("gists/%v/comments", PathEscape(gistID))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -55,7 +55,7 @@ func (s *GistsService) ListComments(ctx context.Context, gistID string, opts *Li
 //
 //meta:operation GET /gists/{gist_id}/comments/{comment_id}
 func (s *GistsService) GetComment(ctx context.Context, gistID string, commentID int64) (*GistComment, *Response, error) {
-	u := fmt.Sprintf("gists/%v/comments/%v", gistID, commentID)
+	u := fmt.Sprintf("gists/%v/comments/%v", PathEscape(gistID), commentID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -76,7 +76,7 @@ func (s *GistsService) GetComment(ctx context.Context, gistID string, commentID
 //
 //meta:operation POST /gists/{gist_id}/comments
 func (s *GistsService) CreateComment(ctx context.Context, gistID string, comment *GistComment) (*GistComment, *Response, error) {
-	u := fmt.Sprintf("gists/%v/comments", gistID)
+	u := fmt.Sprintf("gists/%v/comments", PathEscape(gistID))
 	req, err := s.client.NewRequest("POST", u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -97,7 +97,7 @@ func (s *GistsService) CreateComment(ctx context.Context, gistID string, comment
 //
 //meta:operation PATCH /gists/{gist_id}/comments/{comment_id}
 func (s *GistsService) EditComment(ctx context.Context, gistID string, commentID int64, comment *GistComment) (*GistComment, *Response, error) {
-	u := fmt.Sprintf("gists/%v/comments/%v", gistID, commentID)
+	u := fmt.Sprintf("gists/%v/comments/%v", PathEscape(gistID), commentID)
 	req, err := s.client.NewRequest("PATCH", u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -118,7 +118,7 @@ func (s *GistsService) EditComment(ctx context.Context, gistID string, commentID
 //
 //meta:operation DELETE /gists/{gist_id}/comments/{comment_id}
 func (s *GistsService) DeleteComment(ctx context.Context, gistID string, commentID int64) (*Response, error) {
-	u := fmt.Sprintf("gists/%v/comments/%v", gistID, commentID)
+	u := fmt.Sprintf("gists/%v/comments/%v", PathEscape(gistID), commentID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -115,6 +115,8 @@ func TestGistsService_ListComments_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gists.ListComments(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Gists.ListComments(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_GetComment(t *testing.T) {
@@ -159,6 +161,8 @@ func TestGistsService_GetComment_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gists.GetComment(ctx, "%", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Gists.GetComment(ctx, "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_CreateComment(t *testing.T) {
@@ -212,6 +216,8 @@ func TestGistsService_CreateComment_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gists.CreateComment(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Gists.CreateComment(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_EditComment(t *testing.T) {
@@ -265,6 +271,8 @@ func TestGistsService_EditComment_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gists.EditComment(ctx, "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Gists.EditComment(ctx, "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_DeleteComment(t *testing.T) {
@@ -299,4 +307,6 @@ func TestGistsService_DeleteComment_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Gists.DeleteComment(ctx, "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Gists.DeleteComment(ctx, "..", 1)
+	testPathForbiddenError(t, err)
 }
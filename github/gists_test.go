@@ -308,6 +308,8 @@ func TestGistsService_List_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gists.List(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Gists.List(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_ListAll(t *testing.T) {
@@ -424,6 +426,121 @@ func TestGistsService_Get_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gists.Get(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Gists.Get(ctx, "..")
+	testPathForbiddenError(t, err)
+}
+
+func TestGistsService_FetchFullGistFile(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	client = client.WithAuthToken("token")
+	rawURL := serverURL + baseURLPath + "/raw/1/abc/big.txt"
+	mux.HandleFunc("/gists/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer token")
+		fmt.Fprintf(w, `{"id":"1","files":{"big.txt":{"filename":"big.txt","size":12,"raw_url":%q,"content":"Hello","truncated":true}}}`, rawURL)
+	})
+	mux.HandleFunc("/raw/1/abc/big.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "")
+		testHeader(t, r, "Accept", "")
+		testHeader(t, r, headerAPIVersion, "")
+		fmt.Fprint(w, "Hello World!")
+	})
+
+	ctx := context.Background()
+	file, _, err := client.Gists.FetchFullGistFile(ctx, "1", "big.txt")
+	if err != nil {
+		t.Errorf("Gists.FetchFullGistFile returned error: %v", err)
+	}
+	want := &GistFile{
+		Filename:  String("big.txt"),
+		Size:      Int(12),
+		RawURL:    String(rawURL),
+		Content:   String("Hello World!"),
+		Truncated: Bool(false),
+	}
+	if !cmp.Equal(file, want) {
+		t.Errorf("Gists.FetchFullGistFile returned %+v, want %+v", file, want)
+	}
+
+	const methodName = "FetchFullGistFile"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Gists.FetchFullGistFile(ctx, "\n", "big.txt")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Gists.FetchFullGistFile(ctx, "1", "big.txt")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGistsService_FetchFullGistFile_rawError(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	rawURL := serverURL + baseURLPath + "/raw/1/abc/big.txt"
+	mux.HandleFunc("/gists/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":"1","files":{"big.txt":{"raw_url":%q,"truncated":true}}}`, rawURL)
+	})
+	mux.HandleFunc("/raw/1/abc/big.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	file, resp, err := client.Gists.FetchFullGistFile(ctx, "1", "big.txt")
+	if err == nil {
+		t.Error("Gists.FetchFullGistFile returned no error")
+	}
+	if file != nil {
+		t.Errorf("Gists.FetchFullGistFile returned %+v, want nil", file)
+	}
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("Gists.FetchFullGistFile returned response %+v, want the gist response", resp)
+	}
+}
+
+func TestGistsService_FetchFullGistFile_notTruncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/gists/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"1","files":{"small.txt":{"filename":"small.txt","content":"Hello","truncated":false}}}`)
+	})
+
+	ctx := context.Background()
+	file, _, err := client.Gists.FetchFullGistFile(ctx, "1", "small.txt")
+	if err != nil {
+		t.Errorf("Gists.FetchFullGistFile returned error: %v", err)
+	}
+	want := &GistFile{Filename: String("small.txt"), Content: String("Hello"), Truncated: Bool(false)}
+	if !cmp.Equal(file, want) {
+		t.Errorf("Gists.FetchFullGistFile returned %+v, want %+v", file, want)
+	}
+}
+
+func TestGistsService_FetchFullGistFile_invalidFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/gists/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"1","files":{"big.txt":{"truncated":true}}}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Gists.FetchFullGistFile(ctx, "1", "missing.txt"); err == nil {
+		t.Error("Expected error to be returned because the gist has no such file")
+	}
+	if _, _, err := client.Gists.FetchFullGistFile(ctx, "1", "big.txt"); err == nil {
+		t.Error("Expected error to be returned because the file has no raw URL")
+	}
 }
 
 func TestGistsService_GetRevision(t *testing.T) {
@@ -533,6 +650,22 @@ func TestGistsService_Create(t *testing.T) {
 	})
 }
 
+func TestGistsService_Create_noFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/gists", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Gists.Create should not send a request for a gist without files")
+	})
+
+	ctx := context.Background()
+	for _, gist := range []*Gist{nil, {Description: String("d")}, {Files: map[GistFilename]GistFile{}}} {
+		if _, _, err := client.Gists.Create(ctx, gist); err == nil {
+			t.Errorf("Gists.Create(%+v) returned no error", gist)
+		}
+	}
+}
+
 func TestGistsService_Edit(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -611,6 +744,8 @@ func TestGistsService_Edit_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gists.Edit(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Gists.Edit(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_ListCommits(t *testing.T) {
@@ -715,6 +850,8 @@ func TestGistsService_ListCommits_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gists.ListCommits(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Gists.ListCommits(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_Delete(t *testing.T) {
@@ -749,6 +886,8 @@ func TestGistsService_Delete_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Gists.Delete(ctx, "%")
 	testURLParseError(t, err)
+	_, err = client.Gists.Delete(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_Star(t *testing.T) {
@@ -783,6 +922,8 @@ func TestGistsService_Star_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Gists.Star(ctx, "%")
 	testURLParseError(t, err)
+	_, err = client.Gists.Star(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_Unstar(t *testing.T) {
@@ -817,6 +958,8 @@ func TestGistsService_Unstar_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Gists.Unstar(ctx, "%")
 	testURLParseError(t, err)
+	_, err = client.Gists.Unstar(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_IsStarred_hasStar(t *testing.T) {
@@ -892,6 +1035,8 @@ func TestGistsService_IsStarred_invalidID(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gists.IsStarred(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Gists.IsStarred(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestGistsService_Fork(t *testing.T) {
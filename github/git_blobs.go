@@ -8,7 +8,13 @@ package github
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"hash"
+	"io"
+	"strings"
 )
 
 // Blob represents a blob object.
@@ -27,7 +33,7 @@ type Blob struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/git/blobs/{file_sha}
 func (s *GitService) GetBlob(ctx context.Context, owner string, repo string, sha string) (*Blob, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", owner, repo, sha)
+	u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", PathEscape(owner), PathEscape(repo), sha)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -49,7 +55,7 @@ func (s *GitService) GetBlob(ctx context.Context, owner string, repo string, sha
 //
 //meta:operation GET /repos/{owner}/{repo}/git/blobs/{file_sha}
 func (s *GitService) GetBlobRaw(ctx context.Context, owner, repo, sha string) ([]byte, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", owner, repo, sha)
+	u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", PathEscape(owner), PathEscape(repo), sha)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -66,13 +72,38 @@ func (s *GitService) GetBlobRaw(ctx context.Context, owner, repo, sha string) ([
 	return buf.Bytes(), resp, nil
 }
 
+// DownloadBlob returns an io.ReadCloser that reads the raw contents of a blob.
+// Unlike GetBlobRaw, the contents are streamed from the response rather than
+// read into memory, which makes it suitable for large blobs. It is the caller's
+// responsibility to close the ReadCloser.
+//
+// GitHub API docs: https://docs.github.com/rest/git/blobs#get-a-blob
+//
+//meta:operation GET /repos/{owner}/{repo}/git/blobs/{file_sha}
+func (s *GitService) DownloadBlob(ctx context.Context, owner, repo, sha string) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", PathEscape(owner), PathEscape(repo), sha)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github.v3.raw")
+
+	resp, err := s.client.BareDo(ctx, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resp.Body, resp, nil
+}
+
 // CreateBlob creates a blob object.
 //
 // GitHub API docs: https://docs.github.com/rest/git/blobs#create-a-blob
 //
 //meta:operation POST /repos/{owner}/{repo}/git/blobs
 func (s *GitService) CreateBlob(ctx context.Context, owner string, repo string, blob *Blob) (*Blob, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/git/blobs", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/git/blobs", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, blob)
 	if err != nil {
 		return nil, nil, err
@@ -86,3 +117,82 @@ func (s *GitService) CreateBlob(ctx context.Context, owner string, repo string,
 
 	return t, resp, nil
 }
+
+// CreateBlobFromReader creates a blob object from the size bytes read from r.
+// The content is base64 encoded while it is uploaded, so that neither the
+// content nor its encoding have to be held in memory.
+//
+// If verify is true, the SHA returned by GitHub is compared with the git blob
+// hash of the uploaded content, and an error is returned if they differ.
+//
+// Since r is only read once, the request has no GetBody and cannot be retried
+// or resent on a redirect. r is no longer read once CreateBlobFromReader
+// returns.
+//
+// GitHub API docs: https://docs.github.com/rest/git/blobs#create-a-blob
+//
+//meta:operation POST /repos/{owner}/{repo}/git/blobs
+func (s *GitService) CreateBlobFromReader(ctx context.Context, owner, repo string, r io.Reader, size int64, verify bool) (*Blob, *Response, error) {
+	const (
+		bodyPrefix = `{"encoding":"base64","content":"`
+		bodySuffix = `"}`
+	)
+
+	u := fmt.Sprintf("repos/%v/%v/git/blobs", PathEscape(owner), PathEscape(repo))
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := newBlobHash(size)
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		enc := base64.NewEncoder(base64.StdEncoding, pw)
+		n, err := io.Copy(enc, io.TeeReader(io.LimitReader(r, size), h))
+		if err == nil && n != size {
+			err = io.ErrUnexpectedEOF
+		}
+		if err == nil {
+			err = enc.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req.Body = io.NopCloser(io.MultiReader(strings.NewReader(bodyPrefix), pr, strings.NewReader(bodySuffix)))
+	req.ContentLength = int64(len(bodyPrefix)) + int64(base64.StdEncoding.EncodedLen(int(size))) + int64(len(bodySuffix))
+	req.Header.Set("Content-Type", "application/json")
+
+	t := new(Blob)
+	resp, err := s.client.Do(ctx, req, t)
+
+	// Stop the encoding if the upload ended early, and wait for it so that r
+	// and h are no longer used.
+	if err != nil {
+		pr.CloseWithError(err)
+	} else {
+		pr.Close()
+	}
+	<-done
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if verify {
+		if want := hex.EncodeToString(h.Sum(nil)); t.GetSHA() != want {
+			return nil, resp, fmt.Errorf("blob SHA mismatch: GitHub returned %q, computed %q", t.GetSHA(), want)
+		}
+	}
+
+	return t, resp, nil
+}
+
+// newBlobHash returns a hash that computes the git object ID of a blob of the
+// given size once its content is written to it.
+func newBlobHash(size int64) hash.Hash {
+	h := sha1.New()
+	fmt.Fprintf(h, "blob %d\x00", size)
+	return h
+}
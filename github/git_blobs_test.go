@@ -8,9 +8,13 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -169,6 +173,264 @@ func TestGitService_CreateBlob_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Git.CreateBlob(ctx, "%", "%", &Blob{})
 	testURLParseError(t, err)
+	_, _, err = client.Git.CreateBlob(ctx, "..", "..", &Blob{})
+	testPathForbiddenError(t, err)
+}
+
+func TestGitService_DownloadBlob(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.raw")
+
+		fmt.Fprint(w, `raw contents here`)
+	})
+
+	ctx := context.Background()
+	rc, _, err := client.Git.DownloadBlob(ctx, "o", "r", "s")
+	if err != nil {
+		t.Fatalf("Git.DownloadBlob returned error: %v", err)
+	}
+	defer rc.Close()
+
+	blob, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("reading blob returned error: %v", err)
+	}
+	if want := []byte("raw contents here"); !bytes.Equal(blob, want) {
+		t.Errorf("Git.DownloadBlob returned %q, want %q", blob, want)
+	}
+
+	const methodName = "DownloadBlob"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.DownloadBlob(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Git.DownloadBlob(ctx, "o", "r", "s")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGitService_CreateBlobFromReader(t *testing.T) {
+	const (
+		content = "blob content"
+		sha     = "a6631cacc073854915fc7bdd06d6350bc8835495"
+	)
+
+	tests := []struct {
+		name      string
+		returnSHA string
+		verify    bool
+		wantErr   bool
+	}{
+		{name: "verified", returnSHA: sha, verify: true},
+		{name: "mismatch", returnSHA: "deadbeef", verify: true, wantErr: true},
+		{name: "unverified", returnSHA: "deadbeef"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testHeader(t, r, "Content-Type", "application/json")
+
+				if want := int64(len(`{"encoding":"base64","content":""}`) + base64.StdEncoding.EncodedLen(len(content))); r.ContentLength != want {
+					t.Errorf("Request ContentLength = %v, want %v", r.ContentLength, want)
+				}
+
+				v := new(Blob)
+				assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+				want := &Blob{
+					Content:  String(base64.StdEncoding.EncodeToString([]byte(content))),
+					Encoding: String("base64"),
+				}
+				if !cmp.Equal(v, want) {
+					t.Errorf("Request body = %+v, want %+v", v, want)
+				}
+
+				fmt.Fprintf(w, `{"sha": %q, "size": %d}`, test.returnSHA, len(content))
+			})
+
+			ctx := context.Background()
+			blob, _, err := client.Git.CreateBlobFromReader(ctx, "o", "r", strings.NewReader(content), int64(len(content)), test.verify)
+			if test.wantErr {
+				if err == nil {
+					t.Error("Git.CreateBlobFromReader returned nil error, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Git.CreateBlobFromReader returned error: %v", err)
+			}
+
+			want := &Blob{SHA: String(test.returnSHA), Size: Int(len(content))}
+			if !cmp.Equal(blob, want) {
+				t.Errorf("Git.CreateBlobFromReader returned %+v, want %+v", blob, want)
+			}
+		})
+	}
+}
+
+func TestGitService_CreateBlobFromReader_shortRead(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Git.CreateBlobFromReader(ctx, "o", "r", strings.NewReader("short"), 100, false); err == nil {
+		t.Error("Git.CreateBlobFromReader returned nil error for short reader, want error")
+	}
+
+	const methodName = "CreateBlobFromReader"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.CreateBlobFromReader(ctx, "\n", "\n", strings.NewReader(""), 0, false)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Git.CreateBlobFromReader(ctx, "o", "r", strings.NewReader("a"), 1, false)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+// stopReader is an endless reader that reports reads made once stopped is
+// set.
+type stopReader struct {
+	t       *testing.T
+	stopped atomic.Bool
+}
+
+func (r *stopReader) Read(p []byte) (int, error) {
+	if r.stopped.Load() {
+		r.t.Error("stopReader read after being stopped")
+	}
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+func TestGitService_CreateBlobFromReader_stopsReading(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	})
+
+	ctx := context.Background()
+	r := &stopReader{t: t}
+	_, resp, err := client.Git.CreateBlobFromReader(ctx, "o", "r", r, 64<<20, false)
+	r.stopped.Store(true)
+	if err == nil {
+		t.Error("Git.CreateBlobFromReader returned nil error, want error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Git.CreateBlobFromReader returned response %v, want a 422", resp)
+	}
+}
+
+func TestNewBlobHash(t *testing.T) {
+	h := newBlobHash(int64(len("blob content")))
+	h.Write([]byte("blob content"))
+	// Matches `printf 'blob content' | git hash-object --stdin`.
+	if got, want := fmt.Sprintf("%x", h.Sum(nil)), "a6631cacc073854915fc7bdd06d6350bc8835495"; got != want {
+		t.Errorf("newBlobHash = %v, want %v", got, want)
+	}
+}
+
+const benchmarkBlobSize = 50 << 20
+
+func BenchmarkGitService_CreateBlobFromReader(b *testing.B) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		fmt.Fprint(w, `{"sha":"s"}`)
+	})
+
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.SetBytes(benchmarkBlobSize)
+	for i := 0; i < b.N; i++ {
+		r := io.LimitReader(zeroReader{}, benchmarkBlobSize)
+		if _, _, err := client.Git.CreateBlobFromReader(ctx, "o", "r", r, benchmarkBlobSize, false); err != nil {
+			b.Fatalf("Git.CreateBlobFromReader returned error: %v", err)
+		}
+	}
+}
+
+func BenchmarkGitService_CreateBlob(b *testing.B) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		fmt.Fprint(w, `{"sha":"s"}`)
+	})
+
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.SetBytes(benchmarkBlobSize)
+	for i := 0; i < b.N; i++ {
+		content, _ := io.ReadAll(io.LimitReader(zeroReader{}, benchmarkBlobSize))
+		blob := &Blob{
+			Content:  String(base64.StdEncoding.EncodeToString(content)),
+			Encoding: String("base64"),
+		}
+		if _, _, err := client.Git.CreateBlob(ctx, "o", "r", blob); err != nil {
+			b.Fatalf("Git.CreateBlob returned error: %v", err)
+		}
+	}
+}
+
+func BenchmarkGitService_DownloadBlob(b *testing.B) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(w, io.LimitReader(zeroReader{}, benchmarkBlobSize))
+	})
+
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.SetBytes(benchmarkBlobSize)
+	for i := 0; i < b.N; i++ {
+		rc, _, err := client.Git.DownloadBlob(ctx, "o", "r", "s")
+		if err != nil {
+			b.Fatalf("Git.DownloadBlob returned error: %v", err)
+		}
+		_, _ = io.Copy(io.Discard, rc)
+		rc.Close()
+	}
+}
+
+// zeroReader is an io.Reader that reads an endless stream of zero bytes.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
 }
 
 func TestBlob_Marshal(t *testing.T) {
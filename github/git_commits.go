@@ -8,6 +8,7 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -16,10 +17,57 @@ import (
 
 // SignatureVerification represents GPG signature verification.
 type SignatureVerification struct {
-	Verified  *bool   `json:"verified,omitempty"`
-	Reason    *string `json:"reason,omitempty"`
-	Signature *string `json:"signature,omitempty"`
-	Payload   *string `json:"payload,omitempty"`
+	Verified *bool `json:"verified,omitempty"`
+	// Reason is one of the SignatureVerificationReason* constants.
+	Reason     *string    `json:"reason,omitempty"`
+	Signature  *string    `json:"signature,omitempty"`
+	Payload    *string    `json:"payload,omitempty"`
+	VerifiedAt *Timestamp `json:"verified_at,omitempty"`
+}
+
+// Reasons reported in SignatureVerification.Reason.
+const (
+	SignatureVerificationReasonExpiredKey           = "expired_key"
+	SignatureVerificationReasonNotSigningKey        = "not_signing_key"
+	SignatureVerificationReasonGPGVerifyError       = "gpgverify_error"
+	SignatureVerificationReasonGPGVerifyUnavailable = "gpgverify_unavailable"
+	SignatureVerificationReasonUnsigned             = "unsigned"
+	SignatureVerificationReasonUnknownSignatureType = "unknown_signature_type"
+	SignatureVerificationReasonNoUser               = "no_user"
+	SignatureVerificationReasonUnverifiedEmail      = "unverified_email"
+	SignatureVerificationReasonBadEmail             = "bad_email"
+	SignatureVerificationReasonUnknownKey           = "unknown_key"
+	SignatureVerificationReasonMalformedSignature   = "malformed_signature"
+	SignatureVerificationReasonInvalid              = "invalid"
+	SignatureVerificationReasonValid                = "valid"
+	SignatureVerificationReasonBadCert              = "bad_cert"
+	SignatureVerificationReasonOCSPPending          = "ocsp_pending"
+	SignatureVerificationReasonOCSPError            = "ocsp_error"
+	SignatureVerificationReasonOCSPRevoked          = "ocsp_revoked"
+)
+
+// IsVerifiedBy reports whether v is a verified signature made by key or one of
+// its subkeys. The signing key is read from the issuer of the armored OpenPGP
+// signature in v.Signature, and compared with the key IDs of key.
+func (v *SignatureVerification) IsVerifiedBy(key *GPGKey) bool {
+	if !v.GetVerified() || key == nil {
+		return false
+	}
+
+	keyID, err := signatureIssuerKeyID(v.GetSignature())
+	if err != nil {
+		return false
+	}
+
+	if strings.EqualFold(key.GetKeyID(), keyID) {
+		return true
+	}
+	for _, subkey := range key.Subkeys {
+		if strings.EqualFold(subkey.GetKeyID(), keyID) {
+			return true
+		}
+	}
+	return false
 }
 
 // MessageSigner is used by GitService.CreateCommit to sign a commit.
@@ -86,7 +134,7 @@ func (c CommitAuthor) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/git/commits/{commit_sha}
 func (s *GitService) GetCommit(ctx context.Context, owner string, repo string, sha string) (*Commit, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/git/commits/%v", owner, repo, sha)
+	u := fmt.Sprintf("repos/%v/%v/git/commits/%v", PathEscape(owner), PathEscape(repo), sha)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -135,22 +183,9 @@ func (s *GitService) CreateCommit(ctx context.Context, owner string, repo string
 		opts = &CreateCommitOptions{}
 	}
 
-	u := fmt.Sprintf("repos/%v/%v/git/commits", owner, repo)
-
-	parents := make([]string, len(commit.Parents))
-	for i, parent := range commit.Parents {
-		parents[i] = *parent.SHA
-	}
+	u := fmt.Sprintf("repos/%v/%v/git/commits", PathEscape(owner), PathEscape(repo))
 
-	body := &createCommit{
-		Author:    commit.Author,
-		Committer: commit.Committer,
-		Message:   commit.Message,
-		Parents:   parents,
-	}
-	if commit.Tree != nil {
-		body.Tree = commit.Tree.SHA
-	}
+	body := newCreateCommit(commit)
 	switch {
 	case commit.Verification != nil:
 		body.Signature = commit.Verification.Signature
@@ -176,6 +211,36 @@ func (s *GitService) CreateCommit(ctx context.Context, owner string, repo string
 	return c, resp, nil
 }
 
+// newCreateCommit converts commit into the body of a CreateCommit request.
+func newCreateCommit(commit *Commit) *createCommit {
+	parents := make([]string, len(commit.Parents))
+	for i, parent := range commit.Parents {
+		parents[i] = *parent.SHA
+	}
+
+	body := &createCommit{
+		Author:    commit.Author,
+		Committer: commit.Committer,
+		Message:   commit.Message,
+		Parents:   parents,
+	}
+	if commit.Tree != nil {
+		body.Tree = commit.Tree.SHA
+	}
+	return body
+}
+
+// CommitSignaturePayload returns the payload that GitService.CreateCommit
+// passes to a MessageSigner for commit. It is the commit object exactly as git
+// stores it, without the signature, which is what GitHub verifies the
+// signature against. It can be used to test a MessageSigner on its own.
+func CommitSignaturePayload(commit *Commit) (string, error) {
+	if commit == nil {
+		return "", errors.New("commit must be provided")
+	}
+	return createSignatureMessage(newCreateCommit(commit))
+}
+
 func createSignature(signer MessageSigner, commit *createCommit) (string, error) {
 	if signer == nil {
 		return "", errors.New("createSignature: invalid parameters")
@@ -223,3 +288,183 @@ func createSignatureMessage(commit *createCommit) (string, error) {
 
 	return strings.Join(message, "\n"), nil
 }
+
+// signatureIssuerKeyID returns the ID of the key that made the armored
+// OpenPGP signature, as 16 uppercase hexadecimal digits.
+func signatureIssuerKeyID(armored string) (string, error) {
+	data, err := dearmorSignature(armored)
+	if err != nil {
+		return "", err
+	}
+
+	tag, body, err := readPacket(data)
+	if err != nil {
+		return "", err
+	}
+	if tag != 2 {
+		return "", fmt.Errorf("signature: unexpected packet tag %v", tag)
+	}
+	if len(body) == 0 {
+		return "", errors.New("signature: empty signature packet")
+	}
+
+	switch body[0] {
+	case 3:
+		// Version 3 signatures hold the issuer key ID at a fixed offset.
+		if len(body) < 15 {
+			return "", errors.New("signature: short signature packet")
+		}
+		return fmt.Sprintf("%X", body[7:15]), nil
+	case 4:
+		// Version 4 signatures hold it in their hashed or unhashed subpackets.
+		rest := body[4:]
+		var fingerprintKeyID string
+		for i := 0; i < 2; i++ {
+			if len(rest) < 2 {
+				return "", errors.New("signature: short signature packet")
+			}
+			n := int(rest[0])<<8 | int(rest[1])
+			if len(rest) < 2+n {
+				return "", errors.New("signature: short signature packet")
+			}
+			keyID, fprKeyID, err := subpacketsIssuer(rest[2 : 2+n])
+			if err != nil {
+				return "", err
+			}
+			if keyID != "" {
+				return keyID, nil
+			}
+			if fingerprintKeyID == "" {
+				fingerprintKeyID = fprKeyID
+			}
+			rest = rest[2+n:]
+		}
+		if fingerprintKeyID != "" {
+			return fingerprintKeyID, nil
+		}
+		return "", errors.New("signature: no issuer")
+	default:
+		return "", fmt.Errorf("signature: unsupported version %v", body[0])
+	}
+}
+
+// dearmorSignature decodes an ASCII armored OpenPGP signature.
+func dearmorSignature(armored string) ([]byte, error) {
+	lines := strings.Split(strings.ReplaceAll(armored, "\r\n", "\n"), "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) != "-----BEGIN PGP SIGNATURE-----" {
+		lines = lines[1:]
+	}
+	if len(lines) == 0 {
+		return nil, errors.New("signature: not an armored PGP signature")
+	}
+	lines = lines[1:]
+
+	// Skip the armor headers, which end at the first empty line.
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) != "" {
+		lines = lines[1:]
+	}
+
+	var encoded strings.Builder
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "=") || strings.HasPrefix(line, "-----END") {
+			break
+		}
+		encoded.WriteString(line)
+	}
+
+	return base64.StdEncoding.DecodeString(encoded.String())
+}
+
+// readPacket returns the tag and body of the OpenPGP packet at the start of
+// data.
+func readPacket(data []byte) (tag byte, body []byte, err error) {
+	if len(data) < 2 || data[0]&0x80 == 0 {
+		return 0, nil, errors.New("signature: invalid packet header")
+	}
+
+	var n, header int
+	if data[0]&0x40 != 0 {
+		// New format packet.
+		tag = data[0] & 0x3f
+		switch l := data[1]; {
+		case l < 192:
+			n, header = int(l), 2
+		case l < 224:
+			if len(data) < 3 {
+				return 0, nil, errors.New("signature: invalid packet header")
+			}
+			n, header = (int(l)-192)<<8+int(data[2])+192, 3
+		case l == 255:
+			if len(data) < 6 {
+				return 0, nil, errors.New("signature: invalid packet header")
+			}
+			n, header = int(data[2])<<24|int(data[3])<<16|int(data[4])<<8|int(data[5]), 6
+		default:
+			return 0, nil, errors.New("signature: partial packet lengths are not supported")
+		}
+	} else {
+		// Old format packet.
+		tag = (data[0] >> 2) & 0xf
+		switch data[0] & 3 {
+		case 0:
+			n, header = int(data[1]), 2
+		case 1:
+			if len(data) < 3 {
+				return 0, nil, errors.New("signature: invalid packet header")
+			}
+			n, header = int(data[1])<<8|int(data[2]), 3
+		case 2:
+			if len(data) < 5 {
+				return 0, nil, errors.New("signature: invalid packet header")
+			}
+			n, header = int(data[1])<<24|int(data[2])<<16|int(data[3])<<8|int(data[4]), 5
+		default:
+			n, header = len(data)-1, 1
+		}
+	}
+
+	if n < 0 || len(data) < header+n {
+		return 0, nil, errors.New("signature: short packet")
+	}
+	return tag, data[header : header+n], nil
+}
+
+// subpacketsIssuer returns the key IDs given by the issuer and issuer
+// fingerprint subpackets of a version 4 signature, if present.
+func subpacketsIssuer(data []byte) (keyID, fingerprintKeyID string, err error) {
+	for len(data) > 0 {
+		var n, header int
+		switch l := data[0]; {
+		case l < 192:
+			n, header = int(l), 1
+		case l < 255:
+			if len(data) < 2 {
+				return "", "", errors.New("signature: invalid subpacket")
+			}
+			n, header = (int(l)-192)<<8+int(data[1])+192, 2
+		default:
+			if len(data) < 5 {
+				return "", "", errors.New("signature: invalid subpacket")
+			}
+			n, header = int(data[1])<<24|int(data[2])<<16|int(data[3])<<8|int(data[4]), 5
+		}
+		if n < 1 || len(data) < header+n {
+			return "", "", errors.New("signature: invalid subpacket")
+		}
+
+		subpacket := data[header : header+n]
+		switch value := subpacket[1:]; subpacket[0] & 0x7f {
+		case 16: // Issuer key ID.
+			if len(value) == 8 {
+				keyID = fmt.Sprintf("%X", value)
+			}
+		case 33: // Issuer fingerprint, of which the key ID is the last 8 bytes.
+			if len(value) == 21 && value[0] == 4 {
+				fingerprintKeyID = fmt.Sprintf("%X", value[len(value)-8:])
+			}
+		}
+		data = data[header+n:]
+	}
+	return keyID, fingerprintKeyID, nil
+}
@@ -7,6 +7,8 @@ package github
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -506,6 +508,58 @@ Commit Message.`
 	}
 }
 
+func TestCommitSignaturePayload(t *testing.T) {
+	// Generated with git commit-tree; the object hashes to
+	// 1160e2d968e21ecf4450cd510a606515ba6e94b0.
+	want := "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n" +
+		"parent 0b432ffc44f60138e4323cdf0de61d01ef6a355f\n" +
+		"author Jane Doe <jane@example.com> 1709521567 +0200\n" +
+		"committer John Roe <john@example.com> 1709559550 -0530\n" +
+		"\n" +
+		"Add feature\n" +
+		"\n" +
+		"Longer body.\n"
+
+	commit := &Commit{
+		Message: String("Add feature\n\nLonger body.\n"),
+		Tree:    &Tree{SHA: String("4b825dc642cb6eb9a060e54bf8d69288fbee4904")},
+		Parents: []*Commit{{SHA: String("0b432ffc44f60138e4323cdf0de61d01ef6a355f")}},
+		Author: &CommitAuthor{
+			Name:  String("Jane Doe"),
+			Email: String("jane@example.com"),
+			Date:  &Timestamp{time.Date(2024, time.March, 4, 5, 6, 7, 0, time.FixedZone("", 2*60*60))},
+		},
+		Committer: &CommitAuthor{
+			Name:  String("John Roe"),
+			Email: String("john@example.com"),
+			Date:  &Timestamp{time.Date(2024, time.March, 4, 8, 9, 10, 0, time.FixedZone("", -(5*60*60+30*60)))},
+		},
+	}
+
+	got, err := CommitSignaturePayload(commit)
+	if err != nil {
+		t.Fatalf("CommitSignaturePayload returned error: %v", err)
+	}
+	if got != want {
+		t.Errorf("CommitSignaturePayload = %q, want %q", got, want)
+	}
+	if sha := gitObjectSHA("commit", got); sha != "1160e2d968e21ecf4450cd510a606515ba6e94b0" {
+		t.Errorf("CommitSignaturePayload hashes to %v, want 1160e2d968e21ecf4450cd510a606515ba6e94b0", sha)
+	}
+
+	if _, err := CommitSignaturePayload(nil); err == nil {
+		t.Error("CommitSignaturePayload(nil) returned no error")
+	}
+	if _, err := CommitSignaturePayload(&Commit{Message: String("m")}); err == nil {
+		t.Error("CommitSignaturePayload without author returned no error")
+	}
+}
+
+// gitObjectSHA returns the hash git assigns to an object of the given type and content.
+func gitObjectSHA(objectType, content string) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("%s %d\x00%s", objectType, len(content), content))))
+}
+
 func TestGitService_CreateCommit_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -513,28 +567,198 @@ func TestGitService_CreateCommit_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Git.CreateCommit(ctx, "%", "%", &Commit{}, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Git.CreateCommit(ctx, "..", "..", &Commit{}, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestSignatureVerification_Marshal(t *testing.T) {
 	testJSONMarshal(t, &SignatureVerification{}, "{}")
 
 	u := &SignatureVerification{
-		Verified:  Bool(true),
-		Reason:    String("reason"),
-		Signature: String("sign"),
-		Payload:   String("payload"),
+		Verified:   Bool(true),
+		Reason:     String("reason"),
+		Signature:  String("sign"),
+		Payload:    String("payload"),
+		VerifiedAt: &Timestamp{referenceTime},
 	}
 
 	want := `{
 		"verified": true,
 		"reason": "reason",
 		"signature": "sign",
-		"payload": "payload"
+		"payload": "payload",
+		"verified_at": ` + referenceTimeStr + `
 	}`
 
 	testJSONMarshal(t, u, want)
 }
 
+// testSignature is an armored signature made by the key with ID
+// F0D038BE45E1764C, holding both issuer and issuer fingerprint subpackets.
+const testSignature = `-----BEGIN PGP SIGNATURE-----
+
+iQEzBAABCgAdFiEEz0LHUDmMkaEg+n+S8NA4vkXhdkwFAmrPNgwACgkQ8NA4vkXh
+dkzHPQf/X9wpwZr3TPbrSV6wrt+ExVWj0HZQvIAqY8LA2ZmtiShkh1XUa4C87tp2
+exmh+PESAArr4muHnKcYBfxsGET0C92+U5d8y+m9ZcZnv7dOCweT1otlk4L91Ej/
+/OJTTjQickD7JVjVC5us+x9xXZJ9roH3xOt0kvSZlVB8J466srJq9RoUXsf+PcUI
+zwRskULwN+zURaDIsSqEwlCs3E4Qc+4iKM7GEe119SM6EFPsGn+3MFsAkDUT7pLD
+2ZL8L4/mBQF3VOS9vszRGhcTcP9h1IMjXUrvPZTM00VnmgMgKec0TlJDh98UPh+a
+qeWBBkERBsesnTqhRY9KIwhvipnZTw==
+=cbMj
+-----END PGP SIGNATURE-----`
+
+func TestSignatureVerification_IsVerifiedBy(t *testing.T) {
+	verified := &SignatureVerification{
+		Verified:  Bool(true),
+		Reason:    String(SignatureVerificationReasonValid),
+		Signature: String(testSignature),
+	}
+
+	tests := []struct {
+		name string
+		v    *SignatureVerification
+		key  *GPGKey
+		want bool
+	}{
+		{
+			name: "primary key",
+			v:    verified,
+			key:  &GPGKey{KeyID: String("F0D038BE45E1764C")},
+			want: true,
+		},
+		{
+			name: "lowercase key ID",
+			v:    verified,
+			key:  &GPGKey{KeyID: String("f0d038be45e1764c")},
+			want: true,
+		},
+		{
+			name: "subkey",
+			v:    verified,
+			key: &GPGKey{
+				KeyID:   String("3262EFF25BA0D270"),
+				Subkeys: []*GPGKey{{KeyID: String("F0D038BE45E1764C")}},
+			},
+			want: true,
+		},
+		{
+			name: "other key",
+			v:    verified,
+			key:  &GPGKey{KeyID: String("3262EFF25BA0D270")},
+		},
+		{
+			name: "nil key",
+			v:    verified,
+		},
+		{
+			name: "nil verification",
+			key:  &GPGKey{KeyID: String("F0D038BE45E1764C")},
+		},
+		{
+			name: "unverified",
+			v: &SignatureVerification{
+				Verified:  Bool(false),
+				Reason:    String(SignatureVerificationReasonUnknownKey),
+				Signature: String(testSignature),
+			},
+			key: &GPGKey{KeyID: String("F0D038BE45E1764C")},
+		},
+		{
+			name: "malformed signature",
+			v: &SignatureVerification{
+				Verified:  Bool(true),
+				Signature: String("-----BEGIN PGP SIGNATURE-----\n\n!!!\n-----END PGP SIGNATURE-----"),
+			},
+			key: &GPGKey{KeyID: String("F0D038BE45E1764C")},
+		},
+		{
+			name: "no signature",
+			v:    &SignatureVerification{Verified: Bool(true)},
+			key:  &GPGKey{KeyID: String("F0D038BE45E1764C")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.IsVerifiedBy(tt.key); got != tt.want {
+				t.Errorf("IsVerifiedBy returned %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitService_GetCommit_expiredKeyVerification(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{
+			"sha": "s",
+			"verification": {
+				"verified": false,
+				"reason": "expired_key",
+				"signature": %q,
+				"payload": "tree x\n\nmsg\n",
+				"verified_at": null
+			}
+		}`, testSignature)
+	})
+
+	ctx := context.Background()
+	commit, _, err := client.Git.GetCommit(ctx, "o", "r", "s")
+	if err != nil {
+		t.Fatalf("Git.GetCommit returned error: %v", err)
+	}
+
+	want := &SignatureVerification{
+		Verified:  Bool(false),
+		Reason:    String(SignatureVerificationReasonExpiredKey),
+		Signature: String(testSignature),
+		Payload:   String("tree x\n\nmsg\n"),
+	}
+	if !cmp.Equal(commit.Verification, want) {
+		t.Errorf("Git.GetCommit returned verification %+v, want %+v", commit.Verification, want)
+	}
+
+	key := &GPGKey{KeyID: String("F0D038BE45E1764C"), ExpiresAt: &Timestamp{referenceTime}}
+	if commit.Verification.IsVerifiedBy(key) {
+		t.Errorf("IsVerifiedBy returned true for a signature by an expired key")
+	}
+}
+
+func TestSignatureIssuerKeyID(t *testing.T) {
+	got, err := signatureIssuerKeyID(testSignature)
+	if err != nil {
+		t.Fatalf("signatureIssuerKeyID returned error: %v", err)
+	}
+	if want := "F0D038BE45E1764C"; got != want {
+		t.Errorf("signatureIssuerKeyID returned %v, want %v", got, want)
+	}
+
+	// An old format packet with a version 3 signature.
+	v3 := []byte{0x88, 15, 3, 5, 0, 0, 0, 0, 0, 0xF0, 0xD0, 0x38, 0xBE, 0x45, 0xE1, 0x76, 0x4C}
+	armored := "-----BEGIN PGP SIGNATURE-----\nVersion: test\n\n" + base64.StdEncoding.EncodeToString(v3) + "\n-----END PGP SIGNATURE-----\n"
+	got, err = signatureIssuerKeyID(armored)
+	if err != nil {
+		t.Fatalf("signatureIssuerKeyID returned error: %v", err)
+	}
+	if want := "F0D038BE45E1764C"; got != want {
+		t.Errorf("signatureIssuerKeyID returned %v, want %v", got, want)
+	}
+
+	for _, sig := range []string{
+		"",
+		"not a signature",
+		"-----BEGIN PGP SIGNATURE-----\n\nAAAA\n-----END PGP SIGNATURE-----",
+		"-----BEGIN PGP SIGNATURE-----\n\n" + base64.StdEncoding.EncodeToString([]byte{0xc2, 10, 4}) + "\n-----END PGP SIGNATURE-----",
+	} {
+		if _, err := signatureIssuerKeyID(sig); err == nil {
+			t.Errorf("signatureIssuerKeyID(%q) returned no error", sig)
+		}
+	}
+}
+
 func TestCommitAuthor_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CommitAuthor{}, "{}")
 
@@ -7,11 +7,27 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 )
 
+var (
+	// ErrRefProtected is returned by GitService.EnsureRef when GitHub rejects
+	// the update because the ref is protected.
+	ErrRefProtected = errors.New("ref is protected")
+
+	// ErrRefNotFastForward is returned by GitService.EnsureRef when the ref
+	// cannot be moved to the requested commit without force.
+	ErrRefNotFastForward = errors.New("ref update is not a fast forward")
+
+	// ErrRefConcurrentModification is returned by GitService.EnsureRef when
+	// the ref was created or deleted by someone else while it was being ensured.
+	ErrRefConcurrentModification = errors.New("ref was modified concurrently")
+)
+
 // Reference represents a GitHub reference.
 type Reference struct {
 	Ref    *string    `json:"ref"`
@@ -54,7 +70,7 @@ type updateRefRequest struct {
 //meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
 func (s *GitService) GetRef(ctx context.Context, owner string, repo string, ref string) (*Reference, *Response, error) {
 	ref = strings.TrimPrefix(ref, "refs/")
-	u := fmt.Sprintf("repos/%v/%v/git/ref/%v", owner, repo, refURLEscape(ref))
+	u := fmt.Sprintf("repos/%v/%v/git/ref/%v", PathEscape(owner), PathEscape(repo), refURLEscape(ref))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -98,7 +114,7 @@ func (s *GitService) ListMatchingRefs(ctx context.Context, owner, repo string, o
 	if opts != nil {
 		ref = strings.TrimPrefix(opts.Ref, "refs/")
 	}
-	u := fmt.Sprintf("repos/%v/%v/git/matching-refs/%v", owner, repo, refURLEscape(ref))
+	u := fmt.Sprintf("repos/%v/%v/git/matching-refs/%v", PathEscape(owner), PathEscape(repo), refURLEscape(ref))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -124,7 +140,7 @@ func (s *GitService) ListMatchingRefs(ctx context.Context, owner, repo string, o
 //
 //meta:operation POST /repos/{owner}/{repo}/git/refs
 func (s *GitService) CreateRef(ctx context.Context, owner string, repo string, ref *Reference) (*Reference, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/git/refs", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/git/refs", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, &createRefRequest{
 		// back-compat with previous behavior that didn't require 'refs/' prefix
 		Ref: String("refs/" + strings.TrimPrefix(*ref.Ref, "refs/")),
@@ -150,7 +166,7 @@ func (s *GitService) CreateRef(ctx context.Context, owner string, repo string, r
 //meta:operation PATCH /repos/{owner}/{repo}/git/refs/{ref}
 func (s *GitService) UpdateRef(ctx context.Context, owner string, repo string, ref *Reference, force bool) (*Reference, *Response, error) {
 	refPath := strings.TrimPrefix(*ref.Ref, "refs/")
-	u := fmt.Sprintf("repos/%v/%v/git/refs/%v", owner, repo, refURLEscape(refPath))
+	u := fmt.Sprintf("repos/%v/%v/git/refs/%v", PathEscape(owner), PathEscape(repo), refURLEscape(refPath))
 	req, err := s.client.NewRequest("PATCH", u, &updateRefRequest{
 		SHA:   ref.Object.SHA,
 		Force: &force,
@@ -175,7 +191,7 @@ func (s *GitService) UpdateRef(ctx context.Context, owner string, repo string, r
 //meta:operation DELETE /repos/{owner}/{repo}/git/refs/{ref}
 func (s *GitService) DeleteRef(ctx context.Context, owner string, repo string, ref string) (*Response, error) {
 	ref = strings.TrimPrefix(ref, "refs/")
-	u := fmt.Sprintf("repos/%v/%v/git/refs/%v", owner, repo, refURLEscape(ref))
+	u := fmt.Sprintf("repos/%v/%v/git/refs/%v", PathEscape(owner), PathEscape(repo), refURLEscape(ref))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -183,3 +199,73 @@ func (s *GitService) DeleteRef(ctx context.Context, owner string, repo string, r
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// EnsureRef makes sure that ref exists in a repository and points at sha.
+// If the ref does not exist it is created, otherwise it is updated. Unless force
+// is set, updates must be fast forwards.
+//
+// Errors returned by GitHub are classified where possible: errors.Is reports
+// whether the returned error is ErrRefProtected, ErrRefNotFastForward or
+// ErrRefConcurrentModification, while errors.As can still be used to get the
+// underlying *ErrorResponse.
+//
+// GitHub API docs: https://docs.github.com/rest/git/refs#create-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/refs#update-a-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation POST /repos/{owner}/{repo}/git/refs
+//meta:operation PATCH /repos/{owner}/{repo}/git/refs/{ref}
+func (s *GitService) EnsureRef(ctx context.Context, owner, repo, ref, sha string, force bool) (*Reference, *Response, error) {
+	ref = "refs/" + strings.TrimPrefix(ref, "refs/")
+
+	existing, resp, err := s.GetRef(ctx, owner, repo, ref)
+	if err != nil {
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return nil, resp, err
+		}
+
+		created, resp, err := s.CreateRef(ctx, owner, repo, &Reference{
+			Ref:    &ref,
+			Object: &GitObject{SHA: &sha},
+		})
+		if err != nil {
+			return nil, resp, classifyRefError(err)
+		}
+		return created, resp, nil
+	}
+
+	if existing.GetObject().GetSHA() == sha {
+		return existing, resp, nil
+	}
+
+	updated, resp, err := s.UpdateRef(ctx, owner, repo, &Reference{
+		Ref:    &ref,
+		Object: &GitObject{SHA: &sha},
+	}, force)
+	if err != nil {
+		return nil, resp, classifyRefError(err)
+	}
+	return updated, resp, nil
+}
+
+// classifyRefError wraps err with ErrRefProtected, ErrRefNotFastForward or
+// ErrRefConcurrentModification if it is a 422 response GitHub documents for
+// those conditions. Other errors are returned unchanged.
+func classifyRefError(err error) error {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+		return err
+	}
+
+	msg := strings.ToLower(errResp.Message)
+	switch {
+	case strings.Contains(msg, "protected"):
+		return fmt.Errorf("%w: %w", ErrRefProtected, err)
+	case strings.Contains(msg, "fast forward"), strings.Contains(msg, "fast-forward"):
+		return fmt.Errorf("%w: %w", ErrRefNotFastForward, err)
+	case strings.Contains(msg, "already exists"), strings.Contains(msg, "does not exist"):
+		return fmt.Errorf("%w: %w", ErrRefConcurrentModification, err)
+	}
+	return err
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -676,6 +677,203 @@ func TestGitService_UpdateRef_pathEscape(t *testing.T) {
 	}
 }
 
+func TestGitService_GetRef_pathEscapeReservedCharacters(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v1#rc%1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "/repos/o/r/git/ref/tags/v1%23rc%251"; r.URL.EscapedPath() != want {
+			t.Errorf("EscapedPath = %v, want %v", r.URL.EscapedPath(), want)
+		}
+		fmt.Fprint(w, `{"ref": "refs/tags/v1#rc%1"}`)
+	})
+
+	ctx := context.Background()
+	ref, _, err := client.Git.GetRef(ctx, "o", "r", "refs/tags/v1#rc%1")
+	if err != nil {
+		t.Fatalf("Git.GetRef returned error: %v", err)
+	}
+	if got, want := ref.GetRef(), "refs/tags/v1#rc%1"; got != want {
+		t.Errorf("Git.GetRef returned ref %q, want %q", got, want)
+	}
+}
+
+func TestGitService_EnsureRef(t *testing.T) {
+	const (
+		oldSHA = "aa218f56b14c9653891f9e74264a383fa43fefbd"
+		newSHA = "bb218f56b14c9653891f9e74264a383fa43fefbd"
+	)
+
+	tests := []struct {
+		name          string
+		ref           string
+		force         bool
+		existingSHA   string
+		createStatus  int
+		createMessage string
+		updateStatus  int
+		updateMessage string
+		wantMethods   []string
+		wantErr       error
+	}{
+		{
+			name:        "create new",
+			ref:         "tags/v1.0.0",
+			wantMethods: []string{"GET", "POST"},
+		},
+		{
+			name:        "already up to date",
+			ref:         "refs/heads/main",
+			existingSHA: newSHA,
+			wantMethods: []string{"GET"},
+		},
+		{
+			name:        "fast forward",
+			ref:         "heads/main",
+			existingSHA: oldSHA,
+			wantMethods: []string{"GET", "PATCH"},
+		},
+		{
+			name:        "force",
+			ref:         "heads/main",
+			force:       true,
+			existingSHA: oldSHA,
+			wantMethods: []string{"GET", "PATCH"},
+		},
+		{
+			name:          "protected",
+			ref:           "heads/main",
+			existingSHA:   oldSHA,
+			updateStatus:  http.StatusUnprocessableEntity,
+			updateMessage: "Cannot force-push to this protected branch",
+			wantMethods:   []string{"GET", "PATCH"},
+			wantErr:       ErrRefProtected,
+		},
+		{
+			name:          "not a fast forward",
+			ref:           "heads/main",
+			existingSHA:   oldSHA,
+			updateStatus:  http.StatusUnprocessableEntity,
+			updateMessage: "Update is not a fast forward",
+			wantMethods:   []string{"GET", "PATCH"},
+			wantErr:       ErrRefNotFastForward,
+		},
+		{
+			name:          "created concurrently",
+			ref:           "tags/v1.0.0",
+			createStatus:  http.StatusUnprocessableEntity,
+			createMessage: "Reference already exists",
+			wantMethods:   []string{"GET", "POST"},
+			wantErr:       ErrRefConcurrentModification,
+		},
+		{
+			name:          "deleted concurrently",
+			ref:           "heads/main",
+			existingSHA:   oldSHA,
+			updateStatus:  http.StatusUnprocessableEntity,
+			updateMessage: "Reference does not exist",
+			wantMethods:   []string{"GET", "PATCH"},
+			wantErr:       ErrRefConcurrentModification,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			refName := "refs/" + strings.TrimPrefix(test.ref, "refs/")
+			var methods []string
+
+			mux.HandleFunc("/repos/o/r/git/ref/"+strings.TrimPrefix(refName, "refs/"), func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				methods = append(methods, r.Method)
+				if test.existingSHA == "" {
+					w.WriteHeader(http.StatusNotFound)
+					fmt.Fprint(w, `{"message":"Not Found"}`)
+					return
+				}
+				fmt.Fprintf(w, `{"ref":%q,"object":{"sha":%q}}`, refName, test.existingSHA)
+			})
+			mux.HandleFunc("/repos/o/r/git/refs", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				methods = append(methods, r.Method)
+				testBody(t, r, fmt.Sprintf(`{"ref":%q,"sha":%q}`+"\n", refName, newSHA))
+				if test.createStatus != 0 {
+					w.WriteHeader(test.createStatus)
+					fmt.Fprintf(w, `{"message":%q}`, test.createMessage)
+					return
+				}
+				fmt.Fprintf(w, `{"ref":%q,"object":{"sha":%q}}`, refName, newSHA)
+			})
+			mux.HandleFunc("/repos/o/r/git/refs/"+strings.TrimPrefix(refName, "refs/"), func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PATCH")
+				methods = append(methods, r.Method)
+				testBody(t, r, fmt.Sprintf(`{"sha":%q,"force":%v}`+"\n", newSHA, test.force))
+				if test.updateStatus != 0 {
+					w.WriteHeader(test.updateStatus)
+					fmt.Fprintf(w, `{"message":%q}`, test.updateMessage)
+					return
+				}
+				fmt.Fprintf(w, `{"ref":%q,"object":{"sha":%q}}`, refName, newSHA)
+			})
+
+			ctx := context.Background()
+			ref, _, err := client.Git.EnsureRef(ctx, "o", "r", test.ref, newSHA, test.force)
+			if !cmp.Equal(methods, test.wantMethods) {
+				t.Errorf("Git.EnsureRef made requests %v, want %v", methods, test.wantMethods)
+			}
+
+			if test.wantErr != nil {
+				if !errors.Is(err, test.wantErr) {
+					t.Errorf("Git.EnsureRef returned error %v, want %v", err, test.wantErr)
+				}
+				var errResp *ErrorResponse
+				if !errors.As(err, &errResp) {
+					t.Errorf("Git.EnsureRef returned error %v, want it to wrap *ErrorResponse", err)
+				}
+				if ref != nil {
+					t.Errorf("Git.EnsureRef returned %+v, want nil", ref)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Git.EnsureRef returned error: %v", err)
+			}
+			want := &Reference{Ref: String(refName), Object: &GitObject{SHA: String(newSHA)}}
+			if !cmp.Equal(ref, want) {
+				t.Errorf("Git.EnsureRef returned %+v, want %+v", ref, want)
+			}
+		})
+	}
+}
+
+func TestGitService_EnsureRef_getFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Git.EnsureRef(ctx, "o", "r", "heads/main", "sha", false)
+	if err == nil {
+		t.Fatal("Git.EnsureRef returned nil error, want error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Git.EnsureRef returned response %+v, want status 500", resp)
+	}
+
+	const methodName = "EnsureRef"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.EnsureRef(ctx, "\n", "\n", "\n", "sha", false)
+		return err
+	})
+}
+
 func TestReference_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Reference{}, "{}")
 
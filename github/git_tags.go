@@ -6,8 +6,11 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 )
 
 // Tag represents a tag object.
@@ -39,7 +42,7 @@ type createTagRequest struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/git/tags/{tag_sha}
 func (s *GitService) GetTag(ctx context.Context, owner string, repo string, sha string) (*Tag, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/git/tags/%v", owner, repo, sha)
+	u := fmt.Sprintf("repos/%v/%v/git/tags/%v", PathEscape(owner), PathEscape(repo), sha)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -54,23 +57,47 @@ func (s *GitService) GetTag(ctx context.Context, owner string, repo string, sha
 	return tag, resp, nil
 }
 
+// CreateTagOptions specifies optional parameters to the GitService.CreateTagWithOptions method.
+type CreateTagOptions struct {
+	// CreateTagWithOptions will sign the tag with this signer. See MessageSigner doc for more details.
+	// Signed tags must have a Tagger with a Date.
+	Signer MessageSigner
+}
+
 // CreateTag creates a tag object.
 //
 // GitHub API docs: https://docs.github.com/rest/git/tags#create-a-tag-object
 //
 //meta:operation POST /repos/{owner}/{repo}/git/tags
 func (s *GitService) CreateTag(ctx context.Context, owner string, repo string, tag *Tag) (*Tag, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/git/tags", owner, repo)
+	return s.CreateTagWithOptions(ctx, owner, repo, tag, nil)
+}
 
-	// convert Tag into a createTagRequest
-	tagRequest := &createTagRequest{
-		Tag:     tag.Tag,
-		Message: tag.Message,
-		Tagger:  tag.Tagger,
+// CreateTagWithOptions creates a tag object, as CreateTag does, with the
+// optional parameters in opts.
+//
+// GitHub stores the signature of an annotated tag at the end of its message.
+// If opts.Signer is set, the tag is signed and the signature appended to the
+// message before the tag is created.
+//
+// GitHub API docs: https://docs.github.com/rest/git/tags#create-a-tag-object
+//
+//meta:operation POST /repos/{owner}/{repo}/git/tags
+func (s *GitService) CreateTagWithOptions(ctx context.Context, owner string, repo string, tag *Tag, opts *CreateTagOptions) (*Tag, *Response, error) {
+	if tag == nil {
+		return nil, nil, fmt.Errorf("tag must be provided")
 	}
-	if tag.Object != nil {
-		tagRequest.Object = tag.Object.SHA
-		tagRequest.Type = tag.Object.Type
+	if opts == nil {
+		opts = &CreateTagOptions{}
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/git/tags", PathEscape(owner), PathEscape(repo))
+
+	tagRequest := newCreateTagRequest(tag)
+	if opts.Signer != nil {
+		if err := signTagRequest(opts.Signer, tagRequest); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	req, err := s.client.NewRequest("POST", u, tagRequest)
@@ -86,3 +113,81 @@ func (s *GitService) CreateTag(ctx context.Context, owner string, repo string, t
 
 	return t, resp, nil
 }
+
+// newCreateTagRequest converts tag into a createTagRequest.
+func newCreateTagRequest(tag *Tag) *createTagRequest {
+	tagRequest := &createTagRequest{
+		Tag:     tag.Tag,
+		Message: tag.Message,
+		Tagger:  tag.Tagger,
+	}
+	if tag.Object != nil {
+		tagRequest.Object = tag.Object.SHA
+		tagRequest.Type = tag.Object.Type
+	}
+	return tagRequest
+}
+
+// TagSignaturePayload returns the payload that GitService.CreateTagWithOptions passes to
+// a MessageSigner for tag. It is the tag object exactly as git stores it,
+// without the signature, which is what GitHub verifies the signature against.
+// It can be used to test a MessageSigner on its own.
+func TagSignaturePayload(tag *Tag) (string, error) {
+	if tag == nil {
+		return "", errors.New("tag must be provided")
+	}
+	tagRequest := newCreateTagRequest(tag)
+	tagRequest.Message = withTrailingNewline(tagRequest.Message)
+	return createTagSignatureMessage(tagRequest)
+}
+
+// signTagRequest signs tag with signer and appends the signature to its message.
+func signTagRequest(signer MessageSigner, tag *createTagRequest) error {
+	// The signature must start on a line of its own.
+	tag.Message = withTrailingNewline(tag.Message)
+
+	message, err := createTagSignatureMessage(tag)
+	if err != nil {
+		return err
+	}
+
+	var signature bytes.Buffer
+	if err := signer.Sign(&signature, strings.NewReader(message)); err != nil {
+		return err
+	}
+
+	tag.Message = String(*tag.Message + signature.String())
+	return nil
+}
+
+func createTagSignatureMessage(tag *createTagRequest) (string, error) {
+	if tag == nil || tag.Tag == nil || tag.Message == nil || tag.Object == nil || tag.Tagger == nil || tag.Tagger.Date == nil {
+		return "", errors.New("createTagSignatureMessage: invalid parameters")
+	}
+
+	objectType := "commit"
+	if tag.Type != nil {
+		objectType = *tag.Type
+	}
+	date := tag.Tagger.GetDate()
+
+	message := []string{
+		fmt.Sprintf("object %s", *tag.Object),
+		fmt.Sprintf("type %s", objectType),
+		fmt.Sprintf("tag %s", *tag.Tag),
+		// There needs to be a double newline after tagger
+		fmt.Sprintf("tagger %s <%s> %d %s\n", tag.Tagger.GetName(), tag.Tagger.GetEmail(), date.Unix(), date.Format("-0700")),
+		*tag.Message,
+	}
+
+	return strings.Join(message, "\n"), nil
+}
+
+// withTrailingNewline returns s with a newline appended, unless s is nil,
+// empty or already ends with one.
+func withTrailingNewline(s *string) *string {
+	if s == nil || *s == "" || strings.HasSuffix(*s, "\n") {
+		return s
+	}
+	return String(*s + "\n")
+}
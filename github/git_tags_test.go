@@ -8,9 +8,11 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -98,6 +100,140 @@ func TestGitService_CreateTag(t *testing.T) {
 	})
 }
 
+// signedTagFixture returns a tag and the payload git generates for it. The
+// payload hashes to b506e098f53190d597c5a56131bea0cca3e60292.
+func signedTagFixture() (*Tag, string) {
+	tag := &Tag{
+		Tag:     String("v1.0.0"),
+		Message: String("Release v1.0.0"),
+		Object: &GitObject{
+			Type: String("commit"),
+			SHA:  String("1160e2d968e21ecf4450cd510a606515ba6e94b0"),
+		},
+		Tagger: &CommitAuthor{
+			Name:  String("John Roe"),
+			Email: String("john@example.com"),
+			Date:  &Timestamp{time.Date(2024, time.March, 4, 8, 9, 10, 0, time.FixedZone("", -(5*60*60+30*60)))},
+		},
+	}
+	payload := "object 1160e2d968e21ecf4450cd510a606515ba6e94b0\n" +
+		"type commit\n" +
+		"tag v1.0.0\n" +
+		"tagger John Roe <john@example.com> 1709559550 -0530\n" +
+		"\n" +
+		"Release v1.0.0\n"
+	return tag, payload
+}
+
+func TestGitService_CreateSignedTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	inputTag, payload := signedTagFixture()
+	signature := "-----BEGIN PGP SIGNATURE-----\n\nsig\n-----END PGP SIGNATURE-----\n"
+
+	mux.HandleFunc("/repos/o/r/git/tags", func(w http.ResponseWriter, r *http.Request) {
+		v := new(createTagRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, "POST")
+		want := &createTagRequest{
+			Tag:     String("v1.0.0"),
+			Message: String("Release v1.0.0\n" + signature),
+			Object:  String("1160e2d968e21ecf4450cd510a606515ba6e94b0"),
+			Type:    String("commit"),
+			Tagger:  inputTag.Tagger,
+		}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"tag": "v1.0.0", "sha": "b506e098f53190d597c5a56131bea0cca3e60292"}`)
+	})
+
+	ctx := context.Background()
+	opts := &CreateTagOptions{Signer: mockSigner(t, signature, nil, payload)}
+	tag, _, err := client.Git.CreateTagWithOptions(ctx, "o", "r", inputTag, opts)
+	if err != nil {
+		t.Errorf("Git.CreateTagWithOptions returned error: %v", err)
+	}
+
+	want := &Tag{Tag: String("v1.0.0"), SHA: String("b506e098f53190d597c5a56131bea0cca3e60292")}
+	if !cmp.Equal(tag, want) {
+		t.Errorf("Git.CreateTagWithOptions returned %+v, want %+v", tag, want)
+	}
+	if got := inputTag.GetMessage(); got != "Release v1.0.0" {
+		t.Errorf("Git.CreateTagWithOptions modified the input message to %q", got)
+	}
+}
+
+func TestGitService_CreateSignedTag_signerError(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	inputTag, _ := signedTagFixture()
+	wantErr := errors.New("signer error")
+
+	ctx := context.Background()
+	opts := &CreateTagOptions{Signer: mockSigner(t, "", wantErr, "")}
+	_, _, err := client.Git.CreateTagWithOptions(ctx, "o", "r", inputTag, opts)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Git.CreateTagWithOptions returned error %v, want %v", err, wantErr)
+	}
+}
+
+func TestGitService_CreateSignedTag_invalidParams(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	opts := &CreateTagOptions{Signer: uncalledSigner(t)}
+	_, _, err := client.Git.CreateTagWithOptions(ctx, "o", "r", &Tag{Tag: String("t"), Message: String("m")}, opts)
+	if err == nil {
+		t.Error("Expected error to be returned because the tag has no tagger")
+	}
+}
+
+func TestGitService_CreateTag_nilTag(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	_, _, err := client.Git.CreateTag(ctx, "o", "r", nil)
+	if err == nil {
+		t.Error("Expected error to be returned because nil tag was passed")
+	}
+}
+
+func TestTagSignaturePayload(t *testing.T) {
+	tag, want := signedTagFixture()
+
+	got, err := TagSignaturePayload(tag)
+	if err != nil {
+		t.Fatalf("TagSignaturePayload returned error: %v", err)
+	}
+	if got != want {
+		t.Errorf("TagSignaturePayload = %q, want %q", got, want)
+	}
+	if sha := gitObjectSHA("tag", got); sha != "b506e098f53190d597c5a56131bea0cca3e60292" {
+		t.Errorf("TagSignaturePayload hashes to %v, want b506e098f53190d597c5a56131bea0cca3e60292", sha)
+	}
+
+	// A message that already ends with a newline is used as is.
+	tag.Message = String("Release v1.0.0\n")
+	if got, _ := TagSignaturePayload(tag); got != want {
+		t.Errorf("TagSignaturePayload = %q, want %q", got, want)
+	}
+
+	if _, err := TagSignaturePayload(nil); err == nil {
+		t.Error("TagSignaturePayload(nil) returned no error")
+	}
+	tag.Tagger = nil
+	if _, err := TagSignaturePayload(tag); err == nil {
+		t.Error("TagSignaturePayload without tagger returned no error")
+	}
+}
+
 func TestTag_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Tag{}, "{}")
 
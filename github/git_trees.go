@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // Tree represents a GitHub tree.
@@ -93,11 +94,16 @@ func (t *TreeEntry) MarshalJSON() ([]byte, error) {
 
 // GetTree fetches the Tree object for a given sha hash from a repository.
 //
+// When recursive is true, GitHub limits the number of entries returned and
+// sets Tree.Truncated if the limit was exceeded, in which case Entries is
+// incomplete. Callers that need every entry should check Truncated, or use
+// WalkTree which handles truncation.
+//
 // GitHub API docs: https://docs.github.com/rest/git/trees#get-a-tree
 //
 //meta:operation GET /repos/{owner}/{repo}/git/trees/{tree_sha}
 func (s *GitService) GetTree(ctx context.Context, owner string, repo string, sha string, recursive bool) (*Tree, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/git/trees/%v", owner, repo, sha)
+	u := fmt.Sprintf("repos/%v/%v/git/trees/%v", PathEscape(owner), PathEscape(repo), sha)
 	if recursive {
 		u += "?recursive=1"
 	}
@@ -116,6 +122,75 @@ func (s *GitService) GetTree(ctx context.Context, owner string, repo string, sha
 	return t, resp, nil
 }
 
+// WalkTreeFunc is the type of the function called by GitService.WalkTree for
+// each entry in a tree. The entry's Path is relative to the root of the walk.
+// If the function returns an error, the walk stops and returns that error.
+type WalkTreeFunc func(entry *TreeEntry) error
+
+// WalkTree calls fn for every entry in the tree identified by sha and all of
+// its subtrees, including entries for the subtrees themselves.
+//
+// The tree is first fetched recursively. If GitHub truncates the response,
+// WalkTree falls back to fetching subtrees separately, so that fn still sees
+// every entry exactly once. Submodules (entries of type "commit") are reported
+// but not descended into. The order in which entries are visited is not
+// specified.
+//
+// GitHub API docs: https://docs.github.com/rest/git/trees#get-a-tree
+//
+//meta:operation GET /repos/{owner}/{repo}/git/trees/{tree_sha}
+func (s *GitService) WalkTree(ctx context.Context, owner, repo, sha string, fn WalkTreeFunc) error {
+	type pendingTree struct {
+		sha    string
+		prefix string
+		// ancestors holds the SHAs of the trees containing this tree,
+		// which protects the walk against cycles.
+		ancestors []string
+	}
+
+	queue := []pendingTree{{sha: sha}}
+	for len(queue) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		pending := queue[0]
+		queue = queue[1:]
+
+		tree, _, err := s.GetTree(ctx, owner, repo, pending.sha, true)
+		if err != nil {
+			return err
+		}
+		truncated := tree.GetTruncated()
+		if truncated {
+			// Only the direct children can be trusted to be complete,
+			// subtrees are walked separately.
+			if tree, _, err = s.GetTree(ctx, owner, repo, pending.sha, false); err != nil {
+				return err
+			}
+		}
+
+		ancestors := append(pending.ancestors[:len(pending.ancestors):len(pending.ancestors)], pending.sha)
+		for _, entry := range tree.Entries {
+			e := *entry
+			e.Path = String(pending.prefix + entry.GetPath())
+			if err := fn(&e); err != nil {
+				return err
+			}
+
+			if truncated && entry.GetType() == "tree" && !slices.Contains(ancestors, entry.GetSHA()) {
+				queue = append(queue, pendingTree{
+					sha:       entry.GetSHA(),
+					prefix:    e.GetPath() + "/",
+					ancestors: ancestors,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
 // createTree represents the body of a CreateTree request.
 type createTree struct {
 	BaseTree string        `json:"base_tree,omitempty"`
@@ -130,7 +205,7 @@ type createTree struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/git/trees
 func (s *GitService) CreateTree(ctx context.Context, owner string, repo string, baseTree string, entries []*TreeEntry) (*Tree, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/git/trees", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/git/trees", PathEscape(owner), PathEscape(repo))
 
 	newEntries := make([]interface{}, 0, len(entries))
 	for _, entry := range entries {
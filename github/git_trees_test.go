@@ -8,9 +8,12 @@ package github
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -339,6 +342,8 @@ func TestGitService_CreateTree_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Git.CreateTree(ctx, "%", "%", "", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Git.CreateTree(ctx, "..", "..", "", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestTree_Marshal(t *testing.T) {
@@ -446,3 +451,154 @@ func TestCreateTree_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestGitService_WalkTree(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// Tree layout:
+	//
+	//	a.txt
+	//	sub       (submodule)
+	//	dir1/b.txt
+	//	dir1/dir2/c.txt
+	//	big/d.txt (big is truncated when fetched recursively)
+	//	big/dir3/e.txt
+	trees := map[string]map[bool]string{
+		"root": {
+			true: `{"sha":"root","truncated":true,"tree":[{"path":"a.txt","type":"blob","sha":"a"}]}`,
+			false: `{"sha":"root","tree":[
+				{"path":"a.txt","type":"blob","sha":"a"},
+				{"path":"sub","type":"commit","sha":"root"},
+				{"path":"dir1","type":"tree","sha":"dir1"},
+				{"path":"big","type":"tree","sha":"big"}
+			]}`,
+		},
+		"dir1": {
+			true: `{"sha":"dir1","tree":[
+				{"path":"b.txt","type":"blob","sha":"b"},
+				{"path":"dir2","type":"tree","sha":"dir2"},
+				{"path":"dir2/c.txt","type":"blob","sha":"c"}
+			]}`,
+		},
+		"big": {
+			true: `{"sha":"big","truncated":true,"tree":[{"path":"d.txt","type":"blob","sha":"d"}]}`,
+			false: `{"sha":"big","tree":[
+				{"path":"d.txt","type":"blob","sha":"d"},
+				{"path":"dir3","type":"tree","sha":"dir3"}
+			]}`,
+		},
+		"dir3": {
+			true: `{"sha":"dir3","tree":[{"path":"e.txt","type":"blob","sha":"e"}]}`,
+		},
+	}
+	mux.HandleFunc("/repos/o/r/git/trees/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		sha := strings.TrimPrefix(r.URL.Path, "/repos/o/r/git/trees/")
+		body, ok := trees[sha][r.URL.Query().Get("recursive") == "1"]
+		if !ok {
+			t.Errorf("unexpected request for tree %v (recursive=%q)", sha, r.URL.Query().Get("recursive"))
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, body)
+	})
+
+	ctx := context.Background()
+	var got []string
+	err := client.Git.WalkTree(ctx, "o", "r", "root", func(entry *TreeEntry) error {
+		got = append(got, entry.GetType()+" "+entry.GetPath())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Git.WalkTree returned error: %v", err)
+	}
+
+	sort.Strings(got)
+	want := []string{
+		"blob a.txt",
+		"blob big/d.txt",
+		"blob big/dir3/e.txt",
+		"blob dir1/b.txt",
+		"blob dir1/dir2/c.txt",
+		"commit sub",
+		"tree big",
+		"tree big/dir3",
+		"tree dir1",
+		"tree dir1/dir2",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Git.WalkTree visited %v, want %v", got, want)
+	}
+}
+
+func TestGitService_WalkTree_cycle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/repos/o/r/git/trees/loop", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 4 {
+			t.Error("Git.WalkTree did not stop descending into a cyclic tree")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"sha":"loop","truncated":true,"tree":[{"path":"self","type":"tree","sha":"loop"}]}`)
+	})
+
+	ctx := context.Background()
+	var got []string
+	err := client.Git.WalkTree(ctx, "o", "r", "loop", func(entry *TreeEntry) error {
+		got = append(got, entry.GetPath())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Git.WalkTree returned error: %v", err)
+	}
+	if want := []string{"self"}; !cmp.Equal(got, want) {
+		t.Errorf("Git.WalkTree visited %v, want %v", got, want)
+	}
+}
+
+func TestGitService_WalkTree_stops(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/root", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"root","truncated":true,"tree":[{"path":"dir","type":"tree","sha":"dir"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/dir", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Git.WalkTree fetched a subtree after being stopped")
+	})
+
+	t.Run("callback error", func(t *testing.T) {
+		errStop := errors.New("stop")
+		err := client.Git.WalkTree(context.Background(), "o", "r", "root", func(entry *TreeEntry) error {
+			return errStop
+		})
+		if err != errStop {
+			t.Errorf("Git.WalkTree returned error %v, want %v", err, errStop)
+		}
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		err := client.Git.WalkTree(ctx, "o", "r", "root", func(entry *TreeEntry) error {
+			cancel()
+			return nil
+		})
+		if err != context.Canceled {
+			t.Errorf("Git.WalkTree returned error %v, want %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("request failure", func(t *testing.T) {
+		err := client.Git.WalkTree(context.Background(), "o", "r", "\n", func(entry *TreeEntry) error {
+			return nil
+		})
+		if err == nil {
+			t.Error("Git.WalkTree returned nil error, want error")
+		}
+	})
+}
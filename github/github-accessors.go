@@ -238,6 +238,14 @@ func (a *ActionsVariable) GetVisibility() string {
 	return *a.Visibility
 }
 
+// GetWithTimestamps returns the WithTimestamps field if it's non-nil, zero value otherwise.
+func (a *ActivityListStarredOptions) GetWithTimestamps() bool {
+	if a == nil || a.WithTimestamps == nil {
+		return false
+	}
+	return *a.WithTimestamps
+}
+
 // GetCountryCode returns the CountryCode field if it's non-nil, zero value otherwise.
 func (a *ActorLocation) GetCountryCode() string {
 	if a == nil || a.CountryCode == nil {
@@ -382,6 +390,22 @@ func (a *AdvisoryCVSS) GetVectorString() string {
 	return *a.VectorString
 }
 
+// GetCVSSV3 returns the CVSSV3 field.
+func (a *AdvisoryCVSSSeverities) GetCVSSV3() *AdvisoryCVSS {
+	if a == nil {
+		return nil
+	}
+	return a.CVSSV3
+}
+
+// GetCVSSV4 returns the CVSSV4 field.
+func (a *AdvisoryCVSSSeverities) GetCVSSV4() *AdvisoryCVSS {
+	if a == nil {
+		return nil
+	}
+	return a.CVSSV4
+}
+
 // GetCWEID returns the CWEID field if it's non-nil, zero value otherwise.
 func (a *AdvisoryCWEs) GetCWEID() string {
 	if a == nil || a.CWEID == nil {
@@ -662,6 +686,30 @@ func (a *AnalysesListOptions) GetSarifID() string {
 	return *a.SarifID
 }
 
+// GetAnnouncement returns the Announcement field if it's non-nil, zero value otherwise.
+func (a *Announcement) GetAnnouncement() string {
+	if a == nil || a.Announcement == nil {
+		return ""
+	}
+	return *a.Announcement
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (a *Announcement) GetExpiresAt() Timestamp {
+	if a == nil || a.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *a.ExpiresAt
+}
+
+// GetUserDismissible returns the UserDismissible field if it's non-nil, zero value otherwise.
+func (a *Announcement) GetUserDismissible() bool {
+	if a == nil || a.UserDismissible == nil {
+		return false
+	}
+	return *a.UserDismissible
+}
+
 // GetSSHKeyFingerprints returns the SSHKeyFingerprints map if it's non-nil, an empty map otherwise.
 func (a *APIMeta) GetSSHKeyFingerprints() map[string]string {
 	if a == nil || a.SSHKeyFingerprints == nil {
@@ -1886,6 +1934,206 @@ func (b *BypassActor) GetBypassMode() string {
 	return *b.BypassMode
 }
 
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetCreatedAt() Timestamp {
+	if b == nil || b.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *b.CreatedAt
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetExpiresAt() Timestamp {
+	if b == nil || b.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *b.ExpiresAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetHTMLURL() string {
+	if b == nil || b.HTMLURL == nil {
+		return ""
+	}
+	return *b.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetID() int64 {
+	if b == nil || b.ID == nil {
+		return 0
+	}
+	return *b.ID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetNumber() int64 {
+	if b == nil || b.Number == nil {
+		return 0
+	}
+	return *b.Number
+}
+
+// GetOrganization returns the Organization field.
+func (b *BypassRequest) GetOrganization() *Organization {
+	if b == nil {
+		return nil
+	}
+	return b.Organization
+}
+
+// GetRepository returns the Repository field.
+func (b *BypassRequest) GetRepository() *Repository {
+	if b == nil {
+		return nil
+	}
+	return b.Repository
+}
+
+// GetRequester returns the Requester field.
+func (b *BypassRequest) GetRequester() *BypassRequestActor {
+	if b == nil {
+		return nil
+	}
+	return b.Requester
+}
+
+// GetRequesterComment returns the RequesterComment field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetRequesterComment() string {
+	if b == nil || b.RequesterComment == nil {
+		return ""
+	}
+	return *b.RequesterComment
+}
+
+// GetRequestType returns the RequestType field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetRequestType() string {
+	if b == nil || b.RequestType == nil {
+		return ""
+	}
+	return *b.RequestType
+}
+
+// GetResourceIdentifier returns the ResourceIdentifier field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetResourceIdentifier() string {
+	if b == nil || b.ResourceIdentifier == nil {
+		return ""
+	}
+	return *b.ResourceIdentifier
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetStatus() string {
+	if b == nil || b.Status == nil {
+		return ""
+	}
+	return *b.Status
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (b *BypassRequest) GetURL() string {
+	if b == nil || b.URL == nil {
+		return ""
+	}
+	return *b.URL
+}
+
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (b *BypassRequestActor) GetActorID() int64 {
+	if b == nil || b.ActorID == nil {
+		return 0
+	}
+	return *b.ActorID
+}
+
+// GetActorName returns the ActorName field if it's non-nil, zero value otherwise.
+func (b *BypassRequestActor) GetActorName() string {
+	if b == nil || b.ActorName == nil {
+		return ""
+	}
+	return *b.ActorName
+}
+
+// GetBypassReason returns the BypassReason field if it's non-nil, zero value otherwise.
+func (b *BypassRequestData) GetBypassReason() string {
+	if b == nil || b.BypassReason == nil {
+		return ""
+	}
+	return *b.BypassReason
+}
+
+// GetRulesetID returns the RulesetID field if it's non-nil, zero value otherwise.
+func (b *BypassRequestData) GetRulesetID() int64 {
+	if b == nil || b.RulesetID == nil {
+		return 0
+	}
+	return *b.RulesetID
+}
+
+// GetRulesetName returns the RulesetName field if it's non-nil, zero value otherwise.
+func (b *BypassRequestData) GetRulesetName() string {
+	if b == nil || b.RulesetName == nil {
+		return ""
+	}
+	return *b.RulesetName
+}
+
+// GetRuleType returns the RuleType field if it's non-nil, zero value otherwise.
+func (b *BypassRequestData) GetRuleType() string {
+	if b == nil || b.RuleType == nil {
+		return ""
+	}
+	return *b.RuleType
+}
+
+// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
+func (b *BypassRequestData) GetSecretType() string {
+	if b == nil || b.SecretType == nil {
+		return ""
+	}
+	return *b.SecretType
+}
+
+// GetTotalViolations returns the TotalViolations field if it's non-nil, zero value otherwise.
+func (b *BypassRequestData) GetTotalViolations() int {
+	if b == nil || b.TotalViolations == nil {
+		return 0
+	}
+	return *b.TotalViolations
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (b *BypassRequestReview) GetCreatedAt() Timestamp {
+	if b == nil || b.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *b.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BypassRequestReview) GetID() int64 {
+	if b == nil || b.ID == nil {
+		return 0
+	}
+	return *b.ID
+}
+
+// GetReviewer returns the Reviewer field.
+func (b *BypassRequestReview) GetReviewer() *BypassRequestActor {
+	if b == nil {
+		return nil
+	}
+	return b.Reviewer
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (b *BypassRequestReview) GetStatus() string {
+	if b == nil || b.Status == nil {
+		return ""
+	}
+	return *b.Status
+}
+
 // GetApp returns the App field.
 func (c *CheckRun) GetApp() *App {
 	if c == nil {
@@ -2014,6 +2262,14 @@ func (c *CheckRunAnnotation) GetAnnotationLevel() string {
 	return *c.AnnotationLevel
 }
 
+// GetBlobHRef returns the BlobHRef field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetBlobHRef() string {
+	if c == nil || c.BlobHRef == nil {
+		return ""
+	}
+	return *c.BlobHRef
+}
+
 // GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
 func (c *CheckRunAnnotation) GetEndColumn() int {
 	if c == nil || c.EndColumn == nil {
@@ -2486,6 +2742,22 @@ func (c *CodeQLDatabase) GetURL() string {
 	return *c.URL
 }
 
+// GetFileSize returns the FileSize field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetFileSize() int {
+	if c == nil || c.FileSize == nil {
+		return 0
+	}
+	return *c.FileSize
+}
+
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetGitURL() string {
+	if c == nil || c.GitURL == nil {
+		return ""
+	}
+	return *c.GitURL
+}
+
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
 func (c *CodeResult) GetHTMLURL() string {
 	if c == nil || c.HTMLURL == nil {
@@ -2494,6 +2766,22 @@ func (c *CodeResult) GetHTMLURL() string {
 	return *c.HTMLURL
 }
 
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetLanguage() string {
+	if c == nil || c.Language == nil {
+		return ""
+	}
+	return *c.Language
+}
+
+// GetLastModifiedAt returns the LastModifiedAt field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetLastModifiedAt() Timestamp {
+	if c == nil || c.LastModifiedAt == nil {
+		return Timestamp{}
+	}
+	return *c.LastModifiedAt
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (c *CodeResult) GetName() string {
 	if c == nil || c.Name == nil {
@@ -2518,6 +2806,14 @@ func (c *CodeResult) GetRepository() *Repository {
 	return c.Repository
 }
 
+// GetScore returns the Score field.
+func (c *CodeResult) GetScore() *float64 {
+	if c == nil {
+		return nil
+	}
+	return c.Score
+}
+
 // GetSHA returns the SHA field if it's non-nil, zero value otherwise.
 func (c *CodeResult) GetSHA() string {
 	if c == nil || c.SHA == nil {
@@ -2526,6 +2822,14 @@ func (c *CodeResult) GetSHA() string {
 	return *c.SHA
 }
 
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
+	}
+	return *c.URL
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (c *CodeScanningAlertEvent) GetAction() string {
 	if c == nil || c.Action == nil {
@@ -3774,6 +4078,14 @@ func (c *CommunityHealthMetrics) GetUpdatedAt() Timestamp {
 	return *c.UpdatedAt
 }
 
+// GetPerHost returns the PerHost map if it's non-nil, an empty map otherwise.
+func (c *ConnStats) GetPerHost() map[string]HostConnStats {
+	if c == nil || c.PerHost == nil {
+		return map[string]HostConnStats{}
+	}
+	return c.PerHost
+}
+
 // GetID returns the ID field if it's non-nil, zero value otherwise.
 func (c *ContentReference) GetID() int64 {
 	if c == nil || c.ID == nil {
@@ -4326,6 +4638,54 @@ func (c *CreateEvent) GetSender() *User {
 	return c.Sender
 }
 
+// GetEnableStaticIP returns the EnableStaticIP field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetEnableStaticIP() bool {
+	if c == nil || c.EnableStaticIP == nil {
+		return false
+	}
+	return *c.EnableStaticIP
+}
+
+// GetImage returns the Image field.
+func (c *CreateHostedRunnerRequest) GetImage() *HostedRunnerImage {
+	if c == nil {
+		return nil
+	}
+	return c.Image
+}
+
+// GetMaximumRunners returns the MaximumRunners field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetMaximumRunners() int64 {
+	if c == nil || c.MaximumRunners == nil {
+		return 0
+	}
+	return *c.MaximumRunners
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetRunnerGroupID() int64 {
+	if c == nil || c.RunnerGroupID == nil {
+		return 0
+	}
+	return *c.RunnerGroupID
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetSize() string {
+	if c == nil || c.Size == nil {
+		return ""
+	}
+	return *c.Size
+}
+
 // GetEmail returns the Email field if it's non-nil, zero value otherwise.
 func (c *CreateOrgInvitationOptions) GetEmail() string {
 	if c == nil || c.Email == nil {
@@ -4374,6 +4734,46 @@ func (c *CreateOrUpdateCustomRoleOptions) GetName() string {
 	return *c.Name
 }
 
+// GetArtifactID returns the ArtifactID field if it's non-nil, zero value otherwise.
+func (c *CreatePagesDeploymentRequest) GetArtifactID() int64 {
+	if c == nil || c.ArtifactID == nil {
+		return 0
+	}
+	return *c.ArtifactID
+}
+
+// GetArtifactURL returns the ArtifactURL field if it's non-nil, zero value otherwise.
+func (c *CreatePagesDeploymentRequest) GetArtifactURL() string {
+	if c == nil || c.ArtifactURL == nil {
+		return ""
+	}
+	return *c.ArtifactURL
+}
+
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (c *CreatePagesDeploymentRequest) GetEnvironment() string {
+	if c == nil || c.Environment == nil {
+		return ""
+	}
+	return *c.Environment
+}
+
+// GetOIDCToken returns the OIDCToken field if it's non-nil, zero value otherwise.
+func (c *CreatePagesDeploymentRequest) GetOIDCToken() string {
+	if c == nil || c.OIDCToken == nil {
+		return ""
+	}
+	return *c.OIDCToken
+}
+
+// GetPagesBuildVersion returns the PagesBuildVersion field if it's non-nil, zero value otherwise.
+func (c *CreatePagesDeploymentRequest) GetPagesBuildVersion() string {
+	if c == nil || c.PagesBuildVersion == nil {
+		return ""
+	}
+	return *c.PagesBuildVersion
+}
+
 // GetFrom returns the From field if it's non-nil, zero value otherwise.
 func (c *CreateProtectedChanges) GetFrom() bool {
 	if c == nil || c.From == nil {
@@ -4718,7 +5118,55 @@ func (c *CustomProperty) GetRequired() bool {
 	return *c.Required
 }
 
-// GetValue returns the Value field if it's non-nil, zero value otherwise.
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *CustomPropertyEvent) GetAction() string {
+	if c == nil || c.Action == nil {
+		return ""
+	}
+	return *c.Action
+}
+
+// GetDefinition returns the Definition field.
+func (c *CustomPropertyEvent) GetDefinition() *CustomProperty {
+	if c == nil {
+		return nil
+	}
+	return c.Definition
+}
+
+// GetEnterprise returns the Enterprise field.
+func (c *CustomPropertyEvent) GetEnterprise() *Enterprise {
+	if c == nil {
+		return nil
+	}
+	return c.Enterprise
+}
+
+// GetInstallation returns the Installation field.
+func (c *CustomPropertyEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
+	}
+	return c.Installation
+}
+
+// GetOrg returns the Org field.
+func (c *CustomPropertyEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Org
+}
+
+// GetSender returns the Sender field.
+func (c *CustomPropertyEvent) GetSender() *User {
+	if c == nil {
+		return nil
+	}
+	return c.Sender
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
 func (c *CustomPropertyValue) GetValue() string {
 	if c == nil || c.Value == nil {
 		return ""
@@ -4726,6 +5174,54 @@ func (c *CustomPropertyValue) GetValue() string {
 	return *c.Value
 }
 
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *CustomPropertyValuesEvent) GetAction() string {
+	if c == nil || c.Action == nil {
+		return ""
+	}
+	return *c.Action
+}
+
+// GetEnterprise returns the Enterprise field.
+func (c *CustomPropertyValuesEvent) GetEnterprise() *Enterprise {
+	if c == nil {
+		return nil
+	}
+	return c.Enterprise
+}
+
+// GetInstallation returns the Installation field.
+func (c *CustomPropertyValuesEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
+	}
+	return c.Installation
+}
+
+// GetOrg returns the Org field.
+func (c *CustomPropertyValuesEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Org
+}
+
+// GetRepo returns the Repo field.
+func (c *CustomPropertyValuesEvent) GetRepo() *Repository {
+	if c == nil {
+		return nil
+	}
+	return c.Repo
+}
+
+// GetSender returns the Sender field.
+func (c *CustomPropertyValuesEvent) GetSender() *User {
+	if c == nil {
+		return nil
+	}
+	return c.Sender
+}
+
 // GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
 func (c *CustomRepoRoles) GetBaseRole() string {
 	if c == nil || c.BaseRole == nil {
@@ -5766,6 +6262,14 @@ func (d *DeploymentRequest) GetTransientEnvironment() bool {
 	return *d.TransientEnvironment
 }
 
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentsListOptions) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
+		return ""
+	}
+	return *d.Environment
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (d *DeploymentStatus) GetCreatedAt() Timestamp {
 	if d == nil || d.CreatedAt == nil {
@@ -5998,12 +6502,12 @@ func (d *Discussion) GetAnswerChosenAt() Timestamp {
 	return *d.AnswerChosenAt
 }
 
-// GetAnswerChosenBy returns the AnswerChosenBy field if it's non-nil, zero value otherwise.
-func (d *Discussion) GetAnswerChosenBy() string {
-	if d == nil || d.AnswerChosenBy == nil {
-		return ""
+// GetAnswerChosenBy returns the AnswerChosenBy field.
+func (d *Discussion) GetAnswerChosenBy() *User {
+	if d == nil {
+		return nil
 	}
-	return *d.AnswerChosenBy
+	return d.AnswerChosenBy
 }
 
 // GetAnswerHTMLURL returns the AnswerHTMLURL field if it's non-nil, zero value otherwise.
@@ -6094,6 +6598,14 @@ func (d *Discussion) GetNumber() int {
 	return *d.Number
 }
 
+// GetReactions returns the Reactions field.
+func (d *Discussion) GetReactions() *Reactions {
+	if d == nil {
+		return nil
+	}
+	return d.Reactions
+}
+
 // GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
 func (d *Discussion) GetRepositoryURL() string {
 	if d == nil || d.RepositoryURL == nil {
@@ -6110,6 +6622,22 @@ func (d *Discussion) GetState() string {
 	return *d.State
 }
 
+// GetStateReason returns the StateReason field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetStateReason() string {
+	if d == nil || d.StateReason == nil {
+		return ""
+	}
+	return *d.StateReason
+}
+
+// GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetTimelineURL() string {
+	if d == nil || d.TimelineURL == nil {
+		return ""
+	}
+	return *d.TimelineURL
+}
+
 // GetTitle returns the Title field if it's non-nil, zero value otherwise.
 func (d *Discussion) GetTitle() string {
 	if d == nil || d.Title == nil {
@@ -6326,6 +6854,14 @@ func (d *DiscussionCommentEvent) GetAction() string {
 	return *d.Action
 }
 
+// GetChanges returns the Changes field.
+func (d *DiscussionCommentEvent) GetChanges() *EditChange {
+	if d == nil {
+		return nil
+	}
+	return d.Changes
+}
+
 // GetComment returns the Comment field.
 func (d *DiscussionCommentEvent) GetComment() *CommentDiscussion {
 	if d == nil {
@@ -6382,6 +6918,22 @@ func (d *DiscussionEvent) GetAction() string {
 	return *d.Action
 }
 
+// GetAnswer returns the Answer field.
+func (d *DiscussionEvent) GetAnswer() *CommentDiscussion {
+	if d == nil {
+		return nil
+	}
+	return d.Answer
+}
+
+// GetChanges returns the Changes field.
+func (d *DiscussionEvent) GetChanges() *EditChange {
+	if d == nil {
+		return nil
+	}
+	return d.Changes
+}
+
 // GetDiscussion returns the Discussion field.
 func (d *DiscussionEvent) GetDiscussion() *Discussion {
 	if d == nil {
@@ -6398,6 +6950,14 @@ func (d *DiscussionEvent) GetInstallation() *Installation {
 	return d.Installation
 }
 
+// GetOldAnswer returns the OldAnswer field.
+func (d *DiscussionEvent) GetOldAnswer() *CommentDiscussion {
+	if d == nil {
+		return nil
+	}
+	return d.OldAnswer
+}
+
 // GetOrg returns the Org field.
 func (d *DiscussionEvent) GetOrg() *Organization {
 	if d == nil {
@@ -6486,14 +7046,6 @@ func (d *DismissStaleReviewsOnPushChanges) GetFrom() bool {
 	return *d.From
 }
 
-// GetClientPayload returns the ClientPayload field if it's non-nil, zero value otherwise.
-func (d *DispatchRequestOptions) GetClientPayload() json.RawMessage {
-	if d == nil || d.ClientPayload == nil {
-		return json.RawMessage{}
-	}
-	return *d.ClientPayload
-}
-
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
 func (d *DraftReviewComment) GetBody() string {
 	if d == nil || d.Body == nil {
@@ -6614,6 +7166,14 @@ func (e *EditChange) GetRepo() *EditRepo {
 	return e.Repo
 }
 
+// GetStateReason returns the StateReason field.
+func (e *EditChange) GetStateReason() *EditStateReason {
+	if e == nil {
+		return nil
+	}
+	return e.StateReason
+}
+
 // GetTitle returns the Title field.
 func (e *EditChange) GetTitle() *EditTitle {
 	if e == nil {
@@ -6670,6 +7230,14 @@ func (e *EditSHA) GetFrom() string {
 	return *e.From
 }
 
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (e *EditStateReason) GetFrom() string {
+	if e == nil || e.From == nil {
+		return ""
+	}
+	return *e.From
+}
+
 // GetFrom returns the From field if it's non-nil, zero value otherwise.
 func (e *EditTitle) GetFrom() string {
 	if e == nil || e.From == nil {
@@ -7334,6 +7902,14 @@ func (g *GenerateJITConfigRequest) GetWorkFolder() string {
 	return *g.WorkFolder
 }
 
+// GetConfigurationFilePath returns the ConfigurationFilePath field if it's non-nil, zero value otherwise.
+func (g *GenerateNotesOptions) GetConfigurationFilePath() string {
+	if g == nil || g.ConfigurationFilePath == nil {
+		return ""
+	}
+	return *g.ConfigurationFilePath
+}
+
 // GetPreviousTagName returns the PreviousTagName field if it's non-nil, zero value otherwise.
 func (g *GenerateNotesOptions) GetPreviousTagName() string {
 	if g == nil || g.PreviousTagName == nil {
@@ -7598,6 +8174,14 @@ func (g *GistFile) GetSize() int {
 	return *g.Size
 }
 
+// GetTruncated returns the Truncated field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetTruncated() bool {
+	if g == nil || g.Truncated == nil {
+		return false
+	}
+	return *g.Truncated
+}
+
 // GetType returns the Type field if it's non-nil, zero value otherwise.
 func (g *GistFile) GetType() string {
 	if g == nil || g.Type == nil {
@@ -8094,6 +8678,14 @@ func (h *Hook) GetID() int64 {
 	return *h.ID
 }
 
+// GetLastResponse returns the LastResponse field.
+func (h *Hook) GetLastResponse() *HookLastResponse {
+	if h == nil {
+		return nil
+	}
+	return h.LastResponse
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (h *Hook) GetName() string {
 	if h == nil || h.Name == nil {
@@ -8278,6 +8870,30 @@ func (h *HookDelivery) GetStatusCode() int {
 	return *h.StatusCode
 }
 
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (h *HookLastResponse) GetCode() int {
+	if h == nil || h.Code == nil {
+		return 0
+	}
+	return *h.Code
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (h *HookLastResponse) GetMessage() string {
+	if h == nil || h.Message == nil {
+		return ""
+	}
+	return *h.Message
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (h *HookLastResponse) GetStatus() string {
+	if h == nil || h.Status == nil {
+		return ""
+	}
+	return *h.Status
+}
+
 // GetHeaders returns the Headers map if it's non-nil, an empty map otherwise.
 func (h *HookRequest) GetHeaders() map[string]string {
 	if h == nil || h.Headers == nil {
@@ -8334,96 +8950,352 @@ func (h *HookStats) GetTotalHooks() int {
 	return *h.TotalHooks
 }
 
-// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupDescription() string {
-	if i == nil || i.GroupDescription == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetID() int64 {
+	if h == nil || h.ID == nil {
+		return 0
 	}
-	return *i.GroupDescription
+	return *h.ID
 }
 
-// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupID() string {
-	if i == nil || i.GroupID == nil {
-		return ""
+// GetImageDetails returns the ImageDetails field.
+func (h *HostedRunner) GetImageDetails() *HostedRunnerImageDetail {
+	if h == nil {
+		return nil
 	}
-	return *i.GroupID
+	return h.ImageDetails
 }
 
-// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupName() string {
-	if i == nil || i.GroupName == nil {
-		return ""
+// GetLastActiveOn returns the LastActiveOn field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetLastActiveOn() Timestamp {
+	if h == nil || h.LastActiveOn == nil {
+		return Timestamp{}
 	}
-	return *i.GroupName
+	return *h.LastActiveOn
 }
 
-// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsCount() int {
-	if i == nil || i.AuthorsCount == nil {
-		return 0
+// GetMachineSizeDetails returns the MachineSizeDetails field.
+func (h *HostedRunner) GetMachineSizeDetails() *HostedRunnerMachineSpec {
+	if h == nil {
+		return nil
 	}
-	return *i.AuthorsCount
+	return h.MachineSizeDetails
 }
 
-// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsURL() string {
-	if i == nil || i.AuthorsURL == nil {
-		return ""
+// GetMaximumRunners returns the MaximumRunners field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetMaximumRunners() int64 {
+	if h == nil || h.MaximumRunners == nil {
+		return 0
 	}
-	return *i.AuthorsURL
+	return *h.MaximumRunners
 }
 
-// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetCommitCount() int {
-	if i == nil || i.CommitCount == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetName() string {
+	if h == nil || h.Name == nil {
+		return ""
 	}
-	return *i.CommitCount
+	return *h.Name
 }
 
-// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
-func (i *Import) GetFailedStep() string {
-	if i == nil || i.FailedStep == nil {
+// GetPlatform returns the Platform field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetPlatform() string {
+	if h == nil || h.Platform == nil {
 		return ""
 	}
-	return *i.FailedStep
+	return *h.Platform
 }
 
-// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
-func (i *Import) GetHasLargeFiles() bool {
-	if i == nil || i.HasLargeFiles == nil {
+// GetPublicIPEnabled returns the PublicIPEnabled field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetPublicIPEnabled() bool {
+	if h == nil || h.PublicIPEnabled == nil {
 		return false
 	}
-	return *i.HasLargeFiles
+	return *h.PublicIPEnabled
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
-		return ""
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetRunnerGroupID() int64 {
+	if h == nil || h.RunnerGroupID == nil {
+		return 0
 	}
-	return *i.HTMLURL
+	return *h.RunnerGroupID
 }
 
-// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
-func (i *Import) GetHumanName() string {
-	if i == nil || i.HumanName == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetStatus() string {
+	if h == nil || h.Status == nil {
 		return ""
 	}
-	return *i.HumanName
+	return *h.Status
 }
 
-// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesCount() int {
-	if i == nil || i.LargeFilesCount == nil {
-		return 0
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
 	}
-	return *i.LargeFilesCount
+	return *h.ID
 }
 
-// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesSize() int {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetSource() string {
+	if h == nil || h.Source == nil {
+		return ""
+	}
+	return *h.Source
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetVersion() string {
+	if h == nil || h.Version == nil {
+		return ""
+	}
+	return *h.Version
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetDisplayName() string {
+	if h == nil || h.DisplayName == nil {
+		return ""
+	}
+	return *h.DisplayName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
+	}
+	return *h.ID
+}
+
+// GetSizeGB returns the SizeGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetSizeGB() int64 {
+	if h == nil || h.SizeGB == nil {
+		return 0
+	}
+	return *h.SizeGB
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetSource() string {
+	if h == nil || h.Source == nil {
+		return ""
+	}
+	return *h.Source
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageSpec) GetDisplayName() string {
+	if h == nil || h.DisplayName == nil {
+		return ""
+	}
+	return *h.DisplayName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageSpec) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
+	}
+	return *h.ID
+}
+
+// GetPlatform returns the Platform field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageSpec) GetPlatform() string {
+	if h == nil || h.Platform == nil {
+		return ""
+	}
+	return *h.Platform
+}
+
+// GetSizeGB returns the SizeGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageSpec) GetSizeGB() int64 {
+	if h == nil || h.SizeGB == nil {
+		return 0
+	}
+	return *h.SizeGB
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageSpec) GetSource() string {
+	if h == nil || h.Source == nil {
+		return ""
+	}
+	return *h.Source
+}
+
+// GetPublicIPs returns the PublicIPs field.
+func (h *HostedRunnerLimits) GetPublicIPs() *HostedRunnerPublicIPLimits {
+	if h == nil {
+		return nil
+	}
+	return h.PublicIPs
+}
+
+// GetCPUCores returns the CPUCores field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetCPUCores() int {
+	if h == nil || h.CPUCores == nil {
+		return 0
+	}
+	return *h.CPUCores
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
+	}
+	return *h.ID
+}
+
+// GetMemoryGB returns the MemoryGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetMemoryGB() int {
+	if h == nil || h.MemoryGB == nil {
+		return 0
+	}
+	return *h.MemoryGB
+}
+
+// GetStorageGB returns the StorageGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetStorageGB() int {
+	if h == nil || h.StorageGB == nil {
+		return 0
+	}
+	return *h.StorageGB
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIP) GetEnabled() bool {
+	if h == nil || h.Enabled == nil {
+		return false
+	}
+	return *h.Enabled
+}
+
+// GetLength returns the Length field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIP) GetLength() int {
+	if h == nil || h.Length == nil {
+		return 0
+	}
+	return *h.Length
+}
+
+// GetPrefix returns the Prefix field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIP) GetPrefix() string {
+	if h == nil || h.Prefix == nil {
+		return ""
+	}
+	return *h.Prefix
+}
+
+// GetCurrentUsage returns the CurrentUsage field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIPLimits) GetCurrentUsage() int {
+	if h == nil || h.CurrentUsage == nil {
+		return 0
+	}
+	return *h.CurrentUsage
+}
+
+// GetMaximum returns the Maximum field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIPLimits) GetMaximum() int {
+	if h == nil || h.Maximum == nil {
+		return 0
+	}
+	return *h.Maximum
+}
+
+// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupDescription() string {
+	if i == nil || i.GroupDescription == nil {
+		return ""
+	}
+	return *i.GroupDescription
+}
+
+// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupID() string {
+	if i == nil || i.GroupID == nil {
+		return ""
+	}
+	return *i.GroupID
+}
+
+// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupName() string {
+	if i == nil || i.GroupName == nil {
+		return ""
+	}
+	return *i.GroupName
+}
+
+// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsCount() int {
+	if i == nil || i.AuthorsCount == nil {
+		return 0
+	}
+	return *i.AuthorsCount
+}
+
+// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsURL() string {
+	if i == nil || i.AuthorsURL == nil {
+		return ""
+	}
+	return *i.AuthorsURL
+}
+
+// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetCommitCount() int {
+	if i == nil || i.CommitCount == nil {
+		return 0
+	}
+	return *i.CommitCount
+}
+
+// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
+func (i *Import) GetFailedStep() string {
+	if i == nil || i.FailedStep == nil {
+		return ""
+	}
+	return *i.FailedStep
+}
+
+// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
+func (i *Import) GetHasLargeFiles() bool {
+	if i == nil || i.HasLargeFiles == nil {
+		return false
+	}
+	return *i.HasLargeFiles
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
+func (i *Import) GetHumanName() string {
+	if i == nil || i.HumanName == nil {
+		return ""
+	}
+	return *i.HumanName
+}
+
+// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesCount() int {
+	if i == nil || i.LargeFilesCount == nil {
+		return 0
+	}
+	return *i.LargeFilesCount
+}
+
+// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesSize() int {
 	if i == nil || i.LargeFilesSize == nil {
 		return 0
 	}
@@ -8750,6 +9622,14 @@ func (i *InstallationPermissions) GetActions() string {
 	return *i.Actions
 }
 
+// GetAdditionalPermissions returns the AdditionalPermissions map if it's non-nil, an empty map otherwise.
+func (i *InstallationPermissions) GetAdditionalPermissions() map[string]string {
+	if i == nil || i.AdditionalPermissions == nil {
+		return map[string]string{}
+	}
+	return i.AdditionalPermissions
+}
+
 // GetAdministration returns the Administration field if it's non-nil, zero value otherwise.
 func (i *InstallationPermissions) GetAdministration() string {
 	if i == nil || i.Administration == nil {
@@ -9622,6 +10502,14 @@ func (i *IssueComment) GetID() int64 {
 	return *i.ID
 }
 
+// GetIsMinimized returns the IsMinimized field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetIsMinimized() bool {
+	if i == nil || i.IsMinimized == nil {
+		return false
+	}
+	return *i.IsMinimized
+}
+
 // GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
 func (i *IssueComment) GetIssueURL() string {
 	if i == nil || i.IssueURL == nil {
@@ -9630,6 +10518,14 @@ func (i *IssueComment) GetIssueURL() string {
 	return *i.IssueURL
 }
 
+// GetMinimizedReason returns the MinimizedReason field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetMinimizedReason() string {
+	if i == nil || i.MinimizedReason == nil {
+		return ""
+	}
+	return *i.MinimizedReason
+}
+
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
 func (i *IssueComment) GetNodeID() string {
 	if i == nil || i.NodeID == nil {
@@ -9734,71 +10630,143 @@ func (i *IssueCommentEvent) GetSender() *User {
 	return i.Sender
 }
 
-// GetActor returns the Actor field.
-func (i *IssueEvent) GetActor() *User {
-	if i == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssueDependenciesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
 	}
-	return i.Actor
+	return *i.Action
 }
 
-// GetAssignee returns the Assignee field.
-func (i *IssueEvent) GetAssignee() *User {
+// GetBlockedIssue returns the BlockedIssue field.
+func (i *IssueDependenciesEvent) GetBlockedIssue() *Issue {
 	if i == nil {
 		return nil
 	}
-	return i.Assignee
+	return i.BlockedIssue
 }
 
-// GetAssigner returns the Assigner field.
-func (i *IssueEvent) GetAssigner() *User {
+// GetBlockedIssueRepo returns the BlockedIssueRepo field.
+func (i *IssueDependenciesEvent) GetBlockedIssueRepo() *Repository {
 	if i == nil {
 		return nil
 	}
-	return i.Assigner
+	return i.BlockedIssueRepo
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCommitID() string {
-	if i == nil || i.CommitID == nil {
-		return ""
+// GetBlockingIssue returns the BlockingIssue field.
+func (i *IssueDependenciesEvent) GetBlockingIssue() *Issue {
+	if i == nil {
+		return nil
 	}
-	return *i.CommitID
+	return i.BlockingIssue
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+// GetBlockingIssueRepo returns the BlockingIssueRepo field.
+func (i *IssueDependenciesEvent) GetBlockingIssueRepo() *Repository {
+	if i == nil {
+		return nil
 	}
-	return *i.CreatedAt
+	return i.BlockingIssueRepo
 }
 
-// GetDismissedReview returns the DismissedReview field.
-func (i *IssueEvent) GetDismissedReview() *DismissedReview {
+// GetInstallation returns the Installation field.
+func (i *IssueDependenciesEvent) GetInstallation() *Installation {
 	if i == nil {
 		return nil
 	}
-	return i.DismissedReview
+	return i.Installation
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetEvent() string {
-	if i == nil || i.Event == nil {
-		return ""
+// GetOrg returns the Org field.
+func (i *IssueDependenciesEvent) GetOrg() *Organization {
+	if i == nil {
+		return nil
 	}
-	return *i.Event
+	return i.Org
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetRepo returns the Repo field.
+func (i *IssueDependenciesEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
 	}
-	return *i.ID
+	return i.Repo
 }
 
-// GetIssue returns the Issue field.
+// GetSender returns the Sender field.
+func (i *IssueDependenciesEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetActor returns the Actor field.
+func (i *IssueEvent) GetActor() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Actor
+}
+
+// GetAssignee returns the Assignee field.
+func (i *IssueEvent) GetAssignee() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetAssigner returns the Assigner field.
+func (i *IssueEvent) GetAssigner() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assigner
+}
+
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCommitID() string {
+	if i == nil || i.CommitID == nil {
+		return ""
+	}
+	return *i.CommitID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.CreatedAt
+}
+
+// GetDismissedReview returns the DismissedReview field.
+func (i *IssueEvent) GetDismissedReview() *DismissedReview {
+	if i == nil {
+		return nil
+	}
+	return i.DismissedReview
+}
+
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetEvent() string {
+	if i == nil || i.Event == nil {
+		return ""
+	}
+	return *i.Event
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetIssue returns the Issue field.
 func (i *IssueEvent) GetIssue() *Issue {
 	if i == nil {
 		return nil
@@ -10670,6 +11638,54 @@ func (l *License) GetURL() string {
 	return *l.URL
 }
 
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (l *LicenseSyncInfo) GetDate() Timestamp {
+	if l == nil || l.Date == nil {
+		return Timestamp{}
+	}
+	return *l.Date
+}
+
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (l *LicenseSyncInfo) GetError() string {
+	if l == nil || l.Error == nil {
+		return ""
+	}
+	return *l.Error
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (l *LicenseSyncInfo) GetStatus() string {
+	if l == nil || l.Status == nil {
+		return ""
+	}
+	return *l.Status
+}
+
+// GetHostname returns the Hostname field if it's non-nil, zero value otherwise.
+func (l *LicenseSyncServerInstance) GetHostname() string {
+	if l == nil || l.Hostname == nil {
+		return ""
+	}
+	return *l.Hostname
+}
+
+// GetLastSync returns the LastSync field.
+func (l *LicenseSyncServerInstance) GetLastSync() *LicenseSyncInfo {
+	if l == nil {
+		return nil
+	}
+	return l.LastSync
+}
+
+// GetServerID returns the ServerID field if it's non-nil, zero value otherwise.
+func (l *LicenseSyncServerInstance) GetServerID() string {
+	if l == nil || l.ServerID == nil {
+		return ""
+	}
+	return *l.ServerID
+}
+
 // GetFrom returns the From field if it's non-nil, zero value otherwise.
 func (l *LinearHistoryRequirementEnforcementLevelChanges) GetFrom() string {
 	if l == nil || l.From == nil {
@@ -10926,6 +11942,14 @@ func (l *ListOrganizations) GetTotalCount() int {
 	return *l.TotalCount
 }
 
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (l *ListRepositories) GetRepositorySelection() string {
+	if l == nil || l.RepositorySelection == nil {
+		return ""
+	}
+	return *l.RepositorySelection
+}
+
 // GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
 func (l *ListRepositories) GetTotalCount() int {
 	if l == nil || l.TotalCount == nil {
@@ -10966,6 +11990,14 @@ func (l *ListSCIMProvisionedIdentitiesOptions) GetStartIndex() int {
 	return *l.StartIndex
 }
 
+// GetWithTimestamps returns the WithTimestamps field if it's non-nil, zero value otherwise.
+func (l *ListStargazersOptions) GetWithTimestamps() bool {
+	if l == nil || l.WithTimestamps == nil {
+		return false
+	}
+	return *l.WithTimestamps
+}
+
 // GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
 func (l *Location) GetEndColumn() int {
 	if l == nil || l.EndColumn == nil {
@@ -11814,6 +12846,30 @@ func (m *Migration) GetExcludeAttachments() bool {
 	return *m.ExcludeAttachments
 }
 
+// GetExcludeGitData returns the ExcludeGitData field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeGitData() bool {
+	if m == nil || m.ExcludeGitData == nil {
+		return false
+	}
+	return *m.ExcludeGitData
+}
+
+// GetExcludeOwnerProjects returns the ExcludeOwnerProjects field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeOwnerProjects() bool {
+	if m == nil || m.ExcludeOwnerProjects == nil {
+		return false
+	}
+	return *m.ExcludeOwnerProjects
+}
+
+// GetExcludeReleases returns the ExcludeReleases field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeReleases() bool {
+	if m == nil || m.ExcludeReleases == nil {
+		return false
+	}
+	return *m.ExcludeReleases
+}
+
 // GetGUID returns the GUID field if it's non-nil, zero value otherwise.
 func (m *Migration) GetGUID() string {
 	if m == nil || m.GUID == nil {
@@ -11838,6 +12894,14 @@ func (m *Migration) GetLockRepositories() bool {
 	return *m.LockRepositories
 }
 
+// GetOrgMetadataOnly returns the OrgMetadataOnly field if it's non-nil, zero value otherwise.
+func (m *Migration) GetOrgMetadataOnly() bool {
+	if m == nil || m.OrgMetadataOnly == nil {
+		return false
+	}
+	return *m.OrgMetadataOnly
+}
+
 // GetState returns the State field if it's non-nil, zero value otherwise.
 func (m *Migration) GetState() string {
 	if m == nil || m.State == nil {
@@ -12142,6 +13206,102 @@ func (m *MostRecentInstance) GetState() string {
 	return *m.State
 }
 
+// GetComputeService returns the ComputeService field if it's non-nil, zero value otherwise.
+func (n *NetworkConfiguration) GetComputeService() string {
+	if n == nil || n.ComputeService == nil {
+		return ""
+	}
+	return *n.ComputeService
+}
+
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (n *NetworkConfiguration) GetCreatedOn() Timestamp {
+	if n == nil || n.CreatedOn == nil {
+		return Timestamp{}
+	}
+	return *n.CreatedOn
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (n *NetworkConfiguration) GetID() string {
+	if n == nil || n.ID == nil {
+		return ""
+	}
+	return *n.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (n *NetworkConfiguration) GetName() string {
+	if n == nil || n.Name == nil {
+		return ""
+	}
+	return *n.Name
+}
+
+// GetComputeService returns the ComputeService field if it's non-nil, zero value otherwise.
+func (n *NetworkConfigurationRequest) GetComputeService() string {
+	if n == nil || n.ComputeService == nil {
+		return ""
+	}
+	return *n.ComputeService
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (n *NetworkConfigurationRequest) GetName() string {
+	if n == nil || n.Name == nil {
+		return ""
+	}
+	return *n.Name
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (n *NetworkConfigurations) GetTotalCount() int64 {
+	if n == nil || n.TotalCount == nil {
+		return 0
+	}
+	return *n.TotalCount
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (n *NetworkSettings) GetID() string {
+	if n == nil || n.ID == nil {
+		return ""
+	}
+	return *n.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (n *NetworkSettings) GetName() string {
+	if n == nil || n.Name == nil {
+		return ""
+	}
+	return *n.Name
+}
+
+// GetNetworkConfigurationID returns the NetworkConfigurationID field if it's non-nil, zero value otherwise.
+func (n *NetworkSettings) GetNetworkConfigurationID() string {
+	if n == nil || n.NetworkConfigurationID == nil {
+		return ""
+	}
+	return *n.NetworkConfigurationID
+}
+
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (n *NetworkSettings) GetRegion() string {
+	if n == nil || n.Region == nil {
+		return ""
+	}
+	return *n.Region
+}
+
+// GetSubnetID returns the SubnetID field if it's non-nil, zero value otherwise.
+func (n *NetworkSettings) GetSubnetID() string {
+	if n == nil || n.SubnetID == nil {
+		return ""
+	}
+	return *n.SubnetID
+}
+
 // GetBase returns the Base field if it's non-nil, zero value otherwise.
 func (n *NewPullRequest) GetBase() string {
 	if n == nil || n.Base == nil {
@@ -12758,6 +13918,22 @@ func (o *Organization) GetSecretScanningEnabledForNewRepos() bool {
 	return *o.SecretScanningEnabledForNewRepos
 }
 
+// GetSecretScanningPushProtectionCustomLink returns the SecretScanningPushProtectionCustomLink field if it's non-nil, zero value otherwise.
+func (o *Organization) GetSecretScanningPushProtectionCustomLink() string {
+	if o == nil || o.SecretScanningPushProtectionCustomLink == nil {
+		return ""
+	}
+	return *o.SecretScanningPushProtectionCustomLink
+}
+
+// GetSecretScanningPushProtectionCustomLinkEnabled returns the SecretScanningPushProtectionCustomLinkEnabled field if it's non-nil, zero value otherwise.
+func (o *Organization) GetSecretScanningPushProtectionCustomLinkEnabled() bool {
+	if o == nil || o.SecretScanningPushProtectionCustomLinkEnabled == nil {
+		return false
+	}
+	return *o.SecretScanningPushProtectionCustomLinkEnabled
+}
+
 // GetSecretScanningPushProtectionEnabledForNewRepos returns the SecretScanningPushProtectionEnabledForNewRepos field if it's non-nil, zero value otherwise.
 func (o *Organization) GetSecretScanningPushProtectionEnabledForNewRepos() bool {
 	if o == nil || o.SecretScanningPushProtectionEnabledForNewRepos == nil {
@@ -12894,6 +14070,22 @@ func (o *OrganizationInstallations) GetTotalCount() int {
 	return *o.TotalCount
 }
 
+// GetHasOrganizationProjects returns the HasOrganizationProjects field if it's non-nil, zero value otherwise.
+func (o *OrganizationProjectsPolicy) GetHasOrganizationProjects() bool {
+	if o == nil || o.HasOrganizationProjects == nil {
+		return false
+	}
+	return *o.HasOrganizationProjects
+}
+
+// GetHasRepositoryProjects returns the HasRepositoryProjects field if it's non-nil, zero value otherwise.
+func (o *OrganizationProjectsPolicy) GetHasRepositoryProjects() bool {
+	if o == nil || o.HasRepositoryProjects == nil {
+		return false
+	}
+	return *o.HasRepositoryProjects
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (o *OrgBlockEvent) GetAction() string {
 	if o == nil || o.Action == nil {
@@ -13798,6 +14990,22 @@ func (p *Pages) GetHTTPSEnforced() bool {
 	return *p.HTTPSEnforced
 }
 
+// GetPendingDomainUnverifiedAt returns the PendingDomainUnverifiedAt field if it's non-nil, zero value otherwise.
+func (p *Pages) GetPendingDomainUnverifiedAt() Timestamp {
+	if p == nil || p.PendingDomainUnverifiedAt == nil {
+		return Timestamp{}
+	}
+	return *p.PendingDomainUnverifiedAt
+}
+
+// GetProtectedDomainState returns the ProtectedDomainState field if it's non-nil, zero value otherwise.
+func (p *Pages) GetProtectedDomainState() string {
+	if p == nil || p.ProtectedDomainState == nil {
+		return ""
+	}
+	return *p.ProtectedDomainState
+}
+
 // GetPublic returns the Public field if it's non-nil, zero value otherwise.
 func (p *Pages) GetPublic() bool {
 	if p == nil || p.Public == nil {
@@ -13894,6 +15102,46 @@ func (p *PagesBuild) GetURL() string {
 	return *p.URL
 }
 
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PagesDeployment) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetPageURL returns the PageURL field if it's non-nil, zero value otherwise.
+func (p *PagesDeployment) GetPageURL() string {
+	if p == nil || p.PageURL == nil {
+		return ""
+	}
+	return *p.PageURL
+}
+
+// GetPreviewURL returns the PreviewURL field if it's non-nil, zero value otherwise.
+func (p *PagesDeployment) GetPreviewURL() string {
+	if p == nil || p.PreviewURL == nil {
+		return ""
+	}
+	return *p.PreviewURL
+}
+
+// GetStatusURL returns the StatusURL field if it's non-nil, zero value otherwise.
+func (p *PagesDeployment) GetStatusURL() string {
+	if p == nil || p.StatusURL == nil {
+		return ""
+	}
+	return *p.StatusURL
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PagesDeploymentStatus) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
 // GetCAAError returns the CAAError field if it's non-nil, zero value otherwise.
 func (p *PagesDomain) GetCAAError() string {
 	if p == nil || p.CAAError == nil {
@@ -14230,20 +15478,108 @@ func (p *PagesUpdate) GetSource() *PagesSource {
 	return p.Source
 }
 
-// GetOrg returns the Org map if it's non-nil, an empty map otherwise.
-func (p *PersonalAccessTokenPermissions) GetOrg() map[string]string {
-	if p == nil || p.Org == nil {
-		return map[string]string{}
+// GetAccessGrantedAt returns the AccessGrantedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetAccessGrantedAt() Timestamp {
+	if p == nil || p.AccessGrantedAt == nil {
+		return Timestamp{}
 	}
-	return p.Org
+	return *p.AccessGrantedAt
 }
 
-// GetOther returns the Other map if it's non-nil, an empty map otherwise.
-func (p *PersonalAccessTokenPermissions) GetOther() map[string]string {
-	if p == nil || p.Other == nil {
-		return map[string]string{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return p.Other
+	return *p.ID
+}
+
+// GetOwner returns the Owner field.
+func (p *PersonalAccessToken) GetOwner() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Owner
+}
+
+// GetPermissions returns the Permissions field.
+func (p *PersonalAccessToken) GetPermissions() *PersonalAccessTokenPermissions {
+	if p == nil {
+		return nil
+	}
+	return p.Permissions
+}
+
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetRepositoriesURL() string {
+	if p == nil || p.RepositoriesURL == nil {
+		return ""
+	}
+	return *p.RepositoriesURL
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetRepositorySelection() string {
+	if p == nil || p.RepositorySelection == nil {
+		return ""
+	}
+	return *p.RepositorySelection
+}
+
+// GetTokenExpired returns the TokenExpired field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenExpired() bool {
+	if p == nil || p.TokenExpired == nil {
+		return false
+	}
+	return *p.TokenExpired
+}
+
+// GetTokenExpiresAt returns the TokenExpiresAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenExpiresAt() Timestamp {
+	if p == nil || p.TokenExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *p.TokenExpiresAt
+}
+
+// GetTokenID returns the TokenID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenID() int64 {
+	if p == nil || p.TokenID == nil {
+		return 0
+	}
+	return *p.TokenID
+}
+
+// GetTokenLastUsedAt returns the TokenLastUsedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenLastUsedAt() Timestamp {
+	if p == nil || p.TokenLastUsedAt == nil {
+		return Timestamp{}
+	}
+	return *p.TokenLastUsedAt
+}
+
+// GetTokenName returns the TokenName field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenName() string {
+	if p == nil || p.TokenName == nil {
+		return ""
+	}
+	return *p.TokenName
+}
+
+// GetOrg returns the Org map if it's non-nil, an empty map otherwise.
+func (p *PersonalAccessTokenPermissions) GetOrg() map[string]string {
+	if p == nil || p.Org == nil {
+		return map[string]string{}
+	}
+	return p.Org
+}
+
+// GetOther returns the Other map if it's non-nil, an empty map otherwise.
+func (p *PersonalAccessTokenPermissions) GetOther() map[string]string {
+	if p == nil || p.Other == nil {
+		return map[string]string{}
+	}
+	return p.Other
 }
 
 // GetRepo returns the Repo map if it's non-nil, an empty map otherwise.
@@ -14286,6 +15622,14 @@ func (p *PersonalAccessTokenRequest) GetOwner() *User {
 	return p.Owner
 }
 
+// GetPermissions returns the Permissions field.
+func (p *PersonalAccessTokenRequest) GetPermissions() *PersonalAccessTokenPermissions {
+	if p == nil {
+		return nil
+	}
+	return p.Permissions
+}
+
 // GetPermissionsAdded returns the PermissionsAdded field.
 func (p *PersonalAccessTokenRequest) GetPermissionsAdded() *PersonalAccessTokenPermissions {
 	if p == nil {
@@ -14310,6 +15654,22 @@ func (p *PersonalAccessTokenRequest) GetPermissionsUpgraded() *PersonalAccessTok
 	return p.PermissionsUpgraded
 }
 
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetReason() string {
+	if p == nil || p.Reason == nil {
+		return ""
+	}
+	return *p.Reason
+}
+
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetRepositoriesURL() string {
+	if p == nil || p.RepositoriesURL == nil {
+		return ""
+	}
+	return *p.RepositoriesURL
+}
+
 // GetRepositoryCount returns the RepositoryCount field if it's non-nil, zero value otherwise.
 func (p *PersonalAccessTokenRequest) GetRepositoryCount() int64 {
 	if p == nil || p.RepositoryCount == nil {
@@ -14342,6 +15702,14 @@ func (p *PersonalAccessTokenRequest) GetTokenExpiresAt() Timestamp {
 	return *p.TokenExpiresAt
 }
 
+// GetTokenID returns the TokenID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenID() int64 {
+	if p == nil || p.TokenID == nil {
+		return 0
+	}
+	return *p.TokenID
+}
+
 // GetTokenLastUsedAt returns the TokenLastUsedAt field if it's non-nil, zero value otherwise.
 func (p *PersonalAccessTokenRequest) GetTokenLastUsedAt() Timestamp {
 	if p == nil || p.TokenLastUsedAt == nil {
@@ -14350,6 +15718,14 @@ func (p *PersonalAccessTokenRequest) GetTokenLastUsedAt() Timestamp {
 	return *p.TokenLastUsedAt
 }
 
+// GetTokenName returns the TokenName field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenName() string {
+	if p == nil || p.TokenName == nil {
+		return ""
+	}
+	return *p.TokenName
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (p *PersonalAccessTokenRequestEvent) GetAction() string {
 	if p == nil || p.Action == nil {
@@ -15166,6 +16542,102 @@ func (p *ProjectEvent) GetSender() *User {
 	return p.Sender
 }
 
+// GetActor returns the Actor field.
+func (p *ProjectItemChange) GetActor() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Actor
+}
+
+// GetChangedAt returns the ChangedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectItemChange) GetChangedAt() Timestamp {
+	if p == nil || p.ChangedAt == nil {
+		return Timestamp{}
+	}
+	return *p.ChangedAt
+}
+
+// GetFieldName returns the FieldName field if it's non-nil, zero value otherwise.
+func (p *ProjectItemChange) GetFieldName() string {
+	if p == nil || p.FieldName == nil {
+		return ""
+	}
+	return *p.FieldName
+}
+
+// GetFieldNodeID returns the FieldNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectItemChange) GetFieldNodeID() string {
+	if p == nil || p.FieldNodeID == nil {
+		return ""
+	}
+	return *p.FieldNodeID
+}
+
+// GetFieldType returns the FieldType field if it's non-nil, zero value otherwise.
+func (p *ProjectItemChange) GetFieldType() string {
+	if p == nil || p.FieldType == nil {
+		return ""
+	}
+	return *p.FieldType
+}
+
+// GetFrom returns the From field.
+func (p *ProjectItemChange) GetFrom() *ProjectV2ItemFieldValue {
+	if p == nil {
+		return nil
+	}
+	return p.From
+}
+
+// GetItemNodeID returns the ItemNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectItemChange) GetItemNodeID() string {
+	if p == nil || p.ItemNodeID == nil {
+		return ""
+	}
+	return *p.ItemNodeID
+}
+
+// GetProjectNodeID returns the ProjectNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectItemChange) GetProjectNodeID() string {
+	if p == nil || p.ProjectNodeID == nil {
+		return ""
+	}
+	return *p.ProjectNodeID
+}
+
+// GetTo returns the To field.
+func (p *ProjectItemChange) GetTo() *ProjectV2ItemFieldValue {
+	if p == nil {
+		return nil
+	}
+	return p.To
+}
+
+// GetArchived returns the Archived map if it's non-nil, an empty map otherwise.
+func (p *ProjectItemsSummary) GetArchived() map[string]int {
+	if p == nil || p.Archived == nil {
+		return map[string]int{}
+	}
+	return p.Archived
+}
+
+// GetCounts returns the Counts map if it's non-nil, an empty map otherwise.
+func (p *ProjectItemsSummary) GetCounts() map[string]int {
+	if p == nil || p.Counts == nil {
+		return map[string]int{}
+	}
+	return p.Counts
+}
+
+// GetField returns the Field field.
+func (p *ProjectItemsSummary) GetField() *ProjectV2Field {
+	if p == nil {
+		return nil
+	}
+	return p.Field
+}
+
 // GetFrom returns the From field if it's non-nil, zero value otherwise.
 func (p *ProjectName) GetFrom() string {
 	if p == nil || p.From == nil {
@@ -15259,155 +16731,603 @@ func (p *ProjectsV2) GetDeletedAt() Timestamp {
 	if p == nil || p.DeletedAt == nil {
 		return Timestamp{}
 	}
-	return *p.DeletedAt
+	return *p.DeletedAt
+}
+
+// GetDeletedBy returns the DeletedBy field.
+func (p *ProjectsV2) GetDeletedBy() *User {
+	if p == nil {
+		return nil
+	}
+	return p.DeletedBy
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *ProjectsV2) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectsV2) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectsV2) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *ProjectsV2) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetOwner returns the Owner field.
+func (p *ProjectsV2) GetOwner() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Owner
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *ProjectsV2) GetPublic() bool {
+	if p == nil || p.Public == nil {
+		return false
+	}
+	return *p.Public
+}
+
+// GetShortDescription returns the ShortDescription field if it's non-nil, zero value otherwise.
+func (p *ProjectsV2) GetShortDescription() string {
+	if p == nil || p.ShortDescription == nil {
+		return ""
+	}
+	return *p.ShortDescription
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectsV2) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
+	}
+	return *p.Title
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectsV2) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Event) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetInstallation returns the Installation field.
+func (p *ProjectV2Event) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetOrg returns the Org field.
+func (p *ProjectV2Event) GetOrg() *Organization {
+	if p == nil {
+		return nil
+	}
+	return p.Org
+}
+
+// GetProjectsV2 returns the ProjectsV2 field.
+func (p *ProjectV2Event) GetProjectsV2() *ProjectsV2 {
+	if p == nil {
+		return nil
+	}
+	return p.ProjectsV2
+}
+
+// GetSender returns the Sender field.
+func (p *ProjectV2Event) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetDataType returns the DataType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetDataType() string {
+	if p == nil || p.DataType == nil {
+		return ""
+	}
+	return *p.DataType
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldOption) GetColor() string {
+	if p == nil || p.Color == nil {
+		return ""
+	}
+	return *p.Color
+}
+
+// GetDescription returns the Description field.
+func (p *ProjectV2FieldOption) GetDescription() *ProjectV2TextContent {
+	if p == nil {
+		return nil
+	}
+	return p.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldOption) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field.
+func (p *ProjectV2FieldOption) GetName() *ProjectV2TextContent {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetArchivedAt returns the ArchivedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetArchivedAt() Timestamp {
+	if p == nil || p.ArchivedAt == nil {
+		return Timestamp{}
+	}
+	return *p.ArchivedAt
+}
+
+// GetContent returns the Content field.
+func (p *ProjectV2Item) GetContent() *ProjectV2ItemContent {
+	if p == nil {
+		return nil
+	}
+	return p.Content
+}
+
+// GetContentNodeID returns the ContentNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetContentNodeID() string {
+	if p == nil || p.ContentNodeID == nil {
+		return ""
+	}
+	return *p.ContentNodeID
+}
+
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetContentType() string {
+	if p == nil || p.ContentType == nil {
+		return ""
+	}
+	return *p.ContentType
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (p *ProjectV2Item) GetCreator() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Creator
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetProjectNodeID returns the ProjectNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetProjectNodeID() string {
+	if p == nil || p.ProjectNodeID == nil {
+		return ""
+	}
+	return *p.ProjectNodeID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetArchivedAt returns the ArchivedAt field.
+func (p *ProjectV2ItemChange) GetArchivedAt() *ArchivedAt {
+	if p == nil {
+		return nil
+	}
+	return p.ArchivedAt
+}
+
+// GetFieldValue returns the FieldValue field.
+func (p *ProjectV2ItemChange) GetFieldValue() *ProjectV2ItemFieldValueChange {
+	if p == nil {
+		return nil
+	}
+	return p.FieldValue
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
+	}
+	return *p.Body
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
+	}
+	return *p.Title
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetChanges returns the Changes field.
+func (p *ProjectV2ItemEvent) GetChanges() *ProjectV2ItemChange {
+	if p == nil {
+		return nil
+	}
+	return p.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (p *ProjectV2ItemEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetOrg returns the Org field.
+func (p *ProjectV2ItemEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
+	}
+	return p.Org
+}
+
+// GetProjectV2Item returns the ProjectV2Item field.
+func (p *ProjectV2ItemEvent) GetProjectV2Item() *ProjectV2Item {
+	if p == nil {
+		return nil
+	}
+	return p.ProjectV2Item
 }
 
-// GetDeletedBy returns the DeletedBy field.
-func (p *ProjectsV2) GetDeletedBy() *User {
+// GetSender returns the Sender field.
+func (p *ProjectV2ItemEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
-	return p.DeletedBy
+	return p.Sender
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *ProjectsV2) GetDescription() string {
-	if p == nil || p.Description == nil {
+// GetDataType returns the DataType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemField) GetDataType() string {
+	if p == nil || p.DataType == nil {
 		return ""
 	}
-	return *p.Description
+	return *p.DataType
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectsV2) GetID() int64 {
+func (p *ProjectV2ItemField) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
 	return *p.ID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectsV2) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemField) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.Name
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *ProjectsV2) GetNumber() int {
-	if p == nil || p.Number == nil {
-		return 0
+// GetValue returns the Value field.
+func (p *ProjectV2ItemField) GetValue() *ProjectV2ItemFieldValue {
+	if p == nil {
+		return nil
 	}
-	return *p.Number
+	return p.Value
 }
 
-// GetOwner returns the Owner field.
-func (p *ProjectsV2) GetOwner() *User {
+// GetIteration returns the Iteration field.
+func (p *ProjectV2ItemFieldValue) GetIteration() *ProjectV2Iteration {
 	if p == nil {
 		return nil
 	}
-	return p.Owner
+	return p.Iteration
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (p *ProjectsV2) GetPublic() bool {
-	if p == nil || p.Public == nil {
-		return false
+// GetNumber returns the Number field.
+func (p *ProjectV2ItemFieldValue) GetNumber() *float64 {
+	if p == nil {
+		return nil
 	}
-	return *p.Public
+	return p.Number
 }
 
-// GetShortDescription returns the ShortDescription field if it's non-nil, zero value otherwise.
-func (p *ProjectsV2) GetShortDescription() string {
-	if p == nil || p.ShortDescription == nil {
+// GetSingleSelectOption returns the SingleSelectOption field.
+func (p *ProjectV2ItemFieldValue) GetSingleSelectOption() *ProjectV2SingleSelectOption {
+	if p == nil {
+		return nil
+	}
+	return p.SingleSelectOption
+}
+
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValue) GetText() string {
+	if p == nil || p.Text == nil {
 		return ""
 	}
-	return *p.ShortDescription
+	return *p.Text
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *ProjectsV2) GetTitle() string {
-	if p == nil || p.Title == nil {
+// GetFieldName returns the FieldName field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValueChange) GetFieldName() string {
+	if p == nil || p.FieldName == nil {
 		return ""
 	}
-	return *p.Title
+	return *p.FieldName
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectsV2) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetFieldNodeID returns the FieldNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValueChange) GetFieldNodeID() string {
+	if p == nil || p.FieldNodeID == nil {
+		return ""
 	}
-	return *p.UpdatedAt
+	return *p.FieldNodeID
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectV2Event) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetFieldType returns the FieldType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValueChange) GetFieldType() string {
+	if p == nil || p.FieldType == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.FieldType
 }
 
-// GetInstallation returns the Installation field.
-func (p *ProjectV2Event) GetInstallation() *Installation {
+// GetFrom returns the From field.
+func (p *ProjectV2ItemFieldValueChange) GetFrom() *ProjectV2ItemFieldValue {
 	if p == nil {
 		return nil
 	}
-	return p.Installation
+	return p.From
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectV2Event) GetOrg() *Organization {
-	if p == nil {
-		return nil
+// GetProjectNumber returns the ProjectNumber field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValueChange) GetProjectNumber() int {
+	if p == nil || p.ProjectNumber == nil {
+		return 0
 	}
-	return p.Org
+	return *p.ProjectNumber
 }
 
-// GetProjectsV2 returns the ProjectsV2 field.
-func (p *ProjectV2Event) GetProjectsV2() *ProjectsV2 {
+// GetTo returns the To field.
+func (p *ProjectV2ItemFieldValueChange) GetTo() *ProjectV2ItemFieldValue {
 	if p == nil {
 		return nil
 	}
-	return p.ProjectsV2
+	return p.To
 }
 
-// GetSender returns the Sender field.
-func (p *ProjectV2Event) GetSender() *User {
-	if p == nil {
-		return nil
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Iteration) GetDuration() int {
+	if p == nil || p.Duration == nil {
+		return 0
 	}
-	return p.Sender
+	return *p.Duration
 }
 
-// GetArchivedAt returns the ArchivedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectV2Item) GetArchivedAt() Timestamp {
-	if p == nil || p.ArchivedAt == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Iteration) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
 	}
-	return *p.ArchivedAt
+	return *p.ID
 }
 
-// GetContentNodeID returns the ContentNodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectV2Item) GetContentNodeID() string {
-	if p == nil || p.ContentNodeID == nil {
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Iteration) GetStartDate() string {
+	if p == nil || p.StartDate == nil {
 		return ""
 	}
-	return *p.ContentNodeID
+	return *p.StartDate
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (p *ProjectV2Item) GetContentType() string {
-	if p == nil || p.ContentType == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Iteration) GetTitle() string {
+	if p == nil || p.Title == nil {
 		return ""
 	}
-	return *p.ContentType
+	return *p.Title
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (p *ProjectV2SingleSelectOption) GetColor() string {
+	if p == nil || p.Color == nil {
+		return ""
+	}
+	return *p.Color
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *ProjectV2SingleSelectOption) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2SingleSelectOption) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2SingleSelectOption) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
+	}
+	return *p.Body
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectV2Item) GetCreatedAt() Timestamp {
+func (p *ProjectV2StatusUpdate) GetCreatedAt() Timestamp {
 	if p == nil || p.CreatedAt == nil {
 		return Timestamp{}
 	}
@@ -15415,7 +17335,7 @@ func (p *ProjectV2Item) GetCreatedAt() Timestamp {
 }
 
 // GetCreator returns the Creator field.
-func (p *ProjectV2Item) GetCreator() *User {
+func (p *ProjectV2StatusUpdate) GetCreator() *User {
 	if p == nil {
 		return nil
 	}
@@ -15423,7 +17343,7 @@ func (p *ProjectV2Item) GetCreator() *User {
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectV2Item) GetID() int64 {
+func (p *ProjectV2StatusUpdate) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
@@ -15431,7 +17351,7 @@ func (p *ProjectV2Item) GetID() int64 {
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectV2Item) GetNodeID() string {
+func (p *ProjectV2StatusUpdate) GetNodeID() string {
 	if p == nil || p.NodeID == nil {
 		return ""
 	}
@@ -15439,31 +17359,79 @@ func (p *ProjectV2Item) GetNodeID() string {
 }
 
 // GetProjectNodeID returns the ProjectNodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectV2Item) GetProjectNodeID() string {
+func (p *ProjectV2StatusUpdate) GetProjectNodeID() string {
 	if p == nil || p.ProjectNodeID == nil {
 		return ""
 	}
 	return *p.ProjectNodeID
 }
 
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetStartDate() string {
+	if p == nil || p.StartDate == nil {
+		return ""
+	}
+	return *p.StartDate
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
+// GetTargetDate returns the TargetDate field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetTargetDate() string {
+	if p == nil || p.TargetDate == nil {
+		return ""
+	}
+	return *p.TargetDate
+}
+
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectV2Item) GetUpdatedAt() Timestamp {
+func (p *ProjectV2StatusUpdate) GetUpdatedAt() Timestamp {
 	if p == nil || p.UpdatedAt == nil {
 		return Timestamp{}
 	}
 	return *p.UpdatedAt
 }
 
-// GetArchivedAt returns the ArchivedAt field.
-func (p *ProjectV2ItemChange) GetArchivedAt() *ArchivedAt {
+// GetBody returns the Body field.
+func (p *ProjectV2StatusUpdateChange) GetBody() *StringChange {
 	if p == nil {
 		return nil
 	}
-	return p.ArchivedAt
+	return p.Body
+}
+
+// GetStartDate returns the StartDate field.
+func (p *ProjectV2StatusUpdateChange) GetStartDate() *StringChange {
+	if p == nil {
+		return nil
+	}
+	return p.StartDate
+}
+
+// GetStatus returns the Status field.
+func (p *ProjectV2StatusUpdateChange) GetStatus() *StringChange {
+	if p == nil {
+		return nil
+	}
+	return p.Status
+}
+
+// GetTargetDate returns the TargetDate field.
+func (p *ProjectV2StatusUpdateChange) GetTargetDate() *StringChange {
+	if p == nil {
+		return nil
+	}
+	return p.TargetDate
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectV2ItemEvent) GetAction() string {
+func (p *ProjectV2StatusUpdateEvent) GetAction() string {
 	if p == nil || p.Action == nil {
 		return ""
 	}
@@ -15471,7 +17439,7 @@ func (p *ProjectV2ItemEvent) GetAction() string {
 }
 
 // GetChanges returns the Changes field.
-func (p *ProjectV2ItemEvent) GetChanges() *ProjectV2ItemChange {
+func (p *ProjectV2StatusUpdateEvent) GetChanges() *ProjectV2StatusUpdateChange {
 	if p == nil {
 		return nil
 	}
@@ -15479,7 +17447,7 @@ func (p *ProjectV2ItemEvent) GetChanges() *ProjectV2ItemChange {
 }
 
 // GetInstallation returns the Installation field.
-func (p *ProjectV2ItemEvent) GetInstallation() *Installation {
+func (p *ProjectV2StatusUpdateEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
@@ -15487,29 +17455,45 @@ func (p *ProjectV2ItemEvent) GetInstallation() *Installation {
 }
 
 // GetOrg returns the Org field.
-func (p *ProjectV2ItemEvent) GetOrg() *Organization {
+func (p *ProjectV2StatusUpdateEvent) GetOrg() *Organization {
 	if p == nil {
 		return nil
 	}
 	return p.Org
 }
 
-// GetProjectV2Item returns the ProjectV2Item field.
-func (p *ProjectV2ItemEvent) GetProjectV2Item() *ProjectV2Item {
+// GetProjectV2StatusUpdate returns the ProjectV2StatusUpdate field.
+func (p *ProjectV2StatusUpdateEvent) GetProjectV2StatusUpdate() *ProjectV2StatusUpdate {
 	if p == nil {
 		return nil
 	}
-	return p.ProjectV2Item
+	return p.ProjectV2StatusUpdate
 }
 
 // GetSender returns the Sender field.
-func (p *ProjectV2ItemEvent) GetSender() *User {
+func (p *ProjectV2StatusUpdateEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Sender
 }
 
+// GetHTML returns the HTML field if it's non-nil, zero value otherwise.
+func (p *ProjectV2TextContent) GetHTML() string {
+	if p == nil || p.HTML == nil {
+		return ""
+	}
+	return *p.HTML
+}
+
+// GetRaw returns the Raw field if it's non-nil, zero value otherwise.
+func (p *ProjectV2TextContent) GetRaw() string {
+	if p == nil || p.Raw == nil {
+		return ""
+	}
+	return *p.Raw
+}
+
 // GetAllowDeletions returns the AllowDeletions field.
 func (p *Protection) GetAllowDeletions() *AllowDeletions {
 	if p == nil {
@@ -15902,6 +17886,22 @@ func (p *PublicKey) GetKeyID() string {
 	return *p.KeyID
 }
 
+// GetDiscussionCategoryName returns the DiscussionCategoryName field if it's non-nil, zero value otherwise.
+func (p *PublishReleaseOptions) GetDiscussionCategoryName() string {
+	if p == nil || p.DiscussionCategoryName == nil {
+		return ""
+	}
+	return *p.DiscussionCategoryName
+}
+
+// GetMakeLatest returns the MakeLatest field if it's non-nil, zero value otherwise.
+func (p *PublishReleaseOptions) GetMakeLatest() string {
+	if p == nil || p.MakeLatest == nil {
+		return ""
+	}
+	return *p.MakeLatest
+}
+
 // GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
 func (p *PullRequest) GetActiveLockReason() string {
 	if p == nil || p.ActiveLockReason == nil {
@@ -18062,6 +20062,62 @@ func (r *RegistrationToken) GetToken() string {
 	return *r.Token
 }
 
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RegistryPackageEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
+	}
+	return *r.Action
+}
+
+// GetEnterprise returns the Enterprise field.
+func (r *RegistryPackageEvent) GetEnterprise() *Enterprise {
+	if r == nil {
+		return nil
+	}
+	return r.Enterprise
+}
+
+// GetInstallation returns the Installation field.
+func (r *RegistryPackageEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
+	}
+	return r.Installation
+}
+
+// GetOrganization returns the Organization field.
+func (r *RegistryPackageEvent) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Organization
+}
+
+// GetRegistryPackage returns the RegistryPackage field.
+func (r *RegistryPackageEvent) GetRegistryPackage() *Package {
+	if r == nil {
+		return nil
+	}
+	return r.RegistryPackage
+}
+
+// GetRepository returns the Repository field.
+func (r *RegistryPackageEvent) GetRepository() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.Repository
+}
+
+// GetSender returns the Sender field.
+func (r *RegistryPackageEvent) GetSender() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Sender
+}
+
 // GetBrowserDownloadURL returns the BrowserDownloadURL field if it's non-nil, zero value otherwise.
 func (r *ReleaseAsset) GetBrowserDownloadURL() string {
 	if r == nil || r.BrowserDownloadURL == nil {
@@ -18086,6 +20142,14 @@ func (r *ReleaseAsset) GetCreatedAt() Timestamp {
 	return *r.CreatedAt
 }
 
+// GetDigest returns the Digest field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetDigest() string {
+	if r == nil || r.Digest == nil {
+		return ""
+	}
+	return *r.Digest
+}
+
 // GetDownloadCount returns the DownloadCount field if it's non-nil, zero value otherwise.
 func (r *ReleaseAsset) GetDownloadCount() int {
 	if r == nil || r.DownloadCount == nil {
@@ -18494,6 +20558,22 @@ func (r *RepoRequiredWorkflows) GetTotalCount() int {
 	return *r.TotalCount
 }
 
+// GetCodeScanning returns the CodeScanning map if it's non-nil, an empty map otherwise.
+func (r *RepoSecuritySummary) GetCodeScanning() map[string]int {
+	if r == nil || r.CodeScanning == nil {
+		return map[string]int{}
+	}
+	return r.CodeScanning
+}
+
+// GetDependabot returns the Dependabot map if it's non-nil, an empty map otherwise.
+func (r *RepoSecuritySummary) GetDependabot() map[string]int {
+	if r == nil || r.Dependabot == nil {
+		return map[string]int{}
+	}
+	return r.Dependabot
+}
+
 // GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
 func (r *RepositoriesSearchResult) GetIncompleteResults() bool {
 	if r == nil || r.IncompleteResults == nil {
@@ -19374,6 +21454,22 @@ func (r *RepositoryComment) GetBody() string {
 	return *r.Body
 }
 
+// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetBodyHTML() string {
+	if r == nil || r.BodyHTML == nil {
+		return ""
+	}
+	return *r.BodyHTML
+}
+
+// GetBodyText returns the BodyText field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetBodyText() string {
+	if r == nil || r.BodyText == nil {
+		return ""
+	}
+	return *r.BodyText
+}
+
 // GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
 func (r *RepositoryComment) GetCommitID() string {
 	if r == nil || r.CommitID == nil {
@@ -19998,6 +22094,14 @@ func (r *RepositoryPermissionLevel) GetPermission() string {
 	return *r.Permission
 }
 
+// GetRoleName returns the RoleName field if it's non-nil, zero value otherwise.
+func (r *RepositoryPermissionLevel) GetRoleName() string {
+	if r == nil || r.RoleName == nil {
+		return ""
+	}
+	return *r.RoleName
+}
+
 // GetUser returns the User field.
 func (r *RepositoryPermissionLevel) GetUser() *User {
 	if r == nil {
@@ -20174,6 +22278,102 @@ func (r *RepositoryRule) GetParameters() json.RawMessage {
 	return *r.Parameters
 }
 
+// GetConditions returns the Conditions field.
+func (r *RepositoryRulesetTemplate) GetConditions() *RulesetConditions {
+	if r == nil {
+		return nil
+	}
+	return r.Conditions
+}
+
+// GetTarget returns the Target field if it's non-nil, zero value otherwise.
+func (r *RepositoryRulesetTemplate) GetTarget() string {
+	if r == nil || r.Target == nil {
+		return ""
+	}
+	return *r.Target
+}
+
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetCVEID() string {
+	if r == nil || r.CVEID == nil {
+		return ""
+	}
+	return *r.CVEID
+}
+
+// GetCVSSVectorString returns the CVSSVectorString field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetCVSSVectorString() string {
+	if r == nil || r.CVSSVectorString == nil {
+		return ""
+	}
+	return *r.CVSSVectorString
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
+	}
+	return *r.Description
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetSeverity() string {
+	if r == nil || r.Severity == nil {
+		return ""
+	}
+	return *r.Severity
+}
+
+// GetStartPrivateFork returns the StartPrivateFork field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetStartPrivateFork() bool {
+	if r == nil || r.StartPrivateFork == nil {
+		return false
+	}
+	return *r.StartPrivateFork
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetState() string {
+	if r == nil || r.State == nil {
+		return ""
+	}
+	return *r.State
+}
+
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetSummary() string {
+	if r == nil || r.Summary == nil {
+		return ""
+	}
+	return *r.Summary
+}
+
+// GetPackage returns the Package field.
+func (r *RepositorySecurityAdvisoryVulnerability) GetPackage() *VulnerabilityPackage {
+	if r == nil {
+		return nil
+	}
+	return r.Package
+}
+
+// GetPatchedVersions returns the PatchedVersions field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryVulnerability) GetPatchedVersions() string {
+	if r == nil || r.PatchedVersions == nil {
+		return ""
+	}
+	return *r.PatchedVersions
+}
+
+// GetVulnerableVersionRange returns the VulnerableVersionRange field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryVulnerability) GetVulnerableVersionRange() string {
+	if r == nil || r.VulnerableVersionRange == nil {
+		return ""
+	}
+	return *r.VulnerableVersionRange
+}
+
 // GetCommit returns the Commit field.
 func (r *RepositoryTag) GetCommit() *Commit {
 	if r == nil {
@@ -20190,6 +22390,14 @@ func (r *RepositoryTag) GetName() string {
 	return *r.Name
 }
 
+// GetTag returns the Tag field.
+func (r *RepositoryTag) GetTag() *Tag {
+	if r == nil {
+		return nil
+	}
+	return r.Tag
+}
+
 // GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
 func (r *RepositoryTag) GetTarballURL() string {
 	if r == nil || r.TarballURL == nil {
@@ -20750,6 +22958,30 @@ func (r *Ruleset) GetTarget() string {
 	return *r.Target
 }
 
+// GetRuleset returns the Ruleset field.
+func (r *RulesetChangeSummary) GetRuleset() *Ruleset {
+	if r == nil {
+		return nil
+	}
+	return r.Ruleset
+}
+
+// GetOrganizationID returns the OrganizationID field.
+func (r *RulesetConditions) GetOrganizationID() *RulesetOrganizationIDsConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.OrganizationID
+}
+
+// GetOrganizationName returns the OrganizationName field.
+func (r *RulesetConditions) GetOrganizationName() *RulesetOrganizationNamesConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.OrganizationName
+}
+
 // GetRefName returns the RefName field.
 func (r *RulesetConditions) GetRefName() *RulesetRefConditionParameters {
 	if r == nil {
@@ -20774,6 +23006,14 @@ func (r *RulesetConditions) GetRepositoryName() *RulesetRepositoryNamesCondition
 	return r.RepositoryName
 }
 
+// GetRepositoryProperty returns the RepositoryProperty field.
+func (r *RulesetConditions) GetRepositoryProperty() *RulesetRepositoryPropertyConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryProperty
+}
+
 // GetHRef returns the HRef field if it's non-nil, zero value otherwise.
 func (r *RulesetLink) GetHRef() string {
 	if r == nil || r.HRef == nil {
@@ -20798,6 +23038,14 @@ func (r *RulesetRepositoryNamesConditionParameters) GetProtected() bool {
 	return *r.Protected
 }
 
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (r *RulesetRepositoryPropertyTargetParameters) GetSource() string {
+	if r == nil || r.Source == nil {
+		return ""
+	}
+	return *r.Source
+}
+
 // GetBusy returns the Busy field if it's non-nil, zero value otherwise.
 func (r *Runner) GetBusy() bool {
 	if r == nil || r.Busy == nil {
@@ -21662,6 +23910,62 @@ func (s *SecretScanningAlertLocationDetails) GetStartline() int {
 	return *s.Startline
 }
 
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
+	}
+	return *s.Action
+}
+
+// GetAlert returns the Alert field.
+func (s *SecretScanningAlertLocationEvent) GetAlert() *SecretScanningAlert {
+	if s == nil {
+		return nil
+	}
+	return s.Alert
+}
+
+// GetInstallation returns the Installation field.
+func (s *SecretScanningAlertLocationEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
+	}
+	return s.Installation
+}
+
+// GetLocation returns the Location field.
+func (s *SecretScanningAlertLocationEvent) GetLocation() *SecretScanningAlertLocation {
+	if s == nil {
+		return nil
+	}
+	return s.Location
+}
+
+// GetOrganization returns the Organization field.
+func (s *SecretScanningAlertLocationEvent) GetOrganization() *Organization {
+	if s == nil {
+		return nil
+	}
+	return s.Organization
+}
+
+// GetRepo returns the Repo field.
+func (s *SecretScanningAlertLocationEvent) GetRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repo
+}
+
+// GetSender returns the Sender field.
+func (s *SecretScanningAlertLocationEvent) GetSender() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
 // GetResolution returns the Resolution field if it's non-nil, zero value otherwise.
 func (s *SecretScanningAlertUpdateOptions) GetResolution() string {
 	if s == nil || s.Resolution == nil {
@@ -21670,6 +23974,134 @@ func (s *SecretScanningAlertUpdateOptions) GetResolution() string {
 	return *s.Resolution
 }
 
+// GetPatternConfigVersion returns the PatternConfigVersion field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternConfigs) GetPatternConfigVersion() string {
+	if s == nil || s.PatternConfigVersion == nil {
+		return ""
+	}
+	return *s.PatternConfigVersion
+}
+
+// GetPatternConfigVersion returns the PatternConfigVersion field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternConfigsUpdate) GetPatternConfigVersion() string {
+	if s == nil || s.PatternConfigVersion == nil {
+		return ""
+	}
+	return *s.PatternConfigVersion
+}
+
+// GetPatternConfigVersion returns the PatternConfigVersion field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternConfigsUpdateOptions) GetPatternConfigVersion() string {
+	if s == nil || s.PatternConfigVersion == nil {
+		return ""
+	}
+	return *s.PatternConfigVersion
+}
+
+// GetAlertTotal returns the AlertTotal field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetAlertTotal() int {
+	if s == nil || s.AlertTotal == nil {
+		return 0
+	}
+	return *s.AlertTotal
+}
+
+// GetAlertTotalPercentage returns the AlertTotalPercentage field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetAlertTotalPercentage() int {
+	if s == nil || s.AlertTotalPercentage == nil {
+		return 0
+	}
+	return *s.AlertTotalPercentage
+}
+
+// GetBypassRate returns the BypassRate field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetBypassRate() int {
+	if s == nil || s.BypassRate == nil {
+		return 0
+	}
+	return *s.BypassRate
+}
+
+// GetCustomPatternVersion returns the CustomPatternVersion field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetCustomPatternVersion() string {
+	if s == nil || s.CustomPatternVersion == nil {
+		return ""
+	}
+	return *s.CustomPatternVersion
+}
+
+// GetDefaultSetting returns the DefaultSetting field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetDefaultSetting() string {
+	if s == nil || s.DefaultSetting == nil {
+		return ""
+	}
+	return *s.DefaultSetting
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
+	}
+	return *s.DisplayName
+}
+
+// GetEnterpriseSetting returns the EnterpriseSetting field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetEnterpriseSetting() string {
+	if s == nil || s.EnterpriseSetting == nil {
+		return ""
+	}
+	return *s.EnterpriseSetting
+}
+
+// GetFalsePositiveRate returns the FalsePositiveRate field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetFalsePositiveRate() int {
+	if s == nil || s.FalsePositiveRate == nil {
+		return 0
+	}
+	return *s.FalsePositiveRate
+}
+
+// GetFalsePositives returns the FalsePositives field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetFalsePositives() int {
+	if s == nil || s.FalsePositives == nil {
+		return 0
+	}
+	return *s.FalsePositives
+}
+
+// GetSetting returns the Setting field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetSetting() string {
+	if s == nil || s.Setting == nil {
+		return ""
+	}
+	return *s.Setting
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetSlug() string {
+	if s == nil || s.Slug == nil {
+		return ""
+	}
+	return *s.Slug
+}
+
+// GetTokenType returns the TokenType field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternOverride) GetTokenType() string {
+	if s == nil || s.TokenType == nil {
+		return ""
+	}
+	return *s.TokenType
+}
+
+// GetCustomPatternVersion returns the CustomPatternVersion field if it's non-nil, zero value otherwise.
+func (s *SecretScanningPatternSetting) GetCustomPatternVersion() string {
+	if s == nil || s.CustomPatternVersion == nil {
+		return ""
+	}
+	return *s.CustomPatternVersion
+}
+
 // GetStatus returns the Status field if it's non-nil, zero value otherwise.
 func (s *SecretScanningPushProtection) GetStatus() string {
 	if s == nil || s.Status == nil {
@@ -21726,6 +24158,14 @@ func (s *SecurityAdvisory) GetCVSS() *AdvisoryCVSS {
 	return s.CVSS
 }
 
+// GetCVSSSeverities returns the CVSSSeverities field.
+func (s *SecurityAdvisory) GetCVSSSeverities() *AdvisoryCVSSSeverities {
+	if s == nil {
+		return nil
+	}
+	return s.CVSSSeverities
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (s *SecurityAdvisory) GetDescription() string {
 	if s == nil || s.Description == nil {
@@ -22059,7 +24499,31 @@ func (s *SignatureVerification) GetVerified() bool {
 	if s == nil || s.Verified == nil {
 		return false
 	}
-	return *s.Verified
+	return *s.Verified
+}
+
+// GetVerifiedAt returns the VerifiedAt field if it's non-nil, zero value otherwise.
+func (s *SignatureVerification) GetVerifiedAt() Timestamp {
+	if s == nil || s.VerifiedAt == nil {
+		return Timestamp{}
+	}
+	return *s.VerifiedAt
+}
+
+// GetProvider returns the Provider field if it's non-nil, zero value otherwise.
+func (s *SocialAccount) GetProvider() string {
+	if s == nil || s.Provider == nil {
+		return ""
+	}
+	return *s.Provider
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SocialAccount) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
+	}
+	return *s.URL
 }
 
 // GetActor returns the Actor field.
@@ -22382,6 +24846,110 @@ func (s *StatusEvent) GetUpdatedAt() Timestamp {
 	return *s.UpdatedAt
 }
 
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (s *StringChange) GetFrom() string {
+	if s == nil || s.From == nil {
+		return ""
+	}
+	return *s.From
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (s *StringChange) GetTo() string {
+	if s == nil || s.To == nil {
+		return ""
+	}
+	return *s.To
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SubIssuesEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
+	}
+	return *s.Action
+}
+
+// GetInstallation returns the Installation field.
+func (s *SubIssuesEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
+	}
+	return s.Installation
+}
+
+// GetOrg returns the Org field.
+func (s *SubIssuesEvent) GetOrg() *Organization {
+	if s == nil {
+		return nil
+	}
+	return s.Org
+}
+
+// GetParentIssue returns the ParentIssue field.
+func (s *SubIssuesEvent) GetParentIssue() *Issue {
+	if s == nil {
+		return nil
+	}
+	return s.ParentIssue
+}
+
+// GetParentIssueID returns the ParentIssueID field if it's non-nil, zero value otherwise.
+func (s *SubIssuesEvent) GetParentIssueID() int64 {
+	if s == nil || s.ParentIssueID == nil {
+		return 0
+	}
+	return *s.ParentIssueID
+}
+
+// GetParentIssueRepo returns the ParentIssueRepo field.
+func (s *SubIssuesEvent) GetParentIssueRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.ParentIssueRepo
+}
+
+// GetRepo returns the Repo field.
+func (s *SubIssuesEvent) GetRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repo
+}
+
+// GetSender returns the Sender field.
+func (s *SubIssuesEvent) GetSender() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
+// GetSubIssue returns the SubIssue field.
+func (s *SubIssuesEvent) GetSubIssue() *Issue {
+	if s == nil {
+		return nil
+	}
+	return s.SubIssue
+}
+
+// GetSubIssueID returns the SubIssueID field if it's non-nil, zero value otherwise.
+func (s *SubIssuesEvent) GetSubIssueID() int64 {
+	if s == nil || s.SubIssueID == nil {
+		return 0
+	}
+	return *s.SubIssueID
+}
+
+// GetSubIssueRepo returns the SubIssueRepo field.
+func (s *SubIssuesEvent) GetSubIssueRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.SubIssueRepo
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (s *Subscription) GetCreatedAt() Timestamp {
 	if s == nil || s.CreatedAt == nil {
@@ -23830,6 +26398,46 @@ func (u *UpdateEnterpriseRunnerGroupRequest) GetVisibility() string {
 	return *u.Visibility
 }
 
+// GetEnableStaticIP returns the EnableStaticIP field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetEnableStaticIP() bool {
+	if u == nil || u.EnableStaticIP == nil {
+		return false
+	}
+	return *u.EnableStaticIP
+}
+
+// GetImageVersion returns the ImageVersion field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetImageVersion() string {
+	if u == nil || u.ImageVersion == nil {
+		return ""
+	}
+	return *u.ImageVersion
+}
+
+// GetMaximumRunners returns the MaximumRunners field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetMaximumRunners() int64 {
+	if u == nil || u.MaximumRunners == nil {
+		return 0
+	}
+	return *u.MaximumRunners
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetRunnerGroupID() int64 {
+	if u == nil || u.RunnerGroupID == nil {
+		return 0
+	}
+	return *u.RunnerGroupID
+}
+
 // GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
 func (u *UpdateRunnerGroupRequest) GetAllowsPublicRepositories() bool {
 	if u == nil || u.AllowsPublicRepositories == nil {
@@ -23862,6 +26470,126 @@ func (u *UpdateRunnerGroupRequest) GetVisibility() string {
 	return *u.Visibility
 }
 
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetDate() string {
+	if u == nil || u.Date == nil {
+		return ""
+	}
+	return *u.Date
+}
+
+// GetDiscountAmount returns the DiscountAmount field.
+func (u *UsageItem) GetDiscountAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.DiscountAmount
+}
+
+// GetGrossAmount returns the GrossAmount field.
+func (u *UsageItem) GetGrossAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.GrossAmount
+}
+
+// GetNetAmount returns the NetAmount field.
+func (u *UsageItem) GetNetAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.NetAmount
+}
+
+// GetOrganizationName returns the OrganizationName field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetOrganizationName() string {
+	if u == nil || u.OrganizationName == nil {
+		return ""
+	}
+	return *u.OrganizationName
+}
+
+// GetPricePerUnit returns the PricePerUnit field.
+func (u *UsageItem) GetPricePerUnit() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.PricePerUnit
+}
+
+// GetProduct returns the Product field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetProduct() string {
+	if u == nil || u.Product == nil {
+		return ""
+	}
+	return *u.Product
+}
+
+// GetQuantity returns the Quantity field.
+func (u *UsageItem) GetQuantity() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.Quantity
+}
+
+// GetRepositoryName returns the RepositoryName field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetRepositoryName() string {
+	if u == nil || u.RepositoryName == nil {
+		return ""
+	}
+	return *u.RepositoryName
+}
+
+// GetSKU returns the SKU field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetSKU() string {
+	if u == nil || u.SKU == nil {
+		return ""
+	}
+	return *u.SKU
+}
+
+// GetUnitType returns the UnitType field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetUnitType() string {
+	if u == nil || u.UnitType == nil {
+		return ""
+	}
+	return *u.UnitType
+}
+
+// GetDay returns the Day field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetDay() int {
+	if u == nil || u.Day == nil {
+		return 0
+	}
+	return *u.Day
+}
+
+// GetHour returns the Hour field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetHour() int {
+	if u == nil || u.Hour == nil {
+		return 0
+	}
+	return *u.Hour
+}
+
+// GetMonth returns the Month field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetMonth() int {
+	if u == nil || u.Month == nil {
+		return 0
+	}
+	return *u.Month
+}
+
+// GetYear returns the Year field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetYear() int {
+	if u == nil || u.Year == nil {
+		return 0
+	}
+	return *u.Year
+}
+
 // GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
 func (u *User) GetAvatarURL() string {
 	if u == nil || u.AvatarURL == nil {
@@ -24518,6 +27246,102 @@ func (u *UserLDAPMapping) GetURL() string {
 	return *u.URL
 }
 
+// GetEnterpriseServerUser returns the EnterpriseServerUser field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetEnterpriseServerUser() bool {
+	if u == nil || u.EnterpriseServerUser == nil {
+		return false
+	}
+	return *u.EnterpriseServerUser
+}
+
+// GetGitHubComLogin returns the GitHubComLogin field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetGitHubComLogin() string {
+	if u == nil || u.GitHubComLogin == nil {
+		return ""
+	}
+	return *u.GitHubComLogin
+}
+
+// GetGitHubComName returns the GitHubComName field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetGitHubComName() string {
+	if u == nil || u.GitHubComName == nil {
+		return ""
+	}
+	return *u.GitHubComName
+}
+
+// GetGitHubComProfile returns the GitHubComProfile field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetGitHubComProfile() string {
+	if u == nil || u.GitHubComProfile == nil {
+		return ""
+	}
+	return *u.GitHubComProfile
+}
+
+// GetGitHubComSAMLNameID returns the GitHubComSAMLNameID field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetGitHubComSAMLNameID() string {
+	if u == nil || u.GitHubComSAMLNameID == nil {
+		return ""
+	}
+	return *u.GitHubComSAMLNameID
+}
+
+// GetGitHubComTwoFactorAuth returns the GitHubComTwoFactorAuth field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetGitHubComTwoFactorAuth() bool {
+	if u == nil || u.GitHubComTwoFactorAuth == nil {
+		return false
+	}
+	return *u.GitHubComTwoFactorAuth
+}
+
+// GetGitHubComUser returns the GitHubComUser field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetGitHubComUser() bool {
+	if u == nil || u.GitHubComUser == nil {
+		return false
+	}
+	return *u.GitHubComUser
+}
+
+// GetLicenseType returns the LicenseType field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetLicenseType() string {
+	if u == nil || u.LicenseType == nil {
+		return ""
+	}
+	return *u.LicenseType
+}
+
+// GetTotalUserAccounts returns the TotalUserAccounts field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetTotalUserAccounts() int {
+	if u == nil || u.TotalUserAccounts == nil {
+		return 0
+	}
+	return *u.TotalUserAccounts
+}
+
+// GetVisualStudioLicenseStatus returns the VisualStudioLicenseStatus field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetVisualStudioLicenseStatus() string {
+	if u == nil || u.VisualStudioLicenseStatus == nil {
+		return ""
+	}
+	return *u.VisualStudioLicenseStatus
+}
+
+// GetVisualStudioSubscriptionEmail returns the VisualStudioSubscriptionEmail field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetVisualStudioSubscriptionEmail() string {
+	if u == nil || u.VisualStudioSubscriptionEmail == nil {
+		return ""
+	}
+	return *u.VisualStudioSubscriptionEmail
+}
+
+// GetVisualStudioSubscriptionUser returns the VisualStudioSubscriptionUser field if it's non-nil, zero value otherwise.
+func (u *UserLicense) GetVisualStudioSubscriptionUser() bool {
+	if u == nil || u.VisualStudioSubscriptionUser == nil {
+		return false
+	}
+	return *u.VisualStudioSubscriptionUser
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (u *UserMigration) GetCreatedAt() string {
 	if u == nil || u.CreatedAt == nil {
@@ -24534,6 +27358,30 @@ func (u *UserMigration) GetExcludeAttachments() bool {
 	return *u.ExcludeAttachments
 }
 
+// GetExcludeGitData returns the ExcludeGitData field if it's non-nil, zero value otherwise.
+func (u *UserMigration) GetExcludeGitData() bool {
+	if u == nil || u.ExcludeGitData == nil {
+		return false
+	}
+	return *u.ExcludeGitData
+}
+
+// GetExcludeOwnerProjects returns the ExcludeOwnerProjects field if it's non-nil, zero value otherwise.
+func (u *UserMigration) GetExcludeOwnerProjects() bool {
+	if u == nil || u.ExcludeOwnerProjects == nil {
+		return false
+	}
+	return *u.ExcludeOwnerProjects
+}
+
+// GetExcludeReleases returns the ExcludeReleases field if it's non-nil, zero value otherwise.
+func (u *UserMigration) GetExcludeReleases() bool {
+	if u == nil || u.ExcludeReleases == nil {
+		return false
+	}
+	return *u.ExcludeReleases
+}
+
 // GetGUID returns the GUID field if it's non-nil, zero value otherwise.
 func (u *UserMigration) GetGUID() string {
 	if u == nil || u.GUID == nil {
@@ -24558,6 +27406,14 @@ func (u *UserMigration) GetLockRepositories() bool {
 	return *u.LockRepositories
 }
 
+// GetOrgMetadataOnly returns the OrgMetadataOnly field if it's non-nil, zero value otherwise.
+func (u *UserMigration) GetOrgMetadataOnly() bool {
+	if u == nil || u.OrgMetadataOnly == nil {
+		return false
+	}
+	return *u.OrgMetadataOnly
+}
+
 // GetState returns the State field if it's non-nil, zero value otherwise.
 func (u *UserMigration) GetState() string {
 	if u == nil || u.State == nil {
@@ -24686,6 +27542,30 @@ func (w *WatchEvent) GetSender() *User {
 	return w.Sender
 }
 
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (w *WeeklyCodeFrequency) GetAdditions() int {
+	if w == nil || w.Additions == nil {
+		return 0
+	}
+	return *w.Additions
+}
+
+// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
+func (w *WeeklyCodeFrequency) GetDeletions() int {
+	if w == nil || w.Deletions == nil {
+		return 0
+	}
+	return *w.Deletions
+}
+
+// GetWeek returns the Week field if it's non-nil, zero value otherwise.
+func (w *WeeklyCodeFrequency) GetWeek() Timestamp {
+	if w == nil || w.Week == nil {
+		return Timestamp{}
+	}
+	return *w.Week
+}
+
 // GetTotal returns the Total field if it's non-nil, zero value otherwise.
 func (w *WeeklyCommitActivity) GetTotal() int {
 	if w == nil || w.Total == nil {
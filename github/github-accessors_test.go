@@ -292,6 +292,16 @@ func TestActionsVariable_GetVisibility(tt *testing.T) {
 	a.GetVisibility()
 }
 
+func TestActivityListStarredOptions_GetWithTimestamps(tt *testing.T) {
+	var zeroValue bool
+	a := &ActivityListStarredOptions{WithTimestamps: &zeroValue}
+	a.GetWithTimestamps()
+	a = &ActivityListStarredOptions{}
+	a.GetWithTimestamps()
+	a = nil
+	a.GetWithTimestamps()
+}
+
 func TestActorLocation_GetCountryCode(tt *testing.T) {
 	var zeroValue string
 	a := &ActorLocation{CountryCode: &zeroValue}
@@ -439,6 +449,20 @@ func TestAdvisoryCVSS_GetVectorString(tt *testing.T) {
 	a.GetVectorString()
 }
 
+func TestAdvisoryCVSSSeverities_GetCVSSV3(tt *testing.T) {
+	a := &AdvisoryCVSSSeverities{}
+	a.GetCVSSV3()
+	a = nil
+	a.GetCVSSV3()
+}
+
+func TestAdvisoryCVSSSeverities_GetCVSSV4(tt *testing.T) {
+	a := &AdvisoryCVSSSeverities{}
+	a.GetCVSSV4()
+	a = nil
+	a.GetCVSSV4()
+}
+
 func TestAdvisoryCWEs_GetCWEID(tt *testing.T) {
 	var zeroValue string
 	a := &AdvisoryCWEs{CWEID: &zeroValue}
@@ -765,6 +789,36 @@ func TestAnalysesListOptions_GetSarifID(tt *testing.T) {
 	a.GetSarifID()
 }
 
+func TestAnnouncement_GetAnnouncement(tt *testing.T) {
+	var zeroValue string
+	a := &Announcement{Announcement: &zeroValue}
+	a.GetAnnouncement()
+	a = &Announcement{}
+	a.GetAnnouncement()
+	a = nil
+	a.GetAnnouncement()
+}
+
+func TestAnnouncement_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &Announcement{ExpiresAt: &zeroValue}
+	a.GetExpiresAt()
+	a = &Announcement{}
+	a.GetExpiresAt()
+	a = nil
+	a.GetExpiresAt()
+}
+
+func TestAnnouncement_GetUserDismissible(tt *testing.T) {
+	var zeroValue bool
+	a := &Announcement{UserDismissible: &zeroValue}
+	a.GetUserDismissible()
+	a = &Announcement{}
+	a.GetUserDismissible()
+	a = nil
+	a.GetUserDismissible()
+}
+
 func TestAPIMeta_GetSSHKeyFingerprints(tt *testing.T) {
 	zeroValue := map[string]string{}
 	a := &APIMeta{SSHKeyFingerprints: zeroValue}
@@ -2247,6 +2301,244 @@ func TestBypassActor_GetBypassMode(tt *testing.T) {
 	b.GetBypassMode()
 }
 
+func TestBypassRequest_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	b := &BypassRequest{CreatedAt: &zeroValue}
+	b.GetCreatedAt()
+	b = &BypassRequest{}
+	b.GetCreatedAt()
+	b = nil
+	b.GetCreatedAt()
+}
+
+func TestBypassRequest_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	b := &BypassRequest{ExpiresAt: &zeroValue}
+	b.GetExpiresAt()
+	b = &BypassRequest{}
+	b.GetExpiresAt()
+	b = nil
+	b.GetExpiresAt()
+}
+
+func TestBypassRequest_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequest{HTMLURL: &zeroValue}
+	b.GetHTMLURL()
+	b = &BypassRequest{}
+	b.GetHTMLURL()
+	b = nil
+	b.GetHTMLURL()
+}
+
+func TestBypassRequest_GetID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassRequest{ID: &zeroValue}
+	b.GetID()
+	b = &BypassRequest{}
+	b.GetID()
+	b = nil
+	b.GetID()
+}
+
+func TestBypassRequest_GetNumber(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassRequest{Number: &zeroValue}
+	b.GetNumber()
+	b = &BypassRequest{}
+	b.GetNumber()
+	b = nil
+	b.GetNumber()
+}
+
+func TestBypassRequest_GetOrganization(tt *testing.T) {
+	b := &BypassRequest{}
+	b.GetOrganization()
+	b = nil
+	b.GetOrganization()
+}
+
+func TestBypassRequest_GetRepository(tt *testing.T) {
+	b := &BypassRequest{}
+	b.GetRepository()
+	b = nil
+	b.GetRepository()
+}
+
+func TestBypassRequest_GetRequester(tt *testing.T) {
+	b := &BypassRequest{}
+	b.GetRequester()
+	b = nil
+	b.GetRequester()
+}
+
+func TestBypassRequest_GetRequesterComment(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequest{RequesterComment: &zeroValue}
+	b.GetRequesterComment()
+	b = &BypassRequest{}
+	b.GetRequesterComment()
+	b = nil
+	b.GetRequesterComment()
+}
+
+func TestBypassRequest_GetRequestType(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequest{RequestType: &zeroValue}
+	b.GetRequestType()
+	b = &BypassRequest{}
+	b.GetRequestType()
+	b = nil
+	b.GetRequestType()
+}
+
+func TestBypassRequest_GetResourceIdentifier(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequest{ResourceIdentifier: &zeroValue}
+	b.GetResourceIdentifier()
+	b = &BypassRequest{}
+	b.GetResourceIdentifier()
+	b = nil
+	b.GetResourceIdentifier()
+}
+
+func TestBypassRequest_GetStatus(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequest{Status: &zeroValue}
+	b.GetStatus()
+	b = &BypassRequest{}
+	b.GetStatus()
+	b = nil
+	b.GetStatus()
+}
+
+func TestBypassRequest_GetURL(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequest{URL: &zeroValue}
+	b.GetURL()
+	b = &BypassRequest{}
+	b.GetURL()
+	b = nil
+	b.GetURL()
+}
+
+func TestBypassRequestActor_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassRequestActor{ActorID: &zeroValue}
+	b.GetActorID()
+	b = &BypassRequestActor{}
+	b.GetActorID()
+	b = nil
+	b.GetActorID()
+}
+
+func TestBypassRequestActor_GetActorName(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestActor{ActorName: &zeroValue}
+	b.GetActorName()
+	b = &BypassRequestActor{}
+	b.GetActorName()
+	b = nil
+	b.GetActorName()
+}
+
+func TestBypassRequestData_GetBypassReason(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestData{BypassReason: &zeroValue}
+	b.GetBypassReason()
+	b = &BypassRequestData{}
+	b.GetBypassReason()
+	b = nil
+	b.GetBypassReason()
+}
+
+func TestBypassRequestData_GetRulesetID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassRequestData{RulesetID: &zeroValue}
+	b.GetRulesetID()
+	b = &BypassRequestData{}
+	b.GetRulesetID()
+	b = nil
+	b.GetRulesetID()
+}
+
+func TestBypassRequestData_GetRulesetName(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestData{RulesetName: &zeroValue}
+	b.GetRulesetName()
+	b = &BypassRequestData{}
+	b.GetRulesetName()
+	b = nil
+	b.GetRulesetName()
+}
+
+func TestBypassRequestData_GetRuleType(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestData{RuleType: &zeroValue}
+	b.GetRuleType()
+	b = &BypassRequestData{}
+	b.GetRuleType()
+	b = nil
+	b.GetRuleType()
+}
+
+func TestBypassRequestData_GetSecretType(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestData{SecretType: &zeroValue}
+	b.GetSecretType()
+	b = &BypassRequestData{}
+	b.GetSecretType()
+	b = nil
+	b.GetSecretType()
+}
+
+func TestBypassRequestData_GetTotalViolations(tt *testing.T) {
+	var zeroValue int
+	b := &BypassRequestData{TotalViolations: &zeroValue}
+	b.GetTotalViolations()
+	b = &BypassRequestData{}
+	b.GetTotalViolations()
+	b = nil
+	b.GetTotalViolations()
+}
+
+func TestBypassRequestReview_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	b := &BypassRequestReview{CreatedAt: &zeroValue}
+	b.GetCreatedAt()
+	b = &BypassRequestReview{}
+	b.GetCreatedAt()
+	b = nil
+	b.GetCreatedAt()
+}
+
+func TestBypassRequestReview_GetID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassRequestReview{ID: &zeroValue}
+	b.GetID()
+	b = &BypassRequestReview{}
+	b.GetID()
+	b = nil
+	b.GetID()
+}
+
+func TestBypassRequestReview_GetReviewer(tt *testing.T) {
+	b := &BypassRequestReview{}
+	b.GetReviewer()
+	b = nil
+	b.GetReviewer()
+}
+
+func TestBypassRequestReview_GetStatus(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestReview{Status: &zeroValue}
+	b.GetStatus()
+	b = &BypassRequestReview{}
+	b.GetStatus()
+	b = nil
+	b.GetStatus()
+}
+
 func TestCheckRun_GetApp(tt *testing.T) {
 	c := &CheckRun{}
 	c.GetApp()
@@ -2398,6 +2690,16 @@ func TestCheckRunAnnotation_GetAnnotationLevel(tt *testing.T) {
 	c.GetAnnotationLevel()
 }
 
+func TestCheckRunAnnotation_GetBlobHRef(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRunAnnotation{BlobHRef: &zeroValue}
+	c.GetBlobHRef()
+	c = &CheckRunAnnotation{}
+	c.GetBlobHRef()
+	c = nil
+	c.GetBlobHRef()
+}
+
 func TestCheckRunAnnotation_GetEndColumn(tt *testing.T) {
 	var zeroValue int
 	c := &CheckRunAnnotation{EndColumn: &zeroValue}
@@ -2937,6 +3239,26 @@ func TestCodeQLDatabase_GetURL(tt *testing.T) {
 	c.GetURL()
 }
 
+func TestCodeResult_GetFileSize(tt *testing.T) {
+	var zeroValue int
+	c := &CodeResult{FileSize: &zeroValue}
+	c.GetFileSize()
+	c = &CodeResult{}
+	c.GetFileSize()
+	c = nil
+	c.GetFileSize()
+}
+
+func TestCodeResult_GetGitURL(tt *testing.T) {
+	var zeroValue string
+	c := &CodeResult{GitURL: &zeroValue}
+	c.GetGitURL()
+	c = &CodeResult{}
+	c.GetGitURL()
+	c = nil
+	c.GetGitURL()
+}
+
 func TestCodeResult_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
 	c := &CodeResult{HTMLURL: &zeroValue}
@@ -2947,6 +3269,26 @@ func TestCodeResult_GetHTMLURL(tt *testing.T) {
 	c.GetHTMLURL()
 }
 
+func TestCodeResult_GetLanguage(tt *testing.T) {
+	var zeroValue string
+	c := &CodeResult{Language: &zeroValue}
+	c.GetLanguage()
+	c = &CodeResult{}
+	c.GetLanguage()
+	c = nil
+	c.GetLanguage()
+}
+
+func TestCodeResult_GetLastModifiedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CodeResult{LastModifiedAt: &zeroValue}
+	c.GetLastModifiedAt()
+	c = &CodeResult{}
+	c.GetLastModifiedAt()
+	c = nil
+	c.GetLastModifiedAt()
+}
+
 func TestCodeResult_GetName(tt *testing.T) {
 	var zeroValue string
 	c := &CodeResult{Name: &zeroValue}
@@ -2974,6 +3316,13 @@ func TestCodeResult_GetRepository(tt *testing.T) {
 	c.GetRepository()
 }
 
+func TestCodeResult_GetScore(tt *testing.T) {
+	c := &CodeResult{}
+	c.GetScore()
+	c = nil
+	c.GetScore()
+}
+
 func TestCodeResult_GetSHA(tt *testing.T) {
 	var zeroValue string
 	c := &CodeResult{SHA: &zeroValue}
@@ -2984,6 +3333,16 @@ func TestCodeResult_GetSHA(tt *testing.T) {
 	c.GetSHA()
 }
 
+func TestCodeResult_GetURL(tt *testing.T) {
+	var zeroValue string
+	c := &CodeResult{URL: &zeroValue}
+	c.GetURL()
+	c = &CodeResult{}
+	c.GetURL()
+	c = nil
+	c.GetURL()
+}
+
 func TestCodeScanningAlertEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	c := &CodeScanningAlertEvent{Action: &zeroValue}
@@ -4421,6 +4780,16 @@ func TestCommunityHealthMetrics_GetUpdatedAt(tt *testing.T) {
 	c.GetUpdatedAt()
 }
 
+func TestConnStats_GetPerHost(tt *testing.T) {
+	zeroValue := map[string]HostConnStats{}
+	c := &ConnStats{PerHost: zeroValue}
+	c.GetPerHost()
+	c = &ConnStats{}
+	c.GetPerHost()
+	c = nil
+	c.GetPerHost()
+}
+
 func TestContentReference_GetID(tt *testing.T) {
 	var zeroValue int64
 	c := &ContentReference{ID: &zeroValue}
@@ -5075,6 +5444,63 @@ func TestCreateEvent_GetSender(tt *testing.T) {
 	c.GetSender()
 }
 
+func TestCreateHostedRunnerRequest_GetEnableStaticIP(tt *testing.T) {
+	var zeroValue bool
+	c := &CreateHostedRunnerRequest{EnableStaticIP: &zeroValue}
+	c.GetEnableStaticIP()
+	c = &CreateHostedRunnerRequest{}
+	c.GetEnableStaticIP()
+	c = nil
+	c.GetEnableStaticIP()
+}
+
+func TestCreateHostedRunnerRequest_GetImage(tt *testing.T) {
+	c := &CreateHostedRunnerRequest{}
+	c.GetImage()
+	c = nil
+	c.GetImage()
+}
+
+func TestCreateHostedRunnerRequest_GetMaximumRunners(tt *testing.T) {
+	var zeroValue int64
+	c := &CreateHostedRunnerRequest{MaximumRunners: &zeroValue}
+	c.GetMaximumRunners()
+	c = &CreateHostedRunnerRequest{}
+	c.GetMaximumRunners()
+	c = nil
+	c.GetMaximumRunners()
+}
+
+func TestCreateHostedRunnerRequest_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CreateHostedRunnerRequest{Name: &zeroValue}
+	c.GetName()
+	c = &CreateHostedRunnerRequest{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestCreateHostedRunnerRequest_GetRunnerGroupID(tt *testing.T) {
+	var zeroValue int64
+	c := &CreateHostedRunnerRequest{RunnerGroupID: &zeroValue}
+	c.GetRunnerGroupID()
+	c = &CreateHostedRunnerRequest{}
+	c.GetRunnerGroupID()
+	c = nil
+	c.GetRunnerGroupID()
+}
+
+func TestCreateHostedRunnerRequest_GetSize(tt *testing.T) {
+	var zeroValue string
+	c := &CreateHostedRunnerRequest{Size: &zeroValue}
+	c.GetSize()
+	c = &CreateHostedRunnerRequest{}
+	c.GetSize()
+	c = nil
+	c.GetSize()
+}
+
 func TestCreateOrgInvitationOptions_GetEmail(tt *testing.T) {
 	var zeroValue string
 	c := &CreateOrgInvitationOptions{Email: &zeroValue}
@@ -5135,6 +5561,56 @@ func TestCreateOrUpdateCustomRoleOptions_GetName(tt *testing.T) {
 	c.GetName()
 }
 
+func TestCreatePagesDeploymentRequest_GetArtifactID(tt *testing.T) {
+	var zeroValue int64
+	c := &CreatePagesDeploymentRequest{ArtifactID: &zeroValue}
+	c.GetArtifactID()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetArtifactID()
+	c = nil
+	c.GetArtifactID()
+}
+
+func TestCreatePagesDeploymentRequest_GetArtifactURL(tt *testing.T) {
+	var zeroValue string
+	c := &CreatePagesDeploymentRequest{ArtifactURL: &zeroValue}
+	c.GetArtifactURL()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetArtifactURL()
+	c = nil
+	c.GetArtifactURL()
+}
+
+func TestCreatePagesDeploymentRequest_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	c := &CreatePagesDeploymentRequest{Environment: &zeroValue}
+	c.GetEnvironment()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetEnvironment()
+	c = nil
+	c.GetEnvironment()
+}
+
+func TestCreatePagesDeploymentRequest_GetOIDCToken(tt *testing.T) {
+	var zeroValue string
+	c := &CreatePagesDeploymentRequest{OIDCToken: &zeroValue}
+	c.GetOIDCToken()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetOIDCToken()
+	c = nil
+	c.GetOIDCToken()
+}
+
+func TestCreatePagesDeploymentRequest_GetPagesBuildVersion(tt *testing.T) {
+	var zeroValue string
+	c := &CreatePagesDeploymentRequest{PagesBuildVersion: &zeroValue}
+	c.GetPagesBuildVersion()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetPagesBuildVersion()
+	c = nil
+	c.GetPagesBuildVersion()
+}
+
 func TestCreateProtectedChanges_GetFrom(tt *testing.T) {
 	var zeroValue bool
 	c := &CreateProtectedChanges{From: &zeroValue}
@@ -5553,34 +6029,124 @@ func TestCustomProperty_GetRequired(tt *testing.T) {
 	c.GetRequired()
 }
 
-func TestCustomPropertyValue_GetValue(tt *testing.T) {
+func TestCustomPropertyEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	c := &CustomPropertyValue{Value: &zeroValue}
-	c.GetValue()
-	c = &CustomPropertyValue{}
-	c.GetValue()
+	c := &CustomPropertyEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CustomPropertyEvent{}
+	c.GetAction()
 	c = nil
-	c.GetValue()
+	c.GetAction()
 }
 
-func TestCustomRepoRoles_GetBaseRole(tt *testing.T) {
-	var zeroValue string
-	c := &CustomRepoRoles{BaseRole: &zeroValue}
-	c.GetBaseRole()
-	c = &CustomRepoRoles{}
-	c.GetBaseRole()
+func TestCustomPropertyEvent_GetDefinition(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetDefinition()
 	c = nil
-	c.GetBaseRole()
+	c.GetDefinition()
 }
 
-func TestCustomRepoRoles_GetDescription(tt *testing.T) {
-	var zeroValue string
-	c := &CustomRepoRoles{Description: &zeroValue}
-	c.GetDescription()
-	c = &CustomRepoRoles{}
-	c.GetDescription()
+func TestCustomPropertyEvent_GetEnterprise(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetEnterprise()
 	c = nil
-	c.GetDescription()
+	c.GetEnterprise()
+}
+
+func TestCustomPropertyEvent_GetInstallation(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
+}
+
+func TestCustomPropertyEvent_GetOrg(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
+}
+
+func TestCustomPropertyEvent_GetSender(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
+}
+
+func TestCustomPropertyValue_GetValue(tt *testing.T) {
+	var zeroValue string
+	c := &CustomPropertyValue{Value: &zeroValue}
+	c.GetValue()
+	c = &CustomPropertyValue{}
+	c.GetValue()
+	c = nil
+	c.GetValue()
+}
+
+func TestCustomPropertyValuesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	c := &CustomPropertyValuesEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CustomPropertyValuesEvent{}
+	c.GetAction()
+	c = nil
+	c.GetAction()
+}
+
+func TestCustomPropertyValuesEvent_GetEnterprise(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetEnterprise()
+	c = nil
+	c.GetEnterprise()
+}
+
+func TestCustomPropertyValuesEvent_GetInstallation(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
+}
+
+func TestCustomPropertyValuesEvent_GetOrg(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
+}
+
+func TestCustomPropertyValuesEvent_GetRepo(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetRepo()
+	c = nil
+	c.GetRepo()
+}
+
+func TestCustomPropertyValuesEvent_GetSender(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
+}
+
+func TestCustomRepoRoles_GetBaseRole(tt *testing.T) {
+	var zeroValue string
+	c := &CustomRepoRoles{BaseRole: &zeroValue}
+	c.GetBaseRole()
+	c = &CustomRepoRoles{}
+	c.GetBaseRole()
+	c = nil
+	c.GetBaseRole()
+}
+
+func TestCustomRepoRoles_GetDescription(tt *testing.T) {
+	var zeroValue string
+	c := &CustomRepoRoles{Description: &zeroValue}
+	c.GetDescription()
+	c = &CustomRepoRoles{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
 }
 
 func TestCustomRepoRoles_GetID(tt *testing.T) {
@@ -6749,6 +7315,16 @@ func TestDeploymentRequest_GetTransientEnvironment(tt *testing.T) {
 	d.GetTransientEnvironment()
 }
 
+func TestDeploymentsListOptions_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentsListOptions{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentsListOptions{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
+}
+
 func TestDeploymentStatus_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	d := &DeploymentStatus{CreatedAt: &zeroValue}
@@ -7019,10 +7595,7 @@ func TestDiscussion_GetAnswerChosenAt(tt *testing.T) {
 }
 
 func TestDiscussion_GetAnswerChosenBy(tt *testing.T) {
-	var zeroValue string
-	d := &Discussion{AnswerChosenBy: &zeroValue}
-	d.GetAnswerChosenBy()
-	d = &Discussion{}
+	d := &Discussion{}
 	d.GetAnswerChosenBy()
 	d = nil
 	d.GetAnswerChosenBy()
@@ -7135,6 +7708,13 @@ func TestDiscussion_GetNumber(tt *testing.T) {
 	d.GetNumber()
 }
 
+func TestDiscussion_GetReactions(tt *testing.T) {
+	d := &Discussion{}
+	d.GetReactions()
+	d = nil
+	d.GetReactions()
+}
+
 func TestDiscussion_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
 	d := &Discussion{RepositoryURL: &zeroValue}
@@ -7155,6 +7735,26 @@ func TestDiscussion_GetState(tt *testing.T) {
 	d.GetState()
 }
 
+func TestDiscussion_GetStateReason(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{StateReason: &zeroValue}
+	d.GetStateReason()
+	d = &Discussion{}
+	d.GetStateReason()
+	d = nil
+	d.GetStateReason()
+}
+
+func TestDiscussion_GetTimelineURL(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{TimelineURL: &zeroValue}
+	d.GetTimelineURL()
+	d = &Discussion{}
+	d.GetTimelineURL()
+	d = nil
+	d.GetTimelineURL()
+}
+
 func TestDiscussion_GetTitle(tt *testing.T) {
 	var zeroValue string
 	d := &Discussion{Title: &zeroValue}
@@ -7416,6 +8016,13 @@ func TestDiscussionCommentEvent_GetAction(tt *testing.T) {
 	d.GetAction()
 }
 
+func TestDiscussionCommentEvent_GetChanges(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetChanges()
+	d = nil
+	d.GetChanges()
+}
+
 func TestDiscussionCommentEvent_GetComment(tt *testing.T) {
 	d := &DiscussionCommentEvent{}
 	d.GetComment()
@@ -7468,6 +8075,20 @@ func TestDiscussionEvent_GetAction(tt *testing.T) {
 	d.GetAction()
 }
 
+func TestDiscussionEvent_GetAnswer(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetAnswer()
+	d = nil
+	d.GetAnswer()
+}
+
+func TestDiscussionEvent_GetChanges(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetChanges()
+	d = nil
+	d.GetChanges()
+}
+
 func TestDiscussionEvent_GetDiscussion(tt *testing.T) {
 	d := &DiscussionEvent{}
 	d.GetDiscussion()
@@ -7482,6 +8103,13 @@ func TestDiscussionEvent_GetInstallation(tt *testing.T) {
 	d.GetInstallation()
 }
 
+func TestDiscussionEvent_GetOldAnswer(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetOldAnswer()
+	d = nil
+	d.GetOldAnswer()
+}
+
 func TestDiscussionEvent_GetOrg(tt *testing.T) {
 	d := &DiscussionEvent{}
 	d.GetOrg()
@@ -7583,16 +8211,6 @@ func TestDismissStaleReviewsOnPushChanges_GetFrom(tt *testing.T) {
 	d.GetFrom()
 }
 
-func TestDispatchRequestOptions_GetClientPayload(tt *testing.T) {
-	var zeroValue json.RawMessage
-	d := &DispatchRequestOptions{ClientPayload: &zeroValue}
-	d.GetClientPayload()
-	d = &DispatchRequestOptions{}
-	d.GetClientPayload()
-	d = nil
-	d.GetClientPayload()
-}
-
 func TestDraftReviewComment_GetBody(tt *testing.T) {
 	var zeroValue string
 	d := &DraftReviewComment{Body: &zeroValue}
@@ -7722,6 +8340,13 @@ func TestEditChange_GetRepo(tt *testing.T) {
 	e.GetRepo()
 }
 
+func TestEditChange_GetStateReason(tt *testing.T) {
+	e := &EditChange{}
+	e.GetStateReason()
+	e = nil
+	e.GetStateReason()
+}
+
 func TestEditChange_GetTitle(tt *testing.T) {
 	e := &EditChange{}
 	e.GetTitle()
@@ -7780,6 +8405,16 @@ func TestEditSHA_GetFrom(tt *testing.T) {
 	e.GetFrom()
 }
 
+func TestEditStateReason_GetFrom(tt *testing.T) {
+	var zeroValue string
+	e := &EditStateReason{From: &zeroValue}
+	e.GetFrom()
+	e = &EditStateReason{}
+	e.GetFrom()
+	e = nil
+	e.GetFrom()
+}
+
 func TestEditTitle_GetFrom(tt *testing.T) {
 	var zeroValue string
 	e := &EditTitle{From: &zeroValue}
@@ -8562,6 +9197,16 @@ func TestGenerateJITConfigRequest_GetWorkFolder(tt *testing.T) {
 	g.GetWorkFolder()
 }
 
+func TestGenerateNotesOptions_GetConfigurationFilePath(tt *testing.T) {
+	var zeroValue string
+	g := &GenerateNotesOptions{ConfigurationFilePath: &zeroValue}
+	g.GetConfigurationFilePath()
+	g = &GenerateNotesOptions{}
+	g.GetConfigurationFilePath()
+	g = nil
+	g.GetConfigurationFilePath()
+}
+
 func TestGenerateNotesOptions_GetPreviousTagName(tt *testing.T) {
 	var zeroValue string
 	g := &GenerateNotesOptions{PreviousTagName: &zeroValue}
@@ -8880,6 +9525,16 @@ func TestGistFile_GetSize(tt *testing.T) {
 	g.GetSize()
 }
 
+func TestGistFile_GetTruncated(tt *testing.T) {
+	var zeroValue bool
+	g := &GistFile{Truncated: &zeroValue}
+	g.GetTruncated()
+	g = &GistFile{}
+	g.GetTruncated()
+	g = nil
+	g.GetTruncated()
+}
+
 func TestGistFile_GetType(tt *testing.T) {
 	var zeroValue string
 	g := &GistFile{Type: &zeroValue}
@@ -9464,6 +10119,13 @@ func TestHook_GetID(tt *testing.T) {
 	h.GetID()
 }
 
+func TestHook_GetLastResponse(tt *testing.T) {
+	h := &Hook{}
+	h.GetLastResponse()
+	h = nil
+	h.GetLastResponse()
+}
+
 func TestHook_GetName(tt *testing.T) {
 	var zeroValue string
 	h := &Hook{Name: &zeroValue}
@@ -9685,6 +10347,36 @@ func TestHookDelivery_GetStatusCode(tt *testing.T) {
 	h.GetStatusCode()
 }
 
+func TestHookLastResponse_GetCode(tt *testing.T) {
+	var zeroValue int
+	h := &HookLastResponse{Code: &zeroValue}
+	h.GetCode()
+	h = &HookLastResponse{}
+	h.GetCode()
+	h = nil
+	h.GetCode()
+}
+
+func TestHookLastResponse_GetMessage(tt *testing.T) {
+	var zeroValue string
+	h := &HookLastResponse{Message: &zeroValue}
+	h.GetMessage()
+	h = &HookLastResponse{}
+	h.GetMessage()
+	h = nil
+	h.GetMessage()
+}
+
+func TestHookLastResponse_GetStatus(tt *testing.T) {
+	var zeroValue string
+	h := &HookLastResponse{Status: &zeroValue}
+	h.GetStatus()
+	h = &HookLastResponse{}
+	h.GetStatus()
+	h = nil
+	h.GetStatus()
+}
+
 func TestHookRequest_GetHeaders(tt *testing.T) {
 	zeroValue := map[string]string{}
 	h := &HookRequest{Headers: zeroValue}
@@ -9755,97 +10447,408 @@ func TestHookStats_GetTotalHooks(tt *testing.T) {
 	h.GetTotalHooks()
 }
 
-func TestIDPGroup_GetGroupDescription(tt *testing.T) {
-	var zeroValue string
-	i := &IDPGroup{GroupDescription: &zeroValue}
-	i.GetGroupDescription()
-	i = &IDPGroup{}
-	i.GetGroupDescription()
-	i = nil
-	i.GetGroupDescription()
+func TestHostedRunner_GetID(tt *testing.T) {
+	var zeroValue int64
+	h := &HostedRunner{ID: &zeroValue}
+	h.GetID()
+	h = &HostedRunner{}
+	h.GetID()
+	h = nil
+	h.GetID()
 }
 
-func TestIDPGroup_GetGroupID(tt *testing.T) {
-	var zeroValue string
-	i := &IDPGroup{GroupID: &zeroValue}
-	i.GetGroupID()
-	i = &IDPGroup{}
-	i.GetGroupID()
-	i = nil
-	i.GetGroupID()
+func TestHostedRunner_GetImageDetails(tt *testing.T) {
+	h := &HostedRunner{}
+	h.GetImageDetails()
+	h = nil
+	h.GetImageDetails()
 }
 
-func TestIDPGroup_GetGroupName(tt *testing.T) {
-	var zeroValue string
-	i := &IDPGroup{GroupName: &zeroValue}
-	i.GetGroupName()
-	i = &IDPGroup{}
-	i.GetGroupName()
-	i = nil
-	i.GetGroupName()
+func TestHostedRunner_GetLastActiveOn(tt *testing.T) {
+	var zeroValue Timestamp
+	h := &HostedRunner{LastActiveOn: &zeroValue}
+	h.GetLastActiveOn()
+	h = &HostedRunner{}
+	h.GetLastActiveOn()
+	h = nil
+	h.GetLastActiveOn()
 }
 
-func TestImport_GetAuthorsCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{AuthorsCount: &zeroValue}
-	i.GetAuthorsCount()
-	i = &Import{}
-	i.GetAuthorsCount()
-	i = nil
-	i.GetAuthorsCount()
+func TestHostedRunner_GetMachineSizeDetails(tt *testing.T) {
+	h := &HostedRunner{}
+	h.GetMachineSizeDetails()
+	h = nil
+	h.GetMachineSizeDetails()
 }
 
-func TestImport_GetAuthorsURL(tt *testing.T) {
-	var zeroValue string
-	i := &Import{AuthorsURL: &zeroValue}
-	i.GetAuthorsURL()
-	i = &Import{}
-	i.GetAuthorsURL()
-	i = nil
-	i.GetAuthorsURL()
+func TestHostedRunner_GetMaximumRunners(tt *testing.T) {
+	var zeroValue int64
+	h := &HostedRunner{MaximumRunners: &zeroValue}
+	h.GetMaximumRunners()
+	h = &HostedRunner{}
+	h.GetMaximumRunners()
+	h = nil
+	h.GetMaximumRunners()
 }
 
-func TestImport_GetCommitCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{CommitCount: &zeroValue}
-	i.GetCommitCount()
-	i = &Import{}
-	i.GetCommitCount()
-	i = nil
-	i.GetCommitCount()
+func TestHostedRunner_GetName(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunner{Name: &zeroValue}
+	h.GetName()
+	h = &HostedRunner{}
+	h.GetName()
+	h = nil
+	h.GetName()
 }
 
-func TestImport_GetFailedStep(tt *testing.T) {
+func TestHostedRunner_GetPlatform(tt *testing.T) {
 	var zeroValue string
-	i := &Import{FailedStep: &zeroValue}
-	i.GetFailedStep()
-	i = &Import{}
-	i.GetFailedStep()
-	i = nil
-	i.GetFailedStep()
+	h := &HostedRunner{Platform: &zeroValue}
+	h.GetPlatform()
+	h = &HostedRunner{}
+	h.GetPlatform()
+	h = nil
+	h.GetPlatform()
 }
 
-func TestImport_GetHasLargeFiles(tt *testing.T) {
+func TestHostedRunner_GetPublicIPEnabled(tt *testing.T) {
 	var zeroValue bool
-	i := &Import{HasLargeFiles: &zeroValue}
-	i.GetHasLargeFiles()
-	i = &Import{}
-	i.GetHasLargeFiles()
-	i = nil
-	i.GetHasLargeFiles()
+	h := &HostedRunner{PublicIPEnabled: &zeroValue}
+	h.GetPublicIPEnabled()
+	h = &HostedRunner{}
+	h.GetPublicIPEnabled()
+	h = nil
+	h.GetPublicIPEnabled()
 }
 
-func TestImport_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	i := &Import{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Import{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+func TestHostedRunner_GetRunnerGroupID(tt *testing.T) {
+	var zeroValue int64
+	h := &HostedRunner{RunnerGroupID: &zeroValue}
+	h.GetRunnerGroupID()
+	h = &HostedRunner{}
+	h.GetRunnerGroupID()
+	h = nil
+	h.GetRunnerGroupID()
 }
 
-func TestImport_GetHumanName(tt *testing.T) {
+func TestHostedRunner_GetStatus(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunner{Status: &zeroValue}
+	h.GetStatus()
+	h = &HostedRunner{}
+	h.GetStatus()
+	h = nil
+	h.GetStatus()
+}
+
+func TestHostedRunnerImage_GetID(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImage{ID: &zeroValue}
+	h.GetID()
+	h = &HostedRunnerImage{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHostedRunnerImage_GetSource(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImage{Source: &zeroValue}
+	h.GetSource()
+	h = &HostedRunnerImage{}
+	h.GetSource()
+	h = nil
+	h.GetSource()
+}
+
+func TestHostedRunnerImage_GetVersion(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImage{Version: &zeroValue}
+	h.GetVersion()
+	h = &HostedRunnerImage{}
+	h.GetVersion()
+	h = nil
+	h.GetVersion()
+}
+
+func TestHostedRunnerImageDetail_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImageDetail{DisplayName: &zeroValue}
+	h.GetDisplayName()
+	h = &HostedRunnerImageDetail{}
+	h.GetDisplayName()
+	h = nil
+	h.GetDisplayName()
+}
+
+func TestHostedRunnerImageDetail_GetID(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImageDetail{ID: &zeroValue}
+	h.GetID()
+	h = &HostedRunnerImageDetail{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHostedRunnerImageDetail_GetSizeGB(tt *testing.T) {
+	var zeroValue int64
+	h := &HostedRunnerImageDetail{SizeGB: &zeroValue}
+	h.GetSizeGB()
+	h = &HostedRunnerImageDetail{}
+	h.GetSizeGB()
+	h = nil
+	h.GetSizeGB()
+}
+
+func TestHostedRunnerImageDetail_GetSource(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImageDetail{Source: &zeroValue}
+	h.GetSource()
+	h = &HostedRunnerImageDetail{}
+	h.GetSource()
+	h = nil
+	h.GetSource()
+}
+
+func TestHostedRunnerImageSpec_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImageSpec{DisplayName: &zeroValue}
+	h.GetDisplayName()
+	h = &HostedRunnerImageSpec{}
+	h.GetDisplayName()
+	h = nil
+	h.GetDisplayName()
+}
+
+func TestHostedRunnerImageSpec_GetID(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImageSpec{ID: &zeroValue}
+	h.GetID()
+	h = &HostedRunnerImageSpec{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHostedRunnerImageSpec_GetPlatform(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImageSpec{Platform: &zeroValue}
+	h.GetPlatform()
+	h = &HostedRunnerImageSpec{}
+	h.GetPlatform()
+	h = nil
+	h.GetPlatform()
+}
+
+func TestHostedRunnerImageSpec_GetSizeGB(tt *testing.T) {
+	var zeroValue int64
+	h := &HostedRunnerImageSpec{SizeGB: &zeroValue}
+	h.GetSizeGB()
+	h = &HostedRunnerImageSpec{}
+	h.GetSizeGB()
+	h = nil
+	h.GetSizeGB()
+}
+
+func TestHostedRunnerImageSpec_GetSource(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerImageSpec{Source: &zeroValue}
+	h.GetSource()
+	h = &HostedRunnerImageSpec{}
+	h.GetSource()
+	h = nil
+	h.GetSource()
+}
+
+func TestHostedRunnerLimits_GetPublicIPs(tt *testing.T) {
+	h := &HostedRunnerLimits{}
+	h.GetPublicIPs()
+	h = nil
+	h.GetPublicIPs()
+}
+
+func TestHostedRunnerMachineSpec_GetCPUCores(tt *testing.T) {
+	var zeroValue int
+	h := &HostedRunnerMachineSpec{CPUCores: &zeroValue}
+	h.GetCPUCores()
+	h = &HostedRunnerMachineSpec{}
+	h.GetCPUCores()
+	h = nil
+	h.GetCPUCores()
+}
+
+func TestHostedRunnerMachineSpec_GetID(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerMachineSpec{ID: &zeroValue}
+	h.GetID()
+	h = &HostedRunnerMachineSpec{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHostedRunnerMachineSpec_GetMemoryGB(tt *testing.T) {
+	var zeroValue int
+	h := &HostedRunnerMachineSpec{MemoryGB: &zeroValue}
+	h.GetMemoryGB()
+	h = &HostedRunnerMachineSpec{}
+	h.GetMemoryGB()
+	h = nil
+	h.GetMemoryGB()
+}
+
+func TestHostedRunnerMachineSpec_GetStorageGB(tt *testing.T) {
+	var zeroValue int
+	h := &HostedRunnerMachineSpec{StorageGB: &zeroValue}
+	h.GetStorageGB()
+	h = &HostedRunnerMachineSpec{}
+	h.GetStorageGB()
+	h = nil
+	h.GetStorageGB()
+}
+
+func TestHostedRunnerPublicIP_GetEnabled(tt *testing.T) {
+	var zeroValue bool
+	h := &HostedRunnerPublicIP{Enabled: &zeroValue}
+	h.GetEnabled()
+	h = &HostedRunnerPublicIP{}
+	h.GetEnabled()
+	h = nil
+	h.GetEnabled()
+}
+
+func TestHostedRunnerPublicIP_GetLength(tt *testing.T) {
+	var zeroValue int
+	h := &HostedRunnerPublicIP{Length: &zeroValue}
+	h.GetLength()
+	h = &HostedRunnerPublicIP{}
+	h.GetLength()
+	h = nil
+	h.GetLength()
+}
+
+func TestHostedRunnerPublicIP_GetPrefix(tt *testing.T) {
+	var zeroValue string
+	h := &HostedRunnerPublicIP{Prefix: &zeroValue}
+	h.GetPrefix()
+	h = &HostedRunnerPublicIP{}
+	h.GetPrefix()
+	h = nil
+	h.GetPrefix()
+}
+
+func TestHostedRunnerPublicIPLimits_GetCurrentUsage(tt *testing.T) {
+	var zeroValue int
+	h := &HostedRunnerPublicIPLimits{CurrentUsage: &zeroValue}
+	h.GetCurrentUsage()
+	h = &HostedRunnerPublicIPLimits{}
+	h.GetCurrentUsage()
+	h = nil
+	h.GetCurrentUsage()
+}
+
+func TestHostedRunnerPublicIPLimits_GetMaximum(tt *testing.T) {
+	var zeroValue int
+	h := &HostedRunnerPublicIPLimits{Maximum: &zeroValue}
+	h.GetMaximum()
+	h = &HostedRunnerPublicIPLimits{}
+	h.GetMaximum()
+	h = nil
+	h.GetMaximum()
+}
+
+func TestIDPGroup_GetGroupDescription(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupDescription: &zeroValue}
+	i.GetGroupDescription()
+	i = &IDPGroup{}
+	i.GetGroupDescription()
+	i = nil
+	i.GetGroupDescription()
+}
+
+func TestIDPGroup_GetGroupID(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupID: &zeroValue}
+	i.GetGroupID()
+	i = &IDPGroup{}
+	i.GetGroupID()
+	i = nil
+	i.GetGroupID()
+}
+
+func TestIDPGroup_GetGroupName(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupName: &zeroValue}
+	i.GetGroupName()
+	i = &IDPGroup{}
+	i.GetGroupName()
+	i = nil
+	i.GetGroupName()
+}
+
+func TestImport_GetAuthorsCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{AuthorsCount: &zeroValue}
+	i.GetAuthorsCount()
+	i = &Import{}
+	i.GetAuthorsCount()
+	i = nil
+	i.GetAuthorsCount()
+}
+
+func TestImport_GetAuthorsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{AuthorsURL: &zeroValue}
+	i.GetAuthorsURL()
+	i = &Import{}
+	i.GetAuthorsURL()
+	i = nil
+	i.GetAuthorsURL()
+}
+
+func TestImport_GetCommitCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{CommitCount: &zeroValue}
+	i.GetCommitCount()
+	i = &Import{}
+	i.GetCommitCount()
+	i = nil
+	i.GetCommitCount()
+}
+
+func TestImport_GetFailedStep(tt *testing.T) {
+	var zeroValue string
+	i := &Import{FailedStep: &zeroValue}
+	i.GetFailedStep()
+	i = &Import{}
+	i.GetFailedStep()
+	i = nil
+	i.GetFailedStep()
+}
+
+func TestImport_GetHasLargeFiles(tt *testing.T) {
+	var zeroValue bool
+	i := &Import{HasLargeFiles: &zeroValue}
+	i.GetHasLargeFiles()
+	i = &Import{}
+	i.GetHasLargeFiles()
+	i = nil
+	i.GetHasLargeFiles()
+}
+
+func TestImport_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Import{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
+}
+
+func TestImport_GetHumanName(tt *testing.T) {
 	var zeroValue string
 	i := &Import{HumanName: &zeroValue}
 	i.GetHumanName()
@@ -10248,6 +11251,16 @@ func TestInstallationPermissions_GetActions(tt *testing.T) {
 	i.GetActions()
 }
 
+func TestInstallationPermissions_GetAdditionalPermissions(tt *testing.T) {
+	zeroValue := map[string]string{}
+	i := &InstallationPermissions{AdditionalPermissions: zeroValue}
+	i.GetAdditionalPermissions()
+	i = &InstallationPermissions{}
+	i.GetAdditionalPermissions()
+	i = nil
+	i.GetAdditionalPermissions()
+}
+
 func TestInstallationPermissions_GetAdministration(tt *testing.T) {
 	var zeroValue string
 	i := &InstallationPermissions{Administration: &zeroValue}
@@ -11269,6 +12282,16 @@ func TestIssueComment_GetID(tt *testing.T) {
 	i.GetID()
 }
 
+func TestIssueComment_GetIsMinimized(tt *testing.T) {
+	var zeroValue bool
+	i := &IssueComment{IsMinimized: &zeroValue}
+	i.GetIsMinimized()
+	i = &IssueComment{}
+	i.GetIsMinimized()
+	i = nil
+	i.GetIsMinimized()
+}
+
 func TestIssueComment_GetIssueURL(tt *testing.T) {
 	var zeroValue string
 	i := &IssueComment{IssueURL: &zeroValue}
@@ -11279,6 +12302,16 @@ func TestIssueComment_GetIssueURL(tt *testing.T) {
 	i.GetIssueURL()
 }
 
+func TestIssueComment_GetMinimizedReason(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{MinimizedReason: &zeroValue}
+	i.GetMinimizedReason()
+	i = &IssueComment{}
+	i.GetMinimizedReason()
+	i = nil
+	i.GetMinimizedReason()
+}
+
 func TestIssueComment_GetNodeID(tt *testing.T) {
 	var zeroValue string
 	i := &IssueComment{NodeID: &zeroValue}
@@ -11382,6 +12415,72 @@ func TestIssueCommentEvent_GetSender(tt *testing.T) {
 	i.GetSender()
 }
 
+func TestIssueDependenciesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &IssueDependenciesEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &IssueDependenciesEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
+}
+
+func TestIssueDependenciesEvent_GetBlockedIssue(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetBlockedIssue()
+	i = nil
+	i.GetBlockedIssue()
+}
+
+func TestIssueDependenciesEvent_GetBlockedIssueRepo(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetBlockedIssueRepo()
+	i = nil
+	i.GetBlockedIssueRepo()
+}
+
+func TestIssueDependenciesEvent_GetBlockingIssue(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetBlockingIssue()
+	i = nil
+	i.GetBlockingIssue()
+}
+
+func TestIssueDependenciesEvent_GetBlockingIssueRepo(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetBlockingIssueRepo()
+	i = nil
+	i.GetBlockingIssueRepo()
+}
+
+func TestIssueDependenciesEvent_GetInstallation(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
+}
+
+func TestIssueDependenciesEvent_GetOrg(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetOrg()
+	i = nil
+	i.GetOrg()
+}
+
+func TestIssueDependenciesEvent_GetRepo(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetRepo()
+	i = nil
+	i.GetRepo()
+}
+
+func TestIssueDependenciesEvent_GetSender(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
+}
+
 func TestIssueEvent_GetActor(tt *testing.T) {
 	i := &IssueEvent{}
 	i.GetActor()
@@ -12459,7 +13558,64 @@ func TestLicense_GetURL(tt *testing.T) {
 	l.GetURL()
 }
 
-func TestLinearHistoryRequirementEnforcementLevelChanges_GetFrom(tt *testing.T) {
+func TestLicenseSyncInfo_GetDate(tt *testing.T) {
+	var zeroValue Timestamp
+	l := &LicenseSyncInfo{Date: &zeroValue}
+	l.GetDate()
+	l = &LicenseSyncInfo{}
+	l.GetDate()
+	l = nil
+	l.GetDate()
+}
+
+func TestLicenseSyncInfo_GetError(tt *testing.T) {
+	var zeroValue string
+	l := &LicenseSyncInfo{Error: &zeroValue}
+	l.GetError()
+	l = &LicenseSyncInfo{}
+	l.GetError()
+	l = nil
+	l.GetError()
+}
+
+func TestLicenseSyncInfo_GetStatus(tt *testing.T) {
+	var zeroValue string
+	l := &LicenseSyncInfo{Status: &zeroValue}
+	l.GetStatus()
+	l = &LicenseSyncInfo{}
+	l.GetStatus()
+	l = nil
+	l.GetStatus()
+}
+
+func TestLicenseSyncServerInstance_GetHostname(tt *testing.T) {
+	var zeroValue string
+	l := &LicenseSyncServerInstance{Hostname: &zeroValue}
+	l.GetHostname()
+	l = &LicenseSyncServerInstance{}
+	l.GetHostname()
+	l = nil
+	l.GetHostname()
+}
+
+func TestLicenseSyncServerInstance_GetLastSync(tt *testing.T) {
+	l := &LicenseSyncServerInstance{}
+	l.GetLastSync()
+	l = nil
+	l.GetLastSync()
+}
+
+func TestLicenseSyncServerInstance_GetServerID(tt *testing.T) {
+	var zeroValue string
+	l := &LicenseSyncServerInstance{ServerID: &zeroValue}
+	l.GetServerID()
+	l = &LicenseSyncServerInstance{}
+	l.GetServerID()
+	l = nil
+	l.GetServerID()
+}
+
+func TestLinearHistoryRequirementEnforcementLevelChanges_GetFrom(tt *testing.T) {
 	var zeroValue string
 	l := &LinearHistoryRequirementEnforcementLevelChanges{From: &zeroValue}
 	l.GetFrom()
@@ -12779,6 +13935,16 @@ func TestListOrganizations_GetTotalCount(tt *testing.T) {
 	l.GetTotalCount()
 }
 
+func TestListRepositories_GetRepositorySelection(tt *testing.T) {
+	var zeroValue string
+	l := &ListRepositories{RepositorySelection: &zeroValue}
+	l.GetRepositorySelection()
+	l = &ListRepositories{}
+	l.GetRepositorySelection()
+	l = nil
+	l.GetRepositorySelection()
+}
+
 func TestListRepositories_GetTotalCount(tt *testing.T) {
 	var zeroValue int
 	l := &ListRepositories{TotalCount: &zeroValue}
@@ -12829,6 +13995,16 @@ func TestListSCIMProvisionedIdentitiesOptions_GetStartIndex(tt *testing.T) {
 	l.GetStartIndex()
 }
 
+func TestListStargazersOptions_GetWithTimestamps(tt *testing.T) {
+	var zeroValue bool
+	l := &ListStargazersOptions{WithTimestamps: &zeroValue}
+	l.GetWithTimestamps()
+	l = &ListStargazersOptions{}
+	l.GetWithTimestamps()
+	l = nil
+	l.GetWithTimestamps()
+}
+
 func TestLocation_GetEndColumn(tt *testing.T) {
 	var zeroValue int
 	l := &Location{EndColumn: &zeroValue}
@@ -13781,6 +14957,36 @@ func TestMigration_GetExcludeAttachments(tt *testing.T) {
 	m.GetExcludeAttachments()
 }
 
+func TestMigration_GetExcludeGitData(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeGitData: &zeroValue}
+	m.GetExcludeGitData()
+	m = &Migration{}
+	m.GetExcludeGitData()
+	m = nil
+	m.GetExcludeGitData()
+}
+
+func TestMigration_GetExcludeOwnerProjects(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeOwnerProjects: &zeroValue}
+	m.GetExcludeOwnerProjects()
+	m = &Migration{}
+	m.GetExcludeOwnerProjects()
+	m = nil
+	m.GetExcludeOwnerProjects()
+}
+
+func TestMigration_GetExcludeReleases(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeReleases: &zeroValue}
+	m.GetExcludeReleases()
+	m = &Migration{}
+	m.GetExcludeReleases()
+	m = nil
+	m.GetExcludeReleases()
+}
+
 func TestMigration_GetGUID(tt *testing.T) {
 	var zeroValue string
 	m := &Migration{GUID: &zeroValue}
@@ -13811,6 +15017,16 @@ func TestMigration_GetLockRepositories(tt *testing.T) {
 	m.GetLockRepositories()
 }
 
+func TestMigration_GetOrgMetadataOnly(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{OrgMetadataOnly: &zeroValue}
+	m.GetOrgMetadataOnly()
+	m = &Migration{}
+	m.GetOrgMetadataOnly()
+	m = nil
+	m.GetOrgMetadataOnly()
+}
+
 func TestMigration_GetState(tt *testing.T) {
 	var zeroValue string
 	m := &Migration{State: &zeroValue}
@@ -14164,6 +15380,126 @@ func TestMostRecentInstance_GetState(tt *testing.T) {
 	m.GetState()
 }
 
+func TestNetworkConfiguration_GetComputeService(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkConfiguration{ComputeService: &zeroValue}
+	n.GetComputeService()
+	n = &NetworkConfiguration{}
+	n.GetComputeService()
+	n = nil
+	n.GetComputeService()
+}
+
+func TestNetworkConfiguration_GetCreatedOn(tt *testing.T) {
+	var zeroValue Timestamp
+	n := &NetworkConfiguration{CreatedOn: &zeroValue}
+	n.GetCreatedOn()
+	n = &NetworkConfiguration{}
+	n.GetCreatedOn()
+	n = nil
+	n.GetCreatedOn()
+}
+
+func TestNetworkConfiguration_GetID(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkConfiguration{ID: &zeroValue}
+	n.GetID()
+	n = &NetworkConfiguration{}
+	n.GetID()
+	n = nil
+	n.GetID()
+}
+
+func TestNetworkConfiguration_GetName(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkConfiguration{Name: &zeroValue}
+	n.GetName()
+	n = &NetworkConfiguration{}
+	n.GetName()
+	n = nil
+	n.GetName()
+}
+
+func TestNetworkConfigurationRequest_GetComputeService(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkConfigurationRequest{ComputeService: &zeroValue}
+	n.GetComputeService()
+	n = &NetworkConfigurationRequest{}
+	n.GetComputeService()
+	n = nil
+	n.GetComputeService()
+}
+
+func TestNetworkConfigurationRequest_GetName(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkConfigurationRequest{Name: &zeroValue}
+	n.GetName()
+	n = &NetworkConfigurationRequest{}
+	n.GetName()
+	n = nil
+	n.GetName()
+}
+
+func TestNetworkConfigurations_GetTotalCount(tt *testing.T) {
+	var zeroValue int64
+	n := &NetworkConfigurations{TotalCount: &zeroValue}
+	n.GetTotalCount()
+	n = &NetworkConfigurations{}
+	n.GetTotalCount()
+	n = nil
+	n.GetTotalCount()
+}
+
+func TestNetworkSettings_GetID(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkSettings{ID: &zeroValue}
+	n.GetID()
+	n = &NetworkSettings{}
+	n.GetID()
+	n = nil
+	n.GetID()
+}
+
+func TestNetworkSettings_GetName(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkSettings{Name: &zeroValue}
+	n.GetName()
+	n = &NetworkSettings{}
+	n.GetName()
+	n = nil
+	n.GetName()
+}
+
+func TestNetworkSettings_GetNetworkConfigurationID(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkSettings{NetworkConfigurationID: &zeroValue}
+	n.GetNetworkConfigurationID()
+	n = &NetworkSettings{}
+	n.GetNetworkConfigurationID()
+	n = nil
+	n.GetNetworkConfigurationID()
+}
+
+func TestNetworkSettings_GetRegion(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkSettings{Region: &zeroValue}
+	n.GetRegion()
+	n = &NetworkSettings{}
+	n.GetRegion()
+	n = nil
+	n.GetRegion()
+}
+
+func TestNetworkSettings_GetSubnetID(tt *testing.T) {
+	var zeroValue string
+	n := &NetworkSettings{SubnetID: &zeroValue}
+	n.GetSubnetID()
+	n = &NetworkSettings{}
+	n.GetSubnetID()
+	n = nil
+	n.GetSubnetID()
+}
+
 func TestNewPullRequest_GetBase(tt *testing.T) {
 	var zeroValue string
 	n := &NewPullRequest{Base: &zeroValue}
@@ -14925,6 +16261,26 @@ func TestOrganization_GetSecretScanningEnabledForNewRepos(tt *testing.T) {
 	o.GetSecretScanningEnabledForNewRepos()
 }
 
+func TestOrganization_GetSecretScanningPushProtectionCustomLink(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{SecretScanningPushProtectionCustomLink: &zeroValue}
+	o.GetSecretScanningPushProtectionCustomLink()
+	o = &Organization{}
+	o.GetSecretScanningPushProtectionCustomLink()
+	o = nil
+	o.GetSecretScanningPushProtectionCustomLink()
+}
+
+func TestOrganization_GetSecretScanningPushProtectionCustomLinkEnabled(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{SecretScanningPushProtectionCustomLinkEnabled: &zeroValue}
+	o.GetSecretScanningPushProtectionCustomLinkEnabled()
+	o = &Organization{}
+	o.GetSecretScanningPushProtectionCustomLinkEnabled()
+	o = nil
+	o.GetSecretScanningPushProtectionCustomLinkEnabled()
+}
+
 func TestOrganization_GetSecretScanningPushProtectionEnabledForNewRepos(tt *testing.T) {
 	var zeroValue bool
 	o := &Organization{SecretScanningPushProtectionEnabledForNewRepos: &zeroValue}
@@ -15080,6 +16436,26 @@ func TestOrganizationInstallations_GetTotalCount(tt *testing.T) {
 	o.GetTotalCount()
 }
 
+func TestOrganizationProjectsPolicy_GetHasOrganizationProjects(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationProjectsPolicy{HasOrganizationProjects: &zeroValue}
+	o.GetHasOrganizationProjects()
+	o = &OrganizationProjectsPolicy{}
+	o.GetHasOrganizationProjects()
+	o = nil
+	o.GetHasOrganizationProjects()
+}
+
+func TestOrganizationProjectsPolicy_GetHasRepositoryProjects(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationProjectsPolicy{HasRepositoryProjects: &zeroValue}
+	o.GetHasRepositoryProjects()
+	o = &OrganizationProjectsPolicy{}
+	o.GetHasRepositoryProjects()
+	o = nil
+	o.GetHasRepositoryProjects()
+}
+
 func TestOrgBlockEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	o := &OrgBlockEvent{Action: &zeroValue}
@@ -16126,6 +17502,26 @@ func TestPages_GetHTTPSEnforced(tt *testing.T) {
 	p.GetHTTPSEnforced()
 }
 
+func TestPages_GetPendingDomainUnverifiedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Pages{PendingDomainUnverifiedAt: &zeroValue}
+	p.GetPendingDomainUnverifiedAt()
+	p = &Pages{}
+	p.GetPendingDomainUnverifiedAt()
+	p = nil
+	p.GetPendingDomainUnverifiedAt()
+}
+
+func TestPages_GetProtectedDomainState(tt *testing.T) {
+	var zeroValue string
+	p := &Pages{ProtectedDomainState: &zeroValue}
+	p.GetProtectedDomainState()
+	p = &Pages{}
+	p.GetProtectedDomainState()
+	p = nil
+	p.GetProtectedDomainState()
+}
+
 func TestPages_GetPublic(tt *testing.T) {
 	var zeroValue bool
 	p := &Pages{Public: &zeroValue}
@@ -16237,6 +17633,56 @@ func TestPagesBuild_GetURL(tt *testing.T) {
 	p.GetURL()
 }
 
+func TestPagesDeployment_GetID(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeployment{ID: &zeroValue}
+	p.GetID()
+	p = &PagesDeployment{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPagesDeployment_GetPageURL(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeployment{PageURL: &zeroValue}
+	p.GetPageURL()
+	p = &PagesDeployment{}
+	p.GetPageURL()
+	p = nil
+	p.GetPageURL()
+}
+
+func TestPagesDeployment_GetPreviewURL(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeployment{PreviewURL: &zeroValue}
+	p.GetPreviewURL()
+	p = &PagesDeployment{}
+	p.GetPreviewURL()
+	p = nil
+	p.GetPreviewURL()
+}
+
+func TestPagesDeployment_GetStatusURL(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeployment{StatusURL: &zeroValue}
+	p.GetStatusURL()
+	p = &PagesDeployment{}
+	p.GetStatusURL()
+	p = nil
+	p.GetStatusURL()
+}
+
+func TestPagesDeploymentStatus_GetStatus(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeploymentStatus{Status: &zeroValue}
+	p.GetStatus()
+	p = &PagesDeploymentStatus{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
+}
+
 func TestPagesDomain_GetCAAError(tt *testing.T) {
 	var zeroValue string
 	p := &PagesDomain{CAAError: &zeroValue}
@@ -16648,6 +18094,110 @@ func TestPagesUpdate_GetSource(tt *testing.T) {
 	p.GetSource()
 }
 
+func TestPersonalAccessToken_GetAccessGrantedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{AccessGrantedAt: &zeroValue}
+	p.GetAccessGrantedAt()
+	p = &PersonalAccessToken{}
+	p.GetAccessGrantedAt()
+	p = nil
+	p.GetAccessGrantedAt()
+}
+
+func TestPersonalAccessToken_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessToken{ID: &zeroValue}
+	p.GetID()
+	p = &PersonalAccessToken{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPersonalAccessToken_GetOwner(tt *testing.T) {
+	p := &PersonalAccessToken{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
+}
+
+func TestPersonalAccessToken_GetPermissions(tt *testing.T) {
+	p := &PersonalAccessToken{}
+	p.GetPermissions()
+	p = nil
+	p.GetPermissions()
+}
+
+func TestPersonalAccessToken_GetRepositoriesURL(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessToken{RepositoriesURL: &zeroValue}
+	p.GetRepositoriesURL()
+	p = &PersonalAccessToken{}
+	p.GetRepositoriesURL()
+	p = nil
+	p.GetRepositoriesURL()
+}
+
+func TestPersonalAccessToken_GetRepositorySelection(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessToken{RepositorySelection: &zeroValue}
+	p.GetRepositorySelection()
+	p = &PersonalAccessToken{}
+	p.GetRepositorySelection()
+	p = nil
+	p.GetRepositorySelection()
+}
+
+func TestPersonalAccessToken_GetTokenExpired(tt *testing.T) {
+	var zeroValue bool
+	p := &PersonalAccessToken{TokenExpired: &zeroValue}
+	p.GetTokenExpired()
+	p = &PersonalAccessToken{}
+	p.GetTokenExpired()
+	p = nil
+	p.GetTokenExpired()
+}
+
+func TestPersonalAccessToken_GetTokenExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{TokenExpiresAt: &zeroValue}
+	p.GetTokenExpiresAt()
+	p = &PersonalAccessToken{}
+	p.GetTokenExpiresAt()
+	p = nil
+	p.GetTokenExpiresAt()
+}
+
+func TestPersonalAccessToken_GetTokenID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessToken{TokenID: &zeroValue}
+	p.GetTokenID()
+	p = &PersonalAccessToken{}
+	p.GetTokenID()
+	p = nil
+	p.GetTokenID()
+}
+
+func TestPersonalAccessToken_GetTokenLastUsedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{TokenLastUsedAt: &zeroValue}
+	p.GetTokenLastUsedAt()
+	p = &PersonalAccessToken{}
+	p.GetTokenLastUsedAt()
+	p = nil
+	p.GetTokenLastUsedAt()
+}
+
+func TestPersonalAccessToken_GetTokenName(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessToken{TokenName: &zeroValue}
+	p.GetTokenName()
+	p = &PersonalAccessToken{}
+	p.GetTokenName()
+	p = nil
+	p.GetTokenName()
+}
+
 func TestPersonalAccessTokenPermissions_GetOrg(tt *testing.T) {
 	zeroValue := map[string]string{}
 	p := &PersonalAccessTokenPermissions{Org: zeroValue}
@@ -16712,6 +18262,13 @@ func TestPersonalAccessTokenRequest_GetOwner(tt *testing.T) {
 	p.GetOwner()
 }
 
+func TestPersonalAccessTokenRequest_GetPermissions(tt *testing.T) {
+	p := &PersonalAccessTokenRequest{}
+	p.GetPermissions()
+	p = nil
+	p.GetPermissions()
+}
+
 func TestPersonalAccessTokenRequest_GetPermissionsAdded(tt *testing.T) {
 	p := &PersonalAccessTokenRequest{}
 	p.GetPermissionsAdded()
@@ -16733,6 +18290,26 @@ func TestPersonalAccessTokenRequest_GetPermissionsUpgraded(tt *testing.T) {
 	p.GetPermissionsUpgraded()
 }
 
+func TestPersonalAccessTokenRequest_GetReason(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessTokenRequest{Reason: &zeroValue}
+	p.GetReason()
+	p = &PersonalAccessTokenRequest{}
+	p.GetReason()
+	p = nil
+	p.GetReason()
+}
+
+func TestPersonalAccessTokenRequest_GetRepositoriesURL(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessTokenRequest{RepositoriesURL: &zeroValue}
+	p.GetRepositoriesURL()
+	p = &PersonalAccessTokenRequest{}
+	p.GetRepositoriesURL()
+	p = nil
+	p.GetRepositoriesURL()
+}
+
 func TestPersonalAccessTokenRequest_GetRepositoryCount(tt *testing.T) {
 	var zeroValue int64
 	p := &PersonalAccessTokenRequest{RepositoryCount: &zeroValue}
@@ -16773,6 +18350,16 @@ func TestPersonalAccessTokenRequest_GetTokenExpiresAt(tt *testing.T) {
 	p.GetTokenExpiresAt()
 }
 
+func TestPersonalAccessTokenRequest_GetTokenID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessTokenRequest{TokenID: &zeroValue}
+	p.GetTokenID()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenID()
+	p = nil
+	p.GetTokenID()
+}
+
 func TestPersonalAccessTokenRequest_GetTokenLastUsedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	p := &PersonalAccessTokenRequest{TokenLastUsedAt: &zeroValue}
@@ -16783,6 +18370,16 @@ func TestPersonalAccessTokenRequest_GetTokenLastUsedAt(tt *testing.T) {
 	p.GetTokenLastUsedAt()
 }
 
+func TestPersonalAccessTokenRequest_GetTokenName(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessTokenRequest{TokenName: &zeroValue}
+	p.GetTokenName()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenName()
+	p = nil
+	p.GetTokenName()
+}
+
 func TestPersonalAccessTokenRequestEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	p := &PersonalAccessTokenRequestEvent{Action: &zeroValue}
@@ -17680,10 +19277,118 @@ func TestProjectEvent_GetSender(tt *testing.T) {
 	p.GetSender()
 }
 
-func TestProjectName_GetFrom(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectName{From: &zeroValue}
-	p.GetFrom()
+func TestProjectItemChange_GetActor(tt *testing.T) {
+	p := &ProjectItemChange{}
+	p.GetActor()
+	p = nil
+	p.GetActor()
+}
+
+func TestProjectItemChange_GetChangedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectItemChange{ChangedAt: &zeroValue}
+	p.GetChangedAt()
+	p = &ProjectItemChange{}
+	p.GetChangedAt()
+	p = nil
+	p.GetChangedAt()
+}
+
+func TestProjectItemChange_GetFieldName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectItemChange{FieldName: &zeroValue}
+	p.GetFieldName()
+	p = &ProjectItemChange{}
+	p.GetFieldName()
+	p = nil
+	p.GetFieldName()
+}
+
+func TestProjectItemChange_GetFieldNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectItemChange{FieldNodeID: &zeroValue}
+	p.GetFieldNodeID()
+	p = &ProjectItemChange{}
+	p.GetFieldNodeID()
+	p = nil
+	p.GetFieldNodeID()
+}
+
+func TestProjectItemChange_GetFieldType(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectItemChange{FieldType: &zeroValue}
+	p.GetFieldType()
+	p = &ProjectItemChange{}
+	p.GetFieldType()
+	p = nil
+	p.GetFieldType()
+}
+
+func TestProjectItemChange_GetFrom(tt *testing.T) {
+	p := &ProjectItemChange{}
+	p.GetFrom()
+	p = nil
+	p.GetFrom()
+}
+
+func TestProjectItemChange_GetItemNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectItemChange{ItemNodeID: &zeroValue}
+	p.GetItemNodeID()
+	p = &ProjectItemChange{}
+	p.GetItemNodeID()
+	p = nil
+	p.GetItemNodeID()
+}
+
+func TestProjectItemChange_GetProjectNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectItemChange{ProjectNodeID: &zeroValue}
+	p.GetProjectNodeID()
+	p = &ProjectItemChange{}
+	p.GetProjectNodeID()
+	p = nil
+	p.GetProjectNodeID()
+}
+
+func TestProjectItemChange_GetTo(tt *testing.T) {
+	p := &ProjectItemChange{}
+	p.GetTo()
+	p = nil
+	p.GetTo()
+}
+
+func TestProjectItemsSummary_GetArchived(tt *testing.T) {
+	zeroValue := map[string]int{}
+	p := &ProjectItemsSummary{Archived: zeroValue}
+	p.GetArchived()
+	p = &ProjectItemsSummary{}
+	p.GetArchived()
+	p = nil
+	p.GetArchived()
+}
+
+func TestProjectItemsSummary_GetCounts(tt *testing.T) {
+	zeroValue := map[string]int{}
+	p := &ProjectItemsSummary{Counts: zeroValue}
+	p.GetCounts()
+	p = &ProjectItemsSummary{}
+	p.GetCounts()
+	p = nil
+	p.GetCounts()
+}
+
+func TestProjectItemsSummary_GetField(tt *testing.T) {
+	p := &ProjectItemsSummary{}
+	p.GetField()
+	p = nil
+	p.GetField()
+}
+
+func TestProjectName_GetFrom(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectName{From: &zeroValue}
+	p.GetFrom()
 	p = &ProjectName{}
 	p.GetFrom()
 	p = nil
@@ -17926,6 +19631,100 @@ func TestProjectV2Event_GetSender(tt *testing.T) {
 	p.GetSender()
 }
 
+func TestProjectV2Field_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2Field{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectV2Field{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestProjectV2Field_GetDataType(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2Field{DataType: &zeroValue}
+	p.GetDataType()
+	p = &ProjectV2Field{}
+	p.GetDataType()
+	p = nil
+	p.GetDataType()
+}
+
+func TestProjectV2Field_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectV2Field{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2Field{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2Field_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2Field{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectV2Field{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestProjectV2Field_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2Field{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectV2Field{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
+}
+
+func TestProjectV2Field_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2Field{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectV2Field{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
+}
+
+func TestProjectV2FieldOption_GetColor(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2FieldOption{Color: &zeroValue}
+	p.GetColor()
+	p = &ProjectV2FieldOption{}
+	p.GetColor()
+	p = nil
+	p.GetColor()
+}
+
+func TestProjectV2FieldOption_GetDescription(tt *testing.T) {
+	p := &ProjectV2FieldOption{}
+	p.GetDescription()
+	p = nil
+	p.GetDescription()
+}
+
+func TestProjectV2FieldOption_GetID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2FieldOption{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2FieldOption{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2FieldOption_GetName(tt *testing.T) {
+	p := &ProjectV2FieldOption{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
 func TestProjectV2Item_GetArchivedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	p := &ProjectV2Item{ArchivedAt: &zeroValue}
@@ -17936,6 +19735,13 @@ func TestProjectV2Item_GetArchivedAt(tt *testing.T) {
 	p.GetArchivedAt()
 }
 
+func TestProjectV2Item_GetContent(tt *testing.T) {
+	p := &ProjectV2Item{}
+	p.GetContent()
+	p = nil
+	p.GetContent()
+}
+
 func TestProjectV2Item_GetContentNodeID(tt *testing.T) {
 	var zeroValue string
 	p := &ProjectV2Item{ContentNodeID: &zeroValue}
@@ -18020,6 +19826,93 @@ func TestProjectV2ItemChange_GetArchivedAt(tt *testing.T) {
 	p.GetArchivedAt()
 }
 
+func TestProjectV2ItemChange_GetFieldValue(tt *testing.T) {
+	p := &ProjectV2ItemChange{}
+	p.GetFieldValue()
+	p = nil
+	p.GetFieldValue()
+}
+
+func TestProjectV2ItemContent_GetBody(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemContent{Body: &zeroValue}
+	p.GetBody()
+	p = &ProjectV2ItemContent{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
+}
+
+func TestProjectV2ItemContent_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemContent{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &ProjectV2ItemContent{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
+}
+
+func TestProjectV2ItemContent_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectV2ItemContent{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2ItemContent{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2ItemContent_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemContent{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectV2ItemContent{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
+}
+
+func TestProjectV2ItemContent_GetNumber(tt *testing.T) {
+	var zeroValue int
+	p := &ProjectV2ItemContent{Number: &zeroValue}
+	p.GetNumber()
+	p = &ProjectV2ItemContent{}
+	p.GetNumber()
+	p = nil
+	p.GetNumber()
+}
+
+func TestProjectV2ItemContent_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemContent{State: &zeroValue}
+	p.GetState()
+	p = &ProjectV2ItemContent{}
+	p.GetState()
+	p = nil
+	p.GetState()
+}
+
+func TestProjectV2ItemContent_GetTitle(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemContent{Title: &zeroValue}
+	p.GetTitle()
+	p = &ProjectV2ItemContent{}
+	p.GetTitle()
+	p = nil
+	p.GetTitle()
+}
+
+func TestProjectV2ItemContent_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemContent{URL: &zeroValue}
+	p.GetURL()
+	p = &ProjectV2ItemContent{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
+}
+
 func TestProjectV2ItemEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	p := &ProjectV2ItemEvent{Action: &zeroValue}
@@ -18065,6 +19958,398 @@ func TestProjectV2ItemEvent_GetSender(tt *testing.T) {
 	p.GetSender()
 }
 
+func TestProjectV2ItemField_GetDataType(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemField{DataType: &zeroValue}
+	p.GetDataType()
+	p = &ProjectV2ItemField{}
+	p.GetDataType()
+	p = nil
+	p.GetDataType()
+}
+
+func TestProjectV2ItemField_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectV2ItemField{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2ItemField{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2ItemField_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemField{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectV2ItemField{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestProjectV2ItemField_GetValue(tt *testing.T) {
+	p := &ProjectV2ItemField{}
+	p.GetValue()
+	p = nil
+	p.GetValue()
+}
+
+func TestProjectV2ItemFieldValue_GetIteration(tt *testing.T) {
+	p := &ProjectV2ItemFieldValue{}
+	p.GetIteration()
+	p = nil
+	p.GetIteration()
+}
+
+func TestProjectV2ItemFieldValue_GetNumber(tt *testing.T) {
+	p := &ProjectV2ItemFieldValue{}
+	p.GetNumber()
+	p = nil
+	p.GetNumber()
+}
+
+func TestProjectV2ItemFieldValue_GetSingleSelectOption(tt *testing.T) {
+	p := &ProjectV2ItemFieldValue{}
+	p.GetSingleSelectOption()
+	p = nil
+	p.GetSingleSelectOption()
+}
+
+func TestProjectV2ItemFieldValue_GetText(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemFieldValue{Text: &zeroValue}
+	p.GetText()
+	p = &ProjectV2ItemFieldValue{}
+	p.GetText()
+	p = nil
+	p.GetText()
+}
+
+func TestProjectV2ItemFieldValueChange_GetFieldName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemFieldValueChange{FieldName: &zeroValue}
+	p.GetFieldName()
+	p = &ProjectV2ItemFieldValueChange{}
+	p.GetFieldName()
+	p = nil
+	p.GetFieldName()
+}
+
+func TestProjectV2ItemFieldValueChange_GetFieldNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemFieldValueChange{FieldNodeID: &zeroValue}
+	p.GetFieldNodeID()
+	p = &ProjectV2ItemFieldValueChange{}
+	p.GetFieldNodeID()
+	p = nil
+	p.GetFieldNodeID()
+}
+
+func TestProjectV2ItemFieldValueChange_GetFieldType(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2ItemFieldValueChange{FieldType: &zeroValue}
+	p.GetFieldType()
+	p = &ProjectV2ItemFieldValueChange{}
+	p.GetFieldType()
+	p = nil
+	p.GetFieldType()
+}
+
+func TestProjectV2ItemFieldValueChange_GetFrom(tt *testing.T) {
+	p := &ProjectV2ItemFieldValueChange{}
+	p.GetFrom()
+	p = nil
+	p.GetFrom()
+}
+
+func TestProjectV2ItemFieldValueChange_GetProjectNumber(tt *testing.T) {
+	var zeroValue int
+	p := &ProjectV2ItemFieldValueChange{ProjectNumber: &zeroValue}
+	p.GetProjectNumber()
+	p = &ProjectV2ItemFieldValueChange{}
+	p.GetProjectNumber()
+	p = nil
+	p.GetProjectNumber()
+}
+
+func TestProjectV2ItemFieldValueChange_GetTo(tt *testing.T) {
+	p := &ProjectV2ItemFieldValueChange{}
+	p.GetTo()
+	p = nil
+	p.GetTo()
+}
+
+func TestProjectV2Iteration_GetDuration(tt *testing.T) {
+	var zeroValue int
+	p := &ProjectV2Iteration{Duration: &zeroValue}
+	p.GetDuration()
+	p = &ProjectV2Iteration{}
+	p.GetDuration()
+	p = nil
+	p.GetDuration()
+}
+
+func TestProjectV2Iteration_GetID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2Iteration{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2Iteration{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2Iteration_GetStartDate(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2Iteration{StartDate: &zeroValue}
+	p.GetStartDate()
+	p = &ProjectV2Iteration{}
+	p.GetStartDate()
+	p = nil
+	p.GetStartDate()
+}
+
+func TestProjectV2Iteration_GetTitle(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2Iteration{Title: &zeroValue}
+	p.GetTitle()
+	p = &ProjectV2Iteration{}
+	p.GetTitle()
+	p = nil
+	p.GetTitle()
+}
+
+func TestProjectV2SingleSelectOption_GetColor(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2SingleSelectOption{Color: &zeroValue}
+	p.GetColor()
+	p = &ProjectV2SingleSelectOption{}
+	p.GetColor()
+	p = nil
+	p.GetColor()
+}
+
+func TestProjectV2SingleSelectOption_GetDescription(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2SingleSelectOption{Description: &zeroValue}
+	p.GetDescription()
+	p = &ProjectV2SingleSelectOption{}
+	p.GetDescription()
+	p = nil
+	p.GetDescription()
+}
+
+func TestProjectV2SingleSelectOption_GetID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2SingleSelectOption{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2SingleSelectOption{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2SingleSelectOption_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2SingleSelectOption{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectV2SingleSelectOption{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestProjectV2StatusUpdate_GetBody(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2StatusUpdate{Body: &zeroValue}
+	p.GetBody()
+	p = &ProjectV2StatusUpdate{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
+}
+
+func TestProjectV2StatusUpdate_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2StatusUpdate{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectV2StatusUpdate{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestProjectV2StatusUpdate_GetCreator(tt *testing.T) {
+	p := &ProjectV2StatusUpdate{}
+	p.GetCreator()
+	p = nil
+	p.GetCreator()
+}
+
+func TestProjectV2StatusUpdate_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectV2StatusUpdate{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2StatusUpdate{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2StatusUpdate_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2StatusUpdate{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectV2StatusUpdate{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
+}
+
+func TestProjectV2StatusUpdate_GetProjectNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2StatusUpdate{ProjectNodeID: &zeroValue}
+	p.GetProjectNodeID()
+	p = &ProjectV2StatusUpdate{}
+	p.GetProjectNodeID()
+	p = nil
+	p.GetProjectNodeID()
+}
+
+func TestProjectV2StatusUpdate_GetStartDate(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2StatusUpdate{StartDate: &zeroValue}
+	p.GetStartDate()
+	p = &ProjectV2StatusUpdate{}
+	p.GetStartDate()
+	p = nil
+	p.GetStartDate()
+}
+
+func TestProjectV2StatusUpdate_GetStatus(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2StatusUpdate{Status: &zeroValue}
+	p.GetStatus()
+	p = &ProjectV2StatusUpdate{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
+}
+
+func TestProjectV2StatusUpdate_GetTargetDate(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2StatusUpdate{TargetDate: &zeroValue}
+	p.GetTargetDate()
+	p = &ProjectV2StatusUpdate{}
+	p.GetTargetDate()
+	p = nil
+	p.GetTargetDate()
+}
+
+func TestProjectV2StatusUpdate_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2StatusUpdate{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectV2StatusUpdate{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
+}
+
+func TestProjectV2StatusUpdateChange_GetBody(tt *testing.T) {
+	p := &ProjectV2StatusUpdateChange{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
+}
+
+func TestProjectV2StatusUpdateChange_GetStartDate(tt *testing.T) {
+	p := &ProjectV2StatusUpdateChange{}
+	p.GetStartDate()
+	p = nil
+	p.GetStartDate()
+}
+
+func TestProjectV2StatusUpdateChange_GetStatus(tt *testing.T) {
+	p := &ProjectV2StatusUpdateChange{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
+}
+
+func TestProjectV2StatusUpdateChange_GetTargetDate(tt *testing.T) {
+	p := &ProjectV2StatusUpdateChange{}
+	p.GetTargetDate()
+	p = nil
+	p.GetTargetDate()
+}
+
+func TestProjectV2StatusUpdateEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2StatusUpdateEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectV2StatusUpdateEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
+}
+
+func TestProjectV2StatusUpdateEvent_GetChanges(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetChanges()
+	p = nil
+	p.GetChanges()
+}
+
+func TestProjectV2StatusUpdateEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
+}
+
+func TestProjectV2StatusUpdateEvent_GetOrg(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
+}
+
+func TestProjectV2StatusUpdateEvent_GetProjectV2StatusUpdate(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetProjectV2StatusUpdate()
+	p = nil
+	p.GetProjectV2StatusUpdate()
+}
+
+func TestProjectV2StatusUpdateEvent_GetSender(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
+}
+
+func TestProjectV2TextContent_GetHTML(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2TextContent{HTML: &zeroValue}
+	p.GetHTML()
+	p = &ProjectV2TextContent{}
+	p.GetHTML()
+	p = nil
+	p.GetHTML()
+}
+
+func TestProjectV2TextContent_GetRaw(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2TextContent{Raw: &zeroValue}
+	p.GetRaw()
+	p = &ProjectV2TextContent{}
+	p.GetRaw()
+	p = nil
+	p.GetRaw()
+}
+
 func TestProtection_GetAllowDeletions(tt *testing.T) {
 	p := &Protection{}
 	p.GetAllowDeletions()
@@ -18450,7 +20735,27 @@ func TestPublicKey_GetKeyID(tt *testing.T) {
 	p = &PublicKey{}
 	p.GetKeyID()
 	p = nil
-	p.GetKeyID()
+	p.GetKeyID()
+}
+
+func TestPublishReleaseOptions_GetDiscussionCategoryName(tt *testing.T) {
+	var zeroValue string
+	p := &PublishReleaseOptions{DiscussionCategoryName: &zeroValue}
+	p.GetDiscussionCategoryName()
+	p = &PublishReleaseOptions{}
+	p.GetDiscussionCategoryName()
+	p = nil
+	p.GetDiscussionCategoryName()
+}
+
+func TestPublishReleaseOptions_GetMakeLatest(tt *testing.T) {
+	var zeroValue string
+	p := &PublishReleaseOptions{MakeLatest: &zeroValue}
+	p.GetMakeLatest()
+	p = &PublishReleaseOptions{}
+	p.GetMakeLatest()
+	p = nil
+	p.GetMakeLatest()
 }
 
 func TestPullRequest_GetActiveLockReason(tt *testing.T) {
@@ -20910,6 +23215,58 @@ func TestRegistrationToken_GetToken(tt *testing.T) {
 	r.GetToken()
 }
 
+func TestRegistryPackageEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	r := &RegistryPackageEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RegistryPackageEvent{}
+	r.GetAction()
+	r = nil
+	r.GetAction()
+}
+
+func TestRegistryPackageEvent_GetEnterprise(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetEnterprise()
+	r = nil
+	r.GetEnterprise()
+}
+
+func TestRegistryPackageEvent_GetInstallation(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetInstallation()
+	r = nil
+	r.GetInstallation()
+}
+
+func TestRegistryPackageEvent_GetOrganization(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetOrganization()
+	r = nil
+	r.GetOrganization()
+}
+
+func TestRegistryPackageEvent_GetRegistryPackage(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetRegistryPackage()
+	r = nil
+	r.GetRegistryPackage()
+}
+
+func TestRegistryPackageEvent_GetRepository(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetRepository()
+	r = nil
+	r.GetRepository()
+}
+
+func TestRegistryPackageEvent_GetSender(tt *testing.T) {
+	r := &RegistryPackageEvent{}
+	r.GetSender()
+	r = nil
+	r.GetSender()
+}
+
 func TestReleaseAsset_GetBrowserDownloadURL(tt *testing.T) {
 	var zeroValue string
 	r := &ReleaseAsset{BrowserDownloadURL: &zeroValue}
@@ -20940,6 +23297,16 @@ func TestReleaseAsset_GetCreatedAt(tt *testing.T) {
 	r.GetCreatedAt()
 }
 
+func TestReleaseAsset_GetDigest(tt *testing.T) {
+	var zeroValue string
+	r := &ReleaseAsset{Digest: &zeroValue}
+	r.GetDigest()
+	r = &ReleaseAsset{}
+	r.GetDigest()
+	r = nil
+	r.GetDigest()
+}
+
 func TestReleaseAsset_GetDownloadCount(tt *testing.T) {
 	var zeroValue int
 	r := &ReleaseAsset{DownloadCount: &zeroValue}
@@ -21426,6 +23793,26 @@ func TestRepoRequiredWorkflows_GetTotalCount(tt *testing.T) {
 	r.GetTotalCount()
 }
 
+func TestRepoSecuritySummary_GetCodeScanning(tt *testing.T) {
+	zeroValue := map[string]int{}
+	r := &RepoSecuritySummary{CodeScanning: zeroValue}
+	r.GetCodeScanning()
+	r = &RepoSecuritySummary{}
+	r.GetCodeScanning()
+	r = nil
+	r.GetCodeScanning()
+}
+
+func TestRepoSecuritySummary_GetDependabot(tt *testing.T) {
+	zeroValue := map[string]int{}
+	r := &RepoSecuritySummary{Dependabot: zeroValue}
+	r.GetDependabot()
+	r = &RepoSecuritySummary{}
+	r.GetDependabot()
+	r = nil
+	r.GetDependabot()
+}
+
 func TestRepositoriesSearchResult_GetIncompleteResults(tt *testing.T) {
 	var zeroValue bool
 	r := &RepositoriesSearchResult{IncompleteResults: &zeroValue}
@@ -22502,6 +24889,26 @@ func TestRepositoryComment_GetBody(tt *testing.T) {
 	r.GetBody()
 }
 
+func TestRepositoryComment_GetBodyHTML(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryComment{BodyHTML: &zeroValue}
+	r.GetBodyHTML()
+	r = &RepositoryComment{}
+	r.GetBodyHTML()
+	r = nil
+	r.GetBodyHTML()
+}
+
+func TestRepositoryComment_GetBodyText(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryComment{BodyText: &zeroValue}
+	r.GetBodyText()
+	r = &RepositoryComment{}
+	r.GetBodyText()
+	r = nil
+	r.GetBodyText()
+}
+
 func TestRepositoryComment_GetCommitID(tt *testing.T) {
 	var zeroValue string
 	r := &RepositoryComment{CommitID: &zeroValue}
@@ -23207,6 +25614,16 @@ func TestRepositoryPermissionLevel_GetPermission(tt *testing.T) {
 	r.GetPermission()
 }
 
+func TestRepositoryPermissionLevel_GetRoleName(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryPermissionLevel{RoleName: &zeroValue}
+	r.GetRoleName()
+	r = &RepositoryPermissionLevel{}
+	r.GetRoleName()
+	r = nil
+	r.GetRoleName()
+}
+
 func TestRepositoryPermissionLevel_GetUser(tt *testing.T) {
 	r := &RepositoryPermissionLevel{}
 	r.GetUser()
@@ -23421,6 +25838,120 @@ func TestRepositoryRule_GetParameters(tt *testing.T) {
 	r.GetParameters()
 }
 
+func TestRepositoryRulesetTemplate_GetConditions(tt *testing.T) {
+	r := &RepositoryRulesetTemplate{}
+	r.GetConditions()
+	r = nil
+	r.GetConditions()
+}
+
+func TestRepositoryRulesetTemplate_GetTarget(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRulesetTemplate{Target: &zeroValue}
+	r.GetTarget()
+	r = &RepositoryRulesetTemplate{}
+	r.GetTarget()
+	r = nil
+	r.GetTarget()
+}
+
+func TestRepositorySecurityAdvisoryRequest_GetCVEID(tt *testing.T) {
+	var zeroValue string
+	r := &RepositorySecurityAdvisoryRequest{CVEID: &zeroValue}
+	r.GetCVEID()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetCVEID()
+	r = nil
+	r.GetCVEID()
+}
+
+func TestRepositorySecurityAdvisoryRequest_GetCVSSVectorString(tt *testing.T) {
+	var zeroValue string
+	r := &RepositorySecurityAdvisoryRequest{CVSSVectorString: &zeroValue}
+	r.GetCVSSVectorString()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetCVSSVectorString()
+	r = nil
+	r.GetCVSSVectorString()
+}
+
+func TestRepositorySecurityAdvisoryRequest_GetDescription(tt *testing.T) {
+	var zeroValue string
+	r := &RepositorySecurityAdvisoryRequest{Description: &zeroValue}
+	r.GetDescription()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetDescription()
+	r = nil
+	r.GetDescription()
+}
+
+func TestRepositorySecurityAdvisoryRequest_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	r := &RepositorySecurityAdvisoryRequest{Severity: &zeroValue}
+	r.GetSeverity()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetSeverity()
+	r = nil
+	r.GetSeverity()
+}
+
+func TestRepositorySecurityAdvisoryRequest_GetStartPrivateFork(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositorySecurityAdvisoryRequest{StartPrivateFork: &zeroValue}
+	r.GetStartPrivateFork()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetStartPrivateFork()
+	r = nil
+	r.GetStartPrivateFork()
+}
+
+func TestRepositorySecurityAdvisoryRequest_GetState(tt *testing.T) {
+	var zeroValue string
+	r := &RepositorySecurityAdvisoryRequest{State: &zeroValue}
+	r.GetState()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetState()
+	r = nil
+	r.GetState()
+}
+
+func TestRepositorySecurityAdvisoryRequest_GetSummary(tt *testing.T) {
+	var zeroValue string
+	r := &RepositorySecurityAdvisoryRequest{Summary: &zeroValue}
+	r.GetSummary()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetSummary()
+	r = nil
+	r.GetSummary()
+}
+
+func TestRepositorySecurityAdvisoryVulnerability_GetPackage(tt *testing.T) {
+	r := &RepositorySecurityAdvisoryVulnerability{}
+	r.GetPackage()
+	r = nil
+	r.GetPackage()
+}
+
+func TestRepositorySecurityAdvisoryVulnerability_GetPatchedVersions(tt *testing.T) {
+	var zeroValue string
+	r := &RepositorySecurityAdvisoryVulnerability{PatchedVersions: &zeroValue}
+	r.GetPatchedVersions()
+	r = &RepositorySecurityAdvisoryVulnerability{}
+	r.GetPatchedVersions()
+	r = nil
+	r.GetPatchedVersions()
+}
+
+func TestRepositorySecurityAdvisoryVulnerability_GetVulnerableVersionRange(tt *testing.T) {
+	var zeroValue string
+	r := &RepositorySecurityAdvisoryVulnerability{VulnerableVersionRange: &zeroValue}
+	r.GetVulnerableVersionRange()
+	r = &RepositorySecurityAdvisoryVulnerability{}
+	r.GetVulnerableVersionRange()
+	r = nil
+	r.GetVulnerableVersionRange()
+}
+
 func TestRepositoryTag_GetCommit(tt *testing.T) {
 	r := &RepositoryTag{}
 	r.GetCommit()
@@ -23438,6 +25969,13 @@ func TestRepositoryTag_GetName(tt *testing.T) {
 	r.GetName()
 }
 
+func TestRepositoryTag_GetTag(tt *testing.T) {
+	r := &RepositoryTag{}
+	r.GetTag()
+	r = nil
+	r.GetTag()
+}
+
 func TestRepositoryTag_GetTarballURL(tt *testing.T) {
 	var zeroValue string
 	r := &RepositoryTag{TarballURL: &zeroValue}
@@ -24111,6 +26649,27 @@ func TestRuleset_GetTarget(tt *testing.T) {
 	r.GetTarget()
 }
 
+func TestRulesetChangeSummary_GetRuleset(tt *testing.T) {
+	r := &RulesetChangeSummary{}
+	r.GetRuleset()
+	r = nil
+	r.GetRuleset()
+}
+
+func TestRulesetConditions_GetOrganizationID(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetOrganizationID()
+	r = nil
+	r.GetOrganizationID()
+}
+
+func TestRulesetConditions_GetOrganizationName(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetOrganizationName()
+	r = nil
+	r.GetOrganizationName()
+}
+
 func TestRulesetConditions_GetRefName(tt *testing.T) {
 	r := &RulesetConditions{}
 	r.GetRefName()
@@ -24132,6 +26691,13 @@ func TestRulesetConditions_GetRepositoryName(tt *testing.T) {
 	r.GetRepositoryName()
 }
 
+func TestRulesetConditions_GetRepositoryProperty(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRepositoryProperty()
+	r = nil
+	r.GetRepositoryProperty()
+}
+
 func TestRulesetLink_GetHRef(tt *testing.T) {
 	var zeroValue string
 	r := &RulesetLink{HRef: &zeroValue}
@@ -24159,6 +26725,16 @@ func TestRulesetRepositoryNamesConditionParameters_GetProtected(tt *testing.T) {
 	r.GetProtected()
 }
 
+func TestRulesetRepositoryPropertyTargetParameters_GetSource(tt *testing.T) {
+	var zeroValue string
+	r := &RulesetRepositoryPropertyTargetParameters{Source: &zeroValue}
+	r.GetSource()
+	r = &RulesetRepositoryPropertyTargetParameters{}
+	r.GetSource()
+	r = nil
+	r.GetSource()
+}
+
 func TestRunner_GetBusy(tt *testing.T) {
 	var zeroValue bool
 	r := &Runner{Busy: &zeroValue}
@@ -25194,17 +27770,229 @@ func TestSecretScanningAlertLocationDetails_GetStartline(tt *testing.T) {
 	s = &SecretScanningAlertLocationDetails{}
 	s.GetStartline()
 	s = nil
-	s.GetStartline()
+	s.GetStartline()
+}
+
+func TestSecretScanningAlertLocationEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SecretScanningAlertLocationEvent{}
+	s.GetAction()
+	s = nil
+	s.GetAction()
+}
+
+func TestSecretScanningAlertLocationEvent_GetAlert(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetAlert()
+	s = nil
+	s.GetAlert()
+}
+
+func TestSecretScanningAlertLocationEvent_GetInstallation(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetInstallation()
+	s = nil
+	s.GetInstallation()
+}
+
+func TestSecretScanningAlertLocationEvent_GetLocation(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetLocation()
+	s = nil
+	s.GetLocation()
+}
+
+func TestSecretScanningAlertLocationEvent_GetOrganization(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetOrganization()
+	s = nil
+	s.GetOrganization()
+}
+
+func TestSecretScanningAlertLocationEvent_GetRepo(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetRepo()
+	s = nil
+	s.GetRepo()
+}
+
+func TestSecretScanningAlertLocationEvent_GetSender(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
+}
+
+func TestSecretScanningAlertUpdateOptions_GetResolution(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertUpdateOptions{Resolution: &zeroValue}
+	s.GetResolution()
+	s = &SecretScanningAlertUpdateOptions{}
+	s.GetResolution()
+	s = nil
+	s.GetResolution()
+}
+
+func TestSecretScanningPatternConfigs_GetPatternConfigVersion(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternConfigs{PatternConfigVersion: &zeroValue}
+	s.GetPatternConfigVersion()
+	s = &SecretScanningPatternConfigs{}
+	s.GetPatternConfigVersion()
+	s = nil
+	s.GetPatternConfigVersion()
+}
+
+func TestSecretScanningPatternConfigsUpdate_GetPatternConfigVersion(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternConfigsUpdate{PatternConfigVersion: &zeroValue}
+	s.GetPatternConfigVersion()
+	s = &SecretScanningPatternConfigsUpdate{}
+	s.GetPatternConfigVersion()
+	s = nil
+	s.GetPatternConfigVersion()
+}
+
+func TestSecretScanningPatternConfigsUpdateOptions_GetPatternConfigVersion(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternConfigsUpdateOptions{PatternConfigVersion: &zeroValue}
+	s.GetPatternConfigVersion()
+	s = &SecretScanningPatternConfigsUpdateOptions{}
+	s.GetPatternConfigVersion()
+	s = nil
+	s.GetPatternConfigVersion()
+}
+
+func TestSecretScanningPatternOverride_GetAlertTotal(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningPatternOverride{AlertTotal: &zeroValue}
+	s.GetAlertTotal()
+	s = &SecretScanningPatternOverride{}
+	s.GetAlertTotal()
+	s = nil
+	s.GetAlertTotal()
+}
+
+func TestSecretScanningPatternOverride_GetAlertTotalPercentage(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningPatternOverride{AlertTotalPercentage: &zeroValue}
+	s.GetAlertTotalPercentage()
+	s = &SecretScanningPatternOverride{}
+	s.GetAlertTotalPercentage()
+	s = nil
+	s.GetAlertTotalPercentage()
+}
+
+func TestSecretScanningPatternOverride_GetBypassRate(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningPatternOverride{BypassRate: &zeroValue}
+	s.GetBypassRate()
+	s = &SecretScanningPatternOverride{}
+	s.GetBypassRate()
+	s = nil
+	s.GetBypassRate()
+}
+
+func TestSecretScanningPatternOverride_GetCustomPatternVersion(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternOverride{CustomPatternVersion: &zeroValue}
+	s.GetCustomPatternVersion()
+	s = &SecretScanningPatternOverride{}
+	s.GetCustomPatternVersion()
+	s = nil
+	s.GetCustomPatternVersion()
+}
+
+func TestSecretScanningPatternOverride_GetDefaultSetting(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternOverride{DefaultSetting: &zeroValue}
+	s.GetDefaultSetting()
+	s = &SecretScanningPatternOverride{}
+	s.GetDefaultSetting()
+	s = nil
+	s.GetDefaultSetting()
+}
+
+func TestSecretScanningPatternOverride_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternOverride{DisplayName: &zeroValue}
+	s.GetDisplayName()
+	s = &SecretScanningPatternOverride{}
+	s.GetDisplayName()
+	s = nil
+	s.GetDisplayName()
+}
+
+func TestSecretScanningPatternOverride_GetEnterpriseSetting(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternOverride{EnterpriseSetting: &zeroValue}
+	s.GetEnterpriseSetting()
+	s = &SecretScanningPatternOverride{}
+	s.GetEnterpriseSetting()
+	s = nil
+	s.GetEnterpriseSetting()
+}
+
+func TestSecretScanningPatternOverride_GetFalsePositiveRate(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningPatternOverride{FalsePositiveRate: &zeroValue}
+	s.GetFalsePositiveRate()
+	s = &SecretScanningPatternOverride{}
+	s.GetFalsePositiveRate()
+	s = nil
+	s.GetFalsePositiveRate()
+}
+
+func TestSecretScanningPatternOverride_GetFalsePositives(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningPatternOverride{FalsePositives: &zeroValue}
+	s.GetFalsePositives()
+	s = &SecretScanningPatternOverride{}
+	s.GetFalsePositives()
+	s = nil
+	s.GetFalsePositives()
+}
+
+func TestSecretScanningPatternOverride_GetSetting(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternOverride{Setting: &zeroValue}
+	s.GetSetting()
+	s = &SecretScanningPatternOverride{}
+	s.GetSetting()
+	s = nil
+	s.GetSetting()
+}
+
+func TestSecretScanningPatternOverride_GetSlug(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternOverride{Slug: &zeroValue}
+	s.GetSlug()
+	s = &SecretScanningPatternOverride{}
+	s.GetSlug()
+	s = nil
+	s.GetSlug()
 }
 
-func TestSecretScanningAlertUpdateOptions_GetResolution(tt *testing.T) {
+func TestSecretScanningPatternOverride_GetTokenType(tt *testing.T) {
 	var zeroValue string
-	s := &SecretScanningAlertUpdateOptions{Resolution: &zeroValue}
-	s.GetResolution()
-	s = &SecretScanningAlertUpdateOptions{}
-	s.GetResolution()
+	s := &SecretScanningPatternOverride{TokenType: &zeroValue}
+	s.GetTokenType()
+	s = &SecretScanningPatternOverride{}
+	s.GetTokenType()
 	s = nil
-	s.GetResolution()
+	s.GetTokenType()
+}
+
+func TestSecretScanningPatternSetting_GetCustomPatternVersion(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningPatternSetting{CustomPatternVersion: &zeroValue}
+	s.GetCustomPatternVersion()
+	s = &SecretScanningPatternSetting{}
+	s.GetCustomPatternVersion()
+	s = nil
+	s.GetCustomPatternVersion()
 }
 
 func TestSecretScanningPushProtection_GetStatus(tt *testing.T) {
@@ -25271,6 +28059,13 @@ func TestSecurityAdvisory_GetCVSS(tt *testing.T) {
 	s.GetCVSS()
 }
 
+func TestSecurityAdvisory_GetCVSSSeverities(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetCVSSSeverities()
+	s = nil
+	s.GetCVSSSeverities()
+}
+
 func TestSecurityAdvisory_GetDescription(tt *testing.T) {
 	var zeroValue string
 	s := &SecurityAdvisory{Description: &zeroValue}
@@ -25625,6 +28420,36 @@ func TestSignatureVerification_GetVerified(tt *testing.T) {
 	s.GetVerified()
 }
 
+func TestSignatureVerification_GetVerifiedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SignatureVerification{VerifiedAt: &zeroValue}
+	s.GetVerifiedAt()
+	s = &SignatureVerification{}
+	s.GetVerifiedAt()
+	s = nil
+	s.GetVerifiedAt()
+}
+
+func TestSocialAccount_GetProvider(tt *testing.T) {
+	var zeroValue string
+	s := &SocialAccount{Provider: &zeroValue}
+	s.GetProvider()
+	s = &SocialAccount{}
+	s.GetProvider()
+	s = nil
+	s.GetProvider()
+}
+
+func TestSocialAccount_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SocialAccount{URL: &zeroValue}
+	s.GetURL()
+	s = &SocialAccount{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
 func TestSource_GetActor(tt *testing.T) {
 	s := &Source{}
 	s.GetActor()
@@ -25986,6 +28811,112 @@ func TestStatusEvent_GetUpdatedAt(tt *testing.T) {
 	s.GetUpdatedAt()
 }
 
+func TestStringChange_GetFrom(tt *testing.T) {
+	var zeroValue string
+	s := &StringChange{From: &zeroValue}
+	s.GetFrom()
+	s = &StringChange{}
+	s.GetFrom()
+	s = nil
+	s.GetFrom()
+}
+
+func TestStringChange_GetTo(tt *testing.T) {
+	var zeroValue string
+	s := &StringChange{To: &zeroValue}
+	s.GetTo()
+	s = &StringChange{}
+	s.GetTo()
+	s = nil
+	s.GetTo()
+}
+
+func TestSubIssuesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	s := &SubIssuesEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SubIssuesEvent{}
+	s.GetAction()
+	s = nil
+	s.GetAction()
+}
+
+func TestSubIssuesEvent_GetInstallation(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetInstallation()
+	s = nil
+	s.GetInstallation()
+}
+
+func TestSubIssuesEvent_GetOrg(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetOrg()
+	s = nil
+	s.GetOrg()
+}
+
+func TestSubIssuesEvent_GetParentIssue(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetParentIssue()
+	s = nil
+	s.GetParentIssue()
+}
+
+func TestSubIssuesEvent_GetParentIssueID(tt *testing.T) {
+	var zeroValue int64
+	s := &SubIssuesEvent{ParentIssueID: &zeroValue}
+	s.GetParentIssueID()
+	s = &SubIssuesEvent{}
+	s.GetParentIssueID()
+	s = nil
+	s.GetParentIssueID()
+}
+
+func TestSubIssuesEvent_GetParentIssueRepo(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetParentIssueRepo()
+	s = nil
+	s.GetParentIssueRepo()
+}
+
+func TestSubIssuesEvent_GetRepo(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetRepo()
+	s = nil
+	s.GetRepo()
+}
+
+func TestSubIssuesEvent_GetSender(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
+}
+
+func TestSubIssuesEvent_GetSubIssue(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetSubIssue()
+	s = nil
+	s.GetSubIssue()
+}
+
+func TestSubIssuesEvent_GetSubIssueID(tt *testing.T) {
+	var zeroValue int64
+	s := &SubIssuesEvent{SubIssueID: &zeroValue}
+	s.GetSubIssueID()
+	s = &SubIssuesEvent{}
+	s.GetSubIssueID()
+	s = nil
+	s.GetSubIssueID()
+}
+
+func TestSubIssuesEvent_GetSubIssueRepo(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetSubIssueRepo()
+	s = nil
+	s.GetSubIssueRepo()
+}
+
 func TestSubscription_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	s := &Subscription{CreatedAt: &zeroValue}
@@ -27673,6 +30604,56 @@ func TestUpdateEnterpriseRunnerGroupRequest_GetVisibility(tt *testing.T) {
 	u.GetVisibility()
 }
 
+func TestUpdateHostedRunnerRequest_GetEnableStaticIP(tt *testing.T) {
+	var zeroValue bool
+	u := &UpdateHostedRunnerRequest{EnableStaticIP: &zeroValue}
+	u.GetEnableStaticIP()
+	u = &UpdateHostedRunnerRequest{}
+	u.GetEnableStaticIP()
+	u = nil
+	u.GetEnableStaticIP()
+}
+
+func TestUpdateHostedRunnerRequest_GetImageVersion(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateHostedRunnerRequest{ImageVersion: &zeroValue}
+	u.GetImageVersion()
+	u = &UpdateHostedRunnerRequest{}
+	u.GetImageVersion()
+	u = nil
+	u.GetImageVersion()
+}
+
+func TestUpdateHostedRunnerRequest_GetMaximumRunners(tt *testing.T) {
+	var zeroValue int64
+	u := &UpdateHostedRunnerRequest{MaximumRunners: &zeroValue}
+	u.GetMaximumRunners()
+	u = &UpdateHostedRunnerRequest{}
+	u.GetMaximumRunners()
+	u = nil
+	u.GetMaximumRunners()
+}
+
+func TestUpdateHostedRunnerRequest_GetName(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateHostedRunnerRequest{Name: &zeroValue}
+	u.GetName()
+	u = &UpdateHostedRunnerRequest{}
+	u.GetName()
+	u = nil
+	u.GetName()
+}
+
+func TestUpdateHostedRunnerRequest_GetRunnerGroupID(tt *testing.T) {
+	var zeroValue int64
+	u := &UpdateHostedRunnerRequest{RunnerGroupID: &zeroValue}
+	u.GetRunnerGroupID()
+	u = &UpdateHostedRunnerRequest{}
+	u.GetRunnerGroupID()
+	u = nil
+	u.GetRunnerGroupID()
+}
+
 func TestUpdateRunnerGroupRequest_GetAllowsPublicRepositories(tt *testing.T) {
 	var zeroValue bool
 	u := &UpdateRunnerGroupRequest{AllowsPublicRepositories: &zeroValue}
@@ -27713,6 +30694,141 @@ func TestUpdateRunnerGroupRequest_GetVisibility(tt *testing.T) {
 	u.GetVisibility()
 }
 
+func TestUsageItem_GetDate(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{Date: &zeroValue}
+	u.GetDate()
+	u = &UsageItem{}
+	u.GetDate()
+	u = nil
+	u.GetDate()
+}
+
+func TestUsageItem_GetDiscountAmount(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetDiscountAmount()
+	u = nil
+	u.GetDiscountAmount()
+}
+
+func TestUsageItem_GetGrossAmount(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetGrossAmount()
+	u = nil
+	u.GetGrossAmount()
+}
+
+func TestUsageItem_GetNetAmount(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetNetAmount()
+	u = nil
+	u.GetNetAmount()
+}
+
+func TestUsageItem_GetOrganizationName(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{OrganizationName: &zeroValue}
+	u.GetOrganizationName()
+	u = &UsageItem{}
+	u.GetOrganizationName()
+	u = nil
+	u.GetOrganizationName()
+}
+
+func TestUsageItem_GetPricePerUnit(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetPricePerUnit()
+	u = nil
+	u.GetPricePerUnit()
+}
+
+func TestUsageItem_GetProduct(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{Product: &zeroValue}
+	u.GetProduct()
+	u = &UsageItem{}
+	u.GetProduct()
+	u = nil
+	u.GetProduct()
+}
+
+func TestUsageItem_GetQuantity(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetQuantity()
+	u = nil
+	u.GetQuantity()
+}
+
+func TestUsageItem_GetRepositoryName(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{RepositoryName: &zeroValue}
+	u.GetRepositoryName()
+	u = &UsageItem{}
+	u.GetRepositoryName()
+	u = nil
+	u.GetRepositoryName()
+}
+
+func TestUsageItem_GetSKU(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{SKU: &zeroValue}
+	u.GetSKU()
+	u = &UsageItem{}
+	u.GetSKU()
+	u = nil
+	u.GetSKU()
+}
+
+func TestUsageItem_GetUnitType(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{UnitType: &zeroValue}
+	u.GetUnitType()
+	u = &UsageItem{}
+	u.GetUnitType()
+	u = nil
+	u.GetUnitType()
+}
+
+func TestUsageReportOptions_GetDay(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Day: &zeroValue}
+	u.GetDay()
+	u = &UsageReportOptions{}
+	u.GetDay()
+	u = nil
+	u.GetDay()
+}
+
+func TestUsageReportOptions_GetHour(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Hour: &zeroValue}
+	u.GetHour()
+	u = &UsageReportOptions{}
+	u.GetHour()
+	u = nil
+	u.GetHour()
+}
+
+func TestUsageReportOptions_GetMonth(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Month: &zeroValue}
+	u.GetMonth()
+	u = &UsageReportOptions{}
+	u.GetMonth()
+	u = nil
+	u.GetMonth()
+}
+
+func TestUsageReportOptions_GetYear(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Year: &zeroValue}
+	u.GetYear()
+	u = &UsageReportOptions{}
+	u.GetYear()
+	u = nil
+	u.GetYear()
+}
+
 func TestUser_GetAvatarURL(tt *testing.T) {
 	var zeroValue string
 	u := &User{AvatarURL: &zeroValue}
@@ -28515,6 +31631,126 @@ func TestUserLDAPMapping_GetURL(tt *testing.T) {
 	u.GetURL()
 }
 
+func TestUserLicense_GetEnterpriseServerUser(tt *testing.T) {
+	var zeroValue bool
+	u := &UserLicense{EnterpriseServerUser: &zeroValue}
+	u.GetEnterpriseServerUser()
+	u = &UserLicense{}
+	u.GetEnterpriseServerUser()
+	u = nil
+	u.GetEnterpriseServerUser()
+}
+
+func TestUserLicense_GetGitHubComLogin(tt *testing.T) {
+	var zeroValue string
+	u := &UserLicense{GitHubComLogin: &zeroValue}
+	u.GetGitHubComLogin()
+	u = &UserLicense{}
+	u.GetGitHubComLogin()
+	u = nil
+	u.GetGitHubComLogin()
+}
+
+func TestUserLicense_GetGitHubComName(tt *testing.T) {
+	var zeroValue string
+	u := &UserLicense{GitHubComName: &zeroValue}
+	u.GetGitHubComName()
+	u = &UserLicense{}
+	u.GetGitHubComName()
+	u = nil
+	u.GetGitHubComName()
+}
+
+func TestUserLicense_GetGitHubComProfile(tt *testing.T) {
+	var zeroValue string
+	u := &UserLicense{GitHubComProfile: &zeroValue}
+	u.GetGitHubComProfile()
+	u = &UserLicense{}
+	u.GetGitHubComProfile()
+	u = nil
+	u.GetGitHubComProfile()
+}
+
+func TestUserLicense_GetGitHubComSAMLNameID(tt *testing.T) {
+	var zeroValue string
+	u := &UserLicense{GitHubComSAMLNameID: &zeroValue}
+	u.GetGitHubComSAMLNameID()
+	u = &UserLicense{}
+	u.GetGitHubComSAMLNameID()
+	u = nil
+	u.GetGitHubComSAMLNameID()
+}
+
+func TestUserLicense_GetGitHubComTwoFactorAuth(tt *testing.T) {
+	var zeroValue bool
+	u := &UserLicense{GitHubComTwoFactorAuth: &zeroValue}
+	u.GetGitHubComTwoFactorAuth()
+	u = &UserLicense{}
+	u.GetGitHubComTwoFactorAuth()
+	u = nil
+	u.GetGitHubComTwoFactorAuth()
+}
+
+func TestUserLicense_GetGitHubComUser(tt *testing.T) {
+	var zeroValue bool
+	u := &UserLicense{GitHubComUser: &zeroValue}
+	u.GetGitHubComUser()
+	u = &UserLicense{}
+	u.GetGitHubComUser()
+	u = nil
+	u.GetGitHubComUser()
+}
+
+func TestUserLicense_GetLicenseType(tt *testing.T) {
+	var zeroValue string
+	u := &UserLicense{LicenseType: &zeroValue}
+	u.GetLicenseType()
+	u = &UserLicense{}
+	u.GetLicenseType()
+	u = nil
+	u.GetLicenseType()
+}
+
+func TestUserLicense_GetTotalUserAccounts(tt *testing.T) {
+	var zeroValue int
+	u := &UserLicense{TotalUserAccounts: &zeroValue}
+	u.GetTotalUserAccounts()
+	u = &UserLicense{}
+	u.GetTotalUserAccounts()
+	u = nil
+	u.GetTotalUserAccounts()
+}
+
+func TestUserLicense_GetVisualStudioLicenseStatus(tt *testing.T) {
+	var zeroValue string
+	u := &UserLicense{VisualStudioLicenseStatus: &zeroValue}
+	u.GetVisualStudioLicenseStatus()
+	u = &UserLicense{}
+	u.GetVisualStudioLicenseStatus()
+	u = nil
+	u.GetVisualStudioLicenseStatus()
+}
+
+func TestUserLicense_GetVisualStudioSubscriptionEmail(tt *testing.T) {
+	var zeroValue string
+	u := &UserLicense{VisualStudioSubscriptionEmail: &zeroValue}
+	u.GetVisualStudioSubscriptionEmail()
+	u = &UserLicense{}
+	u.GetVisualStudioSubscriptionEmail()
+	u = nil
+	u.GetVisualStudioSubscriptionEmail()
+}
+
+func TestUserLicense_GetVisualStudioSubscriptionUser(tt *testing.T) {
+	var zeroValue bool
+	u := &UserLicense{VisualStudioSubscriptionUser: &zeroValue}
+	u.GetVisualStudioSubscriptionUser()
+	u = &UserLicense{}
+	u.GetVisualStudioSubscriptionUser()
+	u = nil
+	u.GetVisualStudioSubscriptionUser()
+}
+
 func TestUserMigration_GetCreatedAt(tt *testing.T) {
 	var zeroValue string
 	u := &UserMigration{CreatedAt: &zeroValue}
@@ -28535,6 +31771,36 @@ func TestUserMigration_GetExcludeAttachments(tt *testing.T) {
 	u.GetExcludeAttachments()
 }
 
+func TestUserMigration_GetExcludeGitData(tt *testing.T) {
+	var zeroValue bool
+	u := &UserMigration{ExcludeGitData: &zeroValue}
+	u.GetExcludeGitData()
+	u = &UserMigration{}
+	u.GetExcludeGitData()
+	u = nil
+	u.GetExcludeGitData()
+}
+
+func TestUserMigration_GetExcludeOwnerProjects(tt *testing.T) {
+	var zeroValue bool
+	u := &UserMigration{ExcludeOwnerProjects: &zeroValue}
+	u.GetExcludeOwnerProjects()
+	u = &UserMigration{}
+	u.GetExcludeOwnerProjects()
+	u = nil
+	u.GetExcludeOwnerProjects()
+}
+
+func TestUserMigration_GetExcludeReleases(tt *testing.T) {
+	var zeroValue bool
+	u := &UserMigration{ExcludeReleases: &zeroValue}
+	u.GetExcludeReleases()
+	u = &UserMigration{}
+	u.GetExcludeReleases()
+	u = nil
+	u.GetExcludeReleases()
+}
+
 func TestUserMigration_GetGUID(tt *testing.T) {
 	var zeroValue string
 	u := &UserMigration{GUID: &zeroValue}
@@ -28565,6 +31831,16 @@ func TestUserMigration_GetLockRepositories(tt *testing.T) {
 	u.GetLockRepositories()
 }
 
+func TestUserMigration_GetOrgMetadataOnly(tt *testing.T) {
+	var zeroValue bool
+	u := &UserMigration{OrgMetadataOnly: &zeroValue}
+	u.GetOrgMetadataOnly()
+	u = &UserMigration{}
+	u.GetOrgMetadataOnly()
+	u = nil
+	u.GetOrgMetadataOnly()
+}
+
 func TestUserMigration_GetState(tt *testing.T) {
 	var zeroValue string
 	u := &UserMigration{State: &zeroValue}
@@ -28713,6 +31989,36 @@ func TestWatchEvent_GetSender(tt *testing.T) {
 	w.GetSender()
 }
 
+func TestWeeklyCodeFrequency_GetAdditions(tt *testing.T) {
+	var zeroValue int
+	w := &WeeklyCodeFrequency{Additions: &zeroValue}
+	w.GetAdditions()
+	w = &WeeklyCodeFrequency{}
+	w.GetAdditions()
+	w = nil
+	w.GetAdditions()
+}
+
+func TestWeeklyCodeFrequency_GetDeletions(tt *testing.T) {
+	var zeroValue int
+	w := &WeeklyCodeFrequency{Deletions: &zeroValue}
+	w.GetDeletions()
+	w = &WeeklyCodeFrequency{}
+	w.GetDeletions()
+	w = nil
+	w.GetDeletions()
+}
+
+func TestWeeklyCodeFrequency_GetWeek(tt *testing.T) {
+	var zeroValue Timestamp
+	w := &WeeklyCodeFrequency{Week: &zeroValue}
+	w.GetWeek()
+	w = &WeeklyCodeFrequency{}
+	w.GetWeek()
+	w = nil
+	w.GetWeek()
+}
+
 func TestWeeklyCommitActivity_GetTotal(tt *testing.T) {
 	var zeroValue int
 	w := &WeeklyCommitActivity{Total: &zeroValue}
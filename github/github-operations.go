@@ -0,0 +1,988 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-operations; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package github
+
+var operations = []Operation{
+	{Method: "PATCH", Path: "/admin/ldap/teams/{team_id}/mapping", GoMethods: []string{"AdminService.UpdateTeamLDAPMapping"}},
+	{Method: "PATCH", Path: "/admin/ldap/users/{username}/mapping", GoMethods: []string{"AdminService.UpdateUserLDAPMapping"}},
+	{Method: "POST", Path: "/admin/organizations", GoMethods: []string{"AdminService.CreateOrg"}},
+	{Method: "PATCH", Path: "/admin/organizations/{org}", GoMethods: []string{"AdminService.RenameOrg", "AdminService.RenameOrgByName"}},
+	{Method: "POST", Path: "/admin/users", GoMethods: []string{"AdminService.CreateUser"}},
+	{Method: "DELETE", Path: "/admin/users/{username}", GoMethods: []string{"AdminService.DeleteUser"}},
+	{Method: "DELETE", Path: "/admin/users/{username}/authorizations", GoMethods: []string{"AdminService.DeleteUserImpersonation", "AuthorizationsService.DeleteImpersonation"}},
+	{Method: "POST", Path: "/admin/users/{username}/authorizations", GoMethods: []string{"AdminService.CreateUserImpersonation", "AuthorizationsService.CreateImpersonation"}},
+	{Method: "GET", Path: "/advisories", GoMethods: []string{"SecurityAdvisoriesService.FindAdvisoriesForPackage", "SecurityAdvisoriesService.ListGlobalSecurityAdvisories"}},
+	{Method: "GET", Path: "/advisories/{ghsa_id}", GoMethods: []string{"SecurityAdvisoriesService.GetGlobalSecurityAdvisories"}},
+	{Method: "GET", Path: "/app", GoMethods: []string{"AppsService.Get"}},
+	{Method: "POST", Path: "/app-manifests/{code}/conversions", GoMethods: []string{"AppsService.CompleteAppManifest"}},
+	{Method: "GET", Path: "/app/hook/config", GoMethods: []string{"AppsService.GetHookConfig"}},
+	{Method: "PATCH", Path: "/app/hook/config", GoMethods: []string{"AppsService.UpdateHookConfig"}},
+	{Method: "GET", Path: "/app/hook/deliveries", GoMethods: []string{"AppsService.ListHookDeliveries"}},
+	{Method: "GET", Path: "/app/hook/deliveries/{delivery_id}", GoMethods: []string{"AppsService.GetHookDelivery"}},
+	{Method: "POST", Path: "/app/hook/deliveries/{delivery_id}/attempts", GoMethods: []string{"AppsService.RedeliverHookDelivery"}},
+	{Method: "GET", Path: "/app/installation-requests", GoMethods: []string{"AppsService.ListInstallationRequests"}},
+	{Method: "GET", Path: "/app/installations", GoMethods: []string{"AppsService.ListInstallations", "AppsService.ListInstallationsWithOptions"}},
+	{Method: "DELETE", Path: "/app/installations/{installation_id}", GoMethods: []string{"AppsService.DeleteInstallation"}},
+	{Method: "GET", Path: "/app/installations/{installation_id}", GoMethods: []string{"AppsService.GetInstallation"}},
+	{Method: "POST", Path: "/app/installations/{installation_id}/access_tokens", GoMethods: []string{"AppsService.CreateInstallationToken", "AppsService.CreateInstallationTokenListRepos", "AppsService.CreateRepositoryCloneToken"}},
+	{Method: "DELETE", Path: "/app/installations/{installation_id}/suspended", GoMethods: []string{"AppsService.UnsuspendInstallation"}},
+	{Method: "PUT", Path: "/app/installations/{installation_id}/suspended", GoMethods: []string{"AppsService.SuspendInstallation"}},
+	{Method: "DELETE", Path: "/applications/{client_id}/grant", GoMethods: []string{"AuthorizationsService.DeleteGrant"}},
+	{Method: "DELETE", Path: "/applications/{client_id}/token", GoMethods: []string{"AuthorizationsService.Revoke"}},
+	{Method: "PATCH", Path: "/applications/{client_id}/token", GoMethods: []string{"AuthorizationsService.Reset"}},
+	{Method: "POST", Path: "/applications/{client_id}/token", GoMethods: []string{"AuthorizationsService.Check"}},
+	{Method: "GET", Path: "/apps/{app_slug}", GoMethods: []string{"AppsService.Get"}},
+	{Method: "GET", Path: "/codes_of_conduct", GoMethods: []string{"CodesOfConductService.List"}},
+	{Method: "GET", Path: "/codes_of_conduct/{key}", GoMethods: []string{"CodesOfConductService.Get"}},
+	{Method: "GET", Path: "/emojis", GoMethods: []string{"EmojisService.List"}},
+	{Method: "GET", Path: "/enterprise/stats/all", GoMethods: []string{"AdminService.GetAdminStats"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/cache/usage", GoMethods: []string{"ActionsService.GetTotalCacheUsageForEnterprise"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions", GoMethods: []string{"ActionsService.GetActionsPermissionsInEnterprise"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions", GoMethods: []string{"ActionsService.EditActionsPermissionsInEnterprise"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/organizations", GoMethods: []string{"ActionsService.ListEnabledOrgsInEnterprise"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/organizations", GoMethods: []string{"ActionsService.SetEnabledOrgsInEnterprise"}},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/permissions/organizations/{org_id}", GoMethods: []string{"ActionsService.RemoveEnabledOrgInEnterprise"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/organizations/{org_id}", GoMethods: []string{"ActionsService.AddEnabledOrgInEnterprise"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/selected-actions", GoMethods: []string{"ActionsService.GetActionsAllowedInEnterprise"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/selected-actions", GoMethods: []string{"ActionsService.EditActionsAllowedInEnterprise"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/workflow", GoMethods: []string{"ActionsService.GetDefaultWorkflowPermissionsInEnterprise"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/workflow", GoMethods: []string{"ActionsService.EditDefaultWorkflowPermissionsInEnterprise"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runner-groups", GoMethods: []string{"EnterpriseService.ListRunnerGroups"}},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/runner-groups", GoMethods: []string{"EnterpriseService.CreateEnterpriseRunnerGroup"}},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}", GoMethods: []string{"EnterpriseService.DeleteEnterpriseRunnerGroup"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}", GoMethods: []string{"EnterpriseService.GetEnterpriseRunnerGroup"}},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}", GoMethods: []string{"EnterpriseService.UpdateEnterpriseRunnerGroup"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations", GoMethods: []string{"EnterpriseService.ListOrganizationAccessRunnerGroup"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations", GoMethods: []string{"EnterpriseService.SetOrganizationAccessRunnerGroup"}},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations/{org_id}", GoMethods: []string{"EnterpriseService.RemoveOrganizationAccessRunnerGroup"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations/{org_id}", GoMethods: []string{"EnterpriseService.AddOrganizationAccessRunnerGroup"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners", GoMethods: []string{"EnterpriseService.ListRunnerGroupRunners"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners", GoMethods: []string{"EnterpriseService.SetRunnerGroupRunners"}},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners/{runner_id}", GoMethods: []string{"EnterpriseService.RemoveRunnerGroupRunners"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners/{runner_id}", GoMethods: []string{"EnterpriseService.AddRunnerGroupRunners"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runners", GoMethods: []string{"EnterpriseService.ListRunners"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runners/downloads", GoMethods: []string{"EnterpriseService.ListRunnerApplicationDownloads"}},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/runners/generate-jitconfig", GoMethods: []string{"EnterpriseService.GenerateEnterpriseJITConfig"}},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/runners/registration-token", GoMethods: []string{"EnterpriseService.CreateRegistrationToken"}},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/runners/remove-token", GoMethods: []string{"EnterpriseService.CreateRemoveToken"}},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runners/{runner_id}", GoMethods: []string{"EnterpriseService.RemoveRunner"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runners/{runner_id}", GoMethods: []string{"EnterpriseService.GetRunner"}},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/announcement", GoMethods: []string{"EnterpriseService.RemoveAnnouncement"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/announcement", GoMethods: []string{"EnterpriseService.GetAnnouncement"}},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/announcement", GoMethods: []string{"EnterpriseService.SetAnnouncement"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/audit-log", GoMethods: []string{"EnterpriseService.GetAuditLog"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/code_security_and_analysis", GoMethods: []string{"EnterpriseService.GetCodeSecurityAndAnalysis"}},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/code_security_and_analysis", GoMethods: []string{"EnterpriseService.UpdateCodeSecurityAndAnalysis"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/consumed-licenses", GoMethods: []string{"EnterpriseService.GetConsumedLicenses"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/license-sync-status", GoMethods: []string{"EnterpriseService.GetLicenseSyncStatus"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/network-configurations", GoMethods: []string{"EnterpriseService.ListNetworkConfigurations"}},
+	{Method: "POST", Path: "/enterprises/{enterprise}/network-configurations", GoMethods: []string{"EnterpriseService.CreateNetworkConfiguration"}},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/network-configurations/{network_configuration_id}", GoMethods: []string{"EnterpriseService.DeleteNetworkConfiguration"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/network-configurations/{network_configuration_id}", GoMethods: []string{"EnterpriseService.GetNetworkConfiguration"}},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/network-configurations/{network_configuration_id}", GoMethods: []string{"EnterpriseService.UpdateNetworkConfiguration"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/network-settings/{network_settings_id}", GoMethods: []string{"EnterpriseService.GetNetworkSettings"}},
+	{Method: "POST", Path: "/enterprises/{enterprise}/rulesets", GoMethods: []string{"EnterpriseService.CreateEnterpriseRuleset"}},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/rulesets/{ruleset_id}", GoMethods: []string{"EnterpriseService.DeleteEnterpriseRuleset"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/rulesets/{ruleset_id}", GoMethods: []string{"EnterpriseService.GetEnterpriseRuleset"}},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/rulesets/{ruleset_id}", GoMethods: []string{"EnterpriseService.UpdateEnterpriseRuleset"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/secret-scanning/alerts", GoMethods: []string{"SecretScanningService.ListAlertsForEnterprise"}},
+	{Method: "GET", Path: "/enterprises/{enterprise}/settings/billing/usage", GoMethods: []string{"BillingService.GetUsageReportEnterprise"}},
+	{Method: "POST", Path: "/enterprises/{enterprise}/{security_product}/{enablement}", GoMethods: []string{"EnterpriseService.EnableDisableSecurityFeature"}},
+	{Method: "GET", Path: "/events", GoMethods: []string{"ActivityService.ListEvents"}},
+	{Method: "GET", Path: "/feeds", GoMethods: []string{"ActivityService.ListFeeds"}},
+	{Method: "GET", Path: "/gists", GoMethods: []string{"GistsService.List"}},
+	{Method: "POST", Path: "/gists", GoMethods: []string{"GistsService.Create"}},
+	{Method: "GET", Path: "/gists/public", GoMethods: []string{"GistsService.ListAll"}},
+	{Method: "GET", Path: "/gists/starred", GoMethods: []string{"GistsService.ListStarred"}},
+	{Method: "DELETE", Path: "/gists/{gist_id}", GoMethods: []string{"GistsService.Delete"}},
+	{Method: "GET", Path: "/gists/{gist_id}", GoMethods: []string{"GistsService.FetchFullGistFile", "GistsService.Get"}},
+	{Method: "PATCH", Path: "/gists/{gist_id}", GoMethods: []string{"GistsService.Edit"}},
+	{Method: "GET", Path: "/gists/{gist_id}/comments", GoMethods: []string{"GistsService.ListComments"}},
+	{Method: "POST", Path: "/gists/{gist_id}/comments", GoMethods: []string{"GistsService.CreateComment"}},
+	{Method: "DELETE", Path: "/gists/{gist_id}/comments/{comment_id}", GoMethods: []string{"GistsService.DeleteComment"}},
+	{Method: "GET", Path: "/gists/{gist_id}/comments/{comment_id}", GoMethods: []string{"GistsService.GetComment"}},
+	{Method: "PATCH", Path: "/gists/{gist_id}/comments/{comment_id}", GoMethods: []string{"GistsService.EditComment"}},
+	{Method: "GET", Path: "/gists/{gist_id}/commits", GoMethods: []string{"GistsService.ListCommits"}},
+	{Method: "GET", Path: "/gists/{gist_id}/forks", GoMethods: []string{"GistsService.ListForks"}},
+	{Method: "POST", Path: "/gists/{gist_id}/forks", GoMethods: []string{"GistsService.Fork"}},
+	{Method: "DELETE", Path: "/gists/{gist_id}/star", GoMethods: []string{"GistsService.Unstar"}},
+	{Method: "GET", Path: "/gists/{gist_id}/star", GoMethods: []string{"GistsService.IsStarred"}},
+	{Method: "PUT", Path: "/gists/{gist_id}/star", GoMethods: []string{"GistsService.Star"}},
+	{Method: "GET", Path: "/gists/{gist_id}/{sha}", GoMethods: []string{"GistsService.GetRevision"}},
+	{Method: "GET", Path: "/gitignore/templates", GoMethods: []string{"GitignoresService.List"}},
+	{Method: "GET", Path: "/gitignore/templates/{name}", GoMethods: []string{"GitignoresService.Get", "GitignoresService.GetRaw"}},
+	{Method: "POST", Path: "/hub", GoMethods: []string{"RepositoriesService.Subscribe", "RepositoriesService.Unsubscribe"}},
+	{Method: "GET", Path: "/installation/repositories", GoMethods: []string{"AppsService.ListRepos"}},
+	{Method: "DELETE", Path: "/installation/token", GoMethods: []string{"AppsService.RevokeInstallationToken"}},
+	{Method: "GET", Path: "/issues", GoMethods: []string{"IssuesService.List"}},
+	{Method: "GET", Path: "/licenses", GoMethods: []string{"LicensesService.List"}},
+	{Method: "GET", Path: "/licenses/{license}", GoMethods: []string{"LicensesService.Get"}},
+	{Method: "POST", Path: "/markdown", GoMethods: []string{"MarkdownService.Render"}},
+	{Method: "POST", Path: "/markdown/raw", GoMethods: []string{"MarkdownService.RenderRaw"}},
+	{Method: "GET", Path: "/marketplace_listing/accounts/{account_id}", GoMethods: []string{"MarketplaceService.GetPlanAccountForAccount"}},
+	{Method: "GET", Path: "/marketplace_listing/plans", GoMethods: []string{"MarketplaceService.ListPlans"}},
+	{Method: "GET", Path: "/marketplace_listing/plans/{plan_id}/accounts", GoMethods: []string{"MarketplaceService.ListPlanAccountsForPlan"}},
+	{Method: "GET", Path: "/marketplace_listing/stubbed/accounts/{account_id}", GoMethods: []string{"MarketplaceService.GetPlanAccountForAccount"}},
+	{Method: "GET", Path: "/marketplace_listing/stubbed/plans", GoMethods: []string{"MarketplaceService.ListPlans"}},
+	{Method: "GET", Path: "/marketplace_listing/stubbed/plans/{plan_id}/accounts", GoMethods: []string{"MarketplaceService.ListPlanAccountsForPlan"}},
+	{Method: "GET", Path: "/meta", GoMethods: []string{"MetaService.Get"}},
+	{Method: "GET", Path: "/networks/{owner}/{repo}/events", GoMethods: []string{"ActivityService.ListEventsForRepoNetwork"}},
+	{Method: "GET", Path: "/notifications", GoMethods: []string{"ActivityService.ListNotifications", "ActivityService.ListNotificationsIfModified"}},
+	{Method: "PUT", Path: "/notifications", GoMethods: []string{"ActivityService.MarkNotificationsRead"}},
+	{Method: "DELETE", Path: "/notifications/threads/{thread_id}", GoMethods: []string{"ActivityService.MarkThreadDone"}},
+	{Method: "GET", Path: "/notifications/threads/{thread_id}", GoMethods: []string{"ActivityService.GetThread"}},
+	{Method: "PATCH", Path: "/notifications/threads/{thread_id}", GoMethods: []string{"ActivityService.MarkThreadRead"}},
+	{Method: "DELETE", Path: "/notifications/threads/{thread_id}/subscription", GoMethods: []string{"ActivityService.DeleteThreadSubscription"}},
+	{Method: "GET", Path: "/notifications/threads/{thread_id}/subscription", GoMethods: []string{"ActivityService.GetThreadSubscription"}},
+	{Method: "PUT", Path: "/notifications/threads/{thread_id}/subscription", GoMethods: []string{"ActivityService.SetThreadSubscription"}},
+	{Method: "GET", Path: "/octocat", GoMethods: []string{"MetaService.Octocat"}},
+	{Method: "GET", Path: "/organizations", GoMethods: []string{"OrganizationsService.ListAll"}},
+	{Method: "GET", Path: "/organizations/{organization_id}", GoMethods: []string{"OrganizationsService.GetByID"}},
+	{Method: "GET", Path: "/organizations/{org}/settings/billing/usage", GoMethods: []string{"BillingService.GetUsageReportOrg"}},
+	{Method: "DELETE", Path: "/orgs/{org}", GoMethods: []string{"OrganizationsService.Delete"}},
+	{Method: "GET", Path: "/orgs/{org}", GoMethods: []string{"OrganizationsService.Get", "OrganizationsService.GetProjectsPolicy"}},
+	{Method: "PATCH", Path: "/orgs/{org}", GoMethods: []string{"OrganizationsService.Edit", "OrganizationsService.UpdateProjectsPolicy"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/cache/usage", GoMethods: []string{"ActionsService.GetTotalCacheUsageForOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/cache/usage-by-repository", GoMethods: []string{"ActionsService.ListCacheUsageByRepoForOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners", GoMethods: []string{"ActionsService.ListHostedRunners"}},
+	{Method: "POST", Path: "/orgs/{org}/actions/hosted-runners", GoMethods: []string{"ActionsService.CreateHostedRunner"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/images/github-owned", GoMethods: []string{"ActionsService.ListHostedRunnerGitHubOwnedImages"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/images/partner", GoMethods: []string{"ActionsService.ListHostedRunnerPartnerImages"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/limits", GoMethods: []string{"ActionsService.CreateHostedRunner", "ActionsService.GetHostedRunnerLimits"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/machine-sizes", GoMethods: []string{"ActionsService.ListHostedRunnerMachineSpecs"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/platforms", GoMethods: []string{"ActionsService.ListHostedRunnerPlatforms"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/hosted-runners/{hosted_runner_id}", GoMethods: []string{"ActionsService.DeleteHostedRunner"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/{hosted_runner_id}", GoMethods: []string{"ActionsService.GetHostedRunner"}},
+	{Method: "PATCH", Path: "/orgs/{org}/actions/hosted-runners/{hosted_runner_id}", GoMethods: []string{"ActionsService.UpdateHostedRunner"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/oidc/customization/sub", GoMethods: []string{"ActionsService.GetOrgOIDCSubjectClaimCustomTemplate"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/oidc/customization/sub", GoMethods: []string{"ActionsService.SetOrgOIDCSubjectClaimCustomTemplate"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions", GoMethods: []string{"ActionsService.GetActionsPermissions", "OrganizationsService.GetActionsPermissions"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions", GoMethods: []string{"ActionsService.EditActionsPermissions", "OrganizationsService.EditActionsPermissions"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/repositories", GoMethods: []string{"ActionsService.ListEnabledReposInOrg"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/repositories", GoMethods: []string{"ActionsService.SetEnabledReposInOrg"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/permissions/repositories/{repository_id}", GoMethods: []string{"ActionsService.RemoveEnabledReposInOrg"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/repositories/{repository_id}", GoMethods: []string{"ActionsService.AddEnabledReposInOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/selected-actions", GoMethods: []string{"ActionsService.GetActionsAllowed", "OrganizationsService.GetActionsAllowed"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/selected-actions", GoMethods: []string{"ActionsService.EditActionsAllowed", "OrganizationsService.EditActionsAllowed"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/workflow", GoMethods: []string{"ActionsService.GetDefaultWorkflowPermissionsInOrganization"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/workflow", GoMethods: []string{"ActionsService.EditDefaultWorkflowPermissionsInOrganization"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/required_workflows", GoMethods: []string{"ActionsService.ListOrgRequiredWorkflows"}},
+	{Method: "POST", Path: "/orgs/{org}/actions/required_workflows", GoMethods: []string{"ActionsService.CreateRequiredWorkflow"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/required_workflows/{workflow_id}", GoMethods: []string{"ActionsService.DeleteRequiredWorkflow"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/required_workflows/{workflow_id}", GoMethods: []string{"ActionsService.GetRequiredWorkflowByID"}},
+	{Method: "PATCH", Path: "/orgs/{org}/actions/required_workflows/{workflow_id}", GoMethods: []string{"ActionsService.UpdateRequiredWorkflow"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/required_workflows/{workflow_id}/repositories", GoMethods: []string{"ActionsService.ListRequiredWorkflowSelectedRepos"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/required_workflows/{workflow_id}/repositories", GoMethods: []string{"ActionsService.SetRequiredWorkflowSelectedRepos"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/required_workflows/{workflow_id}/repositories/{repository_id}", GoMethods: []string{"ActionsService.RemoveRepoFromRequiredWorkflow"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/required_workflows/{workflow_id}/repositories/{repository_id}", GoMethods: []string{"ActionsService.AddRepoToRequiredWorkflow"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/runner-groups", GoMethods: []string{"ActionsService.ListOrganizationRunnerGroups"}},
+	{Method: "POST", Path: "/orgs/{org}/actions/runner-groups", GoMethods: []string{"ActionsService.CreateOrganizationRunnerGroup"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}", GoMethods: []string{"ActionsService.DeleteOrganizationRunnerGroup"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}", GoMethods: []string{"ActionsService.GetOrganizationRunnerGroup"}},
+	{Method: "PATCH", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}", GoMethods: []string{"ActionsService.UpdateOrganizationRunnerGroup"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/repositories", GoMethods: []string{"ActionsService.ListRepositoryAccessRunnerGroup"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/repositories", GoMethods: []string{"ActionsService.SetRepositoryAccessRunnerGroup"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/repositories/{repository_id}", GoMethods: []string{"ActionsService.RemoveRepositoryAccessRunnerGroup"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/repositories/{repository_id}", GoMethods: []string{"ActionsService.AddRepositoryAccessRunnerGroup"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/runners", GoMethods: []string{"ActionsService.ListRunnerGroupRunners"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/runners", GoMethods: []string{"ActionsService.SetRunnerGroupRunners"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/runners/{runner_id}", GoMethods: []string{"ActionsService.RemoveRunnerGroupRunners"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/runners/{runner_id}", GoMethods: []string{"ActionsService.AddRunnerGroupRunners"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/runners", GoMethods: []string{"ActionsService.ListOrganizationRunners"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/runners/downloads", GoMethods: []string{"ActionsService.ListOrganizationRunnerApplicationDownloads"}},
+	{Method: "POST", Path: "/orgs/{org}/actions/runners/generate-jitconfig", GoMethods: []string{"ActionsService.GenerateOrgJITConfig"}},
+	{Method: "POST", Path: "/orgs/{org}/actions/runners/registration-token", GoMethods: []string{"ActionsService.CreateOrganizationRegistrationToken"}},
+	{Method: "POST", Path: "/orgs/{org}/actions/runners/remove-token", GoMethods: []string{"ActionsService.CreateOrganizationRemoveToken"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runners/{runner_id}", GoMethods: []string{"ActionsService.RemoveOrganizationRunner"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/runners/{runner_id}", GoMethods: []string{"ActionsService.GetOrganizationRunner"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/secrets", GoMethods: []string{"ActionsService.ListOrgSecrets"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/secrets/public-key", GoMethods: []string{"ActionsService.GetOrgPublicKey"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/secrets/{secret_name}", GoMethods: []string{"ActionsService.DeleteOrgSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/secrets/{secret_name}", GoMethods: []string{"ActionsService.GetOrgSecret"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/secrets/{secret_name}", GoMethods: []string{"ActionsService.CreateOrUpdateOrgSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/secrets/{secret_name}/repositories", GoMethods: []string{"ActionsService.ListSelectedReposForOrgSecret"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/secrets/{secret_name}/repositories", GoMethods: []string{"ActionsService.SetSelectedReposForOrgSecret"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/secrets/{secret_name}/repositories/{repository_id}", GoMethods: []string{"ActionsService.RemoveSelectedRepoFromOrgSecret"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/secrets/{secret_name}/repositories/{repository_id}", GoMethods: []string{"ActionsService.AddSelectedRepoToOrgSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/variables", GoMethods: []string{"ActionsService.ListOrgVariables"}},
+	{Method: "POST", Path: "/orgs/{org}/actions/variables", GoMethods: []string{"ActionsService.CreateOrgVariable"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/variables/{name}", GoMethods: []string{"ActionsService.DeleteOrgVariable"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/variables/{name}", GoMethods: []string{"ActionsService.GetOrgVariable"}},
+	{Method: "PATCH", Path: "/orgs/{org}/actions/variables/{name}", GoMethods: []string{"ActionsService.UpdateOrgVariable"}},
+	{Method: "GET", Path: "/orgs/{org}/actions/variables/{name}/repositories", GoMethods: []string{"ActionsService.ListSelectedReposForOrgVariable"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/variables/{name}/repositories", GoMethods: []string{"ActionsService.SetSelectedReposForOrgVariable"}},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/variables/{name}/repositories/{repository_id}", GoMethods: []string{"ActionsService.RemoveSelectedRepoFromOrgVariable"}},
+	{Method: "PUT", Path: "/orgs/{org}/actions/variables/{name}/repositories/{repository_id}", GoMethods: []string{"ActionsService.AddSelectedRepoToOrgVariable"}},
+	{Method: "DELETE", Path: "/orgs/{org}/announcement", GoMethods: []string{"OrganizationsService.RemoveAnnouncement"}},
+	{Method: "GET", Path: "/orgs/{org}/announcement", GoMethods: []string{"OrganizationsService.GetAnnouncement"}},
+	{Method: "PATCH", Path: "/orgs/{org}/announcement", GoMethods: []string{"OrganizationsService.SetAnnouncement"}},
+	{Method: "GET", Path: "/orgs/{org}/audit-log", GoMethods: []string{"OrganizationsService.GetAuditLog"}},
+	{Method: "GET", Path: "/orgs/{org}/blocks", GoMethods: []string{"OrganizationsService.ListBlockedUsers"}},
+	{Method: "DELETE", Path: "/orgs/{org}/blocks/{username}", GoMethods: []string{"OrganizationsService.UnblockUser"}},
+	{Method: "GET", Path: "/orgs/{org}/blocks/{username}", GoMethods: []string{"OrganizationsService.IsBlocked"}},
+	{Method: "PUT", Path: "/orgs/{org}/blocks/{username}", GoMethods: []string{"OrganizationsService.BlockUser"}},
+	{Method: "GET", Path: "/orgs/{org}/bypass-requests/push-rules", GoMethods: []string{"OrganizationsService.ListPushRuleBypassRequests"}},
+	{Method: "GET", Path: "/orgs/{org}/bypass-requests/secret-scanning", GoMethods: []string{"SecretScanningService.ListBypassRequestsForOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/code-scanning/alerts", GoMethods: []string{"CodeScanningService.ListAlertsForOrg", "OrganizationsService.GetSecuritySummary"}},
+	{Method: "GET", Path: "/orgs/{org}/codespaces/secrets", GoMethods: []string{"CodespacesService.ListOrgSecrets"}},
+	{Method: "GET", Path: "/orgs/{org}/codespaces/secrets/public-key", GoMethods: []string{"CodespacesService.GetOrgPublicKey"}},
+	{Method: "DELETE", Path: "/orgs/{org}/codespaces/secrets/{secret_name}", GoMethods: []string{"CodespacesService.DeleteOrgSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/codespaces/secrets/{secret_name}", GoMethods: []string{"CodespacesService.GetOrgSecret"}},
+	{Method: "PUT", Path: "/orgs/{org}/codespaces/secrets/{secret_name}", GoMethods: []string{"CodespacesService.CreateOrUpdateOrgSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/codespaces/secrets/{secret_name}/repositories", GoMethods: []string{"CodespacesService.ListSelectedReposForOrgSecret"}},
+	{Method: "PUT", Path: "/orgs/{org}/codespaces/secrets/{secret_name}/repositories", GoMethods: []string{"CodespacesService.SetSelectedReposForOrgSecret"}},
+	{Method: "DELETE", Path: "/orgs/{org}/codespaces/secrets/{secret_name}/repositories/{repository_id}", GoMethods: []string{"CodespacesService.RemoveSelectedRepoFromOrgSecret"}},
+	{Method: "PUT", Path: "/orgs/{org}/codespaces/secrets/{secret_name}/repositories/{repository_id}", GoMethods: []string{"CodespacesService.AddSelectedRepoToOrgSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/copilot/billing", GoMethods: []string{"CopilotService.GetCopilotBilling"}},
+	{Method: "GET", Path: "/orgs/{org}/copilot/billing/seats", GoMethods: []string{"CopilotService.ListCopilotSeats"}},
+	{Method: "DELETE", Path: "/orgs/{org}/copilot/billing/selected_teams", GoMethods: []string{"CopilotService.RemoveCopilotTeams"}},
+	{Method: "POST", Path: "/orgs/{org}/copilot/billing/selected_teams", GoMethods: []string{"CopilotService.AddCopilotTeams"}},
+	{Method: "DELETE", Path: "/orgs/{org}/copilot/billing/selected_users", GoMethods: []string{"CopilotService.RemoveCopilotUsers"}},
+	{Method: "POST", Path: "/orgs/{org}/copilot/billing/selected_users", GoMethods: []string{"CopilotService.AddCopilotUsers"}},
+	{Method: "GET", Path: "/orgs/{org}/credential-authorizations", GoMethods: []string{"OrganizationsService.ListCredentialAuthorizations"}},
+	{Method: "DELETE", Path: "/orgs/{org}/credential-authorizations/{credential_id}", GoMethods: []string{"OrganizationsService.RemoveCredentialAuthorization"}},
+	{Method: "GET", Path: "/orgs/{org}/custom-repository-roles", GoMethods: []string{"OrganizationsService.ListCustomRepoRoles"}},
+	{Method: "POST", Path: "/orgs/{org}/custom-repository-roles", GoMethods: []string{"OrganizationsService.CreateCustomRepoRole"}},
+	{Method: "DELETE", Path: "/orgs/{org}/custom-repository-roles/{role_id}", GoMethods: []string{"OrganizationsService.DeleteCustomRepoRole"}},
+	{Method: "PATCH", Path: "/orgs/{org}/custom-repository-roles/{role_id}", GoMethods: []string{"OrganizationsService.UpdateCustomRepoRole"}},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/alerts", GoMethods: []string{"DependabotService.ListOrgAlerts", "OrganizationsService.GetSecuritySummary"}},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/secrets", GoMethods: []string{"DependabotService.ListOrgSecrets"}},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/secrets/public-key", GoMethods: []string{"DependabotService.GetOrgPublicKey"}},
+	{Method: "DELETE", Path: "/orgs/{org}/dependabot/secrets/{secret_name}", GoMethods: []string{"DependabotService.DeleteOrgSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/secrets/{secret_name}", GoMethods: []string{"DependabotService.GetOrgSecret"}},
+	{Method: "PUT", Path: "/orgs/{org}/dependabot/secrets/{secret_name}", GoMethods: []string{"DependabotService.CreateOrUpdateOrgSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/secrets/{secret_name}/repositories", GoMethods: []string{"DependabotService.ListSelectedReposForOrgSecret"}},
+	{Method: "PUT", Path: "/orgs/{org}/dependabot/secrets/{secret_name}/repositories", GoMethods: []string{"DependabotService.SetSelectedReposForOrgSecret"}},
+	{Method: "DELETE", Path: "/orgs/{org}/dependabot/secrets/{secret_name}/repositories/{repository_id}", GoMethods: []string{"DependabotService.RemoveSelectedRepoFromOrgSecret"}},
+	{Method: "PUT", Path: "/orgs/{org}/dependabot/secrets/{secret_name}/repositories/{repository_id}", GoMethods: []string{"DependabotService.AddSelectedRepoToOrgSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/events", GoMethods: []string{"ActivityService.ListEventsForOrganization"}},
+	{Method: "GET", Path: "/orgs/{org}/external-group/{group_id}", GoMethods: []string{"TeamsService.GetExternalGroup"}},
+	{Method: "GET", Path: "/orgs/{org}/external-groups", GoMethods: []string{"TeamsService.ListExternalGroups"}},
+	{Method: "GET", Path: "/orgs/{org}/failed_invitations", GoMethods: []string{"OrganizationsService.ListFailedOrgInvitations"}},
+	{Method: "GET", Path: "/orgs/{org}/hooks", GoMethods: []string{"OrganizationsService.ListHooks", "OrganizationsService.ListUnhealthyHooks"}},
+	{Method: "POST", Path: "/orgs/{org}/hooks", GoMethods: []string{"OrganizationsService.CreateHook"}},
+	{Method: "DELETE", Path: "/orgs/{org}/hooks/{hook_id}", GoMethods: []string{"OrganizationsService.DeleteHook"}},
+	{Method: "GET", Path: "/orgs/{org}/hooks/{hook_id}", GoMethods: []string{"OrganizationsService.GetHook"}},
+	{Method: "PATCH", Path: "/orgs/{org}/hooks/{hook_id}", GoMethods: []string{"OrganizationsService.EditHook"}},
+	{Method: "GET", Path: "/orgs/{org}/hooks/{hook_id}/config", GoMethods: []string{"OrganizationsService.GetHookConfiguration", "OrganizationsService.RotateHookSecret"}},
+	{Method: "PATCH", Path: "/orgs/{org}/hooks/{hook_id}/config", GoMethods: []string{"OrganizationsService.EditHookConfiguration", "OrganizationsService.RotateHookSecret"}},
+	{Method: "GET", Path: "/orgs/{org}/hooks/{hook_id}/deliveries", GoMethods: []string{"OrganizationsService.ListHookDeliveries"}},
+	{Method: "GET", Path: "/orgs/{org}/hooks/{hook_id}/deliveries/{delivery_id}", GoMethods: []string{"OrganizationsService.GetHookDelivery"}},
+	{Method: "POST", Path: "/orgs/{org}/hooks/{hook_id}/deliveries/{delivery_id}/attempts", GoMethods: []string{"OrganizationsService.RedeliverHookDelivery"}},
+	{Method: "POST", Path: "/orgs/{org}/hooks/{hook_id}/pings", GoMethods: []string{"OrganizationsService.PingHook"}},
+	{Method: "GET", Path: "/orgs/{org}/installation", GoMethods: []string{"AppsService.FindOrganizationInstallation"}},
+	{Method: "GET", Path: "/orgs/{org}/installations", GoMethods: []string{"OrganizationsService.ListInstallations"}},
+	{Method: "DELETE", Path: "/orgs/{org}/interaction-limits", GoMethods: []string{"InteractionsService.RemoveRestrictionsFromOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/interaction-limits", GoMethods: []string{"InteractionsService.GetRestrictionsForOrg"}},
+	{Method: "PUT", Path: "/orgs/{org}/interaction-limits", GoMethods: []string{"InteractionsService.UpdateRestrictionsForOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/invitations", GoMethods: []string{"OrganizationsService.ListPendingOrgInvitations"}},
+	{Method: "POST", Path: "/orgs/{org}/invitations", GoMethods: []string{"OrganizationsService.CreateOrgInvitation"}},
+	{Method: "GET", Path: "/orgs/{org}/invitations/{invitation_id}/teams", GoMethods: []string{"OrganizationsService.ListOrgInvitationTeams"}},
+	{Method: "GET", Path: "/orgs/{org}/issues", GoMethods: []string{"IssuesService.ListByOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/members", GoMethods: []string{"OrganizationsService.ListMembers"}},
+	{Method: "DELETE", Path: "/orgs/{org}/members/{username}", GoMethods: []string{"OrganizationsService.RemoveMember"}},
+	{Method: "GET", Path: "/orgs/{org}/members/{username}", GoMethods: []string{"OrganizationsService.IsMember"}},
+	{Method: "GET", Path: "/orgs/{org}/members/{username}/copilot", GoMethods: []string{"CopilotService.GetSeatDetails"}},
+	{Method: "DELETE", Path: "/orgs/{org}/memberships/{username}", GoMethods: []string{"OrganizationsService.RemoveOrgMembership"}},
+	{Method: "GET", Path: "/orgs/{org}/memberships/{username}", GoMethods: []string{"OrganizationsService.GetOrgMembership"}},
+	{Method: "PUT", Path: "/orgs/{org}/memberships/{username}", GoMethods: []string{"OrganizationsService.EditOrgMembership"}},
+	{Method: "GET", Path: "/orgs/{org}/migrations", GoMethods: []string{"MigrationService.ListMigrations"}},
+	{Method: "POST", Path: "/orgs/{org}/migrations", GoMethods: []string{"MigrationService.StartMigration"}},
+	{Method: "GET", Path: "/orgs/{org}/migrations/{migration_id}", GoMethods: []string{"MigrationService.MigrationStatus", "MigrationService.WaitForMigration"}},
+	{Method: "DELETE", Path: "/orgs/{org}/migrations/{migration_id}/archive", GoMethods: []string{"MigrationService.DeleteMigration"}},
+	{Method: "GET", Path: "/orgs/{org}/migrations/{migration_id}/archive", GoMethods: []string{"MigrationService.DownloadMigrationArchive", "MigrationService.MigrationArchiveURL"}},
+	{Method: "DELETE", Path: "/orgs/{org}/migrations/{migration_id}/repos/{repo_name}/lock", GoMethods: []string{"MigrationService.UnlockRepo"}},
+	{Method: "GET", Path: "/orgs/{org}/outside_collaborators", GoMethods: []string{"OrganizationsService.ListOutsideCollaborators"}},
+	{Method: "DELETE", Path: "/orgs/{org}/outside_collaborators/{username}", GoMethods: []string{"OrganizationsService.RemoveOutsideCollaborator"}},
+	{Method: "PUT", Path: "/orgs/{org}/outside_collaborators/{username}", GoMethods: []string{"OrganizationsService.ConvertMemberToOutsideCollaborator"}},
+	{Method: "GET", Path: "/orgs/{org}/packages", GoMethods: []string{"OrganizationsService.ListPackages"}},
+	{Method: "DELETE", Path: "/orgs/{org}/packages/{package_type}/{package_name}", GoMethods: []string{"OrganizationsService.DeletePackage"}},
+	{Method: "GET", Path: "/orgs/{org}/packages/{package_type}/{package_name}", GoMethods: []string{"OrganizationsService.GetPackage"}},
+	{Method: "POST", Path: "/orgs/{org}/packages/{package_type}/{package_name}/restore", GoMethods: []string{"OrganizationsService.RestorePackage"}},
+	{Method: "GET", Path: "/orgs/{org}/packages/{package_type}/{package_name}/versions", GoMethods: []string{"OrganizationsService.PackageGetAllVersions"}},
+	{Method: "DELETE", Path: "/orgs/{org}/packages/{package_type}/{package_name}/versions/{package_version_id}", GoMethods: []string{"OrganizationsService.PackageDeleteVersion"}},
+	{Method: "GET", Path: "/orgs/{org}/packages/{package_type}/{package_name}/versions/{package_version_id}", GoMethods: []string{"OrganizationsService.PackageGetVersion"}},
+	{Method: "POST", Path: "/orgs/{org}/packages/{package_type}/{package_name}/versions/{package_version_id}/restore", GoMethods: []string{"OrganizationsService.PackageRestoreVersion"}},
+	{Method: "GET", Path: "/orgs/{org}/personal-access-token-requests", GoMethods: []string{"OrganizationsService.ListPersonalAccessTokenRequests"}},
+	{Method: "POST", Path: "/orgs/{org}/personal-access-token-requests", GoMethods: []string{"OrganizationsService.ReviewPersonalAccessTokenRequests"}},
+	{Method: "POST", Path: "/orgs/{org}/personal-access-token-requests/{pat_request_id}", GoMethods: []string{"OrganizationsService.ReviewPersonalAccessTokenRequest"}},
+	{Method: "GET", Path: "/orgs/{org}/personal-access-token-requests/{pat_request_id}/repositories", GoMethods: []string{"OrganizationsService.ListPersonalAccessTokenRequestRepositories"}},
+	{Method: "GET", Path: "/orgs/{org}/personal-access-tokens", GoMethods: []string{"OrganizationsService.ListPersonalAccessTokens"}},
+	{Method: "POST", Path: "/orgs/{org}/personal-access-tokens", GoMethods: []string{"OrganizationsService.RevokePersonalAccessTokens"}},
+	{Method: "POST", Path: "/orgs/{org}/personal-access-tokens/{pat_id}", GoMethods: []string{"OrganizationsService.RevokePersonalAccessToken"}},
+	{Method: "GET", Path: "/orgs/{org}/personal-access-tokens/{pat_id}/repositories", GoMethods: []string{"OrganizationsService.ListPersonalAccessTokenRepositories"}},
+	{Method: "GET", Path: "/orgs/{org}/projects", GoMethods: []string{"OrganizationsService.ListProjects"}},
+	{Method: "POST", Path: "/orgs/{org}/projects", GoMethods: []string{"OrganizationsService.CreateProject"}},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2", GoMethods: []string{"ProjectsService.ListOrganizationProjects"}},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2/{project_number}/fields", GoMethods: []string{"ProjectsService.ExportOrganizationProject", "ProjectsService.ListOrganizationProjectFields", "ProjectsService.SetOrganizationProjectItemField", "ProjectsService.SetOrganizationProjectItemFieldValue", "ProjectsService.SummarizeItemsByField"}},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2/{project_number}/items", GoMethods: []string{"ProjectsService.ExportOrganizationProject", "ProjectsService.ListOrganizationProjectItems", "ProjectsService.SummarizeItemsByField"}},
+	{Method: "POST", Path: "/orgs/{org}/projectsV2/{project_number}/items", GoMethods: []string{"ProjectsService.AddOrganizationProjectItem"}},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2/{project_number}/items/{item_id}", GoMethods: []string{"ProjectsService.GetOrganizationProjectItem"}},
+	{Method: "PATCH", Path: "/orgs/{org}/projectsV2/{project_number}/items/{item_id}", GoMethods: []string{"ProjectsService.SetOrganizationProjectItemField", "ProjectsService.SetOrganizationProjectItemFieldValue", "ProjectsService.UpdateOrganizationProjectItem"}},
+	{Method: "GET", Path: "/orgs/{org}/properties/schema", GoMethods: []string{"OrganizationsService.GetAllCustomProperties"}},
+	{Method: "PATCH", Path: "/orgs/{org}/properties/schema", GoMethods: []string{"OrganizationsService.CreateOrUpdateCustomProperties"}},
+	{Method: "DELETE", Path: "/orgs/{org}/properties/schema/{custom_property_name}", GoMethods: []string{"OrganizationsService.RemoveCustomProperty"}},
+	{Method: "GET", Path: "/orgs/{org}/properties/schema/{custom_property_name}", GoMethods: []string{"OrganizationsService.GetCustomProperty"}},
+	{Method: "PUT", Path: "/orgs/{org}/properties/schema/{custom_property_name}", GoMethods: []string{"OrganizationsService.CreateOrUpdateCustomProperty"}},
+	{Method: "GET", Path: "/orgs/{org}/properties/values", GoMethods: []string{"OrganizationsService.ForEachRepoCustomPropertyValues", "OrganizationsService.GroupReposByProperty", "OrganizationsService.ListCustomPropertyValues"}},
+	{Method: "PATCH", Path: "/orgs/{org}/properties/values", GoMethods: []string{"OrganizationsService.CreateOrUpdateRepoCustomPropertyValues"}},
+	{Method: "GET", Path: "/orgs/{org}/public_members", GoMethods: []string{"OrganizationsService.ListMembers"}},
+	{Method: "DELETE", Path: "/orgs/{org}/public_members/{username}", GoMethods: []string{"OrganizationsService.ConcealMembership"}},
+	{Method: "GET", Path: "/orgs/{org}/public_members/{username}", GoMethods: []string{"OrganizationsService.IsPublicMember"}},
+	{Method: "PUT", Path: "/orgs/{org}/public_members/{username}", GoMethods: []string{"OrganizationsService.PublicizeMembership"}},
+	{Method: "GET", Path: "/orgs/{org}/repos", GoMethods: []string{"RepositoriesService.ListByOrg"}},
+	{Method: "POST", Path: "/orgs/{org}/repos", GoMethods: []string{"RepositoriesService.Create"}},
+	{Method: "GET", Path: "/orgs/{org}/rulesets", GoMethods: []string{"OrganizationsService.GetAllOrganizationRulesets"}},
+	{Method: "POST", Path: "/orgs/{org}/rulesets", GoMethods: []string{"OrganizationsService.CreateOrganizationRuleset"}},
+	{Method: "DELETE", Path: "/orgs/{org}/rulesets/{ruleset_id}", GoMethods: []string{"OrganizationsService.DeleteOrganizationRuleset"}},
+	{Method: "GET", Path: "/orgs/{org}/rulesets/{ruleset_id}", GoMethods: []string{"OrganizationsService.GetOrganizationRuleset"}},
+	{Method: "PUT", Path: "/orgs/{org}/rulesets/{ruleset_id}", GoMethods: []string{"OrganizationsService.UpdateOrganizationRuleset"}},
+	{Method: "GET", Path: "/orgs/{org}/secret-scanning/alerts", GoMethods: []string{"OrganizationsService.GetSecuritySummary", "SecretScanningService.ListAlertsForOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/secret-scanning/pattern-configurations", GoMethods: []string{"SecretScanningService.ListPatternConfigsForOrg"}},
+	{Method: "PATCH", Path: "/orgs/{org}/secret-scanning/pattern-configurations", GoMethods: []string{"SecretScanningService.UpdatePatternConfigsForOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/security-advisories", GoMethods: []string{"SecurityAdvisoriesService.ListRepositorySecurityAdvisoriesForOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/security-managers", GoMethods: []string{"OrganizationsService.ListSecurityManagerTeams"}},
+	{Method: "DELETE", Path: "/orgs/{org}/security-managers/teams/{team_slug}", GoMethods: []string{"OrganizationsService.RemoveSecurityManagerTeam"}},
+	{Method: "PUT", Path: "/orgs/{org}/security-managers/teams/{team_slug}", GoMethods: []string{"OrganizationsService.AddSecurityManagerTeam"}},
+	{Method: "GET", Path: "/orgs/{org}/settings/billing/actions", GoMethods: []string{"BillingService.GetActionsBillingOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/settings/billing/advanced-security", GoMethods: []string{"BillingService.GetAdvancedSecurityActiveCommittersOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/settings/billing/packages", GoMethods: []string{"BillingService.GetPackagesBillingOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/settings/billing/shared-storage", GoMethods: []string{"BillingService.GetStorageBillingOrg"}},
+	{Method: "GET", Path: "/orgs/{org}/settings/network-configurations", GoMethods: []string{"OrganizationsService.ListNetworkConfigurations"}},
+	{Method: "POST", Path: "/orgs/{org}/settings/network-configurations", GoMethods: []string{"OrganizationsService.CreateNetworkConfiguration"}},
+	{Method: "DELETE", Path: "/orgs/{org}/settings/network-configurations/{network_configuration_id}", GoMethods: []string{"OrganizationsService.DeleteNetworkConfiguration"}},
+	{Method: "GET", Path: "/orgs/{org}/settings/network-configurations/{network_configuration_id}", GoMethods: []string{"OrganizationsService.GetNetworkConfiguration"}},
+	{Method: "PATCH", Path: "/orgs/{org}/settings/network-configurations/{network_configuration_id}", GoMethods: []string{"OrganizationsService.UpdateNetworkConfiguration"}},
+	{Method: "GET", Path: "/orgs/{org}/settings/network-settings/{network_settings_id}", GoMethods: []string{"OrganizationsService.GetNetworkSettings"}},
+	{Method: "GET", Path: "/orgs/{org}/team-sync/groups", GoMethods: []string{"TeamsService.ListIDPGroupsInOrganization"}},
+	{Method: "GET", Path: "/orgs/{org}/teams", GoMethods: []string{"TeamsService.ListTeams"}},
+	{Method: "POST", Path: "/orgs/{org}/teams", GoMethods: []string{"TeamsService.CreateTeam"}},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}", GoMethods: []string{"TeamsService.DeleteTeamByID", "TeamsService.DeleteTeamBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}", GoMethods: []string{"TeamsService.GetTeamByID", "TeamsService.GetTeamBySlug"}},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}", GoMethods: []string{"TeamsService.EditTeamByID", "TeamsService.EditTeamBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions", GoMethods: []string{"TeamsService.ListDiscussionsByID", "TeamsService.ListDiscussionsBySlug"}},
+	{Method: "POST", Path: "/orgs/{org}/teams/{team_slug}/discussions", GoMethods: []string{"TeamsService.CreateDiscussionByID", "TeamsService.CreateDiscussionBySlug"}},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}", GoMethods: []string{"TeamsService.DeleteDiscussionByID", "TeamsService.DeleteDiscussionBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}", GoMethods: []string{"TeamsService.GetDiscussionByID", "TeamsService.GetDiscussionBySlug"}},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}", GoMethods: []string{"TeamsService.EditDiscussionByID", "TeamsService.EditDiscussionBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments", GoMethods: []string{"TeamsService.ListCommentsByID", "TeamsService.ListCommentsBySlug"}},
+	{Method: "POST", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments", GoMethods: []string{"TeamsService.CreateCommentByID", "TeamsService.CreateCommentBySlug"}},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}", GoMethods: []string{"TeamsService.DeleteCommentByID", "TeamsService.DeleteCommentBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}", GoMethods: []string{"TeamsService.GetCommentByID", "TeamsService.GetCommentBySlug"}},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}", GoMethods: []string{"TeamsService.EditCommentByID", "TeamsService.EditCommentBySlug"}},
+	{Method: "POST", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}/reactions", GoMethods: []string{"ReactionsService.DeleteTeamDiscussionCommentReactionByOrgIDAndTeamID"}},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}/reactions/{reaction_id}", GoMethods: []string{"ReactionsService.DeleteTeamDiscussionCommentReaction"}},
+	{Method: "POST", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/reactions", GoMethods: []string{"ReactionsService.DeleteTeamDiscussionReactionByOrgIDAndTeamID"}},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/reactions/{reaction_id}", GoMethods: []string{"ReactionsService.DeleteTeamDiscussionReaction"}},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/external-groups", GoMethods: []string{"TeamsService.RemoveConnectedExternalGroup"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/external-groups", GoMethods: []string{"TeamsService.ListExternalGroupsForTeamBySlug"}},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}/external-groups", GoMethods: []string{"TeamsService.UpdateConnectedExternalGroup"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/invitations", GoMethods: []string{"TeamsService.ListPendingTeamInvitationsByID", "TeamsService.ListPendingTeamInvitationsBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/members", GoMethods: []string{"ProjectsService.SyncProjectCollaboratorsWithTeam", "TeamsService.GetTeamMembershipByID", "TeamsService.ListTeamMembersByID", "TeamsService.ListTeamMembersBySlug"}},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/memberships/{username}", GoMethods: []string{"TeamsService.RemoveTeamMembershipByID", "TeamsService.RemoveTeamMembershipBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/memberships/{username}", GoMethods: []string{"TeamsService.GetTeamMembershipBySlug"}},
+	{Method: "PUT", Path: "/orgs/{org}/teams/{team_slug}/memberships/{username}", GoMethods: []string{"TeamsService.AddTeamMembershipByID", "TeamsService.AddTeamMembershipBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/projects", GoMethods: []string{"TeamsService.ListTeamProjectsByID", "TeamsService.ListTeamProjectsBySlug"}},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/projects/{project_id}", GoMethods: []string{"TeamsService.RemoveTeamProjectByID", "TeamsService.RemoveTeamProjectBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/projects/{project_id}", GoMethods: []string{"TeamsService.ReviewTeamProjectsByID", "TeamsService.ReviewTeamProjectsBySlug"}},
+	{Method: "PUT", Path: "/orgs/{org}/teams/{team_slug}/projects/{project_id}", GoMethods: []string{"TeamsService.AddTeamProjectByID", "TeamsService.AddTeamProjectBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/repos", GoMethods: []string{"TeamsService.ListTeamReposByID", "TeamsService.ListTeamReposBySlug"}},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}", GoMethods: []string{"TeamsService.RemoveTeamRepoByID", "TeamsService.RemoveTeamRepoBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}", GoMethods: []string{"TeamsService.CheckTeamRepoPermissions", "TeamsService.IsTeamRepoByID", "TeamsService.IsTeamRepoBySlug"}},
+	{Method: "PUT", Path: "/orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}", GoMethods: []string{"TeamsService.AddTeamRepoByID", "TeamsService.AddTeamRepoBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/team-sync/group-mappings", GoMethods: []string{"TeamsService.ListIDPGroupsForTeamByID", "TeamsService.ListIDPGroupsForTeamBySlug"}},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}/team-sync/group-mappings", GoMethods: []string{"TeamsService.CreateOrUpdateIDPGroupConnectionsByID", "TeamsService.CreateOrUpdateIDPGroupConnectionsBySlug"}},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/teams", GoMethods: []string{"TeamsService.ListChildTeamsByParentID", "TeamsService.ListChildTeamsByParentSlug"}},
+	{Method: "DELETE", Path: "/projects/columns/cards/{card_id}", GoMethods: []string{"ProjectsService.DeleteProjectCard"}},
+	{Method: "GET", Path: "/projects/columns/cards/{card_id}", GoMethods: []string{"ProjectsService.GetProjectCard"}},
+	{Method: "PATCH", Path: "/projects/columns/cards/{card_id}", GoMethods: []string{"ProjectsService.UpdateProjectCard"}},
+	{Method: "POST", Path: "/projects/columns/cards/{card_id}/moves", GoMethods: []string{"ProjectsService.MoveProjectCard"}},
+	{Method: "DELETE", Path: "/projects/columns/{column_id}", GoMethods: []string{"ProjectsService.DeleteProjectColumn"}},
+	{Method: "GET", Path: "/projects/columns/{column_id}", GoMethods: []string{"ProjectsService.GetProjectColumn"}},
+	{Method: "PATCH", Path: "/projects/columns/{column_id}", GoMethods: []string{"ProjectsService.UpdateProjectColumn"}},
+	{Method: "GET", Path: "/projects/columns/{column_id}/cards", GoMethods: []string{"ProjectsService.ListProjectCards"}},
+	{Method: "POST", Path: "/projects/columns/{column_id}/cards", GoMethods: []string{"ProjectsService.CreateProjectCard"}},
+	{Method: "POST", Path: "/projects/columns/{column_id}/moves", GoMethods: []string{"ProjectsService.MoveProjectColumn"}},
+	{Method: "DELETE", Path: "/projects/{project_id}", GoMethods: []string{"ProjectsService.DeleteProject"}},
+	{Method: "GET", Path: "/projects/{project_id}", GoMethods: []string{"ProjectsService.GetProject"}},
+	{Method: "PATCH", Path: "/projects/{project_id}", GoMethods: []string{"ProjectsService.UpdateProject"}},
+	{Method: "GET", Path: "/projects/{project_id}/collaborators", GoMethods: []string{"ProjectsService.ListProjectCollaborators", "ProjectsService.SyncProjectCollaboratorsWithTeam"}},
+	{Method: "DELETE", Path: "/projects/{project_id}/collaborators/{username}", GoMethods: []string{"ProjectsService.RemoveProjectCollaborator", "ProjectsService.SyncProjectCollaboratorsWithTeam"}},
+	{Method: "PUT", Path: "/projects/{project_id}/collaborators/{username}", GoMethods: []string{"ProjectsService.AddProjectCollaborator", "ProjectsService.SyncProjectCollaboratorsWithTeam"}},
+	{Method: "GET", Path: "/projects/{project_id}/collaborators/{username}/permission", GoMethods: []string{"ProjectsService.ReviewProjectCollaboratorPermission", "ProjectsService.SyncProjectCollaboratorsWithTeam"}},
+	{Method: "GET", Path: "/projects/{project_id}/columns", GoMethods: []string{"ProjectsService.ListProjectColumns"}},
+	{Method: "POST", Path: "/projects/{project_id}/columns", GoMethods: []string{"ProjectsService.CreateProjectColumn"}},
+	{Method: "GET", Path: "/rate_limit", GoMethods: []string{"RateLimitService.Get"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}", GoMethods: []string{"RepositoriesService.Delete"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}", GoMethods: []string{"RepositoriesService.Get", "RepositoriesService.GetCodeOfConduct"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}", GoMethods: []string{"RepositoriesService.Edit"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/artifacts", GoMethods: []string{"ActionsService.ListArtifacts"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/artifacts/{artifact_id}", GoMethods: []string{"ActionsService.DeleteArtifact"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/artifacts/{artifact_id}", GoMethods: []string{"ActionsService.GetArtifact"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}", GoMethods: []string{"ActionsService.DownloadArtifact"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/cache/usage", GoMethods: []string{"ActionsService.GetCacheUsageForRepo"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/caches", GoMethods: []string{"ActionsService.DeleteCachesByKey"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/caches", GoMethods: []string{"ActionsService.ListCaches"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/caches/{cache_id}", GoMethods: []string{"ActionsService.DeleteCachesByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/jobs/{job_id}", GoMethods: []string{"ActionsService.GetWorkflowJobByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/jobs/{job_id}/logs", GoMethods: []string{"ActionsService.GetWorkflowJobLogs"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/jobs/{job_id}/rerun", GoMethods: []string{"ActionsService.RerunJobByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/oidc/customization/sub", GoMethods: []string{"ActionsService.GetRepoOIDCSubjectClaimCustomTemplate"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/oidc/customization/sub", GoMethods: []string{"ActionsService.SetRepoOIDCSubjectClaimCustomTemplate"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/organization-secrets", GoMethods: []string{"ActionsService.ListRepoOrgSecrets"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/organization-variables", GoMethods: []string{"ActionsService.ListRepoOrgVariables"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions", GoMethods: []string{"RepositoriesService.GetActionsPermissions"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions", GoMethods: []string{"RepositoriesService.EditActionsPermissions"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions/access", GoMethods: []string{"RepositoriesService.GetActionsAccessLevel"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions/access", GoMethods: []string{"RepositoriesService.EditActionsAccessLevel"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions/selected-actions", GoMethods: []string{"RepositoriesService.GetActionsAllowed"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions/selected-actions", GoMethods: []string{"RepositoriesService.EditActionsAllowed"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions/workflow", GoMethods: []string{"RepositoriesService.GetDefaultWorkflowPermissions"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions/workflow", GoMethods: []string{"RepositoriesService.EditDefaultWorkflowPermissions"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/required_workflows", GoMethods: []string{"ActionsService.ListRepoRequiredWorkflows"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runners", GoMethods: []string{"ActionsService.ListRunners"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runners/downloads", GoMethods: []string{"ActionsService.ListRunnerApplicationDownloads"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runners/generate-jitconfig", GoMethods: []string{"ActionsService.GenerateRepoJITConfig"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runners/registration-token", GoMethods: []string{"ActionsService.CreateRegistrationToken"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runners/remove-token", GoMethods: []string{"ActionsService.CreateRemoveToken"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/runners/{runner_id}", GoMethods: []string{"ActionsService.RemoveRunner"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runners/{runner_id}", GoMethods: []string{"ActionsService.GetRunner"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs", GoMethods: []string{"ActionsService.ListRepositoryWorkflowRuns", "RepositoriesService.DispatchAndWaitForRun"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}", GoMethods: []string{"ActionsService.DeleteWorkflowRun"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}", GoMethods: []string{"ActionsService.GetWorkflowRunByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/artifacts", GoMethods: []string{"ActionsService.ListWorkflowRunArtifacts"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}", GoMethods: []string{"ActionsService.GetWorkflowRunAttempt"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/jobs", GoMethods: []string{"ActionsService.ListWorkflowJobsAttempt"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/logs", GoMethods: []string{"ActionsService.GetWorkflowRunAttemptLogs"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/cancel", GoMethods: []string{"ActionsService.CancelWorkflowRunByID"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/deployment_protection_rule", GoMethods: []string{"ActionsService.ReviewCustomDeploymentProtectionRule"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/jobs", GoMethods: []string{"ActionsService.ListWorkflowJobs"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/logs", GoMethods: []string{"ActionsService.DeleteWorkflowRunLogs"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/logs", GoMethods: []string{"ActionsService.GetWorkflowRunLogs"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/pending_deployments", GoMethods: []string{"ActionsService.PendingDeployments"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/rerun", GoMethods: []string{"ActionsService.RerunWorkflowByID"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs", GoMethods: []string{"ActionsService.RerunFailedJobsByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/timing", GoMethods: []string{"ActionsService.GetWorkflowRunUsageByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/secrets", GoMethods: []string{"ActionsService.ListRepoSecrets"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/secrets/public-key", GoMethods: []string{"ActionsService.GetRepoPublicKey"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/secrets/{secret_name}", GoMethods: []string{"ActionsService.DeleteRepoSecret"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/secrets/{secret_name}", GoMethods: []string{"ActionsService.GetRepoSecret"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/secrets/{secret_name}", GoMethods: []string{"ActionsService.CreateOrUpdateRepoSecret"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/variables", GoMethods: []string{"ActionsService.ListRepoVariables"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/variables", GoMethods: []string{"ActionsService.CreateRepoVariable"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/variables/{name}", GoMethods: []string{"ActionsService.DeleteRepoVariable"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/variables/{name}", GoMethods: []string{"ActionsService.GetRepoVariable"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/actions/variables/{name}", GoMethods: []string{"ActionsService.UpdateRepoVariable"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/workflows", GoMethods: []string{"ActionsService.ListWorkflows"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}", GoMethods: []string{"ActionsService.GetWorkflowByFileName", "ActionsService.GetWorkflowByID"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/disable", GoMethods: []string{"ActionsService.DisableWorkflowByFileName", "ActionsService.DisableWorkflowByID"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches", GoMethods: []string{"ActionsService.CreateWorkflowDispatchEventByFileName", "ActionsService.CreateWorkflowDispatchEventByID"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/enable", GoMethods: []string{"ActionsService.EnableWorkflowByFileName", "ActionsService.EnableWorkflowByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs", GoMethods: []string{"ActionsService.ListWorkflowRunsByFileName", "ActionsService.ListWorkflowRunsByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/timing", GoMethods: []string{"ActionsService.GetWorkflowUsageByFileName", "ActionsService.GetWorkflowUsageByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/assignees", GoMethods: []string{"IssuesService.ListAssignees"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/assignees/{assignee}", GoMethods: []string{"IssuesService.IsAssignee"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/autolinks", GoMethods: []string{"RepositoriesService.ListAutolinks"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/autolinks", GoMethods: []string{"RepositoriesService.AddAutolink"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/autolinks/{autolink_id}", GoMethods: []string{"RepositoriesService.DeleteAutolink"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/autolinks/{autolink_id}", GoMethods: []string{"RepositoriesService.GetAutolink"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/automated-security-fixes", GoMethods: []string{"RepositoriesService.DisableAutomatedSecurityFixes"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/automated-security-fixes", GoMethods: []string{"RepositoriesService.GetAutomatedSecurityFixes"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/automated-security-fixes", GoMethods: []string{"RepositoriesService.EnableAutomatedSecurityFixes"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches", GoMethods: []string{"RepositoriesService.ListBranches"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}", GoMethods: []string{"RepositoriesService.GetBranch"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection", GoMethods: []string{"RepositoriesService.RemoveBranchProtection"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection", GoMethods: []string{"RepositoriesService.GetBranchProtection"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/branches/{branch}/protection", GoMethods: []string{"RepositoriesService.UpdateBranchProtection"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins", GoMethods: []string{"RepositoriesService.RemoveAdminEnforcement"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins", GoMethods: []string{"RepositoriesService.GetAdminEnforcement"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins", GoMethods: []string{"RepositoriesService.AddAdminEnforcement"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews", GoMethods: []string{"RepositoriesService.RemovePullRequestReviewEnforcement"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews", GoMethods: []string{"RepositoriesService.GetPullRequestReviewEnforcement"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews", GoMethods: []string{"RepositoriesService.DisableDismissalRestrictions", "RepositoriesService.UpdatePullRequestReviewEnforcement"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_signatures", GoMethods: []string{"RepositoriesService.OptionalSignaturesOnProtectedBranch"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_signatures", GoMethods: []string{"RepositoriesService.GetSignaturesProtectedBranch"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_signatures", GoMethods: []string{"RepositoriesService.RequireSignaturesOnProtectedBranch"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks", GoMethods: []string{"RepositoriesService.RemoveRequiredStatusChecks"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks", GoMethods: []string{"RepositoriesService.GetRequiredStatusChecks"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks", GoMethods: []string{"RepositoriesService.UpdateRequiredStatusChecks"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks/contexts", GoMethods: []string{"RepositoriesService.ListRequiredStatusChecksContexts"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps", GoMethods: []string{"RepositoriesService.RemoveAppRestrictions"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps", GoMethods: []string{"RepositoriesService.ListAppRestrictions", "RepositoriesService.ListApps"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps", GoMethods: []string{"RepositoriesService.AddAppRestrictions"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps", GoMethods: []string{"RepositoriesService.ReplaceAppRestrictions"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams", GoMethods: []string{"RepositoriesService.RemoveTeamRestrictions"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams", GoMethods: []string{"RepositoriesService.ListTeamRestrictions"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams", GoMethods: []string{"RepositoriesService.AddTeamRestrictions"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams", GoMethods: []string{"RepositoriesService.ReplaceTeamRestrictions"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users", GoMethods: []string{"RepositoriesService.RemoveUserRestrictions"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users", GoMethods: []string{"RepositoriesService.ListUserRestrictions"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users", GoMethods: []string{"RepositoriesService.AddUserRestrictions"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users", GoMethods: []string{"RepositoriesService.ReplaceUserRestrictions"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/rename", GoMethods: []string{"RepositoriesService.RenameBranch"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/bypass-requests/push-rules", GoMethods: []string{"RepositoriesService.ListPushRuleBypassRequests"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/bypass-requests/push-rules/{bypass_request_number}", GoMethods: []string{"RepositoriesService.GetPushRuleBypassRequest"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/bypass-requests/secret-scanning", GoMethods: []string{"SecretScanningService.ListBypassRequestsForRepo"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/bypass-requests/secret-scanning/{bypass_request_number}", GoMethods: []string{"SecretScanningService.GetBypassRequest"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/bypass-requests/secret-scanning/{bypass_request_number}", GoMethods: []string{"SecretScanningService.ReviewBypassRequest"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/check-runs", GoMethods: []string{"ChecksService.CreateCheckRun"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/check-runs/{check_run_id}", GoMethods: []string{"ChecksService.GetCheckRun"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/check-runs/{check_run_id}", GoMethods: []string{"ChecksService.UpdateCheckRun"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/check-runs/{check_run_id}/annotations", GoMethods: []string{"ChecksService.ForEachCheckRunAnnotation", "ChecksService.ListCheckRunAnnotations"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/check-runs/{check_run_id}/rerequest", GoMethods: []string{"ChecksService.ReRequestCheckRun"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/check-suites", GoMethods: []string{"ChecksService.CreateCheckSuite"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/check-suites/preferences", GoMethods: []string{"ChecksService.SetCheckSuitePreferences"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/check-suites/{check_suite_id}", GoMethods: []string{"ChecksService.GetCheckSuite"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/check-suites/{check_suite_id}/check-runs", GoMethods: []string{"ChecksService.ListCheckRunsCheckSuite"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/check-suites/{check_suite_id}/rerequest", GoMethods: []string{"ChecksService.ReRequestCheckSuite"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/alerts", GoMethods: []string{"CodeScanningService.ListAlertsForRepo"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/alerts/{alert_number}", GoMethods: []string{"CodeScanningService.GetAlert"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/code-scanning/alerts/{alert_number}", GoMethods: []string{"CodeScanningService.UpdateAlert"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/instances", GoMethods: []string{"CodeScanningService.ListAlertInstances"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/analyses", GoMethods: []string{"CodeScanningService.ListAnalysesForRepo"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}", GoMethods: []string{"CodeScanningService.DeleteAnalysis"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}", GoMethods: []string{"CodeScanningService.GetAnalysis"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/codeql/databases", GoMethods: []string{"CodeScanningService.ListCodeQLDatabases"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/codeql/databases/{language}", GoMethods: []string{"CodeScanningService.GetCodeQLDatabase"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/default-setup", GoMethods: []string{"CodeScanningService.GetDefaultSetupConfiguration"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/code-scanning/default-setup", GoMethods: []string{"CodeScanningService.UpdateDefaultSetupConfiguration"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/code-scanning/sarifs", GoMethods: []string{"CodeScanningService.UploadSarif"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/sarifs/{sarif_id}", GoMethods: []string{"CodeScanningService.GetSARIF"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codeowners/errors", GoMethods: []string{"RepositoriesService.GetCodeownersErrors"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces", GoMethods: []string{"CodespacesService.ListInRepo"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/codespaces", GoMethods: []string{"CodespacesService.CreateInRepo"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/secrets", GoMethods: []string{"CodespacesService.ListRepoSecrets"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/secrets/public-key", GoMethods: []string{"CodespacesService.GetRepoPublicKey"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/codespaces/secrets/{secret_name}", GoMethods: []string{"CodespacesService.DeleteRepoSecret"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/secrets/{secret_name}", GoMethods: []string{"CodespacesService.GetRepoSecret"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/codespaces/secrets/{secret_name}", GoMethods: []string{"CodespacesService.CreateOrUpdateRepoSecret"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/collaborators", GoMethods: []string{"RepositoriesService.ListCollaborators"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/collaborators/{username}", GoMethods: []string{"RepositoriesService.RemoveCollaborator"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/collaborators/{username}", GoMethods: []string{"RepositoriesService.IsCollaborator"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/collaborators/{username}", GoMethods: []string{"RepositoriesService.AddCollaborator"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/collaborators/{username}/permission", GoMethods: []string{"RepositoriesService.GetPermissionLevel", "RepositoriesService.HasPermission"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/comments", GoMethods: []string{"RepositoriesService.ListComments", "RepositoriesService.ListCommentsWithOptions"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/comments/{comment_id}", GoMethods: []string{"RepositoriesService.DeleteComment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/comments/{comment_id}", GoMethods: []string{"RepositoriesService.GetComment", "RepositoriesService.GetCommentWithOptions"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/comments/{comment_id}", GoMethods: []string{"RepositoriesService.UpdateComment", "RepositoriesService.UpdateCommentWithOptions"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/comments/{comment_id}/reactions", GoMethods: []string{"ReactionsService.ListCommentReactions"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/comments/{comment_id}/reactions", GoMethods: []string{"ReactionsService.CreateCommentReaction"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/comments/{comment_id}/reactions/{reaction_id}", GoMethods: []string{"ReactionsService.DeleteCommentReaction", "ReactionsService.DeleteCommentReactionByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits", GoMethods: []string{"RepositoriesService.ListCommits"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{commit_sha}/branches-where-head", GoMethods: []string{"RepositoriesService.ListBranchesHeadCommit"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{commit_sha}/comments", GoMethods: []string{"RepositoriesService.ListCommitComments", "RepositoriesService.ListCommitCommentsWithOptions"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/commits/{commit_sha}/comments", GoMethods: []string{"RepositoriesService.CreateComment", "RepositoriesService.CreateCommentWithOptions"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{commit_sha}/pulls", GoMethods: []string{"PullRequestsService.ListPullRequestsWithCommit"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}", GoMethods: []string{"RepositoriesService.GetCommit", "RepositoriesService.GetCommitDiff", "RepositoriesService.GetCommitRaw", "RepositoriesService.GetCommitRawTo", "RepositoriesService.GetCommitSHA1"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}/check-runs", GoMethods: []string{"ChecksService.ListCheckRunsForRef"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}/check-suites", GoMethods: []string{"ChecksService.ListCheckSuitesForRef"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}/status", GoMethods: []string{"RepositoriesService.GetCombinedStatus"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}/statuses", GoMethods: []string{"RepositoriesService.ListStatuses"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/community/profile", GoMethods: []string{"RepositoriesService.GetCommunityHealthMetrics"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/compare/{basehead}", GoMethods: []string{"RepositoriesService.CompareCommits", "RepositoriesService.CompareCommitsRaw", "RepositoriesService.ListCommitsBetween"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/content_references/{content_reference_id}/attachments", GoMethods: []string{"AppsService.CreateAttachment"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/contents/{path}", GoMethods: []string{"RepositoriesService.DeleteFile"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/contents/{path}", GoMethods: []string{"RepositoriesService.DownloadContents", "RepositoriesService.DownloadContentsWithMeta", "RepositoriesService.GetContents", "RepositoriesService.UploadFileToBranch"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/contents/{path}", GoMethods: []string{"RepositoriesService.CreateFile", "RepositoriesService.UpdateFile", "RepositoriesService.UploadFileToBranch"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/contributors", GoMethods: []string{"RepositoriesService.ListContributors"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/alerts", GoMethods: []string{"DependabotService.ListRepoAlerts"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/alerts/{alert_number}", GoMethods: []string{"DependabotService.GetRepoAlert"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/dependabot/alerts/{alert_number}", GoMethods: []string{"DependabotService.UpdateAlert"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/secrets", GoMethods: []string{"DependabotService.ListRepoSecrets"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/secrets/public-key", GoMethods: []string{"DependabotService.GetRepoPublicKey"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/dependabot/secrets/{secret_name}", GoMethods: []string{"DependabotService.DeleteRepoSecret"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/secrets/{secret_name}", GoMethods: []string{"DependabotService.GetRepoSecret"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/dependabot/secrets/{secret_name}", GoMethods: []string{"DependabotService.CreateOrUpdateRepoSecret"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependency-graph/sbom", GoMethods: []string{"DependencyGraphService.GetSBOM"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/dependency-graph/snapshots", GoMethods: []string{"DependencyGraphService.CreateSnapshot"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/deployments", GoMethods: []string{"RepositoriesService.ListDeployments"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/deployments", GoMethods: []string{"RepositoriesService.CreateDeployment"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}", GoMethods: []string{"RepositoriesService.DeleteDeployment", "RepositoriesService.DeleteDeploymentSafely"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}", GoMethods: []string{"RepositoriesService.GetDeployment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}/statuses", GoMethods: []string{"RepositoriesService.ListDeploymentStatuses", "RepositoriesService.WaitForDeployment"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}/statuses", GoMethods: []string{"RepositoriesService.CreateDeploymentStatus", "RepositoriesService.DeleteDeploymentSafely"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}/statuses/{status_id}", GoMethods: []string{"RepositoriesService.GetDeploymentStatus"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/dispatches", GoMethods: []string{"RepositoriesService.Dispatch", "RepositoriesService.DispatchAndWaitForRun"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments", GoMethods: []string{"RepositoriesService.ListEnvironments"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/environments/{environment_name}", GoMethods: []string{"RepositoriesService.DeleteEnvironment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}", GoMethods: []string{"RepositoriesService.GetEnvironment"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/environments/{environment_name}", GoMethods: []string{"RepositoriesService.CreateUpdateEnvironment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies", GoMethods: []string{"RepositoriesService.ListDeploymentBranchPolicies"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies", GoMethods: []string{"RepositoriesService.CreateDeploymentBranchPolicy"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}", GoMethods: []string{"RepositoriesService.DeleteDeploymentBranchPolicy"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}", GoMethods: []string{"RepositoriesService.GetDeploymentBranchPolicy"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}", GoMethods: []string{"RepositoriesService.UpdateDeploymentBranchPolicy"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules", GoMethods: []string{"RepositoriesService.GetAllDeploymentProtectionRules"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules", GoMethods: []string{"RepositoriesService.CreateCustomDeploymentProtectionRule"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/apps", GoMethods: []string{"RepositoriesService.ListCustomDeploymentRuleIntegrations"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/{protection_rule_id}", GoMethods: []string{"RepositoriesService.DisableCustomDeploymentProtectionRule"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/{protection_rule_id}", GoMethods: []string{"RepositoriesService.GetCustomDeploymentProtectionRule"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables", GoMethods: []string{"ActionsService.ListEnvVariables"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables", GoMethods: []string{"ActionsService.CreateEnvVariable"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables/{name}", GoMethods: []string{"ActionsService.DeleteEnvVariable"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables/{name}", GoMethods: []string{"ActionsService.GetEnvVariable"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables/{name}", GoMethods: []string{"ActionsService.UpdateEnvVariable"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/events", GoMethods: []string{"ActivityService.ListRepositoryEvents"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/forks", GoMethods: []string{"RepositoriesService.ListForks"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/forks", GoMethods: []string{"RepositoriesService.CreateFork"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/blobs", GoMethods: []string{"GitService.CreateBlob", "GitService.CreateBlobFromReader", "RepositoriesService.UploadFileToBranch"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/blobs/{file_sha}", GoMethods: []string{"GitService.DownloadBlob", "GitService.GetBlob", "GitService.GetBlobRaw"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/commits", GoMethods: []string{"GitService.CreateCommit", "RepositoriesService.UploadFileToBranch"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/commits/{commit_sha}", GoMethods: []string{"GitService.GetCommit", "RepositoriesService.UploadFileToBranch"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/matching-refs/{ref}", GoMethods: []string{"GitService.ListMatchingRefs"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/ref/{ref}", GoMethods: []string{"GitService.EnsureRef", "GitService.GetRef", "RepositoriesService.ListCommitsBetween", "RepositoriesService.ListTags", "RepositoriesService.UploadFileToBranch"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/refs", GoMethods: []string{"GitService.CreateRef", "GitService.EnsureRef"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/git/refs/{ref}", GoMethods: []string{"GitService.DeleteRef"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/git/refs/{ref}", GoMethods: []string{"GitService.EnsureRef", "GitService.UpdateRef", "RepositoriesService.UploadFileToBranch"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/tags", GoMethods: []string{"GitService.CreateTag", "GitService.CreateTagWithOptions"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/tags/{tag_sha}", GoMethods: []string{"GitService.GetTag", "RepositoriesService.ListCommitsBetween", "RepositoriesService.ListTags"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/trees", GoMethods: []string{"GitService.CreateTree", "RepositoriesService.UploadFileToBranch"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/trees/{tree_sha}", GoMethods: []string{"GitService.GetTree", "GitService.WalkTree"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks", GoMethods: []string{"RepositoriesService.ListHooks", "RepositoriesService.ListUnhealthyHooks"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/hooks", GoMethods: []string{"RepositoriesService.CreateHook"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/hooks/{hook_id}", GoMethods: []string{"RepositoriesService.DeleteHook"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks/{hook_id}", GoMethods: []string{"RepositoriesService.GetHook"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/hooks/{hook_id}", GoMethods: []string{"RepositoriesService.EditHook"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/config", GoMethods: []string{"RepositoriesService.GetHookConfiguration", "RepositoriesService.RotateHookSecret"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/config", GoMethods: []string{"RepositoriesService.EditHookConfiguration", "RepositoriesService.RotateHookSecret"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/deliveries", GoMethods: []string{"RepositoriesService.ListHookDeliveries"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/deliveries/{delivery_id}", GoMethods: []string{"RepositoriesService.GetHookDelivery"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/deliveries/{delivery_id}/attempts", GoMethods: []string{"RepositoriesService.RedeliverHookDelivery"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/pings", GoMethods: []string{"RepositoriesService.PingHook"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/tests", GoMethods: []string{"RepositoriesService.TestHook"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/import", GoMethods: []string{"MigrationService.CancelImport"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import", GoMethods: []string{"MigrationService.ImportProgress"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/import", GoMethods: []string{"MigrationService.UpdateImport"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/import", GoMethods: []string{"MigrationService.StartImport"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import/authors", GoMethods: []string{"MigrationService.CommitAuthors"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/import/authors/{author_id}", GoMethods: []string{"MigrationService.MapCommitAuthor"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import/issues", GoMethods: []string{"IssueImportService.CheckStatusSince"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/import/issues", GoMethods: []string{"IssueImportService.Create"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import/issues/{issue_number}", GoMethods: []string{"IssueImportService.CheckStatus"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import/large_files", GoMethods: []string{"MigrationService.LargeFiles"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/import/lfs", GoMethods: []string{"MigrationService.SetLFSPreference"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/installation", GoMethods: []string{"AppsService.FindRepositoryInstallation"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/interaction-limits", GoMethods: []string{"InteractionsService.RemoveRestrictionsFromRepo"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/interaction-limits", GoMethods: []string{"InteractionsService.GetRestrictionsForRepo"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/interaction-limits", GoMethods: []string{"InteractionsService.UpdateRestrictionsForRepo"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/invitations", GoMethods: []string{"RepositoriesService.ListInvitations"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/invitations/{invitation_id}", GoMethods: []string{"RepositoriesService.DeleteInvitation"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/invitations/{invitation_id}", GoMethods: []string{"RepositoriesService.UpdateInvitation"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues", GoMethods: []string{"IssuesService.ListByRepo", "IssuesService.ListByRepoWithAnyLabel"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues", GoMethods: []string{"IssuesService.Create"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/comments", GoMethods: []string{"IssuesService.ListComments"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}", GoMethods: []string{"IssuesService.DeleteComment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}", GoMethods: []string{"IssuesService.GetComment"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}", GoMethods: []string{"IssuesService.EditComment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}/reactions", GoMethods: []string{"ReactionsService.ListIssueCommentReactions"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}/reactions", GoMethods: []string{"ReactionsService.CreateIssueCommentReaction"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}/reactions/{reaction_id}", GoMethods: []string{"ReactionsService.DeleteIssueCommentReaction", "ReactionsService.DeleteIssueCommentReactionByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/events", GoMethods: []string{"ActivityService.ListIssueEventsForRepository", "IssuesService.ListRepositoryEvents"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/events/{event_id}", GoMethods: []string{"IssuesService.GetEvent"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}", GoMethods: []string{"IssuesService.Get"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/issues/{issue_number}", GoMethods: []string{"IssuesService.CloseIssue", "IssuesService.Edit", "IssuesService.RemoveMilestone", "IssuesService.ReopenIssue"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/assignees", GoMethods: []string{"IssuesService.RemoveAssignees"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/assignees", GoMethods: []string{"IssuesService.AddAssignees"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/comments", GoMethods: []string{"IssuesService.ListComments"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/comments", GoMethods: []string{"IssuesService.CreateComment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/events", GoMethods: []string{"IssuesService.ListIssueEvents"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels", GoMethods: []string{"IssuesService.RemoveLabelsForIssue"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels", GoMethods: []string{"IssuesService.ListLabelsByIssue"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels", GoMethods: []string{"IssuesService.AddLabelsToIssue", "IssuesService.BulkAddLabelsToIssues"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels", GoMethods: []string{"IssuesService.ReplaceLabelsForIssue"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels/{name}", GoMethods: []string{"IssuesService.RemoveLabelForIssue"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/lock", GoMethods: []string{"IssuesService.Unlock"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/issues/{issue_number}/lock", GoMethods: []string{"IssuesService.Lock"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/reactions", GoMethods: []string{"ReactionsService.ListIssueReactions"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/reactions", GoMethods: []string{"ReactionsService.CreateIssueReaction"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/reactions/{reaction_id}", GoMethods: []string{"ReactionsService.DeleteIssueReaction", "ReactionsService.DeleteIssueReactionByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/timeline", GoMethods: []string{"IssuesService.ListIssueTimeline"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/keys", GoMethods: []string{"RepositoriesService.ListKeys"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/keys", GoMethods: []string{"RepositoriesService.CreateKey"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/keys/{key_id}", GoMethods: []string{"RepositoriesService.DeleteKey"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/keys/{key_id}", GoMethods: []string{"RepositoriesService.GetKey"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/labels", GoMethods: []string{"IssuesService.EnsureLabels", "IssuesService.ListLabels"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/labels", GoMethods: []string{"IssuesService.CreateLabel", "IssuesService.EnsureLabels"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/labels/{name}", GoMethods: []string{"IssuesService.DeleteLabel"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/labels/{name}", GoMethods: []string{"IssuesService.GetLabel"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/labels/{name}", GoMethods: []string{"IssuesService.EditLabel", "IssuesService.EnsureLabels"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/languages", GoMethods: []string{"RepositoriesService.ListLanguages"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/lfs", GoMethods: []string{"RepositoriesService.DisableLFS"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/lfs", GoMethods: []string{"RepositoriesService.EnableLFS"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/license", GoMethods: []string{"RepositoriesService.License"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/merge-upstream", GoMethods: []string{"RepositoriesService.MergeUpstream"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/merges", GoMethods: []string{"RepositoriesService.Merge"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/milestones", GoMethods: []string{"IssuesService.ListMilestones"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/milestones", GoMethods: []string{"IssuesService.CreateMilestone"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/milestones/{milestone_number}", GoMethods: []string{"IssuesService.DeleteMilestone"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/milestones/{milestone_number}", GoMethods: []string{"IssuesService.GetMilestone"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/milestones/{milestone_number}", GoMethods: []string{"IssuesService.EditMilestone"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/milestones/{milestone_number}/labels", GoMethods: []string{"IssuesService.ListLabelsForMilestone"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/notifications", GoMethods: []string{"ActivityService.ListRepositoryNotifications"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/notifications", GoMethods: []string{"ActivityService.MarkRepositoryNotificationsRead"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pages", GoMethods: []string{"RepositoriesService.DisablePages"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages", GoMethods: []string{"RepositoriesService.GetPagesInfo"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pages", GoMethods: []string{"RepositoriesService.EnablePages"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pages", GoMethods: []string{"RepositoriesService.UpdatePages"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/builds", GoMethods: []string{"RepositoriesService.ListPagesBuilds"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pages/builds", GoMethods: []string{"RepositoriesService.RequestPageBuild"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/builds/latest", GoMethods: []string{"RepositoriesService.GetLatestPagesBuild"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/builds/{build_id}", GoMethods: []string{"RepositoriesService.GetPageBuild"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pages/deployments", GoMethods: []string{"RepositoriesService.CreatePagesDeployment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}", GoMethods: []string{"RepositoriesService.GetPagesDeploymentStatus"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}/cancel", GoMethods: []string{"RepositoriesService.CancelPagesDeployment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/health", GoMethods: []string{"RepositoriesService.GetPageHealthCheck"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pre-receive-hooks", GoMethods: []string{"RepositoriesService.ListPreReceiveHooks"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pre-receive-hooks/{pre_receive_hook_id}", GoMethods: []string{"RepositoriesService.DeletePreReceiveHook"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pre-receive-hooks/{pre_receive_hook_id}", GoMethods: []string{"RepositoriesService.GetPreReceiveHook"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/pre-receive-hooks/{pre_receive_hook_id}", GoMethods: []string{"RepositoriesService.UpdatePreReceiveHook"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/private-vulnerability-reporting", GoMethods: []string{"RepositoriesService.DisablePrivateReporting"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/private-vulnerability-reporting", GoMethods: []string{"RepositoriesService.IsPrivateReportingEnabled"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/private-vulnerability-reporting", GoMethods: []string{"RepositoriesService.EnablePrivateReporting"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/projects", GoMethods: []string{"RepositoriesService.ListProjects"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/projects", GoMethods: []string{"RepositoriesService.CreateProject"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/properties/values", GoMethods: []string{"RepositoriesService.GetAllCustomPropertyValues"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/properties/values", GoMethods: []string{"RepositoriesService.CreateOrUpdateCustomProperties"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls", GoMethods: []string{"PullRequestsService.List"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls", GoMethods: []string{"PullRequestsService.Create"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/comments", GoMethods: []string{"PullRequestsService.ListComments"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}", GoMethods: []string{"PullRequestsService.DeleteComment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}", GoMethods: []string{"PullRequestsService.GetComment"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}", GoMethods: []string{"PullRequestsService.EditComment"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions", GoMethods: []string{"ReactionsService.ListPullRequestCommentReactions"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions", GoMethods: []string{"ReactionsService.CreatePullRequestCommentReaction"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions/{reaction_id}", GoMethods: []string{"ReactionsService.DeletePullRequestCommentReaction", "ReactionsService.DeletePullRequestCommentReactionByID"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}", GoMethods: []string{"PullRequestsService.Get", "PullRequestsService.GetDiff", "PullRequestsService.GetPatch", "PullRequestsService.GetRaw", "PullRequestsService.GetRawTo"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/pulls/{pull_number}", GoMethods: []string{"PullRequestsService.Edit"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/comments", GoMethods: []string{"PullRequestsService.ListComments"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/comments", GoMethods: []string{"PullRequestsService.CreateComment", "PullRequestsService.CreateCommentInReplyTo"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/commits", GoMethods: []string{"PullRequestsService.ListCommits"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/files", GoMethods: []string{"PullRequestsService.ListFiles"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/merge", GoMethods: []string{"PullRequestsService.IsMerged"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/merge", GoMethods: []string{"PullRequestsService.Merge"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers", GoMethods: []string{"PullRequestsService.RemoveReviewers"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers", GoMethods: []string{"PullRequestsService.ListReviewers"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers", GoMethods: []string{"PullRequestsService.RequestReviewers"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews", GoMethods: []string{"PullRequestsService.ListReviews"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews", GoMethods: []string{"PullRequestsService.CreateReview"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}", GoMethods: []string{"PullRequestsService.DeletePendingReview"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}", GoMethods: []string{"PullRequestsService.GetReview"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}", GoMethods: []string{"PullRequestsService.UpdateReview"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/comments", GoMethods: []string{"PullRequestsService.ListReviewComments"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/dismissals", GoMethods: []string{"PullRequestsService.DismissReview"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/events", GoMethods: []string{"PullRequestsService.SubmitReview"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/update-branch", GoMethods: []string{"PullRequestsService.UpdateBranch"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/readme", GoMethods: []string{"RepositoriesService.GetReadme"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/readme/{dir}", GoMethods: []string{"RepositoriesService.GetRawReadmeOfDirectory", "RepositoriesService.GetReadmeOfDirectory"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases", GoMethods: []string{"RepositoriesService.ListReleases"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/releases", GoMethods: []string{"RepositoriesService.CreateRelease", "RepositoriesService.CreateReleaseWithGeneratedNotes"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/releases/assets/{asset_id}", GoMethods: []string{"RepositoriesService.DeleteReleaseAsset", "RepositoriesService.UploadReleaseAssetFromReader"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/assets/{asset_id}", GoMethods: []string{"RepositoriesService.DownloadReleaseAsset", "RepositoriesService.DownloadReleaseAssetStream", "RepositoriesService.GetReleaseAsset"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/releases/assets/{asset_id}", GoMethods: []string{"RepositoriesService.EditReleaseAsset"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/releases/generate-notes", GoMethods: []string{"RepositoriesService.CreateReleaseWithGeneratedNotes", "RepositoriesService.GenerateReleaseNotes"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/latest", GoMethods: []string{"RepositoriesService.GetLatestRelease"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/tags/{tag}", GoMethods: []string{"RepositoriesService.GetReleaseByTag"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/releases/{release_id}", GoMethods: []string{"RepositoriesService.DeleteRelease"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/{release_id}", GoMethods: []string{"RepositoriesService.GetRelease"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/releases/{release_id}", GoMethods: []string{"RepositoriesService.EditRelease", "RepositoriesService.PublishDraftRelease"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/{release_id}/assets", GoMethods: []string{"RepositoriesService.ListReleaseAssets", "RepositoriesService.UploadReleaseAssetFromReader"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/releases/{release_id}/assets", GoMethods: []string{"RepositoriesService.UploadReleaseAsset", "RepositoriesService.UploadReleaseAssetFromReader"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/releases/{release_id}/reactions", GoMethods: []string{"ReactionsService.CreateReleaseReaction"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rules/branches/{branch}", GoMethods: []string{"RepositoriesService.GetMergeQueueConfig", "RepositoriesService.GetRulesForBranch"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rulesets", GoMethods: []string{"RepositoriesService.ApplyRulesetTemplate", "RepositoriesService.ApplyRulesetTemplateForEvent", "RepositoriesService.GetAllRulesets", "RepositoriesService.IsTagProtected"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/rulesets", GoMethods: []string{"RepositoriesService.ApplyRulesetTemplate", "RepositoriesService.ApplyRulesetTemplateForEvent", "RepositoriesService.CreateRuleset"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/rulesets/{ruleset_id}", GoMethods: []string{"RepositoriesService.DeleteRuleset"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rulesets/{ruleset_id}", GoMethods: []string{"RepositoriesService.ApplyRulesetTemplate", "RepositoriesService.ApplyRulesetTemplateForEvent", "RepositoriesService.GetRuleset", "RepositoriesService.IsTagProtected", "RepositoriesService.UpdateMergeQueueConfig"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/rulesets/{ruleset_id}", GoMethods: []string{"RepositoriesService.ApplyRulesetTemplate", "RepositoriesService.ApplyRulesetTemplateForEvent", "RepositoriesService.UpdateMergeQueueConfig", "RepositoriesService.UpdateRuleset"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/secret-scanning/alerts", GoMethods: []string{"SecretScanningService.ListAlertsForRepo"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}", GoMethods: []string{"SecretScanningService.GetAlert"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}", GoMethods: []string{"SecretScanningService.UpdateAlert"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}/locations", GoMethods: []string{"SecretScanningService.ListLocationsForAlert"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/security-advisories", GoMethods: []string{"SecurityAdvisoriesService.ListRepositorySecurityAdvisories"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/security-advisories", GoMethods: []string{"SecurityAdvisoriesService.CreateRepositorySecurityAdvisory"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/security-advisories/{ghsa_id}", GoMethods: []string{"SecurityAdvisoriesService.GetRepositorySecurityAdvisory"}},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/security-advisories/{ghsa_id}", GoMethods: []string{"SecurityAdvisoriesService.UpdateRepositorySecurityAdvisory"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/security-advisories/{ghsa_id}/cve", GoMethods: []string{"SecurityAdvisoriesService.RequestCVE"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/security-advisories/{ghsa_id}/forks", GoMethods: []string{"SecurityAdvisoriesService.CreateTemporaryPrivateFork"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stargazers", GoMethods: []string{"ActivityService.ExportStargazers", "ActivityService.ListStargazers"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/code_frequency", GoMethods: []string{"RepositoriesService.ListCodeFrequency"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/commit_activity", GoMethods: []string{"RepositoriesService.ListCommitActivity"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/contributors", GoMethods: []string{"RepositoriesService.ListContributorsStats"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/participation", GoMethods: []string{"RepositoriesService.ListParticipation"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/punch_card", GoMethods: []string{"RepositoriesService.ListPunchCard"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/statuses/{sha}", GoMethods: []string{"RepositoriesService.CreateStatus"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/subscribers", GoMethods: []string{"ActivityService.ListWatchers"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/subscription", GoMethods: []string{"ActivityService.DeleteRepositorySubscription"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/subscription", GoMethods: []string{"ActivityService.GetRepositorySubscription"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/subscription", GoMethods: []string{"ActivityService.SetRepositorySubscription"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/tags", GoMethods: []string{"RepositoriesService.ListTags"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/tags/protection", GoMethods: []string{"RepositoriesService.ListTagProtection"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/tags/protection", GoMethods: []string{"RepositoriesService.CreateTagProtection"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/tags/protection/{tag_protection_id}", GoMethods: []string{"RepositoriesService.DeleteTagProtection"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/tarball/{ref}", GoMethods: []string{"RepositoriesService.GetArchiveLink"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/teams", GoMethods: []string{"RepositoriesService.ListTeams"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/topics", GoMethods: []string{"RepositoriesService.AddTopics", "RepositoriesService.ListAllTopics", "RepositoriesService.RemoveTopics"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/topics", GoMethods: []string{"RepositoriesService.AddTopics", "RepositoriesService.RemoveTopics", "RepositoriesService.ReplaceAllTopics", "RepositoriesService.ReplaceTopics"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/traffic/clones", GoMethods: []string{"RepositoriesService.ListTrafficClones"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/traffic/popular/paths", GoMethods: []string{"RepositoriesService.ListTrafficPaths"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/traffic/popular/referrers", GoMethods: []string{"RepositoriesService.ListTrafficReferrers"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/traffic/views", GoMethods: []string{"RepositoriesService.ListTrafficViews"}},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/transfer", GoMethods: []string{"RepositoriesService.Transfer"}},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/vulnerability-alerts", GoMethods: []string{"RepositoriesService.DisableVulnerabilityAlerts"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/vulnerability-alerts", GoMethods: []string{"RepositoriesService.GetVulnerabilityAlerts"}},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/vulnerability-alerts", GoMethods: []string{"RepositoriesService.EnableVulnerabilityAlerts"}},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/zipball/{ref}", GoMethods: []string{"RepositoriesService.GetArchiveLink"}},
+	{Method: "POST", Path: "/repos/{template_owner}/{template_repo}/generate", GoMethods: []string{"RepositoriesService.CreateFromTemplate"}},
+	{Method: "GET", Path: "/repositories", GoMethods: []string{"RepositoriesService.ListAll"}},
+	{Method: "GET", Path: "/repositories/{repository_id}", GoMethods: []string{"RepositoriesService.GetByID"}},
+	{Method: "GET", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets", GoMethods: []string{"ActionsService.ListEnvSecrets"}},
+	{Method: "GET", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets/public-key", GoMethods: []string{"ActionsService.GetEnvPublicKey"}},
+	{Method: "DELETE", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}", GoMethods: []string{"ActionsService.DeleteEnvSecret"}},
+	{Method: "GET", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}", GoMethods: []string{"ActionsService.GetEnvSecret"}},
+	{Method: "PUT", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}", GoMethods: []string{"ActionsService.CreateOrUpdateEnvSecret"}},
+	{Method: "GET", Path: "/repositories/{repository_id}/installation", GoMethods: []string{"AppsService.FindRepositoryInstallationByID"}},
+	{Method: "GET", Path: "/scim/v2/organizations/{org}/Users", GoMethods: []string{"SCIMService.ListSCIMProvisionedIdentities"}},
+	{Method: "POST", Path: "/scim/v2/organizations/{org}/Users", GoMethods: []string{"SCIMService.ProvisionAndInviteSCIMUser"}},
+	{Method: "DELETE", Path: "/scim/v2/organizations/{org}/Users/{scim_user_id}", GoMethods: []string{"SCIMService.DeleteSCIMUserFromOrg"}},
+	{Method: "GET", Path: "/scim/v2/organizations/{org}/Users/{scim_user_id}", GoMethods: []string{"SCIMService.GetSCIMProvisioningInfoForUser"}},
+	{Method: "PATCH", Path: "/scim/v2/organizations/{org}/Users/{scim_user_id}", GoMethods: []string{"SCIMService.UpdateAttributeForSCIMUser"}},
+	{Method: "PUT", Path: "/scim/v2/organizations/{org}/Users/{scim_user_id}", GoMethods: []string{"SCIMService.UpdateProvisionedOrgMembership"}},
+	{Method: "GET", Path: "/search/code", GoMethods: []string{"SearchService.AllCode", "SearchService.Code"}},
+	{Method: "GET", Path: "/search/commits", GoMethods: []string{"SearchService.AllCommits", "SearchService.Commits"}},
+	{Method: "GET", Path: "/search/issues", GoMethods: []string{"SearchService.AllIssues", "SearchService.Issues"}},
+	{Method: "GET", Path: "/search/labels", GoMethods: []string{"SearchService.Labels"}},
+	{Method: "GET", Path: "/search/repositories", GoMethods: []string{"SearchService.AllRepositories", "SearchService.Repositories"}},
+	{Method: "GET", Path: "/search/topics", GoMethods: []string{"SearchService.Topics"}},
+	{Method: "GET", Path: "/search/users", GoMethods: []string{"SearchService.AllUsers", "SearchService.Users"}},
+	{Method: "GET", Path: "/teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}/reactions", GoMethods: []string{"ReactionsService.ListTeamDiscussionCommentReactions"}},
+	{Method: "POST", Path: "/teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}/reactions", GoMethods: []string{"ReactionsService.CreateTeamDiscussionCommentReaction"}},
+	{Method: "GET", Path: "/teams/{team_id}/discussions/{discussion_number}/reactions", GoMethods: []string{"ReactionsService.ListTeamDiscussionReactions"}},
+	{Method: "POST", Path: "/teams/{team_id}/discussions/{discussion_number}/reactions", GoMethods: []string{"ReactionsService.CreateTeamDiscussionReaction"}},
+	{Method: "GET", Path: "/user", GoMethods: []string{"UsersService.Get"}},
+	{Method: "PATCH", Path: "/user", GoMethods: []string{"UsersService.Edit"}},
+	{Method: "GET", Path: "/user/blocks", GoMethods: []string{"UsersService.ListBlockedUsers"}},
+	{Method: "DELETE", Path: "/user/blocks/{username}", GoMethods: []string{"UsersService.UnblockUser"}},
+	{Method: "GET", Path: "/user/blocks/{username}", GoMethods: []string{"UsersService.IsBlocked"}},
+	{Method: "PUT", Path: "/user/blocks/{username}", GoMethods: []string{"UsersService.BlockUser"}},
+	{Method: "GET", Path: "/user/codespaces", GoMethods: []string{"CodespacesService.List"}},
+	{Method: "GET", Path: "/user/codespaces/secrets", GoMethods: []string{"CodespacesService.ListUserSecrets"}},
+	{Method: "GET", Path: "/user/codespaces/secrets/public-key", GoMethods: []string{"CodespacesService.GetUserPublicKey"}},
+	{Method: "DELETE", Path: "/user/codespaces/secrets/{secret_name}", GoMethods: []string{"CodespacesService.DeleteUserSecret"}},
+	{Method: "GET", Path: "/user/codespaces/secrets/{secret_name}", GoMethods: []string{"CodespacesService.GetUserSecret"}},
+	{Method: "PUT", Path: "/user/codespaces/secrets/{secret_name}", GoMethods: []string{"CodespacesService.CreateOrUpdateUserSecret"}},
+	{Method: "GET", Path: "/user/codespaces/secrets/{secret_name}/repositories", GoMethods: []string{"CodespacesService.ListSelectedReposForUserSecret"}},
+	{Method: "PUT", Path: "/user/codespaces/secrets/{secret_name}/repositories", GoMethods: []string{"CodespacesService.SetSelectedReposForUserSecret"}},
+	{Method: "DELETE", Path: "/user/codespaces/secrets/{secret_name}/repositories/{repository_id}", GoMethods: []string{"CodespacesService.RemoveSelectedRepoFromUserSecret"}},
+	{Method: "PUT", Path: "/user/codespaces/secrets/{secret_name}/repositories/{repository_id}", GoMethods: []string{"CodespacesService.AddSelectedRepoToUserSecret"}},
+	{Method: "DELETE", Path: "/user/codespaces/{codespace_name}", GoMethods: []string{"CodespacesService.Delete"}},
+	{Method: "POST", Path: "/user/codespaces/{codespace_name}/start", GoMethods: []string{"CodespacesService.Start"}},
+	{Method: "POST", Path: "/user/codespaces/{codespace_name}/stop", GoMethods: []string{"CodespacesService.Stop"}},
+	{Method: "PATCH", Path: "/user/email/visibility", GoMethods: []string{"UsersService.SetEmailVisibility"}},
+	{Method: "DELETE", Path: "/user/emails", GoMethods: []string{"UsersService.DeleteEmails"}},
+	{Method: "GET", Path: "/user/emails", GoMethods: []string{"UsersService.GetPrimaryVerifiedEmail", "UsersService.ListEmails"}},
+	{Method: "POST", Path: "/user/emails", GoMethods: []string{"UsersService.AddEmails"}},
+	{Method: "GET", Path: "/user/followers", GoMethods: []string{"UsersService.ListFollowers"}},
+	{Method: "GET", Path: "/user/following", GoMethods: []string{"UsersService.ListFollowing"}},
+	{Method: "DELETE", Path: "/user/following/{username}", GoMethods: []string{"UsersService.Unfollow"}},
+	{Method: "GET", Path: "/user/following/{username}", GoMethods: []string{"UsersService.IsFollowing"}},
+	{Method: "PUT", Path: "/user/following/{username}", GoMethods: []string{"UsersService.Follow"}},
+	{Method: "GET", Path: "/user/gpg_keys", GoMethods: []string{"UsersService.ListGPGKeys"}},
+	{Method: "POST", Path: "/user/gpg_keys", GoMethods: []string{"UsersService.CreateGPGKey"}},
+	{Method: "DELETE", Path: "/user/gpg_keys/{gpg_key_id}", GoMethods: []string{"UsersService.DeleteGPGKey"}},
+	{Method: "GET", Path: "/user/gpg_keys/{gpg_key_id}", GoMethods: []string{"UsersService.GetGPGKey"}},
+	{Method: "GET", Path: "/user/installations", GoMethods: []string{"AppsService.GetUserInstallationPermissions", "AppsService.ListUserInstallations", "AppsService.ListUserInstallationsWithOptions"}},
+	{Method: "GET", Path: "/user/installations/{installation_id}/repositories", GoMethods: []string{"AppsService.ListUserRepos"}},
+	{Method: "DELETE", Path: "/user/installations/{installation_id}/repositories/{repository_id}", GoMethods: []string{"AppsService.RemoveRepository"}},
+	{Method: "PUT", Path: "/user/installations/{installation_id}/repositories/{repository_id}", GoMethods: []string{"AppsService.AddRepository"}},
+	{Method: "GET", Path: "/user/issues", GoMethods: []string{"IssuesService.List"}},
+	{Method: "GET", Path: "/user/keys", GoMethods: []string{"UsersService.ListKeys"}},
+	{Method: "POST", Path: "/user/keys", GoMethods: []string{"UsersService.CreateKey"}},
+	{Method: "DELETE", Path: "/user/keys/{key_id}", GoMethods: []string{"UsersService.DeleteKey"}},
+	{Method: "GET", Path: "/user/keys/{key_id}", GoMethods: []string{"UsersService.GetKey"}},
+	{Method: "GET", Path: "/user/marketplace_purchases", GoMethods: []string{"MarketplaceService.ListMarketplacePurchasesForUser"}},
+	{Method: "GET", Path: "/user/marketplace_purchases/stubbed", GoMethods: []string{"MarketplaceService.ListMarketplacePurchasesForUser"}},
+	{Method: "GET", Path: "/user/memberships/orgs", GoMethods: []string{"OrganizationsService.ListOrgMemberships"}},
+	{Method: "GET", Path: "/user/memberships/orgs/{org}", GoMethods: []string{"OrganizationsService.GetOrgMembership"}},
+	{Method: "PATCH", Path: "/user/memberships/orgs/{org}", GoMethods: []string{"OrganizationsService.EditOrgMembership"}},
+	{Method: "GET", Path: "/user/migrations", GoMethods: []string{"MigrationService.ListUserMigrations"}},
+	{Method: "POST", Path: "/user/migrations", GoMethods: []string{"MigrationService.StartUserMigration"}},
+	{Method: "GET", Path: "/user/migrations/{migration_id}", GoMethods: []string{"MigrationService.UserMigrationStatus", "MigrationService.WaitForUserMigration"}},
+	{Method: "DELETE", Path: "/user/migrations/{migration_id}/archive", GoMethods: []string{"MigrationService.DeleteUserMigration"}},
+	{Method: "GET", Path: "/user/migrations/{migration_id}/archive", GoMethods: []string{"MigrationService.DownloadUserMigrationArchive", "MigrationService.UserMigrationArchiveURL"}},
+	{Method: "DELETE", Path: "/user/migrations/{migration_id}/repos/{repo_name}/lock", GoMethods: []string{"MigrationService.UnlockUserRepo"}},
+	{Method: "GET", Path: "/user/orgs", GoMethods: []string{"OrganizationsService.List"}},
+	{Method: "GET", Path: "/user/packages", GoMethods: []string{"UsersService.ListPackages"}},
+	{Method: "DELETE", Path: "/user/packages/{package_type}/{package_name}", GoMethods: []string{"UsersService.DeletePackage"}},
+	{Method: "GET", Path: "/user/packages/{package_type}/{package_name}", GoMethods: []string{"UsersService.GetPackage"}},
+	{Method: "POST", Path: "/user/packages/{package_type}/{package_name}/restore", GoMethods: []string{"UsersService.RestorePackage"}},
+	{Method: "GET", Path: "/user/packages/{package_type}/{package_name}/versions", GoMethods: []string{"UsersService.PackageGetAllVersions"}},
+	{Method: "DELETE", Path: "/user/packages/{package_type}/{package_name}/versions/{package_version_id}", GoMethods: []string{"UsersService.PackageDeleteVersion"}},
+	{Method: "GET", Path: "/user/packages/{package_type}/{package_name}/versions/{package_version_id}", GoMethods: []string{"UsersService.PackageGetVersion"}},
+	{Method: "POST", Path: "/user/packages/{package_type}/{package_name}/versions/{package_version_id}/restore", GoMethods: []string{"UsersService.PackageRestoreVersion"}},
+	{Method: "POST", Path: "/user/projects", GoMethods: []string{"UsersService.CreateProject"}},
+	{Method: "GET", Path: "/user/repos", GoMethods: []string{"RepositoriesService.List", "RepositoriesService.ListByAuthenticatedUser"}},
+	{Method: "POST", Path: "/user/repos", GoMethods: []string{"RepositoriesService.Create"}},
+	{Method: "GET", Path: "/user/repository_invitations", GoMethods: []string{"UsersService.ListInvitations"}},
+	{Method: "DELETE", Path: "/user/repository_invitations/{invitation_id}", GoMethods: []string{"UsersService.DeclineInvitation"}},
+	{Method: "PATCH", Path: "/user/repository_invitations/{invitation_id}", GoMethods: []string{"UsersService.AcceptInvitation"}},
+	{Method: "DELETE", Path: "/user/social_accounts", GoMethods: []string{"UsersService.DeleteSocialAccounts"}},
+	{Method: "GET", Path: "/user/social_accounts", GoMethods: []string{"UsersService.ListSocialAccounts"}},
+	{Method: "POST", Path: "/user/social_accounts", GoMethods: []string{"UsersService.AddSocialAccounts"}},
+	{Method: "GET", Path: "/user/ssh_signing_keys", GoMethods: []string{"UsersService.ListSSHSigningKeys"}},
+	{Method: "POST", Path: "/user/ssh_signing_keys", GoMethods: []string{"UsersService.CreateSSHSigningKey"}},
+	{Method: "DELETE", Path: "/user/ssh_signing_keys/{ssh_signing_key_id}", GoMethods: []string{"UsersService.DeleteSSHSigningKey"}},
+	{Method: "GET", Path: "/user/ssh_signing_keys/{ssh_signing_key_id}", GoMethods: []string{"UsersService.GetSSHSigningKey"}},
+	{Method: "GET", Path: "/user/starred", GoMethods: []string{"ActivityService.AreStarred", "ActivityService.ListStarred"}},
+	{Method: "DELETE", Path: "/user/starred/{owner}/{repo}", GoMethods: []string{"ActivityService.Unstar"}},
+	{Method: "GET", Path: "/user/starred/{owner}/{repo}", GoMethods: []string{"ActivityService.AreStarred", "ActivityService.IsStarred"}},
+	{Method: "PUT", Path: "/user/starred/{owner}/{repo}", GoMethods: []string{"ActivityService.Star"}},
+	{Method: "GET", Path: "/user/subscriptions", GoMethods: []string{"ActivityService.ListWatched"}},
+	{Method: "GET", Path: "/user/teams", GoMethods: []string{"TeamsService.ListUserTeams"}},
+	{Method: "GET", Path: "/user/{user_id}", GoMethods: []string{"UsersService.GetByID"}},
+	{Method: "GET", Path: "/users", GoMethods: []string{"UsersService.ListAll"}},
+	{Method: "GET", Path: "/users/{username}", GoMethods: []string{"UsersService.Get"}},
+	{Method: "GET", Path: "/users/{username}/events", GoMethods: []string{"ActivityService.ListEventsPerformedByUser"}},
+	{Method: "GET", Path: "/users/{username}/events/orgs/{org}", GoMethods: []string{"ActivityService.ListUserEventsForOrganization"}},
+	{Method: "GET", Path: "/users/{username}/events/public", GoMethods: []string{"ActivityService.ListEventsPerformedByUser"}},
+	{Method: "GET", Path: "/users/{username}/followers", GoMethods: []string{"UsersService.ListFollowers"}},
+	{Method: "GET", Path: "/users/{username}/following", GoMethods: []string{"UsersService.ListFollowing"}},
+	{Method: "GET", Path: "/users/{username}/following/{target_user}", GoMethods: []string{"UsersService.IsFollowing"}},
+	{Method: "GET", Path: "/users/{username}/gists", GoMethods: []string{"GistsService.List"}},
+	{Method: "GET", Path: "/users/{username}/gpg_keys", GoMethods: []string{"UsersService.ListGPGKeys"}},
+	{Method: "GET", Path: "/users/{username}/hovercard", GoMethods: []string{"UsersService.GetHovercard"}},
+	{Method: "GET", Path: "/users/{username}/installation", GoMethods: []string{"AppsService.FindUserInstallation"}},
+	{Method: "GET", Path: "/users/{username}/keys", GoMethods: []string{"UsersService.ListKeys"}},
+	{Method: "GET", Path: "/users/{username}/orgs", GoMethods: []string{"OrganizationsService.List"}},
+	{Method: "GET", Path: "/users/{username}/packages", GoMethods: []string{"UsersService.ListPackages"}},
+	{Method: "DELETE", Path: "/users/{username}/packages/{package_type}/{package_name}", GoMethods: []string{"UsersService.DeletePackage"}},
+	{Method: "GET", Path: "/users/{username}/packages/{package_type}/{package_name}", GoMethods: []string{"UsersService.GetPackage"}},
+	{Method: "POST", Path: "/users/{username}/packages/{package_type}/{package_name}/restore", GoMethods: []string{"UsersService.RestorePackage"}},
+	{Method: "GET", Path: "/users/{username}/packages/{package_type}/{package_name}/versions", GoMethods: []string{"UsersService.PackageGetAllVersions"}},
+	{Method: "DELETE", Path: "/users/{username}/packages/{package_type}/{package_name}/versions/{package_version_id}", GoMethods: []string{"UsersService.PackageDeleteVersion"}},
+	{Method: "GET", Path: "/users/{username}/packages/{package_type}/{package_name}/versions/{package_version_id}", GoMethods: []string{"UsersService.PackageGetVersion"}},
+	{Method: "POST", Path: "/users/{username}/packages/{package_type}/{package_name}/versions/{package_version_id}/restore", GoMethods: []string{"UsersService.PackageRestoreVersion"}},
+	{Method: "GET", Path: "/users/{username}/projects", GoMethods: []string{"UsersService.ListProjects"}},
+	{Method: "GET", Path: "/users/{username}/received_events", GoMethods: []string{"ActivityService.ListEventsReceivedByUser"}},
+	{Method: "GET", Path: "/users/{username}/received_events/public", GoMethods: []string{"ActivityService.ListEventsReceivedByUser"}},
+	{Method: "GET", Path: "/users/{username}/repos", GoMethods: []string{"RepositoriesService.List", "RepositoriesService.ListByUser"}},
+	{Method: "GET", Path: "/users/{username}/settings/billing/actions", GoMethods: []string{"BillingService.GetActionsBillingUser"}},
+	{Method: "GET", Path: "/users/{username}/settings/billing/packages", GoMethods: []string{"BillingService.GetPackagesBillingUser"}},
+	{Method: "GET", Path: "/users/{username}/settings/billing/shared-storage", GoMethods: []string{"BillingService.GetStorageBillingUser"}},
+	{Method: "DELETE", Path: "/users/{username}/site_admin", GoMethods: []string{"UsersService.DemoteSiteAdmin"}},
+	{Method: "PUT", Path: "/users/{username}/site_admin", GoMethods: []string{"UsersService.PromoteSiteAdmin"}},
+	{Method: "GET", Path: "/users/{username}/social_accounts", GoMethods: []string{"UsersService.ListSocialAccounts"}},
+	{Method: "GET", Path: "/users/{username}/ssh_signing_keys", GoMethods: []string{"UsersService.ListSSHSigningKeys"}},
+	{Method: "GET", Path: "/users/{username}/starred", GoMethods: []string{"ActivityService.ListStarred"}},
+	{Method: "GET", Path: "/users/{username}/subscriptions", GoMethods: []string{"ActivityService.ListWatched"}},
+	{Method: "DELETE", Path: "/users/{username}/suspended", GoMethods: []string{"UsersService.Unsuspend"}},
+	{Method: "PUT", Path: "/users/{username}/suspended", GoMethods: []string{"UsersService.Suspend"}},
+	{Method: "GET", Path: "/versions", GoMethods: []string{"MetaService.ListAPIVersions"}},
+	{Method: "GET", Path: "/zen", GoMethods: []string{"MetaService.Zen"}},
+}
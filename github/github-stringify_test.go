@@ -92,6 +92,41 @@ func TestAdvancedSecurity_String(t *testing.T) {
 	}
 }
 
+func TestAnnouncement_String(t *testing.T) {
+	v := Announcement{
+		Announcement:    String(""),
+		ExpiresAt:       &Timestamp{},
+		UserDismissible: Bool(false),
+	}
+	want := `github.Announcement{Announcement:"", ExpiresAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UserDismissible:false}`
+	if got := v.String(); got != want {
+		t.Errorf("Announcement.String = %v, want %v", got, want)
+	}
+}
+
+func TestAppConfig_String(t *testing.T) {
+	v := AppConfig{
+		ID:            Int64(0),
+		Slug:          String(""),
+		NodeID:        String(""),
+		Owner:         &User{},
+		Name:          String(""),
+		Description:   String(""),
+		ExternalURL:   String(""),
+		HTMLURL:       String(""),
+		CreatedAt:     &Timestamp{},
+		UpdatedAt:     &Timestamp{},
+		ClientID:      String(""),
+		ClientSecret:  String(""),
+		WebhookSecret: String(""),
+		PEM:           String(""),
+	}
+	want := `github.AppConfig{ID:0, Slug:"", NodeID:"", Owner:github.User{}, Name:"", Description:"", ExternalURL:"", HTMLURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, ClientID:"", ClientSecret:"", WebhookSecret:"", PEM:""}`
+	if got := v.String(); got != want {
+		t.Errorf("AppConfig.String = %v, want %v", got, want)
+	}
+}
+
 func TestAuthorization_String(t *testing.T) {
 	v := Authorization{
 		ID:             Int64(0),
@@ -218,13 +253,20 @@ func TestCodeOfConduct_String(t *testing.T) {
 
 func TestCodeResult_String(t *testing.T) {
 	v := CodeResult{
-		Name:       String(""),
-		Path:       String(""),
-		SHA:        String(""),
-		HTMLURL:    String(""),
-		Repository: &Repository{},
+		Name:           String(""),
+		Path:           String(""),
+		SHA:            String(""),
+		URL:            String(""),
+		GitURL:         String(""),
+		HTMLURL:        String(""),
+		Repository:     &Repository{},
+		Score:          Float64(0.0),
+		FileSize:       Int(0),
+		Language:       String(""),
+		LastModifiedAt: &Timestamp{},
+		LineNumbers:    []string{""},
 	}
-	want := `github.CodeResult{Name:"", Path:"", SHA:"", HTMLURL:"", Repository:github.Repository{}}`
+	want := `github.CodeResult{Name:"", Path:"", SHA:"", URL:"", GitURL:"", HTMLURL:"", Repository:github.Repository{}, Score:0, FileSize:0, Language:"", LastModifiedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, LineNumbers:[""]}`
 	if got := v.String(); got != want {
 		t.Errorf("CodeResult.String = %v, want %v", got, want)
 	}
@@ -508,14 +550,15 @@ func TestGistCommit_String(t *testing.T) {
 
 func TestGistFile_String(t *testing.T) {
 	v := GistFile{
-		Size:     Int(0),
-		Filename: String(""),
-		Language: String(""),
-		Type:     String(""),
-		RawURL:   String(""),
-		Content:  String(""),
+		Size:      Int(0),
+		Filename:  String(""),
+		Language:  String(""),
+		Type:      String(""),
+		RawURL:    String(""),
+		Content:   String(""),
+		Truncated: Bool(false),
 	}
-	want := `github.GistFile{Size:0, Filename:"", Language:"", Type:"", RawURL:"", Content:""}`
+	want := `github.GistFile{Size:0, Filename:"", Language:"", Type:"", RawURL:"", Content:"", Truncated:false}`
 	if got := v.String(); got != want {
 		t.Errorf("GistFile.String = %v, want %v", got, want)
 	}
@@ -609,19 +652,20 @@ func TestHeadCommit_String(t *testing.T) {
 
 func TestHook_String(t *testing.T) {
 	v := Hook{
-		CreatedAt: &Timestamp{},
-		UpdatedAt: &Timestamp{},
-		URL:       String(""),
-		ID:        Int64(0),
-		Type:      String(""),
-		Name:      String(""),
-		TestURL:   String(""),
-		PingURL:   String(""),
-		Config:    &HookConfig{},
-		Events:    []string{""},
-		Active:    Bool(false),
+		CreatedAt:    &Timestamp{},
+		UpdatedAt:    &Timestamp{},
+		URL:          String(""),
+		ID:           Int64(0),
+		Type:         String(""),
+		Name:         String(""),
+		TestURL:      String(""),
+		PingURL:      String(""),
+		LastResponse: &HookLastResponse{},
+		Config:       &HookConfig{},
+		Events:       []string{""},
+		Active:       Bool(false),
 	}
-	want := `github.Hook{CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, URL:"", ID:0, Type:"", Name:"", TestURL:"", PingURL:"", Config:github.HookConfig{}, Events:[""], Active:false}`
+	want := `github.Hook{CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, URL:"", ID:0, Type:"", Name:"", TestURL:"", PingURL:"", LastResponse:github.HookLastResponse{}, Config:github.HookConfig{}, Events:[""], Active:false}`
 	if got := v.String(); got != want {
 		t.Errorf("Hook.String = %v, want %v", got, want)
 	}
@@ -791,8 +835,10 @@ func TestIssueComment_String(t *testing.T) {
 		URL:               String(""),
 		HTMLURL:           String(""),
 		IssueURL:          String(""),
+		IsMinimized:       Bool(false),
+		MinimizedReason:   String(""),
 	}
-	want := `github.IssueComment{ID:0, NodeID:"", Body:"", User:github.User{}, Reactions:github.Reactions{}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, AuthorAssociation:"", URL:"", HTMLURL:"", IssueURL:""}`
+	want := `github.IssueComment{ID:0, NodeID:"", Body:"", User:github.User{}, Reactions:github.Reactions{}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, AuthorAssociation:"", URL:"", HTMLURL:"", IssueURL:"", IsMinimized:false, MinimizedReason:""}`
 	if got := v.String(); got != want {
 		t.Errorf("IssueComment.String = %v, want %v", got, want)
 	}
@@ -908,16 +954,21 @@ func TestMembership_String(t *testing.T) {
 
 func TestMigration_String(t *testing.T) {
 	v := Migration{
-		ID:                 Int64(0),
-		GUID:               String(""),
-		State:              String(""),
-		LockRepositories:   Bool(false),
-		ExcludeAttachments: Bool(false),
-		URL:                String(""),
-		CreatedAt:          String(""),
-		UpdatedAt:          String(""),
-	}
-	want := `github.Migration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, URL:"", CreatedAt:"", UpdatedAt:""}`
+		ID:                   Int64(0),
+		GUID:                 String(""),
+		State:                String(""),
+		LockRepositories:     Bool(false),
+		ExcludeAttachments:   Bool(false),
+		ExcludeGitData:       Bool(false),
+		ExcludeReleases:      Bool(false),
+		ExcludeOwnerProjects: Bool(false),
+		OrgMetadataOnly:      Bool(false),
+		Exclude:              []string{""},
+		URL:                  String(""),
+		CreatedAt:            String(""),
+		UpdatedAt:            String(""),
+	}
+	want := `github.Migration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, ExcludeGitData:false, ExcludeReleases:false, ExcludeOwnerProjects:false, OrgMetadataOnly:false, Exclude:[""], URL:"", CreatedAt:"", UpdatedAt:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Migration.String = %v, want %v", got, want)
 	}
@@ -1053,6 +1104,8 @@ func TestOrganization_String(t *testing.T) {
 		DependencyGraphEnabledForNewRepos:              Bool(false),
 		SecretScanningEnabledForNewRepos:               Bool(false),
 		SecretScanningPushProtectionEnabledForNewRepos: Bool(false),
+		SecretScanningPushProtectionCustomLinkEnabled:  Bool(false),
+		SecretScanningPushProtectionCustomLink:         String(""),
 		SecretScanningValidityChecksEnabled:            Bool(false),
 		URL:                                            String(""),
 		EventsURL:                                      String(""),
@@ -1062,7 +1115,7 @@ func TestOrganization_String(t *testing.T) {
 		PublicMembersURL:                               String(""),
 		ReposURL:                                       String(""),
 	}
-	want := `github.Organization{Login:"", ID:0, NodeID:"", AvatarURL:"", HTMLURL:"", Name:"", Company:"", Blog:"", Location:"", Email:"", TwitterUsername:"", Description:"", PublicRepos:0, PublicGists:0, Followers:0, Following:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, TotalPrivateRepos:0, OwnedPrivateRepos:0, PrivateGists:0, DiskUsage:0, Collaborators:0, BillingEmail:"", Type:"", Plan:github.Plan{}, TwoFactorRequirementEnabled:false, IsVerified:false, HasOrganizationProjects:false, HasRepositoryProjects:false, DefaultRepoPermission:"", DefaultRepoSettings:"", MembersCanCreateRepos:false, MembersCanCreatePublicRepos:false, MembersCanCreatePrivateRepos:false, MembersCanCreateInternalRepos:false, MembersCanForkPrivateRepos:false, MembersAllowedRepositoryCreationType:"", MembersCanCreatePages:false, MembersCanCreatePublicPages:false, MembersCanCreatePrivatePages:false, WebCommitSignoffRequired:false, AdvancedSecurityEnabledForNewRepos:false, DependabotAlertsEnabledForNewRepos:false, DependabotSecurityUpdatesEnabledForNewRepos:false, DependencyGraphEnabledForNewRepos:false, SecretScanningEnabledForNewRepos:false, SecretScanningPushProtectionEnabledForNewRepos:false, SecretScanningValidityChecksEnabled:false, URL:"", EventsURL:"", HooksURL:"", IssuesURL:"", MembersURL:"", PublicMembersURL:"", ReposURL:""}`
+	want := `github.Organization{Login:"", ID:0, NodeID:"", AvatarURL:"", HTMLURL:"", Name:"", Company:"", Blog:"", Location:"", Email:"", TwitterUsername:"", Description:"", PublicRepos:0, PublicGists:0, Followers:0, Following:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, TotalPrivateRepos:0, OwnedPrivateRepos:0, PrivateGists:0, DiskUsage:0, Collaborators:0, BillingEmail:"", Type:"", Plan:github.Plan{}, TwoFactorRequirementEnabled:false, IsVerified:false, HasOrganizationProjects:false, HasRepositoryProjects:false, DefaultRepoPermission:"", DefaultRepoSettings:"", MembersCanCreateRepos:false, MembersCanCreatePublicRepos:false, MembersCanCreatePrivateRepos:false, MembersCanCreateInternalRepos:false, MembersCanForkPrivateRepos:false, MembersAllowedRepositoryCreationType:"", MembersCanCreatePages:false, MembersCanCreatePublicPages:false, MembersCanCreatePrivatePages:false, WebCommitSignoffRequired:false, AdvancedSecurityEnabledForNewRepos:false, DependabotAlertsEnabledForNewRepos:false, DependabotSecurityUpdatesEnabledForNewRepos:false, DependencyGraphEnabledForNewRepos:false, SecretScanningEnabledForNewRepos:false, SecretScanningPushProtectionEnabledForNewRepos:false, SecretScanningPushProtectionCustomLinkEnabled:false, SecretScanningPushProtectionCustomLink:"", SecretScanningValidityChecksEnabled:false, URL:"", EventsURL:"", HooksURL:"", IssuesURL:"", MembersURL:"", PublicMembersURL:"", ReposURL:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Organization.String = %v, want %v", got, want)
 	}
@@ -1493,8 +1546,9 @@ func TestReleaseAsset_String(t *testing.T) {
 		BrowserDownloadURL: String(""),
 		Uploader:           &User{},
 		NodeID:             String(""),
+		Digest:             String(""),
 	}
-	want := `github.ReleaseAsset{ID:0, URL:"", Name:"", Label:"", State:"", ContentType:"", Size:0, DownloadCount:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, BrowserDownloadURL:"", Uploader:github.User{}, NodeID:""}`
+	want := `github.ReleaseAsset{ID:0, URL:"", Name:"", Label:"", State:"", ContentType:"", Size:0, DownloadCount:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, BrowserDownloadURL:"", Uploader:github.User{}, NodeID:"", Digest:""}`
 	if got := v.String(); got != want {
 		t.Errorf("ReleaseAsset.String = %v, want %v", got, want)
 	}
@@ -1670,10 +1724,12 @@ func TestRepositoryComment_String(t *testing.T) {
 		CreatedAt: &Timestamp{},
 		UpdatedAt: &Timestamp{},
 		Body:      String(""),
+		BodyText:  String(""),
+		BodyHTML:  String(""),
 		Path:      String(""),
 		Position:  Int(0),
 	}
-	want := `github.RepositoryComment{HTMLURL:"", URL:"", ID:0, NodeID:"", CommitID:"", User:github.User{}, Reactions:github.Reactions{}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Body:"", Path:"", Position:0}`
+	want := `github.RepositoryComment{HTMLURL:"", URL:"", ID:0, NodeID:"", CommitID:"", User:github.User{}, Reactions:github.Reactions{}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Body:"", BodyText:"", BodyHTML:"", Path:"", Position:0}`
 	if got := v.String(); got != want {
 		t.Errorf("RepositoryComment.String = %v, want %v", got, want)
 	}
@@ -1837,6 +1893,17 @@ func TestSecurityAndAnalysis_String(t *testing.T) {
 	}
 }
 
+func TestSocialAccount_String(t *testing.T) {
+	v := SocialAccount{
+		Provider: String(""),
+		URL:      String(""),
+	}
+	want := `github.SocialAccount{Provider:"", URL:""}`
+	if got := v.String(); got != want {
+		t.Errorf("SocialAccount.String = %v, want %v", got, want)
+	}
+}
+
 func TestSourceImportAuthor_String(t *testing.T) {
 	v := SourceImportAuthor{
 		ID:         Int64(0),
@@ -2043,16 +2110,21 @@ func TestUserLDAPMapping_String(t *testing.T) {
 
 func TestUserMigration_String(t *testing.T) {
 	v := UserMigration{
-		ID:                 Int64(0),
-		GUID:               String(""),
-		State:              String(""),
-		LockRepositories:   Bool(false),
-		ExcludeAttachments: Bool(false),
-		URL:                String(""),
-		CreatedAt:          String(""),
-		UpdatedAt:          String(""),
-	}
-	want := `github.UserMigration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, URL:"", CreatedAt:"", UpdatedAt:""}`
+		ID:                   Int64(0),
+		GUID:                 String(""),
+		State:                String(""),
+		LockRepositories:     Bool(false),
+		ExcludeAttachments:   Bool(false),
+		ExcludeGitData:       Bool(false),
+		ExcludeReleases:      Bool(false),
+		ExcludeOwnerProjects: Bool(false),
+		OrgMetadataOnly:      Bool(false),
+		Exclude:              []string{""},
+		URL:                  String(""),
+		CreatedAt:            String(""),
+		UpdatedAt:            String(""),
+	}
+	want := `github.UserMigration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, ExcludeGitData:false, ExcludeReleases:false, ExcludeOwnerProjects:false, OrgMetadataOnly:false, Exclude:[""], URL:"", CreatedAt:"", UpdatedAt:""}`
 	if got := v.String(); got != want {
 		t.Errorf("UserMigration.String = %v, want %v", got, want)
 	}
@@ -2070,6 +2142,18 @@ func TestUserStats_String(t *testing.T) {
 	}
 }
 
+func TestWeeklyCodeFrequency_String(t *testing.T) {
+	v := WeeklyCodeFrequency{
+		Week:      &Timestamp{},
+		Additions: Int(0),
+		Deletions: Int(0),
+	}
+	want := `github.WeeklyCodeFrequency{Week:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Additions:0, Deletions:0}`
+	if got := v.String(); got != want {
+		t.Errorf("WeeklyCodeFrequency.String = %v, want %v", got, want)
+	}
+}
+
 func TestWeeklyCommitActivity_String(t *testing.T) {
 	v := WeeklyCommitActivity{
 		Days:  []int{0},
@@ -6,6 +6,7 @@
 //go:generate go run gen-accessors.go
 //go:generate go run gen-stringify-test.go
 //go:generate ../script/metadata.sh update-go
+//go:generate go run gen-operations.go
 
 package github
 
@@ -51,6 +52,8 @@ const (
 	mediaTypeV3Patch           = "application/vnd.github.v3.patch"
 	mediaTypeOrgPermissionRepo = "application/vnd.github.v3.repository+json"
 	mediaTypeIssueImportAPI    = "application/vnd.github.golden-comet-preview+json"
+	mediaTypeRawJSON           = "application/vnd.github.raw+json"
+	mediaTypeHTMLJSON          = "application/vnd.github.html+json"
 
 	// Media Type values to access preview APIs
 	// These media types will be added to the API request as headers
@@ -173,6 +176,11 @@ type Client struct {
 	rateLimits              [Categories]Rate // Rate limits for the client as determined by the most recent API calls.
 	secondaryRateLimitReset time.Time        // Secondary rate limit reset for the client as determined by the most recent API calls.
 
+	defaultTimeout time.Duration // Timeout of the requests whose context has no deadline, see WithDefaultTimeout.
+
+	projectFieldsMu sync.Mutex
+	projectFields   map[string][]*ProjectV2Field // Fields of projects v2 by "org/number", see SetOrganizationProjectItemField.
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
@@ -290,6 +298,39 @@ type RawOptions struct {
 	Type RawType
 }
 
+// ErrDiffTooLarge is wrapped by the error that the methods returning a diff
+// or patch return when GitHub refuses to generate one because it is too
+// large. The changed files can still be listed, for example with
+// PullRequestsService.ListFiles or from the Files of a RepositoryCommit.
+var ErrDiffTooLarge = errors.New("the diff is too large to be returned by the API; list the changed files instead")
+
+// getRaw fetches the resource at urlStr in the raw format of opts and copies
+// it to w. A 406 Not Acceptable response is reported as ErrDiffTooLarge.
+func (c *Client) getRaw(ctx context.Context, urlStr string, opts RawOptions, w io.Writer) (*Response, error) {
+	var accept string
+	switch opts.Type {
+	case Diff:
+		accept = mediaTypeV3Diff
+	case Patch:
+		accept = mediaTypeV3Patch
+	default:
+		return nil, fmt.Errorf("unsupported raw type %d", opts.Type)
+	}
+
+	req, err := c.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+
+	resp, err := c.Do(ctx, req, w)
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusNotAcceptable {
+		err = fmt.Errorf("%w: %w", ErrDiffTooLarge, err)
+	}
+	return resp, err
+}
+
 // addOptions adds the parameters in opts as URL query parameters to s. opts
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opts interface{}) (string, error) {
@@ -308,10 +349,55 @@ func addOptions(s string, opts interface{}) (string, error) {
 		return s, err
 	}
 
-	u.RawQuery = qs.Encode()
+	// Merge with any query already in s; values from opts take precedence.
+	merged := u.Query()
+	for k, v := range qs {
+		merged[k] = v
+	}
+	u.RawQuery = merged.Encode()
 	return u.String(), nil
 }
 
+// PathEscape escapes s so that it can be used as a single segment of a request
+// path, for example a user name, team slug or branch name containing reserved
+// characters such as "/", "?" or "#". The services use it for the string
+// parameters they join into request paths, and it can be used likewise to
+// build the path of a request made with Client.NewRequest. Segments that
+// escape to ".." are rejected by NewRequest with ErrPathForbidden.
+//
+// Unlike url.PathEscape, PathEscape leaves "%" as is, so that values escaped
+// by the caller, such as "help%20wanted", are not escaped twice. A "%" that
+// does not start an escape sequence, like an ASCII control character, which
+// is also left as is, makes NewRequest fail to parse the path.
+func PathEscape(s string) string {
+	var b strings.Builder
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c == '%' || c < 0x20 || c == 0x7f {
+			b.WriteString(url.PathEscape(s[start:i]))
+			b.WriteByte(c)
+			start = i + 1
+		}
+	}
+	b.WriteString(url.PathEscape(s[start:]))
+	return b.String()
+}
+
+// checkPath returns ErrPathForbidden if the decoded path of urlStr contains a
+// ".." segment, which would make the request escape the intended endpoint.
+func checkPath(urlStr string) error {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return err
+	}
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == ".." {
+			return ErrPathForbidden
+		}
+	}
+	return nil
+}
+
 // NewClient returns a new GitHub API client. If a nil httpClient is
 // provided, a new http.Client will be used. To use API methods which require
 // authentication, either use Client.WithAuthToken or provide NewClient with
@@ -390,6 +476,21 @@ func (c *Client) WithEnterpriseURLs(baseURL, uploadURL string) (*Client, error)
 	return c2, nil
 }
 
+// WithDefaultTimeout returns a copy of the client that applies timeout as the
+// deadline of the requests whose context has no deadline, so that a hung
+// call doesn't block forever. A deadline set by the caller takes precedence,
+// and WithRequestTimeout and WithoutRequestTimeout override the default for
+// a single call. For methods returning the body of the response, such as
+// streaming downloads, the deadline also applies to reading the body.
+//
+// A timeout of zero or less disables the default timeout.
+func (c *Client) WithDefaultTimeout(timeout time.Duration) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.defaultTimeout = timeout
+	return c2
+}
+
 // initialize sets default values and initializes services.
 func (c *Client) initialize() {
 	if c.client == nil {
@@ -455,6 +556,7 @@ func (c *Client) copy() *Client {
 		BaseURL:                 c.BaseURL,
 		UploadURL:               c.UploadURL,
 		secondaryRateLimitReset: c.secondaryRateLimitReset,
+		defaultTimeout:          c.defaultTimeout,
 	}
 	c.clientMu.Unlock()
 	if c.client != nil {
@@ -501,16 +603,48 @@ func WithVersion(version string) RequestOption {
 	}
 }
 
+// WithIfNoneMatch makes this individual request conditional on the resource
+// not matching etag, a value of Response.ETag from an earlier response. If it
+// still matches, GitHub responds with 304 Not Modified, which is returned as
+// an *ErrorResponse whose Response.StatusCode is http.StatusNotModified.
+// For more information, see:
+// https://docs.github.com/rest/using-the-rest-api/best-practices-for-using-the-rest-api#use-conditional-requests-if-appropriate
+func WithIfNoneMatch(etag string) RequestOption {
+	return func(req *http.Request) {
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+	}
+}
+
+// WithIfModifiedSince makes this individual request conditional on the
+// resource having been modified after t, typically a value of
+// Response.LastModified from an earlier response. It is useful for endpoints
+// that send Last-Modified but no ETag. A 304 Not Modified response is handled
+// as with WithIfNoneMatch. A zero t sends an unconditional request.
+func WithIfModifiedSince(t time.Time) RequestOption {
+	return func(req *http.Request) {
+		if !t.IsZero() {
+			req.Header.Set("If-Modified-Since", t.UTC().Format(http.TimeFormat))
+		}
+	}
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
 // specified, the value pointed to by body is JSON encoded and included as the
-// request body.
+// request body. ErrPathForbidden is returned if the path of urlStr contains a
+// ".." segment.
 func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...RequestOption) (*http.Request, error) {
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
 
+	if err := checkPath(urlStr); err != nil {
+		return nil, err
+	}
+
 	u, err := c.BaseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -557,6 +691,10 @@ func (c *Client) NewFormRequest(urlStr string, body io.Reader, opts ...RequestOp
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
 
+	if err := checkPath(urlStr); err != nil {
+		return nil, err
+	}
+
 	u, err := c.BaseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -662,6 +800,12 @@ type Response struct {
 	// token's expiration date. Timestamp is 0001-01-01 when token doesn't expire.
 	// So it is valid for TokenExpiration.Equal(Timestamp{}) or TokenExpiration.Time.After(time.Now())
 	TokenExpiration Timestamp
+
+	// The caching validators of the response, from its ETag and Last-Modified
+	// headers, for use with WithIfNoneMatch and WithIfModifiedSince.
+	// LastModified is the zero time if the header is missing or invalid.
+	ETag         string
+	LastModified time.Time
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -671,6 +815,8 @@ func newResponse(r *http.Response) *Response {
 	response.populatePageValues()
 	response.Rate = parseRate(r)
 	response.TokenExpiration = parseTokenExpiration(r)
+	response.ETag = r.Header.Get("ETag")
+	response.LastModified = parseLastModified(r)
 	return response
 }
 
@@ -800,11 +946,24 @@ func parseTokenExpiration(r *http.Response) Timestamp {
 	return Timestamp{} // 0001-01-01 00:00:00
 }
 
+// parseLastModified parses the Last-Modified header.
+// Returns the zero time if the header is not defined or could not be parsed.
+func parseLastModified(r *http.Response) time.Time {
+	if v := r.Header.Get("Last-Modified"); v != "" {
+		if t, err := http.ParseTime(v); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
 type requestContext uint8
 
 const (
 	bypassRateLimitCheck requestContext = iota
 	SleepUntilPrimaryRateLimitResetWhenRateLimited
+	requestMetadataKey
+	requestTimeoutKey
 )
 
 // BareDo sends an API request and lets you handle the api response. If an error
@@ -820,9 +979,26 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 		return nil, errNonNilContext
 	}
 
+	ctx, cancel := c.requestTimeoutContext(ctx)
+	if cancel == nil {
+		return c.bareDo(ctx, req)
+	}
+
+	resp, err := c.bareDo(ctx, req)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	// The deadline must last until the caller is done with the body.
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (c *Client) bareDo(ctx context.Context, req *http.Request) (*Response, error) {
 	req = withContext(ctx, req)
+	setAuditHeaders(req, RequestMetadataFromContext(ctx))
 
-	rateLimitCategory := GetRateLimitCategory(req.Method, req.URL.Path)
+	rateLimitCategory := c.rateLimitCategory(req)
 
 	if bypass := ctx.Value(bypassRateLimitCheck); bypass == nil {
 		// If we've hit rate limit, don't make further requests before Reset time.
@@ -951,7 +1127,7 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory Rat
 	c.rateMu.Lock()
 	rate := c.rateLimits[rateLimitCategory]
 	c.rateMu.Unlock()
-	if !rate.Reset.Time.IsZero() && rate.Remaining == 0 && time.Now().Before(rate.Reset.Time) {
+	if rate.Exhausted() {
 		// Create a fake response.
 		resp := &http.Response{
 			Status:     http.StatusText(http.StatusForbidden),
@@ -982,6 +1158,33 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory Rat
 	return nil
 }
 
+// CheckRateLimitBeforehand reports, without making any network calls, whether
+// req would currently be refused because the most recently seen rate limit
+// of its RateLimitCategory is exhausted. If so, it returns the *RateLimitError
+// that Client.Do would return for req; otherwise it returns nil.
+//
+// Client.Do already performs this check before each request; this method lets
+// callers decide whether to attempt a request, or how long to wait, up front.
+func (c *Client) CheckRateLimitBeforehand(req *http.Request) error {
+	// Never wait for the reset here, even if req's context asks Client.Do to.
+	req = req.WithContext(context.WithValue(req.Context(), SleepUntilPrimaryRateLimitResetWhenRateLimited, nil))
+	if err := c.checkRateLimitBeforeDo(req, c.rateLimitCategory(req)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// rateLimitCategory returns the RateLimitCategory of req. Requests under
+// BaseURL are categorized by their path relative to it, so that, for
+// instance, search requests to GitHub Enterprise Server are recognized.
+func (c *Client) rateLimitCategory(req *http.Request) RateLimitCategory {
+	path := req.URL.Path
+	if c.BaseURL != nil && req.URL.Host == c.BaseURL.Host && strings.HasPrefix(path, c.BaseURL.Path) {
+		path = "/" + strings.TrimPrefix(path, c.BaseURL.Path)
+	}
+	return GetRateLimitCategory(req.Method, path)
+}
+
 // checkSecondaryRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
 // current client state in order to quickly check if *AbuseRateLimitError can be immediately returned
 // from Client.Do, and if so, returns it so that Client.Do can skip making a network API call unnecessarily.
@@ -1165,6 +1368,33 @@ func (ae *AcceptedError) Is(target error) bool {
 	return bytes.Equal(ae.Raw, v.Raw)
 }
 
+// doRetryingAccepted sends a GET request for u and decodes the response into
+// v like Do, but sends it again after delay, up to retries times, while GitHub
+// responds with 202 Accepted. If GitHub is still not done, the *AcceptedError
+// of the last attempt is returned.
+func (c *Client) doRetryingAccepted(ctx context.Context, u string, v interface{}, retries int, delay time.Duration) (*Response, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := c.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.Do(ctx, req, v)
+		var acceptedError *AcceptedError
+		if attempt >= retries || !errors.As(err, &acceptedError) {
+			return resp, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
 // AbuseRateLimitError occurs when GitHub returns 403 Forbidden response with the
 // "documentation_url" field value equal to "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits".
 type AbuseRateLimitError struct {
@@ -1290,9 +1520,7 @@ func CheckResponse(r *http.Response) error {
 			Response: errorResponse.Response,
 			Message:  errorResponse.Message,
 		}
-	case r.StatusCode == http.StatusForbidden &&
-		(strings.HasSuffix(errorResponse.DocumentationURL, "#abuse-rate-limits") ||
-			strings.HasSuffix(errorResponse.DocumentationURL, "secondary-rate-limits")):
+	case r.StatusCode == http.StatusForbidden && isSecondaryRateLimitDocumentation(errorResponse.DocumentationURL):
 		abuseRateLimitError := &AbuseRateLimitError{
 			Response: errorResponse.Response,
 			Message:  errorResponse.Message,
@@ -1306,6 +1534,39 @@ func CheckResponse(r *http.Response) error {
 	}
 }
 
+// defaultSecondaryRateLimitWait is how long to pause after a secondary rate
+// limit error without a Retry-After hint, as GitHub recommends.
+const defaultSecondaryRateLimitWait = time.Minute
+
+// waitForRateLimit reports whether err is a primary or secondary rate limit
+// error, and if so, waits until the request can be retried. It returns
+// ctx.Err() if ctx is done first.
+func waitForRateLimit(ctx context.Context, err error) (bool, error) {
+	var wait time.Duration
+	var abuseErr *AbuseRateLimitError
+	var rateLimitErr *RateLimitError
+	switch {
+	case errors.As(err, &abuseErr):
+		wait = defaultSecondaryRateLimitWait
+		if abuseErr.RetryAfter != nil {
+			wait = *abuseErr.RetryAfter
+		}
+	case errors.As(err, &rateLimitErr):
+		wait = time.Until(rateLimitErr.Rate.Reset.Time)
+	default:
+		return false, nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-timer.C:
+		return true, nil
+	}
+}
+
 // parseBoolResponse determines the boolean result from a GitHub API response.
 // Several GitHub API methods return boolean responses indicated by the HTTP
 // status code in the response (true indicated by a 204, false indicated by a
@@ -1359,7 +1620,7 @@ func GetRateLimitCategory(method, path string) RateLimitCategory {
 
 	case strings.HasPrefix(path, "/search/"):
 		return SearchCategory
-	case path == "/graphql":
+	case path == "/graphql" || path == "/api/graphql":
 		return GraphqlCategory
 	case strings.HasPrefix(path, "/app-manifests/") &&
 		strings.HasSuffix(path, "/conversions") &&
@@ -1579,6 +1840,40 @@ func (c *Client) roundTripWithOptionalFollowRedirect(ctx context.Context, u stri
 	return resp, err
 }
 
+// downloadRedirect downloads the contents at url, a redirect from the API to
+// the storage host that serves a download such as a release asset or a
+// migration archive. It is the responsibility of the caller to close the
+// returned reader.
+//
+// The storage host rejects requests that carry GitHub credentials, and the
+// redirect URL is pre-signed, so the request is made with
+// followRedirectsClient rather than the client's own. If
+// followRedirectsClient is nil, an http.Client with the timeout of the
+// client's own but the default transport is used, so that authentication
+// added by the client's transport is not forwarded. Pass a client to use a
+// proxy or other transport settings for the download.
+func (c *Client) downloadRedirect(ctx context.Context, followRedirectsClient *http.Client, url string) (io.ReadCloser, error) {
+	if followRedirectsClient == nil {
+		followRedirectsClient = &http.Client{Timeout: c.client.Timeout}
+	}
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = withContext(ctx, req)
+	req.Header.Set("Accept", "*/*")
+	resp, err := followRedirectsClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool { return &v }
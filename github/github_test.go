@@ -132,6 +132,15 @@ func testURLParseError(t *testing.T, err error) {
 	}
 }
 
+// testPathForbiddenError checks that err reports a path parameter that
+// would escape the endpoint, such as "..", without making a request.
+func testPathForbiddenError(t *testing.T, err error) {
+	t.Helper()
+	if !errors.Is(err, ErrPathForbidden) {
+		t.Errorf("Expected %v, got %+v", ErrPathForbidden, err)
+	}
+}
+
 func testBody(t *testing.T, r *http.Request, want string) {
 	t.Helper()
 	b, err := io.ReadAll(r.Body)
@@ -595,6 +604,24 @@ func TestNewRequest_emptyBody(t *testing.T) {
 	}
 }
 
+// ensure that a body is encoded regardless of method, since some endpoints
+// accept a body with a DELETE request.
+func TestNewRequest_deleteWithBody(t *testing.T) {
+	c := NewClient(nil)
+	req, err := c.NewRequest("DELETE", ".", []string{"a"})
+	if err != nil {
+		t.Fatalf("NewRequest returned unexpected error: %v", err)
+	}
+
+	body, _ := io.ReadAll(req.Body)
+	if got, want := string(body), `["a"]`+"\n"; got != want {
+		t.Errorf("NewRequest() Body is %v, want %v", got, want)
+	}
+	if got, want := req.Header.Get("Content-Type"), "application/json"; got != want {
+		t.Errorf("NewRequest() Content-Type is %v, want %v", got, want)
+	}
+}
+
 func TestNewRequest_errorForNoTrailingSlash(t *testing.T) {
 	tests := []struct {
 		rawurl    string
@@ -1149,6 +1176,11 @@ func TestDo_rateLimitCategory(t *testing.T) {
 			url:      "/graphql",
 			category: GraphqlCategory,
 		},
+		{
+			method:   http.MethodPost,
+			url:      "/api/graphql",
+			category: GraphqlCategory,
+		},
 		{
 			method:   http.MethodPost,
 			url:      "/app-manifests/code/conversions",
@@ -1335,6 +1367,94 @@ func TestDo_rateLimit_noNetworkCall(t *testing.T) {
 	}
 }
 
+func TestClient_rateLimitCategory(t *testing.T) {
+	ghes, err := NewClient(nil).WithEnterpriseURLs("https://ghes.example.com/api/v3/", "https://ghes.example.com/api/uploads/")
+	if err != nil {
+		t.Fatalf("WithEnterpriseURLs returned error: %v", err)
+	}
+
+	tests := map[string]struct {
+		client *Client
+		method string
+		url    string
+		want   RateLimitCategory
+	}{
+		"core":              {client: NewClient(nil), method: "GET", url: "repos/o/r", want: CoreCategory},
+		"search":            {client: NewClient(nil), method: "GET", url: "search/issues?q=a", want: SearchCategory},
+		"code search":       {client: NewClient(nil), method: "GET", url: "search/code?q=a", want: CodeSearchCategory},
+		"GHES core":         {client: ghes, method: "GET", url: "repos/o/r", want: CoreCategory},
+		"GHES search":       {client: ghes, method: "GET", url: "search/issues?q=a", want: SearchCategory},
+		"GHES code search":  {client: ghes, method: "GET", url: "search/code?q=a", want: CodeSearchCategory},
+		"GHES scim":         {client: ghes, method: "GET", url: "scim/v2/organizations/o/Users", want: ScimCategory},
+		"GHES graphql":      {client: ghes, method: "POST", url: "https://ghes.example.com/api/graphql", want: GraphqlCategory},
+		"other host":        {client: ghes, method: "GET", url: "https://example.com/api/v3/search/issues", want: CoreCategory},
+		"repository search": {client: NewClient(nil), method: "GET", url: "repos/o/search", want: CoreCategory},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := test.client.NewRequest(test.method, test.url, nil)
+			if err != nil {
+				t.Fatalf("NewRequest returned error: %v", err)
+			}
+			if got := test.client.rateLimitCategory(req); got != test.want {
+				t.Errorf("rateLimitCategory(%v) = %v, want %v", req.URL, got, test.want)
+			}
+		})
+	}
+}
+
+func TestClient_CheckRateLimitBeforehand(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	madeNetworkCall := false
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		madeNetworkCall = true
+	})
+
+	reset := time.Now().Add(time.Minute)
+	client.rateMu.Lock()
+	client.rateLimits[SearchCategory] = Rate{Limit: 30, Remaining: 0, Reset: Timestamp{reset}}
+	client.rateMu.Unlock()
+
+	searchReq, _ := client.NewRequest("GET", "search/issues?q=a", nil)
+	coreReq, _ := client.NewRequest("GET", "repos/o/r", nil)
+
+	err := client.CheckRateLimitBeforehand(searchReq)
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("CheckRateLimitBeforehand(search) returned %v, want *RateLimitError", err)
+	}
+	if got, want := rateLimitErr.Rate.Limit, 30; got != want {
+		t.Errorf("RateLimitError.Rate.Limit = %v, want %v", got, want)
+	}
+	if d := rateLimitErr.Rate.ResetIn(); d <= 0 || d > time.Minute {
+		t.Errorf("RateLimitError.Rate.ResetIn() = %v, want up to a minute", d)
+	}
+
+	if err := client.CheckRateLimitBeforehand(coreReq); err != nil {
+		t.Errorf("CheckRateLimitBeforehand(core) returned %v, want nil", err)
+	}
+
+	// It never waits for the reset, even when asked to by the context.
+	ctx := context.WithValue(context.Background(), SleepUntilPrimaryRateLimitResetWhenRateLimited, true)
+	start := time.Now()
+	if err := client.CheckRateLimitBeforehand(searchReq.WithContext(ctx)); err == nil {
+		t.Error("CheckRateLimitBeforehand(search) returned nil, want error")
+	}
+	if time.Since(start) > time.Second {
+		t.Error("CheckRateLimitBeforehand waited for the rate limit to reset")
+	}
+
+	if _, err := client.Do(context.Background(), searchReq, nil); err == nil {
+		t.Error("Do(search) returned nil error, want error")
+	}
+	if madeNetworkCall {
+		t.Error("Do(search) made a network call, even though the search rate limit is known to be exceeded")
+	}
+}
+
 // Ignore rate limit headers if the response was served from cache.
 func TestDo_rateLimit_ignoredFromCache(t *testing.T) {
 	client, mux, _, teardown := setup()
@@ -2584,6 +2704,104 @@ func TestAddOptions_QueryValues(t *testing.T) {
 	}
 }
 
+func TestAddOptions_mergesQuery(t *testing.T) {
+	opts := &ListOptions{Page: 2, PerPage: 10}
+	got, err := addOptions("repos/o/r/rulesets?includes_parents=true&page=1", opts)
+	if err != nil {
+		t.Fatalf("addOptions returned error: %v", err)
+	}
+	if want := "repos/o/r/rulesets?includes_parents=true&page=2&per_page=10"; got != want {
+		t.Errorf("addOptions returned %q, want %q", got, want)
+	}
+}
+
+func TestPathEscape(t *testing.T) {
+	tests := map[string]string{
+		"main":       "main",
+		"feature/x":  "feature%2Fx",
+		"what?ever":  "what%3Fever",
+		"feat#1":     "feat%231",
+		"100%":       "100%",
+		"with space": "with%20space",
+		"help%20me":  "help%20me",
+		"a%2Fb/c":    "a%2Fb%2Fc",
+		"line\nfeed": "line\nfeed",
+		"étiquette":  "%C3%A9tiquette",
+	}
+	for s, want := range tests {
+		if got := PathEscape(s); got != want {
+			t.Errorf("PathEscape(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
+
+func TestPathEscape_servicePaths(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.EscapedPath(), "/repos/o%2Fx/r%3Fy/labels/a%2Fb%3Fc"; got != want {
+			t.Errorf("request path = %q, want %q", got, want)
+		}
+		if r.URL.RawQuery != "" {
+			t.Errorf("request query = %q, want none", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"name":"a/b?c"}`)
+	})
+
+	ctx := context.Background()
+	label, _, err := client.Issues.GetLabel(ctx, "o/x", "r?y", "a/b?c")
+	if err != nil {
+		t.Fatalf("Issues.GetLabel returned error: %v", err)
+	}
+	if got, want := label.GetName(), "a/b?c"; got != want {
+		t.Errorf("Issues.GetLabel returned label %q, want %q", got, want)
+	}
+}
+
+func TestPathEscape_preEscaped(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.EscapedPath(), "/repos/o/r/labels/help%20wanted"; got != want {
+			t.Errorf("request path = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"name":"help wanted"}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Issues.GetLabel(ctx, "o", "r", "help%20wanted"); err != nil {
+		t.Fatalf("Issues.GetLabel returned error: %v", err)
+	}
+}
+
+func TestNewRequest_pathTraversal(t *testing.T) {
+	c := NewClient(nil)
+
+	for _, urlStr := range []string{
+		"projects/1/collaborators/..",
+		"projects/1/collaborators/" + PathEscape("../admin"),
+		"repos/o/r/%2E%2E/branches",
+	} {
+		if _, err := c.NewRequest("GET", urlStr, nil); !errors.Is(err, ErrPathForbidden) {
+			t.Errorf("NewRequest(%q) returned error %v, want %v", urlStr, err, ErrPathForbidden)
+		}
+		if _, err := c.NewFormRequest(urlStr, nil); !errors.Is(err, ErrPathForbidden) {
+			t.Errorf("NewFormRequest(%q) returned error %v, want %v", urlStr, err, ErrPathForbidden)
+		}
+	}
+
+	// Dots that do not form a whole segment are allowed.
+	for _, urlStr := range []string{".", "repos/o/r/compare/main...topic", "users/a..b"} {
+		if _, err := c.NewRequest("GET", urlStr, nil); err != nil {
+			t.Errorf("NewRequest(%q) returned error: %v", urlStr, err)
+		}
+	}
+}
+
 func TestBareDo_returnsOpenBody(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -2775,6 +2993,95 @@ func TestParseTokenExpiration(t *testing.T) {
 	}
 }
 
+func TestParseLastModified(t *testing.T) {
+	tests := []struct {
+		header string
+		want   time.Time
+	}{
+		{header: "", want: time.Time{}},
+		{header: "this is a garbage", want: time.Time{}},
+		{header: "Mon, 02 Jan 2006 15:04:05 GMT", want: referenceTime},
+		// Obsolete formats are still accepted.
+		{header: "Monday, 02-Jan-06 15:04:05 GMT", want: referenceTime},
+	}
+
+	for _, tt := range tests {
+		res := &http.Response{
+			Request: &http.Request{},
+			Header:  http.Header{},
+		}
+
+		res.Header.Set("Last-Modified", tt.header)
+		got := parseLastModified(res)
+		if !got.Equal(tt.want) {
+			t.Errorf("parseLastModified of %q\nreturned %#v\n    want %#v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestNewRequest_conditionalOptions(t *testing.T) {
+	c := NewClient(nil)
+
+	since := time.Date(2006, time.January, 2, 8, 4, 5, 0, time.FixedZone("MST", -7*60*60))
+	req, _ := c.NewRequest("GET", ".", nil, WithIfNoneMatch(`"abc"`), WithIfModifiedSince(since))
+	if got, want := req.Header.Get("If-None-Match"), `"abc"`; got != want {
+		t.Errorf("If-None-Match = %q, want %q", got, want)
+	}
+	if got, want := req.Header.Get("If-Modified-Since"), "Mon, 02 Jan 2006 15:04:05 GMT"; got != want {
+		t.Errorf("If-Modified-Since = %q, want %q", got, want)
+	}
+
+	req, _ = c.NewRequest("GET", ".", nil, WithIfNoneMatch(""), WithIfModifiedSince(time.Time{}))
+	for _, h := range []string{"If-None-Match", "If-Modified-Since"} {
+		if got := req.Header.Get(h); got != "" {
+			t.Errorf("%v = %q, want none for empty validators", h, got)
+		}
+	}
+}
+
+func TestDo_lastModifiedConditionalRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	lastModified := referenceTime.Format(http.TimeFormat)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	ctx := context.Background()
+	req, _ := client.NewRequest("GET", ".", nil)
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if resp.ETag != "" || !resp.LastModified.Equal(referenceTime) {
+		t.Fatalf("Response validators = %q, %v, want none and %v", resp.ETag, resp.LastModified, referenceTime)
+	}
+
+	req, _ = client.NewRequest("GET", ".", nil, WithIfModifiedSince(resp.LastModified))
+	resp, err = client.Do(ctx, req, nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Do returned error %v, want *ErrorResponse", err)
+	}
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Response.StatusCode = %v, want %v", resp.StatusCode, http.StatusNotModified)
+	}
+}
+
+func TestNewResponse_eTag(t *testing.T) {
+	res := &http.Response{Request: &http.Request{}, Header: http.Header{}}
+	res.Header.Set("ETag", `W/"abc"`)
+	if got, want := newResponse(res).ETag, `W/"abc"`; got != want {
+		t.Errorf("Response.ETag = %q, want %q", got, want)
+	}
+}
+
 func TestClientCopy_leak_transport(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
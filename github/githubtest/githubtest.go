@@ -0,0 +1,113 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package githubtest provides helpers for testing code that consumes GitHub
+// webhooks with the github package.
+//
+// The requests built by this package carry the same headers GitHub sends on
+// a webhook delivery, so they are accepted by github.ValidatePayload and can
+// be passed on to github.ParseWebHook.
+package githubtest
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v61/github"
+)
+
+// GenerateSignature returns the value of the X-Hub-Signature-256 header that
+// GitHub would send for payload signed with secret.
+func GenerateSignature(secret, payload []byte) string {
+	return "sha256=" + generateMAC(sha256.New, secret, payload)
+}
+
+// GenerateSHA1Signature returns the value of the legacy X-Hub-Signature
+// header that GitHub would send for payload signed with secret.
+func GenerateSHA1Signature(secret, payload []byte) string {
+	return "sha1=" + generateMAC(sha1.New, secret, payload)
+}
+
+// NewWebhookRequest returns a webhook delivery request for the given event
+// type with a JSON body, signed with secret. payload may be a []byte or
+// string containing the raw JSON, or any value that will be marshaled to JSON.
+// If secret is empty, no signature headers are set.
+func NewWebhookRequest(t testing.TB, event string, payload any, secret string) *http.Request {
+	t.Helper()
+	return newWebhookRequest(t, event, "application/json", string(marshalPayload(t, payload)), secret)
+}
+
+// NewFormWebhookRequest is like NewWebhookRequest, but encodes the JSON
+// payload in the "payload" parameter of an application/x-www-form-urlencoded
+// body, as GitHub does for webhooks configured with that content type.
+func NewFormWebhookRequest(t testing.TB, event string, payload any, secret string) *http.Request {
+	t.Helper()
+	body := url.Values{"payload": {string(marshalPayload(t, payload))}}.Encode()
+	return newWebhookRequest(t, event, "application/x-www-form-urlencoded", body, secret)
+}
+
+func newWebhookRequest(t testing.TB, event, contentType, body, secret string) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(github.EventTypeHeader, event)
+	req.Header.Set(github.DeliveryIDHeader, newDeliveryID(t))
+	if secret != "" {
+		// The signature covers the raw body, which for form-encoded
+		// deliveries includes the "payload=" parameter encoding.
+		req.Header.Set(github.SHA256SignatureHeader, GenerateSignature([]byte(secret), []byte(body)))
+		req.Header.Set(github.SHA1SignatureHeader, GenerateSHA1Signature([]byte(secret), []byte(body)))
+	}
+	return req
+}
+
+func marshalPayload(t testing.TB, payload any) []byte {
+	t.Helper()
+
+	switch p := payload.(type) {
+	case []byte:
+		return p
+	case string:
+		return []byte(p)
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("githubtest: unable to marshal webhook payload: %v", err)
+	}
+	return b
+}
+
+// newDeliveryID returns a random version 4 UUID, matching the format of the
+// X-GitHub-Delivery header.
+func newDeliveryID(t testing.TB) string {
+	t.Helper()
+
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		t.Fatalf("githubtest: unable to generate delivery ID: %v", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func generateMAC(hashFunc func() hash.Hash, secret, payload []byte) string {
+	mac := hmac.New(hashFunc, secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-github/v61/github"
+)
+
+func TestGenerateSignature(t *testing.T) {
+	// Example from https://docs.github.com/webhooks/using-webhooks/validating-webhook-deliveries#testing-the-webhook-payload-validation
+	got := GenerateSignature([]byte("It's a Secret to Everybody"), []byte("Hello, World!"))
+	want := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+	if got != want {
+		t.Errorf("GenerateSignature = %q, want %q", got, want)
+	}
+}
+
+func TestNewWebhookRequest_roundTrip(t *testing.T) {
+	secret := "0123456789abcdef"
+	push := &github.PushEvent{Ref: github.String("refs/heads/main")}
+
+	tests := []struct {
+		name string
+		new  func(t testing.TB, event string, payload any, secret string) *http.Request
+	}{
+		{name: "json", new: NewWebhookRequest},
+		{name: "form", new: NewFormWebhookRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := test.new(t, "push", push, secret)
+
+			if got := github.WebHookType(req); got != "push" {
+				t.Errorf("WebHookType = %q, want %q", got, "push")
+			}
+			if id := github.DeliveryID(req); !regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`).MatchString(id) {
+				t.Errorf("DeliveryID = %q, want a version 4 UUID", id)
+			}
+
+			payload, err := github.ValidatePayload(req, []byte(secret))
+			if err != nil {
+				t.Fatalf("ValidatePayload returned error: %v", err)
+			}
+
+			event, err := github.ParseWebHook(github.WebHookType(req), payload)
+			if err != nil {
+				t.Fatalf("ParseWebHook returned error: %v", err)
+			}
+			if got := event.(*github.PushEvent).GetRef(); got != "refs/heads/main" {
+				t.Errorf("PushEvent.Ref = %q, want %q", got, "refs/heads/main")
+			}
+		})
+	}
+}
+
+func TestNewWebhookRequest_wrongSecret(t *testing.T) {
+	for _, newReq := range []func(t testing.TB, event string, payload any, secret string) *http.Request{NewWebhookRequest, NewFormWebhookRequest} {
+		req := newReq(t, "ping", `{"zen":"Keep it logically awesome."}`, "secret")
+		if _, err := github.ValidatePayload(req, []byte("other")); err == nil {
+			t.Error("ValidatePayload returned nil error for wrong secret, want error")
+		}
+	}
+}
+
+func TestNewWebhookRequest_noSecret(t *testing.T) {
+	req := NewWebhookRequest(t, "ping", []byte(`{"zen":"Design for failure."}`), "")
+	if got := req.Header.Get(github.SHA256SignatureHeader); got != "" {
+		t.Errorf("%v = %q, want empty", github.SHA256SignatureHeader, got)
+	}
+
+	payload, err := github.ValidatePayload(req, nil)
+	if err != nil {
+		t.Fatalf("ValidatePayload returned error: %v", err)
+	}
+	if got, want := string(payload), `{"zen":"Design for failure."}`; got != want {
+		t.Errorf("ValidatePayload = %q, want %q", got, want)
+	}
+}
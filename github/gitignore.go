@@ -6,6 +6,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 )
@@ -52,7 +53,7 @@ func (s *GitignoresService) List(ctx context.Context) ([]string, *Response, erro
 //
 //meta:operation GET /gitignore/templates/{name}
 func (s *GitignoresService) Get(ctx context.Context, name string) (*Gitignore, *Response, error) {
-	u := fmt.Sprintf("gitignore/templates/%v", name)
+	u := fmt.Sprintf("gitignore/templates/%v", PathEscape(name))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -66,3 +67,30 @@ func (s *GitignoresService) Get(ctx context.Context, name string) (*Gitignore, *
 
 	return gitignore, resp, nil
 }
+
+// GetRaw returns the source of a Gitignore template by name. It requests the
+// raw media type, so the source is returned as is rather than within JSON.
+//
+// The templates change rarely, so reading them through a caching
+// http.Transport saves rate limit; see the package documentation.
+//
+// GitHub API docs: https://docs.github.com/rest/gitignore/gitignore#get-a-gitignore-template
+//
+//meta:operation GET /gitignore/templates/{name}
+func (s *GitignoresService) GetRaw(ctx context.Context, name string) (string, *Response, error) {
+	u := fmt.Sprintf("gitignore/templates/%v", PathEscape(name))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github.v3.raw")
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return buf.String(), resp, nil
+}
@@ -6,9 +6,12 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -86,6 +89,181 @@ func TestGitignoresService_Get_invalidTemplate(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Gitignores.Get(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Gitignores.Get(ctx, "..")
+	testPathForbiddenError(t, err)
+}
+
+func TestGitignoresService_GetRaw(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const source = "# Go\n*.test\n*.out\n"
+	mux.HandleFunc("/gitignore/templates/Go", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.raw")
+		w.Header().Set("Content-Type", "application/vnd.github.v3.raw; charset=utf-8")
+		fmt.Fprint(w, source)
+	})
+
+	ctx := context.Background()
+	got, resp, err := client.Gitignores.GetRaw(ctx, "Go")
+	if err != nil {
+		t.Errorf("Gitignores.GetRaw returned error: %v", err)
+	}
+	if got != source {
+		t.Errorf("Gitignores.GetRaw returned %q, want %q", got, source)
+	}
+	if got, want := resp.Header.Get("Content-Type"), "application/vnd.github.v3.raw; charset=utf-8"; got != want {
+		t.Errorf("Gitignores.GetRaw returned Content-Type %q, want %q", got, want)
+	}
+
+	const methodName = "GetRaw"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Gitignores.GetRaw(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Gitignores.GetRaw(ctx, "Go")
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+// conditionalCache is a minimal caching transport that revalidates cached
+// responses with If-None-Match, or If-Modified-Since for responses without an
+// ETag, like github.com/gregjones/httpcache.
+type conditionalCache struct {
+	mu      sync.Mutex
+	entries map[string]conditionalCacheEntry
+	hits    int
+}
+
+type conditionalCacheEntry struct {
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+}
+
+func (c *conditionalCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := req.URL.String()
+	entry, cached := c.entries[key]
+	req = req.Clone(req.Context())
+	if cached {
+		if entry.etag != "" {
+			req.Header.Set("If-None-Match", entry.etag)
+		} else {
+			req.Header.Set("If-Modified-Since", entry.lastModified)
+		}
+	}
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		c.hits++
+		resp.StatusCode = http.StatusOK
+		resp.Header = entry.header.Clone()
+		resp.Body = io.NopCloser(bytes.NewReader(entry.body))
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if (etag != "" || lastModified != "") && resp.StatusCode == http.StatusOK {
+		if c.entries == nil {
+			c.entries = make(map[string]conditionalCacheEntry)
+		}
+		c.entries[key] = conditionalCacheEntry{etag: etag, lastModified: lastModified, header: resp.Header.Clone(), body: body}
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func TestGitignoresService_GetRaw_conditionalRequestCache(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	cache := &conditionalCache{}
+	client.client.Transport = cache
+
+	const etag = `"abc"`
+	var requests int
+	mux.HandleFunc("/gitignore/templates/Go", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		fmt.Fprint(w, "*.test\n")
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		got, _, err := client.Gitignores.GetRaw(ctx, "Go")
+		if err != nil {
+			t.Fatalf("Gitignores.GetRaw returned error: %v", err)
+		}
+		if want := "*.test\n"; got != want {
+			t.Errorf("Gitignores.GetRaw returned %q, want %q", got, want)
+		}
+	}
+	if requests != 2 || cache.hits != 1 {
+		t.Errorf("server got %v requests and cache served %v, want 2 and 1", requests, cache.hits)
+	}
+}
+
+func TestGitignoresService_GetRaw_lastModifiedOnly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	cache := &conditionalCache{}
+	client.client.Transport = cache
+
+	lastModified := referenceTime.Format(http.TimeFormat)
+	var requests int
+	mux.HandleFunc("/gitignore/templates/Go", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") != "" {
+			t.Errorf("If-None-Match = %q, want none", r.Header.Get("If-None-Match"))
+		}
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		fmt.Fprint(w, "*.test\n")
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		got, resp, err := client.Gitignores.GetRaw(ctx, "Go")
+		if err != nil {
+			t.Fatalf("Gitignores.GetRaw returned error: %v", err)
+		}
+		if want := "*.test\n"; got != want {
+			t.Errorf("Gitignores.GetRaw returned %q, want %q", got, want)
+		}
+		if !resp.LastModified.Equal(referenceTime) || resp.ETag != "" {
+			t.Errorf("Response validators = %q, %v, want none and %v", resp.ETag, resp.LastModified, referenceTime)
+		}
+	}
+	if requests != 2 || cache.hits != 1 {
+		t.Errorf("server got %v requests and cache served %v, want 2 and 1", requests, cache.hits)
+	}
 }
 
 func TestGitignore_Marshal(t *testing.T) {
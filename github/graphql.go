@@ -0,0 +1,154 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GraphQLError represents a single error returned by the GitHub GraphQL API.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+type GraphQLError struct {
+	Type       string                 `json:"type,omitempty"`
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"` // field names and list indices
+	Locations  []GraphQLErrorLocation `json:"locations,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// GraphQLErrorLocation is a position in a GraphQL query at which an error occurred.
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (e *GraphQLError) Error() string {
+	if e.Type != "" {
+		return fmt.Sprintf("%v: %v", e.Type, e.Message)
+	}
+	return e.Message
+}
+
+// GraphQLErrors is returned by Client.GraphQL when the GraphQL API responds
+// with a non-empty errors array. The GraphQL API reports such errors with a
+// 200 OK status, and may still return partial data alongside them.
+type GraphQLErrors struct {
+	Response *http.Response // HTTP response that caused this error
+	Errors   []*GraphQLError
+}
+
+func (r *GraphQLErrors) Error() string {
+	msgs := make([]string, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		msgs = append(msgs, e.Error())
+	}
+	msg := strings.Join(msgs, "; ")
+
+	if r.Response != nil && r.Response.Request != nil {
+		return fmt.Sprintf("%v %v: %v", r.Response.Request.Method, sanitizeURL(r.Response.Request.URL), msg)
+	}
+
+	return msg
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []*GraphQLError `json:"errors,omitempty"`
+}
+
+// graphQLRateLimit is the shape of the rateLimit object that GraphQL queries
+// can select alongside their other fields.
+type graphQLRateLimit struct {
+	RateLimit *struct {
+		Limit     int       `json:"limit"`
+		Remaining int       `json:"remaining"`
+		ResetAt   Timestamp `json:"resetAt"`
+	} `json:"rateLimit"`
+}
+
+// graphQLURL returns the URL of the GraphQL endpoint matching c.BaseURL: it
+// is https://api.github.com/graphql for GitHub.com and
+// http(s)://[hostname]/api/graphql for GitHub Enterprise Server.
+func (c *Client) graphQLURL() (*url.URL, error) {
+	if !strings.HasSuffix(c.BaseURL.Path, "/") {
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
+	}
+
+	if strings.HasSuffix(c.BaseURL.Path, "/api/v3/") {
+		return c.BaseURL.Parse("../graphql")
+	}
+	return c.BaseURL.Parse("graphql")
+}
+
+// GraphQL sends query, with the given variables, to the GitHub GraphQL API
+// using the same HTTP client, authentication and base URL as the REST API.
+// The data field of the response is JSON decoded into result, if non-nil.
+//
+// This is not a typed GraphQL client; it is meant for the few operations,
+// such as some project and discussion mutations, that are only available
+// through GraphQL.
+//
+// If the response contains errors, they are returned as *GraphQLErrors,
+// after any partial data has been decoded into result. If the query selects
+// rateLimit { limit remaining resetAt }, the returned Response's Rate is
+// populated from it.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]interface{}, result interface{}) (*Response, error) {
+	u, err := c.graphQLURL()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("POST", u.String(), &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	gr := new(graphQLResponse)
+	resp, err := c.Do(ctx, req, gr)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(gr.Data) > 0 && string(gr.Data) != "null" {
+		rl := new(graphQLRateLimit)
+		if err := json.Unmarshal(gr.Data, rl); err == nil && rl.RateLimit != nil {
+			resp.Rate = Rate{
+				Limit:     rl.RateLimit.Limit,
+				Remaining: rl.RateLimit.Remaining,
+				Reset:     rl.RateLimit.ResetAt,
+			}
+			c.rateMu.Lock()
+			c.rateLimits[GraphqlCategory] = resp.Rate
+			c.rateMu.Unlock()
+		}
+
+		if result != nil {
+			if err := json.Unmarshal(gr.Data, result); err != nil {
+				return resp, err
+			}
+		}
+	}
+
+	if len(gr.Errors) > 0 {
+		return resp, &GraphQLErrors{Response: resp.Response, Errors: gr.Errors}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_GraphQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"query":"query($id: ID!) { node(id: $id) { id } }","variables":{"id":"N_1"}}`+"\n")
+		fmt.Fprint(w, `{"data":{"node":{"id":"N_1"}}}`)
+	})
+
+	var result struct {
+		Node struct {
+			ID string `json:"id"`
+		} `json:"node"`
+	}
+	ctx := context.Background()
+	_, err := client.GraphQL(ctx, "query($id: ID!) { node(id: $id) { id } }", map[string]interface{}{"id": "N_1"}, &result)
+	if err != nil {
+		t.Errorf("GraphQL returned error: %v", err)
+	}
+	if got, want := result.Node.ID, "N_1"; got != want {
+		t.Errorf("GraphQL decoded node ID %q, want %q", got, want)
+	}
+
+	const methodName = "GraphQL"
+	testNewRequestAndDoFailureCategory(t, methodName, client, GraphqlCategory, func() (*Response, error) {
+		return client.GraphQL(ctx, "{ viewer { login } }", nil, nil)
+	})
+}
+
+func TestClient_GraphQL_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{
+			"data": {"a": {"id": "N_1"}, "b": null},
+			"errors": [
+				{
+					"type": "NOT_FOUND",
+					"message": "Could not resolve to a node with the global id of 'N_2'",
+					"path": ["b", 0],
+					"locations": [{"line": 1, "column": 25}]
+				},
+				{
+					"message": "Something went wrong",
+					"extensions": {"code": "internal"}
+				}
+			]
+		}`)
+	})
+
+	var result struct {
+		A *struct {
+			ID string `json:"id"`
+		} `json:"a"`
+	}
+	ctx := context.Background()
+	_, err := client.GraphQL(ctx, `{ a: node(id: "N_1") { id } b: node(id: "N_2") { id } }`, nil, &result)
+
+	var gqlErr *GraphQLErrors
+	if !errors.As(err, &gqlErr) {
+		t.Fatalf("GraphQL returned error %v, want *GraphQLErrors", err)
+	}
+	if gqlErr.Response == nil || gqlErr.Response.StatusCode != http.StatusOK {
+		t.Errorf("GraphQLErrors.Response = %+v, want status 200", gqlErr.Response)
+	}
+
+	want := []*GraphQLError{
+		{
+			Type:      "NOT_FOUND",
+			Message:   "Could not resolve to a node with the global id of 'N_2'",
+			Path:      []interface{}{"b", float64(0)},
+			Locations: []GraphQLErrorLocation{{Line: 1, Column: 25}},
+		},
+		{
+			Message:    "Something went wrong",
+			Extensions: map[string]interface{}{"code": "internal"},
+		},
+	}
+	if !cmp.Equal(gqlErr.Errors, want) {
+		t.Errorf("GraphQLErrors.Errors = %+v, want %+v", gqlErr.Errors, want)
+	}
+
+	msg := gqlErr.Error()
+	for _, s := range []string{"POST", "/graphql", "NOT_FOUND: Could not resolve", "; Something went wrong"} {
+		if !strings.Contains(msg, s) {
+			t.Errorf("GraphQLErrors.Error() = %q, want it to contain %q", msg, s)
+		}
+	}
+
+	if result.A == nil || result.A.ID != "N_1" {
+		t.Errorf("GraphQL did not decode partial data, got %+v", result.A)
+	}
+}
+
+func TestClient_GraphQL_httpError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Bad credentials"}`, http.StatusUnauthorized)
+	})
+
+	ctx := context.Background()
+	_, err := client.GraphQL(ctx, "{ viewer { login } }", nil, nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("GraphQL returned error %v, want *ErrorResponse", err)
+	}
+}
+
+func TestClient_GraphQL_rateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"rateLimit":{"limit":5000,"cost":1,"remaining":4990,"resetAt":"2006-01-02T15:04:05Z"}}}`)
+	})
+
+	ctx := context.Background()
+	resp, err := client.GraphQL(ctx, "{ rateLimit { limit cost remaining resetAt } }", nil, nil)
+	if err != nil {
+		t.Fatalf("GraphQL returned error: %v", err)
+	}
+
+	want := Rate{
+		Limit:     5000,
+		Remaining: 4990,
+		Reset:     Timestamp{time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)},
+	}
+	if !cmp.Equal(resp.Rate, want) {
+		t.Errorf("GraphQL returned Rate %+v, want %+v", resp.Rate, want)
+	}
+
+	client.rateMu.Lock()
+	got := client.rateLimits[GraphqlCategory]
+	client.rateMu.Unlock()
+	if !cmp.Equal(got, want) {
+		t.Errorf("GraphQL stored rate limit %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_graphQLURL(t *testing.T) {
+	tests := map[string]struct {
+		baseURL   string
+		uploadURL string
+		want      string
+	}{
+		"GitHub.com": {
+			want: "https://api.github.com/graphql",
+		},
+		"GHES": {
+			baseURL:   "https://ghes.example.com/api/v3/",
+			uploadURL: "https://ghes.example.com/api/uploads/",
+			want:      "https://ghes.example.com/api/graphql",
+		},
+		"GHES without api/v3": {
+			baseURL:   "https://ghes.example.com",
+			uploadURL: "https://ghes.example.com",
+			want:      "https://ghes.example.com/api/graphql",
+		},
+		"GHE.com": {
+			baseURL:   "https://api.octocorp.ghe.com/",
+			uploadURL: "https://uploads.octocorp.ghe.com/",
+			want:      "https://api.octocorp.ghe.com/graphql",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(nil)
+			if test.baseURL != "" {
+				var err error
+				c, err = c.WithEnterpriseURLs(test.baseURL, test.uploadURL)
+				if err != nil {
+					t.Fatalf("WithEnterpriseURLs returned error: %v", err)
+				}
+			}
+
+			u, err := c.graphQLURL()
+			if err != nil {
+				t.Fatalf("graphQLURL returned error: %v", err)
+			}
+			if got := u.String(); got != test.want {
+				t.Errorf("graphQLURL = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestClient_graphQLURL_noTrailingSlash(t *testing.T) {
+	c := NewClient(nil)
+	c.BaseURL.Path = "/api/v3"
+	if _, err := c.GraphQL(context.Background(), "{ viewer { login } }", nil, nil); err == nil {
+		t.Error("GraphQL returned nil error, want error for BaseURL without trailing slash")
+	}
+}
@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /orgs/{org}/interaction-limits
 func (s *InteractionsService) GetRestrictionsForOrg(ctx context.Context, organization string) (*InteractionRestriction, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/interaction-limits", organization)
+	u := fmt.Sprintf("orgs/%v/interaction-limits", PathEscape(organization))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -45,7 +45,7 @@ func (s *InteractionsService) GetRestrictionsForOrg(ctx context.Context, organiz
 //
 //meta:operation PUT /orgs/{org}/interaction-limits
 func (s *InteractionsService) UpdateRestrictionsForOrg(ctx context.Context, organization, limit string) (*InteractionRestriction, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/interaction-limits", organization)
+	u := fmt.Sprintf("orgs/%v/interaction-limits", PathEscape(organization))
 
 	interaction := &InteractionRestriction{Limit: String(limit)}
 
@@ -73,7 +73,7 @@ func (s *InteractionsService) UpdateRestrictionsForOrg(ctx context.Context, orga
 //
 //meta:operation DELETE /orgs/{org}/interaction-limits
 func (s *InteractionsService) RemoveRestrictionsFromOrg(ctx context.Context, organization string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/interaction-limits", organization)
+	u := fmt.Sprintf("orgs/%v/interaction-limits", PathEscape(organization))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
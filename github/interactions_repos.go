@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /repos/{owner}/{repo}/interaction-limits
 func (s *InteractionsService) GetRestrictionsForRepo(ctx context.Context, owner, repo string) (*InteractionRestriction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/interaction-limits", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/interaction-limits", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -45,7 +45,7 @@ func (s *InteractionsService) GetRestrictionsForRepo(ctx context.Context, owner,
 //
 //meta:operation PUT /repos/{owner}/{repo}/interaction-limits
 func (s *InteractionsService) UpdateRestrictionsForRepo(ctx context.Context, owner, repo, limit string) (*InteractionRestriction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/interaction-limits", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/interaction-limits", PathEscape(owner), PathEscape(repo))
 
 	interaction := &InteractionRestriction{Limit: String(limit)}
 
@@ -73,7 +73,7 @@ func (s *InteractionsService) UpdateRestrictionsForRepo(ctx context.Context, own
 //
 //meta:operation DELETE /repos/{owner}/{repo}/interaction-limits
 func (s *InteractionsService) RemoveRestrictionsFromRepo(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/interaction-limits", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/interaction-limits", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
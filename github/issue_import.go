@@ -74,7 +74,7 @@ type IssueImportError struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/import/issues
 func (s *IssueImportService) Create(ctx context.Context, owner, repo string, issue *IssueImportRequest) (*IssueImportResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import/issues", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/import/issues", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, issue)
 	if err != nil {
 		return nil, nil, err
@@ -105,7 +105,7 @@ func (s *IssueImportService) Create(ctx context.Context, owner, repo string, iss
 //
 //meta:operation GET /repos/{owner}/{repo}/import/issues/{issue_number}
 func (s *IssueImportService) CheckStatus(ctx context.Context, owner, repo string, issueID int64) (*IssueImportResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import/issues/%v", owner, repo, issueID)
+	u := fmt.Sprintf("repos/%v/%v/import/issues/%v", PathEscape(owner), PathEscape(repo), issueID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -129,7 +129,7 @@ func (s *IssueImportService) CheckStatus(ctx context.Context, owner, repo string
 //
 //meta:operation GET /repos/{owner}/{repo}/import/issues
 func (s *IssueImportService) CheckStatusSince(ctx context.Context, owner, repo string, since Timestamp) ([]*IssueImportResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import/issues?since=%v", owner, repo, since.Format("2006-01-02"))
+	u := fmt.Sprintf("repos/%v/%v/import/issues?since=%v", PathEscape(owner), PathEscape(repo), since.Format("2006-01-02"))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -168,6 +168,8 @@ func TestIssueImportService_Create_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.IssueImport.Create(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.IssueImport.Create(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssueImportService_CheckStatus(t *testing.T) {
@@ -214,6 +216,8 @@ func TestIssueImportService_CheckStatus_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.IssueImport.CheckStatus(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, _, err = client.IssueImport.CheckStatus(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssueImportService_CheckStatusSince(t *testing.T) {
@@ -277,6 +281,8 @@ func TestIssueImportService_CheckStatusSince_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.IssueImport.CheckStatusSince(ctx, "%", "r", Timestamp{time.Now()})
 	testURLParseError(t, err)
+	_, _, err = client.IssueImport.CheckStatusSince(ctx, "..", "r", Timestamp{time.Now()})
+	testPathForbiddenError(t, err)
 }
 
 var issueImportResponseJSON = []byte(`{
@@ -7,7 +7,9 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -28,7 +30,7 @@ type Issue struct {
 	ID     *int64  `json:"id,omitempty"`
 	Number *int    `json:"number,omitempty"`
 	State  *string `json:"state,omitempty"`
-	// StateReason can be one of: "completed", "not_planned", "reopened".
+	// StateReason is one of the IssueStateReason constants.
 	StateReason       *string           `json:"state_reason,omitempty"`
 	Locked            *bool             `json:"locked,omitempty"`
 	Title             *string           `json:"title,omitempty"`
@@ -76,6 +78,14 @@ func (i Issue) IsPullRequest() bool {
 	return i.PullRequestLinks != nil
 }
 
+// The possible values of Issue.StateReason and IssueRequest.StateReason.
+const (
+	IssueStateReasonCompleted  = "completed"
+	IssueStateReasonNotPlanned = "not_planned"
+	IssueStateReasonDuplicate  = "duplicate"
+	IssueStateReasonReopened   = "reopened"
+)
+
 // IssueRequest represents a request to create/edit an issue.
 // It is separate from Issue above because otherwise Labels
 // and Assignee fail to serialize to the correct JSON.
@@ -85,10 +95,32 @@ type IssueRequest struct {
 	Labels   *[]string `json:"labels,omitempty"`
 	Assignee *string   `json:"assignee,omitempty"`
 	State    *string   `json:"state,omitempty"`
-	// StateReason can be 'completed' or 'not_planned'.
+	// StateReason is the reason for a change of State: IssueStateReasonReopened
+	// with the "open" state, or another IssueStateReason constant with the
+	// "closed" state.
 	StateReason *string   `json:"state_reason,omitempty"`
 	Milestone   *int      `json:"milestone,omitempty"`
 	Assignees   *[]string `json:"assignees,omitempty"`
+
+	// NullableMilestone, if specified, is sent as the milestone instead of
+	// Milestone. Set it to NewNullNullable[int]() to remove the milestone of
+	// an issue while editing it.
+	NullableMilestone Nullable[int] `json:"-"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *IssueRequest) MarshalJSON() ([]byte, error) {
+	type Alias IssueRequest
+	if !r.NullableMilestone.IsSpecified() {
+		return json.Marshal((*Alias)(r))
+	}
+	return json.Marshal(&struct {
+		*Alias
+		Milestone Nullable[int] `json:"milestone"`
+	}{
+		Alias:     (*Alias)(r),
+		Milestone: r.NullableMilestone,
+	})
 }
 
 // IssueListOptions specifies the optional parameters to the IssuesService.List
@@ -156,7 +188,7 @@ func (s *IssuesService) List(ctx context.Context, all bool, opts *IssueListOptio
 //
 //meta:operation GET /orgs/{org}/issues
 func (s *IssuesService) ListByOrg(ctx context.Context, org string, opts *IssueListOptions) ([]*Issue, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/issues", org)
+	u := fmt.Sprintf("orgs/%v/issues", PathEscape(org))
 	return s.listIssues(ctx, u, opts)
 }
 
@@ -183,12 +215,20 @@ func (s *IssuesService) listIssues(ctx context.Context, u string, opts *IssueLis
 	return issues, resp, nil
 }
 
+// Special values of IssueListByRepoOptions.Milestone and Assignee.
+const (
+	MilestoneNone = "none" // Issues without a milestone.
+	MilestoneAny  = "*"    // Issues with any milestone.
+	AssigneeNone  = "none" // Issues without an assignee.
+	AssigneeAny   = "*"    // Issues with any assignee.
+)
+
 // IssueListByRepoOptions specifies the optional parameters to the
 // IssuesService.ListByRepo method.
 type IssueListByRepoOptions struct {
 	// Milestone limits issues for the specified milestone. Possible values are
-	// a milestone number, "none" for issues with no milestone, "*" for issues
-	// with any milestone.
+	// a milestone number, MilestoneNone for issues with no milestone,
+	// MilestoneAny for issues with any milestone.
 	Milestone string `url:"milestone,omitempty"`
 
 	// State filters issues based on their state. Possible values are: open,
@@ -196,8 +236,8 @@ type IssueListByRepoOptions struct {
 	State string `url:"state,omitempty"`
 
 	// Assignee filters issues based on their assignee. Possible values are a
-	// user name, "none" for issues that are not assigned, "*" for issues with
-	// any assigned user.
+	// user name, AssigneeNone for issues that are not assigned, AssigneeAny
+	// for issues with any assigned user.
 	Assignee string `url:"assignee,omitempty"`
 
 	// Creator filters issues based on their creator.
@@ -206,7 +246,9 @@ type IssueListByRepoOptions struct {
 	// Mentioned filters issues to those mentioned a specific user.
 	Mentioned string `url:"mentioned,omitempty"`
 
-	// Labels filters issues based on their label.
+	// Labels filters issues based on their label. Issues must have all the
+	// labels; see IssuesService.ListByRepoWithAnyLabel to list issues that
+	// have any of several labels.
 	Labels []string `url:"labels,omitempty,comma"`
 
 	// Sort specifies how to sort issues. Possible values are: created, updated,
@@ -229,7 +271,7 @@ type IssueListByRepoOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/issues
 func (s *IssuesService) ListByRepo(ctx context.Context, owner string, repo string, opts *IssueListByRepoOptions) ([]*Issue, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/issues", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -252,13 +294,86 @@ func (s *IssuesService) ListByRepo(ctx context.Context, owner string, repo strin
 	return issues, resp, nil
 }
 
+// ListByRepoWithAnyLabel lists all the issues of the specified repository
+// that have at least one of labels, in addition to matching opts. GitHub can
+// only filter issues by all of several labels, so this makes one listing per
+// label, fetching as many pages as needed, and merges the results. Issues
+// with several of labels are only returned once, and the issues are sorted as
+// opts.Sort and opts.Direction specify. opts.ListOptions is ignored. It pauses
+// whenever it is rate limited, until ctx is done.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/issues#list-repository-issues
+//
+//meta:operation GET /repos/{owner}/{repo}/issues
+func (s *IssuesService) ListByRepoWithAnyLabel(ctx context.Context, owner string, repo string, labels []string, opts *IssueListByRepoOptions) ([]*Issue, error) {
+	var base IssueListByRepoOptions
+	if opts != nil {
+		base = *opts
+	}
+	base.ListOptions = ListOptions{PerPage: 100}
+
+	seen := make(map[int64]bool)
+	var issues []*Issue
+	for _, label := range labels {
+		pageOpts := base
+		pageOpts.Labels = append(append([]string(nil), base.Labels...), label)
+		for {
+			page, resp, err := s.ListByRepo(ctx, owner, repo, &pageOpts)
+			if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+				return nil, werr
+			} else if retry {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			for _, issue := range page {
+				if id := issue.GetID(); !seen[id] {
+					seen[id] = true
+					issues = append(issues, issue)
+				}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+			pageOpts.Page = resp.NextPage
+		}
+	}
+
+	sortIssues(issues, base.Sort, base.Direction)
+	return issues, nil
+}
+
+// sortIssues sorts issues the way the issue listing endpoints do for the given
+// sort and direction options, keeping the order of equal issues.
+func sortIssues(issues []*Issue, by, direction string) {
+	less := func(a, b *Issue) bool {
+		switch by {
+		case "updated":
+			return a.GetUpdatedAt().Before(b.GetUpdatedAt().Time)
+		case "comments":
+			return a.GetComments() < b.GetComments()
+		default:
+			return a.GetCreatedAt().Before(b.GetCreatedAt().Time)
+		}
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		if direction == "asc" {
+			return less(issues[i], issues[j])
+		}
+		return less(issues[j], issues[i])
+	})
+}
+
 // Get a single issue.
 //
 // GitHub API docs: https://docs.github.com/rest/issues/issues#get-an-issue
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}
 func (s *IssuesService) Get(ctx context.Context, owner string, repo string, number int) (*Issue, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -282,7 +397,7 @@ func (s *IssuesService) Get(ctx context.Context, owner string, repo string, numb
 //
 //meta:operation POST /repos/{owner}/{repo}/issues
 func (s *IssuesService) Create(ctx context.Context, owner string, repo string, issue *IssueRequest) (*Issue, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/issues", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, issue)
 	if err != nil {
 		return nil, nil, err
@@ -303,7 +418,7 @@ func (s *IssuesService) Create(ctx context.Context, owner string, repo string, i
 //
 //meta:operation PATCH /repos/{owner}/{repo}/issues/{issue_number}
 func (s *IssuesService) Edit(ctx context.Context, owner string, repo string, number int, issue *IssueRequest) (*Issue, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("PATCH", u, issue)
 	if err != nil {
 		return nil, nil, err
@@ -326,7 +441,7 @@ func (s *IssuesService) Edit(ctx context.Context, owner string, repo string, num
 //
 //meta:operation PATCH /repos/{owner}/{repo}/issues/{issue_number}
 func (s *IssuesService) RemoveMilestone(ctx context.Context, owner, repo string, issueNumber int) (*Issue, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%v", owner, repo, issueNumber)
+	u := fmt.Sprintf("repos/%v/%v/issues/%v", PathEscape(owner), PathEscape(repo), issueNumber)
 	req, err := s.client.NewRequest("PATCH", u, &struct {
 		Milestone *Milestone `json:"milestone"`
 	}{})
@@ -343,6 +458,40 @@ func (s *IssuesService) RemoveMilestone(ctx context.Context, owner, repo string,
 	return i, resp, nil
 }
 
+// CloseIssue closes an issue with the given reason, one of
+// IssueStateReasonCompleted, IssueStateReasonNotPlanned and
+// IssueStateReasonDuplicate. An empty reason leaves it to GitHub, which uses
+// IssueStateReasonCompleted. Pull requests can be closed too, but GitHub
+// ignores the reason for them.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/issues#update-an-issue
+//
+//meta:operation PATCH /repos/{owner}/{repo}/issues/{issue_number}
+func (s *IssuesService) CloseIssue(ctx context.Context, owner, repo string, number int, reason string) (*Issue, *Response, error) {
+	request := &IssueRequest{State: String("closed")}
+	switch reason {
+	case "":
+	case IssueStateReasonCompleted, IssueStateReasonNotPlanned, IssueStateReasonDuplicate:
+		request.StateReason = String(reason)
+	default:
+		return nil, nil, fmt.Errorf("invalid close reason %q, want %q, %q or %q", reason, IssueStateReasonCompleted, IssueStateReasonNotPlanned, IssueStateReasonDuplicate)
+	}
+	return s.Edit(ctx, owner, repo, number, request)
+}
+
+// ReopenIssue reopens a closed issue, with IssueStateReasonReopened as its
+// state reason.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/issues#update-an-issue
+//
+//meta:operation PATCH /repos/{owner}/{repo}/issues/{issue_number}
+func (s *IssuesService) ReopenIssue(ctx context.Context, owner, repo string, number int) (*Issue, *Response, error) {
+	return s.Edit(ctx, owner, repo, number, &IssueRequest{
+		State:       String("open"),
+		StateReason: String(IssueStateReasonReopened),
+	})
+}
+
 // LockIssueOptions specifies the optional parameters to the
 // IssuesService.Lock method.
 type LockIssueOptions struct {
@@ -358,7 +507,7 @@ type LockIssueOptions struct {
 //
 //meta:operation PUT /repos/{owner}/{repo}/issues/{issue_number}/lock
 func (s *IssuesService) Lock(ctx context.Context, owner string, repo string, number int, opts *LockIssueOptions) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d/lock", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/lock", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, err
@@ -373,7 +522,7 @@ func (s *IssuesService) Lock(ctx context.Context, owner string, repo string, num
 //
 //meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/lock
 func (s *IssuesService) Unlock(ctx context.Context, owner string, repo string, number int) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d/lock", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/lock", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
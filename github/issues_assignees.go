@@ -17,7 +17,7 @@ import (
 //
 //meta:operation GET /repos/{owner}/{repo}/assignees
 func (s *IssuesService) ListAssignees(ctx context.Context, owner, repo string, opts *ListOptions) ([]*User, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/assignees", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/assignees", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -43,7 +43,7 @@ func (s *IssuesService) ListAssignees(ctx context.Context, owner, repo string, o
 //
 //meta:operation GET /repos/{owner}/{repo}/assignees/{assignee}
 func (s *IssuesService) IsAssignee(ctx context.Context, owner, repo, user string) (bool, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/assignees/%v", owner, repo, user)
+	u := fmt.Sprintf("repos/%v/%v/assignees/%v", PathEscape(owner), PathEscape(repo), PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return false, nil, err
@@ -63,7 +63,7 @@ func (s *IssuesService) AddAssignees(ctx context.Context, owner, repo string, nu
 	users := &struct {
 		Assignees []string `json:"assignees,omitempty"`
 	}{Assignees: assignees}
-	u := fmt.Sprintf("repos/%v/%v/issues/%v/assignees", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%v/assignees", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("POST", u, users)
 	if err != nil {
 		return nil, nil, err
@@ -87,7 +87,7 @@ func (s *IssuesService) RemoveAssignees(ctx context.Context, owner, repo string,
 	users := &struct {
 		Assignees []string `json:"assignees,omitempty"`
 	}{Assignees: assignees}
-	u := fmt.Sprintf("repos/%v/%v/issues/%v/assignees", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%v/assignees", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("DELETE", u, users)
 	if err != nil {
 		return nil, nil, err
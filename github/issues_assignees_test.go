@@ -59,6 +59,8 @@ func TestIssuesService_ListAssignees_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ListAssignees(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ListAssignees(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_IsAssignee_true(t *testing.T) {
@@ -166,6 +168,8 @@ func TestIssuesService_IsAssignee_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.IsAssignee(ctx, "%", "r", "u")
 	testURLParseError(t, err)
+	_, _, err = client.Issues.IsAssignee(ctx, "..", "r", "u")
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_AddAssignees(t *testing.T) {
@@ -26,6 +26,11 @@ type IssueComment struct {
 	URL               *string `json:"url,omitempty"`
 	HTMLURL           *string `json:"html_url,omitempty"`
 	IssueURL          *string `json:"issue_url,omitempty"`
+	// IsMinimized and MinimizedReason are only set where the API returns
+	// them. MinimizedReason is the lower case classifier the comment was
+	// minimized with, such as "spam", see Client.MinimizeComment.
+	IsMinimized     *bool   `json:"is_minimized,omitempty"`
+	MinimizedReason *string `json:"minimized_reason,omitempty"`
 }
 
 func (i IssueComment) String() string {
@@ -58,9 +63,9 @@ type IssueListCommentsOptions struct {
 func (s *IssuesService) ListComments(ctx context.Context, owner string, repo string, number int, opts *IssueListCommentsOptions) ([]*IssueComment, *Response, error) {
 	var u string
 	if number == 0 {
-		u = fmt.Sprintf("repos/%v/%v/issues/comments", owner, repo)
+		u = fmt.Sprintf("repos/%v/%v/issues/comments", PathEscape(owner), PathEscape(repo))
 	} else {
-		u = fmt.Sprintf("repos/%v/%v/issues/%d/comments", owner, repo, number)
+		u = fmt.Sprintf("repos/%v/%v/issues/%d/comments", PathEscape(owner), PathEscape(repo), number)
 	}
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -90,7 +95,7 @@ func (s *IssuesService) ListComments(ctx context.Context, owner string, repo str
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/comments/{comment_id}
 func (s *IssuesService) GetComment(ctx context.Context, owner string, repo string, commentID int64) (*IssueComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/comments/%d", owner, repo, commentID)
+	u := fmt.Sprintf("repos/%v/%v/issues/comments/%d", PathEscape(owner), PathEscape(repo), commentID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -115,7 +120,7 @@ func (s *IssuesService) GetComment(ctx context.Context, owner string, repo strin
 //
 //meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/comments
 func (s *IssuesService) CreateComment(ctx context.Context, owner string, repo string, number int, comment *IssueComment) (*IssueComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d/comments", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/comments", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("POST", u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -136,7 +141,7 @@ func (s *IssuesService) CreateComment(ctx context.Context, owner string, repo st
 //
 //meta:operation PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}
 func (s *IssuesService) EditComment(ctx context.Context, owner string, repo string, commentID int64, comment *IssueComment) (*IssueComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/comments/%d", owner, repo, commentID)
+	u := fmt.Sprintf("repos/%v/%v/issues/comments/%d", PathEscape(owner), PathEscape(repo), commentID)
 	req, err := s.client.NewRequest("PATCH", u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -156,7 +161,7 @@ func (s *IssuesService) EditComment(ctx context.Context, owner string, repo stri
 //
 //meta:operation DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}
 func (s *IssuesService) DeleteComment(ctx context.Context, owner string, repo string, commentID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/comments/%d", owner, repo, commentID)
+	u := fmt.Sprintf("repos/%v/%v/issues/comments/%d", PathEscape(owner), PathEscape(repo), commentID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
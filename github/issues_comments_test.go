@@ -108,6 +108,8 @@ func TestIssuesService_ListComments_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ListComments(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ListComments(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_GetComment(t *testing.T) {
@@ -153,6 +155,8 @@ func TestIssuesService_GetComment_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.GetComment(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.GetComment(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_CreateComment(t *testing.T) {
@@ -206,6 +210,8 @@ func TestIssuesService_CreateComment_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.CreateComment(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.CreateComment(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_EditComment(t *testing.T) {
@@ -259,6 +265,8 @@ func TestIssuesService_EditComment_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.EditComment(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.EditComment(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_DeleteComment(t *testing.T) {
@@ -293,6 +301,8 @@ func TestIssuesService_DeleteComment_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Issues.DeleteComment(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, err = client.Issues.DeleteComment(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssueComment_Marshal(t *testing.T) {
@@ -71,6 +71,13 @@ type IssueEvent struct {
 	//       The Actor requested or removed the request for a review.
 	//       RequestedReviewer or RequestedTeam, and ReviewRequester will be populated below.
 	//
+	//    added_to_project, moved_columns_in_project, removed_from_project, converted_note_to_issue
+	//       The issue was added to, moved within, or removed from a classic project, or
+	//       created from a project note. ProjectCard will be populated below.
+	//
+	// GitHub adds new event types over time, such as the project v2 ones. Events of
+	// types not listed here are still decoded, with the fields they share with the
+	// events above populated and any others ignored.
 	Event *string `json:"event,omitempty"`
 
 	CreatedAt *Timestamp `json:"created_at,omitempty"`
@@ -109,7 +116,7 @@ type DismissedReview struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/events
 func (s *IssuesService) ListIssueEvents(ctx context.Context, owner, repo string, number int, opts *ListOptions) ([]*IssueEvent, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%v/events", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%v/events", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -137,7 +144,7 @@ func (s *IssuesService) ListIssueEvents(ctx context.Context, owner, repo string,
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/events
 func (s *IssuesService) ListRepositoryEvents(ctx context.Context, owner, repo string, opts *ListOptions) ([]*IssueEvent, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/events", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/issues/events", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -163,7 +170,7 @@ func (s *IssuesService) ListRepositoryEvents(ctx context.Context, owner, repo st
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/events/{event_id}
 func (s *IssuesService) GetEvent(ctx context.Context, owner, repo string, id int64) (*IssueEvent, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/events/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/issues/events/%v", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -537,3 +537,64 @@ func TestIssueEvent_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestIssuesService_ListIssueEvents_payloads(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"event":"labeled","label":{"name":"bug","color":"f00"}},
+			{"id":2,"event":"milestoned","milestone":{"title":"v1"}},
+			{"id":3,"event":"renamed","rename":{"from":"old","to":"new"}},
+			{"id":4,"event":"review_requested","requested_reviewer":{"login":"r"},"requested_team":{"slug":"t"},"review_requester":{"login":"q"}},
+			{"id":5,"event":"review_dismissed","dismissed_review":{"state":"approved","review_id":7,"dismissal_message":"m","dismissal_commit_id":"c"}},
+			{"id":6,"event":"moved_columns_in_project","project_card":{"id":8,"project_id":9,"column_name":"Done","previous_column_name":"To do"}},
+			{"id":7,"event":"added_to_project_v2","project_v2":{"id":10},"performed_via_github_app":{"slug":"a"}}
+		]`)
+	})
+
+	ctx := context.Background()
+	events, _, err := client.Issues.ListIssueEvents(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Issues.ListIssueEvents returned error: %v", err)
+	}
+
+	want := []*IssueEvent{
+		{ID: Int64(1), Event: String("labeled"), Label: &Label{Name: String("bug"), Color: String("f00")}},
+		{ID: Int64(2), Event: String("milestoned"), Milestone: &Milestone{Title: String("v1")}},
+		{ID: Int64(3), Event: String("renamed"), Rename: &Rename{From: String("old"), To: String("new")}},
+		{
+			ID:                Int64(4),
+			Event:             String("review_requested"),
+			RequestedReviewer: &User{Login: String("r")},
+			RequestedTeam:     &Team{Slug: String("t")},
+			ReviewRequester:   &User{Login: String("q")},
+		},
+		{
+			ID:    Int64(5),
+			Event: String("review_dismissed"),
+			DismissedReview: &DismissedReview{
+				State:             String("approved"),
+				ReviewID:          Int64(7),
+				DismissalMessage:  String("m"),
+				DismissalCommitID: String("c"),
+			},
+		},
+		{
+			ID:    Int64(6),
+			Event: String("moved_columns_in_project"),
+			ProjectCard: &ProjectCard{
+				ID:                 Int64(8),
+				ProjectID:          Int64(9),
+				ColumnName:         String("Done"),
+				PreviousColumnName: String("To do"),
+			},
+		},
+		{ID: Int64(7), Event: String("added_to_project_v2"), PerformedViaGithubApp: &App{Slug: String("a")}},
+	}
+	if !cmp.Equal(events, want) {
+		t.Errorf("Issues.ListIssueEvents returned %+v, want %+v", events, want)
+	}
+}
@@ -31,7 +31,7 @@ func (l Label) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/labels
 func (s *IssuesService) ListLabels(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/labels", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/labels", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -57,7 +57,7 @@ func (s *IssuesService) ListLabels(ctx context.Context, owner string, repo strin
 //
 //meta:operation GET /repos/{owner}/{repo}/labels/{name}
 func (s *IssuesService) GetLabel(ctx context.Context, owner string, repo string, name string) (*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, name)
+	u := fmt.Sprintf("repos/%v/%v/labels/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -78,7 +78,7 @@ func (s *IssuesService) GetLabel(ctx context.Context, owner string, repo string,
 //
 //meta:operation POST /repos/{owner}/{repo}/labels
 func (s *IssuesService) CreateLabel(ctx context.Context, owner string, repo string, label *Label) (*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/labels", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/labels", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, label)
 	if err != nil {
 		return nil, nil, err
@@ -99,7 +99,7 @@ func (s *IssuesService) CreateLabel(ctx context.Context, owner string, repo stri
 //
 //meta:operation PATCH /repos/{owner}/{repo}/labels/{name}
 func (s *IssuesService) EditLabel(ctx context.Context, owner string, repo string, name string, label *Label) (*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, name)
+	u := fmt.Sprintf("repos/%v/%v/labels/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	req, err := s.client.NewRequest("PATCH", u, label)
 	if err != nil {
 		return nil, nil, err
@@ -120,7 +120,7 @@ func (s *IssuesService) EditLabel(ctx context.Context, owner string, repo string
 //
 //meta:operation DELETE /repos/{owner}/{repo}/labels/{name}
 func (s *IssuesService) DeleteLabel(ctx context.Context, owner string, repo string, name string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, name)
+	u := fmt.Sprintf("repos/%v/%v/labels/%v", PathEscape(owner), PathEscape(repo), PathEscape(name))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -134,7 +134,7 @@ func (s *IssuesService) DeleteLabel(ctx context.Context, owner string, repo stri
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/labels
 func (s *IssuesService) ListLabelsByIssue(ctx context.Context, owner string, repo string, number int, opts *ListOptions) ([]*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -160,7 +160,7 @@ func (s *IssuesService) ListLabelsByIssue(ctx context.Context, owner string, rep
 //
 //meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/labels
 func (s *IssuesService) AddLabelsToIssue(ctx context.Context, owner string, repo string, number int, labels []string) ([]*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("POST", u, labels)
 	if err != nil {
 		return nil, nil, err
@@ -181,7 +181,7 @@ func (s *IssuesService) AddLabelsToIssue(ctx context.Context, owner string, repo
 //
 //meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/labels/{name}
 func (s *IssuesService) RemoveLabelForIssue(ctx context.Context, owner string, repo string, number int, label string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels/%v", owner, repo, number, label)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels/%v", PathEscape(owner), PathEscape(repo), number, PathEscape(label))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -196,7 +196,7 @@ func (s *IssuesService) RemoveLabelForIssue(ctx context.Context, owner string, r
 //
 //meta:operation PUT /repos/{owner}/{repo}/issues/{issue_number}/labels
 func (s *IssuesService) ReplaceLabelsForIssue(ctx context.Context, owner string, repo string, number int, labels []string) ([]*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("PUT", u, labels)
 	if err != nil {
 		return nil, nil, err
@@ -217,7 +217,7 @@ func (s *IssuesService) ReplaceLabelsForIssue(ctx context.Context, owner string,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/labels
 func (s *IssuesService) RemoveLabelsForIssue(ctx context.Context, owner string, repo string, number int) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -232,7 +232,7 @@ func (s *IssuesService) RemoveLabelsForIssue(ctx context.Context, owner string,
 //
 //meta:operation GET /repos/{owner}/{repo}/milestones/{milestone_number}/labels
 func (s *IssuesService) ListLabelsForMilestone(ctx context.Context, owner string, repo string, number int, opts *ListOptions) ([]*Label, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/milestones/%d/labels", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/milestones/%d/labels", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
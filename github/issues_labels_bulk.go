@@ -0,0 +1,245 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// defaultBulkLabelConcurrency is the default number of issues
+// BulkAddLabelsToIssues labels at once.
+const defaultBulkLabelConcurrency = 4
+
+// EnsureLabelsReport reports the labels created and updated by
+// IssuesService.EnsureLabels. Names are those of the requested labels.
+type EnsureLabelsReport struct {
+	Created   []string
+	Updated   []string
+	Unchanged []string
+}
+
+// EnsureLabels makes the labels of a repository include labels: missing
+// labels are created, and existing labels whose color or description differ
+// are updated. A nil Color or Description is left as is on existing labels.
+// Label names are compared case-insensitively, as GitHub does, and colors
+// may have a leading "#". Other labels of the repository are left alone.
+//
+// On rate limit errors, EnsureLabels waits for the limit to reset and
+// retries. It stops at the first other error, returning the report of the
+// changes made so far.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/labels#create-a-label
+// GitHub API docs: https://docs.github.com/rest/issues/labels#list-labels-for-a-repository
+// GitHub API docs: https://docs.github.com/rest/issues/labels#update-a-label
+//
+//meta:operation GET /repos/{owner}/{repo}/labels
+//meta:operation POST /repos/{owner}/{repo}/labels
+//meta:operation PATCH /repos/{owner}/{repo}/labels/{name}
+func (s *IssuesService) EnsureLabels(ctx context.Context, owner, repo string, labels []*Label) (*EnsureLabelsReport, *Response, error) {
+	for _, l := range labels {
+		if l.GetName() == "" {
+			return nil, nil, errors.New("label has no name")
+		}
+	}
+
+	var existing []*Label
+	var resp *Response
+	opts := &ListOptions{PerPage: 100}
+	for {
+		var page []*Label
+		var err error
+		page, resp, err = s.ListLabels(ctx, owner, repo, opts)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return nil, resp, werr
+		} else if retry {
+			continue
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		existing = append(existing, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	report := &EnsureLabelsReport{}
+	for _, c := range diffLabels(existing, labels) {
+		if c.op == "" {
+			report.Unchanged = append(report.Unchanged, c.name)
+			continue
+		}
+		for {
+			var err error
+			if c.op == "create" {
+				_, resp, err = s.CreateLabel(ctx, owner, repo, c.label)
+			} else {
+				_, resp, err = s.EditLabel(ctx, owner, repo, c.current, c.label)
+			}
+			if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+				return report, resp, werr
+			} else if retry {
+				continue
+			}
+			if err != nil {
+				return report, resp, err
+			}
+			break
+		}
+		if c.op == "create" {
+			report.Created = append(report.Created, c.name)
+		} else {
+			report.Updated = append(report.Updated, c.name)
+		}
+	}
+	return report, resp, nil
+}
+
+// labelChange is a change computed by diffLabels.
+type labelChange struct {
+	name string // the requested name
+	op   string // "create", "update", or "" for an unchanged label
+	// current is the name of the existing label to update.
+	current string
+	// label is the label to create, or the fields to update.
+	label *Label
+}
+
+// diffLabels returns the changes making existing include wanted, in the
+// order of wanted. Duplicate wanted labels are ignored.
+func diffLabels(existing, wanted []*Label) []labelChange {
+	byName := make(map[string]*Label, len(existing))
+	for _, l := range existing {
+		byName[strings.ToLower(l.GetName())] = l
+	}
+
+	var changes []labelChange
+	seen := make(map[string]bool, len(wanted))
+	for _, w := range wanted {
+		key := strings.ToLower(w.GetName())
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		label := &Label{Name: w.Name, Description: w.Description}
+		if w.Color != nil {
+			label.Color = String(normalizeLabelColor(*w.Color))
+		}
+		current, ok := byName[key]
+		if !ok {
+			changes = append(changes, labelChange{name: w.GetName(), op: "create", label: label})
+			continue
+		}
+
+		update := &Label{}
+		if label.Color != nil && *label.Color != normalizeLabelColor(current.GetColor()) {
+			update.Color = label.Color
+		}
+		if label.Description != nil && *label.Description != current.GetDescription() {
+			update.Description = label.Description
+		}
+		if update.Color == nil && update.Description == nil {
+			changes = append(changes, labelChange{name: w.GetName()})
+			continue
+		}
+		changes = append(changes, labelChange{name: w.GetName(), op: "update", current: current.GetName(), label: update})
+	}
+	return changes
+}
+
+// normalizeLabelColor returns color as GitHub expects it, without a leading
+// "#" and in lower case.
+func normalizeLabelColor(color string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(color), "#"))
+}
+
+// BulkLabelOptions specifies the optional parameters to the
+// IssuesService.BulkAddLabelsToIssues method.
+type BulkLabelOptions struct {
+	// Concurrency is the maximum number of issues labeled at once. The
+	// default is 4.
+	Concurrency int
+}
+
+// BulkLabelReport reports the issues labeled by
+// IssuesService.BulkAddLabelsToIssues. Issue numbers are sorted.
+type BulkLabelReport struct {
+	Labeled []int
+	Failed  []*BulkLabelFailure
+}
+
+// BulkLabelFailure is an issue that could not be labeled.
+type BulkLabelFailure struct {
+	Number int
+	Err    error
+}
+
+func (f *BulkLabelFailure) Error() string {
+	return fmt.Sprintf("issue #%v: %v", f.Number, f.Err)
+}
+
+func (f *BulkLabelFailure) Unwrap() error {
+	return f.Err
+}
+
+// BulkAddLabelsToIssues adds the labels with the given names to each of the
+// issues or pull requests with the given numbers, several at once. The labels
+// should exist, see EnsureLabels, or GitHub creates them with a default color.
+//
+// On rate limit errors, the labeling of an issue waits for the limit to reset
+// and is retried. Other failures are collected in the report, and do not stop
+// the labeling of the other issues; the returned error joins them, and is nil
+// if every issue was labeled.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/labels#add-labels-to-an-issue
+//
+//meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/labels
+func (s *IssuesService) BulkAddLabelsToIssues(ctx context.Context, owner, repo string, issueNumbers []int, labelNames []string, opts *BulkLabelOptions) (*BulkLabelReport, error) {
+	concurrency := defaultBulkLabelConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	report := &BulkLabelReport{}
+	var mu sync.Mutex
+	runBounded(len(issueNumbers), concurrency, func(i int) {
+		number := issueNumbers[i]
+		var err error
+		for {
+			_, _, err = s.AddLabelsToIssue(ctx, owner, repo, number, labelNames)
+			if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+				err = werr
+			} else if retry {
+				continue
+			}
+			break
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			report.Failed = append(report.Failed, &BulkLabelFailure{Number: number, Err: err})
+			return
+		}
+		report.Labeled = append(report.Labeled, number)
+	})
+
+	sort.Ints(report.Labeled)
+	sort.Slice(report.Failed, func(i, j int) bool { return report.Failed[i].Number < report.Failed[j].Number })
+
+	errs := make([]error, len(report.Failed))
+	for i, f := range report.Failed {
+		errs[i] = f
+	}
+	return report, errors.Join(errs...)
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffLabels(t *testing.T) {
+	existing := []*Label{
+		{Name: String("bug"), Color: String("D73A4A"), Description: String("Something isn't working")},
+		{Name: String("Enhancement"), Color: String("a2eeef"), Description: String("New feature")},
+		{Name: String("docs"), Color: String("0075ca")},
+	}
+	wanted := []*Label{
+		{Name: String("bug"), Color: String("#d73a4a"), Description: String("Something isn't working")},
+		{Name: String("enhancement"), Color: String("#00ff00")},
+		{Name: String("docs"), Description: String("Documentation")},
+		{Name: String("good first issue"), Color: String("#7057FF")},
+		{Name: String("BUG"), Color: String("000000")},
+	}
+
+	want := []labelChange{
+		{name: "bug"},
+		{name: "enhancement", op: "update", current: "Enhancement", label: &Label{Color: String("00ff00")}},
+		{name: "docs", op: "update", current: "docs", label: &Label{Description: String("Documentation")}},
+		{name: "good first issue", op: "create", label: &Label{Name: String("good first issue"), Color: String("7057ff")}},
+	}
+	got := diffLabels(existing, wanted)
+	if !cmp.Equal(got, want, cmp.AllowUnexported(labelChange{})) {
+		t.Errorf("diffLabels returned %+v, want %+v", got, want)
+	}
+}
+
+func TestIssuesService_EnsureLabels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			if r.FormValue("page") == "" {
+				testFormValues(t, r, values{"per_page": "100"})
+				w.Header().Set("Link", `<https://api.github.com/repos/o/r/labels?per_page=100&page=2>; rel="next"`)
+				fmt.Fprint(w, `[{"name":"bug","color":"d73a4a"}]`)
+				return
+			}
+			testFormValues(t, r, values{"per_page": "100", "page": "2"})
+			fmt.Fprint(w, `[{"name":"Docs","color":"0075ca","description":"Docs"}]`)
+		case "POST":
+			testBody(t, r, `{"name":"triage","color":"ededed"}`+"\n")
+			fmt.Fprint(w, `{"name":"triage"}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/labels/Docs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"color":"1d76db"}`+"\n")
+		fmt.Fprint(w, `{"name":"Docs"}`)
+	})
+
+	ctx := context.Background()
+	labels := []*Label{
+		{Name: String("bug"), Color: String("#D73A4A")},
+		{Name: String("docs"), Color: String("#1d76db"), Description: String("Docs")},
+		{Name: String("triage"), Color: String("#ededed")},
+	}
+	report, _, err := client.Issues.EnsureLabels(ctx, "o", "r", labels)
+	if err != nil {
+		t.Fatalf("Issues.EnsureLabels returned error: %v", err)
+	}
+
+	want := &EnsureLabelsReport{
+		Created:   []string{"triage"},
+		Updated:   []string{"docs"},
+		Unchanged: []string{"bug"},
+	}
+	if !cmp.Equal(report, want) {
+		t.Errorf("Issues.EnsureLabels returned %+v, want %+v", report, want)
+	}
+
+	const methodName = "EnsureLabels"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.EnsureLabels(ctx, "\n", "\n", labels)
+		return err
+	})
+
+	// EnsureLabels waits for rate limits to reset, so use a canceled context
+	// to return the rate limit error right away.
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.EnsureLabels(canceled, "o", "r", labels)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_EnsureLabels_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Issues.EnsureLabels(ctx, "o", "r", []*Label{{Color: String("ffffff")}}); err == nil {
+		t.Error("Issues.EnsureLabels returned no error for a label without a name")
+	}
+
+	report, _, err := client.Issues.EnsureLabels(ctx, "o", "r", []*Label{{Name: String("bug")}})
+	if err == nil {
+		t.Error("Issues.EnsureLabels returned no error")
+	}
+	if want := (&EnsureLabelsReport{}); !cmp.Equal(report, want) {
+		t.Errorf("Issues.EnsureLabels returned %+v, want %+v", report, want)
+	}
+}
+
+func TestIssuesService_BulkAddLabelsToIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var active, maxActive int
+	for _, number := range []int{1, 2, 3, 4, 5} {
+		number := number
+		mux.HandleFunc(fmt.Sprintf("/repos/o/r/issues/%v/labels", number), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `["bug","triage"]`+"\n")
+
+			mu.Lock()
+			active++
+			if active > maxActive {
+				maxActive = active
+			}
+			mu.Unlock()
+			defer func() {
+				mu.Lock()
+				active--
+				mu.Unlock()
+			}()
+
+			switch number {
+			case 2:
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"message":"Not Found"}`)
+			case 4:
+				w.WriteHeader(http.StatusGone)
+				fmt.Fprint(w, `{"message":"Issues are disabled for this repo"}`)
+			default:
+				fmt.Fprint(w, `[{"name":"bug"},{"name":"triage"}]`)
+			}
+		})
+	}
+
+	ctx := context.Background()
+	opts := &BulkLabelOptions{Concurrency: 2}
+	report, err := client.Issues.BulkAddLabelsToIssues(ctx, "o", "r", []int{5, 4, 3, 2, 1}, []string{"bug", "triage"}, opts)
+	if err == nil {
+		t.Fatal("Issues.BulkAddLabelsToIssues returned no error")
+	}
+	if !strings.Contains(err.Error(), "issue #2") || !strings.Contains(err.Error(), "issue #4") {
+		t.Errorf("Issues.BulkAddLabelsToIssues returned error %q, want it to mention issues #2 and #4", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Issues.BulkAddLabelsToIssues returned error %v, want an *ErrorResponse", err)
+	}
+
+	if want := []int{1, 3, 5}; !cmp.Equal(report.Labeled, want) {
+		t.Errorf("Issues.BulkAddLabelsToIssues labeled %v, want %v", report.Labeled, want)
+	}
+	if len(report.Failed) != 2 || report.Failed[0].Number != 2 || report.Failed[1].Number != 4 {
+		t.Fatalf("Issues.BulkAddLabelsToIssues failed %+v, want issues 2 and 4", report.Failed)
+	}
+	if !errors.As(report.Failed[1], &errResp) || errResp.Response.StatusCode != http.StatusGone {
+		t.Errorf("Issues.BulkAddLabelsToIssues failure of issue 4 is %v, want a 410 response", report.Failed[1].Err)
+	}
+	if maxActive > opts.Concurrency {
+		t.Errorf("Issues.BulkAddLabelsToIssues labeled %v issues at once, want at most %v", maxActive, opts.Concurrency)
+	}
+
+	report, err = client.Issues.BulkAddLabelsToIssues(ctx, "o", "r", []int{1, 3}, []string{"bug", "triage"}, nil)
+	if err != nil {
+		t.Errorf("Issues.BulkAddLabelsToIssues returned error: %v", err)
+	}
+	if want := (&BulkLabelReport{Labeled: []int{1, 3}}); !cmp.Equal(report, want) {
+		t.Errorf("Issues.BulkAddLabelsToIssues returned %+v, want %+v", report, want)
+	}
+}
@@ -59,6 +59,8 @@ func TestIssuesService_ListLabels_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ListLabels(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ListLabels(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_GetLabel(t *testing.T) {
@@ -103,6 +105,8 @@ func TestIssuesService_GetLabel_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.GetLabel(ctx, "%", "%", "%")
 	testURLParseError(t, err)
+	_, _, err = client.Issues.GetLabel(ctx, "..", "..", "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_CreateLabel(t *testing.T) {
@@ -156,6 +160,8 @@ func TestIssuesService_CreateLabel_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.CreateLabel(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.CreateLabel(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_EditLabel(t *testing.T) {
@@ -209,6 +215,8 @@ func TestIssuesService_EditLabel_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.EditLabel(ctx, "%", "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.EditLabel(ctx, "..", "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_DeleteLabel(t *testing.T) {
@@ -243,6 +251,8 @@ func TestIssuesService_DeleteLabel_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Issues.DeleteLabel(ctx, "%", "%", "%")
 	testURLParseError(t, err)
+	_, err = client.Issues.DeleteLabel(ctx, "..", "..", "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_ListLabelsByIssue(t *testing.T) {
@@ -292,6 +302,8 @@ func TestIssuesService_ListLabelsByIssue_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ListLabelsByIssue(ctx, "%", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ListLabelsByIssue(ctx, "..", "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_AddLabelsToIssue(t *testing.T) {
@@ -345,6 +357,8 @@ func TestIssuesService_AddLabelsToIssue_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.AddLabelsToIssue(ctx, "%", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.AddLabelsToIssue(ctx, "..", "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_RemoveLabelForIssue(t *testing.T) {
@@ -379,6 +393,8 @@ func TestIssuesService_RemoveLabelForIssue_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Issues.RemoveLabelForIssue(ctx, "%", "%", 1, "%")
 	testURLParseError(t, err)
+	_, err = client.Issues.RemoveLabelForIssue(ctx, "..", "..", 1, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_ReplaceLabelsForIssue(t *testing.T) {
@@ -432,6 +448,8 @@ func TestIssuesService_ReplaceLabelsForIssue_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ReplaceLabelsForIssue(ctx, "%", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ReplaceLabelsForIssue(ctx, "..", "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_RemoveLabelsForIssue(t *testing.T) {
@@ -466,6 +484,8 @@ func TestIssuesService_RemoveLabelsForIssue_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Issues.RemoveLabelsForIssue(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Issues.RemoveLabelsForIssue(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_ListLabelsForMilestone(t *testing.T) {
@@ -512,6 +532,8 @@ func TestIssuesService_ListLabelsForMilestone_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ListLabelsForMilestone(ctx, "%", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ListLabelsForMilestone(ctx, "..", "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestLabel_Marshal(t *testing.T) {
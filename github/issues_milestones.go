@@ -58,7 +58,7 @@ type MilestoneListOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/milestones
 func (s *IssuesService) ListMilestones(ctx context.Context, owner string, repo string, opts *MilestoneListOptions) ([]*Milestone, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/milestones", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/milestones", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -84,7 +84,7 @@ func (s *IssuesService) ListMilestones(ctx context.Context, owner string, repo s
 //
 //meta:operation GET /repos/{owner}/{repo}/milestones/{milestone_number}
 func (s *IssuesService) GetMilestone(ctx context.Context, owner string, repo string, number int) (*Milestone, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/milestones/%d", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/milestones/%d", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -105,7 +105,7 @@ func (s *IssuesService) GetMilestone(ctx context.Context, owner string, repo str
 //
 //meta:operation POST /repos/{owner}/{repo}/milestones
 func (s *IssuesService) CreateMilestone(ctx context.Context, owner string, repo string, milestone *Milestone) (*Milestone, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/milestones", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/milestones", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, milestone)
 	if err != nil {
 		return nil, nil, err
@@ -126,7 +126,7 @@ func (s *IssuesService) CreateMilestone(ctx context.Context, owner string, repo
 //
 //meta:operation PATCH /repos/{owner}/{repo}/milestones/{milestone_number}
 func (s *IssuesService) EditMilestone(ctx context.Context, owner string, repo string, number int, milestone *Milestone) (*Milestone, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/milestones/%d", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/milestones/%d", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("PATCH", u, milestone)
 	if err != nil {
 		return nil, nil, err
@@ -147,7 +147,7 @@ func (s *IssuesService) EditMilestone(ctx context.Context, owner string, repo st
 //
 //meta:operation DELETE /repos/{owner}/{repo}/milestones/{milestone_number}
 func (s *IssuesService) DeleteMilestone(ctx context.Context, owner string, repo string, number int) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/milestones/%d", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/milestones/%d", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -64,6 +64,8 @@ func TestIssuesService_ListMilestones_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ListMilestones(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ListMilestones(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_GetMilestone(t *testing.T) {
@@ -108,6 +110,8 @@ func TestIssuesService_GetMilestone_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.GetMilestone(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.GetMilestone(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_CreateMilestone(t *testing.T) {
@@ -161,6 +165,8 @@ func TestIssuesService_CreateMilestone_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.CreateMilestone(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.CreateMilestone(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_EditMilestone(t *testing.T) {
@@ -214,6 +220,8 @@ func TestIssuesService_EditMilestone_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.EditMilestone(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.EditMilestone(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_DeleteMilestone(t *testing.T) {
@@ -248,6 +256,8 @@ func TestIssuesService_DeleteMilestone_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Issues.DeleteMilestone(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, err = client.Issues.DeleteMilestone(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestMilestone_Marshal(t *testing.T) {
@@ -127,6 +127,8 @@ func TestIssuesService_ListByOrg_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ListByOrg(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ListByOrg(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_ListByOrg_badOrg(t *testing.T) {
@@ -136,6 +138,8 @@ func TestIssuesService_ListByOrg_badOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ListByOrg(ctx, "\n", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ListByOrg(ctx, "o/../../admin", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_ListByRepo(t *testing.T) {
@@ -190,6 +194,112 @@ func TestIssuesService_ListByRepo(t *testing.T) {
 	})
 }
 
+func TestIssuesService_ListByRepo_sentinels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"milestone": "none", "assignee": "*"})
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	opts := &IssueListByRepoOptions{Milestone: MilestoneNone, Assignee: AssigneeAny}
+	if _, _, err := client.Issues.ListByRepo(ctx, "o", "r", opts); err != nil {
+		t.Errorf("Issues.ListByRepo returned error: %v", err)
+	}
+
+	if u, _ := addOptions("u", &IssueListByRepoOptions{Milestone: MilestoneAny, Assignee: AssigneeNone}); u != "u?assignee=none&milestone=%2A" {
+		t.Errorf("addOptions = %q, want %q", u, "u?assignee=none&milestone=%2A")
+	}
+}
+
+func TestIssuesService_ListByRepoWithAnyLabel(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := values{"state": "all", "sort": "updated", "labels": r.FormValue("labels"), "per_page": "100"}
+		if page := r.FormValue("page"); page != "" {
+			want["page"] = page
+		}
+		testFormValues(t, r, want)
+
+		switch r.FormValue("labels") + " " + r.FormValue("page") {
+		case "team,bug ":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/issues?labels=team%2Cbug&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":5,"number":5,"updated_at":"2024-01-05T00:00:00Z"},{"id":3,"number":3,"updated_at":"2024-01-03T00:00:00Z"}]`)
+		case "team,bug 2":
+			fmt.Fprint(w, `[{"id":1,"number":1,"updated_at":"2024-01-01T00:00:00Z"}]`)
+		case "team,docs ":
+			fmt.Fprint(w, `[{"id":4,"number":4,"updated_at":"2024-01-04T00:00:00Z"},{"id":3,"number":3,"updated_at":"2024-01-03T00:00:00Z"},{"id":2,"number":2,"updated_at":"2024-01-02T00:00:00Z"}]`)
+		default:
+			t.Errorf("unexpected labels %q page %q", r.FormValue("labels"), r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	opts := &IssueListByRepoOptions{State: "all", Labels: []string{"team"}, Sort: "updated", ListOptions: ListOptions{Page: 7}}
+	issues, err := client.Issues.ListByRepoWithAnyLabel(ctx, "o", "r", []string{"bug", "docs"}, opts)
+	if err != nil {
+		t.Fatalf("Issues.ListByRepoWithAnyLabel returned error: %v", err)
+	}
+
+	var numbers []int
+	for _, issue := range issues {
+		numbers = append(numbers, issue.GetNumber())
+	}
+	if want := []int{5, 4, 3, 2, 1}; !cmp.Equal(numbers, want) {
+		t.Errorf("Issues.ListByRepoWithAnyLabel returned issues %v, want %v", numbers, want)
+	}
+	if want := []string{"team"}; !cmp.Equal(opts.Labels, want) {
+		t.Errorf("Issues.ListByRepoWithAnyLabel modified opts.Labels to %v, want %v", opts.Labels, want)
+	}
+
+	const methodName = "ListByRepoWithAnyLabel"
+	testBadOptions(t, methodName, func() error {
+		_, err := client.Issues.ListByRepoWithAnyLabel(ctx, "\n", "\n", []string{"bug"}, nil)
+		return err
+	})
+}
+
+func TestSortIssues(t *testing.T) {
+	issue := func(number, comments int, created string) *Issue {
+		ts, _ := time.Parse(time.RFC3339, created)
+		return &Issue{Number: Int(number), Comments: Int(comments), CreatedAt: &Timestamp{ts}}
+	}
+	issues := []*Issue{
+		issue(1, 5, "2024-01-01T00:00:00Z"),
+		issue(2, 1, "2024-01-03T00:00:00Z"),
+		issue(3, 5, "2024-01-02T00:00:00Z"),
+	}
+
+	tests := map[string]struct {
+		by, direction string
+		want          []int
+	}{
+		"default":       {want: []int{2, 3, 1}},
+		"created asc":   {by: "created", direction: "asc", want: []int{1, 3, 2}},
+		"comments desc": {by: "comments", want: []int{1, 3, 2}},
+		"comments asc":  {by: "comments", direction: "asc", want: []int{2, 1, 3}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sorted := append([]*Issue(nil), issues...)
+			sortIssues(sorted, tc.by, tc.direction)
+			var numbers []int
+			for _, issue := range sorted {
+				numbers = append(numbers, issue.GetNumber())
+			}
+			if !cmp.Equal(numbers, tc.want) {
+				t.Errorf("sortIssues(%q, %q) = %v, want %v", tc.by, tc.direction, numbers, tc.want)
+			}
+		})
+	}
+}
+
 func TestIssuesService_ListByRepo_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -197,6 +307,8 @@ func TestIssuesService_ListByRepo_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.ListByRepo(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.ListByRepo(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_Get(t *testing.T) {
@@ -250,6 +362,8 @@ func TestIssuesService_Get_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.Get(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.Get(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_Create(t *testing.T) {
@@ -308,6 +422,8 @@ func TestIssuesService_Create_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.Create(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.Create(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_Edit(t *testing.T) {
@@ -354,6 +470,37 @@ func TestIssuesService_Edit(t *testing.T) {
 	})
 }
 
+func TestIssuesService_Edit_nullableMilestone(t *testing.T) {
+	tests := map[string]struct {
+		input *IssueRequest
+		want  string
+	}{
+		"absent":            {input: &IssueRequest{Title: String("t")}, want: `{"title":"t"}`},
+		"pointer":           {input: &IssueRequest{Milestone: Int(1)}, want: `{"milestone":1}`},
+		"null":              {input: &IssueRequest{Title: String("t"), NullableMilestone: NewNullNullable[int]()}, want: `{"title":"t","milestone":null}`},
+		"value":             {input: &IssueRequest{NullableMilestone: NewNullable(2)}, want: `{"milestone":2}`},
+		"overrides pointer": {input: &IssueRequest{Milestone: Int(1), NullableMilestone: NewNullNullable[int]()}, want: `{"milestone":null}`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PATCH")
+				testBody(t, r, tc.want+"\n")
+				fmt.Fprint(w, `{"number":1}`)
+			})
+
+			ctx := context.Background()
+			if _, _, err := client.Issues.Edit(ctx, "o", "r", 1, tc.input); err != nil {
+				t.Errorf("Issues.Edit returned error: %v", err)
+			}
+		})
+	}
+}
+
 func TestIssuesService_RemoveMilestone(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -388,6 +535,148 @@ func TestIssuesService_RemoveMilestone(t *testing.T) {
 	})
 }
 
+func TestIssuesService_CloseIssue(t *testing.T) {
+	tests := map[string]struct {
+		reason   string
+		wantBody string
+	}{
+		"default": {
+			wantBody: `{"state":"closed"}` + "\n",
+		},
+		"completed": {
+			reason:   IssueStateReasonCompleted,
+			wantBody: `{"state":"closed","state_reason":"completed"}` + "\n",
+		},
+		"not planned": {
+			reason:   IssueStateReasonNotPlanned,
+			wantBody: `{"state":"closed","state_reason":"not_planned"}` + "\n",
+		},
+		"duplicate": {
+			reason:   IssueStateReasonDuplicate,
+			wantBody: `{"state":"closed","state_reason":"duplicate"}` + "\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PATCH")
+				testBody(t, r, test.wantBody)
+				fmt.Fprint(w, `{"number":1,"state":"closed","state_reason":"`+test.reason+`"}`)
+			})
+
+			ctx := context.Background()
+			issue, _, err := client.Issues.CloseIssue(ctx, "o", "r", 1, test.reason)
+			if err != nil {
+				t.Errorf("Issues.CloseIssue returned error: %v", err)
+			}
+
+			want := &Issue{Number: Int(1), State: String("closed"), StateReason: String(test.reason)}
+			if !cmp.Equal(issue, want) {
+				t.Errorf("Issues.CloseIssue returned %+v, want %+v", issue, want)
+			}
+
+			const methodName = "CloseIssue"
+			testBadOptions(t, methodName, func() (err error) {
+				_, _, err = client.Issues.CloseIssue(ctx, "\n", "\n", -1, test.reason)
+				return err
+			})
+
+			testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+				got, resp, err := client.Issues.CloseIssue(ctx, "o", "r", 1, test.reason)
+				if got != nil {
+					t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+				}
+				return resp, err
+			})
+		})
+	}
+}
+
+func TestIssuesService_CloseIssue_invalidReason(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, reason := range []string{IssueStateReasonReopened, "wontfix"} {
+		_, resp, err := client.Issues.CloseIssue(ctx, "o", "r", 1, reason)
+		if err == nil {
+			t.Errorf("Issues.CloseIssue(%q) returned nil error, want an error", reason)
+		}
+		if resp != nil {
+			t.Errorf("Issues.CloseIssue(%q) returned response %+v, want nil", reason, resp)
+		}
+	}
+}
+
+func TestIssuesService_ReopenIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"open","state_reason":"reopened"}`+"\n")
+		fmt.Fprint(w, `{"number":1,"state":"open","state_reason":"reopened"}`)
+	})
+
+	ctx := context.Background()
+	issue, _, err := client.Issues.ReopenIssue(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Issues.ReopenIssue returned error: %v", err)
+	}
+
+	want := &Issue{Number: Int(1), State: String("open"), StateReason: String(IssueStateReasonReopened)}
+	if !cmp.Equal(issue, want) {
+		t.Errorf("Issues.ReopenIssue returned %+v, want %+v", issue, want)
+	}
+
+	const methodName = "ReopenIssue"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.ReopenIssue(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.ReopenIssue(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_Edit_stateReason(t *testing.T) {
+	tests := map[string]struct {
+		input *IssueRequest
+		want  string
+	}{
+		"reason without state": {&IssueRequest{StateReason: String(IssueStateReasonNotPlanned)}, `{"state_reason":"not_planned"}`},
+		"unknown reason":       {&IssueRequest{State: String("closed"), StateReason: String("wontfix")}, `{"state":"closed","state_reason":"wontfix"}`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			// Edit sends the state reason as is, leaving its validation to GitHub.
+			mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PATCH")
+				testBody(t, r, test.want+"\n")
+				fmt.Fprint(w, `{"number":1}`)
+			})
+
+			ctx := context.Background()
+			if _, _, err := client.Issues.Edit(ctx, "o", "r", 1, test.input); err != nil {
+				t.Errorf("Issues.Edit returned error: %v", err)
+			}
+		})
+	}
+}
+
 func TestIssuesService_Edit_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -395,6 +684,8 @@ func TestIssuesService_Edit_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Issues.Edit(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Issues.Edit(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestIssuesService_Lock(t *testing.T) {
@@ -170,7 +170,7 @@ type Source struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/timeline
 func (s *IssuesService) ListIssueTimeline(ctx context.Context, owner, repo string, number int, opts *ListOptions) ([]*Timeline, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%v/timeline", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%v/timeline", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -37,7 +37,32 @@ func (l RepositoryLicense) String() string {
 	return Stringify(l)
 }
 
+// The SPDX IDs of commonly used licenses, as found in License.SPDXID.
+const (
+	LicenseAGPL30      = "AGPL-3.0"
+	LicenseApache20    = "Apache-2.0"
+	LicenseBSD2Clause  = "BSD-2-Clause"
+	LicenseBSD3Clause  = "BSD-3-Clause"
+	LicenseBSL10       = "BSL-1.0"
+	LicenseCC010       = "CC0-1.0"
+	LicenseEPL20       = "EPL-2.0"
+	LicenseGPL20       = "GPL-2.0"
+	LicenseGPL30       = "GPL-3.0"
+	LicenseLGPL21      = "LGPL-2.1"
+	LicenseLGPL30      = "LGPL-3.0"
+	LicenseMIT         = "MIT"
+	LicenseMPL20       = "MPL-2.0"
+	LicenseUnlicense   = "Unlicense"
+	LicenseNoAssertion = "NOASSERTION" // A license GitHub could not identify.
+)
+
+// LicenseKeyOther is the License.Key of licenses GitHub could not identify.
+const LicenseKeyOther = "other"
+
 // License represents an open source license.
+//
+// When GitHub detects a license file but cannot identify the license, Key is
+// LicenseKeyOther and SPDXID is LicenseNoAssertion or null.
 type License struct {
 	Key  *string `json:"key,omitempty"`
 	Name *string `json:"name,omitempty"`
@@ -58,6 +83,14 @@ func (l License) String() string {
 	return Stringify(l)
 }
 
+// IsIdentified reports whether GitHub identified the license, that is,
+// whether it has an SPDX ID other than LicenseNoAssertion. It returns false
+// for a nil license.
+func (l *License) IsIdentified() bool {
+	id := l.GetSPDXID()
+	return id != "" && id != LicenseNoAssertion && l.GetKey() != LicenseKeyOther
+}
+
 // List popular open source licenses.
 //
 // GitHub API docs: https://docs.github.com/rest/licenses/licenses#get-all-commonly-used-licenses
@@ -84,7 +117,7 @@ func (s *LicensesService) List(ctx context.Context) ([]*License, *Response, erro
 //
 //meta:operation GET /licenses/{license}
 func (s *LicensesService) Get(ctx context.Context, licenseName string) (*License, *Response, error) {
-	u := fmt.Sprintf("licenses/%s", licenseName)
+	u := fmt.Sprintf("licenses/%s", PathEscape(licenseName))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -179,6 +180,104 @@ func TestLicensesService_Get(t *testing.T) {
 	})
 }
 
+func TestLicensesService_Get_fullDetail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/licenses/mit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"key": "mit",
+			"name": "MIT License",
+			"spdx_id": "MIT",
+			"url": "https://api.github.com/licenses/mit",
+			"node_id": "MDc6TGljZW5zZW1pdA==",
+			"html_url": "http://choosealicense.com/licenses/mit/",
+			"description": "A short and simple permissive license.",
+			"implementation": "Create a text file (typically named LICENSE or LICENSE.txt) in the root of your source code and copy the text of the license into the file.",
+			"permissions": ["commercial-use", "modifications", "distribution", "private-use"],
+			"conditions": ["include-copyright"],
+			"limitations": ["liability", "warranty"],
+			"body": "MIT License\n\nCopyright (c) [year] [fullname]",
+			"featured": true
+		}`)
+	})
+
+	ctx := context.Background()
+	license, _, err := client.Licenses.Get(ctx, "mit")
+	if err != nil {
+		t.Fatalf("Licenses.Get returned error: %v", err)
+	}
+
+	want := &License{
+		Key:            String("mit"),
+		Name:           String("MIT License"),
+		SPDXID:         String(LicenseMIT),
+		URL:            String("https://api.github.com/licenses/mit"),
+		HTMLURL:        String("http://choosealicense.com/licenses/mit/"),
+		Description:    String("A short and simple permissive license."),
+		Implementation: String("Create a text file (typically named LICENSE or LICENSE.txt) in the root of your source code and copy the text of the license into the file."),
+		Permissions:    &[]string{"commercial-use", "modifications", "distribution", "private-use"},
+		Conditions:     &[]string{"include-copyright"},
+		Limitations:    &[]string{"liability", "warranty"},
+		Body:           String("MIT License\n\nCopyright (c) [year] [fullname]"),
+		Featured:       Bool(true),
+	}
+	if !cmp.Equal(license, want) {
+		t.Errorf("Licenses.Get returned %+v, want %+v", license, want)
+	}
+	if !license.IsIdentified() {
+		t.Error("License.IsIdentified returned false for MIT")
+	}
+}
+
+func TestLicense_IsIdentified(t *testing.T) {
+	tests := map[string]struct {
+		json string
+		want bool
+	}{
+		"identified":   {json: `{"key":"apache-2.0","spdx_id":"Apache-2.0"}`, want: true},
+		"noassertion":  {json: `{"key":"other","name":"Other","spdx_id":"NOASSERTION","url":null}`, want: false},
+		"null spdx id": {json: `{"key":"other","name":"Other","spdx_id":null}`, want: false},
+		"other key":    {json: `{"key":"other","spdx_id":"MIT"}`, want: false},
+		"empty":        {json: `{}`, want: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var l *License
+			if err := json.Unmarshal([]byte(tc.json), &l); err != nil {
+				t.Fatalf("json.Unmarshal returned error: %v", err)
+			}
+			if got := l.IsIdentified(); got != tc.want {
+				t.Errorf("IsIdentified = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	var nilLicense *License
+	if nilLicense.IsIdentified() {
+		t.Error("IsIdentified returned true for a nil license")
+	}
+}
+
+func TestRepositoryLicense_noAssertion(t *testing.T) {
+	data := `{"name":"LICENSE","path":"LICENSE","license":{"key":"other","name":"Other","spdx_id":"NOASSERTION","url":null,"node_id":"MDc6TGljZW5zZTA="}}`
+
+	got := new(RepositoryLicense)
+	if err := json.Unmarshal([]byte(data), got); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	want := &License{Key: String(LicenseKeyOther), Name: String("Other"), SPDXID: String(LicenseNoAssertion)}
+	if !cmp.Equal(got.License, want) {
+		t.Errorf("RepositoryLicense.License = %+v, want %+v", got.License, want)
+	}
+	if got.License.IsIdentified() {
+		t.Error("License.IsIdentified returned true for NOASSERTION")
+	}
+}
+
 func TestLicensesService_Get_invalidTemplate(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -186,4 +285,6 @@ func TestLicensesService_Get_invalidTemplate(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Licenses.Get(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Licenses.Get(ctx, "..")
+	testPathForbiddenError(t, err)
 }
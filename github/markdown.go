@@ -8,6 +8,8 @@ package github
 import (
 	"bytes"
 	"context"
+	"io"
+	"strings"
 )
 
 // MarkdownService provides access to markdown-related functions in the GitHub API.
@@ -38,7 +40,7 @@ type markdownRenderRequest struct {
 	Context *string `json:"context,omitempty"`
 }
 
-// Render renders an arbitrary Render document.
+// Render renders an arbitrary Render document, returning the rendered HTML.
 //
 // GitHub API docs: https://docs.github.com/rest/markdown/markdown#render-a-markdown-document
 //
@@ -67,3 +69,32 @@ func (s *MarkdownService) Render(ctx context.Context, text string, opts *Markdow
 
 	return buf.String(), resp, nil
 }
+
+// RenderRaw renders a Markdown document sent as plain text, returning the
+// rendered HTML. It is rendered like README files are, as in the "markdown"
+// mode of Render; there is no repository context.
+//
+// GitHub API docs: https://docs.github.com/rest/markdown/markdown#render-a-markdown-document-in-raw-mode
+//
+//meta:operation POST /markdown/raw
+func (s *MarkdownService) RenderRaw(ctx context.Context, text string) (string, *Response, error) {
+	req, err := s.client.NewRequest("POST", "markdown/raw", nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	req.Body = io.NopCloser(strings.NewReader(text))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(text)), nil
+	}
+	req.ContentLength = int64(len(text))
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+
+	buf := new(bytes.Buffer)
+	resp, err := s.client.Do(ctx, req, buf)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return buf.String(), resp, nil
+}
@@ -61,6 +61,63 @@ func TestMarkdownService_Markdown(t *testing.T) {
 	})
 }
 
+func TestMarkdownService_Render_unicode(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const text = "# Grüße 🎉\n\nSee #1 ✓"
+	mux.HandleFunc("/markdown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"text":"# Grüße 🎉\n\nSee #1 ✓","mode":"gfm","context":"o/r"}`+"\n")
+		w.Header().Set("Content-Type", "text/html;charset=utf-8")
+		fmt.Fprint(w, `<h1>Grüße 🎉</h1><p>See <a href="https://github.com/o/r/issues/1">#1</a> ✓</p>`)
+	})
+
+	ctx := context.Background()
+	md, _, err := client.Markdown.Render(ctx, text, &MarkdownOptions{Mode: "gfm", Context: "o/r"})
+	if err != nil {
+		t.Errorf("Render returned error: %v", err)
+	}
+
+	if want := `<h1>Grüße 🎉</h1><p>See <a href="https://github.com/o/r/issues/1">#1</a> ✓</p>`; want != md {
+		t.Errorf("Render returned %+v, want %+v", md, want)
+	}
+}
+
+func TestMarkdownService_RenderRaw(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const text = "# Grüße 🎉\n\n*日本語*"
+	mux.HandleFunc("/markdown/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "text/plain; charset=utf-8")
+		testBody(t, r, text)
+		w.Header().Set("Content-Type", "text/html;charset=utf-8")
+		fmt.Fprint(w, "<h1>Grüße 🎉</h1>\n<p><em>日本語</em></p>\n")
+	})
+
+	ctx := context.Background()
+	md, _, err := client.Markdown.RenderRaw(ctx, text)
+	if err != nil {
+		t.Errorf("RenderRaw returned error: %v", err)
+	}
+
+	if want := "<h1>Grüße 🎉</h1>\n<p><em>日本語</em></p>\n"; want != md {
+		t.Errorf("RenderRaw returned %+v, want %+v", md, want)
+	}
+
+	const methodName = "RenderRaw"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Markdown.RenderRaw(ctx, text)
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestMarkdownRenderRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &markdownRenderRequest{}, "{}")
 
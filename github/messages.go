@@ -9,6 +9,7 @@
 package github
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -53,6 +54,8 @@ var (
 		"commit_comment":                 &CommitCommentEvent{},
 		"content_reference":              &ContentReferenceEvent{},
 		"create":                         &CreateEvent{},
+		"custom_property":                &CustomPropertyEvent{},
+		"custom_property_values":         &CustomPropertyValuesEvent{},
 		"delete":                         &DeleteEvent{},
 		"dependabot_alert":               &DependabotAlertEvent{},
 		"deploy_key":                     &DeployKeyEvent{},
@@ -68,6 +71,7 @@ var (
 		"installation_repositories":      &InstallationRepositoriesEvent{},
 		"installation_target":            &InstallationTargetEvent{},
 		"issue_comment":                  &IssueCommentEvent{},
+		"issue_dependencies":             &IssueDependenciesEvent{},
 		"issues":                         &IssuesEvent{},
 		"label":                          &LabelEvent{},
 		"marketplace_purchase":           &MarketplacePurchaseEvent{},
@@ -87,6 +91,7 @@ var (
 		"project_column":                 &ProjectColumnEvent{},
 		"projects_v2":                    &ProjectV2Event{},
 		"projects_v2_item":               &ProjectV2ItemEvent{},
+		"projects_v2_status_update":      &ProjectV2StatusUpdateEvent{},
 		"public":                         &PublicEvent{},
 		"pull_request":                   &PullRequestEvent{},
 		"pull_request_review":            &PullRequestReviewEvent{},
@@ -94,16 +99,19 @@ var (
 		"pull_request_review_thread":     &PullRequestReviewThreadEvent{},
 		"pull_request_target":            &PullRequestTargetEvent{},
 		"push":                           &PushEvent{},
+		"registry_package":               &RegistryPackageEvent{},
 		"repository":                     &RepositoryEvent{},
 		"repository_dispatch":            &RepositoryDispatchEvent{},
 		"repository_import":              &RepositoryImportEvent{},
 		"repository_vulnerability_alert": &RepositoryVulnerabilityAlertEvent{},
 		"release":                        &ReleaseEvent{},
 		"secret_scanning_alert":          &SecretScanningAlertEvent{},
+		"secret_scanning_alert_location": &SecretScanningAlertLocationEvent{},
 		"security_advisory":              &SecurityAdvisoryEvent{},
 		"security_and_analysis":          &SecurityAndAnalysisEvent{},
 		"star":                           &StarEvent{},
 		"status":                         &StatusEvent{},
+		"sub_issues":                     &SubIssuesEvent{},
 		"team":                           &TeamEvent{},
 		"team_add":                       &TeamAddEvent{},
 		"user":                           &UserEvent{},
@@ -262,6 +270,189 @@ func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err e
 	return ValidatePayloadFromBody(contentType, r.Body, signature, secretToken)
 }
 
+// ValidatePayloadOptions specifies optional behavior of ValidatePayloadWithTokens
+// and NewValidatingReader.
+type ValidatePayloadOptions struct {
+	// AllowSHA1 permits the legacy HMAC-SHA1 signature delivered in the
+	// X-Hub-Signature header to be used when no X-Hub-Signature-256 header
+	// is present. SHA-1 signatures are rejected unless this is set.
+	AllowSHA1 bool
+}
+
+// ValidatePayloadWithTokens validates an incoming GitHub Webhook event request
+// against each of the given secret tokens and returns the (JSON) payload.
+// The payload is accepted if its signature matches any of the tokens, which
+// allows a webhook secret to be rotated without rejecting deliveries signed
+// with the previous secret.
+//
+// Unlike ValidatePayload, a signature is always required and at least one
+// secret token must be provided. The Content-Type header of the payload can be
+// "application/json" or "application/x-www-form-urlencoded".
+//
+// Example usage:
+//
+//	func (s *GitHubEventMonitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	  payload, err := github.ValidatePayloadWithTokens(r, [][]byte{s.newSecret, s.oldSecret}, nil)
+//	  if err != nil { ... }
+//	  // Process payload...
+//	}
+func ValidatePayloadWithTokens(r *http.Request, secretTokens [][]byte, opts *ValidatePayloadOptions) (payload []byte, err error) {
+	if len(secretTokens) == 0 {
+		return nil, errors.New("no secret tokens provided")
+	}
+
+	signature, err := requestSignature(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSignatureWithTokens(signature, body, secretTokens); err != nil {
+		return nil, err
+	}
+
+	// The signature has already been checked against the raw body,
+	// so only the JSON payload needs to be extracted from it.
+	return ValidatePayloadFromBody(contentType, bytes.NewReader(body), "", nil)
+}
+
+// ValidatingReader reads the body of a webhook request while computing its
+// HMAC signature for each secret token. It allows a JSON payload to be decoded
+// straight from the request body without buffering it first.
+//
+// The payload must not be trusted until Validate returns nil.
+type ValidatingReader struct {
+	body       io.Reader
+	macs       []hash.Hash
+	messageMAC []byte
+}
+
+// NewValidatingReader returns a ValidatingReader for the body of the webhook
+// request r. Only "application/json" payloads are supported, since
+// form-encoded payloads must be read in full before they can be decoded.
+//
+// Example usage:
+//
+//	func (s *GitHubEventMonitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	  vr, err := github.NewValidatingReader(r, [][]byte{s.newSecret, s.oldSecret}, nil)
+//	  if err != nil { ... }
+//	  var event github.PushEvent
+//	  if err := json.NewDecoder(vr).Decode(&event); err != nil { ... }
+//	  if err := vr.Validate(); err != nil { ... }
+//	  // Process event...
+//	}
+func NewValidatingReader(r *http.Request, secretTokens [][]byte, opts *ValidatePayloadOptions) (*ValidatingReader, error) {
+	if len(secretTokens) == 0 {
+		return nil, errors.New("no secret tokens provided")
+	}
+
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "application/json" {
+		return nil, fmt.Errorf("webhook request has unsupported Content-Type %q for streaming validation", contentType)
+	}
+
+	signature, err := requestSignature(r, opts)
+	if err != nil {
+		return nil, err
+	}
+	mac, hashFunc, err := messageMAC(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	macs := make([]hash.Hash, len(secretTokens))
+	writers := make([]io.Writer, len(secretTokens))
+	for i, token := range secretTokens {
+		macs[i] = hmac.New(hashFunc, token)
+		writers[i] = macs[i]
+	}
+
+	return &ValidatingReader{
+		body:       io.TeeReader(r.Body, io.MultiWriter(writers...)),
+		macs:       macs,
+		messageMAC: mac,
+	}, nil
+}
+
+// Read reads from the underlying request body.
+func (v *ValidatingReader) Read(p []byte) (int, error) {
+	return v.body.Read(p)
+}
+
+// Validate consumes any unread part of the request body and reports whether
+// the payload signature matches one of the secret tokens.
+func (v *ValidatingReader) Validate() error {
+	if _, err := io.Copy(io.Discard, v.body); err != nil {
+		return err
+	}
+
+	valid := false
+	for _, mac := range v.macs {
+		// Check every token so that timing does not depend on which one matched.
+		if hmac.Equal(v.messageMAC, mac.Sum(nil)) {
+			valid = true
+		}
+	}
+	if !valid {
+		return errors.New("payload signature check failed")
+	}
+	return nil
+}
+
+// requestSignature returns the signature of the webhook request r, preferring
+// the SHA-256 signature and only falling back to SHA-1 when opts allows it.
+func requestSignature(r *http.Request, opts *ValidatePayloadOptions) (string, error) {
+	allowSHA1 := opts != nil && opts.AllowSHA1
+
+	signature := r.Header.Get(SHA256SignatureHeader)
+	if signature == "" && allowSHA1 {
+		signature = r.Header.Get(SHA1SignatureHeader)
+	}
+	if signature == "" {
+		if r.Header.Get(SHA1SignatureHeader) != "" {
+			return "", errors.New("SHA-1 signatures are not accepted unless AllowSHA1 is set")
+		}
+		return "", errors.New("missing signature")
+	}
+	if !allowSHA1 && strings.HasPrefix(signature, sha1Prefix+"=") {
+		return "", errors.New("SHA-1 signatures are not accepted unless AllowSHA1 is set")
+	}
+	return signature, nil
+}
+
+// validateSignatureWithTokens validates the signature for the given payload
+// against each of the secret tokens.
+func validateSignatureWithTokens(signature string, payload []byte, secretTokens [][]byte) error {
+	messageMAC, hashFunc, err := messageMAC(signature)
+	if err != nil {
+		return err
+	}
+
+	valid := false
+	for _, token := range secretTokens {
+		// Check every token so that timing does not depend on which one matched.
+		if checkMAC(payload, messageMAC, token, hashFunc) {
+			valid = true
+		}
+	}
+	if !valid {
+		return errors.New("payload signature check failed")
+	}
+	return nil
+}
+
 // ValidateSignature validates the signature for the given payload.
 // signature is the GitHub hash signature delivered in the X-Hub-Signature header.
 // payload is the JSON payload sent by GitHub Webhooks.
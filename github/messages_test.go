@@ -7,9 +7,13 @@ package github
 
 import (
 	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
 	"net/http"
 	"net/url"
 	"strings"
@@ -98,6 +102,195 @@ func TestValidatePayload(t *testing.T) {
 	}
 }
 
+func TestValidatePayloadWithTokens(t *testing.T) {
+	const body = `{"yo":true}`
+	oldSecret := []byte("old-secret")
+	newSecret := []byte("new-secret")
+	sign := func(prefix string, hashFunc func() hash.Hash, secret []byte) string {
+		return prefix + "=" + hex.EncodeToString(genMAC([]byte(body), secret, hashFunc))
+	}
+
+	tests := []struct {
+		name        string
+		header      string
+		signature   string
+		tokens      [][]byte
+		opts        *ValidatePayloadOptions
+		wantErr     bool
+		contentType string
+	}{
+		{
+			name:      "sha256 with current secret",
+			header:    SHA256SignatureHeader,
+			signature: sign(sha256Prefix, sha256.New, newSecret),
+			tokens:    [][]byte{newSecret, oldSecret},
+		},
+		{
+			name:      "sha256 with previous secret during rotation",
+			header:    SHA256SignatureHeader,
+			signature: sign(sha256Prefix, sha256.New, oldSecret),
+			tokens:    [][]byte{newSecret, oldSecret},
+		},
+		{
+			name:      "sha256 with wrong secret",
+			header:    SHA256SignatureHeader,
+			signature: sign(sha256Prefix, sha256.New, []byte("wrong")),
+			tokens:    [][]byte{newSecret, oldSecret},
+			wantErr:   true,
+		},
+		{
+			name:      "sha256 after previous secret is retired",
+			header:    SHA256SignatureHeader,
+			signature: sign(sha256Prefix, sha256.New, oldSecret),
+			tokens:    [][]byte{newSecret},
+			wantErr:   true,
+		},
+		{
+			name:      "sha1 rejected by default",
+			header:    SHA1SignatureHeader,
+			signature: sign(sha1Prefix, sha1.New, newSecret),
+			tokens:    [][]byte{newSecret},
+			wantErr:   true,
+		},
+		{
+			name:      "sha1 prefix in sha256 header rejected by default",
+			header:    SHA256SignatureHeader,
+			signature: sign(sha1Prefix, sha1.New, newSecret),
+			tokens:    [][]byte{newSecret},
+			wantErr:   true,
+		},
+		{
+			name:      "sha1 accepted when enabled",
+			header:    SHA1SignatureHeader,
+			signature: sign(sha1Prefix, sha1.New, oldSecret),
+			tokens:    [][]byte{newSecret, oldSecret},
+			opts:      &ValidatePayloadOptions{AllowSHA1: true},
+		},
+		{
+			name:    "missing signature",
+			tokens:  [][]byte{newSecret},
+			wantErr: true,
+		},
+		{
+			name:      "no tokens",
+			header:    SHA256SignatureHeader,
+			signature: sign(sha256Prefix, sha256.New, newSecret),
+			wantErr:   true,
+		},
+		{
+			name:        "form encoded payload",
+			header:      SHA256SignatureHeader,
+			tokens:      [][]byte{newSecret},
+			contentType: "application/x-www-form-urlencoded",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reqBody := body
+			contentType := "application/json"
+			signature := test.signature
+			if test.contentType != "" {
+				contentType = test.contentType
+				reqBody = url.Values{"payload": {body}}.Encode()
+				signature = sha256Prefix + "=" + hex.EncodeToString(genMAC([]byte(reqBody), newSecret, sha256.New))
+			}
+
+			req, err := http.NewRequest("POST", "http://localhost/event", strings.NewReader(reqBody))
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			req.Header.Set("Content-Type", contentType)
+			if test.header != "" {
+				req.Header.Set(test.header, signature)
+			}
+
+			got, err := ValidatePayloadWithTokens(req, test.tokens, test.opts)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("ValidatePayloadWithTokens returned nil error, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidatePayloadWithTokens returned error: %v", err)
+			}
+			if string(got) != body {
+				t.Errorf("ValidatePayloadWithTokens = %q, want %q", got, body)
+			}
+		})
+	}
+}
+
+func TestValidatingReader(t *testing.T) {
+	const body = `{"ref":"refs/heads/main","size":2}`
+	oldSecret := []byte("old-secret")
+	newSecret := []byte("new-secret")
+
+	newReq := func(header, signature string) *http.Request {
+		req, err := http.NewRequest("POST", "http://localhost/event", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(header, signature)
+		return req
+	}
+
+	t.Run("rotation", func(t *testing.T) {
+		signature := "sha256=" + hex.EncodeToString(genMAC([]byte(body), oldSecret, sha256.New))
+		vr, err := NewValidatingReader(newReq(SHA256SignatureHeader, signature), [][]byte{newSecret, oldSecret}, nil)
+		if err != nil {
+			t.Fatalf("NewValidatingReader returned error: %v", err)
+		}
+
+		var event PushEvent
+		if err := json.NewDecoder(vr).Decode(&event); err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+		if err := vr.Validate(); err != nil {
+			t.Errorf("Validate returned error: %v", err)
+		}
+		if got, want := event.GetRef(), "refs/heads/main"; got != want {
+			t.Errorf("event.Ref = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		signature := "sha256=" + hex.EncodeToString(genMAC([]byte(body), []byte("wrong"), sha256.New))
+		vr, err := NewValidatingReader(newReq(SHA256SignatureHeader, signature), [][]byte{newSecret, oldSecret}, nil)
+		if err != nil {
+			t.Fatalf("NewValidatingReader returned error: %v", err)
+		}
+		if err := vr.Validate(); err == nil {
+			t.Error("Validate returned nil error, want error")
+		}
+	})
+
+	t.Run("sha1", func(t *testing.T) {
+		signature := "sha1=" + hex.EncodeToString(genMAC([]byte(body), newSecret, sha1.New))
+		if _, err := NewValidatingReader(newReq(SHA1SignatureHeader, signature), [][]byte{newSecret}, nil); err == nil {
+			t.Error("NewValidatingReader returned nil error for sha1 signature, want error")
+		}
+
+		vr, err := NewValidatingReader(newReq(SHA1SignatureHeader, signature), [][]byte{newSecret}, &ValidatePayloadOptions{AllowSHA1: true})
+		if err != nil {
+			t.Fatalf("NewValidatingReader returned error: %v", err)
+		}
+		if err := vr.Validate(); err != nil {
+			t.Errorf("Validate returned error: %v", err)
+		}
+	})
+
+	t.Run("form encoded", func(t *testing.T) {
+		req := newReq(SHA256SignatureHeader, "sha256=00")
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if _, err := NewValidatingReader(req, [][]byte{newSecret}, nil); err == nil {
+			t.Error("NewValidatingReader returned nil error for form encoded payload, want error")
+		}
+	})
+}
+
 func TestValidatePayload_FormGet(t *testing.T) {
 	payload := `{"yo":true}`
 	signature := "sha1=3374ef144403e8035423b23b02e2c9d7a4c50368"
@@ -280,6 +473,14 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &CreateEvent{},
 			messageType: "create",
 		},
+		{
+			payload:     &CustomPropertyEvent{},
+			messageType: "custom_property",
+		},
+		{
+			payload:     &CustomPropertyValuesEvent{},
+			messageType: "custom_property_values",
+		},
 		{
 			payload:     &DeleteEvent{},
 			messageType: "delete",
@@ -340,6 +541,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &IssueCommentEvent{},
 			messageType: "issue_comment",
 		},
+		{
+			payload:     &IssueDependenciesEvent{},
+			messageType: "issue_dependencies",
+		},
 		{
 			payload:     &IssuesEvent{},
 			messageType: "issues",
@@ -416,6 +621,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &ProjectV2ItemEvent{},
 			messageType: "projects_v2_item",
 		},
+		{
+			payload:     &ProjectV2StatusUpdateEvent{},
+			messageType: "projects_v2_status_update",
+		},
 		{
 			payload:     &PublicEvent{},
 			messageType: "public",
@@ -444,6 +653,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &PushEvent{},
 			messageType: "push",
 		},
+		{
+			payload:     &RegistryPackageEvent{},
+			messageType: "registry_package",
+		},
 		{
 			payload:     &ReleaseEvent{},
 			messageType: "release",
@@ -460,6 +673,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &SecretScanningAlertEvent{},
 			messageType: "secret_scanning_alert",
 		},
+		{
+			payload:     &SecretScanningAlertLocationEvent{},
+			messageType: "secret_scanning_alert_location",
+		},
 		{
 			payload:     &SecurityAdvisoryEvent{},
 			messageType: "security_advisory",
@@ -476,6 +693,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &StatusEvent{},
 			messageType: "status",
 		},
+		{
+			payload:     &SubIssuesEvent{},
+			messageType: "sub_issues",
+		},
 		{
 			payload:     &TeamEvent{},
 			messageType: "team",
@@ -529,6 +750,203 @@ func TestParseWebHook(t *testing.T) {
 	}
 }
 
+func TestParseWebHook_fixtures(t *testing.T) {
+	tests := []struct {
+		messageType string
+		payload     string
+		check       func(t *testing.T, event interface{})
+	}{
+		{
+			messageType: "merge_group",
+			payload: `{
+				"action": "checks_requested",
+				"merge_group": {
+					"head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+					"head_ref": "refs/heads/gh-readonly-queue/main/pr-104-abcdef",
+					"base_sha": "380387f6b0ed4e2da4d4fa2c6e5e13bc0e4aee8e",
+					"base_ref": "refs/heads/main",
+					"head_commit": {"message": "Merge pull request #104"}
+				},
+				"repository": {"full_name": "octo-org/octo-repo"}
+			}`,
+			check: func(t *testing.T, event interface{}) {
+				e := event.(*MergeGroupEvent)
+				if got, want := e.GetMergeGroup().GetHeadSHA(), "ec26c3e57ca3a959ca5aad62de7213c562f8c821"; got != want {
+					t.Errorf("merge_group.head_sha = %q, want %q", got, want)
+				}
+				if got, want := e.GetMergeGroup().GetHeadCommit().GetMessage(), "Merge pull request #104"; got != want {
+					t.Errorf("merge_group.head_commit.message = %q, want %q", got, want)
+				}
+			},
+		},
+		{
+			messageType: "deployment_protection_rule",
+			payload: `{
+				"action": "requested",
+				"environment": "production",
+				"event": "push",
+				"deployment_callback_url": "https://api.github.com/repos/o/r/actions/runs/1/deployment_protection_rule",
+				"deployment": {"id": 42, "ref": "main"}
+			}`,
+			check: func(t *testing.T, event interface{}) {
+				e := event.(*DeploymentProtectionRuleEvent)
+				if got, want := e.GetAction(), "requested"; got != want {
+					t.Errorf("action = %q, want %q", got, want)
+				}
+				if got, want := e.GetDeployment().GetID(), int64(42); got != want {
+					t.Errorf("deployment.id = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			messageType: "secret_scanning_alert_location",
+			payload: `{
+				"action": "created",
+				"alert": {"number": 7, "secret_type": "mailchimp_api_key"},
+				"location": {
+					"type": "commit",
+					"details": {"path": "keys.txt", "start_line": 3, "blob_sha": "af5626b4a114abcb82d63db7c8082c3c4756e51b"}
+				}
+			}`,
+			check: func(t *testing.T, event interface{}) {
+				e := event.(*SecretScanningAlertLocationEvent)
+				if got, want := e.GetAlert().GetNumber(), 7; got != want {
+					t.Errorf("alert.number = %v, want %v", got, want)
+				}
+				if got, want := e.GetLocation().GetDetails().GetPath(), "keys.txt"; got != want {
+					t.Errorf("location.details.path = %q, want %q", got, want)
+				}
+			},
+		},
+		{
+			messageType: "sub_issues",
+			payload: `{
+				"action": "sub_issue_added",
+				"parent_issue_id": 100,
+				"parent_issue": {"id": 100, "number": 1, "title": "Parent"},
+				"sub_issue_id": 200,
+				"sub_issue": {"id": 200, "number": 2, "title": "Child"},
+				"sub_issue_repo": {"full_name": "octo-org/octo-repo"}
+			}`,
+			check: func(t *testing.T, event interface{}) {
+				e := event.(*SubIssuesEvent)
+				if got, want := e.GetParentIssue().GetNumber(), 1; got != want {
+					t.Errorf("parent_issue.number = %v, want %v", got, want)
+				}
+				if got, want := e.GetSubIssueRepo().GetFullName(), "octo-org/octo-repo"; got != want {
+					t.Errorf("sub_issue_repo.full_name = %q, want %q", got, want)
+				}
+			},
+		},
+		{
+			messageType: "issue_dependencies",
+			payload: `{
+				"action": "blocked_by_added",
+				"blocked_issue": {"number": 3},
+				"blocking_issue": {"number": 4},
+				"blocking_issue_repo": {"name": "other-repo"}
+			}`,
+			check: func(t *testing.T, event interface{}) {
+				e := event.(*IssueDependenciesEvent)
+				if got, want := e.GetBlockedIssue().GetNumber(), 3; got != want {
+					t.Errorf("blocked_issue.number = %v, want %v", got, want)
+				}
+				if got, want := e.GetBlockingIssueRepo().GetName(), "other-repo"; got != want {
+					t.Errorf("blocking_issue_repo.name = %q, want %q", got, want)
+				}
+			},
+		},
+		{
+			messageType: "custom_property",
+			payload: `{
+				"action": "created",
+				"definition": {"property_name": "team", "value_type": "single_select", "allowed_values": ["a", "b"]},
+				"organization": {"login": "octo-org"}
+			}`,
+			check: func(t *testing.T, event interface{}) {
+				e := event.(*CustomPropertyEvent)
+				if got, want := e.GetDefinition().GetPropertyName(), "team"; got != want {
+					t.Errorf("definition.property_name = %q, want %q", got, want)
+				}
+				if got, want := e.GetDefinition().ValueType, "single_select"; got != want {
+					t.Errorf("definition.value_type = %q, want %q", got, want)
+				}
+			},
+		},
+		{
+			messageType: "custom_property_values",
+			payload: `{
+				"action": "updated",
+				"new_property_values": [{"property_name": "team", "value": "b"}],
+				"old_property_values": [{"property_name": "team", "value": "a"}],
+				"repository": {"name": "octo-repo"}
+			}`,
+			check: func(t *testing.T, event interface{}) {
+				e := event.(*CustomPropertyValuesEvent)
+				if len(e.NewPropertyValues) != 1 || e.NewPropertyValues[0].GetValue() != "b" {
+					t.Errorf("new_property_values = %+v, want value b", e.NewPropertyValues)
+				}
+				if len(e.OldPropertyValues) != 1 || e.OldPropertyValues[0].PropertyName != "team" {
+					t.Errorf("old_property_values = %+v, want property team", e.OldPropertyValues)
+				}
+			},
+		},
+		{
+			messageType: "registry_package",
+			payload: `{
+				"action": "published",
+				"registry_package": {
+					"name": "hello-world",
+					"package_type": "container",
+					"package_version": {"version": "1.0.0", "name": "sha256:abc"}
+				}
+			}`,
+			check: func(t *testing.T, event interface{}) {
+				e := event.(*RegistryPackageEvent)
+				if got, want := e.GetRegistryPackage().GetPackageType(), "container"; got != want {
+					t.Errorf("registry_package.package_type = %q, want %q", got, want)
+				}
+				if got, want := e.GetRegistryPackage().GetPackageVersion().GetVersion(), "1.0.0"; got != want {
+					t.Errorf("registry_package.package_version.version = %q, want %q", got, want)
+				}
+			},
+		},
+		{
+			messageType: "projects_v2_status_update",
+			payload: `{
+				"action": "edited",
+				"changes": {"status": {"from": "ON_TRACK", "to": "AT_RISK"}},
+				"projects_v2_status_update": {
+					"id": 5,
+					"project_node_id": "PVT_kwDOA",
+					"status": "AT_RISK",
+					"target_date": "2024-12-31",
+					"creator": {"login": "octocat"}
+				}
+			}`,
+			check: func(t *testing.T, event interface{}) {
+				e := event.(*ProjectV2StatusUpdateEvent)
+				if got, want := e.GetProjectV2StatusUpdate().GetStatus(), "AT_RISK"; got != want {
+					t.Errorf("projects_v2_status_update.status = %q, want %q", got, want)
+				}
+				if got, want := e.GetChanges().GetStatus().GetFrom(), "ON_TRACK"; got != want {
+					t.Errorf("changes.status.from = %q, want %q", got, want)
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.messageType, func(t *testing.T) {
+			event, err := ParseWebHook(test.messageType, []byte(test.payload))
+			if err != nil {
+				t.Fatalf("ParseWebHook(%q) returned error: %v", test.messageType, err)
+			}
+			test.check(t, event)
+		})
+	}
+}
+
 func TestAllMessageTypesMapped(t *testing.T) {
 	for _, mt := range MessageTypes() {
 		if obj := EventForType(mt); obj == nil {
@@ -9,7 +9,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/netip"
 	"net/url"
+	"strings"
 )
 
 // MetaService provides access to functions in the GitHub API that GitHub categorizes as "meta".
@@ -75,6 +77,127 @@ type APIMeta struct {
 	Domains map[string][]string `json:"domains,omitempty"`
 }
 
+// Categories of the IP address ranges listed in APIMeta, named after their
+// JSON fields.
+const (
+	MetaIPRangesHooks                    = "hooks"
+	MetaIPRangesWeb                      = "web"
+	MetaIPRangesAPI                      = "api"
+	MetaIPRangesGit                      = "git"
+	MetaIPRangesPackages                 = "packages"
+	MetaIPRangesPages                    = "pages"
+	MetaIPRangesImporter                 = "importer"
+	MetaIPRangesGithubEnterpriseImporter = "github_enterprise_importer"
+	MetaIPRangesActions                  = "actions"
+	MetaIPRangesDependabot               = "dependabot"
+)
+
+// metaIPRangeCategories lists every category of IP address ranges in APIMeta.
+var metaIPRangeCategories = []string{
+	MetaIPRangesHooks,
+	MetaIPRangesWeb,
+	MetaIPRangesAPI,
+	MetaIPRangesGit,
+	MetaIPRangesPackages,
+	MetaIPRangesPages,
+	MetaIPRangesImporter,
+	MetaIPRangesGithubEnterpriseImporter,
+	MetaIPRangesActions,
+	MetaIPRangesDependabot,
+}
+
+// rawIPRanges returns the IP address ranges of category, as returned by the API.
+func (m *APIMeta) rawIPRanges(category string) ([]string, error) {
+	switch category {
+	case MetaIPRangesHooks:
+		return m.Hooks, nil
+	case MetaIPRangesWeb:
+		return m.Web, nil
+	case MetaIPRangesAPI:
+		return m.API, nil
+	case MetaIPRangesGit:
+		return m.Git, nil
+	case MetaIPRangesPackages:
+		return m.Packages, nil
+	case MetaIPRangesPages:
+		return m.Pages, nil
+	case MetaIPRangesImporter:
+		return m.Importer, nil
+	case MetaIPRangesGithubEnterpriseImporter:
+		return m.GithubEnterpriseImporter, nil
+	case MetaIPRangesActions:
+		return m.Actions, nil
+	case MetaIPRangesDependabot:
+		return m.Dependabot, nil
+	default:
+		return nil, fmt.Errorf("unknown IP range category %q", category)
+	}
+}
+
+// IPRanges parses the IP address ranges of category, one of the MetaIPRanges*
+// constants. Both IPv4 and IPv6 ranges are returned. Plain addresses, which
+// some categories list, are returned as single address prefixes.
+func (m *APIMeta) IPRanges(category string) ([]netip.Prefix, error) {
+	raw, err := m.rawIPRanges(category)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(raw))
+	for _, r := range raw {
+		p, err := parseIPRange(r)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", category, err)
+		}
+		prefixes = append(prefixes, p)
+	}
+	return prefixes, nil
+}
+
+func parseIPRange(r string) (netip.Prefix, error) {
+	if strings.Contains(r, "/") {
+		p, err := netip.ParsePrefix(r)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return p.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(r)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// IsGitHubIP reports whether addr is in the IP address ranges of any of
+// categories, which are MetaIPRanges* constants. If no categories are given,
+// the ranges of every category are checked. Ranges that can't be parsed, and
+// unknown categories, are ignored.
+func (m *APIMeta) IsGitHubIP(addr netip.Addr, categories ...string) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	addr = addr.Unmap()
+
+	if len(categories) == 0 {
+		categories = metaIPRangeCategories
+	}
+	for _, category := range categories {
+		raw, err := m.rawIPRanges(category)
+		if err != nil {
+			continue
+		}
+		for _, r := range raw {
+			p, err := parseIPRange(r)
+			if err == nil && p.Contains(addr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Get returns information about GitHub.com, the service. Or, if you access
 // this endpoint on your organization’s GitHub Enterprise installation, this
 // endpoint provides information about that installation.
@@ -104,6 +227,27 @@ func (c *Client) APIMeta(ctx context.Context) (*APIMeta, *Response, error) {
 	return c.Meta.Get(ctx)
 }
 
+// ListAPIVersions returns the REST API versions supported by GitHub, as
+// dates such as "2022-11-29". See WithVersion to select one for a request.
+//
+// GitHub API docs: https://docs.github.com/rest/meta/meta#get-all-api-versions
+//
+//meta:operation GET /versions
+func (s *MetaService) ListAPIVersions(ctx context.Context) ([]string, *Response, error) {
+	req, err := s.client.NewRequest("GET", "versions", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions []string
+	resp, err := s.client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return versions, resp, nil
+}
+
 // Octocat returns an ASCII art octocat with the specified message in a speech
 // bubble. If message is empty, a random zen phrase is used.
 //
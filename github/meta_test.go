@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -98,6 +99,126 @@ func TestMetaService_Get(t *testing.T) {
 	})
 }
 
+func TestAPIMeta_IPRanges(t *testing.T) {
+	m := &APIMeta{
+		Hooks:    []string{"192.30.252.0/22", "2a0a:a440::/29"},
+		Importer: []string{"54.158.161.132", "2606:50c0:8000::153"},
+		Git:      []string{"140.82.112.5/20"},
+		Pages:    []string{"not-a-prefix"},
+	}
+
+	tests := []struct {
+		category string
+		want     []netip.Prefix
+	}{
+		{
+			category: MetaIPRangesHooks,
+			want:     []netip.Prefix{netip.MustParsePrefix("192.30.252.0/22"), netip.MustParsePrefix("2a0a:a440::/29")},
+		},
+		{
+			category: MetaIPRangesImporter,
+			want:     []netip.Prefix{netip.MustParsePrefix("54.158.161.132/32"), netip.MustParsePrefix("2606:50c0:8000::153/128")},
+		},
+		{
+			// Host bits are cleared.
+			category: MetaIPRangesGit,
+			want:     []netip.Prefix{netip.MustParsePrefix("140.82.112.0/20")},
+		},
+		{
+			category: MetaIPRangesActions,
+			want:     []netip.Prefix{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.category, func(t *testing.T) {
+			got, err := m.IPRanges(tt.category)
+			if err != nil {
+				t.Fatalf("IPRanges returned error: %v", err)
+			}
+			if !cmp.Equal(got, tt.want, cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })) {
+				t.Errorf("IPRanges returned %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := m.IPRanges(MetaIPRangesPages); err == nil {
+		t.Error("IPRanges returned no error for an invalid range")
+	}
+	if _, err := m.IPRanges("unknown"); err == nil {
+		t.Error("IPRanges returned no error for an unknown category")
+	}
+}
+
+func TestAPIMeta_IsGitHubIP(t *testing.T) {
+	m := &APIMeta{
+		Hooks:   []string{"192.30.252.0/22", "2a0a:a440::/29"},
+		Actions: []string{"4.175.114.51/32", "2603:1030::/40"},
+		Pages:   []string{"not-a-prefix", "185.199.108.0/22"},
+	}
+
+	tests := []struct {
+		name       string
+		addr       string
+		categories []string
+		want       bool
+	}{
+		{name: "IPv4 in any category", addr: "192.30.252.10", want: true},
+		{name: "IPv6 in any category", addr: "2a0a:a440:1::1", want: true},
+		{name: "IPv4-mapped IPv6", addr: "::ffff:192.30.252.10", want: true},
+		{name: "not in any category", addr: "8.8.8.8"},
+		{name: "IPv6 not in any category", addr: "2001:db8::1"},
+		{name: "in filtered category", addr: "192.30.252.10", categories: []string{MetaIPRangesHooks}, want: true},
+		{name: "IPv6 in filtered category", addr: "2603:1030:0:1::1", categories: []string{MetaIPRangesDependabot, MetaIPRangesActions}, want: true},
+		{name: "IPv6 outside filtered category", addr: "2603:1030:0:1::1", categories: []string{MetaIPRangesHooks}},
+		{name: "outside filtered category", addr: "4.175.114.51", categories: []string{MetaIPRangesHooks, MetaIPRangesWeb}},
+		{name: "invalid range ignored", addr: "185.199.109.153", categories: []string{MetaIPRangesPages}, want: true},
+		{name: "unknown category ignored", addr: "4.175.114.51", categories: []string{"unknown", MetaIPRangesActions}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.IsGitHubIP(netip.MustParseAddr(tt.addr), tt.categories...); got != tt.want {
+				t.Errorf("IsGitHubIP(%v, %v) returned %v, want %v", tt.addr, tt.categories, got, tt.want)
+			}
+		})
+	}
+
+	if m.IsGitHubIP(netip.Addr{}) {
+		t.Error("IsGitHubIP returned true for the zero address")
+	}
+}
+
+func TestMetaService_ListAPIVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `["2022-11-28","2022-11-29"]`)
+	})
+
+	ctx := context.Background()
+	versions, _, err := client.Meta.ListAPIVersions(ctx)
+	if err != nil {
+		t.Errorf("ListAPIVersions returned error: %v", err)
+	}
+
+	want := []string{"2022-11-28", "2022-11-29"}
+	if !cmp.Equal(versions, want) {
+		t.Errorf("ListAPIVersions returned %+v, want %+v", versions, want)
+	}
+
+	const methodName = "ListAPIVersions"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Meta.ListAPIVersions(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestMetaService_Octocat(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
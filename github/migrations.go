@@ -9,8 +9,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // MigrationService provides access to the migration related functions
@@ -35,11 +37,16 @@ type Migration struct {
 	LockRepositories *bool `json:"lock_repositories,omitempty"`
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
-	ExcludeAttachments *bool         `json:"exclude_attachments,omitempty"`
-	URL                *string       `json:"url,omitempty"`
-	CreatedAt          *string       `json:"created_at,omitempty"`
-	UpdatedAt          *string       `json:"updated_at,omitempty"`
-	Repositories       []*Repository `json:"repositories,omitempty"`
+	ExcludeAttachments   *bool         `json:"exclude_attachments,omitempty"`
+	ExcludeGitData       *bool         `json:"exclude_git_data,omitempty"`
+	ExcludeReleases      *bool         `json:"exclude_releases,omitempty"`
+	ExcludeOwnerProjects *bool         `json:"exclude_owner_projects,omitempty"`
+	OrgMetadataOnly      *bool         `json:"org_metadata_only,omitempty"`
+	Exclude              []string      `json:"exclude,omitempty"`
+	URL                  *string       `json:"url,omitempty"`
+	CreatedAt            *string       `json:"created_at,omitempty"`
+	UpdatedAt            *string       `json:"updated_at,omitempty"`
+	Repositories         []*Repository `json:"repositories,omitempty"`
 }
 
 func (m Migration) String() string {
@@ -55,6 +62,26 @@ type MigrationOptions struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments bool
+
+	// ExcludeGitData indicates whether repository git data should be excluded
+	// from the migration.
+	ExcludeGitData bool
+
+	// ExcludeReleases indicates whether releases should be excluded from the
+	// migration (to reduce migration archive file size).
+	ExcludeReleases bool
+
+	// ExcludeOwnerProjects indicates whether projects owned by the organization
+	// or users should be excluded from the migration.
+	ExcludeOwnerProjects bool
+
+	// OrgMetadataOnly indicates whether only organization metadata should be
+	// migrated. When true, no repositories are included.
+	OrgMetadataOnly bool
+
+	// Exclude lists related items to exclude from the migration.
+	// Possible values are: "repositories".
+	Exclude []string
 }
 
 // startMigration represents the body of a StartMigration request.
@@ -69,6 +96,12 @@ type startMigration struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+
+	ExcludeGitData       *bool    `json:"exclude_git_data,omitempty"`
+	ExcludeReleases      *bool    `json:"exclude_releases,omitempty"`
+	ExcludeOwnerProjects *bool    `json:"exclude_owner_projects,omitempty"`
+	OrgMetadataOnly      *bool    `json:"org_metadata_only,omitempty"`
+	Exclude              []string `json:"exclude,omitempty"`
 }
 
 // StartMigration starts the generation of a migration archive.
@@ -78,12 +111,17 @@ type startMigration struct {
 //
 //meta:operation POST /orgs/{org}/migrations
 func (s *MigrationService) StartMigration(ctx context.Context, org string, repos []string, opts *MigrationOptions) (*Migration, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/migrations", org)
+	u := fmt.Sprintf("orgs/%v/migrations", PathEscape(org))
 
 	body := &startMigration{Repositories: repos}
 	if opts != nil {
 		body.LockRepositories = Bool(opts.LockRepositories)
 		body.ExcludeAttachments = Bool(opts.ExcludeAttachments)
+		body.ExcludeGitData = Bool(opts.ExcludeGitData)
+		body.ExcludeReleases = Bool(opts.ExcludeReleases)
+		body.ExcludeOwnerProjects = Bool(opts.ExcludeOwnerProjects)
+		body.OrgMetadataOnly = Bool(opts.OrgMetadataOnly)
+		body.Exclude = opts.Exclude
 	}
 
 	req, err := s.client.NewRequest("POST", u, body)
@@ -109,7 +147,7 @@ func (s *MigrationService) StartMigration(ctx context.Context, org string, repos
 //
 //meta:operation GET /orgs/{org}/migrations
 func (s *MigrationService) ListMigrations(ctx context.Context, org string, opts *ListOptions) ([]*Migration, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/migrations", org)
+	u := fmt.Sprintf("orgs/%v/migrations", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -139,7 +177,7 @@ func (s *MigrationService) ListMigrations(ctx context.Context, org string, opts
 //
 //meta:operation GET /orgs/{org}/migrations/{migration_id}
 func (s *MigrationService) MigrationStatus(ctx context.Context, org string, id int64) (*Migration, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/migrations/%v", org, id)
+	u := fmt.Sprintf("orgs/%v/migrations/%v", PathEscape(org), id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -165,7 +203,7 @@ func (s *MigrationService) MigrationStatus(ctx context.Context, org string, id i
 //
 //meta:operation GET /orgs/{org}/migrations/{migration_id}/archive
 func (s *MigrationService) MigrationArchiveURL(ctx context.Context, org string, id int64) (url string, err error) {
-	u := fmt.Sprintf("orgs/%v/migrations/%v/archive", org, id)
+	u := fmt.Sprintf("orgs/%v/migrations/%v/archive", PathEscape(org), id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -197,6 +235,117 @@ func (s *MigrationService) MigrationArchiveURL(ctx context.Context, org string,
 	return loc, nil
 }
 
+// DownloadMigrationArchive writes the contents of a migration archive to w,
+// returning the number of bytes written. The archive is streamed rather than
+// held in memory, so it is suitable for large organizations.
+//
+// GitHub redirects archive downloads to a short-lived URL on a storage host.
+// The redirect is followed with followRedirectsClient, or if it is nil, with
+// a client that does not forward the credentials of this client, as the
+// storage host rejects them.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/orgs#download-an-organization-migration-archive
+//
+//meta:operation GET /orgs/{org}/migrations/{migration_id}/archive
+func (s *MigrationService) DownloadMigrationArchive(ctx context.Context, org string, id int64, w io.Writer, followRedirectsClient *http.Client) (int64, error) {
+	url, err := s.MigrationArchiveURL(ctx, org, id)
+	if err != nil {
+		return 0, err
+	}
+	return s.downloadMigrationArchive(ctx, url, w, followRedirectsClient)
+}
+
+// downloadMigrationArchive writes the archive at url to w.
+func (s *MigrationService) downloadMigrationArchive(ctx context.Context, url string, w io.Writer, followRedirectsClient *http.Client) (int64, error) {
+	if url == "" {
+		return 0, errors.New("no migration archive URL provided")
+	}
+
+	rc, err := s.client.downloadRedirect(ctx, followRedirectsClient, url)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	return io.Copy(w, rc)
+}
+
+// ErrMigrationFailed is returned by MigrationService.WaitForMigration and
+// MigrationService.WaitForUserMigration when the migration fails.
+var ErrMigrationFailed = errors.New("migration failed")
+
+// WaitForMigrationOptions specifies the optional parameters to the
+// MigrationService.WaitForMigration and MigrationService.WaitForUserMigration
+// methods.
+type WaitForMigrationOptions struct {
+	// Interval is the delay before the status is polled again after the first
+	// check. It doubles after each poll, up to MaxInterval. Defaults to 5s.
+	Interval time.Duration
+
+	// MaxInterval is the longest delay between polls. Defaults to one minute.
+	MaxInterval time.Duration
+}
+
+// WaitForMigration polls the status of a migration, backing off between
+// polls, until it is "exported" or "failed", or ctx is done. It returns the
+// final migration, along with ErrMigrationFailed if the migration failed.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/orgs#get-an-organization-migration-status
+//
+//meta:operation GET /orgs/{org}/migrations/{migration_id}
+func (s *MigrationService) WaitForMigration(ctx context.Context, org string, id int64, opts *WaitForMigrationOptions) (*Migration, error) {
+	var m *Migration
+	err := waitForMigration(ctx, opts, func() (string, error) {
+		var err error
+		m, _, err = s.MigrationStatus(ctx, org, id)
+		return m.GetState(), err
+	})
+	if err != nil && !errors.Is(err, ErrMigrationFailed) {
+		return nil, err
+	}
+	return m, err
+}
+
+// waitForMigration calls status until it reports a final migration state.
+func waitForMigration(ctx context.Context, opts *WaitForMigrationOptions, status func() (string, error)) error {
+	interval, maxInterval := 5*time.Second, time.Minute
+	if opts != nil && opts.Interval > 0 {
+		interval = opts.Interval
+	}
+	if opts != nil && opts.MaxInterval > 0 {
+		maxInterval = opts.MaxInterval
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	for {
+		state, err := status()
+		if err != nil {
+			return err
+		}
+		switch state {
+		case "exported":
+			return nil
+		case "failed":
+			return ErrMigrationFailed
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
 // DeleteMigration deletes a previous migration archive.
 // id is the migration ID.
 //
@@ -204,7 +353,7 @@ func (s *MigrationService) MigrationArchiveURL(ctx context.Context, org string,
 //
 //meta:operation DELETE /orgs/{org}/migrations/{migration_id}/archive
 func (s *MigrationService) DeleteMigration(ctx context.Context, org string, id int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/migrations/%v/archive", org, id)
+	u := fmt.Sprintf("orgs/%v/migrations/%v/archive", PathEscape(org), id)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -226,7 +375,7 @@ func (s *MigrationService) DeleteMigration(ctx context.Context, org string, id i
 //
 //meta:operation DELETE /orgs/{org}/migrations/{migration_id}/repos/{repo_name}/lock
 func (s *MigrationService) UnlockRepo(ctx context.Context, org string, id int64, repo string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/migrations/%v/repos/%v/lock", org, id, repo)
+	u := fmt.Sprintf("orgs/%v/migrations/%v/repos/%v/lock", PathEscape(org), id, PathEscape(repo))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -150,7 +150,7 @@ func (f LargeFile) String() string {
 //
 //meta:operation PUT /repos/{owner}/{repo}/import
 func (s *MigrationService) StartImport(ctx context.Context, owner, repo string, in *Import) (*Import, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/import", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PUT", u, in)
 	if err != nil {
 		return nil, nil, err
@@ -171,7 +171,7 @@ func (s *MigrationService) StartImport(ctx context.Context, owner, repo string,
 //
 //meta:operation GET /repos/{owner}/{repo}/import
 func (s *MigrationService) ImportProgress(ctx context.Context, owner, repo string) (*Import, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/import", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -192,7 +192,7 @@ func (s *MigrationService) ImportProgress(ctx context.Context, owner, repo strin
 //
 //meta:operation PATCH /repos/{owner}/{repo}/import
 func (s *MigrationService) UpdateImport(ctx context.Context, owner, repo string, in *Import) (*Import, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/import", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PATCH", u, in)
 	if err != nil {
 		return nil, nil, err
@@ -223,7 +223,7 @@ func (s *MigrationService) UpdateImport(ctx context.Context, owner, repo string,
 //
 //meta:operation GET /repos/{owner}/{repo}/import/authors
 func (s *MigrationService) CommitAuthors(ctx context.Context, owner, repo string) ([]*SourceImportAuthor, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import/authors", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/import/authors", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -246,7 +246,7 @@ func (s *MigrationService) CommitAuthors(ctx context.Context, owner, repo string
 //
 //meta:operation PATCH /repos/{owner}/{repo}/import/authors/{author_id}
 func (s *MigrationService) MapCommitAuthor(ctx context.Context, owner, repo string, id int64, author *SourceImportAuthor) (*SourceImportAuthor, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import/authors/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/import/authors/%v", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("PATCH", u, author)
 	if err != nil {
 		return nil, nil, err
@@ -269,7 +269,7 @@ func (s *MigrationService) MapCommitAuthor(ctx context.Context, owner, repo stri
 //
 //meta:operation PATCH /repos/{owner}/{repo}/import/lfs
 func (s *MigrationService) SetLFSPreference(ctx context.Context, owner, repo string, in *Import) (*Import, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import/lfs", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/import/lfs", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PATCH", u, in)
 	if err != nil {
 		return nil, nil, err
@@ -290,7 +290,7 @@ func (s *MigrationService) SetLFSPreference(ctx context.Context, owner, repo str
 //
 //meta:operation GET /repos/{owner}/{repo}/import/large_files
 func (s *MigrationService) LargeFiles(ctx context.Context, owner, repo string) ([]*LargeFile, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import/large_files", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/import/large_files", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -311,7 +311,7 @@ func (s *MigrationService) LargeFiles(ctx context.Context, owner, repo string) (
 //
 //meta:operation DELETE /repos/{owner}/{repo}/import
 func (s *MigrationService) CancelImport(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/import", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/import", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -55,7 +55,7 @@ func TestMigrationService_StartImport(t *testing.T) {
 		return err
 	})
 
-	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+	testNewRequestAndDoFailureCategory(t, methodName, client, SourceImportCategory, func() (*Response, error) {
 		got, resp, err := client.Migrations.StartImport(ctx, "o", "r", input)
 		if got != nil {
 			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
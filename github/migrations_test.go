@@ -6,11 +6,14 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -55,6 +58,31 @@ func TestMigrationService_StartMigration(t *testing.T) {
 	})
 }
 
+func TestMigrationService_StartMigration_excludeOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/migrations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"repositories":["r"],"lock_repositories":true,"exclude_attachments":false,"exclude_git_data":true,"exclude_releases":true,"exclude_owner_projects":false,"org_metadata_only":true,"exclude":["repositories"]}`+"\n")
+
+		w.WriteHeader(http.StatusCreated)
+		assertWrite(t, w, migrationJSON)
+	})
+
+	opt := &MigrationOptions{
+		LockRepositories: true,
+		ExcludeGitData:   true,
+		ExcludeReleases:  true,
+		OrgMetadataOnly:  true,
+		Exclude:          []string{"repositories"},
+	}
+	ctx := context.Background()
+	if _, _, err := client.Migrations.StartMigration(ctx, "o", []string{"r"}, opt); err != nil {
+		t.Errorf("StartMigration returned error: %v", err)
+	}
+}
+
 func TestMigrationService_ListMigrations(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -160,6 +188,130 @@ func TestMigrationService_MigrationArchiveURL(t *testing.T) {
 	})
 }
 
+func TestMigrationService_DownloadMigrationArchive(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+	client = client.WithAuthToken("token")
+
+	mux.HandleFunc("/orgs/o/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer token")
+
+		http.Redirect(w, r, serverURL+baseURLPath+"/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("storage request has Authorization header %q, want none", got)
+		}
+
+		assertWrite(t, w, []byte("0123456789abcdef"))
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	n, err := client.Migrations.DownloadMigrationArchive(ctx, "o", 1, &buf, nil)
+	if err != nil {
+		t.Fatalf("DownloadMigrationArchive returned error: %v", err)
+	}
+	if want := int64(16); n != want {
+		t.Errorf("DownloadMigrationArchive wrote %v bytes, want %v", n, want)
+	}
+	if got, want := buf.String(), "0123456789abcdef"; got != want {
+		t.Errorf("DownloadMigrationArchive wrote %q, want %q", got, want)
+	}
+}
+
+func TestMigrationService_DownloadMigrationArchive_expiredURL(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	if _, err := client.Migrations.DownloadMigrationArchive(ctx, "o", 1, &buf, nil); err == nil {
+		t.Error("DownloadMigrationArchive returned no error, want one")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("DownloadMigrationArchive wrote %q, want nothing", buf.String())
+	}
+}
+
+func TestMigrationService_WaitForMigration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	states := []string{"pending", "exporting", "exported"}
+	var polls int
+	mux.HandleFunc("/orgs/o/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"id":1,"state":%q}`, states[polls])
+		polls++
+	})
+
+	ctx := context.Background()
+	opts := &WaitForMigrationOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+	got, err := client.Migrations.WaitForMigration(ctx, "o", 1, opts)
+	if err != nil {
+		t.Fatalf("WaitForMigration returned error: %v", err)
+	}
+	if want := (&Migration{ID: Int64(1), State: String("exported")}); !cmp.Equal(got, want) {
+		t.Errorf("WaitForMigration = %+v, want %+v", got, want)
+	}
+	if want := 3; polls != want {
+		t.Errorf("WaitForMigration polled %v times, want %v", polls, want)
+	}
+}
+
+func TestMigrationService_WaitForMigration_failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"state":"failed"}`)
+	})
+
+	ctx := context.Background()
+	got, err := client.Migrations.WaitForMigration(ctx, "o", 1, nil)
+	if !errors.Is(err, ErrMigrationFailed) {
+		t.Errorf("WaitForMigration returned error %v, want %v", err, ErrMigrationFailed)
+	}
+	if want := (&Migration{ID: Int64(1), State: String("failed")}); !cmp.Equal(got, want) {
+		t.Errorf("WaitForMigration = %+v, want %+v", got, want)
+	}
+}
+
+func TestMigrationService_WaitForMigration_contextDone(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/orgs/o/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"state":"exporting"}`)
+		cancel()
+	})
+
+	got, err := client.Migrations.WaitForMigration(ctx, "o", 1, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitForMigration returned error %v, want %v", err, context.Canceled)
+	}
+	if got != nil {
+		t.Errorf("WaitForMigration = %+v, want nil", got)
+	}
+
+	const methodName = "WaitForMigration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Migrations.WaitForMigration(context.Background(), "\n", -1, nil)
+		return err
+	})
+}
+
 func TestMigrationService_DeleteMigration(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
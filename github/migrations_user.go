@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -28,26 +29,55 @@ type UserMigration struct {
 	LockRepositories *bool `json:"lock_repositories,omitempty"`
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
-	ExcludeAttachments *bool         `json:"exclude_attachments,omitempty"`
-	URL                *string       `json:"url,omitempty"`
-	CreatedAt          *string       `json:"created_at,omitempty"`
-	UpdatedAt          *string       `json:"updated_at,omitempty"`
-	Repositories       []*Repository `json:"repositories,omitempty"`
+	ExcludeAttachments   *bool         `json:"exclude_attachments,omitempty"`
+	ExcludeGitData       *bool         `json:"exclude_git_data,omitempty"`
+	ExcludeReleases      *bool         `json:"exclude_releases,omitempty"`
+	ExcludeOwnerProjects *bool         `json:"exclude_owner_projects,omitempty"`
+	OrgMetadataOnly      *bool         `json:"org_metadata_only,omitempty"`
+	Exclude              []string      `json:"exclude,omitempty"`
+	URL                  *string       `json:"url,omitempty"`
+	CreatedAt            *string       `json:"created_at,omitempty"`
+	UpdatedAt            *string       `json:"updated_at,omitempty"`
+	Repositories         []*Repository `json:"repositories,omitempty"`
 }
 
 func (m UserMigration) String() string {
 	return Stringify(m)
 }
 
-// UserMigrationOptions specifies the optional parameters to Migration methods.
+// UserMigrationOptions specifies the optional parameters to the
+// MigrationService.StartUserMigration method.
 type UserMigrationOptions struct {
-	// LockRepositories indicates whether repositories should be locked (to prevent
-	// manipulation) while migrating data.
+	// LockRepositories indicates whether the user's repositories should be
+	// locked (to prevent manipulation) while migrating data.
 	LockRepositories bool
 
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments bool
+
+	// ExcludeGitData indicates whether the git data of the repositories
+	// should be excluded from the migration.
+	ExcludeGitData bool
+
+	// ExcludeReleases indicates whether releases should be excluded from the
+	// migration (to reduce migration archive file size).
+	ExcludeReleases bool
+
+	// ExcludeOwnerProjects indicates whether the projects owned by the user
+	// should be excluded from the migration.
+	ExcludeOwnerProjects bool
+
+	// MetadataOnly indicates whether only the metadata of the user's account
+	// should be migrated. The repositories passed to StartUserMigration must
+	// then be empty, and GitHub ignores the other options. It is sent as the
+	// org_metadata_only parameter, which the user migrations API shares with
+	// organization migrations.
+	MetadataOnly bool
+
+	// Exclude lists related items to exclude from the migration.
+	// Possible values are: "repositories".
+	Exclude []string
 }
 
 // startUserMigration represents the body of a StartMigration request.
@@ -62,6 +92,12 @@ type startUserMigration struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+
+	ExcludeGitData       *bool    `json:"exclude_git_data,omitempty"`
+	ExcludeReleases      *bool    `json:"exclude_releases,omitempty"`
+	ExcludeOwnerProjects *bool    `json:"exclude_owner_projects,omitempty"`
+	OrgMetadataOnly      *bool    `json:"org_metadata_only,omitempty"`
+	Exclude              []string `json:"exclude,omitempty"`
 }
 
 // StartUserMigration starts the generation of a migration archive.
@@ -77,6 +113,11 @@ func (s *MigrationService) StartUserMigration(ctx context.Context, repos []strin
 	if opts != nil {
 		body.LockRepositories = Bool(opts.LockRepositories)
 		body.ExcludeAttachments = Bool(opts.ExcludeAttachments)
+		body.ExcludeGitData = Bool(opts.ExcludeGitData)
+		body.ExcludeReleases = Bool(opts.ExcludeReleases)
+		body.ExcludeOwnerProjects = Bool(opts.ExcludeOwnerProjects)
+		body.OrgMetadataOnly = Bool(opts.MetadataOnly)
+		body.Exclude = opts.Exclude
 	}
 
 	req, err := s.client.NewRequest("POST", u, body)
@@ -187,6 +228,40 @@ func (s *MigrationService) UserMigrationArchiveURL(ctx context.Context, id int64
 	return loc, nil
 }
 
+// DownloadUserMigrationArchive writes the contents of a user migration archive
+// to w, returning the number of bytes written. See
+// MigrationService.DownloadMigrationArchive for how the download is made.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/users#download-a-user-migration-archive
+//
+//meta:operation GET /user/migrations/{migration_id}/archive
+func (s *MigrationService) DownloadUserMigrationArchive(ctx context.Context, id int64, w io.Writer, followRedirectsClient *http.Client) (int64, error) {
+	url, err := s.UserMigrationArchiveURL(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	return s.downloadMigrationArchive(ctx, url, w, followRedirectsClient)
+}
+
+// WaitForUserMigration polls the status of a user migration until it is
+// "exported" or "failed", or ctx is done. See MigrationService.WaitForMigration.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/users#get-a-user-migration-status
+//
+//meta:operation GET /user/migrations/{migration_id}
+func (s *MigrationService) WaitForUserMigration(ctx context.Context, id int64, opts *WaitForMigrationOptions) (*UserMigration, error) {
+	var m *UserMigration
+	err := waitForMigration(ctx, opts, func() (string, error) {
+		var err error
+		m, _, err = s.UserMigrationStatus(ctx, id)
+		return m.GetState(), err
+	})
+	if err != nil && !errors.Is(err, ErrMigrationFailed) {
+		return nil, err
+	}
+	return m, err
+}
+
 // DeleteUserMigration will delete a previous migration archive.
 // id is the migration ID.
 //
@@ -216,7 +291,7 @@ func (s *MigrationService) DeleteUserMigration(ctx context.Context, id int64) (*
 //
 //meta:operation DELETE /user/migrations/{migration_id}/repos/{repo_name}/lock
 func (s *MigrationService) UnlockUserRepo(ctx context.Context, id int64, repo string) (*Response, error) {
-	url := fmt.Sprintf("user/migrations/%v/repos/%v/lock", id, repo)
+	url := fmt.Sprintf("user/migrations/%v/repos/%v/lock", id, PathEscape(repo))
 
 	req, err := s.client.NewRequest("DELETE", url, nil)
 	if err != nil {
@@ -6,11 +6,14 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -53,6 +56,30 @@ func TestMigrationService_StartUserMigration(t *testing.T) {
 	})
 }
 
+func TestMigrationService_StartUserMigration_metadataOnly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"lock_repositories":false,"exclude_attachments":false,"exclude_git_data":false,"exclude_releases":false,"exclude_owner_projects":true,"org_metadata_only":true,"exclude":["repositories"]}`+"\n")
+
+		w.WriteHeader(http.StatusCreated)
+		assertWrite(t, w, userMigrationJSON)
+	})
+
+	opt := &UserMigrationOptions{
+		ExcludeOwnerProjects: true,
+		MetadataOnly:         true,
+		Exclude:              []string{"repositories"},
+	}
+
+	ctx := context.Background()
+	if _, _, err := client.Migrations.StartUserMigration(ctx, nil, opt); err != nil {
+		t.Errorf("StartUserMigration returned error: %v", err)
+	}
+}
+
 func TestMigrationService_ListUserMigrations(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -148,6 +175,66 @@ func TestMigrationService_UserMigrationArchiveURL(t *testing.T) {
 	}
 }
 
+func TestMigrationService_DownloadUserMigrationArchive(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+	client = client.WithAuthToken("token")
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer token")
+
+		http.Redirect(w, r, serverURL+baseURLPath+"/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("storage request has Authorization header %q, want none", got)
+		}
+
+		assertWrite(t, w, []byte("0123456789abcdef"))
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	n, err := client.Migrations.DownloadUserMigrationArchive(ctx, 1, &buf, nil)
+	if err != nil {
+		t.Fatalf("DownloadUserMigrationArchive returned error: %v", err)
+	}
+	if want := int64(16); n != want {
+		t.Errorf("DownloadUserMigrationArchive wrote %v bytes, want %v", n, want)
+	}
+	if got, want := buf.String(), "0123456789abcdef"; got != want {
+		t.Errorf("DownloadUserMigrationArchive wrote %q, want %q", got, want)
+	}
+}
+
+func TestMigrationService_WaitForUserMigration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	states := []string{"pending", "exporting", "failed"}
+	var polls int
+	mux.HandleFunc("/user/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"id":1,"state":%q}`, states[polls])
+		polls++
+	})
+
+	ctx := context.Background()
+	opts := &WaitForMigrationOptions{Interval: time.Millisecond}
+	got, err := client.Migrations.WaitForUserMigration(ctx, 1, opts)
+	if !errors.Is(err, ErrMigrationFailed) {
+		t.Errorf("WaitForUserMigration returned error %v, want %v", err, ErrMigrationFailed)
+	}
+	if want := (&UserMigration{ID: Int64(1), State: String("failed")}); !cmp.Equal(got, want) {
+		t.Errorf("WaitForUserMigration = %+v, want %+v", got, want)
+	}
+	if want := 3; polls != want {
+		t.Errorf("WaitForUserMigration polled %v times, want %v", polls, want)
+	}
+}
+
 func TestMigrationService_DeleteUserMigration(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,67 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "encoding/json"
+
+// Nullable is a request field that can be left out, explicitly set to null,
+// or set to a value. Pointer fields tagged omitempty can't represent null,
+// which some PATCH endpoints use to clear a value.
+//
+// The zero value is left out of requests when the field is tagged omitempty.
+// Use NewNullable and NewNullNullable to create the other states.
+type Nullable[T any] map[bool]T
+
+// NewNullable returns a Nullable set to v.
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{true: v}
+}
+
+// NewNullNullable returns a Nullable explicitly set to null.
+func NewNullNullable[T any]() Nullable[T] {
+	var zero T
+	return Nullable[T]{false: zero}
+}
+
+// IsSpecified reports whether n is set, either to null or to a value.
+func (n Nullable[T]) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// IsNull reports whether n is explicitly set to null.
+func (n Nullable[T]) IsNull() bool {
+	_, ok := n[false]
+	return ok
+}
+
+// Get returns the value of n, and whether it's set to a value.
+func (n Nullable[T]) Get() (T, bool) {
+	v, ok := n[true]
+	return v, ok
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if v, ok := n.Get(); ok {
+		return json.Marshal(v)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NewNullNullable[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = NewNullable(v)
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type nullableTestStruct struct {
+	Milestone Nullable[int]    `json:"milestone,omitempty"`
+	Name      Nullable[string] `json:"name,omitempty"`
+}
+
+func TestNullable_states(t *testing.T) {
+	var absent Nullable[int]
+	if absent.IsSpecified() || absent.IsNull() {
+		t.Errorf("zero Nullable: IsSpecified = %v, IsNull = %v, want false, false", absent.IsSpecified(), absent.IsNull())
+	}
+	if _, ok := absent.Get(); ok {
+		t.Error("zero Nullable: Get reported a value")
+	}
+
+	null := NewNullNullable[int]()
+	if !null.IsSpecified() || !null.IsNull() {
+		t.Errorf("NewNullNullable: IsSpecified = %v, IsNull = %v, want true, true", null.IsSpecified(), null.IsNull())
+	}
+	if _, ok := null.Get(); ok {
+		t.Error("NewNullNullable: Get reported a value")
+	}
+
+	value := NewNullable(0)
+	if !value.IsSpecified() || value.IsNull() {
+		t.Errorf("NewNullable: IsSpecified = %v, IsNull = %v, want true, false", value.IsSpecified(), value.IsNull())
+	}
+	if v, ok := value.Get(); !ok || v != 0 {
+		t.Errorf("NewNullable: Get = %v, %v, want 0, true", v, ok)
+	}
+}
+
+func TestNullable_MarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		v    *nullableTestStruct
+		want string
+	}{
+		"absent": {v: &nullableTestStruct{}, want: `{}`},
+		"null":   {v: &nullableTestStruct{Milestone: NewNullNullable[int](), Name: NewNullNullable[string]()}, want: `{"milestone":null,"name":null}`},
+		"value":  {v: &nullableTestStruct{Milestone: NewNullable(0), Name: NewNullable("")}, want: `{"milestone":0,"name":""}`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := json.Marshal(tc.v)
+			if err != nil {
+				t.Fatalf("json.Marshal returned error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("json.Marshal = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNullable_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		data    string
+		want    *nullableTestStruct
+		wantErr bool
+	}{
+		"absent":     {data: `{}`, want: &nullableTestStruct{}},
+		"null":       {data: `{"milestone":null,"name":null}`, want: &nullableTestStruct{Milestone: NewNullNullable[int](), Name: NewNullNullable[string]()}},
+		"value":      {data: `{"milestone":1,"name":"n"}`, want: &nullableTestStruct{Milestone: NewNullable(1), Name: NewNullable("n")}},
+		"wrong type": {data: `{"milestone":"1"}`, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := &nullableTestStruct{}
+			err := json.Unmarshal([]byte(tc.data), got)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("json.Unmarshal(%v) returned nil error, want error", tc.data)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("json.Unmarshal(%v) returned error: %v", tc.data, err)
+			}
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("json.Unmarshal(%v) = %+v, want %+v", tc.data, got, tc.want)
+			}
+		})
+	}
+}
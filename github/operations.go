@@ -0,0 +1,163 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Operation is a GitHub REST API operation covered by this package.
+type Operation struct {
+	// Method is the HTTP method of the operation, such as "GET".
+	Method string
+	// Path is the path template of the operation as documented by GitHub,
+	// such as "/repos/{owner}/{repo}".
+	Path string
+	// GoMethods lists the methods that call the operation, such as
+	// "RepositoriesService.Get", sorted by name.
+	GoMethods []string
+}
+
+func (o Operation) copy() Operation {
+	o.GoMethods = append([]string(nil), o.GoMethods...)
+	return o
+}
+
+// Operations returns all the REST API operations covered by this package,
+// sorted by path and then method. The list is generated from the
+// "//meta:operation" directives of the service methods.
+func Operations() []Operation {
+	ops := make([]Operation, len(operations))
+	for i, op := range operations {
+		ops[i] = op.copy()
+	}
+	return ops
+}
+
+// operationTemplate is an Operation with its path split into segments.
+type operationTemplate struct {
+	op       *Operation
+	segments []string
+}
+
+var (
+	operationIndexOnce sync.Once
+	operationIndex     map[string][]operationTemplate
+)
+
+func operationTemplates(method string) []operationTemplate {
+	operationIndexOnce.Do(func() {
+		operationIndex = make(map[string][]operationTemplate)
+		for i := range operations {
+			op := &operations[i]
+			operationIndex[op.Method] = append(operationIndex[op.Method], operationTemplate{
+				op:       op,
+				segments: splitPath(op.Path),
+			})
+		}
+	})
+	return operationIndex[method]
+}
+
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// multiSegmentParams are the path parameters that may contain slashes, such
+// as file paths and git references.
+var multiSegmentParams = map[string]bool{
+	"{path}": true,
+	"{ref}":  true,
+}
+
+func isPathParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// matchSegments reports whether the first len(template) segments of path
+// match the template.
+func matchSegments(template, path []string) bool {
+	for i, t := range template {
+		if isPathParam(t) {
+			if path[i] == "" {
+				return false
+			}
+		} else if t != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// moreSpecific reports whether template a is preferable to template b. At the
+// first segment where one has a literal and the other a parameter, the
+// literal wins, so "/repos/{owner}/{repo}/pulls/comments" is preferred to
+// "/repos/{owner}/{repo}/pulls/{pull_number}".
+func moreSpecific(a, b []string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if pa, pb := isPathParam(a[i]), isPathParam(b[i]); pa != pb {
+			return pb
+		}
+	}
+	return len(a) > len(b)
+}
+
+// LookupOperation returns the covered REST API operation that a request with
+// the given method and path would call. path is relative to the root of the
+// API, such as "/repos/o/r/issues", and is expected to be escaped as in
+// url.URL.EscapedPath. A file path or git reference at the end of a path
+// template, such as {path} in "/repos/{owner}/{repo}/contents/{path}", may
+// span several segments.
+func LookupOperation(method, path string) (Operation, bool) {
+	segments := splitPath(path)
+
+	templates := operationTemplates(method)
+	var best, prefix *operationTemplate
+	for i := range templates {
+		t := &templates[i]
+		switch {
+		case len(t.segments) == len(segments):
+			if matchSegments(t.segments, segments) && (best == nil || moreSpecific(t.segments, best.segments)) {
+				best = t
+			}
+		case len(t.segments) > 0 && len(t.segments) < len(segments) && multiSegmentParams[t.segments[len(t.segments)-1]]:
+			if matchSegments(t.segments, segments) && (prefix == nil || moreSpecific(t.segments, prefix.segments)) {
+				prefix = t
+			}
+		}
+	}
+	if best == nil {
+		best = prefix
+	}
+	if best == nil {
+		return Operation{}, false
+	}
+	return best.op.copy(), true
+}
+
+// OperationForRequest returns the covered REST API operation that req calls,
+// for example to label requests in a custom http.RoundTripper or response
+// hook. The client's BaseURL or UploadURL path is removed from the request
+// path before it is looked up with LookupOperation.
+func (c *Client) OperationForRequest(req *http.Request) (Operation, bool) {
+	if req == nil || req.URL == nil {
+		return Operation{}, false
+	}
+	path := req.URL.EscapedPath()
+	for _, base := range []string{c.UploadURL.Path, c.BaseURL.Path} {
+		if base != "" && base != "/" && strings.HasPrefix(path, base) {
+			path = "/" + strings.TrimPrefix(path, base)
+			break
+		}
+	}
+	return LookupOperation(req.Method, path)
+}
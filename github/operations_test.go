@@ -0,0 +1,206 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOperations(t *testing.T) {
+	ops := Operations()
+	if len(ops) == 0 {
+		t.Fatal("Operations returned no operations")
+	}
+
+	sorted := sort.SliceIsSorted(ops, func(i, j int) bool {
+		if ops[i].Path != ops[j].Path {
+			return ops[i].Path < ops[j].Path
+		}
+		return ops[i].Method < ops[j].Method
+	})
+	if !sorted {
+		t.Error("Operations is not sorted by path and method")
+	}
+
+	var stargazers *Operation
+	for i, op := range ops {
+		if op.Method == "GET" && op.Path == "/repos/{owner}/{repo}/stargazers" {
+			stargazers = &ops[i]
+		}
+	}
+	want := &Operation{
+		Method:    "GET",
+		Path:      "/repos/{owner}/{repo}/stargazers",
+		GoMethods: []string{"ActivityService.ExportStargazers", "ActivityService.ListStargazers"},
+	}
+	if !cmp.Equal(stargazers, want) {
+		t.Errorf("Operations has %+v, want %+v", stargazers, want)
+	}
+
+	// The registry is not modified through the returned operations.
+	ops[0].GoMethods[0] = "changed"
+	if Operations()[0].GoMethods[0] == "changed" {
+		t.Error("modifying the result of Operations modified the registry")
+	}
+}
+
+func TestLookupOperation(t *testing.T) {
+	tests := map[string]struct {
+		method, path string
+		wantPath     string
+		wantMethod   string
+	}{
+		"literal": {
+			method:     "GET",
+			path:       "/meta",
+			wantPath:   "/meta",
+			wantMethod: "MetaService.Get",
+		},
+		"parameters": {
+			method:     "GET",
+			path:       "/repos/o/r/stargazers",
+			wantPath:   "/repos/{owner}/{repo}/stargazers",
+			wantMethod: "ActivityService.ListStargazers",
+		},
+		"literal preferred to parameter": {
+			method:     "GET",
+			path:       "/repos/o/r/pulls/comments",
+			wantPath:   "/repos/{owner}/{repo}/pulls/comments",
+			wantMethod: "PullRequestsService.ListComments",
+		},
+		"parameter": {
+			method:     "GET",
+			path:       "/repos/o/r/pulls/1",
+			wantPath:   "/repos/{owner}/{repo}/pulls/{pull_number}",
+			wantMethod: "PullRequestsService.Get",
+		},
+		"trailing parameter spanning segments": {
+			method:     "GET",
+			path:       "/repos/o/r/contents/a/b/c.txt",
+			wantPath:   "/repos/{owner}/{repo}/contents/{path}",
+			wantMethod: "RepositoriesService.GetContents",
+		},
+		"escaped segment": {
+			method:     "GET",
+			path:       "/repos/o/r/git/ref/heads%2Fmain",
+			wantPath:   "/repos/{owner}/{repo}/git/ref/{ref}",
+			wantMethod: "GitService.GetRef",
+		},
+		"trailing slash": {
+			method:     "GET",
+			path:       "/orgs/o/projects/",
+			wantPath:   "/orgs/{org}/projects",
+			wantMethod: "OrganizationsService.ListProjects",
+		},
+		"projects v2 list": {
+			method:     "GET",
+			path:       "/orgs/o/projectsV2",
+			wantPath:   "/orgs/{org}/projectsV2",
+			wantMethod: "ProjectsService.ListOrganizationProjects",
+		},
+		"projects v2": {
+			method:     "GET",
+			path:       "/orgs/o/projectsV2/1/fields",
+			wantPath:   "/orgs/{org}/projectsV2/{project_number}/fields",
+			wantMethod: "ProjectsService.ListOrganizationProjectFields",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			op, ok := LookupOperation(tc.method, tc.path)
+			if !ok {
+				t.Fatalf("LookupOperation(%q, %q) found no operation", tc.method, tc.path)
+			}
+			if op.Method != tc.method || op.Path != tc.wantPath {
+				t.Errorf("LookupOperation(%q, %q) = %v %v, want %v %v", tc.method, tc.path, op.Method, op.Path, tc.method, tc.wantPath)
+			}
+			found := false
+			for _, m := range op.GoMethods {
+				found = found || m == tc.wantMethod
+			}
+			if !found {
+				t.Errorf("LookupOperation(%q, %q).GoMethods = %v, want it to include %v", tc.method, tc.path, op.GoMethods, tc.wantMethod)
+			}
+		})
+	}
+}
+
+func TestLookupOperation_notFound(t *testing.T) {
+	tests := map[string]struct {
+		method, path string
+	}{
+		"unknown path":   {"GET", "/no/such/endpoint"},
+		"unknown method": {"TRACE", "/meta"},
+		"too short":      {"GET", "/repos/o"},
+		"empty segment":  {"GET", "/repos/o//stargazers"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if op, ok := LookupOperation(tc.method, tc.path); ok {
+				t.Errorf("LookupOperation(%q, %q) = %+v, want none", tc.method, tc.path, op)
+			}
+		})
+	}
+}
+
+func TestClient_OperationForRequest(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	req, err := client.NewRequest("GET", "repos/o/r/pulls/1", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	op, ok := client.OperationForRequest(req)
+	if !ok || op.Path != "/repos/{owner}/{repo}/pulls/{pull_number}" {
+		t.Errorf("OperationForRequest = %+v, %v, want GET /repos/{owner}/{repo}/pulls/{pull_number}", op, ok)
+	}
+
+	upload, err := client.NewUploadRequest("repos/o/r/releases/1/assets?name=a", nil, 0, "")
+	if err != nil {
+		t.Fatalf("NewUploadRequest returned error: %v", err)
+	}
+	op, ok = client.OperationForRequest(upload)
+	if !ok || op.Method != "POST" || op.Path != "/repos/{owner}/{repo}/releases/{release_id}/assets" {
+		t.Errorf("OperationForRequest = %+v, %v, want POST /repos/{owner}/{repo}/releases/{release_id}/assets", op, ok)
+	}
+
+	if _, ok := client.OperationForRequest(nil); ok {
+		t.Error("OperationForRequest(nil) found an operation")
+	}
+}
+
+func TestClient_OperationForRequest_enterprise(t *testing.T) {
+	client, err := NewClient(nil).WithEnterpriseURLs("https://ghe.example.com/", "")
+	if err != nil {
+		t.Fatalf("WithEnterpriseURLs returned error: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "GET", "https://ghe.example.com/api/v3/repos/o/r/stargazers?page=2", nil)
+	if err != nil {
+		t.Fatalf("http.NewRequest returned error: %v", err)
+	}
+	op, ok := client.OperationForRequest(req)
+	if !ok || op.Path != "/repos/{owner}/{repo}/stargazers" {
+		t.Errorf("OperationForRequest = %+v, %v, want GET /repos/{owner}/{repo}/stargazers", op, ok)
+	}
+
+	req, err = http.NewRequestWithContext(context.Background(), "POST", "https://ghe.example.com/api/uploads/repos/o/r/releases/1/assets", nil)
+	if err != nil {
+		t.Fatalf("http.NewRequest returned error: %v", err)
+	}
+	op, ok = client.OperationForRequest(req)
+	if !ok || op.Path != "/repos/{owner}/{repo}/releases/{release_id}/assets" {
+		t.Errorf("OperationForRequest = %+v, %v, want POST /repos/{owner}/{repo}/releases/{release_id}/assets", op, ok)
+	}
+}
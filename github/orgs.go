@@ -81,20 +81,25 @@ type Organization struct {
 	MembersCanCreatePublicPages *bool `json:"members_can_create_public_pages,omitempty"`
 	// MembersCanCreatePrivatePages toggles whether organization members can create private GitHub Pages sites.
 	MembersCanCreatePrivatePages *bool `json:"members_can_create_private_pages,omitempty"`
-	// WebCommitSignoffRequire toggles
+	// WebCommitSignoffRequired toggles whether contributors are required to sign off on web-based commits.
 	WebCommitSignoffRequired *bool `json:"web_commit_signoff_required,omitempty"`
-	// AdvancedSecurityAuditLogEnabled toggles whether the advanced security audit log is enabled.
+	// AdvancedSecurityEnabledForNewRepos toggles whether GitHub Advanced Security is enabled on new repositories.
 	AdvancedSecurityEnabledForNewRepos *bool `json:"advanced_security_enabled_for_new_repositories,omitempty"`
-	// DependabotAlertsEnabled toggles whether dependabot alerts are enabled.
+	// DependabotAlertsEnabledForNewRepos toggles whether dependabot alerts are enabled on new repositories.
 	DependabotAlertsEnabledForNewRepos *bool `json:"dependabot_alerts_enabled_for_new_repositories,omitempty"`
-	// DependabotSecurityUpdatesEnabled toggles whether dependabot security updates are enabled.
+	// DependabotSecurityUpdatesEnabledForNewRepos toggles whether dependabot security updates are enabled on new repositories.
 	DependabotSecurityUpdatesEnabledForNewRepos *bool `json:"dependabot_security_updates_enabled_for_new_repositories,omitempty"`
-	// DependabotGraphEnabledForNewRepos toggles whether dependabot graph is enabled on new repositories.
+	// DependencyGraphEnabledForNewRepos toggles whether the dependency graph is enabled on new repositories.
 	DependencyGraphEnabledForNewRepos *bool `json:"dependency_graph_enabled_for_new_repositories,omitempty"`
-	// SecretScanningEnabled toggles whether secret scanning is enabled on new repositories.
+	// SecretScanningEnabledForNewRepos toggles whether secret scanning is enabled on new repositories.
 	SecretScanningEnabledForNewRepos *bool `json:"secret_scanning_enabled_for_new_repositories,omitempty"`
 	// SecretScanningPushProtectionEnabledForNewRepos toggles whether secret scanning push protection is enabled on new repositories.
 	SecretScanningPushProtectionEnabledForNewRepos *bool `json:"secret_scanning_push_protection_enabled_for_new_repositories,omitempty"`
+	// SecretScanningPushProtectionCustomLinkEnabled toggles whether a custom link is shown to contributors who are blocked from pushing a secret.
+	SecretScanningPushProtectionCustomLinkEnabled *bool `json:"secret_scanning_push_protection_custom_link_enabled,omitempty"`
+	// SecretScanningPushProtectionCustomLink is the URL shown to contributors who are blocked from pushing a secret,
+	// if SecretScanningPushProtectionCustomLinkEnabled is true.
+	SecretScanningPushProtectionCustomLink *string `json:"secret_scanning_push_protection_custom_link,omitempty"`
 	// SecretScanningValidityChecksEnabled toggles whether secret scanning validity check is enabled.
 	SecretScanningValidityChecksEnabled *bool `json:"secret_scanning_validity_checks_enabled,omitempty"`
 
@@ -183,7 +188,7 @@ func (s *OrganizationsService) ListAll(ctx context.Context, opts *OrganizationsL
 func (s *OrganizationsService) List(ctx context.Context, user string, opts *ListOptions) ([]*Organization, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/orgs", user)
+		u = fmt.Sprintf("users/%v/orgs", PathEscape(user))
 	} else {
 		u = "user/orgs"
 	}
@@ -212,7 +217,7 @@ func (s *OrganizationsService) List(ctx context.Context, user string, opts *List
 //
 //meta:operation GET /orgs/{org}
 func (s *OrganizationsService) Get(ctx context.Context, org string) (*Organization, *Response, error) {
-	u := fmt.Sprintf("orgs/%v", org)
+	u := fmt.Sprintf("orgs/%v", PathEscape(org))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -257,7 +262,7 @@ func (s *OrganizationsService) GetByID(ctx context.Context, id int64) (*Organiza
 //
 //meta:operation PATCH /orgs/{org}
 func (s *OrganizationsService) Edit(ctx context.Context, name string, org *Organization) (*Organization, *Response, error) {
-	u := fmt.Sprintf("orgs/%v", name)
+	u := fmt.Sprintf("orgs/%v", PathEscape(name))
 	req, err := s.client.NewRequest("PATCH", u, org)
 	if err != nil {
 		return nil, nil, err
@@ -281,7 +286,7 @@ func (s *OrganizationsService) Edit(ctx context.Context, name string, org *Organ
 //
 //meta:operation DELETE /orgs/{org}
 func (s *OrganizationsService) Delete(ctx context.Context, org string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v", org)
+	u := fmt.Sprintf("orgs/%v", PathEscape(org))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -296,7 +301,7 @@ func (s *OrganizationsService) Delete(ctx context.Context, org string) (*Respons
 //
 //meta:operation GET /orgs/{org}/installations
 func (s *OrganizationsService) ListInstallations(ctx context.Context, org string, opts *ListOptions) (*OrganizationInstallations, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/installations", org)
+	u := fmt.Sprintf("orgs/%v/installations", PathEscape(org))
 
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -0,0 +1,105 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// Announcement represents the announcement banner of an organization or an
+// enterprise.
+type Announcement struct {
+	// Announcement is the text of the banner, in GitHub Flavored Markdown.
+	Announcement *string `json:"announcement,omitempty"`
+	// ExpiresAt is when the banner expires. When setting a banner, omitting
+	// ExpiresAt, which the API treats like null, sets a banner that never
+	// expires. It's nil for a banner that never expires.
+	ExpiresAt *Timestamp `json:"expires_at,omitempty"`
+	// UserDismissible is whether users can dismiss the banner.
+	UserDismissible *bool `json:"user_dismissible,omitempty"`
+}
+
+func (a Announcement) String() string {
+	return Stringify(a)
+}
+
+// GetAnnouncement gets the announcement banner of an organization. An
+// organization without a banner returns an Announcement with a nil
+// Announcement field.
+//
+// This endpoint is only available on GitHub Enterprise Cloud and GitHub
+// Enterprise Server.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/announcement-banners/organizations#get-announcement-banner-for-organization
+//
+//meta:operation GET /orgs/{org}/announcement
+func (s *OrganizationsService) GetAnnouncement(ctx context.Context, org string) (*Announcement, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/announcement", PathEscape(org))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	announcement := new(Announcement)
+	resp, err := s.client.Do(ctx, req, announcement)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return announcement, resp, nil
+}
+
+// SetAnnouncement sets the announcement banner of an organization. Setting an
+// announcement with empty text removes the banner instead, as with
+// RemoveAnnouncement, and returns a nil Announcement.
+//
+// This endpoint is only available on GitHub Enterprise Cloud and GitHub
+// Enterprise Server.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/announcement-banners/organizations#set-announcement-banner-for-organization
+//
+//meta:operation PATCH /orgs/{org}/announcement
+func (s *OrganizationsService) SetAnnouncement(ctx context.Context, org string, announcement *Announcement) (*Announcement, *Response, error) {
+	if announcement.GetAnnouncement() == "" {
+		resp, err := s.RemoveAnnouncement(ctx, org)
+		return nil, resp, err
+	}
+
+	u := fmt.Sprintf("orgs/%v/announcement", PathEscape(org))
+	req, err := s.client.NewRequest("PATCH", u, announcement)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(Announcement)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// RemoveAnnouncement removes the announcement banner of an organization.
+//
+// This endpoint is only available on GitHub Enterprise Cloud and GitHub
+// Enterprise Server.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/announcement-banners/organizations#remove-announcement-banner-from-organization
+//
+//meta:operation DELETE /orgs/{org}/announcement
+func (s *OrganizationsService) RemoveAnnouncement(ctx context.Context, org string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/announcement", PathEscape(org))
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_GetAnnouncement(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"announcement":"Very **important** announcement","expires_at":`+referenceTimeStr+`,"user_dismissible":false}`)
+	})
+
+	ctx := context.Background()
+	announcement, _, err := client.Organizations.GetAnnouncement(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.GetAnnouncement returned error: %v", err)
+	}
+
+	want := &Announcement{
+		Announcement:    String("Very **important** announcement"),
+		ExpiresAt:       &Timestamp{referenceTime},
+		UserDismissible: Bool(false),
+	}
+	if !cmp.Equal(announcement, want) {
+		t.Errorf("Organizations.GetAnnouncement returned %+v, want %+v", announcement, want)
+	}
+
+	const methodName = "GetAnnouncement"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetAnnouncement(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetAnnouncement(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetAnnouncement_nullFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"announcement":null,"expires_at":null,"user_dismissible":null}`)
+	})
+
+	ctx := context.Background()
+	announcement, _, err := client.Organizations.GetAnnouncement(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.GetAnnouncement returned error: %v", err)
+	}
+
+	if want := (&Announcement{}); !cmp.Equal(announcement, want) {
+		t.Errorf("Organizations.GetAnnouncement returned %+v, want %+v", announcement, want)
+	}
+}
+
+func TestOrganizationsService_SetAnnouncement(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Announcement{
+		Announcement:    String("Maintenance tonight"),
+		ExpiresAt:       &Timestamp{referenceTime},
+		UserDismissible: Bool(true),
+	}
+
+	mux.HandleFunc("/orgs/o/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"announcement":"Maintenance tonight","expires_at":`+referenceTimeStr+`,"user_dismissible":true}`+"\n")
+		fmt.Fprint(w, `{"announcement":"Maintenance tonight","expires_at":`+referenceTimeStr+`,"user_dismissible":true}`)
+	})
+
+	ctx := context.Background()
+	announcement, _, err := client.Organizations.SetAnnouncement(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Organizations.SetAnnouncement returned error: %v", err)
+	}
+
+	if !cmp.Equal(announcement, input) {
+		t.Errorf("Organizations.SetAnnouncement returned %+v, want %+v", announcement, input)
+	}
+
+	const methodName = "SetAnnouncement"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.SetAnnouncement(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.SetAnnouncement(ctx, "o", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_SetAnnouncement_withoutExpiry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"announcement":"a"}`+"\n")
+		fmt.Fprint(w, `{"announcement":"a","expires_at":null,"user_dismissible":false}`)
+	})
+
+	ctx := context.Background()
+	announcement, _, err := client.Organizations.SetAnnouncement(ctx, "o", &Announcement{Announcement: String("a")})
+	if err != nil {
+		t.Errorf("Organizations.SetAnnouncement returned error: %v", err)
+	}
+
+	want := &Announcement{Announcement: String("a"), UserDismissible: Bool(false)}
+	if !cmp.Equal(announcement, want) {
+		t.Errorf("Organizations.SetAnnouncement returned %+v, want %+v", announcement, want)
+	}
+}
+
+func TestOrganizationsService_SetAnnouncement_empty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	for _, input := range []*Announcement{nil, {}, {Announcement: String("")}} {
+		announcement, resp, err := client.Organizations.SetAnnouncement(ctx, "o", input)
+		if err != nil {
+			t.Errorf("Organizations.SetAnnouncement(%v) returned error: %v", input, err)
+		}
+		if announcement != nil {
+			t.Errorf("Organizations.SetAnnouncement(%v) returned %+v, want nil", input, announcement)
+		}
+		if resp.StatusCode != http.StatusNoContent {
+			t.Errorf("Organizations.SetAnnouncement(%v) returned status %v, want %v", input, resp.StatusCode, http.StatusNoContent)
+		}
+	}
+}
+
+func TestOrganizationsService_RemoveAnnouncement(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.RemoveAnnouncement(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.RemoveAnnouncement returned error: %v", err)
+	}
+
+	const methodName = "RemoveAnnouncement"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RemoveAnnouncement(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RemoveAnnouncement(ctx, "o")
+	})
+}
+
+func TestAnnouncement_Marshal(t *testing.T) {
+	testJSONMarshal(t, &Announcement{}, "{}")
+
+	a := &Announcement{
+		Announcement:    String("a"),
+		ExpiresAt:       &Timestamp{referenceTime},
+		UserDismissible: Bool(true),
+	}
+
+	want := `{
+		"announcement": "a",
+		"expires_at": ` + referenceTimeStr + `,
+		"user_dismissible": true
+	}`
+
+	testJSONMarshal(t, a, want)
+}
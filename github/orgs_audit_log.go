@@ -121,7 +121,7 @@ func (a *AuditEntry) MarshalJSON() ([]byte, error) {
 //
 //meta:operation GET /orgs/{org}/audit-log
 func (s *OrganizationsService) GetAuditLog(ctx context.Context, org string, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/audit-log", org)
+	u := fmt.Sprintf("orgs/%v/audit-log", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,38 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListPushRuleBypassRequests lists the push rule bypass requests of the
+// repositories of an organization.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/orgs/bypass-requests#list-push-rule-bypass-requests-within-an-organization
+//
+//meta:operation GET /orgs/{org}/bypass-requests/push-rules
+func (s *OrganizationsService) ListPushRuleBypassRequests(ctx context.Context, org string, opts *BypassRequestListOptions) ([]*BypassRequest, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/bypass-requests/push-rules", PathEscape(org))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*BypassRequest
+	resp, err := s.client.Do(ctx, req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_ListPushRuleBypassRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/bypass-requests/push-rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"repository_name": "r",
+			"requester":       "octocat",
+		})
+		fmt.Fprint(w, `[{"number": 1, "requester": {"actor_id": 12, "actor_name": "octocat"}}]`)
+	})
+
+	opts := &BypassRequestListOptions{RepositoryName: "r", Requester: "octocat"}
+	ctx := context.Background()
+	requests, _, err := client.Organizations.ListPushRuleBypassRequests(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListPushRuleBypassRequests returned error: %v", err)
+	}
+
+	want := []*BypassRequest{{
+		Number:    Int64(1),
+		Requester: &BypassRequestActor{ActorID: Int64(12), ActorName: String("octocat")},
+	}}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("Organizations.ListPushRuleBypassRequests returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListPushRuleBypassRequests"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListPushRuleBypassRequests(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListPushRuleBypassRequests(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
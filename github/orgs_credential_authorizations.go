@@ -55,14 +55,23 @@ type CredentialAuthorization struct {
 	AuthorizedCredentialExpiresAt *Timestamp `json:"authorized_credential_expires_at,omitempty"`
 }
 
+// CredentialAuthorizationsListOptions specifies the optional parameters to the
+// OrganizationsService.ListCredentialAuthorizations method.
+type CredentialAuthorizationsListOptions struct {
+	ListOptions
+
+	// Login limits the credentials to those owned by the user with this login.
+	Login string `url:"login,omitempty"`
+}
+
 // ListCredentialAuthorizations lists credentials authorized through SAML SSO
 // for a given organization. Only available with GitHub Enterprise Cloud.
 //
 // GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/orgs/orgs#list-saml-sso-authorizations-for-an-organization
 //
 //meta:operation GET /orgs/{org}/credential-authorizations
-func (s *OrganizationsService) ListCredentialAuthorizations(ctx context.Context, org string, opts *ListOptions) ([]*CredentialAuthorization, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/credential-authorizations", org)
+func (s *OrganizationsService) ListCredentialAuthorizations(ctx context.Context, org string, opts *CredentialAuthorizationsListOptions) ([]*CredentialAuthorization, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/credential-authorizations", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -85,11 +94,14 @@ func (s *OrganizationsService) ListCredentialAuthorizations(ctx context.Context,
 // RemoveCredentialAuthorization revokes the SAML SSO authorization for a given
 // credential within an organization. Only available with GitHub Enterprise Cloud.
 //
+// Removing a credential that isn't authorized, for example because it was
+// already removed, returns an *ErrorResponse with a 404 status code.
+//
 // GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/orgs/orgs#remove-a-saml-sso-authorization-for-an-organization
 //
 //meta:operation DELETE /orgs/{org}/credential-authorizations/{credential_id}
 func (s *OrganizationsService) RemoveCredentialAuthorization(ctx context.Context, org string, credentialID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/credential-authorizations/%v", org, credentialID)
+	u := fmt.Sprintf("orgs/%v/credential-authorizations/%v", PathEscape(org), credentialID)
 	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
 	if err != nil {
 		return nil, err
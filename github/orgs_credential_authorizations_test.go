@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -34,7 +35,7 @@ func TestOrganizationsService_ListCredentialAuthorizations(t *testing.T) {
 		]`)
 	})
 
-	opts := &ListOptions{Page: 2, PerPage: 2}
+	opts := &CredentialAuthorizationsListOptions{ListOptions: ListOptions{Page: 2, PerPage: 2}}
 	ctx := context.Background()
 	creds, _, err := client.Organizations.ListCredentialAuthorizations(ctx, "o", opts)
 	if err != nil {
@@ -68,6 +69,75 @@ func TestOrganizationsService_ListCredentialAuthorizations(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_ListCredentialAuthorizations_loginFilter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/credential-authorizations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"login": "octocat"})
+		fmt.Fprint(w, `[
+			{
+				"login": "octocat",
+				"credential_id": 1,
+				"credential_type": "personal access token",
+				"token_last_eight": "12345678",
+				"credential_authorized_at": "2017-01-21T00:00:00Z",
+				"credential_accessed_at": null,
+				"scopes": ["repo", "read:org"],
+				"authorized_credential_id": 11,
+				"authorized_credential_note": "ci token",
+				"authorized_credential_expires_at": "2017-02-21T00:00:00Z"
+			},
+			{
+				"login": "octocat",
+				"credential_id": 2,
+				"credential_type": "SSH key",
+				"credential_authorized_at": "2017-01-21T00:00:00Z",
+				"credential_accessed_at": "2017-01-22T00:00:00Z",
+				"fingerprint": "jklmnop12345678",
+				"authorized_credential_id": 12,
+				"authorized_credential_title": "laptop"
+			}
+		]`)
+	})
+
+	opts := &CredentialAuthorizationsListOptions{Login: "octocat"}
+	ctx := context.Background()
+	creds, _, err := client.Organizations.ListCredentialAuthorizations(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListCredentialAuthorizations returned error: %v", err)
+	}
+
+	authorized := time.Date(2017, time.January, 21, 0, 0, 0, 0, time.UTC)
+	want := []*CredentialAuthorization{
+		{
+			Login:                         String("octocat"),
+			CredentialID:                  Int64(1),
+			CredentialType:                String("personal access token"),
+			TokenLastEight:                String("12345678"),
+			CredentialAuthorizedAt:        &Timestamp{authorized},
+			Scopes:                        []string{"repo", "read:org"},
+			AuthorizedCredentialID:        Int64(11),
+			AuthorizedCredentialNote:      String("ci token"),
+			AuthorizedCredentialExpiresAt: &Timestamp{time.Date(2017, time.February, 21, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			Login:                     String("octocat"),
+			CredentialID:              Int64(2),
+			CredentialType:            String("SSH key"),
+			CredentialAuthorizedAt:    &Timestamp{authorized},
+			CredentialAccessedAt:      &Timestamp{time.Date(2017, time.January, 22, 0, 0, 0, 0, time.UTC)},
+			Fingerprint:               String("jklmnop12345678"),
+			AuthorizedCredentialID:    Int64(12),
+			AuthorizedCredentialTitle: String("laptop"),
+		},
+	}
+	if !cmp.Equal(creds, want) {
+		t.Errorf("Organizations.ListCredentialAuthorizations returned %+v, want %+v", creds, want)
+	}
+}
+
 func TestOrganizationsService_RemoveCredentialAuthorization(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -97,3 +167,24 @@ func TestOrganizationsService_RemoveCredentialAuthorization(t *testing.T) {
 		return client.Organizations.RemoveCredentialAuthorization(ctx, "o", 1)
 	})
 }
+
+func TestOrganizationsService_RemoveCredentialAuthorization_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/credential-authorizations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Organizations.RemoveCredentialAuthorization(ctx, "o", 1)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Organizations.RemoveCredentialAuthorization returned error %v, want *ErrorResponse", err)
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Organizations.RemoveCredentialAuthorization returned status %v, want %v", resp.StatusCode, http.StatusNotFound)
+	}
+}
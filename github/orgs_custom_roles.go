@@ -34,7 +34,7 @@ type CustomRepoRoles struct {
 //
 //meta:operation GET /orgs/{org}/custom-repository-roles
 func (s *OrganizationsService) ListCustomRepoRoles(ctx context.Context, org string) (*OrganizationCustomRepoRoles, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/custom-repository-roles", org)
+	u := fmt.Sprintf("orgs/%v/custom-repository-roles", PathEscape(org))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -65,7 +65,7 @@ type CreateOrUpdateCustomRoleOptions struct {
 //
 //meta:operation POST /orgs/{org}/custom-repository-roles
 func (s *OrganizationsService) CreateCustomRepoRole(ctx context.Context, org string, opts *CreateOrUpdateCustomRoleOptions) (*CustomRepoRoles, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/custom-repository-roles", org)
+	u := fmt.Sprintf("orgs/%v/custom-repository-roles", PathEscape(org))
 
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
@@ -88,7 +88,7 @@ func (s *OrganizationsService) CreateCustomRepoRole(ctx context.Context, org str
 //
 //meta:operation PATCH /orgs/{org}/custom-repository-roles/{role_id}
 func (s *OrganizationsService) UpdateCustomRepoRole(ctx context.Context, org, roleID string, opts *CreateOrUpdateCustomRoleOptions) (*CustomRepoRoles, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/custom-repository-roles/%v", org, roleID)
+	u := fmt.Sprintf("orgs/%v/custom-repository-roles/%v", PathEscape(org), PathEscape(roleID))
 
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
@@ -111,7 +111,7 @@ func (s *OrganizationsService) UpdateCustomRepoRole(ctx context.Context, org, ro
 //
 //meta:operation DELETE /orgs/{org}/custom-repository-roles/{role_id}
 func (s *OrganizationsService) DeleteCustomRepoRole(ctx context.Context, org, roleID string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/custom-repository-roles/%v", org, roleID)
+	u := fmt.Sprintf("orgs/%v/custom-repository-roles/%v", PathEscape(org), PathEscape(roleID))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /orgs/{org}/hooks
 func (s *OrganizationsService) ListHooks(ctx context.Context, org string, opts *ListOptions) ([]*Hook, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks", org)
+	u := fmt.Sprintf("orgs/%v/hooks", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -36,13 +36,28 @@ func (s *OrganizationsService) ListHooks(ctx context.Context, org string, opts *
 	return hooks, resp, nil
 }
 
+// ListUnhealthyHooks lists the Hooks for the specified organization whose last
+// delivery failed, that is, whose last response code is 400 or above or whose
+// last response status is "misconfigured". All pages of hooks are inspected,
+// starting from the page selected by opts, and the returned Response is the
+// one for the last page.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/webhooks#list-organization-webhooks
+//
+//meta:operation GET /orgs/{org}/hooks
+func (s *OrganizationsService) ListUnhealthyHooks(ctx context.Context, org string, opts *ListOptions) ([]*Hook, *Response, error) {
+	return listUnhealthyHooks(opts, func(opts *ListOptions) ([]*Hook, *Response, error) {
+		return s.ListHooks(ctx, org, opts)
+	})
+}
+
 // GetHook returns a single specified Hook.
 //
 // GitHub API docs: https://docs.github.com/rest/orgs/webhooks#get-an-organization-webhook
 //
 //meta:operation GET /orgs/{org}/hooks/{hook_id}
 func (s *OrganizationsService) GetHook(ctx context.Context, org string, id int64) (*Hook, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks/%d", org, id)
+	u := fmt.Sprintf("orgs/%v/hooks/%d", PathEscape(org), id)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -67,7 +82,7 @@ func (s *OrganizationsService) GetHook(ctx context.Context, org string, id int64
 //
 //meta:operation POST /orgs/{org}/hooks
 func (s *OrganizationsService) CreateHook(ctx context.Context, org string, hook *Hook) (*Hook, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks", org)
+	u := fmt.Sprintf("orgs/%v/hooks", PathEscape(org))
 
 	hookReq := &createHookRequest{
 		Name:   "web",
@@ -96,7 +111,7 @@ func (s *OrganizationsService) CreateHook(ctx context.Context, org string, hook
 //
 //meta:operation PATCH /orgs/{org}/hooks/{hook_id}
 func (s *OrganizationsService) EditHook(ctx context.Context, org string, id int64, hook *Hook) (*Hook, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks/%d", org, id)
+	u := fmt.Sprintf("orgs/%v/hooks/%d", PathEscape(org), id)
 	req, err := s.client.NewRequest("PATCH", u, hook)
 	if err != nil {
 		return nil, nil, err
@@ -117,7 +132,7 @@ func (s *OrganizationsService) EditHook(ctx context.Context, org string, id int6
 //
 //meta:operation POST /orgs/{org}/hooks/{hook_id}/pings
 func (s *OrganizationsService) PingHook(ctx context.Context, org string, id int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks/%d/pings", org, id)
+	u := fmt.Sprintf("orgs/%v/hooks/%d/pings", PathEscape(org), id)
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, err
@@ -132,7 +147,7 @@ func (s *OrganizationsService) PingHook(ctx context.Context, org string, id int6
 //
 //meta:operation DELETE /orgs/{org}/hooks/{hook_id}
 func (s *OrganizationsService) DeleteHook(ctx context.Context, org string, id int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks/%d", org, id)
+	u := fmt.Sprintf("orgs/%v/hooks/%d", PathEscape(org), id)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
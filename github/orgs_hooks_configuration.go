@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /orgs/{org}/hooks/{hook_id}/config
 func (s *OrganizationsService) GetHookConfiguration(ctx context.Context, org string, id int64) (*HookConfig, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks/%v/config", org, id)
+	u := fmt.Sprintf("orgs/%v/hooks/%v/config", PathEscape(org), id)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -37,7 +37,7 @@ func (s *OrganizationsService) GetHookConfiguration(ctx context.Context, org str
 //
 //meta:operation PATCH /orgs/{org}/hooks/{hook_id}/config
 func (s *OrganizationsService) EditHookConfiguration(ctx context.Context, org string, id int64, config *HookConfig) (*HookConfig, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks/%v/config", org, id)
+	u := fmt.Sprintf("orgs/%v/hooks/%v/config", PathEscape(org), id)
 	req, err := s.client.NewRequest("PATCH", u, config)
 	if err != nil {
 		return nil, nil, err
@@ -51,3 +51,30 @@ func (s *OrganizationsService) EditHookConfiguration(ctx context.Context, org st
 
 	return c, resp, nil
 }
+
+// RotateHookSecret replaces the secret of the specified organization webhook
+// with newSecret, leaving the rest of its configuration untouched.
+//
+// It returns the configuration that was in place before the rotation so that
+// the caller can roll back the URL, content type and SSL settings if needed.
+// Note that GitHub never returns the secret itself, so the previous secret
+// must be kept by the caller if it may need to be restored.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/webhooks#get-a-webhook-configuration-for-an-organization
+// GitHub API docs: https://docs.github.com/rest/orgs/webhooks#update-a-webhook-configuration-for-an-organization
+//
+//meta:operation GET /orgs/{org}/hooks/{hook_id}/config
+//meta:operation PATCH /orgs/{org}/hooks/{hook_id}/config
+func (s *OrganizationsService) RotateHookSecret(ctx context.Context, org string, id int64, newSecret string) (*HookConfig, *Response, error) {
+	previous, resp, err := s.GetHookConfiguration(ctx, org, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	_, resp, err = s.EditHookConfiguration(ctx, org, id, &HookConfig{Secret: &newSecret})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return previous, resp, nil
+}
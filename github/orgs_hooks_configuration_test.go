@@ -62,6 +62,8 @@ func TestOrganizationsService_GetHookConfiguration_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.GetHookConfiguration(ctx, "%", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.GetHookConfiguration(ctx, "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_EditHookConfiguration(t *testing.T) {
@@ -120,4 +122,95 @@ func TestOrganizationsService_EditHookConfiguration_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.EditHookConfiguration(ctx, "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.EditHookConfiguration(ctx, "..", 1, nil)
+	testPathForbiddenError(t, err)
+}
+
+func TestOrganizationsService_EditHookConfiguration_onlySendsProvidedKeys(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"content_type":"json"}`+"\n")
+		fmt.Fprint(w, `{"content_type": "json", "insecure_ssl": "0", "secret": "********", "url": "https://example.com/webhook"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Organizations.EditHookConfiguration(ctx, "o", 1, &HookConfig{ContentType: String("json")})
+	if err != nil {
+		t.Errorf("Organizations.EditHookConfiguration returned error: %v", err)
+	}
+}
+
+func TestOrganizationsService_RotateHookSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"content_type": "form", "insecure_ssl": "0", "secret": "********", "url": "https://example.com/old"}`)
+		case "PATCH":
+			testBody(t, r, `{"secret":"new-secret"}`+"\n")
+			fmt.Fprint(w, `{"content_type": "form", "insecure_ssl": "0", "secret": "********", "url": "https://example.com/old"}`)
+		default:
+			t.Errorf("Request method: %v, want GET or PATCH", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	previous, _, err := client.Organizations.RotateHookSecret(ctx, "o", 1, "new-secret")
+	if err != nil {
+		t.Errorf("Organizations.RotateHookSecret returned error: %v", err)
+	}
+
+	want := &HookConfig{
+		ContentType: String("form"),
+		InsecureSSL: String("0"),
+		Secret:      String("********"),
+		URL:         String("https://example.com/old"),
+	}
+	if !cmp.Equal(previous, want) {
+		t.Errorf("Organizations.RotateHookSecret returned %+v, want %+v", previous, want)
+	}
+
+	const methodName = "RotateHookSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.RotateHookSecret(ctx, "\n", -1, "new-secret")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.RotateHookSecret(ctx, "o", 1, "new-secret")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_RotateHookSecret_editFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprint(w, `{"url": "https://example.com/old"}`)
+	})
+
+	ctx := context.Background()
+	previous, resp, err := client.Organizations.RotateHookSecret(ctx, "o", 1, "new-secret")
+	if err == nil {
+		t.Error("Organizations.RotateHookSecret returned nil error, want error")
+	}
+	if previous != nil {
+		t.Errorf("Organizations.RotateHookSecret returned %+v, want nil", previous)
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Organizations.RotateHookSecret returned response %+v, want status 422", resp)
+	}
 }
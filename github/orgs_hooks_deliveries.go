@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /orgs/{org}/hooks/{hook_id}/deliveries
 func (s *OrganizationsService) ListHookDeliveries(ctx context.Context, org string, id int64, opts *ListCursorOptions) ([]*HookDelivery, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks/%v/deliveries", org, id)
+	u := fmt.Sprintf("orgs/%v/hooks/%v/deliveries", PathEscape(org), id)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -42,7 +42,7 @@ func (s *OrganizationsService) ListHookDeliveries(ctx context.Context, org strin
 //
 //meta:operation GET /orgs/{org}/hooks/{hook_id}/deliveries/{delivery_id}
 func (s *OrganizationsService) GetHookDelivery(ctx context.Context, owner string, hookID, deliveryID int64) (*HookDelivery, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks/%v/deliveries/%v", owner, hookID, deliveryID)
+	u := fmt.Sprintf("orgs/%v/hooks/%v/deliveries/%v", PathEscape(owner), hookID, deliveryID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -63,7 +63,7 @@ func (s *OrganizationsService) GetHookDelivery(ctx context.Context, owner string
 //
 //meta:operation POST /orgs/{org}/hooks/{hook_id}/deliveries/{delivery_id}/attempts
 func (s *OrganizationsService) RedeliverHookDelivery(ctx context.Context, owner string, hookID, deliveryID int64) (*HookDelivery, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/hooks/%v/deliveries/%v/attempts", owner, hookID, deliveryID)
+	u := fmt.Sprintf("orgs/%v/hooks/%v/deliveries/%v/attempts", PathEscape(owner), hookID, deliveryID)
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -59,6 +59,8 @@ func TestOrganizationsService_ListHookDeliveries_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.ListHookDeliveries(ctx, "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.ListHookDeliveries(ctx, "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_GetHookDelivery(t *testing.T) {
@@ -103,6 +105,8 @@ func TestOrganizationsService_GetHookDelivery_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.GetHookDelivery(ctx, "%", 1, 1)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.GetHookDelivery(ctx, "..", 1, 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_RedeliverHookDelivery(t *testing.T) {
@@ -147,4 +151,6 @@ func TestOrganizationsService_RedeliverHookDelivery_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.RedeliverHookDelivery(ctx, "%", 1, 1)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.RedeliverHookDelivery(ctx, "..", 1, 1)
+	testPathForbiddenError(t, err)
 }
@@ -53,6 +53,44 @@ func TestOrganizationsService_ListHooks(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_ListUnhealthyHooks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"last_response":{"code":204,"status":"active"}},
+			{"id":2,"last_response":{"code":404,"status":"active","message":"Not Found"}}
+		]`)
+	})
+
+	ctx := context.Background()
+	hooks, _, err := client.Organizations.ListUnhealthyHooks(ctx, "o", nil)
+	if err != nil {
+		t.Errorf("Organizations.ListUnhealthyHooks returned error: %v", err)
+	}
+
+	want := []*Hook{{ID: Int64(2), LastResponse: &HookLastResponse{Code: Int(404), Status: String("active"), Message: String("Not Found")}}}
+	if !cmp.Equal(hooks, want) {
+		t.Errorf("Organizations.ListUnhealthyHooks returned %+v, want %+v", hooks, want)
+	}
+
+	const methodName = "ListUnhealthyHooks"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListUnhealthyHooks(ctx, "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListUnhealthyHooks(ctx, "o", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestOrganizationsService_ListHooks_invalidOrg(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -60,6 +98,8 @@ func TestOrganizationsService_ListHooks_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.ListHooks(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.ListHooks(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_CreateHook(t *testing.T) {
@@ -149,6 +189,8 @@ func TestOrganizationsService_GetHook_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.GetHook(ctx, "%", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.GetHook(ctx, "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_EditHook(t *testing.T) {
@@ -202,6 +244,8 @@ func TestOrganizationsService_EditHook_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.EditHook(ctx, "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.EditHook(ctx, "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_PingHook(t *testing.T) {
@@ -261,4 +305,6 @@ func TestOrganizationsService_DeleteHook_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Organizations.DeleteHook(ctx, "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Organizations.DeleteHook(ctx, "..", 1)
+	testPathForbiddenError(t, err)
 }
@@ -44,6 +44,25 @@ func (m Membership) String() string {
 	return Stringify(m)
 }
 
+// The possible values of Membership.Role for organization memberships.
+const (
+	OrgMembershipRoleAdmin  = "admin"
+	OrgMembershipRoleMember = "member"
+)
+
+// The possible values of Membership.State.
+const (
+	MembershipStateActive  = "active"
+	MembershipStatePending = "pending"
+)
+
+// The possible values of ListMembersOptions.Filter and
+// ListOutsideCollaboratorsOptions.Filter.
+const (
+	MemberFilterAll         = "all"
+	MemberFilter2FADisabled = "2fa_disabled"
+)
+
 // ListMembersOptions specifies optional parameters to the
 // OrganizationsService.ListMembers method.
 type ListMembersOptions struct {
@@ -79,9 +98,9 @@ type ListMembersOptions struct {
 func (s *OrganizationsService) ListMembers(ctx context.Context, org string, opts *ListMembersOptions) ([]*User, *Response, error) {
 	var u string
 	if opts != nil && opts.PublicOnly {
-		u = fmt.Sprintf("orgs/%v/public_members", org)
+		u = fmt.Sprintf("orgs/%v/public_members", PathEscape(org))
 	} else {
-		u = fmt.Sprintf("orgs/%v/members", org)
+		u = fmt.Sprintf("orgs/%v/members", PathEscape(org))
 	}
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -108,7 +127,7 @@ func (s *OrganizationsService) ListMembers(ctx context.Context, org string, opts
 //
 //meta:operation GET /orgs/{org}/members/{username}
 func (s *OrganizationsService) IsMember(ctx context.Context, org, user string) (bool, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/members/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/members/%v", PathEscape(org), PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return false, nil, err
@@ -125,7 +144,7 @@ func (s *OrganizationsService) IsMember(ctx context.Context, org, user string) (
 //
 //meta:operation GET /orgs/{org}/public_members/{username}
 func (s *OrganizationsService) IsPublicMember(ctx context.Context, org, user string) (bool, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/public_members/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/public_members/%v", PathEscape(org), PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return false, nil, err
@@ -142,7 +161,7 @@ func (s *OrganizationsService) IsPublicMember(ctx context.Context, org, user str
 //
 //meta:operation DELETE /orgs/{org}/members/{username}
 func (s *OrganizationsService) RemoveMember(ctx context.Context, org, user string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/members/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/members/%v", PathEscape(org), PathEscape(user))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -158,7 +177,7 @@ func (s *OrganizationsService) RemoveMember(ctx context.Context, org, user strin
 //
 //meta:operation PUT /orgs/{org}/public_members/{username}
 func (s *OrganizationsService) PublicizeMembership(ctx context.Context, org, user string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/public_members/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/public_members/%v", PathEscape(org), PathEscape(user))
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
@@ -173,7 +192,7 @@ func (s *OrganizationsService) PublicizeMembership(ctx context.Context, org, use
 //
 //meta:operation DELETE /orgs/{org}/public_members/{username}
 func (s *OrganizationsService) ConcealMembership(ctx context.Context, org, user string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/public_members/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/public_members/%v", PathEscape(org), PathEscape(user))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -230,9 +249,9 @@ func (s *OrganizationsService) ListOrgMemberships(ctx context.Context, opts *Lis
 func (s *OrganizationsService) GetOrgMembership(ctx context.Context, user, org string) (*Membership, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("orgs/%v/memberships/%v", org, user)
+		u = fmt.Sprintf("orgs/%v/memberships/%v", PathEscape(org), PathEscape(user))
 	} else {
-		u = fmt.Sprintf("user/memberships/orgs/%v", org)
+		u = fmt.Sprintf("user/memberships/orgs/%v", PathEscape(org))
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -251,7 +270,9 @@ func (s *OrganizationsService) GetOrgMembership(ctx context.Context, user, org s
 
 // EditOrgMembership edits the membership for user in specified organization.
 // Passing an empty string for user will edit the membership for the
-// authenticated user.
+// authenticated user. If membership.Role is set, it must be
+// OrgMembershipRoleAdmin or OrgMembershipRoleMember; any other value is
+// rejected without making a request.
 //
 // GitHub API docs: https://docs.github.com/rest/orgs/members#set-organization-membership-for-a-user
 // GitHub API docs: https://docs.github.com/rest/orgs/members#update-an-organization-membership-for-the-authenticated-user
@@ -259,12 +280,20 @@ func (s *OrganizationsService) GetOrgMembership(ctx context.Context, user, org s
 //meta:operation PUT /orgs/{org}/memberships/{username}
 //meta:operation PATCH /user/memberships/orgs/{org}
 func (s *OrganizationsService) EditOrgMembership(ctx context.Context, user, org string, membership *Membership) (*Membership, *Response, error) {
+	if membership != nil && membership.Role != nil {
+		switch role := membership.GetRole(); role {
+		case OrgMembershipRoleAdmin, OrgMembershipRoleMember:
+		default:
+			return nil, nil, fmt.Errorf("invalid organization membership role %q, want %q or %q", role, OrgMembershipRoleAdmin, OrgMembershipRoleMember)
+		}
+	}
+
 	var u, method string
 	if user != "" {
-		u = fmt.Sprintf("orgs/%v/memberships/%v", org, user)
+		u = fmt.Sprintf("orgs/%v/memberships/%v", PathEscape(org), PathEscape(user))
 		method = "PUT"
 	} else {
-		u = fmt.Sprintf("user/memberships/orgs/%v", org)
+		u = fmt.Sprintf("user/memberships/orgs/%v", PathEscape(org))
 		method = "PATCH"
 	}
 
@@ -289,7 +318,7 @@ func (s *OrganizationsService) EditOrgMembership(ctx context.Context, user, org
 //
 //meta:operation DELETE /orgs/{org}/memberships/{username}
 func (s *OrganizationsService) RemoveOrgMembership(ctx context.Context, user, org string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/memberships/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/memberships/%v", PathEscape(org), PathEscape(user))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -304,7 +333,7 @@ func (s *OrganizationsService) RemoveOrgMembership(ctx context.Context, user, or
 //
 //meta:operation GET /orgs/{org}/invitations
 func (s *OrganizationsService) ListPendingOrgInvitations(ctx context.Context, org string, opts *ListOptions) ([]*Invitation, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/invitations", org)
+	u := fmt.Sprintf("orgs/%v/invitations", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -352,7 +381,7 @@ type CreateOrgInvitationOptions struct {
 //
 //meta:operation POST /orgs/{org}/invitations
 func (s *OrganizationsService) CreateOrgInvitation(ctx context.Context, org string, opts *CreateOrgInvitationOptions) (*Invitation, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/invitations", org)
+	u := fmt.Sprintf("orgs/%v/invitations", PathEscape(org))
 
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
@@ -375,7 +404,7 @@ func (s *OrganizationsService) CreateOrgInvitation(ctx context.Context, org stri
 //
 //meta:operation GET /orgs/{org}/invitations/{invitation_id}/teams
 func (s *OrganizationsService) ListOrgInvitationTeams(ctx context.Context, org, invitationID string, opts *ListOptions) ([]*Team, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/invitations/%v/teams", org, invitationID)
+	u := fmt.Sprintf("orgs/%v/invitations/%v/teams", PathEscape(org), PathEscape(invitationID))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -401,7 +430,7 @@ func (s *OrganizationsService) ListOrgInvitationTeams(ctx context.Context, org,
 //
 //meta:operation GET /orgs/{org}/failed_invitations
 func (s *OrganizationsService) ListFailedOrgInvitations(ctx context.Context, org string, opts *ListOptions) ([]*Invitation, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/failed_invitations", org)
+	u := fmt.Sprintf("orgs/%v/failed_invitations", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
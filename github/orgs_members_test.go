@@ -69,6 +69,8 @@ func TestOrganizationsService_ListMembers_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.ListMembers(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.ListMembers(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_ListMembers_public(t *testing.T) {
@@ -174,6 +176,8 @@ func TestOrganizationsService_IsMember_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.IsMember(ctx, "%", "u")
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.IsMember(ctx, "..", "u")
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_IsPublicMember(t *testing.T) {
@@ -257,6 +261,8 @@ func TestOrganizationsService_IsPublicMember_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.IsPublicMember(ctx, "%", "u")
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.IsPublicMember(ctx, "..", "u")
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_RemoveMember(t *testing.T) {
@@ -291,6 +297,8 @@ func TestOrganizationsService_RemoveMember_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Organizations.RemoveMember(ctx, "%", "u")
 	testURLParseError(t, err)
+	_, err = client.Organizations.RemoveMember(ctx, "..", "u")
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_PublicizeMembership(t *testing.T) {
@@ -511,6 +519,38 @@ func TestOrganizationsService_EditOrgMembership_SpecifiedUser(t *testing.T) {
 	}
 }
 
+func TestOrganizationsService_EditOrgMembership_role(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/memberships/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"role":"admin"}`+"\n")
+		fmt.Fprint(w, `{"state":"pending","role":"admin"}`)
+	})
+
+	ctx := context.Background()
+	membership, _, err := client.Organizations.EditOrgMembership(ctx, "u", "o", &Membership{Role: String(OrgMembershipRoleAdmin)})
+	if err != nil {
+		t.Errorf("Organizations.EditOrgMembership returned error: %v", err)
+	}
+
+	want := &Membership{State: String(MembershipStatePending), Role: String(OrgMembershipRoleAdmin)}
+	if !cmp.Equal(membership, want) {
+		t.Errorf("Organizations.EditOrgMembership returned %+v, want %+v", membership, want)
+	}
+
+	for _, role := range []string{"", "maintainer", "owner"} {
+		_, resp, err := client.Organizations.EditOrgMembership(ctx, "u", "o", &Membership{Role: String(role)})
+		if err == nil {
+			t.Errorf("Organizations.EditOrgMembership with role %q returned nil error, want error", role)
+		}
+		if resp != nil {
+			t.Errorf("Organizations.EditOrgMembership with role %q made a request, want none", role)
+		}
+	}
+}
+
 func TestOrganizationsService_RemoveOrgMembership(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
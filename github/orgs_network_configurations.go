@@ -0,0 +1,186 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// The possible values of NetworkConfiguration.ComputeService.
+const (
+	ComputeServiceNone       = "none"
+	ComputeServiceActions    = "actions"
+	ComputeServiceCodespaces = "codespaces"
+)
+
+// NetworkConfiguration represents a hosted compute network configuration,
+// which lets GitHub-hosted compute, such as Actions hosted runners, use the
+// private network described by its network settings.
+type NetworkConfiguration struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	// ComputeService is the hosted compute service using the configuration,
+	// one of the ComputeService constants.
+	ComputeService     *string    `json:"compute_service,omitempty"`
+	NetworkSettingsIDs []string   `json:"network_settings_ids,omitempty"`
+	CreatedOn          *Timestamp `json:"created_on,omitempty"`
+}
+
+// NetworkConfigurations represents a list of hosted compute network
+// configurations.
+type NetworkConfigurations struct {
+	TotalCount            *int64                  `json:"total_count,omitempty"`
+	NetworkConfigurations []*NetworkConfiguration `json:"network_configurations,omitempty"`
+}
+
+// NetworkConfigurationRequest represents a request to create or update a
+// hosted compute network configuration.
+type NetworkConfigurationRequest struct {
+	Name *string `json:"name,omitempty"`
+	// ComputeService is one of the ComputeService constants. GitHub defaults
+	// to ComputeServiceNone on creation.
+	ComputeService *string `json:"compute_service,omitempty"`
+	// NetworkSettingsIDs are the IDs of the network settings to use. They are
+	// required on creation, and GitHub only supports one.
+	NetworkSettingsIDs []string `json:"network_settings_ids,omitempty"`
+}
+
+// NetworkSettings represents a hosted compute network settings resource,
+// which describes an Azure subnet that hosted compute can be attached to.
+type NetworkSettings struct {
+	ID                     *string `json:"id,omitempty"`
+	NetworkConfigurationID *string `json:"network_configuration_id,omitempty"`
+	Name                   *string `json:"name,omitempty"`
+	SubnetID               *string `json:"subnet_id,omitempty"`
+	Region                 *string `json:"region,omitempty"`
+}
+
+// ListNetworkConfigurations lists the hosted compute network configurations of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/network-configurations#list-hosted-compute-network-configurations-for-an-organization
+//
+//meta:operation GET /orgs/{org}/settings/network-configurations
+func (s *OrganizationsService) ListNetworkConfigurations(ctx context.Context, org string, opts *ListOptions) (*NetworkConfigurations, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations", PathEscape(org))
+	return listNetworkConfigurations(ctx, s.client, u, opts)
+}
+
+// CreateNetworkConfiguration creates a hosted compute network configuration for an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/network-configurations#create-a-hosted-compute-network-configuration-for-an-organization
+//
+//meta:operation POST /orgs/{org}/settings/network-configurations
+func (s *OrganizationsService) CreateNetworkConfiguration(ctx context.Context, org string, config *NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations", PathEscape(org))
+	return doNetworkConfiguration(ctx, s.client, "POST", u, config)
+}
+
+// GetNetworkConfiguration gets a hosted compute network configuration of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/network-configurations#get-a-hosted-compute-network-configuration-for-an-organization
+//
+//meta:operation GET /orgs/{org}/settings/network-configurations/{network_configuration_id}
+func (s *OrganizationsService) GetNetworkConfiguration(ctx context.Context, org, networkConfigurationID string) (*NetworkConfiguration, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations/%v", PathEscape(org), PathEscape(networkConfigurationID))
+	return doNetworkConfiguration(ctx, s.client, "GET", u, nil)
+}
+
+// UpdateNetworkConfiguration updates a hosted compute network configuration of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/network-configurations#update-a-hosted-compute-network-configuration-for-an-organization
+//
+//meta:operation PATCH /orgs/{org}/settings/network-configurations/{network_configuration_id}
+func (s *OrganizationsService) UpdateNetworkConfiguration(ctx context.Context, org, networkConfigurationID string, config *NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations/%v", PathEscape(org), PathEscape(networkConfigurationID))
+	return doNetworkConfiguration(ctx, s.client, "PATCH", u, config)
+}
+
+// DeleteNetworkConfiguration deletes a hosted compute network configuration
+// of an organization. GitHub responds with 409 Conflict if the configuration
+// is still in use, for example by a hosted runner group; that error is
+// returned as is.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/network-configurations#delete-a-hosted-compute-network-configuration-from-an-organization
+//
+//meta:operation DELETE /orgs/{org}/settings/network-configurations/{network_configuration_id}
+func (s *OrganizationsService) DeleteNetworkConfiguration(ctx context.Context, org, networkConfigurationID string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations/%v", PathEscape(org), PathEscape(networkConfigurationID))
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetNetworkSettings gets a hosted compute network settings resource of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/network-configurations#get-a-hosted-compute-network-settings-resource-for-an-organization
+//
+//meta:operation GET /orgs/{org}/settings/network-settings/{network_settings_id}
+func (s *OrganizationsService) GetNetworkSettings(ctx context.Context, org, networkSettingsID string) (*NetworkSettings, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-settings/%v", PathEscape(org), PathEscape(networkSettingsID))
+	return getNetworkSettings(ctx, s.client, u)
+}
+
+// listNetworkConfigurations, doNetworkConfiguration and getNetworkSettings
+// are shared by the organization and enterprise methods, whose endpoints only
+// differ in their path.
+
+func listNetworkConfigurations(ctx context.Context, client *Client, u string, opts *ListOptions) (*NetworkConfigurations, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configs := new(NetworkConfigurations)
+	resp, err := client.Do(ctx, req, configs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return configs, resp, nil
+}
+
+func doNetworkConfiguration(ctx context.Context, client *Client, method, u string, config *NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error) {
+	var body interface{}
+	if config != nil {
+		body = config
+	}
+	req, err := client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(NetworkConfiguration)
+	resp, err := client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+func getNetworkSettings(ctx context.Context, client *Client, u string) (*NetworkSettings, *Response, error) {
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(NetworkSettings)
+	resp, err := client.Do(ctx, req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
@@ -0,0 +1,318 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_ListNetworkConfigurations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "per_page": "1"})
+		fmt.Fprint(w, `{
+			"total_count": 2,
+			"network_configurations": [{
+				"id": "123456789ABCDEF",
+				"name": "My network configuration",
+				"compute_service": "actions",
+				"network_settings_ids": ["23456789ABDCEF1"],
+				"created_on": "2006-01-02T15:04:05Z"
+			}]
+		}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 1}
+	ctx := context.Background()
+	configs, _, err := client.Organizations.ListNetworkConfigurations(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListNetworkConfigurations returned error: %v", err)
+	}
+
+	want := &NetworkConfigurations{
+		TotalCount: Int64(2),
+		NetworkConfigurations: []*NetworkConfiguration{{
+			ID:                 String("123456789ABCDEF"),
+			Name:               String("My network configuration"),
+			ComputeService:     String(ComputeServiceActions),
+			NetworkSettingsIDs: []string{"23456789ABDCEF1"},
+			CreatedOn:          &Timestamp{referenceTime},
+		}},
+	}
+	if !cmp.Equal(configs, want) {
+		t.Errorf("Organizations.ListNetworkConfigurations returned %+v, want %+v", configs, want)
+	}
+
+	const methodName = "ListNetworkConfigurations"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListNetworkConfigurations(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListNetworkConfigurations(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"n","compute_service":"actions","network_settings_ids":["s"]}`+"\n")
+		fmt.Fprint(w, `{"id": "c", "name": "n", "compute_service": "actions", "network_settings_ids": ["s"]}`)
+	})
+
+	config := &NetworkConfigurationRequest{
+		Name:               String("n"),
+		ComputeService:     String(ComputeServiceActions),
+		NetworkSettingsIDs: []string{"s"},
+	}
+	ctx := context.Background()
+	got, _, err := client.Organizations.CreateNetworkConfiguration(ctx, "o", config)
+	if err != nil {
+		t.Errorf("Organizations.CreateNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("c"), Name: String("n"), ComputeService: String(ComputeServiceActions), NetworkSettingsIDs: []string{"s"}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Organizations.CreateNetworkConfiguration returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "CreateNetworkConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.CreateNetworkConfiguration(ctx, "\n", config)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.CreateNetworkConfiguration(ctx, "o", config)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": "c", "compute_service": "none"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Organizations.GetNetworkConfiguration(ctx, "o", "c")
+	if err != nil {
+		t.Errorf("Organizations.GetNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("c"), ComputeService: String(ComputeServiceNone)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Organizations.GetNetworkConfiguration returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetNetworkConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetNetworkConfiguration(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetNetworkConfiguration(ctx, "o", "c")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_UpdateNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"compute_service":"none"}`+"\n")
+		fmt.Fprint(w, `{"id": "c", "compute_service": "none"}`)
+	})
+
+	config := &NetworkConfigurationRequest{ComputeService: String(ComputeServiceNone)}
+	ctx := context.Background()
+	got, _, err := client.Organizations.UpdateNetworkConfiguration(ctx, "o", "c", config)
+	if err != nil {
+		t.Errorf("Organizations.UpdateNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("c"), ComputeService: String(ComputeServiceNone)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Organizations.UpdateNetworkConfiguration returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "UpdateNetworkConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.UpdateNetworkConfiguration(ctx, "\n", "\n", config)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.UpdateNetworkConfiguration(ctx, "o", "c", config)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_DeleteNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.DeleteNetworkConfiguration(ctx, "o", "c"); err != nil {
+		t.Errorf("Organizations.DeleteNetworkConfiguration returned error: %v", err)
+	}
+
+	const methodName = "DeleteNetworkConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.DeleteNetworkConfiguration(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.DeleteNetworkConfiguration(ctx, "o", "c")
+	})
+}
+
+func TestOrganizationsService_DeleteNetworkConfiguration_inUse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message": "Network configuration is in use by a runner group."}`)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Organizations.DeleteNetworkConfiguration(ctx, "o", "c")
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Message != "Network configuration is in use by a runner group." {
+		t.Fatalf("Organizations.DeleteNetworkConfiguration returned error %v, want the conflict", err)
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("Organizations.DeleteNetworkConfiguration returned status %v, want %v", resp.StatusCode, http.StatusConflict)
+	}
+}
+
+func TestOrganizationsService_GetNetworkSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-settings/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": "220F78DACB92BBFBC5E6F22DE1CCF52309D",
+			"network_configuration_id": "934E208B3EE0BD60CF5F752C426BFB53562",
+			"name": "my_network_settings",
+			"subnet_id": "/subscriptions/14839728-3ad9-43ab-bd2b-fa6ad0f75e2a/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-subnet",
+			"region": "eastus"
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Organizations.GetNetworkSettings(ctx, "o", "s")
+	if err != nil {
+		t.Errorf("Organizations.GetNetworkSettings returned error: %v", err)
+	}
+
+	want := &NetworkSettings{
+		ID:                     String("220F78DACB92BBFBC5E6F22DE1CCF52309D"),
+		NetworkConfigurationID: String("934E208B3EE0BD60CF5F752C426BFB53562"),
+		Name:                   String("my_network_settings"),
+		SubnetID:               String("/subscriptions/14839728-3ad9-43ab-bd2b-fa6ad0f75e2a/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-subnet"),
+		Region:                 String("eastus"),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Organizations.GetNetworkSettings returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetNetworkSettings"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetNetworkSettings(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetNetworkSettings(ctx, "o", "s")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestNetworkConfiguration_computeService(t *testing.T) {
+	for _, service := range []string{ComputeServiceNone, ComputeServiceActions, ComputeServiceCodespaces} {
+		t.Run(service, func(t *testing.T) {
+			data := fmt.Sprintf(`{"compute_service":%q}`, service)
+
+			var config NetworkConfiguration
+			if err := json.Unmarshal([]byte(data), &config); err != nil {
+				t.Fatalf("json.Unmarshal returned error: %v", err)
+			}
+			if got := config.GetComputeService(); got != service {
+				t.Errorf("ComputeService = %q, want %q", got, service)
+			}
+
+			testJSONMarshal(t, &NetworkConfigurationRequest{ComputeService: String(service)}, data)
+		})
+	}
+}
+
+func TestNetworkConfiguration_Marshal(t *testing.T) {
+	testJSONMarshal(t, &NetworkConfiguration{}, "{}")
+
+	u := &NetworkConfiguration{
+		ID:                 String("c"),
+		Name:               String("n"),
+		ComputeService:     String(ComputeServiceActions),
+		NetworkSettingsIDs: []string{"s"},
+		CreatedOn:          &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"id": "c",
+		"name": "n",
+		"compute_service": "actions",
+		"network_settings_ids": ["s"],
+		"created_on": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -7,14 +7,15 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
 // ListOutsideCollaboratorsOptions specifies optional parameters to the
 // OrganizationsService.ListOutsideCollaborators method.
 type ListOutsideCollaboratorsOptions struct {
-	// Filter outside collaborators returned in the list. Possible values are:
-	// 2fa_disabled, all.  Default is "all".
+	// Filter outside collaborators returned in the list. Possible values are
+	// MemberFilter2FADisabled and MemberFilterAll. Default is "all".
 	Filter string `url:"filter,omitempty"`
 
 	ListOptions
@@ -31,7 +32,7 @@ type ListOutsideCollaboratorsOptions struct {
 //
 //meta:operation GET /orgs/{org}/outside_collaborators
 func (s *OrganizationsService) ListOutsideCollaborators(ctx context.Context, org string, opts *ListOutsideCollaboratorsOptions) ([]*User, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/outside_collaborators", org)
+	u := fmt.Sprintf("orgs/%v/outside_collaborators", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -58,7 +59,7 @@ func (s *OrganizationsService) ListOutsideCollaborators(ctx context.Context, org
 //
 //meta:operation DELETE /orgs/{org}/outside_collaborators/{username}
 func (s *OrganizationsService) RemoveOutsideCollaborator(ctx context.Context, org string, user string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/outside_collaborators/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/outside_collaborators/%v", PathEscape(org), PathEscape(user))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -67,21 +68,46 @@ func (s *OrganizationsService) RemoveOutsideCollaborator(ctx context.Context, or
 	return s.client.Do(ctx, req, nil)
 }
 
+// ConvertMemberToOutsideCollaboratorOptions specifies the optional parameters
+// to the OrganizationsService.ConvertMemberToOutsideCollaborator method.
+type ConvertMemberToOutsideCollaboratorOptions struct {
+	// Async, if true, asks GitHub to queue the conversion and respond
+	// immediately instead of waiting for it to complete.
+	Async bool `json:"async,omitempty"`
+}
+
 // ConvertMemberToOutsideCollaborator reduces the permission level of a member of the
 // organization to that of an outside collaborator. Therefore, they will only
 // have access to the repositories that their current team membership allows.
 // Responses for converting a non-member or the last owner to an outside collaborator
 // are listed in GitHub API docs.
 //
+// The returned bool reports whether the conversion was queued (202 Accepted)
+// rather than completed before GitHub responded (204 No Content). GitHub only
+// queues the conversion when opts.Async is true.
+//
 // GitHub API docs: https://docs.github.com/rest/orgs/outside-collaborators#convert-an-organization-member-to-outside-collaborator
 //
 //meta:operation PUT /orgs/{org}/outside_collaborators/{username}
-func (s *OrganizationsService) ConvertMemberToOutsideCollaborator(ctx context.Context, org string, user string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/outside_collaborators/%v", org, user)
-	req, err := s.client.NewRequest("PUT", u, nil)
+func (s *OrganizationsService) ConvertMemberToOutsideCollaborator(ctx context.Context, org string, user string, opts *ConvertMemberToOutsideCollaboratorOptions) (bool, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/outside_collaborators/%v", PathEscape(org), PathEscape(user))
+	var body interface{}
+	if opts != nil {
+		body = opts
+	}
+	req, err := s.client.NewRequest("PUT", u, body)
 	if err != nil {
-		return nil, err
+		return false, nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		var acceptedError *AcceptedError
+		if errors.As(err, &acceptedError) {
+			return true, resp, nil
+		}
+		return false, resp, err
+	}
+
+	return false, resp, nil
 }
@@ -28,7 +28,7 @@ func TestOrganizationsService_ListOutsideCollaborators(t *testing.T) {
 	})
 
 	opt := &ListOutsideCollaboratorsOptions{
-		Filter:      "2fa_disabled",
+		Filter:      MemberFilter2FADisabled,
 		ListOptions: ListOptions{Page: 2},
 	}
 	ctx := context.Background()
@@ -64,6 +64,8 @@ func TestOrganizationsService_ListOutsideCollaborators_invalidOrg(t *testing.T)
 	ctx := context.Background()
 	_, _, err := client.Organizations.ListOutsideCollaborators(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.ListOutsideCollaborators(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_RemoveOutsideCollaborator(t *testing.T) {
@@ -136,24 +138,61 @@ func TestOrganizationsService_ConvertMemberToOutsideCollaborator(t *testing.T) {
 
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
+		testBody(t, r, "")
+		w.WriteHeader(http.StatusNoContent)
 	}
 	mux.HandleFunc("/orgs/o/outside_collaborators/u", handler)
 
 	ctx := context.Background()
-	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u")
+	queued, resp, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u", nil)
 	if err != nil {
 		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned error: %v", err)
 	}
+	if queued {
+		t.Error("Organizations.ConvertMemberToOutsideCollaborator returned queued = true, want false")
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
 
 	const methodName = "ConvertMemberToOutsideCollaborator"
 	testBadOptions(t, methodName, func() (err error) {
-		_, err = client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "\n", "\n")
+		_, _, err = client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "\n", "\n", nil)
 		return err
 	})
 
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		return client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u")
+		got, resp, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u", nil)
+		if got {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want false", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ConvertMemberToOutsideCollaborator_async(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/outside_collaborators/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"async":true}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
 	})
+
+	ctx := context.Background()
+	opts := &ConvertMemberToOutsideCollaboratorOptions{Async: true}
+	queued, resp, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u", opts)
+	if err != nil {
+		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned error: %v", err)
+	}
+	if !queued {
+		t.Error("Organizations.ConvertMemberToOutsideCollaborator returned queued = false, want true")
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned status %v, want %v", resp.StatusCode, http.StatusAccepted)
+	}
 }
 
 func TestOrganizationsService_ConvertMemberToOutsideCollaborator_NonMemberOrLastOwner(t *testing.T) {
@@ -167,7 +206,7 @@ func TestOrganizationsService_ConvertMemberToOutsideCollaborator_NonMemberOrLast
 	mux.HandleFunc("/orgs/o/outside_collaborators/u", handler)
 
 	ctx := context.Background()
-	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u")
+	_, _, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u", nil)
 	if err, ok := err.(*ErrorResponse); !ok {
 		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator did not return an error")
 	} else if err.Response.StatusCode != http.StatusForbidden {
@@ -7,10 +7,103 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 )
 
+// PersonalAccessToken represents a fine-grained personal access token approved
+// to access the resources of an organization.
+type PersonalAccessToken struct {
+	// Unique identifier of the access grant of the token. Used as the pat_id
+	// parameter of the API.
+	ID    *int64 `json:"id,omitempty"`
+	Owner *User  `json:"owner,omitempty"`
+
+	// Type of repository selection granted. Possible values are:
+	// "none", "all" or "subset"
+	RepositorySelection *string `json:"repository_selection,omitempty"`
+
+	// URL of the list of repositories the token can access.
+	RepositoriesURL *string `json:"repositories_url,omitempty"`
+
+	// Permissions granted, categorized by type of permission.
+	Permissions *PersonalAccessTokenPermissions `json:"permissions,omitempty"`
+
+	// Date and time when the token was granted access to the organization.
+	AccessGrantedAt *Timestamp `json:"access_granted_at,omitempty"`
+
+	// Unique identifier and name of the token.
+	TokenID   *int64  `json:"token_id,omitempty"`
+	TokenName *string `json:"token_name,omitempty"`
+
+	// Whether the token has expired.
+	TokenExpired *bool `json:"token_expired,omitempty"`
+
+	// Date and time when the token expires.
+	TokenExpiresAt *Timestamp `json:"token_expires_at,omitempty"`
+
+	// Date and time when the token was last used for authentication.
+	TokenLastUsedAt *Timestamp `json:"token_last_used_at,omitempty"`
+}
+
+// ListFineGrainedPersonalAccessTokenOptions specifies the optional parameters
+// to the OrganizationsService.ListPersonalAccessTokenRequests and
+// OrganizationsService.ListPersonalAccessTokens methods.
+type ListFineGrainedPersonalAccessTokenOptions struct {
+	// Sort specifies how to sort the results. Only "created_at" is supported.
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort the results. Possible values are: asc, desc.
+	Direction string `url:"direction,omitempty"`
+
+	// Owner limits the results to tokens owned by these users, up to 10.
+	Owner []string `url:"owner,omitempty"`
+
+	// Repository limits the results to tokens with access to this repository.
+	Repository string `url:"repository,omitempty"`
+
+	// Permission limits the results to tokens with this permission, such as
+	// "issues" or "issues:read".
+	Permission string `url:"permission,omitempty"`
+
+	// LastUsedBefore and LastUsedAfter limit the results to tokens last used
+	// before or after a time, in ISO 8601 format.
+	LastUsedBefore string `url:"last_used_before,omitempty"`
+	LastUsedAfter  string `url:"last_used_after,omitempty"`
+
+	ListOptions
+}
+
+// ListPersonalAccessTokenRequests lists the pending requests to access the
+// resources of an organization with fine-grained personal access tokens.
+// Only GitHub Apps can call this API, using the
+// `organization_personal_access_token_requests: read` permission.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/personal-access-tokens#list-requests-to-access-organization-resources-with-fine-grained-personal-access-tokens
+//
+//meta:operation GET /orgs/{org}/personal-access-token-requests
+func (s *OrganizationsService) ListPersonalAccessTokenRequests(ctx context.Context, org string, opts *ListFineGrainedPersonalAccessTokenOptions) ([]*PersonalAccessTokenRequest, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests", PathEscape(org))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*PersonalAccessTokenRequest
+	resp, err := s.client.Do(ctx, req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
+
 // ReviewPersonalAccessTokenRequestOptions specifies the parameters to the ReviewPersonalAccessTokenRequest method.
 type ReviewPersonalAccessTokenRequestOptions struct {
 	Action string  `json:"action"`
@@ -25,7 +118,7 @@ type ReviewPersonalAccessTokenRequestOptions struct {
 //
 //meta:operation POST /orgs/{org}/personal-access-token-requests/{pat_request_id}
 func (s *OrganizationsService) ReviewPersonalAccessTokenRequest(ctx context.Context, org string, requestID int64, opts ReviewPersonalAccessTokenRequestOptions) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/personal-access-token-requests/%v", org, requestID)
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests/%v", PathEscape(org), requestID)
 
 	req, err := s.client.NewRequest(http.MethodPost, u, &opts)
 	if err != nil {
@@ -34,3 +127,169 @@ func (s *OrganizationsService) ReviewPersonalAccessTokenRequest(ctx context.Cont
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// reviewPersonalAccessTokenRequestsRequest represents the body of a request
+// reviewing several requests at once.
+type reviewPersonalAccessTokenRequestsRequest struct {
+	RequestIDs []int64 `json:"pat_request_ids"`
+	ReviewPersonalAccessTokenRequestOptions
+}
+
+// ReviewPersonalAccessTokenRequests approves or denies several pending
+// requests to access organization resources via fine-grained personal access
+// tokens, with the same action and reason. The API processes the reviews
+// asynchronously, so a 202 Accepted response isn't reported as an error.
+// Only GitHub Apps can call this API, using the `organization_personal_access_token_requests: write` permission.
+// `action` can be one of `approve` or `deny`.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/personal-access-tokens#review-requests-to-access-organization-resources-with-fine-grained-personal-access-tokens
+//
+//meta:operation POST /orgs/{org}/personal-access-token-requests
+func (s *OrganizationsService) ReviewPersonalAccessTokenRequests(ctx context.Context, org string, requestIDs []int64, opts ReviewPersonalAccessTokenRequestOptions) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests", PathEscape(org))
+
+	body := &reviewPersonalAccessTokenRequestsRequest{
+		RequestIDs:                              requestIDs,
+		ReviewPersonalAccessTokenRequestOptions: opts,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doAccepted(ctx, s.client, req)
+}
+
+// ListPersonalAccessTokenRequestRepositories lists the repositories a
+// fine-grained personal access token is requesting access to.
+// Only GitHub Apps can call this API, using the
+// `organization_personal_access_token_requests: read` permission.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/personal-access-tokens#list-repositories-requested-to-be-accessed-by-a-fine-grained-personal-access-token
+//
+//meta:operation GET /orgs/{org}/personal-access-token-requests/{pat_request_id}/repositories
+func (s *OrganizationsService) ListPersonalAccessTokenRequestRepositories(ctx context.Context, org string, requestID int64, opts *ListOptions) ([]*Repository, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests/%v/repositories", PathEscape(org), requestID)
+	return s.listPersonalAccessTokenRepositories(ctx, u, opts)
+}
+
+// ListPersonalAccessTokens lists the fine-grained personal access tokens
+// approved to access the resources of an organization.
+// Only GitHub Apps can call this API, using the
+// `organization_personal_access_tokens: read` permission.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/personal-access-tokens#list-fine-grained-personal-access-tokens-with-access-to-organization-resources
+//
+//meta:operation GET /orgs/{org}/personal-access-tokens
+func (s *OrganizationsService) ListPersonalAccessTokens(ctx context.Context, org string, opts *ListFineGrainedPersonalAccessTokenOptions) ([]*PersonalAccessToken, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens", PathEscape(org))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tokens []*PersonalAccessToken
+	resp, err := s.client.Do(ctx, req, &tokens)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tokens, resp, nil
+}
+
+// revokePersonalAccessTokensRequest represents the body of a request revoking
+// the access of fine-grained personal access tokens.
+type revokePersonalAccessTokensRequest struct {
+	Action string  `json:"action"`
+	IDs    []int64 `json:"pat_ids,omitempty"`
+}
+
+// RevokePersonalAccessToken revokes the access of a fine-grained personal
+// access token to the resources of an organization. id is the ID of the
+// PersonalAccessToken.
+// Only GitHub Apps can call this API, using the
+// `organization_personal_access_tokens: write` permission.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/personal-access-tokens#update-the-access-a-fine-grained-personal-access-token-has-to-organization-resources
+//
+//meta:operation POST /orgs/{org}/personal-access-tokens/{pat_id}
+func (s *OrganizationsService) RevokePersonalAccessToken(ctx context.Context, org string, id int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens/%v", PathEscape(org), id)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, &revokePersonalAccessTokensRequest{Action: "revoke"})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RevokePersonalAccessTokens revokes the access of several fine-grained
+// personal access tokens to the resources of an organization. The API
+// processes the revocations asynchronously, so a 202 Accepted response isn't
+// reported as an error.
+// Only GitHub Apps can call this API, using the
+// `organization_personal_access_tokens: write` permission.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/personal-access-tokens#update-the-access-to-organization-resources-via-fine-grained-personal-access-tokens
+//
+//meta:operation POST /orgs/{org}/personal-access-tokens
+func (s *OrganizationsService) RevokePersonalAccessTokens(ctx context.Context, org string, ids []int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens", PathEscape(org))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, &revokePersonalAccessTokensRequest{Action: "revoke", IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return doAccepted(ctx, s.client, req)
+}
+
+// doAccepted sends req, for an API that responds with 202 Accepted once it has
+// scheduled the work, and doesn't report that as an *AcceptedError.
+func doAccepted(ctx context.Context, client *Client, req *http.Request) (*Response, error) {
+	resp, err := client.Do(ctx, req, nil)
+	var acceptedError *AcceptedError
+	if errors.As(err, &acceptedError) {
+		return resp, nil
+	}
+	return resp, err
+}
+
+// ListPersonalAccessTokenRepositories lists the repositories a fine-grained
+// personal access token can access. id is the ID of the PersonalAccessToken.
+// Only GitHub Apps can call this API, using the
+// `organization_personal_access_tokens: read` permission.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/personal-access-tokens#list-repositories-a-fine-grained-personal-access-token-has-access-to
+//
+//meta:operation GET /orgs/{org}/personal-access-tokens/{pat_id}/repositories
+func (s *OrganizationsService) ListPersonalAccessTokenRepositories(ctx context.Context, org string, id int64, opts *ListOptions) ([]*Repository, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens/%v/repositories", PathEscape(org), id)
+	return s.listPersonalAccessTokenRepositories(ctx, u, opts)
+}
+
+func (s *OrganizationsService) listPersonalAccessTokenRepositories(ctx context.Context, u string, opts *ListOptions) ([]*Repository, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var repos []*Repository
+	resp, err := s.client.Do(ctx, req, &repos)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repos, resp, nil
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -56,6 +57,310 @@ func TestOrganizationsService_ReviewPersonalAccessTokenRequest(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_ListPersonalAccessTokenRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.RawQuery, "direction=desc&owner=a&owner=b&page=2&permission=issues%3Aread&sort=created_at"; got != want {
+			t.Errorf("Request query is %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `[{
+			"id": 25381,
+			"reason": "I need access",
+			"owner": {"login": "octocat"},
+			"repository_selection": "subset",
+			"repositories_url": "https://api.github.com/organizations/652551/personal-access-token-requests/25381/repositories",
+			"permissions": {"repository": {"issues": "read"}},
+			"created_at": `+referenceTimeStr+`,
+			"token_id": 98716,
+			"token_name": "ci",
+			"token_expired": false,
+			"token_expires_at": `+referenceTimeStr+`,
+			"token_last_used_at": null
+		}]`)
+	})
+
+	opts := &ListFineGrainedPersonalAccessTokenOptions{
+		Sort:        "created_at",
+		Direction:   "desc",
+		Owner:       []string{"a", "b"},
+		Permission:  "issues:read",
+		ListOptions: ListOptions{Page: 2},
+	}
+	ctx := context.Background()
+	requests, _, err := client.Organizations.ListPersonalAccessTokenRequests(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListPersonalAccessTokenRequests returned error: %v", err)
+	}
+
+	want := []*PersonalAccessTokenRequest{{
+		ID:                  Int64(25381),
+		Reason:              String("I need access"),
+		Owner:               &User{Login: String("octocat")},
+		RepositorySelection: String("subset"),
+		RepositoriesURL:     String("https://api.github.com/organizations/652551/personal-access-token-requests/25381/repositories"),
+		Permissions:         &PersonalAccessTokenPermissions{Repo: map[string]string{"issues": "read"}},
+		CreatedAt:           &Timestamp{referenceTime},
+		TokenID:             Int64(98716),
+		TokenName:           String("ci"),
+		TokenExpired:        Bool(false),
+		TokenExpiresAt:      &Timestamp{referenceTime},
+	}}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("Organizations.ListPersonalAccessTokenRequests returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListPersonalAccessTokenRequests"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListPersonalAccessTokenRequests(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListPersonalAccessTokenRequests(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ReviewPersonalAccessTokenRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"pat_request_ids":[1,2,3],"action":"approve","reason":"ok"}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	opts := ReviewPersonalAccessTokenRequestOptions{Action: "approve", Reason: String("ok")}
+	res, err := client.Organizations.ReviewPersonalAccessTokenRequests(ctx, "o", []int64{1, 2, 3}, opts)
+	if err != nil {
+		t.Errorf("Organizations.ReviewPersonalAccessTokenRequests returned error: %v", err)
+	}
+	if res.StatusCode != http.StatusAccepted {
+		t.Errorf("Organizations.ReviewPersonalAccessTokenRequests returned %v, want %v", res.StatusCode, http.StatusAccepted)
+	}
+
+	const methodName = "ReviewPersonalAccessTokenRequests"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.ReviewPersonalAccessTokenRequests(ctx, "\n", []int64{1}, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.ReviewPersonalAccessTokenRequests(ctx, "o", []int64{1}, opts)
+	})
+}
+
+func TestOrganizationsService_ListPersonalAccessTokenRequestRepositories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests/1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `[{"id":1,"full_name":"o/r"}]`)
+	})
+
+	ctx := context.Background()
+	repos, _, err := client.Organizations.ListPersonalAccessTokenRequestRepositories(ctx, "o", 1, &ListOptions{PerPage: 1})
+	if err != nil {
+		t.Errorf("Organizations.ListPersonalAccessTokenRequestRepositories returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Int64(1), FullName: String("o/r")}}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Organizations.ListPersonalAccessTokenRequestRepositories returned %+v, want %+v", repos, want)
+	}
+
+	const methodName = "ListPersonalAccessTokenRequestRepositories"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListPersonalAccessTokenRequestRepositories(ctx, "\n", 1, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListPersonalAccessTokenRequestRepositories(ctx, "o", 1, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ListPersonalAccessTokens(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"repository": "r", "last_used_before": "2024-01-01T00:00:00Z"})
+		fmt.Fprint(w, `[{
+			"id": 25381,
+			"owner": {"login": "octocat"},
+			"repository_selection": "all",
+			"repositories_url": "https://api.github.com/organizations/652551/personal-access-tokens/25381/repositories",
+			"permissions": {"organization": {"members": "read"}, "repository": {"metadata": "read"}},
+			"access_granted_at": `+referenceTimeStr+`,
+			"token_id": 98716,
+			"token_name": "ci",
+			"token_expired": true,
+			"token_expires_at": `+referenceTimeStr+`,
+			"token_last_used_at": `+referenceTimeStr+`
+		}]`)
+	})
+
+	opts := &ListFineGrainedPersonalAccessTokenOptions{Repository: "r", LastUsedBefore: "2024-01-01T00:00:00Z"}
+	ctx := context.Background()
+	tokens, _, err := client.Organizations.ListPersonalAccessTokens(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListPersonalAccessTokens returned error: %v", err)
+	}
+
+	want := []*PersonalAccessToken{{
+		ID:                  Int64(25381),
+		Owner:               &User{Login: String("octocat")},
+		RepositorySelection: String("all"),
+		RepositoriesURL:     String("https://api.github.com/organizations/652551/personal-access-tokens/25381/repositories"),
+		Permissions: &PersonalAccessTokenPermissions{
+			Org:  map[string]string{"members": "read"},
+			Repo: map[string]string{"metadata": "read"},
+		},
+		AccessGrantedAt: &Timestamp{referenceTime},
+		TokenID:         Int64(98716),
+		TokenName:       String("ci"),
+		TokenExpired:    Bool(true),
+		TokenExpiresAt:  &Timestamp{referenceTime},
+		TokenLastUsedAt: &Timestamp{referenceTime},
+	}}
+	if !cmp.Equal(tokens, want) {
+		t.Errorf("Organizations.ListPersonalAccessTokens returned %+v, want %+v", tokens, want)
+	}
+
+	const methodName = "ListPersonalAccessTokens"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListPersonalAccessTokens(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListPersonalAccessTokens(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_RevokePersonalAccessToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"revoke"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.RevokePersonalAccessToken(ctx, "o", 1); err != nil {
+		t.Errorf("Organizations.RevokePersonalAccessToken returned error: %v", err)
+	}
+
+	const methodName = "RevokePersonalAccessToken"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RevokePersonalAccessToken(ctx, "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RevokePersonalAccessToken(ctx, "o", 1)
+	})
+}
+
+func TestOrganizationsService_RevokePersonalAccessTokens(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"revoke","pat_ids":[1,2]}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.RevokePersonalAccessTokens(ctx, "o", []int64{1, 2}); err != nil {
+		t.Errorf("Organizations.RevokePersonalAccessTokens returned error: %v", err)
+	}
+
+	const methodName = "RevokePersonalAccessTokens"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RevokePersonalAccessTokens(ctx, "\n", []int64{1})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RevokePersonalAccessTokens(ctx, "o", []int64{1})
+	})
+}
+
+func TestOrganizationsService_ListPersonalAccessTokenRepositories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens/1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.FormValue("page") == "2" {
+			fmt.Fprint(w, `[{"id":2}]`)
+			return
+		}
+		w.Header().Set("Link", `<https://api.github.com/organizations/1/personal-access-tokens/1/repositories?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{PerPage: 1}
+	var all []*Repository
+	for {
+		repos, resp, err := client.Organizations.ListPersonalAccessTokenRepositories(ctx, "o", 1, opts)
+		if err != nil {
+			t.Fatalf("Organizations.ListPersonalAccessTokenRepositories returned error: %v", err)
+		}
+		all = append(all, repos...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	want := []*Repository{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !cmp.Equal(all, want) {
+		t.Errorf("Organizations.ListPersonalAccessTokenRepositories returned %+v, want %+v", all, want)
+	}
+
+	const methodName = "ListPersonalAccessTokenRepositories"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListPersonalAccessTokenRepositories(ctx, "\n", 1, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListPersonalAccessTokenRepositories(ctx, "o", 1, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestReviewPersonalAccessTokenRequestOptions_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ReviewPersonalAccessTokenRequestOptions{}, "{}")
 
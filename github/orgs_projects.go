@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /orgs/{org}/projects
 func (s *OrganizationsService) ListProjects(ctx context.Context, org string, opts *ProjectListOptions) ([]*Project, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/projects", org)
+	u := fmt.Sprintf("orgs/%v/projects", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -45,7 +45,11 @@ func (s *OrganizationsService) ListProjects(ctx context.Context, org string, opt
 //
 //meta:operation POST /orgs/{org}/projects
 func (s *OrganizationsService) CreateProject(ctx context.Context, org string, opts *ProjectOptions) (*Project, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/projects", org)
+	if opts != nil {
+		ctx = WithRequestMetadata(ctx, opts.Metadata)
+	}
+
+	u := fmt.Sprintf("orgs/%v/projects", PathEscape(org))
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
 		return nil, nil, err
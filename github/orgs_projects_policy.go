@@ -0,0 +1,68 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrganizationProjectsPolicy represents the settings of an organization that
+// control the use of projects. It is the subset of Organization used by
+// OrganizationsService.GetProjectsPolicy and
+// OrganizationsService.UpdateProjectsPolicy.
+type OrganizationProjectsPolicy struct {
+	// HasOrganizationProjects toggles whether projects can be created at the
+	// organization level.
+	HasOrganizationProjects *bool `json:"has_organization_projects,omitempty"`
+	// HasRepositoryProjects toggles whether projects can be created in the
+	// repositories of the organization.
+	HasRepositoryProjects *bool `json:"has_repository_projects,omitempty"`
+}
+
+// GetProjectsPolicy fetches the project settings of an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/orgs#get-an-organization
+//
+//meta:operation GET /orgs/{org}
+func (s *OrganizationsService) GetProjectsPolicy(ctx context.Context, org string) (*OrganizationProjectsPolicy, *Response, error) {
+	u := fmt.Sprintf("orgs/%v", PathEscape(org))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := new(OrganizationProjectsPolicy)
+	resp, err := s.client.Do(ctx, req, policy)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policy, resp, nil
+}
+
+// UpdateProjectsPolicy updates the project settings of an organization,
+// leaving its other settings alone. Only the non-nil fields of policy are
+// sent. It returns the resulting project settings.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/orgs#update-an-organization
+//
+//meta:operation PATCH /orgs/{org}
+func (s *OrganizationsService) UpdateProjectsPolicy(ctx context.Context, org string, policy *OrganizationProjectsPolicy) (*OrganizationProjectsPolicy, *Response, error) {
+	u := fmt.Sprintf("orgs/%v", PathEscape(org))
+	req, err := s.client.NewRequest("PATCH", u, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(OrganizationProjectsPolicy)
+	resp, err := s.client.Do(ctx, req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_GetProjectsPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"login":"o","id":1,"has_organization_projects":true,"has_repository_projects":false,"members_can_create_repositories":true}`)
+	})
+
+	ctx := context.Background()
+	policy, _, err := client.Organizations.GetProjectsPolicy(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.GetProjectsPolicy returned error: %v", err)
+	}
+
+	want := &OrganizationProjectsPolicy{HasOrganizationProjects: Bool(true), HasRepositoryProjects: Bool(false)}
+	if !cmp.Equal(policy, want) {
+		t.Errorf("Organizations.GetProjectsPolicy returned %+v, want %+v", policy, want)
+	}
+
+	const methodName = "GetProjectsPolicy"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetProjectsPolicy(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetProjectsPolicy(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_UpdateProjectsPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"has_repository_projects":false}`+"\n")
+		fmt.Fprint(w, `{"login":"o","id":1,"has_organization_projects":true,"has_repository_projects":false}`)
+	})
+
+	ctx := context.Background()
+	input := &OrganizationProjectsPolicy{HasRepositoryProjects: Bool(false)}
+	policy, _, err := client.Organizations.UpdateProjectsPolicy(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Organizations.UpdateProjectsPolicy returned error: %v", err)
+	}
+
+	want := &OrganizationProjectsPolicy{HasOrganizationProjects: Bool(true), HasRepositoryProjects: Bool(false)}
+	if !cmp.Equal(policy, want) {
+		t.Errorf("Organizations.UpdateProjectsPolicy returned %+v, want %+v", policy, want)
+	}
+
+	const methodName = "UpdateProjectsPolicy"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.UpdateProjectsPolicy(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.UpdateProjectsPolicy(ctx, "o", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationProjectsPolicy_Marshal(t *testing.T) {
+	testJSONMarshal(t, &OrganizationProjectsPolicy{}, "{}")
+
+	u := &OrganizationProjectsPolicy{
+		HasOrganizationProjects: Bool(true),
+		HasRepositoryProjects:   Bool(false),
+	}
+
+	want := `{
+		"has_organization_projects": true,
+		"has_repository_projects": false
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -43,7 +43,7 @@ type CustomPropertyValue struct {
 //
 //meta:operation GET /orgs/{org}/properties/schema
 func (s *OrganizationsService) GetAllCustomProperties(ctx context.Context, org string) ([]*CustomProperty, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/properties/schema", org)
+	u := fmt.Sprintf("orgs/%v/properties/schema", PathEscape(org))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -65,7 +65,7 @@ func (s *OrganizationsService) GetAllCustomProperties(ctx context.Context, org s
 //
 //meta:operation PATCH /orgs/{org}/properties/schema
 func (s *OrganizationsService) CreateOrUpdateCustomProperties(ctx context.Context, org string, properties []*CustomProperty) ([]*CustomProperty, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/properties/schema", org)
+	u := fmt.Sprintf("orgs/%v/properties/schema", PathEscape(org))
 
 	params := struct {
 		Properties []*CustomProperty `json:"properties"`
@@ -93,7 +93,7 @@ func (s *OrganizationsService) CreateOrUpdateCustomProperties(ctx context.Contex
 //
 //meta:operation GET /orgs/{org}/properties/schema/{custom_property_name}
 func (s *OrganizationsService) GetCustomProperty(ctx context.Context, org, name string) (*CustomProperty, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/properties/schema/%v", org, name)
+	u := fmt.Sprintf("orgs/%v/properties/schema/%v", PathEscape(org), PathEscape(name))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -115,7 +115,7 @@ func (s *OrganizationsService) GetCustomProperty(ctx context.Context, org, name
 //
 //meta:operation PUT /orgs/{org}/properties/schema/{custom_property_name}
 func (s *OrganizationsService) CreateOrUpdateCustomProperty(ctx context.Context, org, customPropertyName string, property *CustomProperty) (*CustomProperty, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/properties/schema/%v", org, customPropertyName)
+	u := fmt.Sprintf("orgs/%v/properties/schema/%v", PathEscape(org), PathEscape(customPropertyName))
 
 	req, err := s.client.NewRequest("PUT", u, property)
 	if err != nil {
@@ -137,7 +137,7 @@ func (s *OrganizationsService) CreateOrUpdateCustomProperty(ctx context.Context,
 //
 //meta:operation DELETE /orgs/{org}/properties/schema/{custom_property_name}
 func (s *OrganizationsService) RemoveCustomProperty(ctx context.Context, org, customPropertyName string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/properties/schema/%v", org, customPropertyName)
+	u := fmt.Sprintf("orgs/%v/properties/schema/%v", PathEscape(org), PathEscape(customPropertyName))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -153,7 +153,11 @@ func (s *OrganizationsService) RemoveCustomProperty(ctx context.Context, org, cu
 //
 //meta:operation GET /orgs/{org}/properties/values
 func (s *OrganizationsService) ListCustomPropertyValues(ctx context.Context, org string, opts *ListOptions) ([]*RepoCustomPropertyValue, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/properties/values", org)
+	return s.listCustomPropertyValues(ctx, org, opts)
+}
+
+func (s *OrganizationsService) listCustomPropertyValues(ctx context.Context, org string, opts interface{}) ([]*RepoCustomPropertyValue, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/properties/values", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -173,13 +177,101 @@ func (s *OrganizationsService) ListCustomPropertyValues(ctx context.Context, org
 	return repoCustomPropertyValues, resp, nil
 }
 
+// ListCustomPropertyValuesOptions specifies the optional parameters to the
+// OrganizationsService.ForEachRepoCustomPropertyValues method.
+type ListCustomPropertyValuesOptions struct {
+	// RepositoryQuery limits the repositories to those matching a search
+	// query, such as "props.team:payments" for the repositories whose "team"
+	// property is "payments".
+	RepositoryQuery string `url:"repository_query,omitempty"`
+
+	ListOptions
+}
+
+// ForEachRepoCustomPropertyValues calls fn with each repository of the
+// specified organization and its custom property values, fetching as many
+// pages as needed without holding more than one page in memory. The
+// repositories only have their ID, Name and FullName set. It pauses whenever
+// it is rate limited, until ctx is done. If fn returns an error,
+// ForEachRepoCustomPropertyValues stops and returns that error.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/custom-properties#list-custom-property-values-for-organization-repositories
+//
+//meta:operation GET /orgs/{org}/properties/values
+func (s *OrganizationsService) ForEachRepoCustomPropertyValues(ctx context.Context, org string, opts *ListCustomPropertyValuesOptions, fn func(*Repository, []*CustomPropertyValue) error) error {
+	pageOpts := &ListCustomPropertyValuesOptions{ListOptions: ListOptions{PerPage: 100}}
+	if opts != nil {
+		pageOpts.RepositoryQuery = opts.RepositoryQuery
+		pageOpts.Page = opts.Page
+		if opts.PerPage != 0 {
+			pageOpts.PerPage = opts.PerPage
+		}
+	}
+
+	for {
+		values, resp, err := s.listCustomPropertyValues(ctx, org, pageOpts)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return werr
+		} else if retry {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, v := range values {
+			repo := &Repository{
+				ID:       Int64(v.RepositoryID),
+				Name:     String(v.RepositoryName),
+				FullName: String(v.RepositoryFullName),
+			}
+			if err := fn(repo, v.Properties); err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil
+		}
+		pageOpts.Page = resp.NextPage
+	}
+}
+
+// GroupReposByProperty returns the names of all the repositories of the
+// specified organization, keyed by their value of the custom property
+// propertyName. Repositories without a value for it are listed under the
+// empty string.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/custom-properties#list-custom-property-values-for-organization-repositories
+//
+//meta:operation GET /orgs/{org}/properties/values
+func (s *OrganizationsService) GroupReposByProperty(ctx context.Context, org, propertyName string) (map[string][]string, error) {
+	groups := make(map[string][]string)
+	err := s.ForEachRepoCustomPropertyValues(ctx, org, nil, func(repo *Repository, properties []*CustomPropertyValue) error {
+		var value string
+		for _, p := range properties {
+			if p.PropertyName == propertyName {
+				value = p.GetValue()
+				break
+			}
+		}
+		groups[value] = append(groups[value], repo.GetName())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
 // CreateOrUpdateRepoCustomPropertyValues creates new or updates existing custom property values across multiple repositories for the specified organization.
 //
 // GitHub API docs: https://docs.github.com/rest/orgs/custom-properties#create-or-update-custom-property-values-for-organization-repositories
 //
 //meta:operation PATCH /orgs/{org}/properties/values
 func (s *OrganizationsService) CreateOrUpdateRepoCustomPropertyValues(ctx context.Context, org string, repoNames []string, properties []*CustomPropertyValue) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/properties/values", org)
+	u := fmt.Sprintf("orgs/%v/properties/values", PathEscape(org))
 
 	params := struct {
 		RepositoryNames []string               `json:"repository_names"`
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -341,6 +342,135 @@ func TestOrganizationsService_ListCustomPropertyValues(t *testing.T) {
 	})
 }
 
+func handleMultiPagePropertyValues(t *testing.T, mux *http.ServeMux, query string) {
+	t.Helper()
+	mux.HandleFunc("/orgs/o/properties/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := values{"per_page": "100"}
+		if query != "" {
+			want["repository_query"] = query
+		}
+		if page := r.FormValue("page"); page != "" {
+			want["page"] = page
+		}
+		testFormValues(t, r, want)
+
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/properties/values?per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"repository_id":1,"repository_name":"api","repository_full_name":"o/api","properties":[{"property_name":"team","value":"payments"}]},
+				{"repository_id":2,"repository_name":"docs","repository_full_name":"o/docs","properties":[]}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[
+				{"repository_id":3,"repository_name":"billing","repository_full_name":"o/billing","properties":[{"property_name":"env","value":"prod"},{"property_name":"team","value":"payments"}]},
+				{"repository_id":4,"repository_name":"web","repository_full_name":"o/web","properties":[{"property_name":"team","value":"frontend"}]},
+				{"repository_id":5,"repository_name":"old","repository_full_name":"o/old","properties":[{"property_name":"team","value":null}]}
+			]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+}
+
+func TestOrganizationsService_ForEachRepoCustomPropertyValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleMultiPagePropertyValues(t, mux, "props.team:payments")
+
+	ctx := context.Background()
+	var repos []*Repository
+	var counts []int
+	opts := &ListCustomPropertyValuesOptions{RepositoryQuery: "props.team:payments"}
+	err := client.Organizations.ForEachRepoCustomPropertyValues(ctx, "o", opts, func(repo *Repository, properties []*CustomPropertyValue) error {
+		repos = append(repos, repo)
+		counts = append(counts, len(properties))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Organizations.ForEachRepoCustomPropertyValues returned error: %v", err)
+	}
+
+	if got, want := len(repos), 5; got != want {
+		t.Fatalf("Organizations.ForEachRepoCustomPropertyValues visited %v repos, want %v", got, want)
+	}
+	wantRepo := &Repository{ID: Int64(3), Name: String("billing"), FullName: String("o/billing")}
+	if !cmp.Equal(repos[2], wantRepo) {
+		t.Errorf("Organizations.ForEachRepoCustomPropertyValues visited %+v, want %+v", repos[2], wantRepo)
+	}
+	if want := []int{1, 0, 2, 1, 1}; !cmp.Equal(counts, want) {
+		t.Errorf("Organizations.ForEachRepoCustomPropertyValues visited property counts %v, want %v", counts, want)
+	}
+}
+
+func TestOrganizationsService_ForEachRepoCustomPropertyValues_stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleMultiPagePropertyValues(t, mux, "")
+
+	ctx := context.Background()
+	stop := errors.New("stop")
+	visited := 0
+	err := client.Organizations.ForEachRepoCustomPropertyValues(ctx, "o", nil, func(*Repository, []*CustomPropertyValue) error {
+		visited++
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("Organizations.ForEachRepoCustomPropertyValues returned error %v, want %v", err, stop)
+	}
+	if visited != 1 {
+		t.Errorf("Organizations.ForEachRepoCustomPropertyValues visited %v repos, want 1", visited)
+	}
+
+	const methodName = "ForEachRepoCustomPropertyValues"
+	testBadOptions(t, methodName, func() error {
+		return client.Organizations.ForEachRepoCustomPropertyValues(ctx, "\n", nil, func(*Repository, []*CustomPropertyValue) error { return nil })
+	})
+}
+
+func TestOrganizationsService_GroupReposByProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleMultiPagePropertyValues(t, mux, "")
+
+	ctx := context.Background()
+	groups, err := client.Organizations.GroupReposByProperty(ctx, "o", "team")
+	if err != nil {
+		t.Fatalf("Organizations.GroupReposByProperty returned error: %v", err)
+	}
+
+	want := map[string][]string{
+		"payments": {"api", "billing"},
+		"frontend": {"web"},
+		"":         {"docs", "old"},
+	}
+	if !cmp.Equal(groups, want) {
+		t.Errorf("Organizations.GroupReposByProperty returned %v, want %v", groups, want)
+	}
+}
+
+func TestOrganizationsService_GroupReposByProperty_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/properties/values", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	groups, err := client.Organizations.GroupReposByProperty(ctx, "o", "team")
+	if err == nil {
+		t.Error("Organizations.GroupReposByProperty returned no error, want one")
+	}
+	if groups != nil {
+		t.Errorf("Organizations.GroupReposByProperty returned %v, want nil", groups)
+	}
+}
+
 func TestOrganizationsService_CreateOrUpdateRepoCustomPropertyValues(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /orgs/{org}/rulesets
 func (s *OrganizationsService) GetAllOrganizationRulesets(ctx context.Context, org string) ([]*Ruleset, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/rulesets", org)
+	u := fmt.Sprintf("orgs/%v/rulesets", PathEscape(org))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -38,7 +38,7 @@ func (s *OrganizationsService) GetAllOrganizationRulesets(ctx context.Context, o
 //
 //meta:operation POST /orgs/{org}/rulesets
 func (s *OrganizationsService) CreateOrganizationRuleset(ctx context.Context, org string, rs *Ruleset) (*Ruleset, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/rulesets", org)
+	u := fmt.Sprintf("orgs/%v/rulesets", PathEscape(org))
 
 	req, err := s.client.NewRequest("POST", u, rs)
 	if err != nil {
@@ -60,7 +60,7 @@ func (s *OrganizationsService) CreateOrganizationRuleset(ctx context.Context, or
 //
 //meta:operation GET /orgs/{org}/rulesets/{ruleset_id}
 func (s *OrganizationsService) GetOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*Ruleset, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID)
+	u := fmt.Sprintf("orgs/%v/rulesets/%v", PathEscape(org), rulesetID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -82,7 +82,7 @@ func (s *OrganizationsService) GetOrganizationRuleset(ctx context.Context, org s
 //
 //meta:operation PUT /orgs/{org}/rulesets/{ruleset_id}
 func (s *OrganizationsService) UpdateOrganizationRuleset(ctx context.Context, org string, rulesetID int64, rs *Ruleset) (*Ruleset, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID)
+	u := fmt.Sprintf("orgs/%v/rulesets/%v", PathEscape(org), rulesetID)
 
 	req, err := s.client.NewRequest("PUT", u, rs)
 	if err != nil {
@@ -104,7 +104,7 @@ func (s *OrganizationsService) UpdateOrganizationRuleset(ctx context.Context, or
 //
 //meta:operation DELETE /orgs/{org}/rulesets/{ruleset_id}
 func (s *OrganizationsService) DeleteOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID)
+	u := fmt.Sprintf("orgs/%v/rulesets/%v", PathEscape(org), rulesetID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /orgs/{org}/security-managers
 func (s *OrganizationsService) ListSecurityManagerTeams(ctx context.Context, org string) ([]*Team, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/security-managers", org)
+	u := fmt.Sprintf("orgs/%v/security-managers", PathEscape(org))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -38,7 +38,7 @@ func (s *OrganizationsService) ListSecurityManagerTeams(ctx context.Context, org
 //
 //meta:operation PUT /orgs/{org}/security-managers/teams/{team_slug}
 func (s *OrganizationsService) AddSecurityManagerTeam(ctx context.Context, org, team string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/security-managers/teams/%v", org, team)
+	u := fmt.Sprintf("orgs/%v/security-managers/teams/%v", PathEscape(org), PathEscape(team))
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
@@ -53,7 +53,7 @@ func (s *OrganizationsService) AddSecurityManagerTeam(ctx context.Context, org,
 //
 //meta:operation DELETE /orgs/{org}/security-managers/teams/{team_slug}
 func (s *OrganizationsService) RemoveSecurityManagerTeam(ctx context.Context, org, team string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/security-managers/teams/%v", org, team)
+	u := fmt.Sprintf("orgs/%v/security-managers/teams/%v", PathEscape(org), PathEscape(team))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
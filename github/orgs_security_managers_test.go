@@ -56,6 +56,8 @@ func TestOrganizationsService_ListSecurityManagerTeams_invalidOrg(t *testing.T)
 	ctx := context.Background()
 	_, _, err := client.Organizations.ListSecurityManagerTeams(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.ListSecurityManagerTeams(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_AddSecurityManagerTeam(t *testing.T) {
@@ -90,6 +92,8 @@ func TestOrganizationsService_AddSecurityManagerTeam_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Organizations.AddSecurityManagerTeam(ctx, "%", "t")
 	testURLParseError(t, err)
+	_, err = client.Organizations.AddSecurityManagerTeam(ctx, "..", "t")
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_AddSecurityManagerTeam_invalidTeam(t *testing.T) {
@@ -99,6 +103,8 @@ func TestOrganizationsService_AddSecurityManagerTeam_invalidTeam(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Organizations.AddSecurityManagerTeam(ctx, "%", "t")
 	testURLParseError(t, err)
+	_, err = client.Organizations.AddSecurityManagerTeam(ctx, "..", "t")
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_RemoveSecurityManagerTeam(t *testing.T) {
@@ -133,6 +139,8 @@ func TestOrganizationsService_RemoveSecurityManagerTeam_invalidOrg(t *testing.T)
 	ctx := context.Background()
 	_, err := client.Organizations.RemoveSecurityManagerTeam(ctx, "%", "t")
 	testURLParseError(t, err)
+	_, err = client.Organizations.RemoveSecurityManagerTeam(ctx, "..", "t")
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_RemoveSecurityManagerTeam_invalidTeam(t *testing.T) {
@@ -142,4 +150,6 @@ func TestOrganizationsService_RemoveSecurityManagerTeam_invalidTeam(t *testing.T
 	ctx := context.Background()
 	_, err := client.Organizations.RemoveSecurityManagerTeam(ctx, "%", "t")
 	testURLParseError(t, err)
+	_, err = client.Organizations.RemoveSecurityManagerTeam(ctx, "..", "t")
+	testPathForbiddenError(t, err)
 }
@@ -0,0 +1,183 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"strings"
+)
+
+// OrgSecuritySummaryOptions specifies the optional parameters to the
+// OrganizationsService.GetSecuritySummary method.
+type OrgSecuritySummaryOptions struct {
+	// RepoNamePrefix limits the summary to the repositories whose name starts
+	// with it. The alert listings cannot be filtered by repository, so this
+	// bounds the size of the summary rather than the number of requests.
+	RepoNamePrefix string
+}
+
+// OrgSecuritySummary counts the open security alerts of the repositories of
+// an organization.
+type OrgSecuritySummary struct {
+	// Repos maps the names of the repositories with open alerts to their
+	// counts.
+	Repos map[string]*RepoSecuritySummary
+}
+
+// RepoSecuritySummary counts the open security alerts of a repository.
+type RepoSecuritySummary struct {
+	// Dependabot counts the open Dependabot alerts by the severity of their
+	// advisory, such as "critical" or "low".
+	Dependabot map[string]int
+	// CodeScanning counts the open code scanning alerts by the security
+	// severity level of their rule, or by the rule severity, such as "error",
+	// for rules without one.
+	CodeScanning map[string]int
+	// SecretScanning is the number of open secret scanning alerts.
+	SecretScanning int
+}
+
+// Total returns the number of open alerts of all types.
+func (r *RepoSecuritySummary) Total() int {
+	if r == nil {
+		return 0
+	}
+	total := r.SecretScanning
+	for _, n := range r.Dependabot {
+		total += n
+	}
+	for _, n := range r.CodeScanning {
+		total += n
+	}
+	return total
+}
+
+func (s *OrgSecuritySummary) repo(repo *Repository, prefix string) *RepoSecuritySummary {
+	name := repo.GetName()
+	if !strings.HasPrefix(name, prefix) {
+		return nil
+	}
+	r, ok := s.Repos[name]
+	if !ok {
+		r = &RepoSecuritySummary{Dependabot: map[string]int{}, CodeScanning: map[string]int{}}
+		s.Repos[name] = r
+	}
+	return r
+}
+
+// nextAlertsPage advances opts or cursor to the page after resp, whether the
+// listing is paginated by page number or by cursor. It reports false when
+// resp is the last page.
+func nextAlertsPage(resp *Response, opts *ListOptions, cursor *ListCursorOptions) bool {
+	switch {
+	case resp.NextPage != 0:
+		opts.Page = resp.NextPage
+	case resp.After != "":
+		cursor.After = resp.After
+	default:
+		return false
+	}
+	return true
+}
+
+// GetSecuritySummary counts the open Dependabot, code scanning and secret
+// scanning alerts of each repository of the specified organization, by
+// severity where there is one. It fetches every page of the organization's
+// alert listings, pausing whenever it is rate limited, until ctx is done.
+//
+// GitHub API docs: https://docs.github.com/rest/code-scanning/code-scanning#list-code-scanning-alerts-for-an-organization
+// GitHub API docs: https://docs.github.com/rest/dependabot/alerts#list-dependabot-alerts-for-an-organization
+// GitHub API docs: https://docs.github.com/rest/secret-scanning/secret-scanning#list-secret-scanning-alerts-for-an-organization
+//
+//meta:operation GET /orgs/{org}/code-scanning/alerts
+//meta:operation GET /orgs/{org}/dependabot/alerts
+//meta:operation GET /orgs/{org}/secret-scanning/alerts
+func (s *OrganizationsService) GetSecuritySummary(ctx context.Context, org string, opts *OrgSecuritySummaryOptions) (*OrgSecuritySummary, error) {
+	var prefix string
+	if opts != nil {
+		prefix = opts.RepoNamePrefix
+	}
+	summary := &OrgSecuritySummary{Repos: map[string]*RepoSecuritySummary{}}
+
+	dependabotOpts := &ListAlertsOptions{State: String("open"), ListCursorOptions: ListCursorOptions{PerPage: 100}}
+	for {
+		alerts, resp, err := s.client.Dependabot.ListOrgAlerts(ctx, org, dependabotOpts)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return nil, werr
+		} else if retry {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, alert := range alerts {
+			if r := summary.repo(alert.Repository, prefix); r != nil {
+				severity := alert.GetSecurityAdvisory().GetSeverity()
+				if severity == "" {
+					severity = alert.GetSecurityVulnerability().GetSeverity()
+				}
+				r.Dependabot[severity]++
+			}
+		}
+
+		if !nextAlertsPage(resp, &dependabotOpts.ListOptions, &dependabotOpts.ListCursorOptions) {
+			break
+		}
+	}
+
+	codeScanningOpts := &AlertListOptions{State: "open", ListOptions: ListOptions{PerPage: 100}}
+	for {
+		alerts, resp, err := s.client.CodeScanning.ListAlertsForOrg(ctx, org, codeScanningOpts)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return nil, werr
+		} else if retry {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, alert := range alerts {
+			if r := summary.repo(alert.Repository, prefix); r != nil {
+				severity := alert.GetRule().GetSecuritySeverityLevel()
+				if severity == "" {
+					severity = alert.GetRule().GetSeverity()
+				}
+				r.CodeScanning[severity]++
+			}
+		}
+
+		if !nextAlertsPage(resp, &codeScanningOpts.ListOptions, &codeScanningOpts.ListCursorOptions) {
+			break
+		}
+	}
+
+	secretScanningOpts := &SecretScanningAlertListOptions{State: "open", ListOptions: ListOptions{PerPage: 100}}
+	for {
+		alerts, resp, err := s.client.SecretScanning.ListAlertsForOrg(ctx, org, secretScanningOpts)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return nil, werr
+		} else if retry {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, alert := range alerts {
+			if r := summary.repo(alert.Repository, prefix); r != nil {
+				r.SecretScanning++
+			}
+		}
+
+		if !nextAlertsPage(resp, &secretScanningOpts.ListOptions, &secretScanningOpts.ListCursorOptions) {
+			break
+		}
+	}
+
+	return summary, nil
+}
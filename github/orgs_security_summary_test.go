@@ -0,0 +1,151 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func handleOrgSecurityAlerts(t *testing.T, mux *http.ServeMux) {
+	t.Helper()
+
+	mux.HandleFunc("/orgs/o/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("after") {
+		case "":
+			testFormValues(t, r, values{"state": "open", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/dependabot/alerts?per_page=100&after=c1>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"number":1,"security_advisory":{"severity":"critical"},"repository":{"name":"api"}},
+				{"number":2,"security_advisory":{"severity":"low"},"repository":{"name":"api"}}
+			]`)
+		case "c1":
+			testFormValues(t, r, values{"state": "open", "per_page": "100", "after": "c1"})
+			fmt.Fprint(w, `[
+				{"number":3,"security_vulnerability":{"severity":"critical"},"repository":{"name":"api"}},
+				{"number":4,"security_advisory":{"severity":"high"},"repository":{"name":"web"}}
+			]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.FormValue("after"))
+		}
+	})
+
+	mux.HandleFunc("/orgs/o/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"state": "open", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/code-scanning/alerts?per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"number":1,"rule":{"severity":"error","security_severity_level":"high"},"repository":{"name":"api"}}]`)
+		case "2":
+			fmt.Fprint(w, `[{"number":2,"rule":{"severity":"warning"},"repository":{"name":"tools"}}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	mux.HandleFunc("/orgs/o/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open", "per_page": "100"})
+		fmt.Fprint(w, `[{"number":1,"repository":{"name":"web"}},{"number":2,"repository":{"name":"web"}}]`)
+	})
+}
+
+func TestOrganizationsService_GetSecuritySummary(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleOrgSecurityAlerts(t, mux)
+
+	ctx := context.Background()
+	summary, err := client.Organizations.GetSecuritySummary(ctx, "o", nil)
+	if err != nil {
+		t.Fatalf("Organizations.GetSecuritySummary returned error: %v", err)
+	}
+
+	want := &OrgSecuritySummary{Repos: map[string]*RepoSecuritySummary{
+		"api": {
+			Dependabot:   map[string]int{"critical": 2, "low": 1},
+			CodeScanning: map[string]int{"high": 1},
+		},
+		"web": {
+			Dependabot:     map[string]int{"high": 1},
+			CodeScanning:   map[string]int{},
+			SecretScanning: 2,
+		},
+		"tools": {
+			Dependabot:   map[string]int{},
+			CodeScanning: map[string]int{"warning": 1},
+		},
+	}}
+	if !cmp.Equal(summary, want) {
+		t.Errorf("Organizations.GetSecuritySummary returned %+v, want %+v", summary, want)
+	}
+
+	if got := summary.Repos["api"].Total(); got != 4 {
+		t.Errorf("RepoSecuritySummary.Total = %v, want 4", got)
+	}
+	if got := summary.Repos["missing"].Total(); got != 0 {
+		t.Errorf("RepoSecuritySummary.Total of a nil summary = %v, want 0", got)
+	}
+}
+
+func TestOrganizationsService_GetSecuritySummary_repoNamePrefix(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleOrgSecurityAlerts(t, mux)
+
+	ctx := context.Background()
+	summary, err := client.Organizations.GetSecuritySummary(ctx, "o", &OrgSecuritySummaryOptions{RepoNamePrefix: "we"})
+	if err != nil {
+		t.Fatalf("Organizations.GetSecuritySummary returned error: %v", err)
+	}
+
+	want := &OrgSecuritySummary{Repos: map[string]*RepoSecuritySummary{
+		"web": {
+			Dependabot:     map[string]int{"high": 1},
+			CodeScanning:   map[string]int{},
+			SecretScanning: 2,
+		},
+	}}
+	if !cmp.Equal(summary, want) {
+		t.Errorf("Organizations.GetSecuritySummary returned %+v, want %+v", summary, want)
+	}
+}
+
+func TestOrganizationsService_GetSecuritySummary_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/orgs/o/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Advanced Security must be enabled for this repository to use code scanning."}`)
+	})
+
+	ctx := context.Background()
+	summary, err := client.Organizations.GetSecuritySummary(ctx, "o", nil)
+	if err == nil {
+		t.Error("Organizations.GetSecuritySummary returned no error, want one")
+	}
+	if summary != nil {
+		t.Errorf("Organizations.GetSecuritySummary returned %+v, want nil", summary)
+	}
+
+	const methodName = "GetSecuritySummary"
+	testBadOptions(t, methodName, func() error {
+		_, err := client.Organizations.GetSecuritySummary(ctx, "\n", nil)
+		return err
+	})
+}
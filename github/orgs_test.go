@@ -179,6 +179,8 @@ func TestOrganizationsService_List_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.List(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.List(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_Get(t *testing.T) {
@@ -224,6 +226,8 @@ func TestOrganizationsService_Get_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.Get(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.Get(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_GetByID(t *testing.T) {
@@ -306,6 +310,33 @@ func TestOrganizationsService_Edit(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_Edit_onlySetFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"web_commit_signoff_required":false}`+"\n")
+		fmt.Fprint(w, `{"id":1,"web_commit_signoff_required":false,"members_can_fork_private_repositories":true,"secret_scanning_push_protection_custom_link":"https://example.com/secrets"}`)
+	})
+
+	ctx := context.Background()
+	org, _, err := client.Organizations.Edit(ctx, "o", &Organization{WebCommitSignoffRequired: Bool(false)})
+	if err != nil {
+		t.Errorf("Organizations.Edit returned error: %v", err)
+	}
+
+	want := &Organization{
+		ID:                                     Int64(1),
+		WebCommitSignoffRequired:               Bool(false),
+		MembersCanForkPrivateRepos:             Bool(true),
+		SecretScanningPushProtectionCustomLink: String("https://example.com/secrets"),
+	}
+	if !cmp.Equal(org, want) {
+		t.Errorf("Organizations.Edit returned %+v, want %+v", org, want)
+	}
+}
+
 func TestOrganizationsService_Edit_invalidOrg(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -313,6 +344,8 @@ func TestOrganizationsService_Edit_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.Edit(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.Edit(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_Delete(t *testing.T) {
@@ -382,6 +415,8 @@ func TestOrganizationsService_ListInstallations_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Organizations.ListInstallations(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Organizations.ListInstallations(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestOrganizationsService_ListInstallations_withListOptions(t *testing.T) {
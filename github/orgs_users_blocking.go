@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /orgs/{org}/blocks
 func (s *OrganizationsService) ListBlockedUsers(ctx context.Context, org string, opts *ListOptions) ([]*User, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/blocks", org)
+	u := fmt.Sprintf("orgs/%v/blocks", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -45,7 +45,7 @@ func (s *OrganizationsService) ListBlockedUsers(ctx context.Context, org string,
 //
 //meta:operation GET /orgs/{org}/blocks/{username}
 func (s *OrganizationsService) IsBlocked(ctx context.Context, org string, user string) (bool, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/blocks/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/blocks/%v", PathEscape(org), PathEscape(user))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -60,13 +60,19 @@ func (s *OrganizationsService) IsBlocked(ctx context.Context, org string, user s
 	return isBlocked, resp, err
 }
 
-// BlockUser blocks specified user from an organization.
+// BlockUser blocks specified user from an organization. Members of the
+// organization can't be blocked; GitHub responds with a 422 error, returned as
+// an *ErrorResponse.
+//
+// This differs from the interaction limits set through
+// InteractionsService.UpdateRestrictionsForRepo, which restrict who may
+// interact with a repository without blocking anyone.
 //
 // GitHub API docs: https://docs.github.com/rest/orgs/blocking#block-a-user-from-an-organization
 //
 //meta:operation PUT /orgs/{org}/blocks/{username}
 func (s *OrganizationsService) BlockUser(ctx context.Context, org string, user string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/blocks/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/blocks/%v", PathEscape(org), PathEscape(user))
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -85,7 +91,7 @@ func (s *OrganizationsService) BlockUser(ctx context.Context, org string, user s
 //
 //meta:operation DELETE /orgs/{org}/blocks/{username}
 func (s *OrganizationsService) UnblockUser(ctx context.Context, org string, user string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/blocks/%v", org, user)
+	u := fmt.Sprintf("orgs/%v/blocks/%v", PathEscape(org), PathEscape(user))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
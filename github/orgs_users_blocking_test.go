@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -88,6 +89,28 @@ func TestOrganizationsService_IsBlocked(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_IsBlocked_notBlocked(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/blocks/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	isBlocked, resp, err := client.Organizations.IsBlocked(ctx, "o", "u")
+	if err != nil {
+		t.Errorf("Organizations.IsBlocked returned error: %v", err)
+	}
+	if want := false; isBlocked != want {
+		t.Errorf("Organizations.IsBlocked returned %+v, want %+v", isBlocked, want)
+	}
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("Organizations.IsBlocked returned status %v, want %v", got, want)
+	}
+}
+
 func TestOrganizationsService_BlockUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -115,6 +138,30 @@ func TestOrganizationsService_BlockUser(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_BlockUser_orgMember(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/blocks/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Blocking an organization member is not allowed."}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.BlockUser(ctx, "o", "u")
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Organizations.BlockUser returned error %v, want *ErrorResponse", err)
+	}
+	if got, want := errResp.Response.StatusCode, http.StatusUnprocessableEntity; got != want {
+		t.Errorf("Organizations.BlockUser returned status %v, want %v", got, want)
+	}
+	if got, want := errResp.Message, "Blocking an organization member is not allowed."; got != want {
+		t.Errorf("Organizations.BlockUser returned message %q, want %q", got, want)
+	}
+}
+
 func TestOrganizationsService_UnblockUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
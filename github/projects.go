@@ -88,6 +88,10 @@ type ProjectOptions struct {
 	// Setting visibility is only available
 	// for organization projects.(Optional.)
 	Private *bool `json:"private,omitempty"`
+
+	// Metadata is sent along with the request for audit purposes; see
+	// RequestMetadata. (Optional.)
+	Metadata RequestMetadata `json:"-"`
 }
 
 // UpdateProject updates a repository project.
@@ -96,6 +100,10 @@ type ProjectOptions struct {
 //
 //meta:operation PATCH /projects/{project_id}
 func (s *ProjectsService) UpdateProject(ctx context.Context, id int64, opts *ProjectOptions) (*Project, *Response, error) {
+	if opts != nil {
+		ctx = WithRequestMetadata(ctx, opts.Metadata)
+	}
+
 	u := fmt.Sprintf("projects/%v", id)
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
@@ -205,6 +213,10 @@ func (s *ProjectsService) GetProjectColumn(ctx context.Context, id int64) (*Proj
 type ProjectColumnOptions struct {
 	// The name of the project column. (Required for creation and update.)
 	Name string `json:"name"`
+
+	// Metadata is sent along with the request for audit purposes; see
+	// RequestMetadata. (Optional.)
+	Metadata RequestMetadata `json:"-"`
 }
 
 // CreateProjectColumn creates a column for the specified (by number) project.
@@ -213,6 +225,10 @@ type ProjectColumnOptions struct {
 //
 //meta:operation POST /projects/{project_id}/columns
 func (s *ProjectsService) CreateProjectColumn(ctx context.Context, projectID int64, opts *ProjectColumnOptions) (*ProjectColumn, *Response, error) {
+	if opts != nil {
+		ctx = WithRequestMetadata(ctx, opts.Metadata)
+	}
+
 	u := fmt.Sprintf("projects/%v/columns", projectID)
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
@@ -237,6 +253,10 @@ func (s *ProjectsService) CreateProjectColumn(ctx context.Context, projectID int
 //
 //meta:operation PATCH /projects/columns/{column_id}
 func (s *ProjectsService) UpdateProjectColumn(ctx context.Context, columnID int64, opts *ProjectColumnOptions) (*ProjectColumn, *Response, error) {
+	if opts != nil {
+		ctx = WithRequestMetadata(ctx, opts.Metadata)
+	}
+
 	u := fmt.Sprintf("projects/columns/%v", columnID)
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
@@ -401,6 +421,10 @@ type ProjectCardOptions struct {
 	// Use true to archive a project card.
 	// Specify false if you need to restore a previously archived project card.
 	Archived *bool `json:"archived,omitempty"`
+
+	// Metadata is sent along with the request for audit purposes; see
+	// RequestMetadata. (Optional.)
+	Metadata RequestMetadata `json:"-"`
 }
 
 // CreateProjectCard creates a card in the specified column of a GitHub Project.
@@ -409,6 +433,10 @@ type ProjectCardOptions struct {
 //
 //meta:operation POST /projects/columns/{column_id}/cards
 func (s *ProjectsService) CreateProjectCard(ctx context.Context, columnID int64, opts *ProjectCardOptions) (*ProjectCard, *Response, error) {
+	if opts != nil {
+		ctx = WithRequestMetadata(ctx, opts.Metadata)
+	}
+
 	u := fmt.Sprintf("projects/columns/%v/cards", columnID)
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
@@ -433,6 +461,10 @@ func (s *ProjectsService) CreateProjectCard(ctx context.Context, columnID int64,
 //
 //meta:operation PATCH /projects/columns/cards/{card_id}
 func (s *ProjectsService) UpdateProjectCard(ctx context.Context, cardID int64, opts *ProjectCardOptions) (*ProjectCard, *Response, error) {
+	if opts != nil {
+		ctx = WithRequestMetadata(ctx, opts.Metadata)
+	}
+
 	u := fmt.Sprintf("projects/columns/cards/%v", cardID)
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
@@ -519,7 +551,7 @@ type ProjectCollaboratorOptions struct {
 //
 //meta:operation PUT /projects/{project_id}/collaborators/{username}
 func (s *ProjectsService) AddProjectCollaborator(ctx context.Context, id int64, username string, opts *ProjectCollaboratorOptions) (*Response, error) {
-	u := fmt.Sprintf("projects/%v/collaborators/%v", id, username)
+	u := fmt.Sprintf("projects/%v/collaborators/%v", id, PathEscape(username))
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, err
@@ -538,7 +570,7 @@ func (s *ProjectsService) AddProjectCollaborator(ctx context.Context, id int64,
 //
 //meta:operation DELETE /projects/{project_id}/collaborators/{username}
 func (s *ProjectsService) RemoveProjectCollaborator(ctx context.Context, id int64, username string) (*Response, error) {
-	u := fmt.Sprintf("projects/%v/collaborators/%v", id, username)
+	u := fmt.Sprintf("projects/%v/collaborators/%v", id, PathEscape(username))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -616,7 +648,7 @@ type ProjectPermissionLevel struct {
 //
 //meta:operation GET /projects/{project_id}/collaborators/{username}/permission
 func (s *ProjectsService) ReviewProjectCollaboratorPermission(ctx context.Context, id int64, username string) (*ProjectPermissionLevel, *Response, error) {
-	u := fmt.Sprintf("projects/%v/collaborators/%v/permission", id, username)
+	u := fmt.Sprintf("projects/%v/collaborators/%v/permission", id, PathEscape(username))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
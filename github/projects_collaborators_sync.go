@@ -0,0 +1,277 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// defaultProjectCollaboratorSyncConcurrency is the default number of changes
+// SyncProjectCollaboratorsWithTeam applies at once.
+const defaultProjectCollaboratorSyncConcurrency = 4
+
+// ProjectCollaboratorSyncOptions specifies the optional parameters to the
+// ProjectsService.SyncProjectCollaboratorsWithTeam method.
+type ProjectCollaboratorSyncOptions struct {
+	// DryRun reports the changes needed without applying them.
+	DryRun bool
+
+	// Concurrency is the maximum number of requests sent at once to look up
+	// permissions and apply changes. The default is 4.
+	Concurrency int
+}
+
+// ProjectCollaboratorSyncReport reports the changes made, or to be made in
+// a dry run, by ProjectsService.SyncProjectCollaboratorsWithTeam. Logins are
+// sorted.
+type ProjectCollaboratorSyncReport struct {
+	Added       []string
+	Removed     []string
+	RoleChanged []string
+	Failed      []*ProjectCollaboratorSyncFailure
+}
+
+// ProjectCollaboratorSyncFailure is a change of a collaborator that failed.
+type ProjectCollaboratorSyncFailure struct {
+	Login string
+	// Op is the failed operation: "review", "add", "update" or "remove".
+	Op  string
+	Err error
+}
+
+func (f *ProjectCollaboratorSyncFailure) Error() string {
+	return fmt.Sprintf("%v %v: %v", f.Op, f.Login, f.Err)
+}
+
+// projectCollaboratorChange is a change computed by diffProjectCollaborators.
+type projectCollaboratorChange struct {
+	login string
+	op    string // "add", "update" or "remove"
+}
+
+// SyncProjectCollaboratorsWithTeam makes the members of the team with the
+// given slug the direct collaborators of the organization project with the
+// given ID, with the given role: "read", "write" or "admin".
+//
+// Team members who are not direct collaborators are added with role, and
+// direct collaborators who are not team members are removed. Team members who
+// are direct collaborators but whose permission on the project is not role are
+// updated to role. Collaborators with access through other means, such as
+// organization ownership or base permissions, are left alone, but the
+// permission compared to role is the effective one.
+//
+// Errors listing the team members or the collaborators are returned as is,
+// with no change applied. Failures looking up permissions or applying changes
+// are collected in the report, and do not stop the other changes. The returned
+// Response is the one of the last listing request.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/collaborators#add-project-collaborator
+// GitHub API docs: https://docs.github.com/rest/projects/collaborators#get-project-permission-for-a-user
+// GitHub API docs: https://docs.github.com/rest/projects/collaborators#list-project-collaborators
+// GitHub API docs: https://docs.github.com/rest/projects/collaborators#remove-user-as-a-collaborator
+// GitHub API docs: https://docs.github.com/rest/teams/members#list-team-members
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/members
+//meta:operation GET /projects/{project_id}/collaborators
+//meta:operation DELETE /projects/{project_id}/collaborators/{username}
+//meta:operation PUT /projects/{project_id}/collaborators/{username}
+//meta:operation GET /projects/{project_id}/collaborators/{username}/permission
+func (s *ProjectsService) SyncProjectCollaboratorsWithTeam(ctx context.Context, org string, projectID int64, teamSlug, role string, opts *ProjectCollaboratorSyncOptions) (*ProjectCollaboratorSyncReport, *Response, error) {
+	switch role {
+	case "read", "write", "admin":
+	default:
+		return nil, nil, fmt.Errorf("invalid project collaborator role %q, want read, write or admin", role)
+	}
+	if opts == nil {
+		opts = &ProjectCollaboratorSyncOptions{}
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultProjectCollaboratorSyncConcurrency
+	}
+
+	var members []string
+	teamOpts := &TeamListTeamMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		users, resp, err := s.client.Teams.ListTeamMembersBySlug(ctx, org, teamSlug, teamOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, u := range users {
+			members = append(members, u.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		teamOpts.Page = resp.NextPage
+	}
+
+	var collaborators []string
+	var resp *Response
+	collabOpts := &ListCollaboratorOptions{Affiliation: String("direct"), ListOptions: ListOptions{PerPage: 100}}
+	for {
+		var users []*User
+		var err error
+		users, resp, err = s.ListProjectCollaborators(ctx, projectID, collabOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, u := range users {
+			collaborators = append(collaborators, u.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		collabOpts.Page = resp.NextPage
+	}
+
+	report := &ProjectCollaboratorSyncReport{}
+	var mu sync.Mutex
+	fail := func(login, op string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		report.Failed = append(report.Failed, &ProjectCollaboratorSyncFailure{Login: login, Op: op, Err: err})
+	}
+
+	// Look up the permissions of the team members who are already direct
+	// collaborators, to find those whose role must change.
+	overlap := intersectLogins(members, collaborators)
+	permissions := make(map[string]string, len(overlap))
+	runBounded(len(overlap), concurrency, func(i int) {
+		login := overlap[i]
+		level, _, err := s.ReviewProjectCollaboratorPermission(ctx, projectID, login)
+		if err != nil {
+			fail(login, "review", err)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		permissions[strings.ToLower(login)] = level.GetPermission()
+	})
+
+	changes := diffProjectCollaborators(members, collaborators, permissions, role)
+	runBounded(len(changes), concurrency, func(i int) {
+		c := changes[i]
+		if !opts.DryRun {
+			var err error
+			switch c.op {
+			case "add", "update":
+				_, err = s.AddProjectCollaborator(ctx, projectID, c.login, &ProjectCollaboratorOptions{Permission: String(role)})
+			case "remove":
+				_, err = s.RemoveProjectCollaborator(ctx, projectID, c.login)
+			}
+			if err != nil {
+				fail(c.login, c.op, err)
+				return
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch c.op {
+		case "add":
+			report.Added = append(report.Added, c.login)
+		case "update":
+			report.RoleChanged = append(report.RoleChanged, c.login)
+		case "remove":
+			report.Removed = append(report.Removed, c.login)
+		}
+	})
+
+	sort.Strings(report.Added)
+	sort.Strings(report.Removed)
+	sort.Strings(report.RoleChanged)
+	sort.Slice(report.Failed, func(i, j int) bool {
+		if report.Failed[i].Login != report.Failed[j].Login {
+			return report.Failed[i].Login < report.Failed[j].Login
+		}
+		return report.Failed[i].Op < report.Failed[j].Op
+	})
+
+	return report, resp, nil
+}
+
+// diffProjectCollaborators returns the changes converging the direct
+// collaborators of a project to the team members with role. permissions maps
+// the lowercased logins of the members who are already collaborators to their
+// permission; members missing from it are left unchanged. Logins are compared
+// case-insensitively, and the changes are sorted by login.
+func diffProjectCollaborators(members, collaborators []string, permissions map[string]string, role string) []projectCollaboratorChange {
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[strings.ToLower(m)] = true
+	}
+	isCollaborator := make(map[string]bool, len(collaborators))
+	for _, c := range collaborators {
+		isCollaborator[strings.ToLower(c)] = true
+	}
+
+	var changes []projectCollaboratorChange
+	seen := make(map[string]bool)
+	for _, m := range members {
+		key := strings.ToLower(m)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if !isCollaborator[key] {
+			changes = append(changes, projectCollaboratorChange{login: m, op: "add"})
+		} else if p, ok := permissions[key]; ok && p != role {
+			changes = append(changes, projectCollaboratorChange{login: m, op: "update"})
+		}
+	}
+	for _, c := range collaborators {
+		key := strings.ToLower(c)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if !isMember[key] {
+			changes = append(changes, projectCollaboratorChange{login: c, op: "remove"})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].login < changes[j].login })
+	return changes
+}
+
+// intersectLogins returns the logins of a that are also in b, compared
+// case-insensitively.
+func intersectLogins(a, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, login := range b {
+		inB[strings.ToLower(login)] = true
+	}
+	var both []string
+	for _, login := range a {
+		if key := strings.ToLower(login); inB[key] {
+			both = append(both, login)
+			delete(inB, key)
+		}
+	}
+	return both
+}
+
+// runBounded calls fn for each index in [0, n), running at most limit calls
+// at once, and returns when all calls returned.
+func runBounded(n, limit int, fn func(i int)) {
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
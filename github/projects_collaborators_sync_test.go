@@ -0,0 +1,218 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffProjectCollaborators(t *testing.T) {
+	tests := map[string]struct {
+		members       []string
+		collaborators []string
+		permissions   map[string]string
+		want          []projectCollaboratorChange
+	}{
+		"in sync": {
+			members:       []string{"a", "b"},
+			collaborators: []string{"b", "a"},
+			permissions:   map[string]string{"a": "write", "b": "write"},
+		},
+		"overlapping membership": {
+			members:       []string{"alice", "bob", "carol"},
+			collaborators: []string{"bob", "dave", "erin"},
+			permissions:   map[string]string{"bob": "write"},
+			want: []projectCollaboratorChange{
+				{login: "alice", op: "add"},
+				{login: "carol", op: "add"},
+				{login: "dave", op: "remove"},
+				{login: "erin", op: "remove"},
+			},
+		},
+		"role upgrade": {
+			members:       []string{"alice", "bob"},
+			collaborators: []string{"alice", "bob"},
+			permissions:   map[string]string{"alice": "read", "bob": "write"},
+			want:          []projectCollaboratorChange{{login: "alice", op: "update"}},
+		},
+		"unknown permission": {
+			members:       []string{"alice"},
+			collaborators: []string{"alice"},
+		},
+		"case insensitive logins": {
+			members:       []string{"Alice", "alice"},
+			collaborators: []string{"ALICE"},
+			permissions:   map[string]string{"alice": "admin"},
+			want:          []projectCollaboratorChange{{login: "Alice", op: "update"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := diffProjectCollaborators(test.members, test.collaborators, test.permissions, "write")
+			if !cmp.Equal(got, test.want, cmp.AllowUnexported(projectCollaboratorChange{})) {
+				t.Errorf("diffProjectCollaborators = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+// setupProjectCollaboratorSync registers handlers for a team with members
+// alice, bob and carol, listed over two pages, and a project with direct
+// collaborators bob (read) and dave. It returns the changes received.
+func setupProjectCollaboratorSync(t *testing.T, mux *http.ServeMux) func() []string {
+	t.Helper()
+
+	mux.HandleFunc("/orgs/o/teams/t/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/teams/t/members?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"login": "alice"}, {"login": "bob"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"login": "carol"}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+	mux.HandleFunc("/projects/1/collaborators", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"affiliation": "direct", "per_page": "100"})
+		fmt.Fprint(w, `[{"login": "bob"}, {"login": "dave"}]`)
+	})
+	mux.HandleFunc("/projects/1/collaborators/bob/permission", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"permission": "read", "user": {"login": "bob"}}`)
+	})
+
+	var mu sync.Mutex
+	var changes []string
+	for _, login := range []string{"alice", "bob", "carol", "dave"} {
+		login := login
+		mux.HandleFunc("/projects/1/collaborators/"+login, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "PUT" {
+				testBody(t, r, `{"permission":"write"}`+"\n")
+			}
+			if login == "carol" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			changes = append(changes, r.Method+" "+login)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		sort.Strings(changes)
+		return changes
+	}
+}
+
+func TestProjectsService_SyncProjectCollaboratorsWithTeam(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	changes := setupProjectCollaboratorSync(t, mux)
+
+	ctx := context.Background()
+	opts := &ProjectCollaboratorSyncOptions{Concurrency: 2}
+	report, _, err := client.Projects.SyncProjectCollaboratorsWithTeam(ctx, "o", 1, "t", "write", opts)
+	if err != nil {
+		t.Fatalf("Projects.SyncProjectCollaboratorsWithTeam returned error: %v", err)
+	}
+
+	if len(report.Failed) != 1 || report.Failed[0].Login != "carol" || report.Failed[0].Op != "add" {
+		t.Fatalf("Projects.SyncProjectCollaboratorsWithTeam returned failures %v, want add carol", report.Failed)
+	}
+	if !strings.HasPrefix(report.Failed[0].Error(), "add carol: ") {
+		t.Errorf("Failed[0].Error() = %q, want prefix %q", report.Failed[0].Error(), "add carol: ")
+	}
+	report.Failed = nil
+	want := &ProjectCollaboratorSyncReport{
+		Added:       []string{"alice"},
+		Removed:     []string{"dave"},
+		RoleChanged: []string{"bob"},
+	}
+	if !cmp.Equal(report, want) {
+		t.Errorf("Projects.SyncProjectCollaboratorsWithTeam returned %+v, want %+v", report, want)
+	}
+
+	wantChanges := []string{"DELETE dave", "PUT alice", "PUT bob"}
+	if got := changes(); !cmp.Equal(got, wantChanges) {
+		t.Errorf("changes applied = %v, want %v", got, wantChanges)
+	}
+}
+
+func TestProjectsService_SyncProjectCollaboratorsWithTeam_dryRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	changes := setupProjectCollaboratorSync(t, mux)
+
+	ctx := context.Background()
+	opts := &ProjectCollaboratorSyncOptions{DryRun: true}
+	report, _, err := client.Projects.SyncProjectCollaboratorsWithTeam(ctx, "o", 1, "t", "write", opts)
+	if err != nil {
+		t.Fatalf("Projects.SyncProjectCollaboratorsWithTeam returned error: %v", err)
+	}
+
+	want := &ProjectCollaboratorSyncReport{
+		Added:       []string{"alice", "carol"},
+		Removed:     []string{"dave"},
+		RoleChanged: []string{"bob"},
+	}
+	if !cmp.Equal(report, want) {
+		t.Errorf("Projects.SyncProjectCollaboratorsWithTeam returned %+v, want %+v", report, want)
+	}
+	if got := changes(); len(got) != 0 {
+		t.Errorf("changes applied in a dry run: %v", got)
+	}
+}
+
+func TestProjectsService_SyncProjectCollaboratorsWithTeam_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/teams/t/members", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"login": "alice"}]`)
+	})
+	mux.HandleFunc("/projects/1/collaborators", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/projects/1/collaborators/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected change %v %v", r.Method, r.URL)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Projects.SyncProjectCollaboratorsWithTeam(ctx, "o", 1, "t", "maintain", nil); err == nil {
+		t.Error("Projects.SyncProjectCollaboratorsWithTeam returned no error for an invalid role")
+	}
+
+	_, resp, err := client.Projects.SyncProjectCollaboratorsWithTeam(ctx, "o", 1, "t", "write", nil)
+	if err == nil {
+		t.Fatal("Projects.SyncProjectCollaboratorsWithTeam returned no error when listing collaborators failed")
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Projects.SyncProjectCollaboratorsWithTeam returned response %+v, want status 403", resp)
+	}
+
+	_, _, err = client.Projects.SyncProjectCollaboratorsWithTeam(ctx, "\n", 1, "t", "write", nil)
+	if err == nil {
+		t.Error("Projects.SyncProjectCollaboratorsWithTeam returned no error for a bad org")
+	}
+}
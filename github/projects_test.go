@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -696,12 +697,36 @@ func TestProjectsService_AddProjectCollaborator(t *testing.T) {
 }
 
 func TestProjectsService_AddCollaborator_invalidUser(t *testing.T) {
-	client, _, _, teardown := setup()
+	client, mux, _, teardown := setup()
 	defer teardown()
 
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %v %v", r.Method, r.URL.EscapedPath())
+	})
+
 	ctx := context.Background()
-	_, err := client.Projects.AddProjectCollaborator(ctx, 1, "%", nil)
-	testURLParseError(t, err)
+	_, err := client.Projects.AddProjectCollaborator(ctx, 1, "../../admin", nil)
+	if !errors.Is(err, ErrPathForbidden) {
+		t.Errorf("Projects.AddProjectCollaborator returned error %v, want %v", err, ErrPathForbidden)
+	}
+}
+
+func TestProjectsService_AddCollaborator_escapesUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		if got, want := r.URL.EscapedPath(), "/projects/1/collaborators/a%2Fb%23"; got != want {
+			t.Errorf("request path = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Projects.AddProjectCollaborator(ctx, 1, "a/b#", nil); err != nil {
+		t.Errorf("Projects.AddProjectCollaborator returned error: %v", err)
+	}
 }
 
 func TestProjectsService_RemoveCollaborator(t *testing.T) {
@@ -736,8 +761,10 @@ func TestProjectsService_RemoveCollaborator_invalidUser(t *testing.T) {
 	defer teardown()
 
 	ctx := context.Background()
-	_, err := client.Projects.RemoveProjectCollaborator(ctx, 1, "%")
-	testURLParseError(t, err)
+	_, err := client.Projects.RemoveProjectCollaborator(ctx, 1, "..")
+	if !errors.Is(err, ErrPathForbidden) {
+		t.Errorf("Projects.RemoveProjectCollaborator returned error %v, want %v", err, ErrPathForbidden)
+	}
 }
 
 func TestProjectsService_ListCollaborators(t *testing.T) {
@@ -0,0 +1,63 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListProjectsPaginationOptions specifies the cursor pagination of the
+// projects v2 List methods.
+type ListProjectsPaginationOptions struct {
+	// A cursor, as given in the Link header. If specified, the query only
+	// searches for results before this cursor.
+	Before string `url:"before,omitempty"`
+
+	// A cursor, as given in the Link header. If specified, the query only
+	// searches for results after this cursor.
+	After string `url:"after,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// ListProjectsOptions specifies the optional parameters to the
+// ProjectsService.ListOrganizationProjects method.
+type ListProjectsOptions struct {
+	// Query filters the projects by their title and description, or with
+	// project qualifiers such as "is:open".
+	Query string `url:"q,omitempty"`
+
+	ListProjectsPaginationOptions
+}
+
+// ListOrganizationProjects lists the projects v2 of the specified
+// organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/projects#list-projects-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2
+func (s *ProjectsService) ListOrganizationProjects(ctx context.Context, org string, opts *ListProjectsOptions) ([]*ProjectsV2, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2", PathEscape(org))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projects []*ProjectsV2
+	resp, err := s.client.Do(ctx, req, &projects)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return projects, resp, nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The formats of ProjectsService.ExportOrganizationProject.
+const (
+	// ProjectExportCSV writes a header row, then one row per item.
+	ProjectExportCSV = "csv"
+	// ProjectExportJSONLines writes one JSON object per line and item.
+	ProjectExportJSONLines = "jsonl"
+)
+
+// ProjectExportOptions specifies the optional parameters to the
+// ProjectsService.ExportOrganizationProject method.
+type ProjectExportOptions struct {
+	// Format is ProjectExportCSV, the default, or ProjectExportJSONLines.
+	Format string
+
+	// IncludeArchived includes the archived items, which are skipped by
+	// default.
+	IncludeArchived bool
+}
+
+// projectExportColumns are the CSV columns written before those of the
+// fields of the project.
+var projectExportColumns = []string{"ID", "Type", "Title", "URL", "Archived At"}
+
+// projectExportItem is a line of a ProjectExportJSONLines export.
+type projectExportItem struct {
+	ID          int64             `json:"id"`
+	ContentType string            `json:"content_type"`
+	Title       string            `json:"title"`
+	URL         string            `json:"url,omitempty"`
+	ArchivedAt  *Timestamp        `json:"archived_at,omitempty"`
+	Fields      map[string]string `json:"fields"`
+}
+
+// projectExportValue renders v as DisplayValue does, except that several
+// values are separated by ";".
+func projectExportValue(v *ProjectV2ItemFieldValue) string {
+	if v != nil && v.Values != nil {
+		return strings.Join(v.displayValues(), ";")
+	}
+	return v.DisplayValue()
+}
+
+// ExportOrganizationProject writes the items of the project v2 with the given
+// number in the specified organization to w, as CSV or JSON lines depending
+// on opts. Each item has its ID, content type, the title and URL of its
+// issue, pull request or draft issue, and the display values of its fields;
+// fields with several values, such as labels, are rendered as values
+// separated by ";". The items are written a page at a time, as they are
+// fetched, so exporting a large project does not hold it in memory.
+// ExportOrganizationProject pauses whenever it is rate limited, until ctx is
+// done.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#list-project-fields-for-organization
+// GitHub API docs: https://docs.github.com/rest/projects/items#list-items-for-an-organization-owned-project
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/fields
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/items
+func (s *ProjectsService) ExportOrganizationProject(ctx context.Context, org string, projectNumber int, w io.Writer, opts *ProjectExportOptions) error {
+	if opts == nil {
+		opts = &ProjectExportOptions{}
+	}
+	switch opts.Format {
+	case "", ProjectExportCSV, ProjectExportJSONLines:
+	default:
+		return fmt.Errorf("unknown project export format %q", opts.Format)
+	}
+
+	fields, _, _, err := s.projectFields(ctx, org, projectNumber, true)
+	if err != nil {
+		return err
+	}
+	var columns []*ProjectV2Field
+	listOpts := &ListProjectItemsOptions{ListProjectsPaginationOptions: ListProjectsPaginationOptions{PerPage: 100}}
+	for _, f := range fields {
+		if f.GetDataType() != ProjectFieldTypeTitle {
+			columns = append(columns, f)
+			listOpts.Fields = append(listOpts.Fields, f.GetID())
+		}
+	}
+
+	// write writes an item, and flush is called after each page of items.
+	var write func(*ProjectV2Item) error
+	var flush func() error
+	if opts.Format == ProjectExportJSONLines {
+		enc := json.NewEncoder(w)
+		write = func(item *ProjectV2Item) error {
+			values := projectItemValues(item)
+			line := &projectExportItem{
+				ID:          item.GetID(),
+				ContentType: item.GetContentType(),
+				Title:       item.GetContent().GetTitle(),
+				URL:         item.GetContent().GetHTMLURL(),
+				ArchivedAt:  item.ArchivedAt,
+				Fields:      map[string]string{},
+			}
+			for _, f := range columns {
+				if v := projectExportValue(values[f.GetID()]); v != "" {
+					line.Fields[f.GetName()] = v
+				}
+			}
+			return enc.Encode(line)
+		}
+		flush = func() error { return nil }
+	} else {
+		cw := csv.NewWriter(w)
+		header := append([]string(nil), projectExportColumns...)
+		for _, f := range columns {
+			header = append(header, f.GetName())
+		}
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+		write = func(item *ProjectV2Item) error {
+			values := projectItemValues(item)
+			row := []string{
+				strconv.FormatInt(item.GetID(), 10),
+				item.GetContentType(),
+				item.GetContent().GetTitle(),
+				item.GetContent().GetHTMLURL(),
+				"",
+			}
+			if item.IsArchived() {
+				row[4] = item.GetArchivedAt().Format(time.RFC3339)
+			}
+			for _, f := range columns {
+				row = append(row, projectExportValue(values[f.GetID()]))
+			}
+			return cw.Write(row)
+		}
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	}
+
+	return s.eachProjectItemsPage(ctx, org, projectNumber, listOpts, func(items []*ProjectV2Item) error {
+		for _, item := range items {
+			if item.IsArchived() && !opts.IncludeArchived {
+				continue
+			}
+			if err := write(item); err != nil {
+				return err
+			}
+		}
+		return flush()
+	})
+}
+
+// projectItemValues returns the values of the fields of item by field ID.
+func projectItemValues(item *ProjectV2Item) map[int64]*ProjectV2ItemFieldValue {
+	values := make(map[int64]*ProjectV2ItemFieldValue, len(item.Fields))
+	for _, f := range item.Fields {
+		values[f.GetID()] = f.Value
+	}
+	return values
+}
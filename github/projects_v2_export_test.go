@@ -0,0 +1,158 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleProjectBoard serves a small board as project 1 of organization o: its
+// fields, and its items over two pages.
+func handleProjectBoard(t *testing.T, mux *http.ServeMux) {
+	t.Helper()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"name":"Title","data_type":"title"},
+			{"id":2,"name":"Status","data_type":"single_select","options":[{"id":"a1","name":{"raw":"Todo","html":"Todo"}}]},
+			{"id":3,"name":"Labels","data_type":"labels"},
+			{"id":4,"name":"Estimate","data_type":"number"}
+		]`)
+	})
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("after") {
+		case "":
+			testFormValues(t, r, values{"fields": "2,3,4", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/projectsV2/1/items?per_page=100&after=c1>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"id":10,"content_type":"Issue","content":{"title":"Fix the build","html_url":"https://github.com/o/r/issues/1"},"fields":[
+					{"id":2,"name":"Status","data_type":"single_select","value":{"id":"a1","name":{"raw":"Todo","html":"Todo"}}},
+					{"id":3,"name":"Labels","data_type":"labels","value":[{"name":"bug"},{"name":"ci, tooling"}]},
+					{"id":4,"name":"Estimate","data_type":"number","value":2.5}
+				]},
+				{"id":11,"content_type":"DraftIssue","content":{"title":"Write \"docs\""},"fields":[
+					{"id":3,"name":"Labels","data_type":"labels","value":[]}
+				]}
+			]`)
+		case "c1":
+			fmt.Fprint(w, `[
+				{"id":12,"content_type":"PullRequest","content":{"title":"Add export","html_url":"https://github.com/o/r/pull/2"},"fields":[
+					{"id":4,"name":"Estimate","data_type":"number","value":1}
+				]},
+				{"id":13,"content_type":"Issue","content":{"title":"Old","html_url":"https://github.com/o/r/issues/3"},"archived_at":`+referenceTimeStr+`}
+			]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.FormValue("after"))
+		}
+	})
+}
+
+func TestProjectsService_ExportOrganizationProject_csv(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleProjectBoard(t, mux)
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	if err := client.Projects.ExportOrganizationProject(ctx, "o", 1, &buf, nil); err != nil {
+		t.Fatalf("Projects.ExportOrganizationProject returned error: %v", err)
+	}
+
+	want := `ID,Type,Title,URL,Archived At,Status,Labels,Estimate
+10,Issue,Fix the build,https://github.com/o/r/issues/1,,Todo,"bug;ci, tooling",2.5
+11,DraftIssue,"Write ""docs""",,,,,
+12,PullRequest,Add export,https://github.com/o/r/pull/2,,,,1
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("Projects.ExportOrganizationProject wrote diff (-want +got):\n%v", diff)
+	}
+}
+
+func TestProjectsService_ExportOrganizationProject_includeArchived(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleProjectBoard(t, mux)
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	opts := &ProjectExportOptions{Format: ProjectExportCSV, IncludeArchived: true}
+	if err := client.Projects.ExportOrganizationProject(ctx, "o", 1, &buf, opts); err != nil {
+		t.Fatalf("Projects.ExportOrganizationProject returned error: %v", err)
+	}
+
+	want := `ID,Type,Title,URL,Archived At,Status,Labels,Estimate
+10,Issue,Fix the build,https://github.com/o/r/issues/1,,Todo,"bug;ci, tooling",2.5
+11,DraftIssue,"Write ""docs""",,,,,
+12,PullRequest,Add export,https://github.com/o/r/pull/2,,,,1
+13,Issue,Old,https://github.com/o/r/issues/3,2006-01-02T15:04:05Z,,,
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("Projects.ExportOrganizationProject wrote diff (-want +got):\n%v", diff)
+	}
+}
+
+func TestProjectsService_ExportOrganizationProject_jsonLines(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleProjectBoard(t, mux)
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	opts := &ProjectExportOptions{Format: ProjectExportJSONLines}
+	if err := client.Projects.ExportOrganizationProject(ctx, "o", 1, &buf, opts); err != nil {
+		t.Fatalf("Projects.ExportOrganizationProject returned error: %v", err)
+	}
+
+	want := `{"id":10,"content_type":"Issue","title":"Fix the build","url":"https://github.com/o/r/issues/1","fields":{"Estimate":"2.5","Labels":"bug;ci, tooling","Status":"Todo"}}
+{"id":11,"content_type":"DraftIssue","title":"Write \"docs\"","fields":{}}
+{"id":12,"content_type":"PullRequest","title":"Add export","url":"https://github.com/o/r/pull/2","fields":{"Estimate":"1"}}
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("Projects.ExportOrganizationProject wrote diff (-want +got):\n%v", diff)
+	}
+}
+
+func TestProjectsService_ExportOrganizationProject_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	if err := client.Projects.ExportOrganizationProject(ctx, "o", 1, &buf, &ProjectExportOptions{Format: "xlsx"}); err == nil {
+		t.Error("Projects.ExportOrganizationProject returned no error for an unknown format")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Projects.ExportOrganizationProject wrote %q for an unknown format, want nothing", buf.String())
+	}
+
+	if err := client.Projects.ExportOrganizationProject(ctx, "o", 1, &buf, nil); err == nil {
+		t.Error("Projects.ExportOrganizationProject returned no error")
+	}
+
+	const methodName = "ExportOrganizationProject"
+	testBadOptions(t, methodName, func() error {
+		return client.Projects.ExportOrganizationProject(ctx, "\n", 1, &buf, nil)
+	})
+}
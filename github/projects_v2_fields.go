@@ -0,0 +1,595 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The data types of the fields of a project v2, as returned by
+// ProjectV2Field.DataType. Other values may be added by GitHub.
+const (
+	ProjectFieldTypeTitle        = "title"
+	ProjectFieldTypeText         = "text"
+	ProjectFieldTypeNumber       = "number"
+	ProjectFieldTypeDate         = "date"
+	ProjectFieldTypeSingleSelect = "single_select"
+	ProjectFieldTypeIteration    = "iteration"
+)
+
+// projectFieldDateLayout is the format of the values of date fields.
+const projectFieldDateLayout = "2006-01-02"
+
+// ProjectV2Field represents a field of a project v2.
+type ProjectV2Field struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	// DataType is the type of the values of the field, one of the
+	// ProjectFieldType constants.
+	DataType *string `json:"data_type,omitempty"`
+	// Options are the options of a single select field.
+	Options   []*ProjectV2FieldOption `json:"options,omitempty"`
+	CreatedAt *Timestamp              `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp              `json:"updated_at,omitempty"`
+}
+
+// ProjectV2FieldOption represents an option of a single select field of a
+// project v2.
+type ProjectV2FieldOption struct {
+	ID          *string               `json:"id,omitempty"`
+	Name        *ProjectV2TextContent `json:"name,omitempty"`
+	Description *ProjectV2TextContent `json:"description,omitempty"`
+	Color       *string               `json:"color,omitempty"`
+}
+
+// ProjectV2TextContent represents a text of a project v2, both as written and
+// rendered.
+type ProjectV2TextContent struct {
+	Raw  *string `json:"raw,omitempty"`
+	HTML *string `json:"html,omitempty"`
+}
+
+// ProjectV2ItemFieldUpdate sets the value of a field of a project v2 item.
+type ProjectV2ItemFieldUpdate struct {
+	// ID is the ID of the field.
+	ID int64 `json:"id"`
+	// Value is the new value of the field: a string for text fields, a
+	// number for number fields, a date formatted as "2006-01-02" for date
+	// fields, and the ID of the option or iteration for single select and
+	// iteration fields. A nil Value clears the field.
+	Value interface{} `json:"value"`
+}
+
+// ProjectV2ItemContent represents the issue, pull request or draft issue of
+// a project v2 item.
+type ProjectV2ItemContent struct {
+	ID      *int64  `json:"id,omitempty"`
+	NodeID  *string `json:"node_id,omitempty"`
+	Number  *int    `json:"number,omitempty"`
+	Title   *string `json:"title,omitempty"`
+	Body    *string `json:"body,omitempty"`
+	State   *string `json:"state,omitempty"`
+	URL     *string `json:"url,omitempty"`
+	HTMLURL *string `json:"html_url,omitempty"`
+}
+
+// ProjectV2ItemField represents the value of a field of a project v2 item.
+type ProjectV2ItemField struct {
+	ID       *int64                   `json:"id,omitempty"`
+	Name     *string                  `json:"name,omitempty"`
+	DataType *string                  `json:"data_type,omitempty"`
+	Value    *ProjectV2ItemFieldValue `json:"value,omitempty"`
+}
+
+// ListProjectItemsOptions specifies the optional parameters to the
+// ProjectsService.ListOrganizationProjectItems method.
+type ListProjectItemsOptions struct {
+	// Query filters the items, using the same syntax as the project's
+	// filter bar, such as "status:Done assignee:octocat". Unlike
+	// ListProjectsOptions.Query, it matches the fields of the items, not the
+	// project. No filter is sent if it is empty.
+	Query string `url:"q,omitempty"`
+
+	// Fields lists the IDs of the fields whose values are returned. Only the
+	// title is returned by default. IDs of fields that do not exist on the
+	// project are ignored by GitHub, so their values are simply missing.
+	Fields []int64 `url:"fields,comma,omitempty"`
+
+	// ExcludeFields drops all the field values, including the title, which
+	// keeps the items small on wide projects. The items then have nil
+	// Fields. It cannot be combined with Fields.
+	ExcludeFields bool `url:"-"`
+
+	ListProjectsPaginationOptions
+}
+
+// GetProjectItemOptions specifies the optional parameters to the
+// ProjectsService.GetOrganizationProjectItem method.
+type GetProjectItemOptions struct {
+	// Fields lists the IDs of the fields whose values are returned, as in
+	// ListProjectItemsOptions.
+	Fields []int64 `url:"fields,comma,omitempty"`
+}
+
+type updateProjectV2ItemRequest struct {
+	Fields []*ProjectV2ItemFieldUpdate `json:"fields"`
+}
+
+// ListOrganizationProjectFields lists the fields of the project v2 with the
+// given number in the specified organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#list-project-fields-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/fields
+func (s *ProjectsService) ListOrganizationProjectFields(ctx context.Context, org string, projectNumber int, opts *ListCursorOptions) ([]*ProjectV2Field, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/fields", PathEscape(org), projectNumber)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fields []*ProjectV2Field
+	resp, err := s.client.Do(ctx, req, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return fields, resp, nil
+}
+
+// ListOrganizationProjectItems lists the items of the project v2 with the
+// given number in the specified organization. Unless opts.ExcludeFields is
+// set, the Fields of each item are non-nil, and empty if none of the
+// requested fields has a value.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#list-items-for-an-organization-owned-project
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/items
+func (s *ProjectsService) ListOrganizationProjectItems(ctx context.Context, org string, projectNumber int, opts *ListProjectItemsOptions) ([]*ProjectV2Item, *Response, error) {
+	exclude := opts != nil && opts.ExcludeFields
+	if exclude && len(opts.Fields) > 0 {
+		return nil, nil, errors.New("ListProjectItemsOptions.ExcludeFields cannot be combined with Fields")
+	}
+
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items", PathEscape(org), projectNumber)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []*ProjectV2Item
+	resp, err := s.client.Do(ctx, req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, item := range items {
+		setProjectItemFields(item, !exclude)
+	}
+	return items, resp, nil
+}
+
+// ProjectV2ItemAddOptions specifies the issue or pull request added by the
+// ProjectsService.AddOrganizationProjectItem method.
+type ProjectV2ItemAddOptions struct {
+	// Type is ProjectItemTypeIssue or ProjectItemTypePullRequest.
+	Type string `json:"type"`
+	// ID is the ID (not Number) of the issue or pull request.
+	ID int64 `json:"id"`
+
+	// Metadata is sent along with the request for audit purposes; see
+	// RequestMetadata. (Optional.)
+	Metadata RequestMetadata `json:"-"`
+}
+
+// AddOrganizationProjectItem adds an issue or pull request as an item of the
+// project v2 with the given number in the specified organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#add-item-to-organization-owned-project
+//
+//meta:operation POST /orgs/{org}/projectsV2/{project_number}/items
+func (s *ProjectsService) AddOrganizationProjectItem(ctx context.Context, org string, projectNumber int, opts *ProjectV2ItemAddOptions) (*ProjectV2Item, *Response, error) {
+	if opts != nil {
+		ctx = WithRequestMetadata(ctx, opts.Metadata)
+	}
+
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items", PathEscape(org), projectNumber)
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item := new(ProjectV2Item)
+	resp, err := s.client.Do(ctx, req, item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item, resp, nil
+}
+
+// eachProjectItemsPage calls fn with each page of the items of a project v2
+// listed with opts, from the page given by opts.After on, pausing whenever it
+// is rate limited, until ctx is done. opts.After is updated as the pages are
+// listed. If fn returns an error, eachProjectItemsPage stops and returns it.
+func (s *ProjectsService) eachProjectItemsPage(ctx context.Context, org string, projectNumber int, opts *ListProjectItemsOptions, fn func([]*ProjectV2Item) error) error {
+	for {
+		items, resp, err := s.ListOrganizationProjectItems(ctx, org, projectNumber, opts)
+		if retry, werr := waitForRateLimit(ctx, err); werr != nil {
+			return werr
+		} else if retry {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(items); err != nil {
+			return err
+		}
+
+		if resp.After == "" {
+			return nil
+		}
+		opts.After = resp.After
+	}
+}
+
+// GetOrganizationProjectItem gets an item of the project v2 with the given
+// number in the specified organization, with the values of the fields listed
+// by opts. Its Fields are non-nil, as for ListOrganizationProjectItems.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#get-an-item-for-an-organization-owned-project
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) GetOrganizationProjectItem(ctx context.Context, org string, projectNumber int, itemID int64, opts *GetProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items/%v", PathEscape(org), projectNumber, itemID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item := new(ProjectV2Item)
+	resp, err := s.client.Do(ctx, req, item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	setProjectItemFields(item, true)
+	return item, resp, nil
+}
+
+// setProjectItemFields makes the Fields of item nil if the field values were
+// not requested, and non-nil otherwise.
+func setProjectItemFields(item *ProjectV2Item, requested bool) {
+	switch {
+	case !requested:
+		item.Fields = nil
+	case item.Fields == nil:
+		item.Fields = []*ProjectV2ItemField{}
+	}
+}
+
+// ProjectV2ItemUpdateOptions specifies the optional parameters to the
+// ProjectsService.UpdateOrganizationProjectItem,
+// SetOrganizationProjectItemField and SetOrganizationProjectItemFieldValue
+// methods.
+type ProjectV2ItemUpdateOptions struct {
+	// Metadata is sent along with the request for audit purposes; see
+	// RequestMetadata. (Optional.)
+	Metadata RequestMetadata
+}
+
+// UpdateOrganizationProjectItem sets the values of fields of an item of the
+// project v2 with the given number in the specified organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#update-project-item-for-organization
+//
+//meta:operation PATCH /orgs/{org}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) UpdateOrganizationProjectItem(ctx context.Context, org string, projectNumber int, itemID int64, fields []*ProjectV2ItemFieldUpdate, opts *ProjectV2ItemUpdateOptions) (*ProjectV2Item, *Response, error) {
+	if opts != nil {
+		ctx = WithRequestMetadata(ctx, opts.Metadata)
+	}
+
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items/%v", PathEscape(org), projectNumber, itemID)
+	req, err := s.client.NewRequest("PATCH", u, &updateProjectV2ItemRequest{Fields: fields})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item := new(ProjectV2Item)
+	resp, err := s.client.Do(ctx, req, item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item, resp, nil
+}
+
+// SetOrganizationProjectItemField sets the field with the given name of an
+// item of the project v2 with the given number in the specified
+// organization, so that "set Status to Done" does not require looking up the
+// IDs of the field and option first. value is parsed according to the type
+// of the field: it is the name of the option of a single select field, a
+// number for a number field, and a date such as "2024-05-27", or an RFC 3339
+// time, for a date field.
+//
+// Names are matched exactly or, failing that, case-insensitively; a name
+// matching several fields or options is an error. The fields of the project
+// are cached by the client, and fetched again when a name cannot be resolved
+// against the cache, or once when GitHub rejects an update built from the
+// cache with a 404 or 422 response. Client.InvalidateProjectFields removes
+// them from the cache.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#list-project-fields-for-organization
+// GitHub API docs: https://docs.github.com/rest/projects/items#update-project-item-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/fields
+//meta:operation PATCH /orgs/{org}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) SetOrganizationProjectItemField(ctx context.Context, org string, projectNumber int, itemID int64, fieldName, value string, opts *ProjectV2ItemUpdateOptions) (*ProjectV2Item, *Response, error) {
+	return s.SetOrganizationProjectItemFieldValue(ctx, org, projectNumber, itemID, fieldName, value, opts)
+}
+
+// SetOrganizationProjectItemFieldValue is like SetOrganizationProjectItemField,
+// but value may also be an int, int64 or float64 for a number field, or a
+// time.Time or Timestamp for a date field. A nil value clears the field.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#list-project-fields-for-organization
+// GitHub API docs: https://docs.github.com/rest/projects/items#update-project-item-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/fields
+//meta:operation PATCH /orgs/{org}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) SetOrganizationProjectItemFieldValue(ctx context.Context, org string, projectNumber int, itemID int64, fieldName string, value interface{}, opts *ProjectV2ItemUpdateOptions) (*ProjectV2Item, *Response, error) {
+	update, resp, cached, err := s.resolveProjectFieldUpdate(ctx, org, projectNumber, fieldName, value, false)
+	if err != nil {
+		return nil, resp, err
+	}
+	item, resp, err := s.UpdateOrganizationProjectItem(ctx, org, projectNumber, itemID, []*ProjectV2ItemFieldUpdate{update}, opts)
+	if err == nil || !cached || resp == nil || (resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusUnprocessableEntity) {
+		return item, resp, err
+	}
+
+	// The cached fields may be out of date, for example if the field or
+	// option was deleted and created again, so fetch them and retry once.
+	update, resp, _, err = s.resolveProjectFieldUpdate(ctx, org, projectNumber, fieldName, value, true)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.UpdateOrganizationProjectItem(ctx, org, projectNumber, itemID, []*ProjectV2ItemFieldUpdate{update}, opts)
+}
+
+// InvalidateProjectFields removes the fields of the project v2 with the given
+// number in the specified organization from the client's cache, see
+// ProjectsService.SetOrganizationProjectItemField. They are fetched again the
+// next time they are needed.
+func (c *Client) InvalidateProjectFields(org string, projectNumber int) {
+	c.projectFieldsMu.Lock()
+	delete(c.projectFields, projectFieldsKey(org, projectNumber))
+	c.projectFieldsMu.Unlock()
+}
+
+// errProjectFieldStale wraps the resolution errors that fetching the fields of
+// the project again may fix, such as a field added since they were cached.
+type errProjectFieldStale struct{ error }
+
+// resolveProjectFieldUpdate builds the update setting the field named
+// fieldName to value, and reports whether it was built from cached fields.
+func (s *ProjectsService) resolveProjectFieldUpdate(ctx context.Context, org string, projectNumber int, fieldName string, value interface{}, refresh bool) (*ProjectV2ItemFieldUpdate, *Response, bool, error) {
+	fields, resp, cached, err := s.projectFields(ctx, org, projectNumber, refresh)
+	if err != nil {
+		return nil, resp, false, err
+	}
+
+	update, err := projectFieldUpdate(fields, fieldName, value)
+	var stale errProjectFieldStale
+	if errors.As(err, &stale) && cached {
+		return s.resolveProjectFieldUpdate(ctx, org, projectNumber, fieldName, value, true)
+	}
+	return update, resp, cached, err
+}
+
+// projectFieldsKey returns the key of the fields of a project v2 in the
+// client's cache.
+func projectFieldsKey(org string, projectNumber int) string {
+	return fmt.Sprintf("%v/%v", org, projectNumber)
+}
+
+// projectFields returns all the fields of a project v2, from the client's
+// cache unless refresh is set. It reports whether they come from the cache,
+// in which case the returned Response is nil; otherwise it is the response
+// to the last listing request. The cached fields are removed if the project
+// cannot be found.
+func (s *ProjectsService) projectFields(ctx context.Context, org string, projectNumber int, refresh bool) ([]*ProjectV2Field, *Response, bool, error) {
+	key := projectFieldsKey(org, projectNumber)
+	c := s.client
+
+	c.projectFieldsMu.Lock()
+	fields, ok := c.projectFields[key]
+	c.projectFieldsMu.Unlock()
+	if ok && !refresh {
+		return fields, nil, true, nil
+	}
+
+	fields = nil
+	var resp *Response
+	opts := &ListCursorOptions{PerPage: 100}
+	for {
+		var page []*ProjectV2Field
+		var err error
+		page, resp, err = s.ListOrganizationProjectFields(ctx, org, projectNumber, opts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				c.InvalidateProjectFields(org, projectNumber)
+			}
+			return nil, resp, false, err
+		}
+		fields = append(fields, page...)
+		if resp.After == "" {
+			break
+		}
+		opts.After = resp.After
+	}
+
+	c.projectFieldsMu.Lock()
+	if c.projectFields == nil {
+		c.projectFields = make(map[string][]*ProjectV2Field)
+	}
+	c.projectFields[key] = fields
+	c.projectFieldsMu.Unlock()
+
+	return fields, resp, false, nil
+}
+
+// matchNames returns the indexes of the names equal to name or, if there are
+// none, equal to it under Unicode case-folding.
+func matchNames(names []string, name string) []int {
+	var exact, folded []int
+	for i, n := range names {
+		switch {
+		case n == name:
+			exact = append(exact, i)
+		case strings.EqualFold(n, name):
+			folded = append(folded, i)
+		}
+	}
+	if len(exact) > 0 {
+		return exact
+	}
+	return folded
+}
+
+// projectField returns the field of a project v2 with the given name, as
+// matched by matchNames.
+func projectField(fields []*ProjectV2Field, fieldName string) (*ProjectV2Field, error) {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.GetName()
+	}
+	switch matches := matchNames(names, fieldName); len(matches) {
+	case 0:
+		return nil, errProjectFieldStale{fmt.Errorf("project has no field named %q, its fields are %q", fieldName, names)}
+	case 1:
+		return fields[matches[0]], nil
+	default:
+		return nil, fmt.Errorf("project field name %q is ambiguous: it matches %v fields", fieldName, len(matches))
+	}
+}
+
+func projectFieldUpdate(fields []*ProjectV2Field, fieldName string, value interface{}) (*ProjectV2ItemFieldUpdate, error) {
+	field, err := projectField(fields, fieldName)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &ProjectV2ItemFieldUpdate{ID: field.GetID()}
+	if value == nil {
+		return update, nil
+	}
+
+	switch typ := field.GetDataType(); typ {
+	case ProjectFieldTypeText:
+		update.Value, err = projectTextValue(value)
+	case ProjectFieldTypeNumber:
+		update.Value, err = projectNumberValue(value)
+	case ProjectFieldTypeDate:
+		update.Value, err = projectDateValue(value)
+	case ProjectFieldTypeSingleSelect:
+		update.Value, err = projectOptionValue(field, value)
+	default:
+		err = fmt.Errorf("setting fields of type %q by name is not supported", typ)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("project field %q: %w", fieldName, err)
+	}
+	return update, nil
+}
+
+func projectTextValue(value interface{}) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("text value must be a string, not %T", value)
+	}
+	return s, nil
+}
+
+func projectNumberValue(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number %q", v)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("number value must be a number or a string, not %T", value)
+}
+
+func projectDateValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(projectFieldDateLayout), nil
+	case Timestamp:
+		return v.Format(projectFieldDateLayout), nil
+	case string:
+		v = strings.TrimSpace(v)
+		if t, err := time.Parse(projectFieldDateLayout, v); err == nil {
+			return t.Format(projectFieldDateLayout), nil
+		}
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t.Format(projectFieldDateLayout), nil
+		}
+		return "", fmt.Errorf("invalid date %q, want a date such as %q", v, projectFieldDateLayout)
+	}
+	return "", fmt.Errorf("date value must be a time.Time, a Timestamp or a string, not %T", value)
+}
+
+func projectOptionValue(field *ProjectV2Field, value interface{}) (string, error) {
+	name, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("single select value must be the name of an option, not %T", value)
+	}
+
+	names := make([]string, len(field.Options))
+	for i, o := range field.Options {
+		names[i] = o.GetName().GetRaw()
+	}
+	switch matches := matchNames(names, name); len(matches) {
+	case 0:
+		return "", errProjectFieldStale{fmt.Errorf("no option named %q, the options are %q", name, names)}
+	case 1:
+		return field.Options[matches[0]].GetID(), nil
+	default:
+		return "", fmt.Errorf("option name %q is ambiguous: it matches %v options", name, len(matches))
+	}
+}
@@ -0,0 +1,663 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testProjectFieldsJSON = `[
+	{"id":1,"name":"Title","data_type":"text"},
+	{"id":2,"name":"Status","data_type":"single_select","options":[
+		{"id":"a1","name":{"raw":"Todo","html":"Todo"}},
+		{"id":"b2","name":{"raw":"Done","html":"Done"}}
+	]},
+	{"id":3,"name":"Estimate","data_type":"number"},
+	{"id":4,"name":"Due","data_type":"date"},
+	{"id":5,"name":"Sprint","data_type":"iteration"},
+	{"id":6,"name":"Notes","data_type":"text"},
+	{"id":7,"name":"notes","data_type":"text"}
+]`
+
+// handleProjectFields serves the fields of project 1 of organization o,
+// counting the requests in calls.
+func handleProjectFields(t *testing.T, mux *http.ServeMux, calls *int) {
+	t.Helper()
+	mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		*calls++
+		fmt.Fprint(w, testProjectFieldsJSON)
+	})
+}
+
+// handleProjectItemUpdate checks that the update of item 10 of project 1 of
+// organization o sets field fieldID to the JSON value want.
+func handleProjectItemUpdate(t *testing.T, mux *http.ServeMux, fieldID int64, want string) {
+	t.Helper()
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, fmt.Sprintf(`{"fields":[{"id":%v,"value":%v}]}`+"\n", fieldID, want))
+		fmt.Fprint(w, `{"id":10}`)
+	})
+}
+
+func TestProjectsService_ListOrganizationProjectFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "after": "c"})
+		fmt.Fprint(w, `[{"id":2,"node_id":"n","name":"Status","data_type":"single_select","options":[{"id":"a1","name":{"raw":"Todo","html":"Todo"},"color":"GRAY"}]}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListCursorOptions{PerPage: 2, After: "c"}
+	fields, _, err := client.Projects.ListOrganizationProjectFields(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Projects.ListOrganizationProjectFields returned error: %v", err)
+	}
+
+	want := []*ProjectV2Field{{
+		ID:       Int64(2),
+		NodeID:   String("n"),
+		Name:     String("Status"),
+		DataType: String(ProjectFieldTypeSingleSelect),
+		Options: []*ProjectV2FieldOption{{
+			ID:    String("a1"),
+			Name:  &ProjectV2TextContent{Raw: String("Todo"), HTML: String("Todo")},
+			Color: String("GRAY"),
+		}},
+	}}
+	if !cmp.Equal(fields, want) {
+		t.Errorf("Projects.ListOrganizationProjectFields returned %+v, want %+v", fields, want)
+	}
+
+	const methodName = "ListOrganizationProjectFields"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListOrganizationProjectFields(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListOrganizationProjectFields(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_ListOrganizationProjectItems(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "is:open", "fields": "2,3", "per_page": "2"})
+		fmt.Fprint(w, `[{"id":10,"content_type":"Issue","content":{"number":1,"title":"t"},"fields":[{"id":2,"name":"Status","data_type":"single_select","value":{"id":"b2","name":{"raw":"Done","html":"Done"}}}]}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListProjectItemsOptions{Query: "is:open", Fields: []int64{2, 3}, ListProjectsPaginationOptions: ListProjectsPaginationOptions{PerPage: 2}}
+	items, _, err := client.Projects.ListOrganizationProjectItems(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Projects.ListOrganizationProjectItems returned error: %v", err)
+	}
+
+	want := []*ProjectV2Item{{
+		ID:          Int64(10),
+		ContentType: String(ProjectItemTypeIssue),
+		Content:     &ProjectV2ItemContent{Number: Int(1), Title: String("t")},
+		Fields: []*ProjectV2ItemField{{
+			ID:       Int64(2),
+			Name:     String("Status"),
+			DataType: String(ProjectFieldTypeSingleSelect),
+			Value:    &ProjectV2ItemFieldValue{SingleSelectOption: &ProjectV2SingleSelectOption{ID: String("b2"), Name: String("Done")}},
+		}},
+	}}
+	if !cmp.Equal(items, want) {
+		t.Errorf("Projects.ListOrganizationProjectItems returned %+v, want %+v", items, want)
+	}
+
+	const methodName = "ListOrganizationProjectItems"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListOrganizationProjectItems(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListOrganizationProjectItems(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_ListOrganizationProjectItems_query(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var queries []string
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, ok := r.URL.Query()["q"]; ok {
+			queries = append(queries, r.URL.Query().Get("q"))
+		} else {
+			queries = append(queries, "<none>")
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	for _, opts := range []*ListProjectItemsOptions{
+		nil,
+		{ListProjectsPaginationOptions: ListProjectsPaginationOptions{PerPage: 2}},
+		{Query: "status:Done"},
+	} {
+		if _, _, err := client.Projects.ListOrganizationProjectItems(ctx, "o", 1, opts); err != nil {
+			t.Errorf("Projects.ListOrganizationProjectItems returned error: %v", err)
+		}
+	}
+
+	if want := []string{"<none>", "<none>", "status:Done"}; !cmp.Equal(queries, want) {
+		t.Errorf("Projects.ListOrganizationProjectItems sent queries %v, want %v", queries, want)
+	}
+}
+
+func TestProjectsService_ListOrganizationProjectItems_fields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch fields := r.FormValue("fields"); fields {
+		case "":
+			// The title is returned by default.
+			fmt.Fprint(w, `[{"id":10,"fields":[{"id":1,"name":"Title","data_type":"title","value":{"raw":"t","html":"t"}}]}]`)
+		case "2,99":
+			// Field 99 does not exist, and item 11 has no value for field 2.
+			fmt.Fprint(w, `[{"id":10,"fields":[{"id":2,"name":"Estimate","data_type":"number","value":3}]},{"id":11,"fields":[]},{"id":12}]`)
+		default:
+			t.Errorf("unexpected fields %q", fields)
+		}
+	})
+
+	ctx := context.Background()
+	items, _, err := client.Projects.ListOrganizationProjectItems(ctx, "o", 1, &ListProjectItemsOptions{ExcludeFields: true})
+	if err != nil {
+		t.Fatalf("Projects.ListOrganizationProjectItems returned error: %v", err)
+	}
+	if want := []*ProjectV2Item{{ID: Int64(10)}}; !cmp.Equal(items, want) || items[0].Fields != nil {
+		t.Errorf("Projects.ListOrganizationProjectItems with ExcludeFields returned %+v, want %+v", items, want)
+	}
+
+	items, _, err = client.Projects.ListOrganizationProjectItems(ctx, "o", 1, &ListProjectItemsOptions{Fields: []int64{2, 99}})
+	if err != nil {
+		t.Fatalf("Projects.ListOrganizationProjectItems returned error: %v", err)
+	}
+	want := []*ProjectV2Item{
+		{ID: Int64(10), Fields: []*ProjectV2ItemField{{
+			ID:       Int64(2),
+			Name:     String("Estimate"),
+			DataType: String(ProjectFieldTypeNumber),
+			Value:    &ProjectV2ItemFieldValue{Number: Float64(3)},
+		}}},
+		{ID: Int64(11), Fields: []*ProjectV2ItemField{}},
+		{ID: Int64(12), Fields: []*ProjectV2ItemField{}},
+	}
+	if !cmp.Equal(items, want) {
+		t.Errorf("Projects.ListOrganizationProjectItems returned %+v, want %+v", items, want)
+	}
+	for _, item := range items[1:] {
+		if item.Fields == nil {
+			t.Errorf("Projects.ListOrganizationProjectItems returned nil Fields for item %v, want an empty slice", item.GetID())
+		}
+	}
+
+	opts := &ListProjectItemsOptions{Fields: []int64{2}, ExcludeFields: true}
+	if _, _, err := client.Projects.ListOrganizationProjectItems(ctx, "o", 1, opts); err == nil {
+		t.Error("Projects.ListOrganizationProjectItems returned no error for ExcludeFields with Fields")
+	}
+}
+
+func TestProjectsService_GetOrganizationProjectItem(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"fields": "2,3"})
+		fmt.Fprint(w, `{"id":10,"content_type":"DraftIssue"}`)
+	})
+
+	ctx := context.Background()
+	opts := &GetProjectItemOptions{Fields: []int64{2, 3}}
+	item, _, err := client.Projects.GetOrganizationProjectItem(ctx, "o", 1, 10, opts)
+	if err != nil {
+		t.Errorf("Projects.GetOrganizationProjectItem returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Int64(10), ContentType: String(ProjectItemTypeDraftIssue), Fields: []*ProjectV2ItemField{}}
+	if !cmp.Equal(item, want) || item.Fields == nil {
+		t.Errorf("Projects.GetOrganizationProjectItem returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "GetOrganizationProjectItem"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.GetOrganizationProjectItem(ctx, "\n", 1, 10, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.GetOrganizationProjectItem(ctx, "o", 1, 10, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_AddOrganizationProjectItem(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "X-Audit-Ticket", "OPS-1")
+		testBody(t, r, `{"type":"Issue","id":5}`+"\n")
+		fmt.Fprint(w, `{"id":10,"content_type":"Issue"}`)
+	})
+
+	ctx := context.Background()
+	opts := &ProjectV2ItemAddOptions{Type: ProjectItemTypeIssue, ID: 5, Metadata: RequestMetadata{"Ticket": "OPS-1"}}
+	item, _, err := client.Projects.AddOrganizationProjectItem(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Projects.AddOrganizationProjectItem returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Int64(10), ContentType: String(ProjectItemTypeIssue)}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.AddOrganizationProjectItem returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "AddOrganizationProjectItem"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.AddOrganizationProjectItem(ctx, "..", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.AddOrganizationProjectItem(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_UpdateOrganizationProjectItem(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleProjectItemUpdate(t, mux, 2, `"b2"`)
+
+	ctx := context.Background()
+	fields := []*ProjectV2ItemFieldUpdate{{ID: 2, Value: "b2"}}
+	item, _, err := client.Projects.UpdateOrganizationProjectItem(ctx, "o", 1, 10, fields, nil)
+	if err != nil {
+		t.Errorf("Projects.UpdateOrganizationProjectItem returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Int64(10)}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.UpdateOrganizationProjectItem returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "UpdateOrganizationProjectItem"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.UpdateOrganizationProjectItem(ctx, "\n", 1, 10, fields, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.UpdateOrganizationProjectItem(ctx, "o", 1, 10, fields, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_SetOrganizationProjectItemField_metadata(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-Audit-Justification", "")
+		fmt.Fprint(w, testProjectFieldsJSON)
+	})
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "X-Audit-Justification", "release 1.2")
+		testHeader(t, r, "X-Audit-Ticket", "OPS-1")
+		fmt.Fprint(w, `{"id":10}`)
+	})
+
+	ctx := context.Background()
+	opts := &ProjectV2ItemUpdateOptions{Metadata: RequestMetadata{"Justification": "release 1.2", "Ticket": "OPS-1"}}
+	if _, _, err := client.Projects.SetOrganizationProjectItemField(ctx, "o", 1, 10, "Status", "Done", opts); err != nil {
+		t.Errorf("Projects.SetOrganizationProjectItemField returned error: %v", err)
+	}
+}
+
+func TestProjectV2ItemFieldUpdate_clear(t *testing.T) {
+	testJSONMarshal(t, &ProjectV2ItemFieldUpdate{ID: 1}, `{"id":1,"value":null}`)
+}
+
+func TestProjectsService_SetOrganizationProjectItemField(t *testing.T) {
+	tests := map[string]struct {
+		fieldName, value string
+		fieldID          int64
+		want             string
+	}{
+		"single select":         {"Status", "Done", 2, `"b2"`},
+		"single select folded":  {"status", "done", 2, `"b2"`},
+		"text":                  {"Title", "Fix the build", 1, `"Fix the build"`},
+		"exact name preferred":  {"notes", "n", 7, `"n"`},
+		"number":                {"Estimate", " 2.5 ", 3, `2.5`},
+		"integer":               {"Estimate", "3", 3, `3`},
+		"date":                  {"Due", "2024-05-27", 4, `"2024-05-27"`},
+		"date from RFC 3339":    {"Due", "2024-05-27T10:00:00Z", 4, `"2024-05-27"`},
+		"text of a folded name": {"TITLE", "t", 1, `"t"`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			var calls int
+			handleProjectFields(t, mux, &calls)
+			handleProjectItemUpdate(t, mux, tc.fieldID, tc.want)
+
+			ctx := context.Background()
+			item, _, err := client.Projects.SetOrganizationProjectItemField(ctx, "o", 1, 10, tc.fieldName, tc.value, nil)
+			if err != nil {
+				t.Fatalf("Projects.SetOrganizationProjectItemField returned error: %v", err)
+			}
+			if want := (&ProjectV2Item{ID: Int64(10)}); !cmp.Equal(item, want) {
+				t.Errorf("Projects.SetOrganizationProjectItemField returned %+v, want %+v", item, want)
+			}
+			if calls != 1 {
+				t.Errorf("Projects.SetOrganizationProjectItemField listed the fields %v times, want 1", calls)
+			}
+		})
+	}
+}
+
+func TestProjectsService_SetOrganizationProjectItemFieldValue(t *testing.T) {
+	due := time.Date(2024, time.May, 27, 23, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		fieldName string
+		value     interface{}
+		fieldID   int64
+		want      string
+	}{
+		"int":       {"Estimate", 3, 3, `3`},
+		"int64":     {"Estimate", int64(4), 3, `4`},
+		"float64":   {"Estimate", 0.5, 3, `0.5`},
+		"time":      {"Due", due, 4, `"2024-05-27"`},
+		"timestamp": {"Due", Timestamp{due}, 4, `"2024-05-27"`},
+		"clear":     {"Status", nil, 2, `null`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			var calls int
+			handleProjectFields(t, mux, &calls)
+			handleProjectItemUpdate(t, mux, tc.fieldID, tc.want)
+
+			ctx := context.Background()
+			if _, _, err := client.Projects.SetOrganizationProjectItemFieldValue(ctx, "o", 1, 10, tc.fieldName, tc.value, nil); err != nil {
+				t.Fatalf("Projects.SetOrganizationProjectItemFieldValue returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestProjectsService_SetOrganizationProjectItemField_cache(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	handleProjectFields(t, mux, &calls)
+	handleProjectItemUpdate(t, mux, 2, `"b2"`)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if _, _, err := client.Projects.SetOrganizationProjectItemField(ctx, "o", 1, 10, "Status", "Done", nil); err != nil {
+			t.Fatalf("Projects.SetOrganizationProjectItemField returned error: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Projects.SetOrganizationProjectItemField listed the fields %v times, want 1", calls)
+	}
+
+	// An unknown name is looked up again, in case the field was added since
+	// the fields were cached.
+	if _, _, err := client.Projects.SetOrganizationProjectItemField(ctx, "o", 1, 10, "Priority", "High", nil); err == nil {
+		t.Error("Projects.SetOrganizationProjectItemField returned no error for an unknown field")
+	}
+	if calls != 2 {
+		t.Errorf("Projects.SetOrganizationProjectItemField listed the fields %v times, want 2", calls)
+	}
+}
+
+func TestProjectsService_SetOrganizationProjectItemField_staleCache(t *testing.T) {
+	for _, status := range []int{http.StatusNotFound, http.StatusUnprocessableEntity} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			// The Status field is deleted and created again with ID 8 after
+			// the fields are first listed and cached by setting the Title.
+			var calls int
+			mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				calls++
+				if calls == 1 {
+					fmt.Fprint(w, testProjectFieldsJSON)
+					return
+				}
+				fmt.Fprint(w, `[{"id":8,"name":"Status","data_type":"single_select","options":[{"id":"c3","name":{"raw":"Done","html":"Done"}}]}]`)
+			})
+			var updates int
+			mux.HandleFunc("/orgs/o/projectsV2/1/items/10", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PATCH")
+				updates++
+				switch updates {
+				case 1:
+					testBody(t, r, `{"fields":[{"id":1,"value":"t"}]}`+"\n")
+				case 2:
+					w.WriteHeader(status)
+					return
+				default:
+					testBody(t, r, `{"fields":[{"id":8,"value":"c3"}]}`+"\n")
+				}
+				fmt.Fprint(w, `{"id":10}`)
+			})
+
+			ctx := context.Background()
+			if _, _, err := client.Projects.SetOrganizationProjectItemField(ctx, "o", 1, 10, "Title", "t", nil); err != nil {
+				t.Fatalf("Projects.SetOrganizationProjectItemField returned error: %v", err)
+			}
+			item, _, err := client.Projects.SetOrganizationProjectItemField(ctx, "o", 1, 10, "Status", "Done", nil)
+			if err != nil {
+				t.Fatalf("Projects.SetOrganizationProjectItemField returned error: %v", err)
+			}
+			if want := (&ProjectV2Item{ID: Int64(10)}); !cmp.Equal(item, want) {
+				t.Errorf("Projects.SetOrganizationProjectItemField returned %+v, want %+v", item, want)
+			}
+			if calls != 2 || updates != 3 {
+				t.Errorf("Projects.SetOrganizationProjectItemField listed the fields %v times and updated the item %v times, want 2 and 3", calls, updates)
+			}
+		})
+	}
+}
+
+func TestClient_InvalidateProjectFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	handleProjectFields(t, mux, &calls)
+	handleProjectItemUpdate(t, mux, 2, `"b2"`)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Projects.SetOrganizationProjectItemField(ctx, "o", 1, 10, "Status", "Done", nil); err != nil {
+			t.Fatalf("Projects.SetOrganizationProjectItemField returned error: %v", err)
+		}
+		client.InvalidateProjectFields("o", 1)
+	}
+	if calls != 2 {
+		t.Errorf("Projects.SetOrganizationProjectItemField listed the fields %v times, want 2", calls)
+	}
+
+	// Invalidating fields that are not cached does nothing.
+	client.InvalidateProjectFields("o", 2)
+}
+
+func TestProjectsService_SetOrganizationProjectItemField_updateError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls, updates int
+	handleProjectFields(t, mux, &calls)
+	mux.HandleFunc("/orgs/o/projectsV2/1/items/10", func(w http.ResponseWriter, r *http.Request) {
+		updates++
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	})
+
+	// Fields that were just fetched are not fetched again.
+	ctx := context.Background()
+	_, resp, err := client.Projects.SetOrganizationProjectItemField(ctx, "o", 1, 10, "Status", "Done", nil)
+	if err == nil {
+		t.Error("Projects.SetOrganizationProjectItemField returned no error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Projects.SetOrganizationProjectItemField returned response %v, want a 422", resp)
+	}
+	if calls != 1 || updates != 1 {
+		t.Errorf("Projects.SetOrganizationProjectItemField listed the fields %v times and updated the item %v times, want 1 and 1", calls, updates)
+	}
+}
+
+func TestProjectsService_SetOrganizationProjectItemField_errors(t *testing.T) {
+	tests := map[string]struct {
+		fieldName string
+		value     interface{}
+		wantErr   string
+	}{
+		"missing field":      {"Priority", "High", `project has no field named "Priority"`},
+		"ambiguous field":    {"NOTES", "n", `project field name "NOTES" is ambiguous: it matches 2 fields`},
+		"missing option":     {"Status", "Blocked", `project field "Status": no option named "Blocked", the options are ["Todo" "Done"]`},
+		"invalid number":     {"Estimate", "many", `project field "Estimate": invalid number "many"`},
+		"invalid date":       {"Due", "tomorrow", `project field "Due": invalid date "tomorrow"`},
+		"wrong value type":   {"Title", 1, `project field "Title": text value must be a string, not int`},
+		"unsupported type":   {"Sprint", "Sprint 1", `project field "Sprint": setting fields of type "iteration" by name is not supported`},
+		"wrong option value": {"Status", 1.0, `project field "Status": single select value must be the name of an option, not float64`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			var calls int
+			handleProjectFields(t, mux, &calls)
+			mux.HandleFunc("/orgs/o/projectsV2/1/items/10", func(w http.ResponseWriter, r *http.Request) {
+				t.Error("Projects.SetOrganizationProjectItemFieldValue updated the item")
+			})
+
+			ctx := context.Background()
+			_, resp, err := client.Projects.SetOrganizationProjectItemFieldValue(ctx, "o", 1, 10, tc.fieldName, tc.value, nil)
+			if err == nil || !strings.HasPrefix(err.Error(), tc.wantErr) {
+				t.Errorf("Projects.SetOrganizationProjectItemFieldValue returned error %v, want %v", err, tc.wantErr)
+			}
+			// The fields were fetched, so the response of the listing is returned.
+			if resp == nil || resp.StatusCode != http.StatusOK {
+				t.Errorf("Projects.SetOrganizationProjectItemFieldValue returned response %v, want the fields listing response", resp)
+			}
+		})
+	}
+}
+
+func TestProjectsService_SetOrganizationProjectItemField_listError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Projects.SetOrganizationProjectItemField(ctx, "o", 1, 10, "Status", "Done", nil)
+	if err == nil {
+		t.Error("Projects.SetOrganizationProjectItemField returned no error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Projects.SetOrganizationProjectItemField returned response %v, want a 404", resp)
+	}
+}
+
+func TestProjectV2Field_Marshal(t *testing.T) {
+	testJSONMarshal(t, &ProjectV2Field{}, "{}")
+
+	u := &ProjectV2Field{
+		ID:       Int64(1),
+		NodeID:   String("n"),
+		Name:     String("Status"),
+		DataType: String("single_select"),
+		Options: []*ProjectV2FieldOption{{
+			ID:          String("a1"),
+			Name:        &ProjectV2TextContent{Raw: String("Todo"), HTML: String("Todo")},
+			Description: &ProjectV2TextContent{Raw: String("d"), HTML: String("<p>d</p>")},
+			Color:       String("GRAY"),
+		}},
+		CreatedAt: &Timestamp{referenceTime},
+		UpdatedAt: &Timestamp{referenceTime},
+	}
+	want := `{
+		"id": 1,
+		"node_id": "n",
+		"name": "Status",
+		"data_type": "single_select",
+		"options": [{
+			"id": "a1",
+			"name": {"raw": "Todo", "html": "Todo"},
+			"description": {"raw": "d", "html": "<p>d</p>"},
+			"color": "GRAY"
+		}],
+		"created_at": ` + referenceTimeStr + `,
+		"updated_at": ` + referenceTimeStr + `
+	}`
+	testJSONMarshal(t, u, want)
+}
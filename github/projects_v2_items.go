@@ -0,0 +1,296 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The possible states of a ProjectsV2, as returned by ProjectsV2.State.
+const (
+	ProjectStateOpen   = "open"
+	ProjectStateClosed = "closed"
+)
+
+// The possible values of ProjectV2Item.ContentType. Other values may be
+// added by GitHub, and are decoded as is.
+const (
+	ProjectItemTypeIssue       = "Issue"
+	ProjectItemTypePullRequest = "PullRequest"
+	ProjectItemTypeDraftIssue  = "DraftIssue"
+)
+
+// IsClosed reports whether the project is closed, that is, whether its
+// ClosedAt is set. It returns false for a nil project.
+func (p *ProjectsV2) IsClosed() bool {
+	return !p.GetClosedAt().IsZero()
+}
+
+// IsOpen reports whether the project is open, that is, not closed. It returns
+// false for a nil project.
+func (p *ProjectsV2) IsOpen() bool {
+	return p != nil && !p.IsClosed()
+}
+
+// State returns ProjectStateOpen or ProjectStateClosed depending on whether
+// the project is closed, or an empty string for a nil project.
+func (p *ProjectsV2) State() string {
+	switch {
+	case p.IsClosed():
+		return ProjectStateClosed
+	case p.IsOpen():
+		return ProjectStateOpen
+	}
+	return ""
+}
+
+// IsArchived reports whether the item is archived, that is, whether its
+// ArchivedAt is set. It returns false for a nil item.
+func (i *ProjectV2Item) IsArchived() bool {
+	return !i.GetArchivedAt().IsZero()
+}
+
+// ProjectV2ItemFieldValue represents the value of a field of a project v2
+// item, as found in the changes of a projects_v2_item webhook event. Exactly
+// one of its fields is set for a non-empty value, depending on the type of
+// the field.
+type ProjectV2ItemFieldValue struct {
+	// Text is set for text and date fields.
+	Text *string
+	// Number is set for number fields.
+	Number *float64
+	// SingleSelectOption is set for single select fields.
+	SingleSelectOption *ProjectV2SingleSelectOption
+	// Iteration is set for iteration fields.
+	Iteration *ProjectV2Iteration
+	// Values is set for fields with several values, such as labels.
+	Values []*ProjectV2ItemFieldValue
+}
+
+// ProjectV2SingleSelectOption represents an option of a single select field
+// of a project v2.
+type ProjectV2SingleSelectOption struct {
+	ID          *string `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ProjectV2Iteration represents an iteration of an iteration field of a
+// project v2.
+type ProjectV2Iteration struct {
+	ID        *string `json:"id,omitempty"`
+	Title     *string `json:"title,omitempty"`
+	StartDate *string `json:"start_date,omitempty"`
+	Duration  *int    `json:"duration,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Strings and numbers are decoded into Text and Number, and arrays into
+// Values; objects are decoded into Iteration if they have a start_date or
+// duration, and into SingleSelectOption otherwise. The names, titles and
+// descriptions of objects may be strings, as in webhook events, or objects
+// with a raw and html text, as in the REST API.
+func (v *ProjectV2ItemFieldValue) UnmarshalJSON(data []byte) error {
+	*v = ProjectV2ItemFieldValue{}
+
+	data = bytes.TrimSpace(data)
+	switch {
+	case len(data) == 0 || bytes.Equal(data, []byte("null")):
+		return nil
+	case data[0] == '"':
+		return json.Unmarshal(data, &v.Text)
+	case data[0] == '[':
+		return json.Unmarshal(data, &v.Values)
+	case data[0] == '{':
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal(data, &keys); err != nil {
+			return err
+		}
+		for _, key := range []string{"name", "title", "description"} {
+			var text struct {
+				Raw *string `json:"raw"`
+			}
+			if raw, ok := keys[key]; ok && len(raw) > 0 && raw[0] == '{' {
+				if err := json.Unmarshal(raw, &text); err != nil {
+					return err
+				}
+				keys[key], _ = json.Marshal(text.Raw)
+			}
+		}
+		data, err := json.Marshal(keys)
+		if err != nil {
+			return err
+		}
+		_, hasStartDate := keys["start_date"]
+		_, hasDuration := keys["duration"]
+		if hasStartDate || hasDuration {
+			return json.Unmarshal(data, &v.Iteration)
+		}
+		return json.Unmarshal(data, &v.SingleSelectOption)
+	default:
+		return json.Unmarshal(data, &v.Number)
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It is the inverse of UnmarshalJSON.
+func (v *ProjectV2ItemFieldValue) MarshalJSON() ([]byte, error) {
+	switch {
+	case v.Text != nil:
+		return json.Marshal(v.Text)
+	case v.Number != nil:
+		return json.Marshal(v.Number)
+	case v.SingleSelectOption != nil:
+		return json.Marshal(v.SingleSelectOption)
+	case v.Iteration != nil:
+		return json.Marshal(v.Iteration)
+	case v.Values != nil:
+		return json.Marshal(v.Values)
+	}
+	return []byte("null"), nil
+}
+
+// DisplayValue returns the value as shown in the project: the name of a single
+// select option, the title of an iteration, the text or number itself, or
+// the display values of several values separated by ", ". It returns an
+// empty string for an empty value.
+func (v *ProjectV2ItemFieldValue) DisplayValue() string {
+	switch {
+	case v == nil:
+		return ""
+	case v.Text != nil:
+		return *v.Text
+	case v.Number != nil:
+		return strconv.FormatFloat(*v.Number, 'f', -1, 64)
+	case v.SingleSelectOption != nil:
+		return v.SingleSelectOption.GetName()
+	case v.Iteration != nil:
+		return v.Iteration.GetTitle()
+	case v.Values != nil:
+		return strings.Join(v.displayValues(), ", ")
+	}
+	return ""
+}
+
+func (v *ProjectV2ItemFieldValue) displayValues() []string {
+	values := make([]string, len(v.Values))
+	for i, value := range v.Values {
+		values[i] = value.DisplayValue()
+	}
+	return values
+}
+
+// ProjectItemChange is a change of the value of a field of a project v2 item,
+// as recorded by ProjectItemChangeRecorder.
+type ProjectItemChange struct {
+	ItemNodeID    *string                  `json:"item_node_id,omitempty"`
+	ProjectNodeID *string                  `json:"project_node_id,omitempty"`
+	FieldNodeID   *string                  `json:"field_node_id,omitempty"`
+	FieldName     *string                  `json:"field_name,omitempty"`
+	FieldType     *string                  `json:"field_type,omitempty"`
+	From          *ProjectV2ItemFieldValue `json:"from,omitempty"`
+	To            *ProjectV2ItemFieldValue `json:"to,omitempty"`
+	Actor         *User                    `json:"actor,omitempty"`
+	ChangedAt     *Timestamp               `json:"changed_at,omitempty"`
+}
+
+// ProjectItemChangeRecorder builds the history of the field values of project
+// v2 items from projects_v2_item webhook events, since the REST API does not
+// expose that history. The zero value is ready to use, and it is safe for
+// concurrent use.
+type ProjectItemChangeRecorder struct {
+	mu      sync.Mutex
+	changes map[string][]*ProjectItemChange // by item node ID, in ChangedAt order
+}
+
+// Record adds the field value change carried by event, if any, to the
+// history of its item. It reports whether event was recorded: only "edited"
+// events changing a field value are.
+func (r *ProjectItemChangeRecorder) Record(event *ProjectV2ItemEvent) bool {
+	fv := event.GetChanges().GetFieldValue()
+	item := event.GetProjectV2Item()
+	if event.GetAction() != "edited" || fv == nil || item.GetNodeID() == "" {
+		return false
+	}
+
+	c := &ProjectItemChange{
+		ItemNodeID:    item.NodeID,
+		ProjectNodeID: item.ProjectNodeID,
+		FieldNodeID:   fv.FieldNodeID,
+		FieldName:     fv.FieldName,
+		FieldType:     fv.FieldType,
+		From:          fv.From,
+		To:            fv.To,
+		Actor:         event.Sender,
+		ChangedAt:     item.UpdatedAt,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.changes == nil {
+		r.changes = make(map[string][]*ProjectItemChange)
+	}
+	// Webhook deliveries are not ordered, so insert the change in place.
+	changes := r.changes[item.GetNodeID()]
+	i := sort.Search(len(changes), func(i int) bool {
+		return changes[i].GetChangedAt().After(c.GetChangedAt().Time)
+	})
+	changes = append(changes, nil)
+	copy(changes[i+1:], changes[i:])
+	changes[i] = c
+	r.changes[item.GetNodeID()] = changes
+	return true
+}
+
+// History returns the recorded changes of the item with the given node ID,
+// oldest first. If fieldName is not empty, only changes of that field are
+// returned.
+func (r *ProjectItemChangeRecorder) History(itemNodeID, fieldName string) []*ProjectItemChange {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var history []*ProjectItemChange
+	for _, c := range r.changes[itemNodeID] {
+		if fieldName == "" || c.GetFieldName() == fieldName {
+			history = append(history, c)
+		}
+	}
+	return history
+}
+
+// TransitionTime returns when the field with the given name of the item with
+// the given node ID was last changed to a value whose DisplayValue is value,
+// for example when its "Status" moved to "Done". It reports false if no such
+// change was recorded.
+func (r *ProjectItemChangeRecorder) TransitionTime(itemNodeID, fieldName, value string) (time.Time, bool) {
+	history := r.History(itemNodeID, fieldName)
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].To.DisplayValue() == value {
+			return history[i].GetChangedAt().Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// MarshalJSON implements the json.Marshaler interface. The recorded history
+// is exported as a JSON object mapping item node IDs to their changes,
+// oldest first.
+func (r *ProjectItemChangeRecorder) MarshalJSON() ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.changes == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(r.changes)
+}
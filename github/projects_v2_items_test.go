@@ -0,0 +1,361 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProjectV2ItemFieldValue_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		want *ProjectV2ItemFieldValue
+	}{
+		"single select": {
+			data: `{"id":"98236657","name":"Done","color":"PURPLE","description":"This has been completed"}`,
+			want: &ProjectV2ItemFieldValue{SingleSelectOption: &ProjectV2SingleSelectOption{
+				ID:          String("98236657"),
+				Name:        String("Done"),
+				Color:       String("PURPLE"),
+				Description: String("This has been completed"),
+			}},
+		},
+		"iteration": {
+			data: `{"id":"c1","title":"Iteration 1","start_date":"2024-01-01","duration":14}`,
+			want: &ProjectV2ItemFieldValue{Iteration: &ProjectV2Iteration{
+				ID:        String("c1"),
+				Title:     String("Iteration 1"),
+				StartDate: String("2024-01-01"),
+				Duration:  Int(14),
+			}},
+		},
+		"text":   {data: `"hello"`, want: &ProjectV2ItemFieldValue{Text: String("hello")}},
+		"number": {data: `2.5`, want: &ProjectV2ItemFieldValue{Number: Float64(2.5)}},
+		"null":   {data: `null`, want: &ProjectV2ItemFieldValue{}},
+		"several values": {
+			data: `[{"name":"bug"},"x"]`,
+			want: &ProjectV2ItemFieldValue{Values: []*ProjectV2ItemFieldValue{
+				{SingleSelectOption: &ProjectV2SingleSelectOption{Name: String("bug")}},
+				{Text: String("x")},
+			}},
+		},
+		"no values": {data: `[]`, want: &ProjectV2ItemFieldValue{Values: []*ProjectV2ItemFieldValue{}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := new(ProjectV2ItemFieldValue)
+			if err := json.Unmarshal([]byte(test.data), got); err != nil {
+				t.Fatalf("json.Unmarshal returned error: %v", err)
+			}
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("json.Unmarshal returned %+v, want %+v", got, test.want)
+			}
+
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("json.Marshal returned error: %v", err)
+			}
+			if string(b) != test.data {
+				t.Errorf("json.Marshal returned %s, want %s", b, test.data)
+			}
+		})
+	}
+}
+
+func TestProjectV2ItemFieldValue_UnmarshalJSON_restTexts(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		want *ProjectV2ItemFieldValue
+	}{
+		"single select": {
+			data: `{"id":"a1","name":{"raw":"Done","html":"Done"},"color":"PURPLE","description":{"raw":"*done*","html":"<em>done</em>"}}`,
+			want: &ProjectV2ItemFieldValue{SingleSelectOption: &ProjectV2SingleSelectOption{
+				ID:          String("a1"),
+				Name:        String("Done"),
+				Color:       String("PURPLE"),
+				Description: String("*done*"),
+			}},
+		},
+		"iteration": {
+			data: `{"id":"c1","title":{"raw":"Sprint 1","html":"Sprint 1"},"start_date":"2024-01-01","duration":14}`,
+			want: &ProjectV2ItemFieldValue{Iteration: &ProjectV2Iteration{
+				ID:        String("c1"),
+				Title:     String("Sprint 1"),
+				StartDate: String("2024-01-01"),
+				Duration:  Int(14),
+			}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := new(ProjectV2ItemFieldValue)
+			if err := json.Unmarshal([]byte(test.data), got); err != nil {
+				t.Fatalf("json.Unmarshal returned error: %v", err)
+			}
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("json.Unmarshal returned %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProjectV2ItemFieldValue_UnmarshalJSON_invalid(t *testing.T) {
+	for _, data := range []string{`true`, `[true]`, `{"name":1}`, `{"name":{"raw":1}}`} {
+		if err := json.Unmarshal([]byte(data), new(ProjectV2ItemFieldValue)); err == nil {
+			t.Errorf("json.Unmarshal(%s) returned nil error, want error", data)
+		}
+	}
+}
+
+func TestProjectV2ItemFieldValue_DisplayValue(t *testing.T) {
+	tests := []struct {
+		v    *ProjectV2ItemFieldValue
+		want string
+	}{
+		{v: nil, want: ""},
+		{v: &ProjectV2ItemFieldValue{}, want: ""},
+		{v: &ProjectV2ItemFieldValue{Text: String("2024-01-02")}, want: "2024-01-02"},
+		{v: &ProjectV2ItemFieldValue{Number: Float64(3)}, want: "3"},
+		{v: &ProjectV2ItemFieldValue{SingleSelectOption: &ProjectV2SingleSelectOption{Name: String("Done")}}, want: "Done"},
+		{v: &ProjectV2ItemFieldValue{Iteration: &ProjectV2Iteration{Title: String("Sprint 3")}}, want: "Sprint 3"},
+		{v: &ProjectV2ItemFieldValue{Values: []*ProjectV2ItemFieldValue{{Text: String("a")}, {Number: Float64(1)}}}, want: "a, 1"},
+	}
+
+	for _, test := range tests {
+		if got := test.v.DisplayValue(); got != test.want {
+			t.Errorf("DisplayValue() = %q, want %q", got, test.want)
+		}
+	}
+}
+
+// statusEvent returns a projects_v2_item "edited" webhook payload moving the
+// Status field of item from one option to another at the given time.
+func statusEvent(item, from, to, at string) string {
+	return fmt.Sprintf(`{
+		"action": "edited",
+		"changes": {
+			"field_value": {
+				"field_node_id": "PVTSSF_status",
+				"field_type": "single_select",
+				"field_name": "Status",
+				"project_number": 1,
+				"from": {"id": "%[2]v", "name": "%[2]v", "color": "GRAY"},
+				"to": {"id": "%[3]v", "name": "%[3]v", "color": "GREEN"}
+			}
+		},
+		"projects_v2_item": {
+			"id": 1,
+			"node_id": "%[1]v",
+			"project_node_id": "PVT_1",
+			"content_type": "Issue",
+			"updated_at": "%[4]v"
+		},
+		"sender": {"login": "octocat"}
+	}`, item, from, to, at)
+}
+
+func TestProjectItemChangeRecorder(t *testing.T) {
+	payloads := []string{
+		statusEvent("PVTI_a", "Todo", "In Progress", "2024-01-02T10:00:00Z"),
+		// Delivered out of order.
+		statusEvent("PVTI_a", "Done", "In Progress", "2024-01-04T10:00:00Z"),
+		statusEvent("PVTI_a", "In Progress", "Done", "2024-01-03T10:00:00Z"),
+		statusEvent("PVTI_b", "Todo", "Done", "2024-01-05T10:00:00Z"),
+		`{
+			"action": "edited",
+			"changes": {"field_value": {"field_node_id": "PVTF_estimate", "field_type": "number", "field_name": "Estimate", "from": null, "to": 5}},
+			"projects_v2_item": {"node_id": "PVTI_a", "updated_at": "2024-01-06T10:00:00Z"}
+		}`,
+		statusEvent("PVTI_a", "In Progress", "Done", "2024-01-07T10:00:00Z"),
+		// Not field value changes.
+		`{"action": "archived", "changes": {"archived_at": {"to": "2024-01-08T10:00:00Z"}}, "projects_v2_item": {"node_id": "PVTI_a"}}`,
+		`{"action": "created", "projects_v2_item": {"node_id": "PVTI_c"}}`,
+	}
+
+	var r ProjectItemChangeRecorder
+	recorded := 0
+	for _, p := range payloads {
+		event := new(ProjectV2ItemEvent)
+		if err := json.Unmarshal([]byte(p), event); err != nil {
+			t.Fatalf("json.Unmarshal returned error: %v", err)
+		}
+		if r.Record(event) {
+			recorded++
+		}
+	}
+	if recorded != 6 {
+		t.Errorf("Record recorded %v events, want 6", recorded)
+	}
+
+	history := r.History("PVTI_a", "Status")
+	var got []string
+	for _, c := range history {
+		got = append(got, c.From.DisplayValue()+" -> "+c.To.DisplayValue())
+	}
+	want := []string{"Todo -> In Progress", "In Progress -> Done", "Done -> In Progress", "In Progress -> Done"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("History(Status) = %v, want %v", got, want)
+	}
+	if got := history[0].GetActor().GetLogin(); got != "octocat" {
+		t.Errorf("History(Status)[0].Actor.Login = %q, want %q", got, "octocat")
+	}
+
+	if got := len(r.History("PVTI_a", "")); got != 5 {
+		t.Errorf("History(all) returned %v changes, want 5", got)
+	}
+	estimate := r.History("PVTI_a", "Estimate")
+	if len(estimate) != 1 || estimate[0].To.GetNumber() == nil || *estimate[0].To.GetNumber() != 5 || estimate[0].From != nil {
+		t.Errorf("History(Estimate) = %+v, want one change from nothing to 5", estimate)
+	}
+
+	doneAt, ok := r.TransitionTime("PVTI_a", "Status", "Done")
+	if wantAt := time.Date(2024, time.January, 7, 10, 0, 0, 0, time.UTC); !ok || !doneAt.Equal(wantAt) {
+		t.Errorf("TransitionTime(PVTI_a, Done) = %v, %v, want %v, true", doneAt, ok, wantAt)
+	}
+	doneAt, ok = r.TransitionTime("PVTI_b", "Status", "Done")
+	if wantAt := time.Date(2024, time.January, 5, 10, 0, 0, 0, time.UTC); !ok || !doneAt.Equal(wantAt) {
+		t.Errorf("TransitionTime(PVTI_b, Done) = %v, %v, want %v, true", doneAt, ok, wantAt)
+	}
+	if _, ok := r.TransitionTime("PVTI_b", "Status", "In Progress"); ok {
+		t.Error("TransitionTime(PVTI_b, In Progress) reported a transition, want none")
+	}
+	if _, ok := r.TransitionTime("PVTI_c", "Status", "Done"); ok {
+		t.Error("TransitionTime(PVTI_c, Done) reported a transition, want none")
+	}
+}
+
+func TestProjectItemChangeRecorder_MarshalJSON(t *testing.T) {
+	var r ProjectItemChangeRecorder
+	b, err := json.Marshal(&r)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if string(b) != "{}" {
+		t.Errorf("json.Marshal of an empty recorder returned %s, want {}", b)
+	}
+
+	event := new(ProjectV2ItemEvent)
+	assertNilError(t, json.Unmarshal([]byte(statusEvent("PVTI_a", "Todo", "Done", "2024-01-02T10:00:00Z")), event))
+	r.Record(event)
+
+	want := `{
+		"PVTI_a": [
+			{
+				"item_node_id": "PVTI_a",
+				"project_node_id": "PVT_1",
+				"field_node_id": "PVTSSF_status",
+				"field_name": "Status",
+				"field_type": "single_select",
+				"from": {"id": "Todo", "name": "Todo", "color": "GRAY"},
+				"to": {"id": "Done", "name": "Done", "color": "GREEN"},
+				"actor": {"login": "octocat"},
+				"changed_at": "2024-01-02T10:00:00Z"
+			}
+		]
+	}`
+	got, err := json.Marshal(&r)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	var gotV, wantV interface{}
+	assertNilError(t, json.Unmarshal(got, &gotV))
+	assertNilError(t, json.Unmarshal([]byte(want), &wantV))
+	if !cmp.Equal(gotV, wantV) {
+		t.Errorf("json.Marshal returned %s, want %s", got, want)
+	}
+}
+
+func TestProjectItemChangeRecorder_concurrent(t *testing.T) {
+	var r ProjectItemChangeRecorder
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			event := new(ProjectV2ItemEvent)
+			at := fmt.Sprintf("2024-01-%02dT10:00:00Z", i+1)
+			assertNilError(t, json.Unmarshal([]byte(statusEvent("PVTI_a", "Todo", "Done", at)), event))
+			r.Record(event)
+			r.History("PVTI_a", "Status")
+		}(i)
+	}
+	wg.Wait()
+
+	history := r.History("PVTI_a", "Status")
+	if len(history) != 10 {
+		t.Fatalf("History returned %v changes, want 10", len(history))
+	}
+	for i := 1; i < len(history); i++ {
+		if history[i].GetChangedAt().Before(history[i-1].GetChangedAt().Time) {
+			t.Errorf("History is not ordered by ChangedAt: %v before %v", history[i-1].GetChangedAt(), history[i].GetChangedAt())
+		}
+	}
+}
+
+func TestProjectsV2_state(t *testing.T) {
+	tests := map[string]struct {
+		project      *ProjectsV2
+		open, closed bool
+		state        string
+	}{
+		"nil":    {project: nil, open: false, closed: false, state: ""},
+		"open":   {project: &ProjectsV2{Title: String("t")}, open: true, closed: false, state: ProjectStateOpen},
+		"closed": {project: &ProjectsV2{ClosedAt: &Timestamp{referenceTime}}, open: false, closed: true, state: ProjectStateClosed},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.project.IsOpen(); got != test.open {
+				t.Errorf("IsOpen() = %v, want %v", got, test.open)
+			}
+			if got := test.project.IsClosed(); got != test.closed {
+				t.Errorf("IsClosed() = %v, want %v", got, test.closed)
+			}
+			if got := test.project.State(); got != test.state {
+				t.Errorf("State() = %q, want %q", got, test.state)
+			}
+		})
+	}
+}
+
+func TestProjectV2Item_IsArchived(t *testing.T) {
+	var nilItem *ProjectV2Item
+	if nilItem.IsArchived() {
+		t.Error("IsArchived() of nil item = true, want false")
+	}
+	if (&ProjectV2Item{}).IsArchived() {
+		t.Error("IsArchived() of unarchived item = true, want false")
+	}
+	if !(&ProjectV2Item{ArchivedAt: &Timestamp{referenceTime}}).IsArchived() {
+		t.Error("IsArchived() of archived item = false, want true")
+	}
+}
+
+func TestProjectV2Item_unknownContentType(t *testing.T) {
+	item := new(ProjectV2Item)
+	if err := json.Unmarshal([]byte(`{"content_type":"SomethingNew"}`), item); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if got := item.GetContentType(); got != "SomethingNew" {
+		t.Errorf("ContentType = %q, want %q", got, "SomethingNew")
+	}
+
+	for _, typ := range []string{ProjectItemTypeIssue, ProjectItemTypePullRequest, ProjectItemTypeDraftIssue} {
+		item := new(ProjectV2Item)
+		assertNilError(t, json.Unmarshal([]byte(`{"content_type":"`+typ+`"}`), item))
+		if got := item.GetContentType(); got != typ {
+			t.Errorf("ContentType = %q, want %q", got, typ)
+		}
+	}
+}
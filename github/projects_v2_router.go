@@ -0,0 +1,116 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ProjectV2EventTarget describes what a projects v2 webhook event is about,
+// as passed to the handlers of ProjectV2Router.
+type ProjectV2EventTarget struct {
+	// Event is the *ProjectV2Event, *ProjectV2ItemEvent or
+	// *ProjectV2StatusUpdateEvent.
+	Event interface{}
+	// InstallationID is 0 for events without an installation, such as
+	// those of user-owned projects.
+	InstallationID int64
+	Org            string
+	// ProjectNumber is 0 if the event does not include it, which is the case
+	// of status update events and of item events that do not change a
+	// field value. ProjectNodeID is always set.
+	ProjectNumber int
+	ProjectNodeID string
+	// ItemID is the ID of the item of a *ProjectV2ItemEvent, and 0 otherwise.
+	ItemID int64
+}
+
+// ProjectV2Handler handles a projects v2 webhook event with client, which is
+// authenticated as the installation that received it.
+type ProjectV2Handler func(ctx context.Context, client *Client, target *ProjectV2EventTarget) error
+
+// ProjectV2Router calls handlers for projects v2 webhook events with a client
+// authenticated as the installation of each event, so that GitHub Apps can
+// call back into the API right away. The clients are created once per
+// installation and reused. A ProjectV2Router is safe for concurrent use.
+type ProjectV2Router struct {
+	client   *Client
+	apps     *AppsService
+	fallback *Client
+
+	mu      sync.Mutex
+	clients map[int64]*Client
+}
+
+// NewProjectV2Router returns a router whose installation clients are copies
+// of client, which should not be authenticated, authenticated with
+// WithInstallationAuth and apps. Events without an installation are handled
+// with fallback, or return an error if it is nil.
+func NewProjectV2Router(client *Client, apps *AppsService, fallback *Client) *ProjectV2Router {
+	return &ProjectV2Router{
+		client:   client,
+		apps:     apps,
+		fallback: fallback,
+		clients:  make(map[int64]*Client),
+	}
+}
+
+// Handle calls fn for event, a *ProjectV2Event, *ProjectV2ItemEvent or
+// *ProjectV2StatusUpdateEvent as returned by ParseWebHook, with the client of
+// its installation. It returns the error returned by fn.
+func (r *ProjectV2Router) Handle(ctx context.Context, event interface{}, fn ProjectV2Handler) error {
+	target, err := newProjectV2EventTarget(event)
+	if err != nil {
+		return err
+	}
+
+	client := r.fallback
+	if target.InstallationID != 0 {
+		client = r.installationClient(target.InstallationID)
+	} else if client == nil {
+		return errors.New("projects v2 event has no installation and the router has no fallback client")
+	}
+	return fn(ctx, client, target)
+}
+
+func (r *ProjectV2Router) installationClient(id int64) *Client {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	client, ok := r.clients[id]
+	if !ok {
+		client = r.client.WithInstallationAuth(r.apps, id)
+		r.clients[id] = client
+	}
+	return client
+}
+
+func newProjectV2EventTarget(event interface{}) (*ProjectV2EventTarget, error) {
+	target := &ProjectV2EventTarget{Event: event}
+	switch e := event.(type) {
+	case *ProjectV2Event:
+		target.InstallationID = e.GetInstallation().GetID()
+		target.Org = e.GetOrg().GetLogin()
+		target.ProjectNumber = e.GetProjectsV2().GetNumber()
+		target.ProjectNodeID = e.GetProjectsV2().GetNodeID()
+	case *ProjectV2ItemEvent:
+		target.InstallationID = e.GetInstallation().GetID()
+		target.Org = e.GetOrg().GetLogin()
+		target.ProjectNumber = e.GetChanges().GetFieldValue().GetProjectNumber()
+		target.ProjectNodeID = e.GetProjectV2Item().GetProjectNodeID()
+		target.ItemID = e.GetProjectV2Item().GetID()
+	case *ProjectV2StatusUpdateEvent:
+		target.InstallationID = e.GetInstallation().GetID()
+		target.Org = e.GetOrg().GetLogin()
+		target.ProjectNodeID = e.GetProjectV2StatusUpdate().GetProjectNodeID()
+	default:
+		return nil, fmt.Errorf("unsupported projects v2 event type %T", event)
+	}
+	return target, nil
+}
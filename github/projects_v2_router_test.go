@@ -0,0 +1,178 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProjectV2Router_Handle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tokens := make(map[string]int)
+	for _, id := range []string{"1", "2"} {
+		id := id
+		mux.HandleFunc("/app/installations/"+id+"/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			tokens[id]++
+			fmt.Fprintf(w, `{"token":"t%v","expires_at":%q}`, id, time.Now().Add(time.Hour).Format(time.RFC3339))
+		})
+	}
+	var auth []string
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"login":"o"}`)
+	})
+
+	events := []interface{}{
+		&ProjectV2ItemEvent{
+			Action:        String("edited"),
+			Changes:       &ProjectV2ItemChange{FieldValue: &ProjectV2ItemFieldValueChange{ProjectNumber: Int(3)}},
+			ProjectV2Item: &ProjectV2Item{ID: Int64(10), ProjectNodeID: String("PVT_3")},
+			Installation:  &Installation{ID: Int64(1)},
+			Org:           &Organization{Login: String("o")},
+		},
+		&ProjectV2Event{
+			ProjectsV2:   &ProjectsV2{Number: Int(4), NodeID: String("PVT_4")},
+			Installation: &Installation{ID: Int64(2)},
+			Org:          &Organization{Login: String("o")},
+		},
+		&ProjectV2StatusUpdateEvent{
+			ProjectV2StatusUpdate: &ProjectV2StatusUpdate{ProjectNodeID: String("PVT_3")},
+			Installation:          &Installation{ID: Int64(1)},
+			Org:                   &Organization{Login: String("o")},
+		},
+	}
+
+	router := NewProjectV2Router(client, client.Apps, nil)
+	ctx := context.Background()
+	var clients []*Client
+	var targets []*ProjectV2EventTarget
+	for _, event := range events {
+		err := router.Handle(ctx, event, func(ctx context.Context, client *Client, target *ProjectV2EventTarget) error {
+			clients = append(clients, client)
+			targets = append(targets, target)
+			_, _, err := client.Organizations.Get(ctx, target.Org)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("ProjectV2Router.Handle returned error: %v", err)
+		}
+	}
+
+	wantTargets := []*ProjectV2EventTarget{
+		{Event: events[0], InstallationID: 1, Org: "o", ProjectNumber: 3, ProjectNodeID: "PVT_3", ItemID: 10},
+		{Event: events[1], InstallationID: 2, Org: "o", ProjectNumber: 4, ProjectNodeID: "PVT_4"},
+		{Event: events[2], InstallationID: 1, Org: "o", ProjectNodeID: "PVT_3"},
+	}
+	if !cmp.Equal(targets, wantTargets) {
+		t.Errorf("ProjectV2Router.Handle passed targets %+v, want %+v", targets, wantTargets)
+	}
+	if clients[0] != clients[2] {
+		t.Error("ProjectV2Router.Handle did not reuse the client of installation 1")
+	}
+	if clients[0] == clients[1] || clients[0] == client || clients[1] == client {
+		t.Error("ProjectV2Router.Handle did not pass a separate client per installation")
+	}
+	if want := map[string]int{"1": 1, "2": 1}; !cmp.Equal(tokens, want) {
+		t.Errorf("ProjectV2Router.Handle created tokens %v, want %v", tokens, want)
+	}
+	if want := []string{"Bearer t1", "Bearer t2", "Bearer t1"}; !cmp.Equal(auth, want) {
+		t.Errorf("ProjectV2Router.Handle clients sent Authorization %q, want %q", auth, want)
+	}
+}
+
+func TestProjectV2Router_Handle_noInstallation(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	event := &ProjectV2Event{ProjectsV2: &ProjectsV2{Number: Int(1)}}
+	ctx := context.Background()
+	fallback := NewClient(nil)
+	var got *Client
+	err := NewProjectV2Router(client, client.Apps, fallback).Handle(ctx, event, func(ctx context.Context, client *Client, target *ProjectV2EventTarget) error {
+		got = client
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ProjectV2Router.Handle returned error: %v", err)
+	}
+	if got != fallback {
+		t.Error("ProjectV2Router.Handle did not pass the fallback client")
+	}
+
+	called := false
+	handler := func(ctx context.Context, client *Client, target *ProjectV2EventTarget) error {
+		called = true
+		return nil
+	}
+	router := NewProjectV2Router(client, client.Apps, nil)
+	if err := router.Handle(ctx, event, handler); err == nil {
+		t.Error("ProjectV2Router.Handle returned no error without an installation or a fallback client")
+	}
+	if err := router.Handle(ctx, &PushEvent{}, handler); err == nil {
+		t.Error("ProjectV2Router.Handle returned no error for a push event")
+	}
+	if called {
+		t.Error("ProjectV2Router.Handle called the handler for an unhandled event")
+	}
+}
+
+func TestClient_WithInstallationAuth(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var created int
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		created++
+		// The first token is about to expire and must be renewed.
+		expiresAt := time.Now().Add(installationTokenExpiryMargin / 2)
+		if created > 1 {
+			expiresAt = time.Now().Add(time.Hour)
+		}
+		fmt.Fprintf(w, `{"token":"t%v","expires_at":%q}`, created, expiresAt.Format(time.RFC3339))
+	})
+	var auth []string
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{}`)
+	})
+
+	installation := client.WithInstallationAuth(client.Apps, 1)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if _, _, err := installation.Meta.Get(ctx); err != nil {
+			t.Fatalf("Meta.Get returned error: %v", err)
+		}
+	}
+	if want := []string{"Bearer t1", "Bearer t2", "Bearer t2"}; !cmp.Equal(auth, want) {
+		t.Errorf("WithInstallationAuth sent Authorization %q, want %q", auth, want)
+	}
+}
+
+func TestClient_WithInstallationAuth_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("WithInstallationAuth sent a request without a token")
+	})
+
+	installation := client.WithInstallationAuth(client.Apps, 1)
+	if _, _, err := installation.Meta.Get(context.Background()); err == nil {
+		t.Error("Meta.Get returned no error")
+	}
+}
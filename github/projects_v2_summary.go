@@ -0,0 +1,107 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+)
+
+// ProjectItemsUnset is the key of ProjectItemsSummary counts for the items
+// that have no value for the field.
+const ProjectItemsUnset = ""
+
+// ProjectItemsSummary counts the items of a project v2 by the value of a
+// field, as returned by ProjectsService.SummarizeItemsByField.
+type ProjectItemsSummary struct {
+	// Field is the summarized field.
+	Field *ProjectV2Field
+
+	// Counts is the number of unarchived items by display value of the
+	// field: the name of a single select option, the title of an iteration,
+	// the text, number or date itself. Items with several values, such as
+	// labels, are counted once per value. Items without a value are counted
+	// under ProjectItemsUnset.
+	Counts map[string]int
+
+	// Archived counts the archived items in the same way.
+	Archived map[string]int
+}
+
+// SummarizeItemsByField counts the items of the project v2 with the given
+// number in the specified organization by the value of the field named
+// fieldName, which is matched as by SetOrganizationProjectItemField. Only the
+// values of that field are listed, a page of 100 items at a time.
+// SummarizeItemsByField pauses whenever it is rate limited, until ctx is
+// done.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#list-project-fields-for-organization
+// GitHub API docs: https://docs.github.com/rest/projects/items#list-items-for-an-organization-owned-project
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/fields
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/items
+func (s *ProjectsService) SummarizeItemsByField(ctx context.Context, org string, projectNumber int, fieldName string) (*ProjectItemsSummary, error) {
+	fields, _, cached, err := s.projectFields(ctx, org, projectNumber, false)
+	if err != nil {
+		return nil, err
+	}
+	field, err := projectField(fields, fieldName)
+	var stale errProjectFieldStale
+	if errors.As(err, &stale) && cached {
+		if fields, _, _, err = s.projectFields(ctx, org, projectNumber, true); err != nil {
+			return nil, err
+		}
+		field, err = projectField(fields, fieldName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &ProjectItemsSummary{
+		Field:    field,
+		Counts:   map[string]int{},
+		Archived: map[string]int{},
+	}
+	opts := &ListProjectItemsOptions{
+		Fields:                        []int64{field.GetID()},
+		ListProjectsPaginationOptions: ListProjectsPaginationOptions{PerPage: 100},
+	}
+	err = s.eachProjectItemsPage(ctx, org, projectNumber, opts, func(items []*ProjectV2Item) error {
+		for _, item := range items {
+			counts := summary.Counts
+			if item.IsArchived() {
+				counts = summary.Archived
+			}
+			for _, v := range projectItemSummaryValues(projectItemValues(item)[field.GetID()]) {
+				counts[v]++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// projectItemSummaryValues returns the display values v is counted under by
+// SummarizeItemsByField.
+func projectItemSummaryValues(v *ProjectV2ItemFieldValue) []string {
+	var values []string
+	if v != nil && v.Values != nil {
+		for _, value := range v.displayValues() {
+			if value != "" {
+				values = append(values, value)
+			}
+		}
+	} else if value := v.DisplayValue(); value != "" {
+		values = append(values, value)
+	}
+	if len(values) == 0 {
+		return []string{ProjectItemsUnset}
+	}
+	return values
+}
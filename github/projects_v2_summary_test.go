@@ -0,0 +1,134 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProjectsService_SummarizeItemsByField(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"name":"Title","data_type":"title"},
+			{"id":2,"name":"Status","data_type":"single_select","options":[{"id":"a1","name":{"raw":"Todo","html":"Todo"}},{"id":"b2","name":{"raw":"Done","html":"Done"}}]}
+		]`)
+	})
+
+	requests := 0
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		switch r.FormValue("after") {
+		case "":
+			testFormValues(t, r, values{"fields": "2", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/projectsV2/1/items?per_page=100&after=c1>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"id":10,"fields":[{"id":2,"name":"Status","data_type":"single_select","value":{"id":"a1","name":{"raw":"Todo","html":"Todo"}}}]},
+				{"id":11,"fields":[]}
+			]`)
+		case "c1":
+			if requests == 2 {
+				w.Header().Set(headerRetryAfter, "0")
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit.","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`)
+				return
+			}
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/projectsV2/1/items?per_page=100&after=c2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"id":12,"fields":[{"id":2,"name":"Status","data_type":"single_select","value":{"id":"b2","name":{"raw":"Done","html":"Done"}}}]},
+				{"id":13,"fields":[{"id":2,"name":"Status","data_type":"single_select","value":{"id":"a1","name":{"raw":"Todo","html":"Todo"}}}]}
+			]`)
+		case "c2":
+			fmt.Fprint(w, `[
+				{"id":14,"archived_at":`+referenceTimeStr+`,"fields":[{"id":2,"name":"Status","data_type":"single_select","value":{"id":"b2","name":{"raw":"Done","html":"Done"}}}]},
+				{"id":15,"archived_at":`+referenceTimeStr+`,"fields":[{"id":2,"name":"Status","data_type":"single_select","value":null}]}
+			]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.FormValue("after"))
+		}
+	})
+
+	ctx := context.Background()
+	summary, err := client.Projects.SummarizeItemsByField(ctx, "o", 1, "status")
+	if err != nil {
+		t.Fatalf("Projects.SummarizeItemsByField returned error: %v", err)
+	}
+
+	if got, want := summary.Field.GetID(), int64(2); got != want {
+		t.Errorf("Projects.SummarizeItemsByField returned field %v, want %v", got, want)
+	}
+	if want := map[string]int{"Todo": 2, "Done": 1, ProjectItemsUnset: 1}; !cmp.Equal(summary.Counts, want) {
+		t.Errorf("Projects.SummarizeItemsByField returned counts %v, want %v", summary.Counts, want)
+	}
+	if want := map[string]int{"Done": 1, ProjectItemsUnset: 1}; !cmp.Equal(summary.Archived, want) {
+		t.Errorf("Projects.SummarizeItemsByField returned archived counts %v, want %v", summary.Archived, want)
+	}
+	if requests != 4 {
+		t.Errorf("Projects.SummarizeItemsByField made %v item requests, want 4", requests)
+	}
+}
+
+func TestProjectsService_SummarizeItemsByField_values(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":3,"name":"Labels","data_type":"labels"}]`)
+	})
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"fields": "3", "per_page": "100"})
+		fmt.Fprint(w, `[
+			{"id":10,"fields":[{"id":3,"name":"Labels","data_type":"labels","value":[{"name":"bug"},{"name":"ci"}]}]},
+			{"id":11,"fields":[{"id":3,"name":"Labels","data_type":"labels","value":[{"name":"bug"}]}]},
+			{"id":12,"fields":[{"id":3,"name":"Labels","data_type":"labels","value":[]}]}
+		]`)
+	})
+
+	ctx := context.Background()
+	summary, err := client.Projects.SummarizeItemsByField(ctx, "o", 1, "Labels")
+	if err != nil {
+		t.Fatalf("Projects.SummarizeItemsByField returned error: %v", err)
+	}
+	if want := map[string]int{"bug": 2, "ci": 1, ProjectItemsUnset: 1}; !cmp.Equal(summary.Counts, want) {
+		t.Errorf("Projects.SummarizeItemsByField returned counts %v, want %v", summary.Counts, want)
+	}
+}
+
+func TestProjectsService_SummarizeItemsByField_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":2,"name":"Status","data_type":"single_select"}]`)
+	})
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"API rate limit exceeded for xxx.xxx.xxx.xxx.","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api"}`)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Projects.SummarizeItemsByField(ctx, "o", 1, "Priority"); err == nil {
+		t.Error("Projects.SummarizeItemsByField returned no error for an unknown field")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.Projects.SummarizeItemsByField(ctx, "o", 1, "Status"); err != context.DeadlineExceeded {
+		t.Errorf("Projects.SummarizeItemsByField returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProjectsService_ListOrganizationProjects(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/projectsV2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "is:open", "after": "c", "per_page": "2"})
+		fmt.Fprint(w, `[{"id":1,"number":2,"title":"t"}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListProjectsOptions{Query: "is:open", ListProjectsPaginationOptions: ListProjectsPaginationOptions{After: "c", PerPage: 2}}
+	projects, _, err := client.Projects.ListOrganizationProjects(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Projects.ListOrganizationProjects returned error: %v", err)
+	}
+
+	want := []*ProjectsV2{{ID: Int64(1), Number: Int(2), Title: String("t")}}
+	if !cmp.Equal(projects, want) {
+		t.Errorf("Projects.ListOrganizationProjects returned %+v, want %+v", projects, want)
+	}
+
+	const methodName = "ListOrganizationProjects"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListOrganizationProjects(ctx, "..", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListOrganizationProjects(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
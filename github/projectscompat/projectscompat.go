@@ -0,0 +1,158 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package projectscompat helps code written against the classic Projects API,
+// which GitHub has removed, move to projects v2. It translates the most
+// common classic calls into their projects v2 equivalents:
+//
+//	compat := projectscompat.New(client)
+//	projects, _, err := compat.ListOrgProjects(ctx, "o", &github.ProjectListOptions{State: "open"})
+//
+// The translated calls return projects v2 types, and each of them documents
+// how its behavior differs from the classic call. Classic concepts without a
+// projects v2 equivalent, such as columns, return an error wrapping
+// errors.ErrUnsupported. The package is a transition aid: new code should
+// call the projects v2 methods of github.ProjectsService directly.
+package projectscompat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v61/github"
+)
+
+// Projects translates classic Projects calls into projects v2 calls made with
+// a github.Client.
+type Projects struct {
+	client *github.Client
+}
+
+// New returns a Projects making its requests with client.
+func New(client *github.Client) *Projects {
+	return &Projects{client: client}
+}
+
+// unsupported returns an error wrapping errors.ErrUnsupported for the classic
+// concept what, with a hint at its projects v2 replacement.
+func unsupported(what, hint string) error {
+	return fmt.Errorf("projectscompat: %v not supported by projects v2, %v: %w", what, hint, errors.ErrUnsupported)
+}
+
+// listOptions translates the options of a classic project listing.
+func listOptions(opts *github.ProjectListOptions) (*github.ListProjectsOptions, error) {
+	v2 := &github.ListProjectsOptions{}
+	if opts == nil {
+		v2.Query = "is:open"
+		return v2, nil
+	}
+
+	switch opts.State {
+	case "", "open":
+		v2.Query = "is:open"
+	case "closed":
+		v2.Query = "is:closed"
+	case "all":
+	default:
+		return nil, fmt.Errorf("projectscompat: unknown project state %q", opts.State)
+	}
+	if opts.Page > 1 {
+		return nil, unsupported("page numbers are", "page with ProjectsService.ListOrganizationProjects and the After cursor of the Response")
+	}
+	v2.PerPage = opts.PerPage
+	return v2, nil
+}
+
+// ListOrgProjects lists the projects of an organization, as
+// OrganizationsService.ListProjects did, using
+// ProjectsService.ListOrganizationProjects.
+//
+// The State of opts is translated into an "is:open" or "is:closed" query, and
+// PerPage is kept. Projects v2 are paginated with cursors rather than page
+// numbers, so only the first page can be listed: a Page greater than 1
+// returns an error wrapping errors.ErrUnsupported.
+//
+// Deprecated: Use ProjectsService.ListOrganizationProjects.
+func (p *Projects) ListOrgProjects(ctx context.Context, org string, opts *github.ProjectListOptions) ([]*github.ProjectsV2, *github.Response, error) {
+	v2, err := listOptions(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.client.Projects.ListOrganizationProjects(ctx, org, v2)
+}
+
+// ListRepoProjects lists the projects of a repository, as
+// RepositoriesService.ListProjects did, with the options of ListOrgProjects.
+//
+// Projects v2 belong to an organization, not to a repository, and the REST
+// API does not tell which projects a repository is linked to, so
+// ListRepoProjects lists all the projects of the organization that owns the
+// repository. Repositories owned by users are not supported.
+//
+// Deprecated: Use ProjectsService.ListOrganizationProjects.
+func (p *Projects) ListRepoProjects(ctx context.Context, owner, repo string, opts *github.ProjectListOptions) ([]*github.ProjectsV2, *github.Response, error) {
+	return p.ListOrgProjects(ctx, owner, opts)
+}
+
+// CreateProjectCard adds an issue or pull request to the project v2 with the
+// given number in the specified organization, as
+// ProjectsService.CreateProjectCard did for a column, using
+// ProjectsService.AddOrganizationProjectItem.
+//
+// Projects v2 have no columns: the item is added without a status, which can
+// then be set with ProjectsService.SetOrganizationProjectItemField. The
+// ContentID and ContentType of opts are used, ContentType defaulting to
+// "Issue", and so is its Metadata. Cards with a Note, which are draft issues
+// in projects v2, and archived cards return an error wrapping
+// errors.ErrUnsupported.
+//
+// Deprecated: Use ProjectsService.AddOrganizationProjectItem.
+func (p *Projects) CreateProjectCard(ctx context.Context, org string, projectNumber int, opts *github.ProjectCardOptions) (*github.ProjectV2Item, *github.Response, error) {
+	if opts == nil || opts.ContentID == 0 {
+		if opts != nil && opts.Note != "" {
+			return nil, nil, unsupported("note cards are", "create a draft issue in the project instead")
+		}
+		return nil, nil, errors.New("projectscompat: CreateProjectCard requires the ContentID of an issue or pull request")
+	}
+	if opts.Archived != nil && *opts.Archived {
+		return nil, nil, unsupported("archived cards are", "archive the item once it is added")
+	}
+
+	typ := opts.ContentType
+	switch typ {
+	case "":
+		typ = github.ProjectItemTypeIssue
+	case github.ProjectItemTypeIssue, github.ProjectItemTypePullRequest:
+	default:
+		return nil, nil, fmt.Errorf("projectscompat: unknown card content type %q", typ)
+	}
+
+	return p.client.Projects.AddOrganizationProjectItem(ctx, org, projectNumber, &github.ProjectV2ItemAddOptions{
+		Type:     typ,
+		ID:       opts.ContentID,
+		Metadata: opts.Metadata,
+	})
+}
+
+// ListProjectColumns returns an error wrapping errors.ErrUnsupported:
+// projects v2 have no columns. The columns of a board view are the options
+// of a single select field, usually Status, which are listed by
+// ProjectsService.ListOrganizationProjectFields.
+//
+// Deprecated: Use ProjectsService.ListOrganizationProjectFields.
+func (p *Projects) ListProjectColumns(ctx context.Context, projectID int64, opts *github.ListOptions) ([]*github.ProjectColumn, *github.Response, error) {
+	return nil, nil, unsupported("columns are", "list the options of the Status field with ProjectsService.ListOrganizationProjectFields")
+}
+
+// MoveProjectCard returns an error wrapping errors.ErrUnsupported: projects
+// v2 have no columns to move cards between. An item moves to another column
+// of a board view when the field the view is grouped by, usually Status, is
+// set with ProjectsService.SetOrganizationProjectItemField.
+//
+// Deprecated: Use ProjectsService.SetOrganizationProjectItemField.
+func (p *Projects) MoveProjectCard(ctx context.Context, cardID int64, opts *github.ProjectCardMoveOptions) (*github.Response, error) {
+	return nil, unsupported("columns are", "set the Status field with ProjectsService.SetOrganizationProjectItemField")
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package projectscompat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+)
+
+// setup returns a Projects sending its requests to a test server serving mux.
+func setup(t *testing.T) (*Projects, *http.ServeMux) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := github.NewClient(nil)
+	u, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.BaseURL = u
+	return New(client), mux
+}
+
+func TestProjects_ListOrgProjects(t *testing.T) {
+	compat, mux := setup(t)
+
+	var queries []url.Values
+	mux.HandleFunc("/orgs/o/projectsV2", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Request method = %v, want GET", r.Method)
+		}
+		queries = append(queries, r.URL.Query())
+		fmt.Fprint(w, `[{"id":1,"number":2}]`)
+	})
+
+	ctx := context.Background()
+	for _, opts := range []*github.ProjectListOptions{
+		nil,
+		{State: "closed", ListOptions: github.ListOptions{PerPage: 10}},
+		{State: "all"},
+	} {
+		projects, _, err := compat.ListOrgProjects(ctx, "o", opts)
+		if err != nil {
+			t.Fatalf("ListOrgProjects returned error: %v", err)
+		}
+		if want := []*github.ProjectsV2{{ID: github.Int64(1), Number: github.Int(2)}}; !cmp.Equal(projects, want) {
+			t.Errorf("ListOrgProjects returned %+v, want %+v", projects, want)
+		}
+	}
+
+	want := []url.Values{
+		{"q": {"is:open"}},
+		{"q": {"is:closed"}, "per_page": {"10"}},
+		{},
+	}
+	if !cmp.Equal(queries, want) {
+		t.Errorf("ListOrgProjects sent queries %v, want %v", queries, want)
+	}
+}
+
+func TestProjects_ListRepoProjects(t *testing.T) {
+	compat, mux := setup(t)
+
+	mux.HandleFunc("/orgs/o/projectsV2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	projects, _, err := compat.ListRepoProjects(ctx, "o", "r", nil)
+	if err != nil {
+		t.Fatalf("ListRepoProjects returned error: %v", err)
+	}
+	if want := []*github.ProjectsV2{{ID: github.Int64(1)}}; !cmp.Equal(projects, want) {
+		t.Errorf("ListRepoProjects returned %+v, want %+v", projects, want)
+	}
+}
+
+func TestProjects_ListOrgProjects_errors(t *testing.T) {
+	compat, _ := setup(t)
+
+	ctx := context.Background()
+	opts := &github.ProjectListOptions{ListOptions: github.ListOptions{Page: 2}}
+	if _, _, err := compat.ListOrgProjects(ctx, "o", opts); !errors.Is(err, errors.ErrUnsupported) {
+		t.Errorf("ListOrgProjects with Page returned error %v, want errors.ErrUnsupported", err)
+	}
+	opts = &github.ProjectListOptions{State: "archived"}
+	if _, _, err := compat.ListOrgProjects(ctx, "o", opts); err == nil {
+		t.Error("ListOrgProjects with an unknown state returned no error")
+	}
+}
+
+func TestProjects_CreateProjectCard(t *testing.T) {
+	compat, mux := setup(t)
+
+	mux.HandleFunc("/orgs/o/projectsV2/1/items", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Request method = %v, want POST", r.Method)
+		}
+		if got, want := r.Header.Get("X-Audit-Ticket"), "OPS-1"; got != want {
+			t.Errorf("X-Audit-Ticket = %q, want %q", got, want)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if want := map[string]interface{}{"type": "Issue", "id": float64(5)}; !cmp.Equal(body, want) {
+			t.Errorf("Request body = %v, want %v", body, want)
+		}
+		fmt.Fprint(w, `{"id":10,"content_type":"Issue"}`)
+	})
+
+	ctx := context.Background()
+	opts := &github.ProjectCardOptions{ContentID: 5, Metadata: github.RequestMetadata{"Ticket": "OPS-1"}}
+	item, _, err := compat.CreateProjectCard(ctx, "o", 1, opts)
+	if err != nil {
+		t.Fatalf("CreateProjectCard returned error: %v", err)
+	}
+	if want := (&github.ProjectV2Item{ID: github.Int64(10), ContentType: github.String("Issue")}); !cmp.Equal(item, want) {
+		t.Errorf("CreateProjectCard returned %+v, want %+v", item, want)
+	}
+}
+
+func TestProjects_CreateProjectCard_errors(t *testing.T) {
+	compat, _ := setup(t)
+
+	ctx := context.Background()
+	for _, opts := range []*github.ProjectCardOptions{
+		{Note: "n"},
+		{ContentID: 5, Archived: github.Bool(true)},
+	} {
+		if _, _, err := compat.CreateProjectCard(ctx, "o", 1, opts); !errors.Is(err, errors.ErrUnsupported) {
+			t.Errorf("CreateProjectCard(%+v) returned error %v, want errors.ErrUnsupported", opts, err)
+		}
+	}
+	for _, opts := range []*github.ProjectCardOptions{
+		nil,
+		{ContentID: 5, ContentType: "Note"},
+	} {
+		if _, _, err := compat.CreateProjectCard(ctx, "o", 1, opts); err == nil || errors.Is(err, errors.ErrUnsupported) {
+			t.Errorf("CreateProjectCard(%+v) returned error %v, want an invalid options error", opts, err)
+		}
+	}
+}
+
+func TestProjects_columns(t *testing.T) {
+	compat, _ := setup(t)
+
+	ctx := context.Background()
+	if _, _, err := compat.ListProjectColumns(ctx, 1, nil); !errors.Is(err, errors.ErrUnsupported) {
+		t.Errorf("ListProjectColumns returned error %v, want errors.ErrUnsupported", err)
+	}
+	if _, err := compat.MoveProjectCard(ctx, 1, nil); !errors.Is(err, errors.ErrUnsupported) {
+		t.Errorf("MoveProjectCard returned error %v, want errors.ErrUnsupported", err)
+	}
+}
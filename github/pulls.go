@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 )
 
 // PullRequestsService handles communication with the pull request related
@@ -146,7 +147,7 @@ type PullRequestListOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls
 func (s *PullRequestsService) List(ctx context.Context, owner string, repo string, opts *PullRequestListOptions) ([]*PullRequest, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pulls", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -175,7 +176,7 @@ func (s *PullRequestsService) List(ctx context.Context, owner string, repo strin
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{commit_sha}/pulls
 func (s *PullRequestsService) ListPullRequestsWithCommit(ctx context.Context, owner, repo, sha string, opts *ListOptions) ([]*PullRequest, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v/pulls", owner, repo, sha)
+	u := fmt.Sprintf("repos/%v/%v/commits/%v/pulls", PathEscape(owner), PathEscape(repo), sha)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -203,7 +204,7 @@ func (s *PullRequestsService) ListPullRequestsWithCommit(ctx context.Context, ow
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}
 func (s *PullRequestsService) Get(ctx context.Context, owner string, repo string, number int) (*PullRequest, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -218,29 +219,16 @@ func (s *PullRequestsService) Get(ctx context.Context, owner string, repo string
 	return pull, resp, nil
 }
 
-// GetRaw gets a single pull request in raw (diff or patch) format.
+// GetRaw gets a single pull request in raw (diff or patch) format. If the
+// diff is too large for GitHub to generate, the error returned wraps
+// ErrDiffTooLarge.
 //
 // GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}
 func (s *PullRequestsService) GetRaw(ctx context.Context, owner string, repo string, number int, opts RawOptions) (string, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d", owner, repo, number)
-	req, err := s.client.NewRequest("GET", u, nil)
-	if err != nil {
-		return "", nil, err
-	}
-
-	switch opts.Type {
-	case Diff:
-		req.Header.Set("Accept", mediaTypeV3Diff)
-	case Patch:
-		req.Header.Set("Accept", mediaTypeV3Patch)
-	default:
-		return "", nil, fmt.Errorf("unsupported raw type %d", opts.Type)
-	}
-
 	var buf bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &buf)
+	resp, err := s.GetRawTo(ctx, owner, repo, number, opts, &buf)
 	if err != nil {
 		return "", resp, err
 	}
@@ -248,6 +236,35 @@ func (s *PullRequestsService) GetRaw(ctx context.Context, owner string, repo str
 	return buf.String(), resp, nil
 }
 
+// GetRawTo is like GetRaw, but streams the diff or patch to w instead of
+// returning it, which avoids holding large diffs in memory.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}
+func (s *PullRequestsService) GetRawTo(ctx context.Context, owner string, repo string, number int, opts RawOptions, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d", PathEscape(owner), PathEscape(repo), number)
+	return s.client.getRaw(ctx, u, opts, w)
+}
+
+// GetDiff gets a single pull request in diff format.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}
+func (s *PullRequestsService) GetDiff(ctx context.Context, owner string, repo string, number int) (string, *Response, error) {
+	return s.GetRaw(ctx, owner, repo, number, RawOptions{Type: Diff})
+}
+
+// GetPatch gets a single pull request in patch format.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}
+func (s *PullRequestsService) GetPatch(ctx context.Context, owner string, repo string, number int) (string, *Response, error) {
+	return s.GetRaw(ctx, owner, repo, number, RawOptions{Type: Patch})
+}
+
 // NewPullRequest represents a new pull request to be created.
 type NewPullRequest struct {
 	Title               *string `json:"title,omitempty"`
@@ -266,7 +283,7 @@ type NewPullRequest struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/pulls
 func (s *PullRequestsService) Create(ctx context.Context, owner string, repo string, pull *NewPullRequest) (*PullRequest, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pulls", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, pull)
 	if err != nil {
 		return nil, nil, err
@@ -307,7 +324,7 @@ type PullRequestBranchUpdateResponse struct {
 //
 //meta:operation PUT /repos/{owner}/{repo}/pulls/{pull_number}/update-branch
 func (s *PullRequestsService) UpdateBranch(ctx context.Context, owner, repo string, number int, opts *PullRequestBranchUpdateOptions) (*PullRequestBranchUpdateResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/update-branch", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/update-branch", PathEscape(owner), PathEscape(repo), number)
 
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
@@ -348,7 +365,7 @@ func (s *PullRequestsService) Edit(ctx context.Context, owner string, repo strin
 		return nil, nil, fmt.Errorf("pull must be provided")
 	}
 
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d", PathEscape(owner), PathEscape(repo), number)
 
 	update := &pullRequestUpdate{
 		Title:               pull.Title,
@@ -383,7 +400,7 @@ func (s *PullRequestsService) Edit(ctx context.Context, owner string, repo strin
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/commits
 func (s *PullRequestsService) ListCommits(ctx context.Context, owner string, repo string, number int, opts *ListOptions) ([]*RepositoryCommit, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/commits", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/commits", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -409,7 +426,7 @@ func (s *PullRequestsService) ListCommits(ctx context.Context, owner string, rep
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/files
 func (s *PullRequestsService) ListFiles(ctx context.Context, owner string, repo string, number int, opts *ListOptions) ([]*CommitFile, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/files", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/files", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -435,7 +452,7 @@ func (s *PullRequestsService) ListFiles(ctx context.Context, owner string, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/merge
 func (s *PullRequestsService) IsMerged(ctx context.Context, owner string, repo string, number int) (bool, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/merge", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/merge", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return false, nil, err
@@ -479,7 +496,7 @@ type pullRequestMergeRequest struct {
 //
 //meta:operation PUT /repos/{owner}/{repo}/pulls/{pull_number}/merge
 func (s *PullRequestsService) Merge(ctx context.Context, owner string, repo string, number int, commitMessage string, options *PullRequestOptions) (*PullRequestMergeResult, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/merge", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/merge", PathEscape(owner), PathEscape(repo), number)
 
 	pullRequestBody := &pullRequestMergeRequest{}
 	if commitMessage != "" {
@@ -76,9 +76,9 @@ type PullRequestListCommentsOptions struct {
 func (s *PullRequestsService) ListComments(ctx context.Context, owner, repo string, number int, opts *PullRequestListCommentsOptions) ([]*PullRequestComment, *Response, error) {
 	var u string
 	if number == 0 {
-		u = fmt.Sprintf("repos/%v/%v/pulls/comments", owner, repo)
+		u = fmt.Sprintf("repos/%v/%v/pulls/comments", PathEscape(owner), PathEscape(repo))
 	} else {
-		u = fmt.Sprintf("repos/%v/%v/pulls/%d/comments", owner, repo, number)
+		u = fmt.Sprintf("repos/%v/%v/pulls/%d/comments", PathEscape(owner), PathEscape(repo), number)
 	}
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -109,7 +109,7 @@ func (s *PullRequestsService) ListComments(ctx context.Context, owner, repo stri
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/comments/{comment_id}
 func (s *PullRequestsService) GetComment(ctx context.Context, owner, repo string, commentID int64) (*PullRequestComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%d", owner, repo, commentID)
+	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%d", PathEscape(owner), PathEscape(repo), commentID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -134,7 +134,7 @@ func (s *PullRequestsService) GetComment(ctx context.Context, owner, repo string
 //
 //meta:operation POST /repos/{owner}/{repo}/pulls/{pull_number}/comments
 func (s *PullRequestsService) CreateComment(ctx context.Context, owner, repo string, number int, comment *PullRequestComment) (*PullRequestComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/comments", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/comments", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("POST", u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -165,7 +165,7 @@ func (s *PullRequestsService) CreateCommentInReplyTo(ctx context.Context, owner,
 		Body:      body,
 		InReplyTo: commentID,
 	}
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/comments", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/comments", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("POST", u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -187,7 +187,7 @@ func (s *PullRequestsService) CreateCommentInReplyTo(ctx context.Context, owner,
 //
 //meta:operation PATCH /repos/{owner}/{repo}/pulls/comments/{comment_id}
 func (s *PullRequestsService) EditComment(ctx context.Context, owner, repo string, commentID int64, comment *PullRequestComment) (*PullRequestComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%d", owner, repo, commentID)
+	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%d", PathEscape(owner), PathEscape(repo), commentID)
 	req, err := s.client.NewRequest("PATCH", u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -208,7 +208,7 @@ func (s *PullRequestsService) EditComment(ctx context.Context, owner, repo strin
 //
 //meta:operation DELETE /repos/{owner}/{repo}/pulls/comments/{comment_id}
 func (s *PullRequestsService) DeleteComment(ctx context.Context, owner, repo string, commentID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%d", owner, repo, commentID)
+	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%d", PathEscape(owner), PathEscape(repo), commentID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -212,6 +212,8 @@ func TestPullRequestsService_ListComments_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.ListComments(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.ListComments(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_GetComment(t *testing.T) {
@@ -258,6 +260,8 @@ func TestPullRequestsService_GetComment_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.GetComment(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.GetComment(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_CreateComment(t *testing.T) {
@@ -314,6 +318,8 @@ func TestPullRequestsService_CreateComment_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.CreateComment(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.CreateComment(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_CreateCommentInReplyTo(t *testing.T) {
@@ -411,6 +417,8 @@ func TestPullRequestsService_EditComment_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.EditComment(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.EditComment(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_DeleteComment(t *testing.T) {
@@ -445,4 +453,6 @@ func TestPullRequestsService_DeleteComment_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.PullRequests.DeleteComment(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, err = client.PullRequests.DeleteComment(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
@@ -29,7 +29,7 @@ type Reviewers struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers
 func (s *PullRequestsService) RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers ReviewersRequest) (*PullRequest, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number)
+	u := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("POST", u, &reviewers)
 	if err != nil {
 		return nil, nil, err
@@ -50,7 +50,7 @@ func (s *PullRequestsService) RequestReviewers(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers
 func (s *PullRequestsService) ListReviewers(ctx context.Context, owner, repo string, number int, opts *ListOptions) (*Reviewers, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/requested_reviewers", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/requested_reviewers", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -76,7 +76,7 @@ func (s *PullRequestsService) ListReviewers(ctx context.Context, owner, repo str
 //
 //meta:operation DELETE /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers
 func (s *PullRequestsService) RemoveReviewers(ctx context.Context, owner, repo string, number int, reviewers ReviewersRequest) (*Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number)
+	u := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", PathEscape(owner), PathEscape(repo), number)
 	req, err := s.client.NewRequest("DELETE", u, &reviewers)
 	if err != nil {
 		return nil, err
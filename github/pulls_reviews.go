@@ -104,7 +104,7 @@ func (r PullRequestReviewDismissalRequest) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews
 func (s *PullRequestsService) ListReviews(ctx context.Context, owner, repo string, number int, opts *ListOptions) ([]*PullRequestReview, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -130,7 +130,7 @@ func (s *PullRequestsService) ListReviews(ctx context.Context, owner, repo strin
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}
 func (s *PullRequestsService) GetReview(ctx context.Context, owner, repo string, number int, reviewID int64) (*PullRequestReview, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d", owner, repo, number, reviewID)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d", PathEscape(owner), PathEscape(repo), number, reviewID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -152,7 +152,7 @@ func (s *PullRequestsService) GetReview(ctx context.Context, owner, repo string,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}
 func (s *PullRequestsService) DeletePendingReview(ctx context.Context, owner, repo string, number int, reviewID int64) (*PullRequestReview, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d", owner, repo, number, reviewID)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d", PathEscape(owner), PathEscape(repo), number, reviewID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -174,7 +174,7 @@ func (s *PullRequestsService) DeletePendingReview(ctx context.Context, owner, re
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/comments
 func (s *PullRequestsService) ListReviewComments(ctx context.Context, owner, repo string, number int, reviewID int64, opts *ListOptions) ([]*PullRequestComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d/comments", owner, repo, number, reviewID)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d/comments", PathEscape(owner), PathEscape(repo), number, reviewID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -234,7 +234,7 @@ func (s *PullRequestsService) ListReviewComments(ctx context.Context, owner, rep
 //
 //meta:operation POST /repos/{owner}/{repo}/pulls/{pull_number}/reviews
 func (s *PullRequestsService) CreateReview(ctx context.Context, owner, repo string, number int, review *PullRequestReviewRequest) (*PullRequestReview, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews", PathEscape(owner), PathEscape(repo), number)
 
 	req, err := s.client.NewRequest("POST", u, review)
 	if err != nil {
@@ -268,7 +268,7 @@ func (s *PullRequestsService) UpdateReview(ctx context.Context, owner, repo stri
 	opts := &struct {
 		Body string `json:"body"`
 	}{Body: body}
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d", owner, repo, number, reviewID)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d", PathEscape(owner), PathEscape(repo), number, reviewID)
 
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
@@ -290,7 +290,7 @@ func (s *PullRequestsService) UpdateReview(ctx context.Context, owner, repo stri
 //
 //meta:operation POST /repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/events
 func (s *PullRequestsService) SubmitReview(ctx context.Context, owner, repo string, number int, reviewID int64, review *PullRequestReviewRequest) (*PullRequestReview, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d/events", owner, repo, number, reviewID)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d/events", PathEscape(owner), PathEscape(repo), number, reviewID)
 
 	req, err := s.client.NewRequest("POST", u, review)
 	if err != nil {
@@ -312,7 +312,7 @@ func (s *PullRequestsService) SubmitReview(ctx context.Context, owner, repo stri
 //
 //meta:operation PUT /repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/dismissals
 func (s *PullRequestsService) DismissReview(ctx context.Context, owner, repo string, number int, reviewID int64, review *PullRequestReviewDismissalRequest) (*PullRequestReview, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d/dismissals", owner, repo, number, reviewID)
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/reviews/%d/dismissals", PathEscape(owner), PathEscape(repo), number, reviewID)
 
 	req, err := s.client.NewRequest("PUT", u, review)
 	if err != nil {
@@ -64,6 +64,8 @@ func TestPullRequestsService_ListReviews_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.ListReviews(ctx, "%", "r", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.ListReviews(ctx, "..", "r", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_GetReview(t *testing.T) {
@@ -108,6 +110,8 @@ func TestPullRequestsService_GetReview_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.GetReview(ctx, "%", "r", 1, 1)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.GetReview(ctx, "..", "r", 1, 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_DeletePendingReview(t *testing.T) {
@@ -152,6 +156,8 @@ func TestPullRequestsService_DeletePendingReview_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.DeletePendingReview(ctx, "%", "r", 1, 1)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.DeletePendingReview(ctx, "..", "r", 1, 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_ListReviewComments(t *testing.T) {
@@ -351,6 +357,8 @@ func TestPullRequestsService_ListReviewComments_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.ListReviewComments(ctx, "%", "r", 1, 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.ListReviewComments(ctx, "..", "r", 1, 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_CreateReview(t *testing.T) {
@@ -408,6 +416,8 @@ func TestPullRequestsService_CreateReview_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.CreateReview(ctx, "%", "r", 1, &PullRequestReviewRequest{})
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.CreateReview(ctx, "..", "r", 1, &PullRequestReviewRequest{})
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_CreateReview_badReview(t *testing.T) {
@@ -575,6 +585,8 @@ func TestPullRequestsService_SubmitReview_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.SubmitReview(ctx, "%", "r", 1, 1, &PullRequestReviewRequest{})
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.SubmitReview(ctx, "..", "r", 1, 1, &PullRequestReviewRequest{})
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_DismissReview(t *testing.T) {
@@ -628,6 +640,8 @@ func TestPullRequestsService_DismissReview_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.DismissReview(ctx, "%", "r", 1, 1, &PullRequestReviewDismissalRequest{})
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.DismissReview(ctx, "..", "r", 1, 1, &PullRequestReviewDismissalRequest{})
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestReviewDismissalRequest_Marshal(t *testing.T) {
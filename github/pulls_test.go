@@ -6,8 +6,10 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -108,6 +110,8 @@ func TestPullRequestsService_List_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.List(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.List(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_Get(t *testing.T) {
@@ -219,6 +223,95 @@ func TestPullRequestsService_GetRaw_invalid(t *testing.T) {
 	}
 }
 
+func TestPullRequestsService_GetDiffAndPatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch accept := r.Header.Get("Accept"); accept {
+		case mediaTypeV3Diff:
+			fmt.Fprint(w, "@@diff content")
+		case mediaTypeV3Patch:
+			fmt.Fprint(w, "@@patch content")
+		default:
+			t.Errorf("unexpected Accept header %q", accept)
+		}
+	})
+
+	ctx := context.Background()
+	diff, _, err := client.PullRequests.GetDiff(ctx, "o", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.GetDiff returned error: %v", err)
+	}
+	if want := "@@diff content"; diff != want {
+		t.Errorf("PullRequests.GetDiff returned %q, want %q", diff, want)
+	}
+
+	patch, _, err := client.PullRequests.GetPatch(ctx, "o", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.GetPatch returned error: %v", err)
+	}
+	if want := "@@patch content"; patch != want {
+		t.Errorf("PullRequests.GetPatch returned %q, want %q", patch, want)
+	}
+}
+
+func TestPullRequestsService_GetRawTo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Diff)
+		fmt.Fprint(w, "@@diff content")
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	_, err := client.PullRequests.GetRawTo(ctx, "o", "r", 1, RawOptions{Type: Diff}, &buf)
+	if err != nil {
+		t.Fatalf("PullRequests.GetRawTo returned error: %v", err)
+	}
+	if got, want := buf.String(), "@@diff content"; got != want {
+		t.Errorf("PullRequests.GetRawTo wrote %q, want %q", got, want)
+	}
+
+	const methodName = "GetRawTo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.PullRequests.GetRawTo(ctx, "\n", "\n", -1, RawOptions{Type: Diff}, &buf)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.PullRequests.GetRawTo(ctx, "o", "r", 1, RawOptions{Type: Diff}, &buf)
+	})
+}
+
+func TestPullRequestsService_GetRaw_tooLarge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", mediaTypeV3Diff)
+		w.WriteHeader(http.StatusNotAcceptable)
+		fmt.Fprint(w, `{"message":"Sorry, the diff exceeded the maximum number of files (300). Consider using 'List pull requests files' API or locally cloning the repository instead.","errors":[{"resource":"PullRequest","field":"diff","code":"too_large"}]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.PullRequests.GetDiff(ctx, "o", "r", 1)
+	if !errors.Is(err, ErrDiffTooLarge) {
+		t.Errorf("PullRequests.GetDiff returned error %v, want it to wrap ErrDiffTooLarge", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotAcceptable {
+		t.Errorf("PullRequests.GetDiff returned error %v, want it to wrap the 406 *ErrorResponse", err)
+	}
+	if got != "" {
+		t.Errorf("PullRequests.GetDiff returned %q, want empty", got)
+	}
+}
+
 func TestPullRequestsService_Get_links(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -351,6 +444,8 @@ func TestPullRequestsService_Get_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.Get(ctx, "%", "r", 1)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.Get(ctx, "..", "r", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_Create(t *testing.T) {
@@ -404,6 +499,8 @@ func TestPullRequestsService_Create_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.Create(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.Create(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_UpdateBranch(t *testing.T) {
@@ -522,6 +619,8 @@ func TestPullRequestsService_Edit_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.PullRequests.Edit(ctx, "%", "r", 1, &PullRequest{})
 	testURLParseError(t, err)
+	_, _, err = client.PullRequests.Edit(ctx, "..", "r", 1, &PullRequest{})
+	testPathForbiddenError(t, err)
 }
 
 func TestPullRequestsService_ListCommits(t *testing.T) {
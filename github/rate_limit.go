@@ -5,7 +5,10 @@
 
 package github
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // RateLimitService provides access to rate limit functions in the GitHub API.
 type RateLimitService service
@@ -26,6 +29,24 @@ func (r Rate) String() string {
 	return Stringify(r)
 }
 
+// Exhausted reports whether no requests remain until the rate limit resets,
+// and the reset is still in the future.
+func (r Rate) Exhausted() bool {
+	return !r.Reset.Time.IsZero() && r.Remaining == 0 && time.Now().Before(r.Reset.Time)
+}
+
+// ResetIn returns how long is left until the rate limit resets, or zero if
+// the reset time is unknown or has already passed.
+func (r Rate) ResetIn() time.Duration {
+	if r.Reset.Time.IsZero() {
+		return 0
+	}
+	if d := time.Until(r.Reset.Time); d > 0 {
+		return d
+	}
+	return 0
+}
+
 // RateLimits represents the rate limits for the current client.
 type RateLimits struct {
 	// The rate limit for non-search API requests. Unauthenticated
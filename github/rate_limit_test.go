@@ -479,3 +479,38 @@ func TestRate_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestRate_Exhausted(t *testing.T) {
+	now := time.Now()
+	tests := map[string]struct {
+		rate Rate
+		want bool
+	}{
+		"unknown":        {rate: Rate{}, want: false},
+		"remaining":      {rate: Rate{Limit: 60, Remaining: 1, Reset: Timestamp{now.Add(time.Minute)}}, want: false},
+		"exhausted":      {rate: Rate{Limit: 60, Remaining: 0, Reset: Timestamp{now.Add(time.Minute)}}, want: true},
+		"reset in past":  {rate: Rate{Limit: 60, Remaining: 0, Reset: Timestamp{now.Add(-time.Minute)}}, want: false},
+		"no reset known": {rate: Rate{Limit: 60, Remaining: 0}, want: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.rate.Exhausted(); got != test.want {
+				t.Errorf("Exhausted() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRate_ResetIn(t *testing.T) {
+	if got := (Rate{}).ResetIn(); got != 0 {
+		t.Errorf("ResetIn() of unknown reset = %v, want 0", got)
+	}
+	if got := (Rate{Reset: Timestamp{time.Now().Add(-time.Minute)}}).ResetIn(); got != 0 {
+		t.Errorf("ResetIn() of past reset = %v, want 0", got)
+	}
+	got := (Rate{Reset: Timestamp{time.Now().Add(time.Minute)}}).ResetIn()
+	if got <= 50*time.Second || got > time.Minute {
+		t.Errorf("ResetIn() = %v, want about a minute", got)
+	}
+}
@@ -64,7 +64,7 @@ type ListCommentReactionOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/comments/{comment_id}/reactions
 func (s *ReactionsService) ListCommentReactions(ctx context.Context, owner, repo string, id int64, opts *ListCommentReactionOptions) ([]*Reaction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions", PathEscape(owner), PathEscape(repo), id)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -96,7 +96,7 @@ func (s *ReactionsService) ListCommentReactions(ctx context.Context, owner, repo
 //
 //meta:operation POST /repos/{owner}/{repo}/comments/{comment_id}/reactions
 func (s *ReactionsService) CreateCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions", PathEscape(owner), PathEscape(repo), id)
 
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
@@ -122,7 +122,7 @@ func (s *ReactionsService) CreateCommentReaction(ctx context.Context, owner, rep
 //
 //meta:operation DELETE /repos/{owner}/{repo}/comments/{comment_id}/reactions/{reaction_id}
 func (s *ReactionsService) DeleteCommentReaction(ctx context.Context, owner, repo string, commentID, reactionID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions/%v", owner, repo, commentID, reactionID)
+	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions/%v", PathEscape(owner), PathEscape(repo), commentID, reactionID)
 
 	return s.deleteReaction(ctx, u)
 }
@@ -144,7 +144,7 @@ func (s *ReactionsService) DeleteCommentReactionByID(ctx context.Context, repoID
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/reactions
 func (s *ReactionsService) ListIssueReactions(ctx context.Context, owner, repo string, number int, opts *ListOptions) ([]*Reaction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%v/reactions", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%v/reactions", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -176,7 +176,7 @@ func (s *ReactionsService) ListIssueReactions(ctx context.Context, owner, repo s
 //
 //meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/reactions
 func (s *ReactionsService) CreateIssueReaction(ctx context.Context, owner, repo string, number int, content string) (*Reaction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%v/reactions", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/issues/%v/reactions", PathEscape(owner), PathEscape(repo), number)
 
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
@@ -202,7 +202,7 @@ func (s *ReactionsService) CreateIssueReaction(ctx context.Context, owner, repo
 //
 //meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/reactions/{reaction_id}
 func (s *ReactionsService) DeleteIssueReaction(ctx context.Context, owner, repo string, issueNumber int, reactionID int64) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/issues/%v/reactions/%v", owner, repo, issueNumber, reactionID)
+	url := fmt.Sprintf("repos/%v/%v/issues/%v/reactions/%v", PathEscape(owner), PathEscape(repo), issueNumber, reactionID)
 
 	return s.deleteReaction(ctx, url)
 }
@@ -224,7 +224,7 @@ func (s *ReactionsService) DeleteIssueReactionByID(ctx context.Context, repoID,
 //
 //meta:operation GET /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions
 func (s *ReactionsService) ListIssueCommentReactions(ctx context.Context, owner, repo string, id int64, opts *ListOptions) ([]*Reaction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions", PathEscape(owner), PathEscape(repo), id)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -256,7 +256,7 @@ func (s *ReactionsService) ListIssueCommentReactions(ctx context.Context, owner,
 //
 //meta:operation POST /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions
 func (s *ReactionsService) CreateIssueCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions", PathEscape(owner), PathEscape(repo), id)
 
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
@@ -282,7 +282,7 @@ func (s *ReactionsService) CreateIssueCommentReaction(ctx context.Context, owner
 //
 //meta:operation DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions/{reaction_id}
 func (s *ReactionsService) DeleteIssueCommentReaction(ctx context.Context, owner, repo string, commentID, reactionID int64) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions/%v", owner, repo, commentID, reactionID)
+	url := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions/%v", PathEscape(owner), PathEscape(repo), commentID, reactionID)
 
 	return s.deleteReaction(ctx, url)
 }
@@ -304,7 +304,7 @@ func (s *ReactionsService) DeleteIssueCommentReactionByID(ctx context.Context, r
 //
 //meta:operation GET /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions
 func (s *ReactionsService) ListPullRequestCommentReactions(ctx context.Context, owner, repo string, id int64, opts *ListOptions) ([]*Reaction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions", PathEscape(owner), PathEscape(repo), id)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -336,7 +336,7 @@ func (s *ReactionsService) ListPullRequestCommentReactions(ctx context.Context,
 //
 //meta:operation POST /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions
 func (s *ReactionsService) CreatePullRequestCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions", PathEscape(owner), PathEscape(repo), id)
 
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
@@ -362,7 +362,7 @@ func (s *ReactionsService) CreatePullRequestCommentReaction(ctx context.Context,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions/{reaction_id}
 func (s *ReactionsService) DeletePullRequestCommentReaction(ctx context.Context, owner, repo string, commentID, reactionID int64) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions/%v", owner, repo, commentID, reactionID)
+	url := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions/%v", PathEscape(owner), PathEscape(repo), commentID, reactionID)
 
 	return s.deleteReaction(ctx, url)
 }
@@ -438,7 +438,7 @@ func (s *ReactionsService) CreateTeamDiscussionReaction(ctx context.Context, tea
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/reactions/{reaction_id}
 func (s *ReactionsService) DeleteTeamDiscussionReaction(ctx context.Context, org, teamSlug string, discussionNumber int, reactionID int64) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/reactions/%v", org, teamSlug, discussionNumber, reactionID)
+	url := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/reactions/%v", PathEscape(org), PathEscape(teamSlug), discussionNumber, reactionID)
 
 	return s.deleteReaction(ctx, url)
 }
@@ -513,7 +513,7 @@ func (s *ReactionsService) CreateTeamDiscussionCommentReaction(ctx context.Conte
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}/reactions/{reaction_id}
 func (s *ReactionsService) DeleteTeamDiscussionCommentReaction(ctx context.Context, org, teamSlug string, discussionNumber, commentNumber int, reactionID int64) (*Response, error) {
-	url := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments/%v/reactions/%v", org, teamSlug, discussionNumber, commentNumber, reactionID)
+	url := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments/%v/reactions/%v", PathEscape(org), PathEscape(teamSlug), discussionNumber, commentNumber, reactionID)
 
 	return s.deleteReaction(ctx, url)
 }
@@ -550,7 +550,7 @@ func (s *ReactionsService) deleteReaction(ctx context.Context, url string) (*Res
 //
 //meta:operation POST /repos/{owner}/{repo}/releases/{release_id}/reactions
 func (s *ReactionsService) CreateReleaseReaction(ctx context.Context, owner, repo string, releaseID int64, content string) (*Reaction, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions", owner, repo, releaseID)
+	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions", PathEscape(owner), PathEscape(repo), releaseID)
 
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
@@ -0,0 +1,366 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package recorder provides an http.RoundTripper that records the requests
+// made by a github.Client and their responses to a file, and replays them
+// later, so that tests of code using the client can run without network
+// access or a mock server.
+//
+// Record a cassette once against the real API:
+//
+//	rec, err := recorder.New("testdata/projects.json", &recorder.Options{Mode: recorder.ModeRecord})
+//	...
+//	client := github.NewClient(&http.Client{Transport: rec}).WithAuthToken(token)
+//	// Make calls with client.
+//	err = rec.Stop() // Writes the cassette.
+//
+// and then replay it in tests, where no token is needed:
+//
+//	rec, err := recorder.New("testdata/projects.json", nil)
+//	...
+//	client := github.NewClient(&http.Client{Transport: rec})
+//
+// Recorded interactions have their credentials scrubbed: authentication
+// headers, credential query parameters, GitHub tokens anywhere in URLs,
+// headers and bodies, and any Options.Secrets. Volatile response headers,
+// such as Date and X-GitHub-Request-Id, are normalized so that re-recording a
+// cassette produces a small diff.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and fails requests that
+	// were not recorded. It is the default mode.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the underlying transport and records them.
+	ModeRecord
+)
+
+// Redacted replaces the scrubbed secrets in recorded interactions.
+const Redacted = "REDACTED"
+
+// Options specifies the optional parameters to New.
+type Options struct {
+	// Mode is the mode of the recorder. The default is ModeReplay.
+	Mode Mode
+
+	// Transport is the transport used to send requests in ModeRecord.
+	// If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// Secrets are additional strings, such as client secrets or webhook
+	// secrets, to scrub from recorded interactions.
+	Secrets []string
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording or replaying interactions.
+// It is safe for concurrent use.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	secrets   []string
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+}
+
+// New returns a Recorder using the cassette file at path. In ModeReplay, the
+// file is read immediately and must exist. In ModeRecord, it is written by
+// Stop.
+func New(path string, opts *Options) (*Recorder, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	r := &Recorder{
+		path:      path,
+		mode:      opts.Mode,
+		transport: opts.Transport,
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	for _, s := range opts.Secrets {
+		if s != "" {
+			r.secrets = append(r.secrets, s)
+		}
+	}
+
+	switch r.mode {
+	case ModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, fmt.Errorf("recorder: reading cassette %v: %w", path, err)
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	case ModeRecord:
+	default:
+		return nil, fmt.Errorf("recorder: invalid mode %v", r.mode)
+	}
+
+	return r, nil
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+// Stop writes the recorded interactions to the cassette file in ModeRecord.
+// It does nothing in ModeReplay.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	interactions := r.interactions
+	if interactions == nil {
+		interactions = []*Interaction{}
+	}
+	data, err := json.MarshalIndent(interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+// Unplayed returns an error listing the recorded interactions that were not
+// replayed, if any. Tests can use it to check that the code under test made
+// all the expected requests. It returns nil in ModeRecord.
+func (r *Recorder) Unplayed() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unplayed []string
+	for i, replayed := range r.replayed {
+		if !replayed {
+			req := r.interactions[i].Request
+			unplayed = append(unplayed, req.Method+" "+req.URL)
+		}
+	}
+	if len(unplayed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("recorder: interactions not replayed: %v", strings.Join(unplayed, ", "))
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request, so read the body of a copy.
+	req = req.Clone(req.Context())
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{
+		Request: &Request{
+			Method: req.Method,
+			URL:    r.scrubURL(req.URL),
+			Header: r.scrubHeader(req.Header),
+			Body:   r.scrub(string(reqBody)),
+		},
+		Response: &Response{
+			StatusCode: resp.StatusCode,
+			Header:     normalizeHeader(r.scrubHeader(resp.Header)),
+			Body:       r.scrub(string(respBody)),
+		},
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	key, err := matchKey(req.Method, r.scrubURL(req.URL))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Identical requests are replayed in the order they were recorded.
+	for i, interaction := range r.interactions {
+		if r.replayed[i] {
+			continue
+		}
+		k, err := matchKey(interaction.Request.Method, interaction.Request.URL)
+		if err != nil || k != key {
+			continue
+		}
+		r.replayed[i] = true
+
+		rec := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+			StatusCode:    rec.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        rec.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(rec.Body)),
+			ContentLength: int64(len(rec.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("recorder: no recorded interaction for %v", key)
+}
+
+// matchKey returns the key matching requests to recorded interactions: the
+// method, path and query with sorted parameters. The scheme and host are
+// ignored so that a cassette can be replayed against any base URL.
+func matchKey(method, rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	key := method + " " + u.EscapedPath()
+	if q := u.Query().Encode(); q != "" {
+		key += "?" + q
+	}
+	return key, nil
+}
+
+// readBody reads and returns the body, and replaces it with a fresh reader so
+// that it can still be read by the caller.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	if closeErr := (*body).Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// tokenRE matches the GitHub token formats: personal access tokens (classic
+// and fine-grained), OAuth, user-to-server, server-to-server and refresh
+// tokens.
+var tokenRE = regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)
+
+// scrub removes the secrets from s.
+func (r *Recorder) scrub(s string) string {
+	s = tokenRE.ReplaceAllString(s, Redacted)
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, Redacted)
+	}
+	return s
+}
+
+// sensitiveParams are the query parameters that carry credentials.
+var sensitiveParams = []string{"access_token", "client_secret", "code", "token"}
+
+func (r *Recorder) scrubURL(u *url.URL) string {
+	scrubbed := *u
+	scrubbed.User = nil
+	if q := scrubbed.Query(); len(q) > 0 {
+		for _, p := range sensitiveParams {
+			if _, ok := q[p]; ok {
+				q.Set(p, Redacted)
+			}
+		}
+		scrubbed.RawQuery = q.Encode()
+	}
+	return r.scrub(scrubbed.String())
+}
+
+// sensitiveHeaders are the headers that carry credentials.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization", "Set-Cookie", "X-GitHub-OTP"}
+
+func (r *Recorder) scrubHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range sensitiveHeaders {
+		if h.Values(name) != nil {
+			h.Set(name, Redacted)
+		}
+	}
+	for name, values := range h {
+		for i, v := range values {
+			values[i] = r.scrub(v)
+		}
+		h[name] = values
+	}
+	return h
+}
+
+// volatileHeaders are the response headers that change on every request,
+// with the fixed values they are normalized to.
+var volatileHeaders = map[string]string{
+	"Date":                "Mon, 02 Jan 2006 15:04:05 GMT",
+	"X-GitHub-Request-Id": "0000:0000:0000000:0000000:00000000",
+}
+
+func normalizeHeader(h http.Header) http.Header {
+	for name, value := range volatileHeaders {
+		if h.Values(name) != nil {
+			h.Set(name, value)
+		}
+	}
+	return h
+}
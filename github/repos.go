@@ -12,13 +12,25 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 const githubBranchNotProtected string = "Branch not protected"
 
 var ErrBranchNotProtected = errors.New("branch is not protected")
 
+// ErrContributorListTooLarge is wrapped by the error that
+// RepositoriesService.ListContributors returns when GitHub refuses to list the
+// contributors of a repository because it has too many of them.
+var ErrContributorListTooLarge = errors.New("the contributor list of the repository is too large to be listed via the API")
+
+// contributorListTooLargeMessage is the message of the 403 Forbidden
+// responses of the contributors endpoint for such repositories.
+const contributorListTooLargeMessage = "contributor list is too large"
+
 // RepositoriesService handles communication with the repository related
 // methods of the GitHub API.
 //
@@ -27,55 +39,57 @@ type RepositoriesService service
 
 // Repository represents a GitHub repository.
 type Repository struct {
-	ID                        *int64            `json:"id,omitempty"`
-	NodeID                    *string           `json:"node_id,omitempty"`
-	Owner                     *User             `json:"owner,omitempty"`
-	Name                      *string           `json:"name,omitempty"`
-	FullName                  *string           `json:"full_name,omitempty"`
-	Description               *string           `json:"description,omitempty"`
-	Homepage                  *string           `json:"homepage,omitempty"`
-	CodeOfConduct             *CodeOfConduct    `json:"code_of_conduct,omitempty"`
-	DefaultBranch             *string           `json:"default_branch,omitempty"`
-	MasterBranch              *string           `json:"master_branch,omitempty"`
-	CreatedAt                 *Timestamp        `json:"created_at,omitempty"`
-	PushedAt                  *Timestamp        `json:"pushed_at,omitempty"`
-	UpdatedAt                 *Timestamp        `json:"updated_at,omitempty"`
-	HTMLURL                   *string           `json:"html_url,omitempty"`
-	CloneURL                  *string           `json:"clone_url,omitempty"`
-	GitURL                    *string           `json:"git_url,omitempty"`
-	MirrorURL                 *string           `json:"mirror_url,omitempty"`
-	SSHURL                    *string           `json:"ssh_url,omitempty"`
-	SVNURL                    *string           `json:"svn_url,omitempty"`
-	Language                  *string           `json:"language,omitempty"`
-	Fork                      *bool             `json:"fork,omitempty"`
-	ForksCount                *int              `json:"forks_count,omitempty"`
-	NetworkCount              *int              `json:"network_count,omitempty"`
-	OpenIssuesCount           *int              `json:"open_issues_count,omitempty"`
-	OpenIssues                *int              `json:"open_issues,omitempty"` // Deprecated: Replaced by OpenIssuesCount. For backward compatibility OpenIssues is still populated.
-	StargazersCount           *int              `json:"stargazers_count,omitempty"`
-	SubscribersCount          *int              `json:"subscribers_count,omitempty"`
-	WatchersCount             *int              `json:"watchers_count,omitempty"` // Deprecated: Replaced by StargazersCount. For backward compatibility WatchersCount is still populated.
-	Watchers                  *int              `json:"watchers,omitempty"`       // Deprecated: Replaced by StargazersCount. For backward compatibility Watchers is still populated.
-	Size                      *int              `json:"size,omitempty"`
-	AutoInit                  *bool             `json:"auto_init,omitempty"`
-	Parent                    *Repository       `json:"parent,omitempty"`
-	Source                    *Repository       `json:"source,omitempty"`
-	TemplateRepository        *Repository       `json:"template_repository,omitempty"`
-	Organization              *Organization     `json:"organization,omitempty"`
-	Permissions               map[string]bool   `json:"permissions,omitempty"`
-	AllowRebaseMerge          *bool             `json:"allow_rebase_merge,omitempty"`
-	AllowUpdateBranch         *bool             `json:"allow_update_branch,omitempty"`
-	AllowSquashMerge          *bool             `json:"allow_squash_merge,omitempty"`
-	AllowMergeCommit          *bool             `json:"allow_merge_commit,omitempty"`
-	AllowAutoMerge            *bool             `json:"allow_auto_merge,omitempty"`
-	AllowForking              *bool             `json:"allow_forking,omitempty"`
-	WebCommitSignoffRequired  *bool             `json:"web_commit_signoff_required,omitempty"`
-	DeleteBranchOnMerge       *bool             `json:"delete_branch_on_merge,omitempty"`
+	ID                       *int64          `json:"id,omitempty"`
+	NodeID                   *string         `json:"node_id,omitempty"`
+	Owner                    *User           `json:"owner,omitempty"`
+	Name                     *string         `json:"name,omitempty"`
+	FullName                 *string         `json:"full_name,omitempty"`
+	Description              *string         `json:"description,omitempty"`
+	Homepage                 *string         `json:"homepage,omitempty"`
+	CodeOfConduct            *CodeOfConduct  `json:"code_of_conduct,omitempty"`
+	DefaultBranch            *string         `json:"default_branch,omitempty"`
+	MasterBranch             *string         `json:"master_branch,omitempty"`
+	CreatedAt                *Timestamp      `json:"created_at,omitempty"`
+	PushedAt                 *Timestamp      `json:"pushed_at,omitempty"`
+	UpdatedAt                *Timestamp      `json:"updated_at,omitempty"`
+	HTMLURL                  *string         `json:"html_url,omitempty"`
+	CloneURL                 *string         `json:"clone_url,omitempty"`
+	GitURL                   *string         `json:"git_url,omitempty"`
+	MirrorURL                *string         `json:"mirror_url,omitempty"`
+	SSHURL                   *string         `json:"ssh_url,omitempty"`
+	SVNURL                   *string         `json:"svn_url,omitempty"`
+	Language                 *string         `json:"language,omitempty"`
+	Fork                     *bool           `json:"fork,omitempty"`
+	ForksCount               *int            `json:"forks_count,omitempty"`
+	NetworkCount             *int            `json:"network_count,omitempty"`
+	OpenIssuesCount          *int            `json:"open_issues_count,omitempty"`
+	OpenIssues               *int            `json:"open_issues,omitempty"` // Deprecated: Replaced by OpenIssuesCount. For backward compatibility OpenIssues is still populated.
+	StargazersCount          *int            `json:"stargazers_count,omitempty"`
+	SubscribersCount         *int            `json:"subscribers_count,omitempty"`
+	WatchersCount            *int            `json:"watchers_count,omitempty"` // Deprecated: Replaced by StargazersCount. For backward compatibility WatchersCount is still populated.
+	Watchers                 *int            `json:"watchers,omitempty"`       // Deprecated: Replaced by StargazersCount. For backward compatibility Watchers is still populated.
+	Size                     *int            `json:"size,omitempty"`
+	AutoInit                 *bool           `json:"auto_init,omitempty"`
+	Parent                   *Repository     `json:"parent,omitempty"`
+	Source                   *Repository     `json:"source,omitempty"`
+	TemplateRepository       *Repository     `json:"template_repository,omitempty"`
+	Organization             *Organization   `json:"organization,omitempty"`
+	Permissions              map[string]bool `json:"permissions,omitempty"`
+	AllowRebaseMerge         *bool           `json:"allow_rebase_merge,omitempty"`
+	AllowUpdateBranch        *bool           `json:"allow_update_branch,omitempty"`
+	AllowSquashMerge         *bool           `json:"allow_squash_merge,omitempty"`
+	AllowMergeCommit         *bool           `json:"allow_merge_commit,omitempty"`
+	AllowAutoMerge           *bool           `json:"allow_auto_merge,omitempty"`
+	AllowForking             *bool           `json:"allow_forking,omitempty"`
+	WebCommitSignoffRequired *bool           `json:"web_commit_signoff_required,omitempty"`
+	DeleteBranchOnMerge      *bool           `json:"delete_branch_on_merge,omitempty"`
+	// Deprecated: Use SquashMergeCommitTitle instead. RepositoriesService.Create
+	// translates it to SquashMergeCommitTitle when that is unset.
 	UseSquashPRTitleAsDefault *bool             `json:"use_squash_pr_title_as_default,omitempty"`
-	SquashMergeCommitTitle    *string           `json:"squash_merge_commit_title,omitempty"`   // Can be one of: "PR_TITLE", "COMMIT_OR_PR_TITLE"
-	SquashMergeCommitMessage  *string           `json:"squash_merge_commit_message,omitempty"` // Can be one of: "PR_BODY", "COMMIT_MESSAGES", "BLANK"
-	MergeCommitTitle          *string           `json:"merge_commit_title,omitempty"`          // Can be one of: "PR_TITLE", "MERGE_MESSAGE"
-	MergeCommitMessage        *string           `json:"merge_commit_message,omitempty"`        // Can be one of: "PR_BODY", "PR_TITLE", "BLANK"
+	SquashMergeCommitTitle    *string           `json:"squash_merge_commit_title,omitempty"`   // One of the SquashMergeCommitTitle constants.
+	SquashMergeCommitMessage  *string           `json:"squash_merge_commit_message,omitempty"` // One of the SquashMergeCommitMessage constants.
+	MergeCommitTitle          *string           `json:"merge_commit_title,omitempty"`          // One of the MergeCommitTitle constants.
+	MergeCommitMessage        *string           `json:"merge_commit_message,omitempty"`        // One of the MergeCommitMessage constants.
 	Topics                    []string          `json:"topics,omitempty"`
 	CustomProperties          map[string]string `json:"custom_properties,omitempty"`
 	Archived                  *bool             `json:"archived,omitempty"`
@@ -316,7 +330,7 @@ type RepositoryListByUserOptions struct {
 //
 //meta:operation GET /users/{username}/repos
 func (s *RepositoriesService) ListByUser(ctx context.Context, user string, opts *RepositoryListByUserOptions) ([]*Repository, *Response, error) {
-	u := fmt.Sprintf("users/%v/repos", user)
+	u := fmt.Sprintf("users/%v/repos", PathEscape(user))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -423,7 +437,7 @@ type RepositoryListByOrgOptions struct {
 //
 //meta:operation GET /orgs/{org}/repos
 func (s *RepositoriesService) ListByOrg(ctx context.Context, org string, opts *RepositoryListByOrgOptions) ([]*Repository, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/repos", org)
+	u := fmt.Sprintf("orgs/%v/repos", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -501,21 +515,23 @@ type createRepoRequest struct {
 	// Creating an organization repository. Required for non-owners.
 	TeamID *int64 `json:"team_id,omitempty"`
 
-	AutoInit                  *bool   `json:"auto_init,omitempty"`
-	GitignoreTemplate         *string `json:"gitignore_template,omitempty"`
-	LicenseTemplate           *string `json:"license_template,omitempty"`
-	AllowSquashMerge          *bool   `json:"allow_squash_merge,omitempty"`
-	AllowMergeCommit          *bool   `json:"allow_merge_commit,omitempty"`
-	AllowRebaseMerge          *bool   `json:"allow_rebase_merge,omitempty"`
-	AllowUpdateBranch         *bool   `json:"allow_update_branch,omitempty"`
-	AllowAutoMerge            *bool   `json:"allow_auto_merge,omitempty"`
-	AllowForking              *bool   `json:"allow_forking,omitempty"`
-	DeleteBranchOnMerge       *bool   `json:"delete_branch_on_merge,omitempty"`
-	UseSquashPRTitleAsDefault *bool   `json:"use_squash_pr_title_as_default,omitempty"`
-	SquashMergeCommitTitle    *string `json:"squash_merge_commit_title,omitempty"`
-	SquashMergeCommitMessage  *string `json:"squash_merge_commit_message,omitempty"`
-	MergeCommitTitle          *string `json:"merge_commit_title,omitempty"`
-	MergeCommitMessage        *string `json:"merge_commit_message,omitempty"`
+	AutoInit                 *bool   `json:"auto_init,omitempty"`
+	GitignoreTemplate        *string `json:"gitignore_template,omitempty"`
+	LicenseTemplate          *string `json:"license_template,omitempty"`
+	AllowSquashMerge         *bool   `json:"allow_squash_merge,omitempty"`
+	AllowMergeCommit         *bool   `json:"allow_merge_commit,omitempty"`
+	AllowRebaseMerge         *bool   `json:"allow_rebase_merge,omitempty"`
+	AllowUpdateBranch        *bool   `json:"allow_update_branch,omitempty"`
+	AllowAutoMerge           *bool   `json:"allow_auto_merge,omitempty"`
+	AllowForking             *bool   `json:"allow_forking,omitempty"`
+	DeleteBranchOnMerge      *bool   `json:"delete_branch_on_merge,omitempty"`
+	SquashMergeCommitTitle   *string `json:"squash_merge_commit_title,omitempty"`
+	SquashMergeCommitMessage *string `json:"squash_merge_commit_message,omitempty"`
+	MergeCommitTitle         *string `json:"merge_commit_title,omitempty"`
+	MergeCommitMessage       *string `json:"merge_commit_message,omitempty"`
+
+	// CustomProperties can only be set when creating an organization repository.
+	CustomProperties map[string]string `json:"custom_properties,omitempty"`
 }
 
 // Create a new repository. If an organization is specified, the new
@@ -523,7 +539,8 @@ type createRepoRequest struct {
 // specified, it will be created for the authenticated user.
 //
 // Note that only a subset of the repo fields are used and repo must
-// not be nil.
+// not be nil. The deprecated UseSquashPRTitleAsDefault field is sent as the
+// equivalent SquashMergeCommitTitle, unless that is set too.
 //
 // Also note that this method will return the response without actually
 // waiting for GitHub to finish creating the repository and letting the
@@ -538,38 +555,45 @@ type createRepoRequest struct {
 func (s *RepositoriesService) Create(ctx context.Context, org string, repo *Repository) (*Repository, *Response, error) {
 	var u string
 	if org != "" {
-		u = fmt.Sprintf("orgs/%v/repos", org)
+		u = fmt.Sprintf("orgs/%v/repos", PathEscape(org))
 	} else {
 		u = "user/repos"
 	}
 
 	repoReq := &createRepoRequest{
-		Name:                      repo.Name,
-		Description:               repo.Description,
-		Homepage:                  repo.Homepage,
-		Private:                   repo.Private,
-		Visibility:                repo.Visibility,
-		HasIssues:                 repo.HasIssues,
-		HasProjects:               repo.HasProjects,
-		HasWiki:                   repo.HasWiki,
-		HasDiscussions:            repo.HasDiscussions,
-		IsTemplate:                repo.IsTemplate,
-		TeamID:                    repo.TeamID,
-		AutoInit:                  repo.AutoInit,
-		GitignoreTemplate:         repo.GitignoreTemplate,
-		LicenseTemplate:           repo.LicenseTemplate,
-		AllowSquashMerge:          repo.AllowSquashMerge,
-		AllowMergeCommit:          repo.AllowMergeCommit,
-		AllowRebaseMerge:          repo.AllowRebaseMerge,
-		AllowUpdateBranch:         repo.AllowUpdateBranch,
-		AllowAutoMerge:            repo.AllowAutoMerge,
-		AllowForking:              repo.AllowForking,
-		DeleteBranchOnMerge:       repo.DeleteBranchOnMerge,
-		UseSquashPRTitleAsDefault: repo.UseSquashPRTitleAsDefault,
-		SquashMergeCommitTitle:    repo.SquashMergeCommitTitle,
-		SquashMergeCommitMessage:  repo.SquashMergeCommitMessage,
-		MergeCommitTitle:          repo.MergeCommitTitle,
-		MergeCommitMessage:        repo.MergeCommitMessage,
+		Name:                     repo.Name,
+		Description:              repo.Description,
+		Homepage:                 repo.Homepage,
+		Private:                  repo.Private,
+		Visibility:               repo.Visibility,
+		HasIssues:                repo.HasIssues,
+		HasProjects:              repo.HasProjects,
+		HasWiki:                  repo.HasWiki,
+		HasDiscussions:           repo.HasDiscussions,
+		IsTemplate:               repo.IsTemplate,
+		TeamID:                   repo.TeamID,
+		AutoInit:                 repo.AutoInit,
+		GitignoreTemplate:        repo.GitignoreTemplate,
+		LicenseTemplate:          repo.LicenseTemplate,
+		AllowSquashMerge:         repo.AllowSquashMerge,
+		AllowMergeCommit:         repo.AllowMergeCommit,
+		AllowRebaseMerge:         repo.AllowRebaseMerge,
+		AllowUpdateBranch:        repo.AllowUpdateBranch,
+		AllowAutoMerge:           repo.AllowAutoMerge,
+		AllowForking:             repo.AllowForking,
+		DeleteBranchOnMerge:      repo.DeleteBranchOnMerge,
+		SquashMergeCommitTitle:   repo.SquashMergeCommitTitle,
+		SquashMergeCommitMessage: repo.SquashMergeCommitMessage,
+		MergeCommitTitle:         repo.MergeCommitTitle,
+		MergeCommitMessage:       repo.MergeCommitMessage,
+		CustomProperties:         repo.CustomProperties,
+	}
+	if repoReq.SquashMergeCommitTitle == nil && repo.UseSquashPRTitleAsDefault != nil {
+		if *repo.UseSquashPRTitleAsDefault {
+			repoReq.SquashMergeCommitTitle = String(SquashMergeCommitTitlePRTitle)
+		} else {
+			repoReq.SquashMergeCommitTitle = String(SquashMergeCommitTitleCommitOrPRTitle)
+		}
 	}
 
 	req, err := s.client.NewRequest("POST", u, repoReq)
@@ -591,21 +615,30 @@ func (s *RepositoriesService) Create(ctx context.Context, org string, repo *Repo
 // TemplateRepoRequest represents a request to create a repository from a template.
 type TemplateRepoRequest struct {
 	// Name is required when creating a repo.
-	Name        *string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
+	// Owner is the organization or user that will own the new repository.
+	// If unset, the repository is created for the authenticated user.
 	Owner       *string `json:"owner,omitempty"`
 	Description *string `json:"description,omitempty"`
 
+	// IncludeAllBranches copies every branch of the template, rather than only
+	// its default branch.
 	IncludeAllBranches *bool `json:"include_all_branches,omitempty"`
 	Private            *bool `json:"private,omitempty"`
 }
 
 // CreateFromTemplate generates a repository from a template.
 //
+// The template repository must have Repository.IsTemplate set. There is no
+// separate endpoint to mark a repository as a template; use Edit with
+// IsTemplate set to true. The generated repository links back to the template
+// through Repository.TemplateRepository.
+//
 // GitHub API docs: https://docs.github.com/rest/repos/repos#create-a-repository-using-a-template
 //
 //meta:operation POST /repos/{template_owner}/{template_repo}/generate
 func (s *RepositoriesService) CreateFromTemplate(ctx context.Context, templateOwner, templateRepo string, templateRepoReq *TemplateRepoRequest) (*Repository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/generate", templateOwner, templateRepo)
+	u := fmt.Sprintf("repos/%v/%v/generate", PathEscape(templateOwner), PathEscape(templateRepo))
 
 	req, err := s.client.NewRequest("POST", u, templateRepoReq)
 	if err != nil {
@@ -628,7 +661,7 @@ func (s *RepositoriesService) CreateFromTemplate(ctx context.Context, templateOw
 //
 //meta:operation GET /repos/{owner}/{repo}
 func (s *RepositoriesService) Get(ctx context.Context, owner, repo string) (*Repository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -661,7 +694,7 @@ func (s *RepositoriesService) Get(ctx context.Context, owner, repo string) (*Rep
 //
 //meta:operation GET /repos/{owner}/{repo}
 func (s *RepositoriesService) GetCodeOfConduct(ctx context.Context, owner, repo string) (*CodeOfConduct, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -706,7 +739,7 @@ func (s *RepositoriesService) GetByID(ctx context.Context, id int64) (*Repositor
 //
 //meta:operation PATCH /repos/{owner}/{repo}
 func (s *RepositoriesService) Edit(ctx context.Context, owner, repo string, repository *Repository) (*Repository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PATCH", u, repository)
 	if err != nil {
 		return nil, nil, err
@@ -729,7 +762,7 @@ func (s *RepositoriesService) Edit(ctx context.Context, owner, repo string, repo
 //
 //meta:operation DELETE /repos/{owner}/{repo}
 func (s *RepositoriesService) Delete(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -738,7 +771,37 @@ func (s *RepositoriesService) Delete(ctx context.Context, owner, repo string) (*
 	return s.client.Do(ctx, req, nil)
 }
 
-// Contributor represents a repository contributor
+// The possible values of Repository.SquashMergeCommitTitle.
+const (
+	SquashMergeCommitTitlePRTitle         = "PR_TITLE"
+	SquashMergeCommitTitleCommitOrPRTitle = "COMMIT_OR_PR_TITLE"
+)
+
+// The possible values of Repository.SquashMergeCommitMessage.
+const (
+	SquashMergeCommitMessagePRBody         = "PR_BODY"
+	SquashMergeCommitMessageCommitMessages = "COMMIT_MESSAGES"
+	SquashMergeCommitMessageBlank          = "BLANK"
+)
+
+// The possible values of Repository.MergeCommitTitle.
+const (
+	MergeCommitTitlePRTitle      = "PR_TITLE"
+	MergeCommitTitleMergeMessage = "MERGE_MESSAGE"
+)
+
+// The possible values of Repository.MergeCommitMessage.
+const (
+	MergeCommitMessagePRBody  = "PR_BODY"
+	MergeCommitMessagePRTitle = "PR_TITLE"
+	MergeCommitMessageBlank   = "BLANK"
+)
+
+// Contributor represents a repository contributor.
+//
+// Anonymous contributors, which are listed with ListContributorsOptions.Anon,
+// are commit authors without a GitHub account: they only have a Type of
+// "Anonymous", a Name, an Email and Contributions.
 type Contributor struct {
 	Login             *string `json:"login,omitempty"`
 	ID                *int64  `json:"id,omitempty"`
@@ -763,11 +826,24 @@ type Contributor struct {
 	Email             *string `json:"email,omitempty"`
 }
 
+// IsAnonymous reports whether c is an anonymous contributor.
+func (c *Contributor) IsAnonymous() bool {
+	return c.GetType() == "Anonymous"
+}
+
 // ListContributorsOptions specifies the optional parameters to the
 // RepositoriesService.ListContributors method.
 type ListContributorsOptions struct {
-	// Include anonymous contributors in results or not
-	Anon string `url:"anon,omitempty"`
+	// Anon includes anonymous contributors in the results.
+	Anon bool `url:"anon,omitempty"`
+
+	// MaxAcceptedRetries is the number of times the request is sent again
+	// while GitHub responds with 202 Accepted, which it does while it
+	// computes the contributors of a large repository.
+	MaxAcceptedRetries int `url:"-"`
+	// AcceptedRetryDelay is the delay before each such retry. It defaults to
+	// one second.
+	AcceptedRetryDelay time.Duration `url:"-"`
 
 	ListOptions
 }
@@ -778,7 +854,7 @@ type ListContributorsOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/vulnerability-alerts
 func (s *RepositoriesService) GetVulnerabilityAlerts(ctx context.Context, owner, repository string) (bool, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/vulnerability-alerts", owner, repository)
+	u := fmt.Sprintf("repos/%v/%v/vulnerability-alerts", PathEscape(owner), PathEscape(repository))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -799,7 +875,7 @@ func (s *RepositoriesService) GetVulnerabilityAlerts(ctx context.Context, owner,
 //
 //meta:operation PUT /repos/{owner}/{repo}/vulnerability-alerts
 func (s *RepositoriesService) EnableVulnerabilityAlerts(ctx context.Context, owner, repository string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/vulnerability-alerts", owner, repository)
+	u := fmt.Sprintf("repos/%v/%v/vulnerability-alerts", PathEscape(owner), PathEscape(repository))
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -818,7 +894,7 @@ func (s *RepositoriesService) EnableVulnerabilityAlerts(ctx context.Context, own
 //
 //meta:operation DELETE /repos/{owner}/{repo}/vulnerability-alerts
 func (s *RepositoriesService) DisableVulnerabilityAlerts(ctx context.Context, owner, repository string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/vulnerability-alerts", owner, repository)
+	u := fmt.Sprintf("repos/%v/%v/vulnerability-alerts", PathEscape(owner), PathEscape(repository))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -837,7 +913,7 @@ func (s *RepositoriesService) DisableVulnerabilityAlerts(ctx context.Context, ow
 //
 //meta:operation GET /repos/{owner}/{repo}/automated-security-fixes
 func (s *RepositoriesService) GetAutomatedSecurityFixes(ctx context.Context, owner, repository string) (*AutomatedSecurityFixes, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/automated-security-fixes", owner, repository)
+	u := fmt.Sprintf("repos/%v/%v/automated-security-fixes", PathEscape(owner), PathEscape(repository))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -858,7 +934,7 @@ func (s *RepositoriesService) GetAutomatedSecurityFixes(ctx context.Context, own
 //
 //meta:operation PUT /repos/{owner}/{repo}/automated-security-fixes
 func (s *RepositoriesService) EnableAutomatedSecurityFixes(ctx context.Context, owner, repository string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/automated-security-fixes", owner, repository)
+	u := fmt.Sprintf("repos/%v/%v/automated-security-fixes", PathEscape(owner), PathEscape(repository))
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -874,7 +950,7 @@ func (s *RepositoriesService) EnableAutomatedSecurityFixes(ctx context.Context,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/automated-security-fixes
 func (s *RepositoriesService) DisableAutomatedSecurityFixes(ctx context.Context, owner, repository string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/automated-security-fixes", owner, repository)
+	u := fmt.Sprintf("repos/%v/%v/automated-security-fixes", PathEscape(owner), PathEscape(repository))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -886,24 +962,39 @@ func (s *RepositoriesService) DisableAutomatedSecurityFixes(ctx context.Context,
 
 // ListContributors lists contributors for a repository.
 //
+// GitHub may respond with 202 Accepted while it computes the contributors of
+// a large repository, in which case an *AcceptedError is returned unless
+// opts.MaxAcceptedRetries allows retrying. GitHub refuses to list the
+// contributors of repositories with too many of them; the error returned then
+// wraps ErrContributorListTooLarge.
+//
 // GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-contributors
 //
 //meta:operation GET /repos/{owner}/{repo}/contributors
 func (s *RepositoriesService) ListContributors(ctx context.Context, owner string, repository string, opts *ListContributorsOptions) ([]*Contributor, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/contributors", owner, repository)
+	u := fmt.Sprintf("repos/%v/%v/contributors", PathEscape(owner), PathEscape(repository))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, nil, err
+	var retries int
+	delay := time.Second
+	if opts != nil {
+		retries = opts.MaxAcceptedRetries
+		if opts.AcceptedRetryDelay > 0 {
+			delay = opts.AcceptedRetryDelay
+		}
 	}
 
 	var contributor []*Contributor
-	resp, err := s.client.Do(ctx, req, &contributor)
+	resp, err := s.client.doRetryingAccepted(ctx, u, &contributor, retries, delay)
 	if err != nil {
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusForbidden &&
+			strings.Contains(errResp.Message, contributorListTooLargeMessage) {
+			err = fmt.Errorf("%w: %w", ErrContributorListTooLarge, err)
+		}
 		return nil, resp, err
 	}
 
@@ -922,14 +1013,14 @@ func (s *RepositoriesService) ListContributors(ctx context.Context, owner string
 // GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-languages
 //
 //meta:operation GET /repos/{owner}/{repo}/languages
-func (s *RepositoriesService) ListLanguages(ctx context.Context, owner string, repo string) (map[string]int, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/languages", owner, repo)
+func (s *RepositoriesService) ListLanguages(ctx context.Context, owner string, repo string) (RepositoryLanguages, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/languages", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	languages := make(map[string]int)
+	languages := make(RepositoryLanguages)
 	resp, err := s.client.Do(ctx, req, &languages)
 	if err != nil {
 		return nil, resp, err
@@ -944,7 +1035,7 @@ func (s *RepositoriesService) ListLanguages(ctx context.Context, owner string, r
 //
 //meta:operation GET /repos/{owner}/{repo}/teams
 func (s *RepositoriesService) ListTeams(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*Team, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/teams", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/teams", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -970,15 +1061,35 @@ type RepositoryTag struct {
 	Commit     *Commit `json:"commit,omitempty"`
 	ZipballURL *string `json:"zipball_url,omitempty"`
 	TarballURL *string `json:"tarball_url,omitempty"`
+
+	// Tag is the annotated tag object, including its message, tagger and
+	// verification. It is only populated by ListTags when
+	// ListTagsOptions.Dereference is set, and is nil for lightweight tags.
+	Tag *Tag `json:"-"`
+}
+
+// ListTagsOptions specifies the optional parameters to the
+// RepositoriesService.ListTags method.
+type ListTagsOptions struct {
+	// Dereference fetches the annotated tag object of each listed tag into
+	// RepositoryTag.Tag. This costs two extra API calls per annotated tag and
+	// one per lightweight tag.
+	Dereference bool `url:"-"`
+
+	ListOptions
 }
 
 // ListTags lists tags for the specified repository.
 //
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/tags#get-a-tag
 // GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-tags
 //
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation GET /repos/{owner}/{repo}/git/tags/{tag_sha}
 //meta:operation GET /repos/{owner}/{repo}/tags
-func (s *RepositoriesService) ListTags(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*RepositoryTag, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/tags", owner, repo)
+func (s *RepositoriesService) ListTags(ctx context.Context, owner string, repo string, opts *ListTagsOptions) ([]*RepositoryTag, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/tags", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -995,6 +1106,24 @@ func (s *RepositoriesService) ListTags(ctx context.Context, owner string, repo s
 		return nil, resp, err
 	}
 
+	if opts == nil || !opts.Dereference {
+		return tags, resp, nil
+	}
+
+	for _, tag := range tags {
+		ref, refResp, err := s.client.Git.GetRef(ctx, owner, repo, "tags/"+tag.GetName())
+		if err != nil {
+			return nil, refResp, err
+		}
+		if ref.GetObject().GetType() != "tag" {
+			continue
+		}
+		tag.Tag, refResp, err = s.client.Git.GetTag(ctx, owner, repo, ref.GetObject().GetSHA())
+		if err != nil {
+			return nil, refResp, err
+		}
+	}
+
 	return tags, resp, nil
 }
 
@@ -1404,7 +1533,7 @@ type AutomatedSecurityFixes struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/branches
 func (s *RepositoriesService) ListBranches(ctx context.Context, owner string, repo string, opts *BranchListOptions) ([]*Branch, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/branches", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -1432,7 +1561,7 @@ func (s *RepositoriesService) ListBranches(ctx context.Context, owner string, re
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}
 func (s *RepositoriesService) GetBranch(ctx context.Context, owner, repo, branch string, maxRedirects int) (*Branch, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 
 	resp, err := s.client.roundTripWithOptionalFollowRedirect(ctx, u, maxRedirects)
 	if err != nil {
@@ -1465,7 +1594,7 @@ type renameBranchRequest struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/branches/{branch}/rename
 func (s *RepositoriesService) RenameBranch(ctx context.Context, owner, repo, branch, newName string) (*Branch, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/rename", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/rename", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	r := &renameBranchRequest{NewName: newName}
 	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
@@ -1489,7 +1618,7 @@ func (s *RepositoriesService) RenameBranch(ctx context.Context, owner, repo, bra
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection
 func (s *RepositoriesService) GetBranchProtection(ctx context.Context, owner, repo, branch string) (*Protection, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1518,7 +1647,7 @@ func (s *RepositoriesService) GetBranchProtection(ctx context.Context, owner, re
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks
 func (s *RepositoriesService) GetRequiredStatusChecks(ctx context.Context, owner, repo, branch string) (*RequiredStatusChecks, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1544,7 +1673,7 @@ func (s *RepositoriesService) GetRequiredStatusChecks(ctx context.Context, owner
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks/contexts
 func (s *RepositoriesService) ListRequiredStatusChecksContexts(ctx context.Context, owner, repo, branch string) (contexts []string, resp *Response, err error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks/contexts", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks/contexts", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1569,7 +1698,7 @@ func (s *RepositoriesService) ListRequiredStatusChecksContexts(ctx context.Conte
 //
 //meta:operation PUT /repos/{owner}/{repo}/branches/{branch}/protection
 func (s *RepositoriesService) UpdateBranchProtection(ctx context.Context, owner, repo, branch string, preq *ProtectionRequest) (*Protection, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("PUT", u, preq)
 	if err != nil {
 		return nil, nil, err
@@ -1595,7 +1724,7 @@ func (s *RepositoriesService) UpdateBranchProtection(ctx context.Context, owner,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/branches/{branch}/protection
 func (s *RepositoriesService) RemoveBranchProtection(ctx context.Context, owner, repo, branch string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -1612,7 +1741,7 @@ func (s *RepositoriesService) RemoveBranchProtection(ctx context.Context, owner,
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection/required_signatures
 func (s *RepositoriesService) GetSignaturesProtectedBranch(ctx context.Context, owner, repo, branch string) (*SignaturesProtectedBranch, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_signatures", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_signatures", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1639,7 +1768,7 @@ func (s *RepositoriesService) GetSignaturesProtectedBranch(ctx context.Context,
 //
 //meta:operation POST /repos/{owner}/{repo}/branches/{branch}/protection/required_signatures
 func (s *RepositoriesService) RequireSignaturesOnProtectedBranch(ctx context.Context, owner, repo, branch string) (*SignaturesProtectedBranch, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_signatures", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_signatures", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1665,7 +1794,7 @@ func (s *RepositoriesService) RequireSignaturesOnProtectedBranch(ctx context.Con
 //
 //meta:operation DELETE /repos/{owner}/{repo}/branches/{branch}/protection/required_signatures
 func (s *RepositoriesService) OptionalSignaturesOnProtectedBranch(ctx context.Context, owner, repo, branch string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_signatures", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_signatures", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -1685,7 +1814,7 @@ func (s *RepositoriesService) OptionalSignaturesOnProtectedBranch(ctx context.Co
 //
 //meta:operation PATCH /repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks
 func (s *RepositoriesService) UpdateRequiredStatusChecks(ctx context.Context, owner, repo, branch string, sreq *RequiredStatusChecksRequest) (*RequiredStatusChecks, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("PATCH", u, sreq)
 	if err != nil {
 		return nil, nil, err
@@ -1708,7 +1837,7 @@ func (s *RepositoriesService) UpdateRequiredStatusChecks(ctx context.Context, ow
 //
 //meta:operation DELETE /repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks
 func (s *RepositoriesService) RemoveRequiredStatusChecks(ctx context.Context, owner, repo, branch string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -1723,7 +1852,7 @@ func (s *RepositoriesService) RemoveRequiredStatusChecks(ctx context.Context, ow
 //
 //meta:operation GET /repos/{owner}/{repo}/license
 func (s *RepositoriesService) License(ctx context.Context, owner, repo string) (*RepositoryLicense, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/license", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/license", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1746,7 +1875,7 @@ func (s *RepositoriesService) License(ctx context.Context, owner, repo string) (
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews
 func (s *RepositoriesService) GetPullRequestReviewEnforcement(ctx context.Context, owner, repo, branch string) (*PullRequestReviewsEnforcement, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_pull_request_reviews", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_pull_request_reviews", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1773,7 +1902,7 @@ func (s *RepositoriesService) GetPullRequestReviewEnforcement(ctx context.Contex
 //
 //meta:operation PATCH /repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews
 func (s *RepositoriesService) UpdatePullRequestReviewEnforcement(ctx context.Context, owner, repo, branch string, patch *PullRequestReviewsEnforcementUpdate) (*PullRequestReviewsEnforcement, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_pull_request_reviews", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_pull_request_reviews", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("PATCH", u, patch)
 	if err != nil {
 		return nil, nil, err
@@ -1800,7 +1929,7 @@ func (s *RepositoriesService) UpdatePullRequestReviewEnforcement(ctx context.Con
 //
 //meta:operation PATCH /repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews
 func (s *RepositoriesService) DisableDismissalRestrictions(ctx context.Context, owner, repo, branch string) (*PullRequestReviewsEnforcement, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_pull_request_reviews", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_pull_request_reviews", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 
 	data := new(struct {
 		DismissalRestrictionsRequest `json:"dismissal_restrictions"`
@@ -1831,7 +1960,7 @@ func (s *RepositoriesService) DisableDismissalRestrictions(ctx context.Context,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews
 func (s *RepositoriesService) RemovePullRequestReviewEnforcement(ctx context.Context, owner, repo, branch string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_pull_request_reviews", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_pull_request_reviews", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -1848,7 +1977,7 @@ func (s *RepositoriesService) RemovePullRequestReviewEnforcement(ctx context.Con
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins
 func (s *RepositoriesService) GetAdminEnforcement(ctx context.Context, owner, repo, branch string) (*AdminEnforcement, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/enforce_admins", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/enforce_admins", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1872,7 +2001,7 @@ func (s *RepositoriesService) GetAdminEnforcement(ctx context.Context, owner, re
 //
 //meta:operation POST /repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins
 func (s *RepositoriesService) AddAdminEnforcement(ctx context.Context, owner, repo, branch string) (*AdminEnforcement, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/enforce_admins", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/enforce_admins", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1895,7 +2024,7 @@ func (s *RepositoriesService) AddAdminEnforcement(ctx context.Context, owner, re
 //
 //meta:operation DELETE /repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins
 func (s *RepositoriesService) RemoveAdminEnforcement(ctx context.Context, owner, repo, branch string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/enforce_admins", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/enforce_admins", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -1915,7 +2044,7 @@ type repositoryTopics struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/topics
 func (s *RepositoriesService) ListAllTopics(ctx context.Context, owner, repo string) ([]string, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/topics", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/topics", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1933,13 +2062,36 @@ func (s *RepositoriesService) ListAllTopics(ctx context.Context, owner, repo str
 	return topics.Names, resp, nil
 }
 
-// ReplaceAllTopics replaces all repository topics.
+// ReplaceAllTopics replaces all repository topics. The topics are normalized
+// with NormalizeTopics and checked with ValidateTopics first, see
+// ReplaceTopics to send them as given.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#replace-all-repository-topics
 //
 //meta:operation PUT /repos/{owner}/{repo}/topics
 func (s *RepositoriesService) ReplaceAllTopics(ctx context.Context, owner, repo string, topics []string) ([]string, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/topics", owner, repo)
+	return s.ReplaceTopics(ctx, owner, repo, topics, nil)
+}
+
+// ReplaceTopics is like ReplaceAllTopics, with options to control the
+// normalization of the topics.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#replace-all-repository-topics
+//
+//meta:operation PUT /repos/{owner}/{repo}/topics
+func (s *RepositoriesService) ReplaceTopics(ctx context.Context, owner, repo string, topics []string, opts *TopicsOptions) ([]string, *Response, error) {
+	return s.replaceTopics(ctx, owner, repo, topics, opts, "")
+}
+
+// replaceTopics replaces all repository topics, only if they have not changed
+// since they had the given ETag, if not empty.
+func (s *RepositoriesService) replaceTopics(ctx context.Context, owner, repo string, topics []string, opts *TopicsOptions, etag string) ([]string, *Response, error) {
+	topics, err := prepareTopics(topics, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/topics", PathEscape(owner), PathEscape(repo))
 	t := &repositoryTopics{
 		Names: topics,
 	}
@@ -1953,6 +2105,9 @@ func (s *RepositoriesService) ReplaceAllTopics(ctx context.Context, owner, repo
 
 	// TODO: remove custom Accept header when this API fully launches.
 	req.Header.Set("Accept", mediaTypeTopicsPreview)
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
 
 	t = new(repositoryTopics)
 	resp, err := s.client.Do(ctx, req, t)
@@ -1974,7 +2129,7 @@ func (s *RepositoriesService) ReplaceAllTopics(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps
 func (s *RepositoriesService) ListApps(ctx context.Context, owner, repo, branch string) ([]*App, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/apps", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/apps", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -2013,7 +2168,7 @@ func (s *RepositoriesService) ListAppRestrictions(ctx context.Context, owner, re
 //
 //meta:operation PUT /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps
 func (s *RepositoriesService) ReplaceAppRestrictions(ctx context.Context, owner, repo, branch string, apps []string) ([]*App, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/apps", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/apps", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("PUT", u, apps)
 	if err != nil {
 		return nil, nil, err
@@ -2039,7 +2194,7 @@ func (s *RepositoriesService) ReplaceAppRestrictions(ctx context.Context, owner,
 //
 //meta:operation POST /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps
 func (s *RepositoriesService) AddAppRestrictions(ctx context.Context, owner, repo, branch string, apps []string) ([]*App, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/apps", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/apps", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("POST", u, apps)
 	if err != nil {
 		return nil, nil, err
@@ -2065,7 +2220,7 @@ func (s *RepositoriesService) AddAppRestrictions(ctx context.Context, owner, rep
 //
 //meta:operation DELETE /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps
 func (s *RepositoriesService) RemoveAppRestrictions(ctx context.Context, owner, repo, branch string, apps []string) ([]*App, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/apps", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/apps", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("DELETE", u, apps)
 	if err != nil {
 		return nil, nil, err
@@ -2089,7 +2244,7 @@ func (s *RepositoriesService) RemoveAppRestrictions(ctx context.Context, owner,
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams
 func (s *RepositoriesService) ListTeamRestrictions(ctx context.Context, owner, repo, branch string) ([]*Team, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/teams", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/teams", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -2116,7 +2271,7 @@ func (s *RepositoriesService) ListTeamRestrictions(ctx context.Context, owner, r
 //
 //meta:operation PUT /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams
 func (s *RepositoriesService) ReplaceTeamRestrictions(ctx context.Context, owner, repo, branch string, teams []string) ([]*Team, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/teams", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/teams", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("PUT", u, teams)
 	if err != nil {
 		return nil, nil, err
@@ -2142,7 +2297,7 @@ func (s *RepositoriesService) ReplaceTeamRestrictions(ctx context.Context, owner
 //
 //meta:operation POST /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams
 func (s *RepositoriesService) AddTeamRestrictions(ctx context.Context, owner, repo, branch string, teams []string) ([]*Team, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/teams", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/teams", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("POST", u, teams)
 	if err != nil {
 		return nil, nil, err
@@ -2168,7 +2323,7 @@ func (s *RepositoriesService) AddTeamRestrictions(ctx context.Context, owner, re
 //
 //meta:operation DELETE /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams
 func (s *RepositoriesService) RemoveTeamRestrictions(ctx context.Context, owner, repo, branch string, teams []string) ([]*Team, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/teams", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/teams", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("DELETE", u, teams)
 	if err != nil {
 		return nil, nil, err
@@ -2192,7 +2347,7 @@ func (s *RepositoriesService) RemoveTeamRestrictions(ctx context.Context, owner,
 //
 //meta:operation GET /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users
 func (s *RepositoriesService) ListUserRestrictions(ctx context.Context, owner, repo, branch string) ([]*User, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/users", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/users", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -2219,7 +2374,7 @@ func (s *RepositoriesService) ListUserRestrictions(ctx context.Context, owner, r
 //
 //meta:operation PUT /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users
 func (s *RepositoriesService) ReplaceUserRestrictions(ctx context.Context, owner, repo, branch string, users []string) ([]*User, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/users", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/users", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("PUT", u, users)
 	if err != nil {
 		return nil, nil, err
@@ -2245,7 +2400,7 @@ func (s *RepositoriesService) ReplaceUserRestrictions(ctx context.Context, owner
 //
 //meta:operation POST /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users
 func (s *RepositoriesService) AddUserRestrictions(ctx context.Context, owner, repo, branch string, users []string) ([]*User, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/users", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/users", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("POST", u, users)
 	if err != nil {
 		return nil, nil, err
@@ -2271,7 +2426,7 @@ func (s *RepositoriesService) AddUserRestrictions(ctx context.Context, owner, re
 //
 //meta:operation DELETE /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users
 func (s *RepositoriesService) RemoveUserRestrictions(ctx context.Context, owner, repo, branch string, users []string) ([]*User, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/users", owner, repo, url.PathEscape(branch))
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/restrictions/users", PathEscape(owner), PathEscape(repo), url.PathEscape(branch))
 	req, err := s.client.NewRequest("DELETE", u, users)
 	if err != nil {
 		return nil, nil, err
@@ -2305,7 +2460,7 @@ type TransferRequest struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/transfer
 func (s *RepositoriesService) Transfer(ctx context.Context, owner, repo string, transfer TransferRequest) (*Repository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/transfer", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/transfer", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, &transfer)
 	if err != nil {
@@ -2321,22 +2476,84 @@ func (s *RepositoriesService) Transfer(ctx context.Context, owner, repo string,
 	return r, resp, nil
 }
 
+// The limits GitHub places on a repository_dispatch event.
+const (
+	maxDispatchEventTypeLength   = 100
+	maxDispatchClientPayloadKeys = 10
+)
+
 // DispatchRequestOptions represents a request to trigger a repository_dispatch event.
 type DispatchRequestOptions struct {
-	// EventType is a custom webhook event name. (Required.)
+	// EventType is a custom webhook event name of at most 100 characters. (Required.)
 	EventType string `json:"event_type"`
 	// ClientPayload is a custom JSON payload with extra information about the webhook event.
-	// Defaults to an empty JSON object.
-	ClientPayload *json.RawMessage `json:"client_payload,omitempty"`
+	// It can be any value that marshals to a JSON object with at most 10
+	// top-level properties, such as a struct, a map or a *json.RawMessage.
+	// Defaults to an empty JSON object. A nil pointer, map or slice is treated
+	// as no payload.
+	ClientPayload interface{} `json:"client_payload,omitempty"`
+}
+
+// hasClientPayload reports whether opts has a client payload, which is not
+// the case for a nil interface or a typed nil, such as a nil pointer, that
+// would otherwise be sent as a null payload.
+func (opts *DispatchRequestOptions) hasClientPayload() bool {
+	if opts.ClientPayload == nil {
+		return false
+	}
+	switch v := reflect.ValueOf(opts.ClientPayload); v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return !v.IsNil()
+	}
+	return true
+}
+
+// validate checks opts against the documented limits of the API, so that
+// they are reported before a request is made.
+func (opts *DispatchRequestOptions) validate() error {
+	if opts.EventType == "" {
+		return errors.New("dispatch event type must not be empty")
+	}
+	if n := utf8.RuneCountInString(opts.EventType); n > maxDispatchEventTypeLength {
+		return fmt.Errorf("dispatch event type is %v characters long, at most %v are allowed", n, maxDispatchEventTypeLength)
+	}
+	if !opts.hasClientPayload() {
+		return nil
+	}
+
+	b, err := json.Marshal(opts.ClientPayload)
+	if err != nil {
+		return err
+	}
+	if string(b) == "null" {
+		return nil
+	}
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(b, &keys); err != nil {
+		return fmt.Errorf("dispatch client payload must be a JSON object: %w", err)
+	}
+	if len(keys) > maxDispatchClientPayloadKeys {
+		return fmt.Errorf("dispatch client payload has %v top-level properties, at most %v are allowed", len(keys), maxDispatchClientPayloadKeys)
+	}
+	return nil
 }
 
 // Dispatch triggers a repository_dispatch event in a GitHub Actions workflow.
+// An error is returned without making a request if opts exceeds the limits
+// documented for the event type or client payload.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#create-a-repository-dispatch-event
 //
 //meta:operation POST /repos/{owner}/{repo}/dispatches
 func (s *RepositoriesService) Dispatch(ctx context.Context, owner, repo string, opts DispatchRequestOptions) (*Repository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/dispatches", owner, repo)
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	if !opts.hasClientPayload() {
+		opts.ClientPayload = nil
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/dispatches", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, &opts)
 	if err != nil {
@@ -2352,6 +2569,66 @@ func (s *RepositoriesService) Dispatch(ctx context.Context, owner, repo string,
 	return r, resp, nil
 }
 
+// dispatchRunPollInterval is the delay between polls of
+// RepositoriesService.DispatchAndWaitForRun.
+var dispatchRunPollInterval = 5 * time.Second
+
+// DispatchAndWaitForRun triggers a repository_dispatch event, then polls the
+// workflow runs of the repository until a run triggered by a
+// repository_dispatch event created no earlier than the dispatch appears,
+// and returns it. It gives up once timeout has passed, if positive, or ctx
+// is done, returning the context error.
+//
+// Runs are matched by event and creation time only, so if several
+// dispatches to the same repository happen at about the same time, the
+// returned run may belong to another one of them.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#list-workflow-runs-for-a-repository
+// GitHub API docs: https://docs.github.com/rest/repos/repos#create-a-repository-dispatch-event
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs
+//meta:operation POST /repos/{owner}/{repo}/dispatches
+func (s *RepositoriesService) DispatchAndWaitForRun(ctx context.Context, owner, repo string, opts DispatchRequestOptions, timeout time.Duration) (*WorkflowRun, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	dispatchedAt := time.Now()
+	_, resp, err := s.Dispatch(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, err
+	}
+	// Prefer the server's clock, as the run's creation time is set by it.
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		dispatchedAt = date
+	}
+
+	listOpts := &ListWorkflowRunsOptions{
+		Event:   "repository_dispatch",
+		Created: ">=" + dispatchedAt.UTC().Format(time.RFC3339),
+	}
+	for {
+		runs, _, err := s.client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		if len(runs.WorkflowRuns) > 0 {
+			// Runs are listed newest first; the oldest is the closest match.
+			return runs.WorkflowRuns[len(runs.WorkflowRuns)-1], nil
+		}
+
+		t := time.NewTimer(dispatchRunPollInterval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
 // isBranchNotProtected determines whether a branch is not protected
 // based on the error message returned by GitHub API.
 func isBranchNotProtected(err error) bool {
@@ -2366,7 +2643,7 @@ func isBranchNotProtected(err error) bool {
 //
 //meta:operation PUT /repos/{owner}/{repo}/private-vulnerability-reporting
 func (s *RepositoriesService) EnablePrivateReporting(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -2388,7 +2665,7 @@ func (s *RepositoriesService) EnablePrivateReporting(ctx context.Context, owner,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/private-vulnerability-reporting
 func (s *RepositoriesService) DisablePrivateReporting(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -2415,7 +2692,7 @@ type checkPrivateReporting struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/private-vulnerability-reporting
 func (s *RepositoriesService) IsPrivateReportingEnabled(ctx context.Context, owner, repo string) (bool, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -27,7 +27,7 @@ type RepositoryActionsAccessLevel struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/permissions/access
 func (s *RepositoriesService) GetActionsAccessLevel(ctx context.Context, owner, repo string) (*RepositoryActionsAccessLevel, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/permissions/access", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/access", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -49,7 +49,7 @@ func (s *RepositoriesService) GetActionsAccessLevel(ctx context.Context, owner,
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/permissions/access
 func (s *RepositoriesService) EditActionsAccessLevel(ctx context.Context, owner, repo string, repositoryActionsAccessLevel RepositoryActionsAccessLevel) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/permissions/access", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/access", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PUT", u, repositoryActionsAccessLevel)
 	if err != nil {
 		return nil, err
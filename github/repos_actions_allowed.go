@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/permissions/selected-actions
 func (s *RepositoriesService) GetActionsAllowed(ctx context.Context, org, repo string) (*ActionsAllowed, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/permissions/selected-actions", org, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/selected-actions", PathEscape(org), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -37,7 +37,7 @@ func (s *RepositoriesService) GetActionsAllowed(ctx context.Context, org, repo s
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/permissions/selected-actions
 func (s *RepositoriesService) EditActionsAllowed(ctx context.Context, org, repo string, actionsAllowed ActionsAllowed) (*ActionsAllowed, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/permissions/selected-actions", org, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/selected-actions", PathEscape(org), PathEscape(repo))
 	req, err := s.client.NewRequest("PUT", u, actionsAllowed)
 	if err != nil {
 		return nil, nil, err
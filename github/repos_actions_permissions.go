@@ -37,7 +37,7 @@ type DefaultWorkflowPermissionRepository struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/permissions
 func (s *RepositoriesService) GetActionsPermissions(ctx context.Context, owner, repo string) (*ActionsPermissionsRepository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/permissions", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -59,7 +59,7 @@ func (s *RepositoriesService) GetActionsPermissions(ctx context.Context, owner,
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/permissions
 func (s *RepositoriesService) EditActionsPermissions(ctx context.Context, owner, repo string, actionsPermissionsRepository ActionsPermissionsRepository) (*ActionsPermissionsRepository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/permissions", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PUT", u, actionsPermissionsRepository)
 	if err != nil {
 		return nil, nil, err
@@ -80,7 +80,7 @@ func (s *RepositoriesService) EditActionsPermissions(ctx context.Context, owner,
 //
 //meta:operation GET /repos/{owner}/{repo}/actions/permissions/workflow
 func (s *RepositoriesService) GetDefaultWorkflowPermissions(ctx context.Context, owner, repo string) (*DefaultWorkflowPermissionRepository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/permissions/workflow", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/workflow", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -102,7 +102,7 @@ func (s *RepositoriesService) GetDefaultWorkflowPermissions(ctx context.Context,
 //
 //meta:operation PUT /repos/{owner}/{repo}/actions/permissions/workflow
 func (s *RepositoriesService) EditDefaultWorkflowPermissions(ctx context.Context, owner, repo string, permissions DefaultWorkflowPermissionRepository) (*DefaultWorkflowPermissionRepository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/actions/permissions/workflow", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/workflow", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PUT", u, permissions)
 	if err != nil {
 		return nil, nil, err
@@ -32,7 +32,7 @@ type Autolink struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/autolinks
 func (s *RepositoriesService) ListAutolinks(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Autolink, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/autolinks", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/autolinks", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -59,7 +59,7 @@ func (s *RepositoriesService) ListAutolinks(ctx context.Context, owner, repo str
 //
 //meta:operation POST /repos/{owner}/{repo}/autolinks
 func (s *RepositoriesService) AddAutolink(ctx context.Context, owner, repo string, opts *AutolinkOptions) (*Autolink, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/autolinks", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/autolinks", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -80,7 +80,7 @@ func (s *RepositoriesService) AddAutolink(ctx context.Context, owner, repo strin
 //
 //meta:operation GET /repos/{owner}/{repo}/autolinks/{autolink_id}
 func (s *RepositoriesService) GetAutolink(ctx context.Context, owner, repo string, id int64) (*Autolink, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/autolinks/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/autolinks/%v", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -103,7 +103,7 @@ func (s *RepositoriesService) GetAutolink(ctx context.Context, owner, repo strin
 //
 //meta:operation DELETE /repos/{owner}/{repo}/autolinks/{autolink_id}
 func (s *RepositoriesService) DeleteAutolink(ctx context.Context, owner, repo string, id int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/autolinks/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/autolinks/%v", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
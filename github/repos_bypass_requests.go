@@ -0,0 +1,139 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// The possible values of BypassRequest.Status.
+const (
+	// BypassRequestStatusOpen is the status of a request pending review.
+	BypassRequestStatusOpen      = "open"
+	BypassRequestStatusApproved  = "approved"
+	BypassRequestStatusDenied    = "denied"
+	BypassRequestStatusExpired   = "expired"
+	BypassRequestStatusCancelled = "cancelled"
+	BypassRequestStatusCompleted = "completed"
+)
+
+// BypassRequest represents a request to bypass a push ruleset or a secret
+// scanning push protection, made by a user who is not allowed to bypass it
+// directly.
+type BypassRequest struct {
+	ID           *int64              `json:"id,omitempty"`
+	Number       *int64              `json:"number,omitempty"`
+	Repository   *Repository         `json:"repository,omitempty"`
+	Organization *Organization       `json:"organization,omitempty"`
+	Requester    *BypassRequestActor `json:"requester,omitempty"`
+	// RequestType is "push_ruleset_bypass" for push rule bypass requests.
+	RequestType *string              `json:"request_type,omitempty"`
+	Data        []*BypassRequestData `json:"data,omitempty"`
+	// ResourceIdentifier is the identifier of the pushed commit.
+	ResourceIdentifier *string                `json:"resource_identifier,omitempty"`
+	Status             *string                `json:"status,omitempty"`
+	RequesterComment   *string                `json:"requester_comment,omitempty"`
+	ExpiresAt          *Timestamp             `json:"expires_at,omitempty"`
+	CreatedAt          *Timestamp             `json:"created_at,omitempty"`
+	Responses          []*BypassRequestReview `json:"responses,omitempty"`
+	URL                *string                `json:"url,omitempty"`
+	HTMLURL            *string                `json:"html_url,omitempty"`
+}
+
+// BypassRequestActor represents the requester or a reviewer of a bypass
+// request.
+type BypassRequestActor struct {
+	ActorID   *int64  `json:"actor_id,omitempty"`
+	ActorName *string `json:"actor_name,omitempty"`
+}
+
+// BypassRequestData describes what a bypass request asks to bypass. Push rule
+// bypass requests set the ruleset fields, and secret scanning bypass requests
+// set the secret fields.
+type BypassRequestData struct {
+	RulesetID       *int64  `json:"ruleset_id,omitempty"`
+	RulesetName     *string `json:"ruleset_name,omitempty"`
+	TotalViolations *int    `json:"total_violations,omitempty"`
+	RuleType        *string `json:"rule_type,omitempty"`
+	SecretType      *string `json:"secret_type,omitempty"`
+	BypassReason    *string `json:"bypass_reason,omitempty"`
+}
+
+// BypassRequestReview represents a review of a bypass request.
+type BypassRequestReview struct {
+	ID        *int64              `json:"id,omitempty"`
+	Reviewer  *BypassRequestActor `json:"reviewer,omitempty"`
+	Status    *string             `json:"status,omitempty"`
+	CreatedAt *Timestamp          `json:"created_at,omitempty"`
+}
+
+// BypassRequestListOptions specifies the optional parameters to the methods
+// listing bypass requests.
+type BypassRequestListOptions struct {
+	// RepositoryName filters the requests of an organization by repository.
+	RepositoryName string `url:"repository_name,omitempty"`
+	// Reviewer filters by the login of the user who reviewed the request.
+	Reviewer string `url:"reviewer,omitempty"`
+	// Requester filters by the login of the user who made the request.
+	Requester string `url:"requester,omitempty"`
+	// TimePeriod filters by creation time. Can be one of: hour, day, week,
+	// month. GitHub defaults to day.
+	TimePeriod string `url:"time_period,omitempty"`
+	// RequestStatus filters by status, one of the BypassRequestStatus
+	// constants or "all". GitHub defaults to all.
+	RequestStatus string `url:"request_status,omitempty"`
+
+	ListOptions
+}
+
+// ListPushRuleBypassRequests lists the push rule bypass requests of a repository.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/repos/bypass-requests#list-repository-push-rule-bypass-requests
+//
+//meta:operation GET /repos/{owner}/{repo}/bypass-requests/push-rules
+func (s *RepositoriesService) ListPushRuleBypassRequests(ctx context.Context, owner, repo string, opts *BypassRequestListOptions) ([]*BypassRequest, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/push-rules", PathEscape(owner), PathEscape(repo))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*BypassRequest
+	resp, err := s.client.Do(ctx, req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
+
+// GetPushRuleBypassRequest gets a push rule bypass request of a repository by its number.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/repos/bypass-requests#get-a-repository-push-bypass-request
+//
+//meta:operation GET /repos/{owner}/{repo}/bypass-requests/push-rules/{bypass_request_number}
+func (s *RepositoriesService) GetPushRuleBypassRequest(ctx context.Context, owner, repo string, number int64) (*BypassRequest, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/push-rules/%v", PathEscape(owner), PathEscape(repo), number)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var request *BypassRequest
+	resp, err := s.client.Do(ctx, req, &request)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return request, resp, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_ListPushRuleBypassRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/push-rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"requester":      "octocat",
+			"request_status": "open",
+			"time_period":    "week",
+			"page":           "2",
+		})
+		fmt.Fprint(w, `[{
+			"id": 21,
+			"number": 42,
+			"repository": {"id": 1, "name": "r", "full_name": "o/r"},
+			"organization": {"id": 2, "name": "o"},
+			"requester": {"actor_id": 12, "actor_name": "octocat"},
+			"request_type": "push_ruleset_bypass",
+			"data": [{"ruleset_id": 410, "ruleset_name": "main", "total_violations": 3, "rule_type": "pull_request"}],
+			"resource_identifier": "827efc6d56897b048c772eb4087f854f46256132",
+			"status": "open",
+			"requester_comment": "Updating the release notes",
+			"expires_at": "2006-01-02T15:04:05Z",
+			"created_at": "2006-01-02T15:04:05Z",
+			"responses": [{"id": 42, "reviewer": {"actor_id": 4, "actor_name": "hubot"}, "status": "denied", "created_at": "2006-01-02T15:04:05Z"}],
+			"url": "https://api.github.com/repos/o/r/bypass-requests/push-rules/42",
+			"html_url": "https://github.com/o/r/exemptions/42"
+		}]`)
+	})
+
+	opts := &BypassRequestListOptions{
+		Requester:     "octocat",
+		RequestStatus: BypassRequestStatusOpen,
+		TimePeriod:    "week",
+		ListOptions:   ListOptions{Page: 2},
+	}
+	ctx := context.Background()
+	requests, _, err := client.Repositories.ListPushRuleBypassRequests(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.ListPushRuleBypassRequests returned error: %v", err)
+	}
+
+	want := []*BypassRequest{{
+		ID:           Int64(21),
+		Number:       Int64(42),
+		Repository:   &Repository{ID: Int64(1), Name: String("r"), FullName: String("o/r")},
+		Organization: &Organization{ID: Int64(2), Name: String("o")},
+		Requester:    &BypassRequestActor{ActorID: Int64(12), ActorName: String("octocat")},
+		RequestType:  String("push_ruleset_bypass"),
+		Data: []*BypassRequestData{{
+			RulesetID:       Int64(410),
+			RulesetName:     String("main"),
+			TotalViolations: Int(3),
+			RuleType:        String("pull_request"),
+		}},
+		ResourceIdentifier: String("827efc6d56897b048c772eb4087f854f46256132"),
+		Status:             String(BypassRequestStatusOpen),
+		RequesterComment:   String("Updating the release notes"),
+		ExpiresAt:          &Timestamp{referenceTime},
+		CreatedAt:          &Timestamp{referenceTime},
+		Responses: []*BypassRequestReview{{
+			ID:        Int64(42),
+			Reviewer:  &BypassRequestActor{ActorID: Int64(4), ActorName: String("hubot")},
+			Status:    String(BypassRequestStatusDenied),
+			CreatedAt: &Timestamp{referenceTime},
+		}},
+		URL:     String("https://api.github.com/repos/o/r/bypass-requests/push-rules/42"),
+		HTMLURL: String("https://github.com/o/r/exemptions/42"),
+	}}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("Repositories.ListPushRuleBypassRequests returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListPushRuleBypassRequests"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListPushRuleBypassRequests(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListPushRuleBypassRequests(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetPushRuleBypassRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/push-rules/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number": 42, "status": "expired"}`)
+	})
+
+	ctx := context.Background()
+	request, _, err := client.Repositories.GetPushRuleBypassRequest(ctx, "o", "r", 42)
+	if err != nil {
+		t.Errorf("Repositories.GetPushRuleBypassRequest returned error: %v", err)
+	}
+
+	want := &BypassRequest{Number: Int64(42), Status: String(BypassRequestStatusExpired)}
+	if !cmp.Equal(request, want) {
+		t.Errorf("Repositories.GetPushRuleBypassRequest returned %+v, want %+v", request, want)
+	}
+
+	const methodName = "GetPushRuleBypassRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetPushRuleBypassRequest(ctx, "\n", "\n", 42)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetPushRuleBypassRequest(ctx, "o", "r", 42)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -40,7 +40,7 @@ type CodeownersError struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/codeowners/errors
 func (s *RepositoriesService) GetCodeownersErrors(ctx context.Context, owner, repo string, opts *GetCodeownersErrorsOptions) (*CodeownersErrors, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/codeowners/errors", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/codeowners/errors", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -52,7 +52,7 @@ type CollaboratorInvitation struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/collaborators
 func (s *RepositoriesService) ListCollaborators(ctx context.Context, owner, repo string, opts *ListCollaboratorsOptions) ([]*User, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/collaborators", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/collaborators", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -81,7 +81,7 @@ func (s *RepositoriesService) ListCollaborators(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/collaborators/{username}
 func (s *RepositoriesService) IsCollaborator(ctx context.Context, owner, repo, user string) (bool, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/collaborators/%v", owner, repo, user)
+	u := fmt.Sprintf("repos/%v/%v/collaborators/%v", PathEscape(owner), PathEscape(repo), PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return false, nil, err
@@ -98,16 +98,54 @@ type RepositoryPermissionLevel struct {
 	// Possible values: "admin", "write", "read", "none"
 	Permission *string `json:"permission,omitempty"`
 
+	// RoleName is the name of the user's role, which is one of "admin",
+	// "maintain", "write", "triage" and "read", or the name of a custom role.
+	RoleName *string `json:"role_name,omitempty"`
+
+	// User.Permissions holds the "pull", "triage", "push", "maintain" and
+	// "admin" permissions of the user.
 	User *User `json:"user,omitempty"`
 }
 
+// repositoryPermissionRanks orders the repository permissions, by both their
+// permission and role names.
+var repositoryPermissionRanks = map[string]int{
+	"pull":     1,
+	"read":     1,
+	"triage":   2,
+	"push":     3,
+	"write":    3,
+	"maintain": 4,
+	"admin":    5,
+}
+
+// rank returns the rank of the highest permission granted by p, or 0 if it
+// grants none. Custom roles are ranked by the permissions they include.
+func (p *RepositoryPermissionLevel) rank() int {
+	if rank, ok := repositoryPermissionRanks[p.GetRoleName()]; ok {
+		return rank
+	}
+
+	if perms := p.GetUser().GetPermissions(); len(perms) > 0 {
+		rank := 0
+		for name, granted := range perms {
+			if r := repositoryPermissionRanks[name]; granted && r > rank {
+				rank = r
+			}
+		}
+		return rank
+	}
+
+	return repositoryPermissionRanks[p.GetPermission()]
+}
+
 // GetPermissionLevel retrieves the specific permission level a collaborator has for a given repository.
 //
 // GitHub API docs: https://docs.github.com/rest/collaborators/collaborators#get-repository-permissions-for-a-user
 //
 //meta:operation GET /repos/{owner}/{repo}/collaborators/{username}/permission
 func (s *RepositoriesService) GetPermissionLevel(ctx context.Context, owner, repo, user string) (*RepositoryPermissionLevel, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/collaborators/%v/permission", owner, repo, user)
+	u := fmt.Sprintf("repos/%v/%v/collaborators/%v/permission", PathEscape(owner), PathEscape(repo), PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -137,6 +175,28 @@ type RepositoryAddCollaboratorOptions struct {
 	Permission string `json:"permission,omitempty"`
 }
 
+// HasPermission reports whether user has at least the permission atLeast on
+// a repository, which is one of "pull" (or "read"), "triage", "push" (or
+// "write"), "maintain" and "admin". A custom role is considered to grant the
+// highest of the standard permissions it includes.
+//
+// GitHub API docs: https://docs.github.com/rest/collaborators/collaborators#get-repository-permissions-for-a-user
+//
+//meta:operation GET /repos/{owner}/{repo}/collaborators/{username}/permission
+func (s *RepositoriesService) HasPermission(ctx context.Context, owner, repo, user, atLeast string) (bool, *Response, error) {
+	want, ok := repositoryPermissionRanks[atLeast]
+	if !ok {
+		return false, nil, fmt.Errorf("unknown repository permission %q", atLeast)
+	}
+
+	rpl, resp, err := s.GetPermissionLevel(ctx, owner, repo, user)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return rpl.rank() >= want, resp, nil
+}
+
 // AddCollaborator sends an invitation to the specified GitHub user
 // to become a collaborator to the given repo.
 //
@@ -144,7 +204,7 @@ type RepositoryAddCollaboratorOptions struct {
 //
 //meta:operation PUT /repos/{owner}/{repo}/collaborators/{username}
 func (s *RepositoriesService) AddCollaborator(ctx context.Context, owner, repo, user string, opts *RepositoryAddCollaboratorOptions) (*CollaboratorInvitation, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/collaborators/%v", owner, repo, user)
+	u := fmt.Sprintf("repos/%v/%v/collaborators/%v", PathEscape(owner), PathEscape(repo), PathEscape(user))
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -166,7 +226,7 @@ func (s *RepositoriesService) AddCollaborator(ctx context.Context, owner, repo,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/collaborators/{username}
 func (s *RepositoriesService) RemoveCollaborator(ctx context.Context, owner, repo, user string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/collaborators/%v", owner, repo, user)
+	u := fmt.Sprintf("repos/%v/%v/collaborators/%v", PathEscape(owner), PathEscape(repo), PathEscape(user))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
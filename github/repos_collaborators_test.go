@@ -141,6 +141,8 @@ func TestRepositoriesService_ListCollaborators_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListCollaborators(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListCollaborators(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_IsCollaborator_True(t *testing.T) {
@@ -218,6 +220,8 @@ func TestRepositoriesService_IsCollaborator_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.IsCollaborator(ctx, "%", "%", "%")
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.IsCollaborator(ctx, "..", "..", "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoryService_GetPermissionLevel(t *testing.T) {
@@ -261,6 +265,118 @@ func TestRepositoryService_GetPermissionLevel(t *testing.T) {
 	})
 }
 
+func TestRepositoryService_GetPermissionLevel_customRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/collaborators/u/permission", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"permission": "write",
+			"role_name": "security-triager",
+			"user": {
+				"login": "u",
+				"permissions": {"admin": false, "maintain": false, "push": true, "triage": true, "pull": true},
+				"role_name": "security-triager"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	rpl, _, err := client.Repositories.GetPermissionLevel(ctx, "o", "r", "u")
+	if err != nil {
+		t.Errorf("Repositories.GetPermissionLevel returned error: %v", err)
+	}
+
+	want := &RepositoryPermissionLevel{
+		Permission: String("write"),
+		RoleName:   String("security-triager"),
+		User: &User{
+			Login:       String("u"),
+			Permissions: map[string]bool{"admin": false, "maintain": false, "push": true, "triage": true, "pull": true},
+			RoleName:    String("security-triager"),
+		},
+	}
+	if !cmp.Equal(rpl, want) {
+		t.Errorf("Repositories.GetPermissionLevel returned %+v, want %+v", rpl, want)
+	}
+}
+
+func TestRepositoriesService_HasPermission(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		want map[string]bool
+	}{
+		"standard role": {
+			body: `{"permission":"write","role_name":"maintain","user":{"login":"u"}}`,
+			want: map[string]bool{"pull": true, "read": true, "triage": true, "push": true, "write": true, "maintain": true, "admin": false},
+		},
+		"custom role": {
+			body: `{"permission":"read","role_name":"triage-plus","user":{"login":"u","permissions":{"admin":false,"maintain":false,"push":false,"triage":true,"pull":true}}}`,
+			want: map[string]bool{"pull": true, "triage": true, "push": false, "maintain": false, "admin": false},
+		},
+		"legacy permission only": {
+			body: `{"permission":"admin","user":{"login":"u"}}`,
+			want: map[string]bool{"pull": true, "maintain": true, "admin": true},
+		},
+		"no access": {
+			body: `{"permission":"none","user":{"login":"u"}}`,
+			want: map[string]bool{"pull": false},
+		},
+		"custom role without user": {
+			body: `{"permission":"write","role_name":"custom"}`,
+			want: map[string]bool{"push": true, "maintain": false},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/collaborators/u/permission", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprint(w, tc.body)
+			})
+
+			ctx := context.Background()
+			for atLeast, want := range tc.want {
+				got, _, err := client.Repositories.HasPermission(ctx, "o", "r", "u", atLeast)
+				if err != nil {
+					t.Errorf("Repositories.HasPermission(%q) returned error: %v", atLeast, err)
+				}
+				if got != want {
+					t.Errorf("Repositories.HasPermission(%q) = %v, want %v", atLeast, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_HasPermission_unknownPermission(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.HasPermission(ctx, "o", "r", "u", "owner"); err == nil {
+		t.Error("Repositories.HasPermission returned nil error, want error")
+	}
+}
+
+func TestRepositoriesService_HasPermission_error(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	const methodName = "HasPermission"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.HasPermission(context.Background(), "o", "r", "u", "push")
+		if got {
+			t.Errorf("testNewRequestAndDoFailure %v = %v, want false", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestRepositoriesService_AddCollaborator(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -325,6 +441,8 @@ func TestRepositoriesService_AddCollaborator_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.AddCollaborator(ctx, "%", "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.AddCollaborator(ctx, "..", "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_RemoveCollaborator(t *testing.T) {
@@ -360,6 +478,8 @@ func TestRepositoriesService_RemoveCollaborator_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Repositories.RemoveCollaborator(ctx, "%", "%", "%")
 	testURLParseError(t, err)
+	_, err = client.Repositories.RemoveCollaborator(ctx, "..", "..", "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoryAddCollaboratorOptions_Marshal(t *testing.T) {
@@ -381,6 +501,7 @@ func TestRepositoryPermissionLevel_Marshal(t *testing.T) {
 
 	r := &RepositoryPermissionLevel{
 		Permission: String("permission"),
+		RoleName:   String("r"),
 		User: &User{
 			Login:           String("l"),
 			ID:              Int64(1),
@@ -405,6 +526,7 @@ func TestRepositoryPermissionLevel_Marshal(t *testing.T) {
 
 	want := `{
 		"permission": "permission",
+		"role_name": "r",
 		"user": {
 			"login": "l",
 			"id": 1,
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
 )
 
 // RepositoryComment represents a comment for a commit, file, or line in a repository.
@@ -24,6 +25,10 @@ type RepositoryComment struct {
 
 	// User-mutable fields
 	Body *string `json:"body"`
+	// BodyText and BodyHTML are populated, along with or instead of Body,
+	// according to the BodyFormat requested.
+	BodyText *string `json:"body_text,omitempty"`
+	BodyHTML *string `json:"body_html,omitempty"`
 	// User-initialized fields
 	Path     *string `json:"path,omitempty"`
 	Position *int    `json:"position,omitempty"`
@@ -33,13 +38,80 @@ func (r RepositoryComment) String() string {
 	return Stringify(r)
 }
 
+// BodyFormat selects the representations of a comment body returned by the
+// API.
+type BodyFormat string
+
+// BodyFormat values.
+const (
+	// BodyFormatRaw returns the Markdown source of the body in Body. It's the
+	// default.
+	BodyFormatRaw BodyFormat = "raw"
+	// BodyFormatText returns a plain text representation of the body in BodyText.
+	BodyFormatText BodyFormat = "text"
+	// BodyFormatHTML returns the body rendered as HTML in BodyHTML.
+	BodyFormatHTML BodyFormat = "html"
+	// BodyFormatFull returns all of Body, BodyText and BodyHTML.
+	BodyFormatFull BodyFormat = "full"
+)
+
+// CommitCommentOptions specifies the optional parameters to the
+// RepositoriesService.CreateCommentWithOptions, GetCommentWithOptions and
+// UpdateCommentWithOptions methods.
+type CommitCommentOptions struct {
+	// BodyFormat selects the representations of the comment body returned.
+	BodyFormat BodyFormat
+}
+
+// CommitCommentListOptions specifies the optional parameters to the
+// RepositoriesService.ListCommentsWithOptions and
+// ListCommitCommentsWithOptions methods.
+type CommitCommentListOptions struct {
+	// BodyFormat selects the representations of the comment bodies returned.
+	BodyFormat BodyFormat `url:"-"`
+
+	ListOptions
+}
+
+// commitCommentListOptions returns the CommitCommentListOptions with the
+// pagination of opts.
+func commitCommentListOptions(opts *ListOptions) *CommitCommentListOptions {
+	if opts == nil {
+		return nil
+	}
+	return &CommitCommentListOptions{ListOptions: *opts}
+}
+
+// setCommitCommentAccept sets the Accept header of req to the commit comment
+// media type for format. Without a format, the reactions preview media type is
+// kept for compatibility.
+func setCommitCommentAccept(req *http.Request, format BodyFormat) {
+	if format == "" {
+		// TODO: remove custom Accept header when this API fully launches.
+		req.Header.Set("Accept", mediaTypeReactionsPreview)
+		return
+	}
+	req.Header.Set("Accept", fmt.Sprintf("application/vnd.github-commitcomment.%v+json", format))
+}
+
 // ListComments lists all the comments for the repository.
 //
 // GitHub API docs: https://docs.github.com/rest/commits/comments#list-commit-comments-for-a-repository
 //
 //meta:operation GET /repos/{owner}/{repo}/comments
 func (s *RepositoriesService) ListComments(ctx context.Context, owner, repo string, opts *ListOptions) ([]*RepositoryComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/comments", owner, repo)
+	return s.ListCommentsWithOptions(ctx, owner, repo, commitCommentListOptions(opts))
+}
+
+// ListCommentsWithOptions lists the comments for the repository, as
+// ListComments does, with the representations of the comment bodies selected
+// by opts.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/comments#list-commit-comments-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/comments
+func (s *RepositoriesService) ListCommentsWithOptions(ctx context.Context, owner, repo string, opts *CommitCommentListOptions) ([]*RepositoryComment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/comments", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -50,8 +122,11 @@ func (s *RepositoriesService) ListComments(ctx context.Context, owner, repo stri
 		return nil, nil, err
 	}
 
-	// TODO: remove custom Accept header when this API fully launches.
-	req.Header.Set("Accept", mediaTypeReactionsPreview)
+	var format BodyFormat
+	if opts != nil {
+		format = opts.BodyFormat
+	}
+	setCommitCommentAccept(req, format)
 
 	var comments []*RepositoryComment
 	resp, err := s.client.Do(ctx, req, &comments)
@@ -68,7 +143,18 @@ func (s *RepositoriesService) ListComments(ctx context.Context, owner, repo stri
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{commit_sha}/comments
 func (s *RepositoriesService) ListCommitComments(ctx context.Context, owner, repo, sha string, opts *ListOptions) ([]*RepositoryComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v/comments", owner, repo, sha)
+	return s.ListCommitCommentsWithOptions(ctx, owner, repo, sha, commitCommentListOptions(opts))
+}
+
+// ListCommitCommentsWithOptions lists the comments for a given commit SHA, as
+// ListCommitComments does, with the representations of the comment bodies
+// selected by opts.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/comments#list-commit-comments
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{commit_sha}/comments
+func (s *RepositoriesService) ListCommitCommentsWithOptions(ctx context.Context, owner, repo, sha string, opts *CommitCommentListOptions) ([]*RepositoryComment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/commits/%v/comments", PathEscape(owner), PathEscape(repo), sha)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -79,8 +165,11 @@ func (s *RepositoriesService) ListCommitComments(ctx context.Context, owner, rep
 		return nil, nil, err
 	}
 
-	// TODO: remove custom Accept header when this API fully launches.
-	req.Header.Set("Accept", mediaTypeReactionsPreview)
+	var format BodyFormat
+	if opts != nil {
+		format = opts.BodyFormat
+	}
+	setCommitCommentAccept(req, format)
 
 	var comments []*RepositoryComment
 	resp, err := s.client.Do(ctx, req, &comments)
@@ -98,11 +187,25 @@ func (s *RepositoriesService) ListCommitComments(ctx context.Context, owner, rep
 //
 //meta:operation POST /repos/{owner}/{repo}/commits/{commit_sha}/comments
 func (s *RepositoriesService) CreateComment(ctx context.Context, owner, repo, sha string, comment *RepositoryComment) (*RepositoryComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v/comments", owner, repo, sha)
+	return s.CreateCommentWithOptions(ctx, owner, repo, sha, comment, nil)
+}
+
+// CreateCommentWithOptions creates a comment for the given commit, as
+// CreateComment does, with the representations of the comment body selected by
+// opts.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/comments#create-a-commit-comment
+//
+//meta:operation POST /repos/{owner}/{repo}/commits/{commit_sha}/comments
+func (s *RepositoriesService) CreateCommentWithOptions(ctx context.Context, owner, repo, sha string, comment *RepositoryComment, opts *CommitCommentOptions) (*RepositoryComment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/commits/%v/comments", PathEscape(owner), PathEscape(repo), sha)
 	req, err := s.client.NewRequest("POST", u, comment)
 	if err != nil {
 		return nil, nil, err
 	}
+	if opts != nil && opts.BodyFormat != "" {
+		setCommitCommentAccept(req, opts.BodyFormat)
+	}
 
 	c := new(RepositoryComment)
 	resp, err := s.client.Do(ctx, req, c)
@@ -119,14 +222,27 @@ func (s *RepositoriesService) CreateComment(ctx context.Context, owner, repo, sh
 //
 //meta:operation GET /repos/{owner}/{repo}/comments/{comment_id}
 func (s *RepositoriesService) GetComment(ctx context.Context, owner, repo string, id int64) (*RepositoryComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/comments/%v", owner, repo, id)
+	return s.GetCommentWithOptions(ctx, owner, repo, id, nil)
+}
+
+// GetCommentWithOptions gets a single comment from a repository, as GetComment
+// does, with the representations of the comment body selected by opts.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/comments#get-a-commit-comment
+//
+//meta:operation GET /repos/{owner}/{repo}/comments/{comment_id}
+func (s *RepositoriesService) GetCommentWithOptions(ctx context.Context, owner, repo string, id int64, opts *CommitCommentOptions) (*RepositoryComment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/comments/%v", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// TODO: remove custom Accept header when this API fully launches.
-	req.Header.Set("Accept", mediaTypeReactionsPreview)
+	var format BodyFormat
+	if opts != nil {
+		format = opts.BodyFormat
+	}
+	setCommitCommentAccept(req, format)
 
 	c := new(RepositoryComment)
 	resp, err := s.client.Do(ctx, req, c)
@@ -143,11 +259,25 @@ func (s *RepositoriesService) GetComment(ctx context.Context, owner, repo string
 //
 //meta:operation PATCH /repos/{owner}/{repo}/comments/{comment_id}
 func (s *RepositoriesService) UpdateComment(ctx context.Context, owner, repo string, id int64, comment *RepositoryComment) (*RepositoryComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/comments/%v", owner, repo, id)
+	return s.UpdateCommentWithOptions(ctx, owner, repo, id, comment, nil)
+}
+
+// UpdateCommentWithOptions updates the body of a single comment, as
+// UpdateComment does, with the representations of the comment body selected by
+// opts.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/comments#update-a-commit-comment
+//
+//meta:operation PATCH /repos/{owner}/{repo}/comments/{comment_id}
+func (s *RepositoriesService) UpdateCommentWithOptions(ctx context.Context, owner, repo string, id int64, comment *RepositoryComment, opts *CommitCommentOptions) (*RepositoryComment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/comments/%v", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("PATCH", u, comment)
 	if err != nil {
 		return nil, nil, err
 	}
+	if opts != nil && opts.BodyFormat != "" {
+		setCommitCommentAccept(req, opts.BodyFormat)
+	}
 
 	c := new(RepositoryComment)
 	resp, err := s.client.Do(ctx, req, c)
@@ -164,7 +294,7 @@ func (s *RepositoriesService) UpdateComment(ctx context.Context, owner, repo str
 //
 //meta:operation DELETE /repos/{owner}/{repo}/comments/{comment_id}
 func (s *RepositoriesService) DeleteComment(ctx context.Context, owner, repo string, id int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/comments/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/comments/%v", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
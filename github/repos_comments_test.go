@@ -60,6 +60,8 @@ func TestRepositoriesService_ListComments_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListComments(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListComments(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_ListCommitComments(t *testing.T) {
@@ -205,6 +207,8 @@ func TestRepositoriesService_GetComment_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.GetComment(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.GetComment(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_UpdateComment(t *testing.T) {
@@ -258,6 +262,8 @@ func TestRepositoriesService_UpdateComment_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.UpdateComment(ctx, "%", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.UpdateComment(ctx, "..", "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_DeleteComment(t *testing.T) {
@@ -292,6 +298,102 @@ func TestRepositoriesService_DeleteComment_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Repositories.DeleteComment(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Repositories.DeleteComment(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
+}
+
+func TestRepositoriesService_CommitComments_bodyFormat(t *testing.T) {
+	formats := map[BodyFormat]string{
+		BodyFormatRaw:  "application/vnd.github-commitcomment.raw+json",
+		BodyFormatText: "application/vnd.github-commitcomment.text+json",
+		BodyFormatHTML: "application/vnd.github-commitcomment.html+json",
+		BodyFormatFull: "application/vnd.github-commitcomment.full+json",
+	}
+
+	for format, accept := range formats {
+		t.Run(string(format), func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/comments", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testHeader(t, r, "Accept", accept)
+				testFormValues(t, r, values{"page": "2"})
+				fmt.Fprint(w, `[{"id":1}]`)
+			})
+			mux.HandleFunc("/repos/o/r/commits/s/comments", func(w http.ResponseWriter, r *http.Request) {
+				testHeader(t, r, "Accept", accept)
+				if r.Method == "POST" {
+					fmt.Fprint(w, `{"id":1}`)
+					return
+				}
+				fmt.Fprint(w, `[{"id":1}]`)
+			})
+			mux.HandleFunc("/repos/o/r/comments/1", func(w http.ResponseWriter, r *http.Request) {
+				testHeader(t, r, "Accept", accept)
+				fmt.Fprint(w, `{"id":1}`)
+			})
+
+			ctx := context.Background()
+			listOpts := &CommitCommentListOptions{BodyFormat: format, ListOptions: ListOptions{Page: 2}}
+			if _, _, err := client.Repositories.ListCommentsWithOptions(ctx, "o", "r", listOpts); err != nil {
+				t.Errorf("Repositories.ListCommentsWithOptions returned error: %v", err)
+			}
+			listOpts.Page = 0
+			if _, _, err := client.Repositories.ListCommitCommentsWithOptions(ctx, "o", "r", "s", listOpts); err != nil {
+				t.Errorf("Repositories.ListCommitCommentsWithOptions returned error: %v", err)
+			}
+
+			opts := &CommitCommentOptions{BodyFormat: format}
+			if _, _, err := client.Repositories.GetCommentWithOptions(ctx, "o", "r", 1, opts); err != nil {
+				t.Errorf("Repositories.GetCommentWithOptions returned error: %v", err)
+			}
+			if _, _, err := client.Repositories.CreateCommentWithOptions(ctx, "o", "r", "s", &RepositoryComment{Body: String("b")}, opts); err != nil {
+				t.Errorf("Repositories.CreateCommentWithOptions returned error: %v", err)
+			}
+			if _, _, err := client.Repositories.UpdateCommentWithOptions(ctx, "o", "r", 1, &RepositoryComment{Body: String("b")}, opts); err != nil {
+				t.Errorf("Repositories.UpdateCommentWithOptions returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_GetComment_bodyFull(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/comments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github-commitcomment.full+json")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"body": "Looks **good**",
+			"body_text": "Looks good",
+			"body_html": "<p>Looks <strong>good</strong></p>",
+			"path": "main.go",
+			"position": 4,
+			"reactions": {"total_count": 1, "+1": 1}
+		}`)
+	})
+
+	ctx := context.Background()
+	comment, _, err := client.Repositories.GetCommentWithOptions(ctx, "o", "r", 1, &CommitCommentOptions{BodyFormat: BodyFormatFull})
+	if err != nil {
+		t.Errorf("Repositories.GetCommentWithOptions returned error: %v", err)
+	}
+
+	want := &RepositoryComment{
+		ID:        Int64(1),
+		Body:      String("Looks **good**"),
+		BodyText:  String("Looks good"),
+		BodyHTML:  String("<p>Looks <strong>good</strong></p>"),
+		Path:      String("main.go"),
+		Position:  Int(4),
+		Reactions: &Reactions{TotalCount: Int(1), PlusOne: Int(1)},
+	}
+	if !cmp.Equal(comment, want) {
+		t.Errorf("Repositories.GetCommentWithOptions returned %+v, want %+v", comment, want)
+	}
 }
 
 func TestRepositoryComment_Marshal(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"time"
 )
@@ -103,13 +104,20 @@ type CommitsListOptions struct {
 	// Path that should be touched by the returned Commits.
 	Path string `url:"path,omitempty"`
 
-	// Author of by which to filter Commits.
+	// Author by which to filter Commits, as a GitHub login or an email address.
 	Author string `url:"author,omitempty"`
 
-	// Since when should Commits be included in the response.
+	// Committer by which to filter Commits, as a GitHub login or an email address.
+	Committer string `url:"committer,omitempty"`
+
+	// Since when should Commits be included in the response. It is sent as
+	// an ISO 8601 timestamp, so sub-second precision is dropped. Only commits
+	// whose committer date is at or after this time are returned.
 	Since time.Time `url:"since,omitempty"`
 
-	// Until when should Commits be included in the response.
+	// Until when should Commits be included in the response. It is sent as
+	// an ISO 8601 timestamp, so sub-second precision is dropped. Only commits
+	// whose committer date is at or before this time are returned.
 	Until time.Time `url:"until,omitempty"`
 
 	ListOptions
@@ -128,7 +136,7 @@ type BranchCommit struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/commits
 func (s *RepositoriesService) ListCommits(ctx context.Context, owner, repo string, opts *CommitsListOptions) ([]*RepositoryCommit, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/commits", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -154,7 +162,7 @@ func (s *RepositoriesService) ListCommits(ctx context.Context, owner, repo strin
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}
 func (s *RepositoriesService) GetCommit(ctx context.Context, owner, repo, sha string, opts *ListOptions) (*RepositoryCommit, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v", owner, repo, sha)
+	u := fmt.Sprintf("repos/%v/%v/commits/%v", PathEscape(owner), PathEscape(repo), sha)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -174,29 +182,16 @@ func (s *RepositoriesService) GetCommit(ctx context.Context, owner, repo, sha st
 	return commit, resp, nil
 }
 
-// GetCommitRaw fetches the specified commit in raw (diff or patch) format.
+// GetCommitRaw fetches the specified commit in raw (diff or patch) format. If
+// the diff is too large for GitHub to generate, the error returned wraps
+// ErrDiffTooLarge.
 //
 // GitHub API docs: https://docs.github.com/rest/commits/commits#get-a-commit
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}
 func (s *RepositoriesService) GetCommitRaw(ctx context.Context, owner string, repo string, sha string, opts RawOptions) (string, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v", owner, repo, sha)
-	req, err := s.client.NewRequest("GET", u, nil)
-	if err != nil {
-		return "", nil, err
-	}
-
-	switch opts.Type {
-	case Diff:
-		req.Header.Set("Accept", mediaTypeV3Diff)
-	case Patch:
-		req.Header.Set("Accept", mediaTypeV3Patch)
-	default:
-		return "", nil, fmt.Errorf("unsupported raw type %d", opts.Type)
-	}
-
 	var buf bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &buf)
+	resp, err := s.GetCommitRawTo(ctx, owner, repo, sha, opts, &buf)
 	if err != nil {
 		return "", resp, err
 	}
@@ -204,6 +199,26 @@ func (s *RepositoriesService) GetCommitRaw(ctx context.Context, owner string, re
 	return buf.String(), resp, nil
 }
 
+// GetCommitRawTo is like GetCommitRaw, but streams the diff or patch to w
+// instead of returning it, which avoids holding large diffs in memory.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#get-a-commit
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}
+func (s *RepositoriesService) GetCommitRawTo(ctx context.Context, owner string, repo string, sha string, opts RawOptions, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/commits/%v", PathEscape(owner), PathEscape(repo), sha)
+	return s.client.getRaw(ctx, u, opts, w)
+}
+
+// GetCommitDiff fetches the specified commit in diff format.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#get-a-commit
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}
+func (s *RepositoriesService) GetCommitDiff(ctx context.Context, owner string, repo string, sha string) (string, *Response, error) {
+	return s.GetCommitRaw(ctx, owner, repo, sha, RawOptions{Type: Diff})
+}
+
 // GetCommitSHA1 gets the SHA-1 of a commit reference. If a last-known SHA1 is
 // supplied and no new commits have occurred, a 304 Unmodified response is returned.
 //
@@ -211,7 +226,7 @@ func (s *RepositoriesService) GetCommitRaw(ctx context.Context, owner string, re
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}
 func (s *RepositoriesService) GetCommitSHA1(ctx context.Context, owner, repo, ref, lastSHA string) (string, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v", owner, repo, refURLEscape(ref))
+	u := fmt.Sprintf("repos/%v/%v/commits/%v", PathEscape(owner), PathEscape(repo), refURLEscape(ref))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -241,7 +256,7 @@ func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo st
 	escapedBase := url.QueryEscape(base)
 	escapedHead := url.QueryEscape(head)
 
-	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v", owner, repo, escapedBase, escapedHead)
+	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v", PathEscape(owner), PathEscape(repo), escapedBase, escapedHead)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -261,6 +276,77 @@ func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo st
 	return comp, resp, nil
 }
 
+// maxTagDereferences bounds how many annotated tags resolveTagSHA follows
+// before giving up, in case of tags pointing at each other.
+const maxTagDereferences = 10
+
+// resolveTagSHA returns the SHA of the commit the given tag points to,
+// dereferencing annotated tags as needed.
+func (s *RepositoriesService) resolveTagSHA(ctx context.Context, owner, repo, tag string) (string, *Response, error) {
+	ref, resp, err := s.client.Git.GetRef(ctx, owner, repo, "tags/"+tag)
+	if err != nil {
+		return "", resp, err
+	}
+
+	obj := ref.GetObject()
+	for i := 0; obj.GetType() == "tag"; i++ {
+		if i == maxTagDereferences {
+			return "", resp, fmt.Errorf("tag %q: too many nested annotated tags", tag)
+		}
+		var t *Tag
+		t, resp, err = s.client.Git.GetTag(ctx, owner, repo, obj.GetSHA())
+		if err != nil {
+			return "", resp, err
+		}
+		obj = t.GetObject()
+	}
+
+	if obj.GetType() != "commit" {
+		return "", resp, fmt.Errorf("tag %q points to a %v, not a commit", tag, obj.GetType())
+	}
+
+	return obj.GetSHA(), resp, nil
+}
+
+// ListCommitsBetween returns all the commits reachable from headTag but not
+// from baseTag, resolving both tags (including annotated tags) to commit SHAs
+// and paging through the comparison of the two. Unlike listing commits within
+// a since/until window, this is not thrown off by rebased or backdated commits.
+//
+// The returned Response is that of the last request made.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#compare-two-commits
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/tags#get-a-tag
+//
+//meta:operation GET /repos/{owner}/{repo}/compare/{basehead}
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation GET /repos/{owner}/{repo}/git/tags/{tag_sha}
+func (s *RepositoriesService) ListCommitsBetween(ctx context.Context, owner, repo, baseTag, headTag string) ([]*RepositoryCommit, *Response, error) {
+	base, resp, err := s.resolveTagSHA(ctx, owner, repo, baseTag)
+	if err != nil {
+		return nil, resp, err
+	}
+	head, resp, err := s.resolveTagSHA(ctx, owner, repo, headTag)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var commits []*RepositoryCommit
+	opts := &ListOptions{PerPage: 100}
+	for {
+		comp, resp, err := s.CompareCommits(ctx, owner, repo, base, head, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		commits = append(commits, comp.Commits...)
+		if resp.NextPage == 0 {
+			return commits, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // CompareCommitsRaw compares a range of commits with each other in raw (diff or patch) format.
 //
 // Both "base" and "head" must be branch names in "repo".
@@ -274,24 +360,10 @@ func (s *RepositoriesService) CompareCommitsRaw(ctx context.Context, owner, repo
 	escapedBase := url.QueryEscape(base)
 	escapedHead := url.QueryEscape(head)
 
-	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v", owner, repo, escapedBase, escapedHead)
-
-	req, err := s.client.NewRequest("GET", u, nil)
-	if err != nil {
-		return "", nil, err
-	}
-
-	switch opts.Type {
-	case Diff:
-		req.Header.Set("Accept", mediaTypeV3Diff)
-	case Patch:
-		req.Header.Set("Accept", mediaTypeV3Patch)
-	default:
-		return "", nil, fmt.Errorf("unsupported raw type %d", opts.Type)
-	}
+	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v", PathEscape(owner), PathEscape(repo), escapedBase, escapedHead)
 
 	var buf bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &buf)
+	resp, err := s.client.getRaw(ctx, u, opts, &buf)
 	if err != nil {
 		return "", resp, err
 	}
@@ -306,7 +378,7 @@ func (s *RepositoriesService) CompareCommitsRaw(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{commit_sha}/branches-where-head
 func (s *RepositoriesService) ListBranchesHeadCommit(ctx context.Context, owner, repo, sha string) ([]*BranchCommit, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v/branches-where-head", owner, repo, sha)
+	u := fmt.Sprintf("repos/%v/%v/commits/%v/branches-where-head", PathEscape(owner), PathEscape(repo), sha)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -6,7 +6,9 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -26,21 +28,23 @@ func TestRepositoriesService_ListCommits(t *testing.T) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r,
 			values{
-				"sha":    "s",
-				"path":   "p",
-				"author": "a",
-				"since":  "2013-08-01T00:00:00Z",
-				"until":  "2013-09-03T00:00:00Z",
+				"sha":       "s",
+				"path":      "p",
+				"author":    "a",
+				"committer": "c@example.com",
+				"since":     "2013-08-01T00:00:00Z",
+				"until":     "2013-09-03T00:00:00Z",
 			})
 		fmt.Fprintf(w, `[{"sha": "s"}]`)
 	})
 
 	opt := &CommitsListOptions{
-		SHA:    "s",
-		Path:   "p",
-		Author: "a",
-		Since:  time.Date(2013, time.August, 1, 0, 0, 0, 0, time.UTC),
-		Until:  time.Date(2013, time.September, 3, 0, 0, 0, 0, time.UTC),
+		SHA:       "s",
+		Path:      "p",
+		Author:    "a",
+		Committer: "c@example.com",
+		Since:     time.Date(2013, time.August, 1, 0, 0, 0, 0, time.UTC),
+		Until:     time.Date(2013, time.September, 3, 0, 0, 0, 0, time.UTC),
 	}
 	ctx := context.Background()
 	commits, _, err := client.Repositories.ListCommits(ctx, "o", "r", opt)
@@ -233,6 +237,73 @@ func TestRepositoriesService_GetCommitRaw_invalid(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_GetCommitDiff(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Diff)
+		fmt.Fprint(w, "@@diff content")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetCommitDiff(ctx, "o", "r", "s")
+	if err != nil {
+		t.Fatalf("Repositories.GetCommitDiff returned error: %v", err)
+	}
+	if want := "@@diff content"; got != want {
+		t.Errorf("Repositories.GetCommitDiff returned %q, want %q", got, want)
+	}
+}
+
+func TestRepositoriesService_GetCommitRawTo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Patch)
+		fmt.Fprint(w, "@@patch content")
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	_, err := client.Repositories.GetCommitRawTo(ctx, "o", "r", "s", RawOptions{Type: Patch}, &buf)
+	if err != nil {
+		t.Fatalf("Repositories.GetCommitRawTo returned error: %v", err)
+	}
+	if got, want := buf.String(), "@@patch content"; got != want {
+		t.Errorf("Repositories.GetCommitRawTo wrote %q, want %q", got, want)
+	}
+
+	const methodName = "GetCommitRawTo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.GetCommitRawTo(ctx, "\n", "\n", "\n", RawOptions{Type: Patch}, &buf)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.GetCommitRawTo(ctx, "o", "r", "s", RawOptions{Type: Patch}, &buf)
+	})
+}
+
+func TestRepositoriesService_GetCommitDiff_tooLarge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotAcceptable)
+		fmt.Fprint(w, `{"message":"The diff is too large.","errors":[{"resource":"Commit","field":"diff","code":"too_large"}]}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.GetCommitDiff(ctx, "o", "r", "s")
+	if !errors.Is(err, ErrDiffTooLarge) {
+		t.Errorf("Repositories.GetCommitDiff returned error %v, want it to wrap ErrDiffTooLarge", err)
+	}
+}
+
 func TestRepositoriesService_GetCommitSHA1(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -504,6 +575,103 @@ func TestRepositoriesService_CompareCommits(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ListCommitsBetween(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/tags/v1.0.0","object":{"type":"commit","sha":"b1"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v2.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/tags/v2.0.0","object":{"type":"tag","sha":"t2"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/tags/t2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"tag":"v2.0.0","sha":"t2","object":{"type":"tag","sha":"t2-inner"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/tags/t2-inner", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"tag":"v2.0.0-inner","sha":"t2-inner","object":{"type":"commit","sha":"h2"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/compare/b1...h2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/compare/b1...h2?per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_commits":3,"commits":[{"sha":"c1"},{"sha":"c2"}]}`)
+		case "2":
+			testFormValues(t, r, values{"per_page": "100", "page": "2"})
+			fmt.Fprint(w, `{"total_commits":3,"commits":[{"sha":"h2"}]}`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	commits, _, err := client.Repositories.ListCommitsBetween(ctx, "o", "r", "v1.0.0", "v2.0.0")
+	if err != nil {
+		t.Errorf("Repositories.ListCommitsBetween returned error: %v", err)
+	}
+
+	want := []*RepositoryCommit{{SHA: String("c1")}, {SHA: String("c2")}, {SHA: String("h2")}}
+	if !cmp.Equal(commits, want) {
+		t.Errorf("Repositories.ListCommitsBetween returned %+v, want %+v", commits, want)
+	}
+
+	const methodName = "ListCommitsBetween"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListCommitsBetween(ctx, "\n", "\n", "v1.0.0", "v2.0.0")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListCommitsBetween(ctx, "o", "r", "v1.0.0", "v2.0.0")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_ListCommitsBetween_tagNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ref":"refs/tags/v1.0.0","object":{"type":"commit","sha":"b1"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/tags/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.ListCommitsBetween(ctx, "o", "r", "v1.0.0", "missing")
+	if err == nil {
+		t.Error("Repositories.ListCommitsBetween returned nil error, want error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Repositories.ListCommitsBetween returned response %+v, want status 404", resp)
+	}
+}
+
+func TestRepositoriesService_ListCommitsBetween_nonCommitTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/ref/tags/tree", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ref":"refs/tags/tree","object":{"type":"tree","sha":"t"}}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.ListCommitsBetween(ctx, "o", "r", "tree", "tree")
+	if err == nil {
+		t.Error("Repositories.ListCommitsBetween returned nil error, want error")
+	}
+}
+
 func TestRepositoriesService_CompareCommitsRaw_diff(t *testing.T) {
 	testCases := []struct {
 		base string
@@ -47,7 +47,7 @@ type CommunityHealthMetrics struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/community/profile
 func (s *RepositoriesService) GetCommunityHealthMetrics(ctx context.Context, owner, repo string) (*CommunityHealthMetrics, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/community/profile", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/community/profile", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -133,6 +133,53 @@ func TestRepositoriesService_GetCommunityHealthMetrics(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetCommunityHealthMetrics_missingFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/community/profile", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+				"health_percentage": 42,
+				"description": null,
+				"documentation": null,
+				"files": {
+					"code_of_conduct": null,
+					"code_of_conduct_file": null,
+					"contributing": null,
+					"issue_template": null,
+					"pull_request_template": null,
+					"license": null,
+					"readme": {
+						"url": "https://api.github.com/repos/o/r/contents/README.md",
+						"html_url": "https://github.com/o/r/blob/main/README.md"
+					}
+				},
+				"content_reports_enabled": false
+			}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetCommunityHealthMetrics(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.GetCommunityHealthMetrics returned error: %v", err)
+	}
+
+	want := &CommunityHealthMetrics{
+		HealthPercentage: Int(42),
+		Files: &CommunityHealthFiles{
+			Readme: &Metric{
+				URL:     String("https://api.github.com/repos/o/r/contents/README.md"),
+				HTMLURL: String("https://github.com/o/r/blob/main/README.md"),
+			},
+		},
+		ContentReportsEnabled: Bool(false),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.GetCommunityHealthMetrics returned %+v, want %+v", got, want)
+	}
+}
+
 func TestMetric_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Metric{}, "{}")
 
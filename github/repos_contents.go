@@ -9,6 +9,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -104,7 +105,7 @@ func (r *RepositoryContent) GetContent() (string, error) {
 //
 //meta:operation GET /repos/{owner}/{repo}/readme
 func (s *RepositoriesService) GetReadme(ctx context.Context, owner, repo string, opts *RepositoryContentGetOptions) (*RepositoryContent, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/readme", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/readme", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -124,6 +125,77 @@ func (s *RepositoriesService) GetReadme(ctx context.Context, owner, repo string,
 	return readme, resp, nil
 }
 
+// GetReadmeOfDirectory gets the Readme file found in the given directory of
+// the repository.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/contents#get-a-repository-readme-for-a-directory
+//
+//meta:operation GET /repos/{owner}/{repo}/readme/{dir}
+func (s *RepositoriesService) GetReadmeOfDirectory(ctx context.Context, owner, repo, dir string, opts *RepositoryContentGetOptions) (*RepositoryContent, *Response, error) {
+	escapedDir := (&url.URL{Path: strings.Trim(dir, "/")}).String()
+	u := fmt.Sprintf("repos/%v/%v/readme/%v", PathEscape(owner), PathEscape(repo), escapedDir)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	readme := new(RepositoryContent)
+	resp, err := s.client.Do(ctx, req, readme)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return readme, resp, nil
+}
+
+// RawReadmeOptions specifies the optional parameters to the
+// RepositoriesService.GetRawReadmeOfDirectory method.
+type RawReadmeOptions struct {
+	Ref string `url:"ref,omitempty"`
+
+	// HTML requests the Readme rendered as HTML instead of its raw contents.
+	HTML bool `url:"-"`
+}
+
+// GetRawReadmeOfDirectory gets the contents of the Readme file found in the
+// given directory of the repository, either raw or rendered as HTML.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/contents#get-a-repository-readme-for-a-directory
+//
+//meta:operation GET /repos/{owner}/{repo}/readme/{dir}
+func (s *RepositoriesService) GetRawReadmeOfDirectory(ctx context.Context, owner, repo, dir string, opts *RawReadmeOptions) (string, *Response, error) {
+	escapedDir := (&url.URL{Path: strings.Trim(dir, "/")}).String()
+	u := fmt.Sprintf("repos/%v/%v/readme/%v", PathEscape(owner), PathEscape(repo), escapedDir)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return "", nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if opts != nil && opts.HTML {
+		req.Header.Set("Accept", mediaTypeHTMLJSON)
+	} else {
+		req.Header.Set("Accept", mediaTypeRawJSON)
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return buf.String(), resp, nil
+}
+
 // DownloadContents returns an io.ReadCloser that reads the contents of the
 // specified file. This function will work with files of any size, as opposed
 // to GetContents which is limited to 1 Mb files. It is the caller's
@@ -219,7 +291,7 @@ func (s *RepositoriesService) GetContents(ctx context.Context, owner, repo, path
 	}
 
 	escapedPath := (&url.URL{Path: strings.TrimSuffix(path, "/")}).String()
-	u := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, escapedPath)
+	u := fmt.Sprintf("repos/%s/%s/contents/%s", PathEscape(owner), PathEscape(repo), escapedPath)
 	u, err = addOptions(u, opts)
 	if err != nil {
 		return nil, nil, nil, err
@@ -256,7 +328,7 @@ func (s *RepositoriesService) GetContents(ctx context.Context, owner, repo, path
 //
 //meta:operation PUT /repos/{owner}/{repo}/contents/{path}
 func (s *RepositoriesService) CreateFile(ctx context.Context, owner, repo, path string, opts *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, path)
+	u := fmt.Sprintf("repos/%s/%s/contents/%s", PathEscape(owner), PathEscape(repo), path)
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -278,7 +350,7 @@ func (s *RepositoriesService) CreateFile(ctx context.Context, owner, repo, path
 //
 //meta:operation PUT /repos/{owner}/{repo}/contents/{path}
 func (s *RepositoriesService) UpdateFile(ctx context.Context, owner, repo, path string, opts *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, path)
+	u := fmt.Sprintf("repos/%s/%s/contents/%s", PathEscape(owner), PathEscape(repo), path)
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -293,6 +365,153 @@ func (s *RepositoriesService) UpdateFile(ctx context.Context, owner, repo, path
 	return updateResponse, resp, nil
 }
 
+// maxUploadFileSize is the largest file UploadFileToBranch accepts, matching
+// the limit GitHub enforces on files in a repository. It is a variable so
+// that tests can lower it.
+var maxUploadFileSize = 100 << 20
+
+// maxUploadAttempts bounds how many times UploadFileToBranch retries when the
+// file changes between fetching its SHA and updating it.
+const maxUploadAttempts = 3
+
+// UploadFileToBranch creates the file at path on branch with the contents read
+// from r, or replaces it if it already exists, and returns the commit and
+// file metadata. It saves callers the read-modify-write dance of CreateFile
+// and UpdateFile: if GitHub reports a conflict because the file exists (or
+// was changed concurrently), the current blob SHA is fetched and the upload
+// retried as an update.
+//
+// The contents are read into memory, up to the 100 MB limit GitHub enforces
+// on files in a repository. If the contents API rejects the file as too large
+// (413 Request Entity Too Large), the file is committed through the Git data
+// API instead: a blob, a tree and a commit are created, and branch is
+// fast-forwarded to it. In that case the returned response only carries the
+// file's Name, Path, SHA, Size and Type, and the commit.
+//
+// GitHub API docs: https://docs.github.com/rest/git/blobs#create-a-blob
+// GitHub API docs: https://docs.github.com/rest/git/commits#create-a-commit
+// GitHub API docs: https://docs.github.com/rest/git/commits#get-a-commit-object
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/refs#update-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/trees#create-a-tree
+// GitHub API docs: https://docs.github.com/rest/repos/contents#create-or-update-file-contents
+// GitHub API docs: https://docs.github.com/rest/repos/contents#get-repository-content
+//
+//meta:operation GET /repos/{owner}/{repo}/contents/{path}
+//meta:operation PUT /repos/{owner}/{repo}/contents/{path}
+//meta:operation POST /repos/{owner}/{repo}/git/blobs
+//meta:operation POST /repos/{owner}/{repo}/git/commits
+//meta:operation GET /repos/{owner}/{repo}/git/commits/{commit_sha}
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation PATCH /repos/{owner}/{repo}/git/refs/{ref}
+//meta:operation POST /repos/{owner}/{repo}/git/trees
+func (s *RepositoriesService) UploadFileToBranch(ctx context.Context, owner, repo, branch, path string, r io.Reader, message string) (*RepositoryContentResponse, *Response, error) {
+	content, err := io.ReadAll(io.LimitReader(r, int64(maxUploadFileSize)+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(content) > maxUploadFileSize {
+		return nil, nil, fmt.Errorf("file %q is larger than the %v bytes GitHub allows", path, maxUploadFileSize)
+	}
+
+	opts := &RepositoryContentFileOptions{
+		Message: &message,
+		Content: content,
+		Branch:  &branch,
+	}
+
+	for attempt := 1; ; attempt++ {
+		res, resp, err := s.CreateFile(ctx, owner, repo, path, opts)
+		if err == nil {
+			return res, resp, nil
+		}
+
+		switch {
+		case resp != nil && resp.StatusCode == http.StatusRequestEntityTooLarge:
+			return s.uploadFileWithGitData(ctx, owner, repo, branch, path, content, message)
+		case resp == nil || attempt == maxUploadAttempts:
+			return nil, resp, err
+		case resp.StatusCode != http.StatusConflict && resp.StatusCode != http.StatusUnprocessableEntity:
+			// GitHub answers 422 when a file is created without the SHA of the
+			// file it would replace, and 409 when that SHA is stale.
+			return nil, resp, err
+		}
+
+		existing, _, getResp, getErr := s.GetContents(ctx, owner, repo, path, &RepositoryContentGetOptions{Ref: branch})
+		if getErr != nil {
+			if getResp != nil && getResp.StatusCode == http.StatusNotFound {
+				// The conflict was not about an existing file.
+				return nil, resp, err
+			}
+			return nil, getResp, getErr
+		}
+		if existing == nil {
+			return nil, resp, fmt.Errorf("path %q is not a file", path)
+		}
+		opts.SHA = existing.SHA
+	}
+}
+
+// uploadFileWithGitData commits content at filePath on branch using the Git data
+// API, for files too large for the contents API.
+func (s *RepositoriesService) uploadFileWithGitData(ctx context.Context, owner, repo, branch, filePath string, content []byte, message string) (*RepositoryContentResponse, *Response, error) {
+	ref, resp, err := s.client.Git.GetRef(ctx, owner, repo, "heads/"+branch)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	parent, resp, err := s.client.Git.GetCommit(ctx, owner, repo, ref.GetObject().GetSHA())
+	if err != nil {
+		return nil, resp, err
+	}
+
+	blob, resp, err := s.client.Git.CreateBlob(ctx, owner, repo, &Blob{
+		Content:  String(base64.StdEncoding.EncodeToString(content)),
+		Encoding: String("base64"),
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	tree, resp, err := s.client.Git.CreateTree(ctx, owner, repo, parent.GetTree().GetSHA(), []*TreeEntry{{
+		Path: String(filePath),
+		Mode: String("100644"),
+		Type: String("blob"),
+		SHA:  blob.SHA,
+	}})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	commit, resp, err := s.client.Git.CreateCommit(ctx, owner, repo, &Commit{
+		Message: String(message),
+		Tree:    tree,
+		Parents: []*Commit{{SHA: parent.SHA}},
+	}, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	_, resp, err = s.client.Git.UpdateRef(ctx, owner, repo, &Reference{
+		Ref:    String("refs/heads/" + branch),
+		Object: &GitObject{SHA: commit.SHA},
+	}, false)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &RepositoryContentResponse{
+		Content: &RepositoryContent{
+			Type: String("file"),
+			Name: String(path.Base(filePath)),
+			Path: String(filePath),
+			SHA:  blob.SHA,
+			Size: Int(len(content)),
+		},
+		Commit: *commit,
+	}, resp, nil
+}
+
 // DeleteFile deletes a file from a repository and returns the commit.
 // Requires the blob SHA of the file to be deleted.
 //
@@ -300,7 +519,7 @@ func (s *RepositoriesService) UpdateFile(ctx context.Context, owner, repo, path
 //
 //meta:operation DELETE /repos/{owner}/{repo}/contents/{path}
 func (s *RepositoriesService) DeleteFile(ctx context.Context, owner, repo, path string, opts *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, path)
+	u := fmt.Sprintf("repos/%s/%s/contents/%s", PathEscape(owner), PathEscape(repo), path)
 	req, err := s.client.NewRequest("DELETE", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -336,7 +555,7 @@ const (
 //meta:operation GET /repos/{owner}/{repo}/tarball/{ref}
 //meta:operation GET /repos/{owner}/{repo}/zipball/{ref}
 func (s *RepositoriesService) GetArchiveLink(ctx context.Context, owner, repo string, archiveformat ArchiveFormat, opts *RepositoryContentGetOptions, maxRedirects int) (*url.URL, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/%s", owner, repo, archiveformat)
+	u := fmt.Sprintf("repos/%s/%s/%s", PathEscape(owner), PathEscape(repo), archiveformat)
 	if opts != nil && opts.Ref != "" {
 		u += fmt.Sprintf("/%s", opts.Ref)
 	}
@@ -7,11 +7,13 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -125,6 +127,104 @@ func TestRepositoriesService_GetReadme(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetReadmeOfDirectory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/readme/my docs/sub", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.EscapedPath(), "/repos/o/r/readme/my%20docs/sub"; got != want {
+			t.Errorf("Request path = %v, want %v", got, want)
+		}
+		testFormValues(t, r, values{"ref": "main"})
+		fmt.Fprint(w, `{
+		  "type": "file",
+		  "encoding": "base64",
+		  "size": 12,
+		  "name": "README.md",
+		  "path": "my docs/sub/README.md"
+		}`)
+	})
+	ctx := context.Background()
+	readme, _, err := client.Repositories.GetReadmeOfDirectory(ctx, "o", "r", "/my docs/sub/", &RepositoryContentGetOptions{Ref: "main"})
+	if err != nil {
+		t.Errorf("Repositories.GetReadmeOfDirectory returned error: %v", err)
+	}
+	want := &RepositoryContent{Type: String("file"), Name: String("README.md"), Size: Int(12), Encoding: String("base64"), Path: String("my docs/sub/README.md")}
+	if !cmp.Equal(readme, want) {
+		t.Errorf("Repositories.GetReadmeOfDirectory returned %+v, want %+v", readme, want)
+	}
+
+	const methodName = "GetReadmeOfDirectory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetReadmeOfDirectory(ctx, "\n", "\n", "\n", &RepositoryContentGetOptions{})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetReadmeOfDirectory(ctx, "o", "r", "my docs/sub", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetRawReadmeOfDirectory(t *testing.T) {
+	tests := map[string]struct {
+		opts       *RawReadmeOptions
+		wantAccept string
+		body       string
+	}{
+		"raw by default": {
+			wantAccept: mediaTypeRawJSON,
+			body:       "# Docs\n",
+		},
+		"html": {
+			opts:       &RawReadmeOptions{HTML: true},
+			wantAccept: mediaTypeHTMLJSON,
+			body:       "<h1>Docs</h1>",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+			mux.HandleFunc("/repos/o/r/readme/my docs", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testHeader(t, r, "Accept", test.wantAccept)
+				fmt.Fprint(w, test.body)
+			})
+
+			ctx := context.Background()
+			got, _, err := client.Repositories.GetRawReadmeOfDirectory(ctx, "o", "r", "my docs", test.opts)
+			if err != nil {
+				t.Fatalf("Repositories.GetRawReadmeOfDirectory returned error: %v", err)
+			}
+			if got != test.body {
+				t.Errorf("Repositories.GetRawReadmeOfDirectory returned %q, want %q", got, test.body)
+			}
+		})
+	}
+
+	client, _, _, teardown := setup()
+	defer teardown()
+	ctx := context.Background()
+	const methodName = "GetRawReadmeOfDirectory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetRawReadmeOfDirectory(ctx, "\n", "\n", "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetRawReadmeOfDirectory(ctx, "o", "r", "my docs", nil)
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestRepositoriesService_DownloadContents_Success(t *testing.T) {
 	client, mux, serverURL, teardown := setup()
 	defer teardown()
@@ -997,3 +1097,146 @@ func TestRepositoryContentFileOptions_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, r, want)
 }
+
+func TestRepositoriesService_UploadFileToBranch_conflictThenUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var puts int
+	mux.HandleFunc("/repos/o/r/contents/docs/img.png", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			puts++
+			v := new(RepositoryContentFileOptions)
+			assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+			want := &RepositoryContentFileOptions{Message: String("m"), Content: []byte("png"), Branch: String("docs")}
+			switch puts {
+			case 1:
+				if !cmp.Equal(v, want) {
+					t.Errorf("first request body = %+v, want %+v", v, want)
+				}
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"message":"is at 1 but expected 0"}`)
+			case 2:
+				want.SHA = String("old")
+				if !cmp.Equal(v, want) {
+					t.Errorf("second request body = %+v, want %+v", v, want)
+				}
+				fmt.Fprint(w, `{"content":{"name":"img.png","path":"docs/img.png","sha":"new"},"commit":{"sha":"c"}}`)
+			default:
+				t.Errorf("unexpected request %v", puts)
+			}
+		case "GET":
+			testFormValues(t, r, values{"ref": "docs"})
+			fmt.Fprint(w, `{"type":"file","name":"img.png","path":"docs/img.png","sha":"old"}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	res, _, err := client.Repositories.UploadFileToBranch(ctx, "o", "r", "docs", "docs/img.png", strings.NewReader("png"), "m")
+	if err != nil {
+		t.Fatalf("Repositories.UploadFileToBranch returned error: %v", err)
+	}
+
+	want := &RepositoryContentResponse{
+		Content: &RepositoryContent{Name: String("img.png"), Path: String("docs/img.png"), SHA: String("new")},
+		Commit:  Commit{SHA: String("c")},
+	}
+	if !cmp.Equal(res, want) {
+		t.Errorf("Repositories.UploadFileToBranch returned %+v, want %+v", res, want)
+	}
+	if puts != 2 {
+		t.Errorf("Repositories.UploadFileToBranch made %v PUT requests, want 2", puts)
+	}
+}
+
+func TestRepositoriesService_UploadFileToBranch_unrelatedError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/img.png", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			http.Error(w, `{"message":"Branch nope not found"}`, http.StatusUnprocessableEntity)
+		case "GET":
+			http.Error(w, `{"message":"No commit found for the ref nope"}`, http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.UploadFileToBranch(ctx, "o", "r", "nope", "img.png", strings.NewReader("png"), "m")
+	if err == nil {
+		t.Fatal("Repositories.UploadFileToBranch returned no error, want one")
+	}
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Repositories.UploadFileToBranch returned status %v, want %v", resp.StatusCode, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestRepositoriesService_UploadFileToBranch_gitDataFallback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/big.bin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		http.Error(w, `{"message":"too large"}`, http.StatusRequestEntityTooLarge)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"type":"commit","sha":"p"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits/p", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"p","tree":{"sha":"bt"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"content":"YmluYXJ5","encoding":"base64"}`+"\n")
+		fmt.Fprint(w, `{"sha":"b"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"base_tree":"bt","tree":[{"sha":"b","path":"big.bin","mode":"100644","type":"blob"}]}`+"\n")
+		fmt.Fprint(w, `{"sha":"t"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"message":"m","tree":"t","parents":["p"]}`+"\n")
+		fmt.Fprint(w, `{"sha":"c","message":"m"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"sha":"c","force":false}`+"\n")
+		fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"type":"commit","sha":"c"}}`)
+	})
+
+	ctx := context.Background()
+	res, _, err := client.Repositories.UploadFileToBranch(ctx, "o", "r", "main", "big.bin", strings.NewReader("binary"), "m")
+	if err != nil {
+		t.Fatalf("Repositories.UploadFileToBranch returned error: %v", err)
+	}
+
+	want := &RepositoryContentResponse{
+		Content: &RepositoryContent{Type: String("file"), Name: String("big.bin"), Path: String("big.bin"), SHA: String("b"), Size: Int(6)},
+		Commit:  Commit{SHA: String("c"), Message: String("m")},
+	}
+	if !cmp.Equal(res, want) {
+		t.Errorf("Repositories.UploadFileToBranch returned %+v, want %+v", res, want)
+	}
+}
+
+func TestRepositoriesService_UploadFileToBranch_tooLarge(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	defer func(size int) { maxUploadFileSize = size }(maxUploadFileSize)
+	maxUploadFileSize = 4
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.UploadFileToBranch(ctx, "o", "r", "main", "f", strings.NewReader("12345"), "m")
+	if err == nil {
+		t.Error("Repositories.UploadFileToBranch returned no error, want one")
+	}
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // DeploymentBranchPolicy represents a single deployment branch policy for an environment.
@@ -36,7 +37,7 @@ type DeploymentBranchPolicyRequest struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies
 func (s *RepositoriesService) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string) (*DeploymentBranchPolicyResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies", owner, repo, environment)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies", PathEscape(owner), PathEscape(repo), url.PathEscape(environment))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -58,7 +59,7 @@ func (s *RepositoriesService) ListDeploymentBranchPolicies(ctx context.Context,
 //
 //meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}
 func (s *RepositoriesService) GetDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, branchPolicyID int64) (*DeploymentBranchPolicy, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies/%v", owner, repo, environment, branchPolicyID)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies/%v", PathEscape(owner), PathEscape(repo), url.PathEscape(environment), branchPolicyID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -80,7 +81,7 @@ func (s *RepositoriesService) GetDeploymentBranchPolicy(ctx context.Context, own
 //
 //meta:operation POST /repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies
 func (s *RepositoriesService) CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, request *DeploymentBranchPolicyRequest) (*DeploymentBranchPolicy, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies", owner, repo, environment)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies", PathEscape(owner), PathEscape(repo), url.PathEscape(environment))
 
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
@@ -102,7 +103,7 @@ func (s *RepositoriesService) CreateDeploymentBranchPolicy(ctx context.Context,
 //
 //meta:operation PUT /repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}
 func (s *RepositoriesService) UpdateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, branchPolicyID int64, request *DeploymentBranchPolicyRequest) (*DeploymentBranchPolicy, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies/%v", owner, repo, environment, branchPolicyID)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies/%v", PathEscape(owner), PathEscape(repo), url.PathEscape(environment), branchPolicyID)
 
 	req, err := s.client.NewRequest("PUT", u, request)
 	if err != nil {
@@ -124,7 +125,7 @@ func (s *RepositoriesService) UpdateDeploymentBranchPolicy(ctx context.Context,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}
 func (s *RepositoriesService) DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, branchPolicyID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies/%v", owner, repo, environment, branchPolicyID)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies/%v", PathEscape(owner), PathEscape(repo), url.PathEscape(environment), branchPolicyID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // CustomDeploymentProtectionRuleApp represents a single deployment protection rule app for an environment.
@@ -49,7 +50,7 @@ type CustomDeploymentProtectionRuleRequest struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules
 func (s *RepositoriesService) GetAllDeploymentProtectionRules(ctx context.Context, owner, repo, environment string) (*ListDeploymentProtectionRuleResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules", owner, repo, environment)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules", PathEscape(owner), PathEscape(repo), url.PathEscape(environment))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -71,7 +72,7 @@ func (s *RepositoriesService) GetAllDeploymentProtectionRules(ctx context.Contex
 //
 //meta:operation POST /repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules
 func (s *RepositoriesService) CreateCustomDeploymentProtectionRule(ctx context.Context, owner, repo, environment string, request *CustomDeploymentProtectionRuleRequest) (*CustomDeploymentProtectionRule, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules", owner, repo, environment)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules", PathEscape(owner), PathEscape(repo), url.PathEscape(environment))
 
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
@@ -93,7 +94,7 @@ func (s *RepositoriesService) CreateCustomDeploymentProtectionRule(ctx context.C
 //
 //meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/apps
 func (s *RepositoriesService) ListCustomDeploymentRuleIntegrations(ctx context.Context, owner, repo, environment string) (*ListCustomDeploymentRuleIntegrationsResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules/apps", owner, repo, environment)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules/apps", PathEscape(owner), PathEscape(repo), url.PathEscape(environment))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -115,7 +116,7 @@ func (s *RepositoriesService) ListCustomDeploymentRuleIntegrations(ctx context.C
 //
 //meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/{protection_rule_id}
 func (s *RepositoriesService) GetCustomDeploymentProtectionRule(ctx context.Context, owner, repo, environment string, protectionRuleID int64) (*CustomDeploymentProtectionRule, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules/%v", owner, repo, environment, protectionRuleID)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules/%v", PathEscape(owner), PathEscape(repo), url.PathEscape(environment), protectionRuleID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -137,7 +138,7 @@ func (s *RepositoriesService) GetCustomDeploymentProtectionRule(ctx context.Cont
 //
 //meta:operation DELETE /repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/{protection_rule_id}
 func (s *RepositoriesService) DisableCustomDeploymentProtectionRule(ctx context.Context, owner, repo, environment string, protectionRuleID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules/%v", owner, repo, environment, protectionRuleID)
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules/%v", PathEscape(owner), PathEscape(repo), url.PathEscape(environment), protectionRuleID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
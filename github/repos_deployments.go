@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // Deployment represents a deployment in a repo
@@ -30,6 +31,15 @@ type Deployment struct {
 	NodeID        *string         `json:"node_id,omitempty"`
 }
 
+// DecodePayload decodes the deployment payload, as given when the deployment
+// was created, into v. It leaves v unchanged if the deployment has no payload.
+func (d *Deployment) DecodePayload(v interface{}) error {
+	if len(d.Payload) == 0 || string(d.Payload) == "null" {
+		return nil
+	}
+	return json.Unmarshal(d.Payload, v)
+}
+
 // DeploymentRequest represents a deployment request
 type DeploymentRequest struct {
 	Ref                   *string     `json:"ref,omitempty"`
@@ -43,6 +53,10 @@ type DeploymentRequest struct {
 	ProductionEnvironment *bool       `json:"production_environment,omitempty"`
 }
 
+// DeploymentEnvironmentNone can be used as DeploymentsListOptions.Environment
+// to list deployments which do not have an environment.
+const DeploymentEnvironmentNone = "none"
+
 // DeploymentsListOptions specifies the optional parameters to the
 // RepositoriesService.ListDeployments method.
 type DeploymentsListOptions struct {
@@ -55,8 +69,11 @@ type DeploymentsListOptions struct {
 	// List deployments for a given task.
 	Task string `url:"task,omitempty"`
 
-	// List deployments for a given environment.
-	Environment string `url:"environment,omitempty"`
+	// List deployments for a given environment. Leave nil to list
+	// deployments for all environments, or use DeploymentEnvironmentNone
+	// to list deployments which have no environment. A pointer to the
+	// empty string is sent as an empty environment filter.
+	Environment *string `url:"environment,omitempty"`
 
 	ListOptions
 }
@@ -67,7 +84,7 @@ type DeploymentsListOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/deployments
 func (s *RepositoriesService) ListDeployments(ctx context.Context, owner, repo string, opts *DeploymentsListOptions) ([]*Deployment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/deployments", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/deployments", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -93,7 +110,7 @@ func (s *RepositoriesService) ListDeployments(ctx context.Context, owner, repo s
 //
 //meta:operation GET /repos/{owner}/{repo}/deployments/{deployment_id}
 func (s *RepositoriesService) GetDeployment(ctx context.Context, owner, repo string, deploymentID int64) (*Deployment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/deployments/%v", owner, repo, deploymentID)
+	u := fmt.Sprintf("repos/%v/%v/deployments/%v", PathEscape(owner), PathEscape(repo), deploymentID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -115,7 +132,7 @@ func (s *RepositoriesService) GetDeployment(ctx context.Context, owner, repo str
 //
 //meta:operation POST /repos/{owner}/{repo}/deployments
 func (s *RepositoriesService) CreateDeployment(ctx context.Context, owner, repo string, request *DeploymentRequest) (*Deployment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/deployments", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/deployments", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
@@ -141,7 +158,7 @@ func (s *RepositoriesService) CreateDeployment(ctx context.Context, owner, repo
 //
 //meta:operation DELETE /repos/{owner}/{repo}/deployments/{deployment_id}
 func (s *RepositoriesService) DeleteDeployment(ctx context.Context, owner, repo string, deploymentID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/deployments/%v", owner, repo, deploymentID)
+	u := fmt.Sprintf("repos/%v/%v/deployments/%v", PathEscape(owner), PathEscape(repo), deploymentID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -149,13 +166,45 @@ func (s *RepositoriesService) DeleteDeployment(ctx context.Context, owner, repo
 	return s.client.Do(ctx, req, nil)
 }
 
+// DeleteDeploymentSafely deletes a deployment of a repository. GitHub only
+// allows inactive deployments to be deleted (unless the deployment is the
+// only one in the repository), so an inactive status is created for the
+// deployment before it is deleted.
+//
+// The returned Response is that of the final request made.
+//
+// GitHub API docs: https://docs.github.com/rest/deployments/deployments#delete-a-deployment
+// GitHub API docs: https://docs.github.com/rest/deployments/statuses#create-a-deployment-status
+//
+//meta:operation DELETE /repos/{owner}/{repo}/deployments/{deployment_id}
+//meta:operation POST /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
+func (s *RepositoriesService) DeleteDeploymentSafely(ctx context.Context, owner, repo string, deploymentID int64) (*Response, error) {
+	status := &DeploymentStatusRequest{State: String(DeploymentStateInactive)}
+	if _, resp, err := s.CreateDeploymentStatus(ctx, owner, repo, deploymentID, status); err != nil {
+		return resp, err
+	}
+
+	return s.DeleteDeployment(ctx, owner, repo, deploymentID)
+}
+
+// The possible states of a DeploymentStatus and DeploymentStatusRequest.
+// DeploymentStateQueued and DeploymentStateInProgress require the flash
+// preview media type, which the deployment status methods send.
+const (
+	DeploymentStateError      = "error"
+	DeploymentStateFailure    = "failure"
+	DeploymentStateInactive   = "inactive"
+	DeploymentStateInProgress = "in_progress"
+	DeploymentStatePending    = "pending"
+	DeploymentStateQueued     = "queued"
+	DeploymentStateSuccess    = "success"
+)
+
 // DeploymentStatus represents the status of a
 // particular deployment.
 type DeploymentStatus struct {
 	ID *int64 `json:"id,omitempty"`
-	// State is the deployment state.
-	// Possible values are: "pending", "success", "failure", "error",
-	// "inactive", "in_progress", "queued".
+	// State is the deployment state, one of the DeploymentState* constants.
 	State          *string    `json:"state,omitempty"`
 	Creator        *User      `json:"creator,omitempty"`
 	Description    *string    `json:"description,omitempty"`
@@ -173,12 +222,19 @@ type DeploymentStatus struct {
 
 // DeploymentStatusRequest represents a deployment request
 type DeploymentStatusRequest struct {
-	State          *string `json:"state,omitempty"`
-	LogURL         *string `json:"log_url,omitempty"`
-	Description    *string `json:"description,omitempty"`
-	Environment    *string `json:"environment,omitempty"`
+	// State is required, and is one of the DeploymentState* constants.
+	State *string `json:"state,omitempty"`
+	// LogURL is the full URL of the deployment's output.
+	LogURL      *string `json:"log_url,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// Environment overrides the name of the environment deployed to.
+	Environment *string `json:"environment,omitempty"`
+	// EnvironmentURL is the URL for accessing the environment deployed to.
 	EnvironmentURL *string `json:"environment_url,omitempty"`
-	AutoInactive   *bool   `json:"auto_inactive,omitempty"`
+	// AutoInactive sets whether to mark the previous non-transient, non-production
+	// deployments of the environment inactive on a successful deployment.
+	// Defaults to true.
+	AutoInactive *bool `json:"auto_inactive,omitempty"`
 }
 
 // ListDeploymentStatuses lists the statuses of a given deployment of a repository.
@@ -187,7 +243,7 @@ type DeploymentStatusRequest struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
 func (s *RepositoriesService) ListDeploymentStatuses(ctx context.Context, owner, repo string, deployment int64, opts *ListOptions) ([]*DeploymentStatus, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/deployments/%v/statuses", owner, repo, deployment)
+	u := fmt.Sprintf("repos/%v/%v/deployments/%v/statuses", PathEscape(owner), PathEscape(repo), deployment)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -217,7 +273,7 @@ func (s *RepositoriesService) ListDeploymentStatuses(ctx context.Context, owner,
 //
 //meta:operation GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses/{status_id}
 func (s *RepositoriesService) GetDeploymentStatus(ctx context.Context, owner, repo string, deploymentID, deploymentStatusID int64) (*DeploymentStatus, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/deployments/%v/statuses/%v", owner, repo, deploymentID, deploymentStatusID)
+	u := fmt.Sprintf("repos/%v/%v/deployments/%v/statuses/%v", PathEscape(owner), PathEscape(repo), deploymentID, deploymentStatusID)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -243,7 +299,7 @@ func (s *RepositoriesService) GetDeploymentStatus(ctx context.Context, owner, re
 //
 //meta:operation POST /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
 func (s *RepositoriesService) CreateDeploymentStatus(ctx context.Context, owner, repo string, deployment int64, request *DeploymentStatusRequest) (*DeploymentStatus, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/deployments/%v/statuses", owner, repo, deployment)
+	u := fmt.Sprintf("repos/%v/%v/deployments/%v/statuses", PathEscape(owner), PathEscape(repo), deployment)
 
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
@@ -262,3 +318,51 @@ func (s *RepositoriesService) CreateDeploymentStatus(ctx context.Context, owner,
 
 	return d, resp, nil
 }
+
+// deploymentStatusPollInterval is the delay between polls of
+// RepositoriesService.WaitForDeployment.
+var deploymentStatusPollInterval = 5 * time.Second
+
+// WaitForDeployment polls the statuses of a deployment until its latest
+// status is in one of targetStates, and returns that status. If targetStates
+// is empty, it waits for the deployment to succeed, fail, error or become
+// inactive. It gives up once timeout has passed, if positive, or ctx is done,
+// returning the context error.
+//
+// GitHub API docs: https://docs.github.com/rest/deployments/statuses#list-deployment-statuses
+//
+//meta:operation GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
+func (s *RepositoriesService) WaitForDeployment(ctx context.Context, owner, repo string, deploymentID int64, targetStates []string, timeout time.Duration) (*DeploymentStatus, error) {
+	if len(targetStates) == 0 {
+		targetStates = []string{DeploymentStateSuccess, DeploymentStateFailure, DeploymentStateError, DeploymentStateInactive}
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// Statuses are listed newest first, so the first one is the latest.
+	opts := &ListOptions{PerPage: 1}
+	for {
+		statuses, _, err := s.ListDeploymentStatuses(ctx, owner, repo, deploymentID, opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(statuses) > 0 {
+			for _, state := range targetStates {
+				if statuses[0].GetState() == state {
+					return statuses[0], nil
+				}
+			}
+		}
+
+		t := time.NewTimer(deploymentStatusPollInterval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
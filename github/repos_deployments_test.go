@@ -8,10 +8,13 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -26,7 +29,7 @@ func TestRepositoriesService_ListDeployments(t *testing.T) {
 		fmt.Fprint(w, `[{"id":1}, {"id":2}]`)
 	})
 
-	opt := &DeploymentsListOptions{Environment: "test"}
+	opt := &DeploymentsListOptions{Environment: String("test")}
 	ctx := context.Background()
 	deployments, _, err := client.Repositories.ListDeployments(ctx, "o", "r", opt)
 	if err != nil {
@@ -53,6 +56,38 @@ func TestRepositoriesService_ListDeployments(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListDeployments_environmentFilter(t *testing.T) {
+	tests := map[string]struct {
+		environment *string
+		want        url.Values
+	}{
+		"absent": {environment: nil, want: url.Values{}},
+		"none":   {environment: String(DeploymentEnvironmentNone), want: url.Values{"environment": {"none"}}},
+		"empty":  {environment: String(""), want: url.Values{"environment": {""}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				if got := r.URL.Query(); !cmp.Equal(got, test.want) {
+					t.Errorf("Request query = %v, want %v", got, test.want)
+				}
+				fmt.Fprint(w, `[]`)
+			})
+
+			ctx := context.Background()
+			opt := &DeploymentsListOptions{Environment: test.environment}
+			if _, _, err := client.Repositories.ListDeployments(ctx, "o", "r", opt); err != nil {
+				t.Errorf("Repositories.ListDeployments returned error: %v", err)
+			}
+		})
+	}
+}
+
 func TestRepositoriesService_GetDeployment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -172,6 +207,68 @@ func TestRepositoriesService_DeleteDeployment(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_DeleteDeploymentSafely(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"state":"inactive"}`+"\n")
+		calls = append(calls, "status")
+		fmt.Fprint(w, `{"id":2,"state":"inactive"}`)
+	})
+	mux.HandleFunc("/repos/o/r/deployments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		calls = append(calls, "delete")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Repositories.DeleteDeploymentSafely(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.DeleteDeploymentSafely returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Error("Repositories.DeleteDeploymentSafely should return a 204 status")
+	}
+	if want := []string{"status", "delete"}; !cmp.Equal(calls, want) {
+		t.Errorf("Repositories.DeleteDeploymentSafely made calls %v, want %v", calls, want)
+	}
+
+	const methodName = "DeleteDeploymentSafely"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.DeleteDeploymentSafely(ctx, "\n", "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.DeleteDeploymentSafely(ctx, "o", "r", 1)
+	})
+}
+
+func TestRepositoriesService_DeleteDeploymentSafely_statusError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	})
+	mux.HandleFunc("/repos/o/r/deployments/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.DeleteDeploymentSafely should not delete when the status could not be created")
+	})
+
+	ctx := context.Background()
+	resp, err := client.Repositories.DeleteDeploymentSafely(ctx, "o", "r", 1)
+	if err == nil {
+		t.Error("Repositories.DeleteDeploymentSafely should return an error")
+	}
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Error("Repositories.DeleteDeploymentSafely should return a 422 status")
+	}
+}
+
 func TestRepositoriesService_ListDeploymentStatuses(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -294,6 +391,160 @@ func TestRepositoriesService_CreateDeploymentStatus(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateDeploymentStatus_previewStates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.Header.Get("Accept"); !strings.Contains(got, mediaTypeExpandDeploymentStatusPreview) {
+			t.Errorf("Accept header = %q, want it to contain %q", got, mediaTypeExpandDeploymentStatusPreview)
+		}
+		v := new(DeploymentStatusRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+		fmt.Fprintf(w, `{"state":%q}`, v.GetState())
+	})
+
+	ctx := context.Background()
+	for _, state := range []string{DeploymentStateQueued, DeploymentStateInProgress} {
+		input := &DeploymentStatusRequest{
+			State:          String(state),
+			LogURL:         String("https://example.com/log"),
+			Environment:    String("staging"),
+			EnvironmentURL: String("https://staging.example.com"),
+			AutoInactive:   Bool(false),
+		}
+		deploymentStatus, _, err := client.Repositories.CreateDeploymentStatus(ctx, "o", "r", 1, input)
+		if err != nil {
+			t.Errorf("Repositories.CreateDeploymentStatus(%v) returned error: %v", state, err)
+		}
+		if got := deploymentStatus.GetState(); got != state {
+			t.Errorf("Repositories.CreateDeploymentStatus returned state %v, want %v", got, state)
+		}
+	}
+}
+
+func TestRepositoriesService_WaitForDeployment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(interval time.Duration) { deploymentStatusPollInterval = interval }(deploymentStatusPollInterval)
+	deploymentStatusPollInterval = time.Millisecond
+
+	states := []string{"", DeploymentStateQueued, DeploymentStateInProgress, DeploymentStateSuccess}
+	polls := 0
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		state := states[polls]
+		polls++
+		if state == "" {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprintf(w, `[{"id":%v,"state":%q}]`, polls, state)
+	})
+
+	ctx := context.Background()
+	status, err := client.Repositories.WaitForDeployment(ctx, "o", "r", 1, nil, time.Minute)
+	if err != nil {
+		t.Fatalf("Repositories.WaitForDeployment returned error: %v", err)
+	}
+
+	want := &DeploymentStatus{ID: Int64(4), State: String(DeploymentStateSuccess)}
+	if !cmp.Equal(status, want) {
+		t.Errorf("Repositories.WaitForDeployment returned %+v, want %+v", status, want)
+	}
+	if polls != 4 {
+		t.Errorf("Repositories.WaitForDeployment polled %v times, want 4", polls)
+	}
+}
+
+func TestRepositoriesService_WaitForDeployment_targetStates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"state":"in_progress"}]`)
+	})
+
+	ctx := context.Background()
+	status, err := client.Repositories.WaitForDeployment(ctx, "o", "r", 1, []string{DeploymentStateInProgress}, 0)
+	if err != nil {
+		t.Fatalf("Repositories.WaitForDeployment returned error: %v", err)
+	}
+	if got := status.GetState(); got != DeploymentStateInProgress {
+		t.Errorf("Repositories.WaitForDeployment returned state %v, want %v", got, DeploymentStateInProgress)
+	}
+}
+
+func TestRepositoriesService_WaitForDeployment_timeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(interval time.Duration) { deploymentStatusPollInterval = interval }(deploymentStatusPollInterval)
+	deploymentStatusPollInterval = time.Millisecond
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1,"state":"pending"}]`)
+	})
+
+	ctx := context.Background()
+	status, err := client.Repositories.WaitForDeployment(ctx, "o", "r", 1, nil, 20*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Repositories.WaitForDeployment returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if status != nil {
+		t.Errorf("Repositories.WaitForDeployment returned %+v, want nil", status)
+	}
+}
+
+func TestRepositoriesService_WaitForDeployment_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Repositories.WaitForDeployment(ctx, "o", "r", 1, nil, time.Minute); err == nil {
+		t.Error("Repositories.WaitForDeployment returned nil error, want error")
+	}
+}
+
+func TestDeployment_DecodePayload(t *testing.T) {
+	type payload struct {
+		Image    string `json:"image"`
+		Replicas int    `json:"replicas"`
+	}
+
+	d := &Deployment{Payload: json.RawMessage(`{"image":"app:1.2.3","replicas":3}`)}
+	var got payload
+	if err := d.DecodePayload(&got); err != nil {
+		t.Fatalf("Deployment.DecodePayload returned error: %v", err)
+	}
+	if want := (payload{Image: "app:1.2.3", Replicas: 3}); got != want {
+		t.Errorf("Deployment.DecodePayload decoded %+v, want %+v", got, want)
+	}
+
+	for _, raw := range []json.RawMessage{nil, json.RawMessage("null")} {
+		got := payload{Image: "unchanged"}
+		if err := (&Deployment{Payload: raw}).DecodePayload(&got); err != nil {
+			t.Errorf("Deployment.DecodePayload(%q) returned error: %v", raw, err)
+		}
+		if got.Image != "unchanged" {
+			t.Errorf("Deployment.DecodePayload(%q) decoded %+v, want it unchanged", raw, got)
+		}
+	}
+
+	d = &Deployment{Payload: json.RawMessage(`"not an object"`)}
+	if err := d.DecodePayload(&got); err == nil {
+		t.Error("Deployment.DecodePayload returned nil error, want error")
+	}
+}
+
 func TestDeploymentStatusRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &DeploymentStatusRequest{}, "{}")
 
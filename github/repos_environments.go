@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // Environment represents a single environment in a repository.
@@ -32,8 +33,16 @@ type Environment struct {
 	ProtectionRules []*ProtectionRule `json:"protection_rules,omitempty"`
 }
 
+// The types of environment reviewers, as used by EnvReviewers and
+// RequiredReviewer.
+const (
+	EnvReviewerTypeUser = "User"
+	EnvReviewerTypeTeam = "Team"
+)
+
 // EnvReviewers represents a single environment reviewer entry.
 type EnvReviewers struct {
+	// Type is either EnvReviewerTypeUser or EnvReviewerTypeTeam.
 	Type *string `json:"type,omitempty"`
 	ID   *int64  `json:"id,omitempty"`
 }
@@ -62,10 +71,30 @@ type ProtectionRule struct {
 
 // RequiredReviewer represents a required reviewer.
 type RequiredReviewer struct {
-	Type     *string     `json:"type,omitempty"`
+	Type *string `json:"type,omitempty"`
+	// Reviewer is a *User if Type is EnvReviewerTypeUser, or a *Team if Type
+	// is EnvReviewerTypeTeam.
 	Reviewer interface{} `json:"reviewer,omitempty"`
 }
 
+// GetUser returns the reviewer if it's a user, or nil.
+func (r *RequiredReviewer) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	u, _ := r.Reviewer.(*User)
+	return u
+}
+
+// GetTeam returns the reviewer if it's a team, or nil.
+func (r *RequiredReviewer) GetTeam() *Team {
+	if r == nil {
+		return nil
+	}
+	t, _ := r.Reviewer.(*Team)
+	return t
+}
+
 // EnvironmentListOptions specifies the optional parameters to the
 // RepositoriesService.ListEnvironments method.
 type EnvironmentListOptions struct {
@@ -83,14 +112,14 @@ func (r *RequiredReviewer) UnmarshalJSON(data []byte) error {
 
 	r.Type = reviewer.Type
 
-	switch *reviewer.Type {
-	case "User":
+	switch r.GetType() {
+	case EnvReviewerTypeUser:
 		reviewer.Reviewer = &User{}
 		if err := json.Unmarshal(data, &reviewer); err != nil {
 			return err
 		}
 		r.Reviewer = reviewer.Reviewer
-	case "Team":
+	case EnvReviewerTypeTeam:
 		reviewer.Reviewer = &Team{}
 		if err := json.Unmarshal(data, &reviewer); err != nil {
 			return err
@@ -111,7 +140,7 @@ func (r *RequiredReviewer) UnmarshalJSON(data []byte) error {
 //
 //meta:operation GET /repos/{owner}/{repo}/environments
 func (s *RepositoriesService) ListEnvironments(ctx context.Context, owner, repo string, opts *EnvironmentListOptions) (*EnvResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/environments", owner, repo)
+	u := fmt.Sprintf("repos/%s/%s/environments", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -132,11 +161,13 @@ func (s *RepositoriesService) ListEnvironments(ctx context.Context, owner, repo
 
 // GetEnvironment get a single environment for a repository.
 //
+// Note: the environment name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
+//
 // GitHub API docs: https://docs.github.com/rest/deployments/environments#get-an-environment
 //
 //meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}
 func (s *RepositoriesService) GetEnvironment(ctx context.Context, owner, repo, name string) (*Environment, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/environments/%s", owner, repo, name)
+	u := fmt.Sprintf("repos/%s/%s/environments/%s", PathEscape(owner), PathEscape(repo), url.PathEscape(name))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -190,11 +221,13 @@ type createUpdateEnvironmentNoEnterprise struct {
 
 // CreateUpdateEnvironment create or update a new environment for a repository.
 //
+// Note: the environment name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
+//
 // GitHub API docs: https://docs.github.com/rest/deployments/environments#create-or-update-an-environment
 //
 //meta:operation PUT /repos/{owner}/{repo}/environments/{environment_name}
 func (s *RepositoriesService) CreateUpdateEnvironment(ctx context.Context, owner, repo, name string, environment *CreateUpdateEnvironment) (*Environment, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/environments/%s", owner, repo, name)
+	u := fmt.Sprintf("repos/%s/%s/environments/%s", PathEscape(owner), PathEscape(repo), url.PathEscape(name))
 
 	req, err := s.client.NewRequest("PUT", u, environment)
 	if err != nil {
@@ -238,11 +271,13 @@ func (s *RepositoriesService) createNewEnvNoEnterprise(ctx context.Context, u st
 
 // DeleteEnvironment delete an environment from a repository.
 //
+// Note: the environment name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
+//
 // GitHub API docs: https://docs.github.com/rest/deployments/environments#delete-an-environment
 //
 //meta:operation DELETE /repos/{owner}/{repo}/environments/{environment_name}
 func (s *RepositoriesService) DeleteEnvironment(ctx context.Context, owner, repo, name string) (*Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/environments/%s", owner, repo, name)
+	u := fmt.Sprintf("repos/%s/%s/environments/%s", PathEscape(owner), PathEscape(repo), url.PathEscape(name))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
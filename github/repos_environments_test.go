@@ -61,6 +61,11 @@ func TestRequiredReviewer_UnmarshalJSON(t *testing.T) {
 			wantRule:  []*RequiredReviewer{{Type: String("Team"), Reviewer: nil}},
 			wantError: true,
 		},
+		"Missing Type in Reviewer Object": {
+			data:      []byte(`[{"reviewer": {"id": 1}}]`),
+			wantRule:  []*RequiredReviewer{{Type: nil, Reviewer: nil}},
+			wantError: true,
+		},
 		"Wrong Type of Reviewer": {
 			data:      []byte(`[{"type": "Cat", "reviewer": {"id": 1,"login": "octocat"}}]`),
 			wantRule:  []*RequiredReviewer{{Type: nil, Reviewer: nil}},
@@ -174,6 +179,119 @@ func TestRepositoriesService_GetEnvironment(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetEnvironment_protectionRules(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// don't url escape the environment name here since mux will convert it to a slash automatically
+	mux.HandleFunc("/repos/o/r/environments/production/eu", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.EscapedPath(), "/repos/o/r/environments/production%2Feu"; got != want {
+			t.Errorf("Request path = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "production/eu",
+			"deployment_branch_policy": {"protected_branches": false, "custom_branch_policies": true},
+			"protection_rules": [
+				{"id": 1, "type": "wait_timer", "wait_timer": 30},
+				{
+					"id": 2,
+					"type": "required_reviewers",
+					"prevent_self_review": true,
+					"reviewers": [
+						{"type": "User", "reviewer": {"id": 1, "login": "octocat"}},
+						{"type": "Team", "reviewer": {"id": 2, "slug": "ops"}}
+					]
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	env, _, err := client.Repositories.GetEnvironment(ctx, "o", "r", "production/eu")
+	if err != nil {
+		t.Fatalf("Repositories.GetEnvironment returned error: %v", err)
+	}
+
+	want := &Environment{
+		ID:                     Int64(1),
+		Name:                   String("production/eu"),
+		DeploymentBranchPolicy: &BranchPolicy{ProtectedBranches: Bool(false), CustomBranchPolicies: Bool(true)},
+		ProtectionRules: []*ProtectionRule{
+			{ID: Int64(1), Type: String("wait_timer"), WaitTimer: Int(30)},
+			{
+				ID:                Int64(2),
+				Type:              String("required_reviewers"),
+				PreventSelfReview: Bool(true),
+				Reviewers: []*RequiredReviewer{
+					{Type: String(EnvReviewerTypeUser), Reviewer: &User{ID: Int64(1), Login: String("octocat")}},
+					{Type: String(EnvReviewerTypeTeam), Reviewer: &Team{ID: Int64(2), Slug: String("ops")}},
+				},
+			},
+		},
+	}
+	if !cmp.Equal(env, want) {
+		t.Errorf("Repositories.GetEnvironment returned %+v, want %+v", env, want)
+	}
+
+	reviewers := env.ProtectionRules[1].Reviewers
+	if got := reviewers[0].GetUser().GetLogin(); got != "octocat" {
+		t.Errorf("RequiredReviewer.GetUser returned login %q, want %q", got, "octocat")
+	}
+	if got := reviewers[0].GetTeam(); got != nil {
+		t.Errorf("RequiredReviewer.GetTeam returned %+v for a user reviewer, want nil", got)
+	}
+	if got := reviewers[1].GetTeam().GetSlug(); got != "ops" {
+		t.Errorf("RequiredReviewer.GetTeam returned slug %q, want %q", got, "ops")
+	}
+	if got := reviewers[1].GetUser(); got != nil {
+		t.Errorf("RequiredReviewer.GetUser returned %+v for a team reviewer, want nil", got)
+	}
+}
+
+func TestRepositoriesService_CreateEnvironment_preventSelfReview(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateUpdateEnvironment{
+		Reviewers: []*EnvReviewers{
+			{Type: String(EnvReviewerTypeUser), ID: Int64(1)},
+			{Type: String(EnvReviewerTypeTeam), ID: Int64(2)},
+		},
+		PreventSelfReview: Bool(true),
+	}
+
+	mux.HandleFunc("/repos/o/r/environments/e", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"wait_timer":0,"reviewers":[{"type":"User","id":1},{"type":"Team","id":2}],"can_admins_bypass":true,"deployment_branch_policy":null,"prevent_self_review":true}`+"\n")
+		fmt.Fprint(w, `{"id": 1, "name": "e", "protection_rules": [{"id": 2, "type": "required_reviewers", "prevent_self_review": true, "reviewers": [{"type": "User", "reviewer": {"id": 1}}, {"type": "Team", "reviewer": {"id": 2}}]}]}`)
+	})
+
+	ctx := context.Background()
+	env, _, err := client.Repositories.CreateUpdateEnvironment(ctx, "o", "r", "e", input)
+	if err != nil {
+		t.Fatalf("Repositories.CreateUpdateEnvironment returned error: %v", err)
+	}
+
+	want := &Environment{
+		ID:   Int64(1),
+		Name: String("e"),
+		ProtectionRules: []*ProtectionRule{{
+			ID:                Int64(2),
+			Type:              String("required_reviewers"),
+			PreventSelfReview: Bool(true),
+			Reviewers: []*RequiredReviewer{
+				{Type: String(EnvReviewerTypeUser), Reviewer: &User{ID: Int64(1)}},
+				{Type: String(EnvReviewerTypeTeam), Reviewer: &Team{ID: Int64(2)}},
+			},
+		}},
+	}
+	if !cmp.Equal(env, want) {
+		t.Errorf("Repositories.CreateUpdateEnvironment returned %+v, want %+v", env, want)
+	}
+}
+
 func TestRepositoriesService_CreateEnvironment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
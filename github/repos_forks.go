@@ -27,7 +27,7 @@ type RepositoryListForksOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/forks
 func (s *RepositoriesService) ListForks(ctx context.Context, owner, repo string, opts *RepositoryListForksOptions) ([]*Repository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/forks", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/forks", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -72,7 +72,7 @@ type RepositoryCreateForkOptions struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/forks
 func (s *RepositoriesService) CreateFork(ctx context.Context, owner, repo string, opts *RepositoryCreateForkOptions) (*Repository, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/forks", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/forks", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
@@ -65,6 +65,8 @@ func TestRepositoriesService_ListForks_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListForks(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListForks(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_CreateFork(t *testing.T) {
@@ -136,4 +138,6 @@ func TestRepositoriesService_CreateFork_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.CreateFork(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.CreateFork(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
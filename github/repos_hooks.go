@@ -39,15 +39,15 @@ type WebHookAuthor = CommitAuthor
 
 // Hook represents a GitHub (web and service) hook for a repository.
 type Hook struct {
-	CreatedAt    *Timestamp             `json:"created_at,omitempty"`
-	UpdatedAt    *Timestamp             `json:"updated_at,omitempty"`
-	URL          *string                `json:"url,omitempty"`
-	ID           *int64                 `json:"id,omitempty"`
-	Type         *string                `json:"type,omitempty"`
-	Name         *string                `json:"name,omitempty"`
-	TestURL      *string                `json:"test_url,omitempty"`
-	PingURL      *string                `json:"ping_url,omitempty"`
-	LastResponse map[string]interface{} `json:"last_response,omitempty"`
+	CreatedAt    *Timestamp        `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp        `json:"updated_at,omitempty"`
+	URL          *string           `json:"url,omitempty"`
+	ID           *int64            `json:"id,omitempty"`
+	Type         *string           `json:"type,omitempty"`
+	Name         *string           `json:"name,omitempty"`
+	TestURL      *string           `json:"test_url,omitempty"`
+	PingURL      *string           `json:"ping_url,omitempty"`
+	LastResponse *HookLastResponse `json:"last_response,omitempty"`
 
 	// Only the following fields are used when creating a hook.
 	// Config is required.
@@ -60,6 +60,48 @@ func (h Hook) String() string {
 	return Stringify(h)
 }
 
+// HookLastResponse represents the response GitHub received the last time it
+// delivered a payload to a Hook.
+type HookLastResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Status  *string `json:"status,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// isUnhealthy reports whether the last delivery to the hook failed, either
+// with an HTTP error code or because GitHub considers it misconfigured.
+func (h *Hook) isUnhealthy() bool {
+	r := h.GetLastResponse()
+	return r.GetCode() >= 400 || r.GetStatus() == "misconfigured"
+}
+
+// listUnhealthyHooks calls list for each page of hooks, starting from the
+// page selected by opts, and returns the hooks whose last delivery failed
+// along with the response for the last page.
+func listUnhealthyHooks(opts *ListOptions, list func(*ListOptions) ([]*Hook, *Response, error)) ([]*Hook, *Response, error) {
+	var pageOpts ListOptions
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	var unhealthy []*Hook
+	for {
+		hooks, resp, err := list(&pageOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, h := range hooks {
+			if h.isUnhealthy() {
+				unhealthy = append(unhealthy, h)
+			}
+		}
+		if resp.NextPage == 0 {
+			return unhealthy, resp, nil
+		}
+		pageOpts.Page = resp.NextPage
+	}
+}
+
 // createHookRequest is a subset of Hook and is used internally
 // by CreateHook to pass only the known fields for the endpoint.
 //
@@ -83,7 +125,7 @@ type createHookRequest struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/hooks
 func (s *RepositoriesService) CreateHook(ctx context.Context, owner, repo string, hook *Hook) (*Hook, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/hooks", PathEscape(owner), PathEscape(repo))
 
 	hookReq := &createHookRequest{
 		Name:   "web",
@@ -112,7 +154,7 @@ func (s *RepositoriesService) CreateHook(ctx context.Context, owner, repo string
 //
 //meta:operation GET /repos/{owner}/{repo}/hooks
 func (s *RepositoriesService) ListHooks(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Hook, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/hooks", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -132,13 +174,28 @@ func (s *RepositoriesService) ListHooks(ctx context.Context, owner, repo string,
 	return hooks, resp, nil
 }
 
+// ListUnhealthyHooks lists the Hooks for the specified repository whose last
+// delivery failed, that is, whose last response code is 400 or above or whose
+// last response status is "misconfigured". All pages of hooks are inspected,
+// starting from the page selected by opts, and the returned Response is the
+// one for the last page.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/webhooks#list-repository-webhooks
+//
+//meta:operation GET /repos/{owner}/{repo}/hooks
+func (s *RepositoriesService) ListUnhealthyHooks(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Hook, *Response, error) {
+	return listUnhealthyHooks(opts, func(opts *ListOptions) ([]*Hook, *Response, error) {
+		return s.ListHooks(ctx, owner, repo, opts)
+	})
+}
+
 // GetHook returns a single specified Hook.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/webhooks#get-a-repository-webhook
 //
 //meta:operation GET /repos/{owner}/{repo}/hooks/{hook_id}
 func (s *RepositoriesService) GetHook(ctx context.Context, owner, repo string, id int64) (*Hook, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%d", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -158,7 +215,7 @@ func (s *RepositoriesService) GetHook(ctx context.Context, owner, repo string, i
 //
 //meta:operation PATCH /repos/{owner}/{repo}/hooks/{hook_id}
 func (s *RepositoriesService) EditHook(ctx context.Context, owner, repo string, id int64, hook *Hook) (*Hook, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%d", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("PATCH", u, hook)
 	if err != nil {
 		return nil, nil, err
@@ -178,7 +235,7 @@ func (s *RepositoriesService) EditHook(ctx context.Context, owner, repo string,
 //
 //meta:operation DELETE /repos/{owner}/{repo}/hooks/{hook_id}
 func (s *RepositoriesService) DeleteHook(ctx context.Context, owner, repo string, id int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%d", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -192,7 +249,7 @@ func (s *RepositoriesService) DeleteHook(ctx context.Context, owner, repo string
 //
 //meta:operation POST /repos/{owner}/{repo}/hooks/{hook_id}/pings
 func (s *RepositoriesService) PingHook(ctx context.Context, owner, repo string, id int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%d/pings", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%d/pings", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, err
@@ -206,7 +263,7 @@ func (s *RepositoriesService) PingHook(ctx context.Context, owner, repo string,
 //
 //meta:operation POST /repos/{owner}/{repo}/hooks/{hook_id}/tests
 func (s *RepositoriesService) TestHook(ctx context.Context, owner, repo string, id int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%d/tests", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%d/tests", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, err
@@ -6,7 +6,9 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -16,6 +18,8 @@ type HookConfig struct {
 	// Possible values are `json` and `form`, the field is not specified the default is `form`
 	ContentType *string `json:"content_type,omitempty"`
 	// The possible values are 0 and 1.
+	// GitHub returns it either as a string or as a number; both are
+	// decoded into a string.
 	// Setting it to 1 will allow skip certificate verification for the host,
 	// potentially exposing to MitM attacks: https://en.wikipedia.org/wiki/Man-in-the-middle_attack
 	InsecureSSL *string `json:"insecure_ssl,omitempty"`
@@ -25,13 +29,48 @@ type HookConfig struct {
 	Secret *string `json:"secret,omitempty"`
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts insecure_ssl as either a JSON string or a JSON number.
+func (c *HookConfig) UnmarshalJSON(data []byte) error {
+	type hookConfig HookConfig
+	aux := struct {
+		*hookConfig
+		InsecureSSL json.RawMessage `json:"insecure_ssl,omitempty"`
+	}{hookConfig: (*hookConfig)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	c.InsecureSSL = nil
+	if len(aux.InsecureSSL) == 0 || bytes.Equal(aux.InsecureSSL, []byte("null")) {
+		return nil
+	}
+
+	if aux.InsecureSSL[0] == '"' {
+		var s string
+		if err := json.Unmarshal(aux.InsecureSSL, &s); err != nil {
+			return err
+		}
+		c.InsecureSSL = &s
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(aux.InsecureSSL, &n); err != nil {
+		return err
+	}
+	s := n.String()
+	c.InsecureSSL = &s
+	return nil
+}
+
 // GetHookConfiguration returns the configuration for the specified repository webhook.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/webhooks#get-a-webhook-configuration-for-a-repository
 //
 //meta:operation GET /repos/{owner}/{repo}/hooks/{hook_id}/config
 func (s *RepositoriesService) GetHookConfiguration(ctx context.Context, owner, repo string, id int64) (*HookConfig, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%v/config", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/config", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -52,7 +91,7 @@ func (s *RepositoriesService) GetHookConfiguration(ctx context.Context, owner, r
 //
 //meta:operation PATCH /repos/{owner}/{repo}/hooks/{hook_id}/config
 func (s *RepositoriesService) EditHookConfiguration(ctx context.Context, owner, repo string, id int64, config *HookConfig) (*HookConfig, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%v/config", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/config", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("PATCH", u, config)
 	if err != nil {
 		return nil, nil, err
@@ -66,3 +105,30 @@ func (s *RepositoriesService) EditHookConfiguration(ctx context.Context, owner,
 
 	return c, resp, nil
 }
+
+// RotateHookSecret replaces the secret of the specified repository webhook
+// with newSecret, leaving the rest of its configuration untouched.
+//
+// It returns the configuration that was in place before the rotation so that
+// the caller can roll back the URL, content type and SSL settings if needed.
+// Note that GitHub never returns the secret itself, so the previous secret
+// must be kept by the caller if it may need to be restored.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/webhooks#get-a-webhook-configuration-for-a-repository
+// GitHub API docs: https://docs.github.com/rest/repos/webhooks#update-a-webhook-configuration-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/hooks/{hook_id}/config
+//meta:operation PATCH /repos/{owner}/{repo}/hooks/{hook_id}/config
+func (s *RepositoriesService) RotateHookSecret(ctx context.Context, owner, repo string, id int64, newSecret string) (*HookConfig, *Response, error) {
+	previous, resp, err := s.GetHookConfiguration(ctx, owner, repo, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	_, resp, err = s.EditHookConfiguration(ctx, owner, repo, id, &HookConfig{Secret: &newSecret})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return previous, resp, nil
+}
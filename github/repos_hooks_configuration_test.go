@@ -62,6 +62,8 @@ func TestRepositoriesService_GetHookConfiguration_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.GetHookConfiguration(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.GetHookConfiguration(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_EditHookConfiguration(t *testing.T) {
@@ -120,4 +122,164 @@ func TestRepositoriesService_EditHookConfiguration_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.EditHookConfiguration(ctx, "%", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.EditHookConfiguration(ctx, "..", "..", 1, nil)
+	testPathForbiddenError(t, err)
+}
+
+func TestRepositoriesService_EditHookConfiguration_onlySendsProvidedKeys(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"content_type":"json"}`+"\n")
+		fmt.Fprint(w, `{"content_type": "json", "insecure_ssl": "0", "secret": "********", "url": "https://example.com/webhook"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.EditHookConfiguration(ctx, "o", "r", 1, &HookConfig{ContentType: String("json")})
+	if err != nil {
+		t.Errorf("Repositories.EditHookConfiguration returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_RotateHookSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"content_type": "form", "insecure_ssl": "0", "secret": "********", "url": "https://example.com/old"}`)
+		case "PATCH":
+			testBody(t, r, `{"secret":"new-secret"}`+"\n")
+			fmt.Fprint(w, `{"content_type": "form", "insecure_ssl": "0", "secret": "********", "url": "https://example.com/old"}`)
+		default:
+			t.Errorf("Request method: %v, want GET or PATCH", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	previous, _, err := client.Repositories.RotateHookSecret(ctx, "o", "r", 1, "new-secret")
+	if err != nil {
+		t.Errorf("Repositories.RotateHookSecret returned error: %v", err)
+	}
+
+	want := &HookConfig{
+		ContentType: String("form"),
+		InsecureSSL: String("0"),
+		Secret:      String("********"),
+		URL:         String("https://example.com/old"),
+	}
+	if !cmp.Equal(previous, want) {
+		t.Errorf("Repositories.RotateHookSecret returned %+v, want %+v", previous, want)
+	}
+
+	const methodName = "RotateHookSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.RotateHookSecret(ctx, "\n", "\n", -1, "new-secret")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.RotateHookSecret(ctx, "o", "r", 1, "new-secret")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_RotateHookSecret_editFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprint(w, `{"url": "https://example.com/old"}`)
+	})
+
+	ctx := context.Background()
+	previous, resp, err := client.Repositories.RotateHookSecret(ctx, "o", "r", 1, "new-secret")
+	if err == nil {
+		t.Error("Repositories.RotateHookSecret returned nil error, want error")
+	}
+	if previous != nil {
+		t.Errorf("Repositories.RotateHookSecret returned %+v, want nil", previous)
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Repositories.RotateHookSecret returned response %+v, want status 422", resp)
+	}
+}
+
+func TestHookConfig_UnmarshalJSON_insecureSSL(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		want *HookConfig
+	}{
+		"string": {
+			data: `{"url":"https://example.com","insecure_ssl":"1"}`,
+			want: &HookConfig{URL: String("https://example.com"), InsecureSSL: String("1")},
+		},
+		"number": {
+			data: `{"content_type":"json","insecure_ssl":0}`,
+			want: &HookConfig{ContentType: String("json"), InsecureSSL: String("0")},
+		},
+		"null": {
+			data: `{"insecure_ssl":null}`,
+			want: &HookConfig{},
+		},
+		"absent": {
+			data: `{"secret":"********"}`,
+			want: &HookConfig{Secret: String("********")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := new(HookConfig)
+			if err := json.Unmarshal([]byte(test.data), got); err != nil {
+				t.Fatalf("json.Unmarshal returned error: %v", err)
+			}
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("json.Unmarshal returned %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestHookConfig_UnmarshalJSON_invalidInsecureSSL(t *testing.T) {
+	for _, data := range []string{`{"insecure_ssl":true}`, `{"insecure_ssl":"1}`} {
+		if err := json.Unmarshal([]byte(data), new(HookConfig)); err == nil {
+			t.Errorf("json.Unmarshal(%s) returned nil error, want error", data)
+		}
+	}
+}
+
+func TestRepositoriesService_GetHookConfiguration_numericInsecureSSL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"content_type":"form","insecure_ssl":1,"url":"https://example.com"}`)
+	})
+
+	ctx := context.Background()
+	config, _, err := client.Repositories.GetHookConfiguration(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetHookConfiguration returned error: %v", err)
+	}
+
+	want := &HookConfig{
+		ContentType: String("form"),
+		InsecureSSL: String("1"),
+		URL:         String("https://example.com"),
+	}
+	if !cmp.Equal(config, want) {
+		t.Errorf("Repositories.GetHookConfiguration returned %+v, want %+v", config, want)
+	}
 }
@@ -67,7 +67,7 @@ func (r HookResponse) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/hooks/{hook_id}/deliveries
 func (s *RepositoriesService) ListHookDeliveries(ctx context.Context, owner, repo string, id int64, opts *ListCursorOptions) ([]*HookDelivery, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries", PathEscape(owner), PathEscape(repo), id)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -93,7 +93,7 @@ func (s *RepositoriesService) ListHookDeliveries(ctx context.Context, owner, rep
 //
 //meta:operation GET /repos/{owner}/{repo}/hooks/{hook_id}/deliveries/{delivery_id}
 func (s *RepositoriesService) GetHookDelivery(ctx context.Context, owner, repo string, hookID, deliveryID int64) (*HookDelivery, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries/%v", owner, repo, hookID, deliveryID)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries/%v", PathEscape(owner), PathEscape(repo), hookID, deliveryID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -114,7 +114,7 @@ func (s *RepositoriesService) GetHookDelivery(ctx context.Context, owner, repo s
 //
 //meta:operation POST /repos/{owner}/{repo}/hooks/{hook_id}/deliveries/{delivery_id}/attempts
 func (s *RepositoriesService) RedeliverHookDelivery(ctx context.Context, owner, repo string, hookID, deliveryID int64) (*HookDelivery, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries/%v/attempts", owner, repo, hookID, deliveryID)
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries/%v/attempts", PathEscape(owner), PathEscape(repo), hookID, deliveryID)
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -61,6 +61,8 @@ func TestRepositoriesService_ListHookDeliveries_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListHookDeliveries(ctx, "%", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListHookDeliveries(ctx, "..", "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_GetHookDelivery(t *testing.T) {
@@ -105,6 +107,8 @@ func TestRepositoriesService_GetHookDelivery_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.GetHookDelivery(ctx, "%", "%", 1, 1)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.GetHookDelivery(ctx, "..", "..", 1, 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_RedeliverHookDelivery(t *testing.T) {
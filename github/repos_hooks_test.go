@@ -98,6 +98,93 @@ func TestRepositoriesService_ListHooks(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListUnhealthyHooks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[
+			{"id":1,"last_response":{"code":200,"status":"active","message":"OK"}},
+			{"id":2,"last_response":{"code":500,"status":"active","message":"Internal Server Error"}},
+			{"id":3,"last_response":{"code":null,"status":"misconfigured","message":"Invalid HTTP Response: 301"}},
+			{"id":4,"last_response":{"code":null,"status":"unused","message":null}},
+			{"id":5}
+		]`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	ctx := context.Background()
+	hooks, _, err := client.Repositories.ListUnhealthyHooks(ctx, "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.ListUnhealthyHooks returned error: %v", err)
+	}
+
+	want := []*Hook{
+		{ID: Int64(2), LastResponse: &HookLastResponse{Code: Int(500), Status: String("active"), Message: String("Internal Server Error")}},
+		{ID: Int64(3), LastResponse: &HookLastResponse{Status: String("misconfigured"), Message: String("Invalid HTTP Response: 301")}},
+	}
+	if !cmp.Equal(hooks, want) {
+		t.Errorf("Repositories.ListUnhealthyHooks returned %+v, want %+v", hooks, want)
+	}
+
+	const methodName = "ListUnhealthyHooks"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListUnhealthyHooks(ctx, "\n", "\n", opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListUnhealthyHooks(ctx, "o", "r", opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_ListUnhealthyHooks_pages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "2"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/hooks?page=2&per_page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1,"last_response":{"code":502}},{"id":2,"last_response":{"code":200}}]`)
+		case "2":
+			testFormValues(t, r, values{"page": "2", "per_page": "2"})
+			fmt.Fprint(w, `[{"id":3,"last_response":{"status":"misconfigured"}}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	opt := &ListOptions{PerPage: 2}
+	ctx := context.Background()
+	hooks, resp, err := client.Repositories.ListUnhealthyHooks(ctx, "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.ListUnhealthyHooks returned error: %v", err)
+	}
+
+	want := []*Hook{
+		{ID: Int64(1), LastResponse: &HookLastResponse{Code: Int(502)}},
+		{ID: Int64(3), LastResponse: &HookLastResponse{Status: String("misconfigured")}},
+	}
+	if !cmp.Equal(hooks, want) {
+		t.Errorf("Repositories.ListUnhealthyHooks returned %+v, want %+v", hooks, want)
+	}
+	if resp.NextPage != 0 {
+		t.Errorf("Repositories.ListUnhealthyHooks returned NextPage %v, want 0", resp.NextPage)
+	}
+	if opt.Page != 0 {
+		t.Errorf("Repositories.ListUnhealthyHooks modified opts.Page to %v", opt.Page)
+	}
+}
+
 func TestRepositoriesService_ListHooks_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -105,6 +192,8 @@ func TestRepositoriesService_ListHooks_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListHooks(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListHooks(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_ListHooks_403_code_no_rate_limit(t *testing.T) {
@@ -157,6 +246,8 @@ func TestRepositoriesService_GetHook_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.GetHook(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.GetHook(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_EditHook(t *testing.T) {
@@ -210,6 +301,8 @@ func TestRepositoriesService_EditHook_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.EditHook(ctx, "%", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.EditHook(ctx, "..", "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_DeleteHook(t *testing.T) {
@@ -244,6 +337,8 @@ func TestRepositoriesService_DeleteHook_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Repositories.DeleteHook(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Repositories.DeleteHook(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_PingHook(t *testing.T) {
@@ -303,6 +398,8 @@ func TestRepositoriesService_TestHook_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Repositories.TestHook(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Repositories.TestHook(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestBranchWebHookPayload_Marshal(t *testing.T) {
@@ -529,8 +626,10 @@ func TestBranchHook_Marshal(t *testing.T) {
 		Name:      String("name"),
 		TestURL:   String("testurl"),
 		PingURL:   String("pingurl"),
-		LastResponse: map[string]interface{}{
-			"item": "item",
+		LastResponse: &HookLastResponse{
+			Code:    Int(200),
+			Status:  String("active"),
+			Message: String("OK"),
 		},
 		Config: &HookConfig{ContentType: String("json")},
 		Events: []string{"1", "2", "3"},
@@ -547,7 +646,9 @@ func TestBranchHook_Marshal(t *testing.T) {
 		"test_url": "testurl",
 		"ping_url": "pingurl",
 		"last_response":{
-			"item": "item"
+			"code": 200,
+			"status": "active",
+			"message": "OK"
 		},
 		"config":{
 			"content_type": "json"
@@ -31,7 +31,7 @@ type RepositoryInvitation struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/invitations
 func (s *RepositoriesService) ListInvitations(ctx context.Context, owner, repo string, opts *ListOptions) ([]*RepositoryInvitation, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/invitations", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/invitations", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -57,7 +57,7 @@ func (s *RepositoriesService) ListInvitations(ctx context.Context, owner, repo s
 //
 //meta:operation DELETE /repos/{owner}/{repo}/invitations/{invitation_id}
 func (s *RepositoriesService) DeleteInvitation(ctx context.Context, owner, repo string, invitationID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/invitations/%v", owner, repo, invitationID)
+	u := fmt.Sprintf("repos/%v/%v/invitations/%v", PathEscape(owner), PathEscape(repo), invitationID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -79,7 +79,7 @@ func (s *RepositoriesService) UpdateInvitation(ctx context.Context, owner, repo
 	opts := &struct {
 		Permissions string `json:"permissions"`
 	}{Permissions: permissions}
-	u := fmt.Sprintf("repos/%v/%v/invitations/%v", owner, repo, invitationID)
+	u := fmt.Sprintf("repos/%v/%v/invitations/%v", PathEscape(owner), PathEscape(repo), invitationID)
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
 		return nil, nil, err
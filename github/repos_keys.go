@@ -18,7 +18,7 @@ import (
 //
 //meta:operation GET /repos/{owner}/{repo}/keys
 func (s *RepositoriesService) ListKeys(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*Key, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/keys", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/keys", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -44,7 +44,7 @@ func (s *RepositoriesService) ListKeys(ctx context.Context, owner string, repo s
 //
 //meta:operation GET /repos/{owner}/{repo}/keys/{key_id}
 func (s *RepositoriesService) GetKey(ctx context.Context, owner string, repo string, id int64) (*Key, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/keys/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/keys/%v", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -66,7 +66,7 @@ func (s *RepositoriesService) GetKey(ctx context.Context, owner string, repo str
 //
 //meta:operation POST /repos/{owner}/{repo}/keys
 func (s *RepositoriesService) CreateKey(ctx context.Context, owner string, repo string, key *Key) (*Key, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/keys", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/keys", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, key)
 	if err != nil {
@@ -88,7 +88,7 @@ func (s *RepositoriesService) CreateKey(ctx context.Context, owner string, repo
 //
 //meta:operation DELETE /repos/{owner}/{repo}/keys/{key_id}
 func (s *RepositoriesService) DeleteKey(ctx context.Context, owner string, repo string, id int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/keys/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/keys/%v", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
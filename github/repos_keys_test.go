@@ -59,6 +59,8 @@ func TestRepositoriesService_ListKeys_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListKeys(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListKeys(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_GetKey(t *testing.T) {
@@ -103,6 +105,8 @@ func TestRepositoriesService_GetKey_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.GetKey(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.GetKey(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_CreateKey(t *testing.T) {
@@ -156,6 +160,8 @@ func TestRepositoriesService_CreateKey_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.CreateKey(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.CreateKey(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_DeleteKey(t *testing.T) {
@@ -190,4 +196,6 @@ func TestRepositoriesService_DeleteKey_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Repositories.DeleteKey(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Repositories.DeleteKey(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
@@ -16,7 +16,7 @@ import (
 //
 //meta:operation PUT /repos/{owner}/{repo}/lfs
 func (s *RepositoriesService) EnableLFS(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/lfs", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/lfs", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -37,7 +37,7 @@ func (s *RepositoriesService) EnableLFS(ctx context.Context, owner, repo string)
 //
 //meta:operation DELETE /repos/{owner}/{repo}/lfs
 func (s *RepositoriesService) DisableLFS(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/lfs", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/lfs", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
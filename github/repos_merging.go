@@ -38,7 +38,7 @@ type RepoMergeUpstreamResult struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/merges
 func (s *RepositoriesService) Merge(ctx context.Context, owner, repo string, request *RepositoryMergeRequest) (*RepositoryCommit, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/merges", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/merges", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
 		return nil, nil, err
@@ -60,7 +60,7 @@ func (s *RepositoriesService) Merge(ctx context.Context, owner, repo string, req
 //
 //meta:operation POST /repos/{owner}/{repo}/merge-upstream
 func (s *RepositoriesService) MergeUpstream(ctx context.Context, owner, repo string, request *RepoMergeUpstreamRequest) (*RepoMergeUpstreamResult, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/merge-upstream", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/merge-upstream", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, request)
 	if err != nil {
 		return nil, nil, err
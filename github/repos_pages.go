@@ -6,7 +6,9 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -22,6 +24,10 @@ type Pages struct {
 	Public           *bool                  `json:"public,omitempty"`
 	HTTPSCertificate *PagesHTTPSCertificate `json:"https_certificate,omitempty"`
 	HTTPSEnforced    *bool                  `json:"https_enforced,omitempty"`
+	// ProtectedDomainState is the state of the verification of the custom
+	// domain. Possible values are: "pending", "verified" and "unverified".
+	ProtectedDomainState      *string    `json:"protected_domain_state,omitempty"`
+	PendingDomainUnverifiedAt *Timestamp `json:"pending_domain_unverified_at,omitempty"`
 }
 
 // PagesSource represents a GitHub page's source.
@@ -107,7 +113,7 @@ type createPagesRequest struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/pages
 func (s *RepositoriesService) EnablePages(ctx context.Context, owner, repo string, pages *Pages) (*Pages, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pages", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pages", PathEscape(owner), PathEscape(repo))
 
 	pagesReq := &createPagesRequest{
 		BuildType: pages.BuildType,
@@ -159,7 +165,7 @@ type PagesUpdate struct {
 //
 //meta:operation PUT /repos/{owner}/{repo}/pages
 func (s *RepositoriesService) UpdatePages(ctx context.Context, owner, repo string, opts *PagesUpdate) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pages", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pages", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
@@ -180,7 +186,7 @@ func (s *RepositoriesService) UpdatePages(ctx context.Context, owner, repo strin
 //
 //meta:operation DELETE /repos/{owner}/{repo}/pages
 func (s *RepositoriesService) DisablePages(ctx context.Context, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pages", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pages", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -198,7 +204,7 @@ func (s *RepositoriesService) DisablePages(ctx context.Context, owner, repo stri
 //
 //meta:operation GET /repos/{owner}/{repo}/pages
 func (s *RepositoriesService) GetPagesInfo(ctx context.Context, owner, repo string) (*Pages, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pages", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pages", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -219,7 +225,7 @@ func (s *RepositoriesService) GetPagesInfo(ctx context.Context, owner, repo stri
 //
 //meta:operation GET /repos/{owner}/{repo}/pages/builds
 func (s *RepositoriesService) ListPagesBuilds(ctx context.Context, owner, repo string, opts *ListOptions) ([]*PagesBuild, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pages/builds", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pages/builds", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -245,7 +251,7 @@ func (s *RepositoriesService) ListPagesBuilds(ctx context.Context, owner, repo s
 //
 //meta:operation GET /repos/{owner}/{repo}/pages/builds/latest
 func (s *RepositoriesService) GetLatestPagesBuild(ctx context.Context, owner, repo string) (*PagesBuild, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pages/builds/latest", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pages/builds/latest", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -266,7 +272,7 @@ func (s *RepositoriesService) GetLatestPagesBuild(ctx context.Context, owner, re
 //
 //meta:operation GET /repos/{owner}/{repo}/pages/builds/{build_id}
 func (s *RepositoriesService) GetPageBuild(ctx context.Context, owner, repo string, id int64) (*PagesBuild, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pages/builds/%v", owner, repo, id)
+	u := fmt.Sprintf("repos/%v/%v/pages/builds/%v", PathEscape(owner), PathEscape(repo), id)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -287,7 +293,7 @@ func (s *RepositoriesService) GetPageBuild(ctx context.Context, owner, repo stri
 //
 //meta:operation POST /repos/{owner}/{repo}/pages/builds
 func (s *RepositoriesService) RequestPageBuild(ctx context.Context, owner, repo string) (*PagesBuild, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pages/builds", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pages/builds", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -308,7 +314,7 @@ func (s *RepositoriesService) RequestPageBuild(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/pages/health
 func (s *RepositoriesService) GetPageHealthCheck(ctx context.Context, owner, repo string) (*PagesHealthCheckResponse, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/pages/health", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/pages/health", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -322,3 +328,138 @@ func (s *RepositoriesService) GetPageHealthCheck(ctx context.Context, owner, rep
 
 	return healthCheckResponse, resp, nil
 }
+
+// CreatePagesDeploymentRequest represents a request to deploy a GitHub Pages
+// site from an artifact uploaded by a GitHub Actions workflow run.
+type CreatePagesDeploymentRequest struct {
+	// ArtifactID is the ID of the artifact holding the site. Either ArtifactID
+	// or ArtifactURL is required.
+	ArtifactID *int64 `json:"artifact_id,omitempty"`
+	// ArtifactURL is the URL of the artifact holding the site.
+	ArtifactURL *string `json:"artifact_url,omitempty"`
+	// Environment is the target environment of the deployment.
+	// Defaults to "github-pages".
+	Environment *string `json:"environment,omitempty"`
+	// PagesBuildVersion is a unique string representing the version of the
+	// build, such as the commit SHA it was built from. It is required.
+	PagesBuildVersion *string `json:"pages_build_version,omitempty"`
+	// OIDCToken is the OIDC token issued by GitHub Actions, certifying the
+	// origin of the deployment. It is required.
+	OIDCToken *string `json:"oidc_token,omitempty"`
+}
+
+// PagesDeployment represents a GitHub Pages deployment.
+type PagesDeployment struct {
+	// ID is the ID of the deployment, which may be the commit SHA it was
+	// created for.
+	ID         *string `json:"id,omitempty"`
+	StatusURL  *string `json:"status_url,omitempty"`
+	PageURL    *string `json:"page_url,omitempty"`
+	PreviewURL *string `json:"preview_url,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// GitHub returns the deployment ID either as a number or as a commit SHA.
+func (d *PagesDeployment) UnmarshalJSON(data []byte) error {
+	var pd struct {
+		ID         interface{} `json:"id"`
+		StatusURL  *string     `json:"status_url"`
+		PageURL    *string     `json:"page_url"`
+		PreviewURL *string     `json:"preview_url"`
+	}
+
+	// Decode numeric IDs as json.Number, as float64 would lose precision.
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&pd); err != nil {
+		return err
+	}
+
+	d.StatusURL = pd.StatusURL
+	d.PageURL = pd.PageURL
+	d.PreviewURL = pd.PreviewURL
+
+	switch v := pd.ID.(type) {
+	case nil:
+		return nil
+	case string:
+		d.ID = &v
+	case json.Number:
+		d.ID = String(v.String())
+	default:
+		return fmt.Errorf("unable to unmarshal %T as a string", v)
+	}
+
+	return nil
+}
+
+// PagesDeploymentStatus represents the status of a GitHub Pages deployment.
+type PagesDeploymentStatus struct {
+	// Status is the current status of the deployment. Possible values are:
+	// "deployment_in_progress", "syncing_files", "finished_file_sync",
+	// "updating_pages", "purging_cdn", "deployment_cancelled",
+	// "deployment_failed", "deployment_content_failed",
+	// "deployment_attempt_error", "deployment_lost" and "succeed".
+	Status *string `json:"status,omitempty"`
+}
+
+// CreatePagesDeployment creates a GitHub Pages deployment from an artifact.
+// It is meant to be called from a GitHub Actions workflow run, which
+// provides the artifact and OIDC token.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#create-a-github-pages-deployment
+//
+//meta:operation POST /repos/{owner}/{repo}/pages/deployments
+func (s *RepositoriesService) CreatePagesDeployment(ctx context.Context, owner, repo string, request *CreatePagesDeploymentRequest) (*PagesDeployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pages/deployments", PathEscape(owner), PathEscape(repo))
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment := new(PagesDeployment)
+	resp, err := s.client.Do(ctx, req, deployment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployment, resp, nil
+}
+
+// GetPagesDeploymentStatus gets the status of a GitHub Pages deployment.
+// deploymentID is the ID of the deployment or the commit SHA it was created for.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#get-the-status-of-a-github-pages-deployment
+//
+//meta:operation GET /repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}
+func (s *RepositoriesService) GetPagesDeploymentStatus(ctx context.Context, owner, repo, deploymentID string) (*PagesDeploymentStatus, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pages/deployments/%v", PathEscape(owner), PathEscape(repo), PathEscape(deploymentID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(PagesDeploymentStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
+
+// CancelPagesDeployment cancels a GitHub Pages deployment that is in progress.
+// deploymentID is the ID of the deployment or the commit SHA it was created for.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#cancel-a-github-pages-deployment
+//
+//meta:operation POST /repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}/cancel
+func (s *RepositoriesService) CancelPagesDeployment(ctx context.Context, owner, repo, deploymentID string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pages/deployments/%v/cancel", PathEscape(owner), PathEscape(repo), PathEscape(deploymentID))
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
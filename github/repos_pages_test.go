@@ -497,6 +497,219 @@ func TestRepositoriesService_GetPageHealthCheck(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetPageHealthCheck_caaError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pages/health", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"domain": {
+				"host": "example.com",
+				"uri": "http://example.com/",
+				"nameservers": "default",
+				"dns_resolves": true,
+				"is_apex_domain": true,
+				"should_be_a_record": true,
+				"is_pointed_to_github_pages_ip": true,
+				"is_served_by_pages": true,
+				"is_valid": true,
+				"responds_to_https": false,
+				"enforces_https": false,
+				"https_error": "Certificate not issued",
+				"is_https_eligible": false,
+				"caa_error": "CAA records for example.com do not allow letsencrypt.org to issue certificates"
+			},
+			"alt_domain": null
+		}`)
+	})
+
+	ctx := context.Background()
+	healthCheckResponse, _, err := client.Repositories.GetPageHealthCheck(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.GetPageHealthCheck returned error: %v", err)
+	}
+
+	want := &PagesHealthCheckResponse{
+		Domain: &PagesDomain{
+			Host:                     String("example.com"),
+			URI:                      String("http://example.com/"),
+			Nameservers:              String("default"),
+			DNSResolves:              Bool(true),
+			IsApexDomain:             Bool(true),
+			ShouldBeARecord:          Bool(true),
+			IsPointedToGithubPagesIP: Bool(true),
+			IsServedByPages:          Bool(true),
+			IsValid:                  Bool(true),
+			RespondsToHTTPS:          Bool(false),
+			EnforcesHTTPS:            Bool(false),
+			HTTPSError:               String("Certificate not issued"),
+			IsHTTPSEligible:          Bool(false),
+			CAAError:                 String("CAA records for example.com do not allow letsencrypt.org to issue certificates"),
+		},
+	}
+	if !cmp.Equal(healthCheckResponse, want) {
+		t.Errorf("Repositories.GetPageHealthCheck returned %+v, want %+v", healthCheckResponse, want)
+	}
+}
+
+func TestRepositoriesService_CreatePagesDeployment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreatePagesDeploymentRequest{
+		ArtifactID:        Int64(42),
+		Environment:       String("github-pages"),
+		PagesBuildVersion: String("4fd754f7e594640989b406850d0bc8f06a121251"),
+		OIDCToken:         String("eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCIsIng1dCI6IlV"),
+	}
+
+	mux.HandleFunc("/repos/o/r/pages/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"artifact_id":42,"environment":"github-pages","pages_build_version":"4fd754f7e594640989b406850d0bc8f06a121251","oidc_token":"eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCIsIng1dCI6IlV"}`+"\n")
+		fmt.Fprint(w, `{
+			"id": "4fd754f7e594640989b406850d0bc8f06a121251",
+			"status_url": "https://api.github.com/repos/o/r/pages/deployments/4fd754f7e594640989b406850d0bc8f06a121251/status",
+			"page_url": "https://o.github.io/r",
+			"preview_url": "https://monalisa-1231a2312sa2-23sda74.drafts.github.io"
+		}`)
+	})
+
+	ctx := context.Background()
+	deployment, _, err := client.Repositories.CreatePagesDeployment(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.CreatePagesDeployment returned error: %v", err)
+	}
+
+	want := &PagesDeployment{
+		ID:         String("4fd754f7e594640989b406850d0bc8f06a121251"),
+		StatusURL:  String("https://api.github.com/repos/o/r/pages/deployments/4fd754f7e594640989b406850d0bc8f06a121251/status"),
+		PageURL:    String("https://o.github.io/r"),
+		PreviewURL: String("https://monalisa-1231a2312sa2-23sda74.drafts.github.io"),
+	}
+	if !cmp.Equal(deployment, want) {
+		t.Errorf("Repositories.CreatePagesDeployment returned %+v, want %+v", deployment, want)
+	}
+
+	const methodName = "CreatePagesDeployment"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CreatePagesDeployment(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CreatePagesDeployment(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPagesDeployment_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		data    string
+		want    *PagesDeployment
+		wantErr bool
+	}{
+		"numeric ID": {
+			data: `{"id":123,"page_url":"https://o.github.io/r"}`,
+			want: &PagesDeployment{ID: String("123"), PageURL: String("https://o.github.io/r")},
+		},
+		"large numeric ID": {
+			data: `{"id":9007199254740993}`,
+			want: &PagesDeployment{ID: String("9007199254740993")},
+		},
+		"string ID": {
+			data: `{"id":"abc"}`,
+			want: &PagesDeployment{ID: String("abc")},
+		},
+		"no ID": {
+			data: `{}`,
+			want: &PagesDeployment{},
+		},
+		"invalid ID": {
+			data:    `{"id":true}`,
+			want:    &PagesDeployment{},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := new(PagesDeployment)
+			err := json.Unmarshal([]byte(tt.data), got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("json.Unmarshal returned error %v, want error: %v", err, tt.wantErr)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("json.Unmarshal = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_GetPagesDeploymentStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pages/deployments/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"status":"succeed"}`)
+	})
+
+	ctx := context.Background()
+	status, _, err := client.Repositories.GetPagesDeploymentStatus(ctx, "o", "r", "123")
+	if err != nil {
+		t.Errorf("Repositories.GetPagesDeploymentStatus returned error: %v", err)
+	}
+
+	want := &PagesDeploymentStatus{Status: String("succeed")}
+	if !cmp.Equal(status, want) {
+		t.Errorf("Repositories.GetPagesDeploymentStatus returned %+v, want %+v", status, want)
+	}
+
+	const methodName = "GetPagesDeploymentStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetPagesDeploymentStatus(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetPagesDeploymentStatus(ctx, "o", "r", "123")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_CancelPagesDeployment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pages/deployments/123/cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Repositories.CancelPagesDeployment(ctx, "o", "r", "123")
+	if err != nil {
+		t.Errorf("Repositories.CancelPagesDeployment returned error: %v", err)
+	}
+
+	const methodName = "CancelPagesDeployment"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.CancelPagesDeployment(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.CancelPagesDeployment(ctx, "o", "r", "123")
+	})
+}
+
 func TestPagesSource_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PagesSource{}, "{}")
 
@@ -556,6 +769,8 @@ func TestPages_Marshal(t *testing.T) {
 			Branch: String("branch"),
 			Path:   String("path"),
 		},
+		ProtectedDomainState:      String("verified"),
+		PendingDomainUnverifiedAt: &Timestamp{referenceTime},
 	}
 
 	want := `{
@@ -567,7 +782,9 @@ func TestPages_Marshal(t *testing.T) {
 		"source": {
 			"branch": "branch",
 			"path": "path"
-		}
+		},
+		"protected_domain_state": "verified",
+		"pending_domain_unverified_at": ` + referenceTimeStr + `
 	}`
 
 	testJSONMarshal(t, u, want)
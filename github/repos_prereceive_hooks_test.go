@@ -61,6 +61,8 @@ func TestRepositoriesService_ListPreReceiveHooks_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListPreReceiveHooks(ctx, "%", "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListPreReceiveHooks(ctx, "..", "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_GetPreReceiveHook(t *testing.T) {
@@ -106,6 +108,8 @@ func TestRepositoriesService_GetPreReceiveHook_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.GetPreReceiveHook(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.GetPreReceiveHook(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_UpdatePreReceiveHook(t *testing.T) {
@@ -159,6 +163,8 @@ func TestRepositoriesService_PreReceiveHook_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.UpdatePreReceiveHook(ctx, "%", "%", 1, nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.UpdatePreReceiveHook(ctx, "..", "..", 1, nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_DeletePreReceiveHook(t *testing.T) {
@@ -193,6 +199,8 @@ func TestRepositoriesService_DeletePreReceiveHook_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Repositories.DeletePreReceiveHook(ctx, "%", "%", 1)
 	testURLParseError(t, err)
+	_, err = client.Repositories.DeletePreReceiveHook(ctx, "..", "..", 1)
+	testPathForbiddenError(t, err)
 }
 
 func TestPreReceiveHook_Marshal(t *testing.T) {
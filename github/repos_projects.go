@@ -25,7 +25,7 @@ type ProjectListOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/projects
 func (s *RepositoriesService) ListProjects(ctx context.Context, owner, repo string, opts *ProjectListOptions) ([]*Project, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/projects", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/projects", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -54,7 +54,11 @@ func (s *RepositoriesService) ListProjects(ctx context.Context, owner, repo stri
 //
 //meta:operation POST /repos/{owner}/{repo}/projects
 func (s *RepositoriesService) CreateProject(ctx context.Context, owner, repo string, opts *ProjectOptions) (*Project, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/projects", owner, repo)
+	if opts != nil {
+		ctx = WithRequestMetadata(ctx, opts.Metadata)
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/projects", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
 		return nil, nil, err
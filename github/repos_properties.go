@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /repos/{owner}/{repo}/properties/values
 func (s *RepositoriesService) GetAllCustomPropertyValues(ctx context.Context, org, repo string) ([]*CustomPropertyValue, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/properties/values", org, repo)
+	u := fmt.Sprintf("repos/%v/%v/properties/values", PathEscape(org), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -38,7 +38,7 @@ func (s *RepositoriesService) GetAllCustomPropertyValues(ctx context.Context, or
 //
 //meta:operation PATCH /repos/{owner}/{repo}/properties/values
 func (s *RepositoriesService) CreateOrUpdateCustomProperties(ctx context.Context, org, repo string, customPropertyValues []*CustomPropertyValue) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/properties/values", org, repo)
+	u := fmt.Sprintf("repos/%v/%v/properties/values", PathEscape(org), PathEscape(repo))
 
 	params := struct {
 		Properties []*CustomPropertyValue `json:"properties"`
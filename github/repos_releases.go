@@ -7,16 +7,25 @@ package github
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"mime"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 )
 
+// ErrReleaseAssetVerification is returned by
+// RepositoriesService.UploadReleaseAssetFromReader when the uploaded asset
+// does not match the uploaded content.
+var ErrReleaseAssetVerification = errors.New("uploaded release asset does not match its content")
+
 // RepositoryRelease represents a GitHub release in a repository.
 type RepositoryRelease struct {
 	TagName         *string `json:"tag_name,omitempty"`
@@ -62,6 +71,20 @@ type GenerateNotesOptions struct {
 	TagName         string  `json:"tag_name"`
 	PreviousTagName *string `json:"previous_tag_name,omitempty"`
 	TargetCommitish *string `json:"target_commitish,omitempty"`
+	// ConfigurationFilePath is the path to a file in the repository containing
+	// the configuration settings for generating release notes. If unset,
+	// .github/release.yml or .github/release.yaml is used if present.
+	ConfigurationFilePath *string `json:"configuration_file_path,omitempty"`
+}
+
+// PublishReleaseOptions specifies optional parameters to the
+// RepositoriesService.PublishDraftRelease method.
+type PublishReleaseOptions struct {
+	// MakeLatest can be one of: "true", "false", or "legacy".
+	MakeLatest *string `json:"make_latest,omitempty"`
+	// DiscussionCategoryName creates a discussion of the given category
+	// linked to the release.
+	DiscussionCategoryName *string `json:"discussion_category_name,omitempty"`
 }
 
 // ReleaseAsset represents a GitHub release asset in a repository.
@@ -79,6 +102,9 @@ type ReleaseAsset struct {
 	BrowserDownloadURL *string    `json:"browser_download_url,omitempty"`
 	Uploader           *User      `json:"uploader,omitempty"`
 	NodeID             *string    `json:"node_id,omitempty"`
+	// Digest is the digest of the asset content, such as "sha256:<hex>".
+	// It is not set for assets uploaded before GitHub started computing digests.
+	Digest *string `json:"digest,omitempty"`
 }
 
 func (r ReleaseAsset) String() string {
@@ -91,7 +117,7 @@ func (r ReleaseAsset) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/releases
 func (s *RepositoriesService) ListReleases(ctx context.Context, owner, repo string, opts *ListOptions) ([]*RepositoryRelease, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases", owner, repo)
+	u := fmt.Sprintf("repos/%s/%s/releases", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -116,7 +142,7 @@ func (s *RepositoriesService) ListReleases(ctx context.Context, owner, repo stri
 //
 //meta:operation GET /repos/{owner}/{repo}/releases/{release_id}
 func (s *RepositoriesService) GetRelease(ctx context.Context, owner, repo string, id int64) (*RepositoryRelease, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%s/%s/releases/%d", PathEscape(owner), PathEscape(repo), id)
 	return s.getSingleRelease(ctx, u)
 }
 
@@ -126,7 +152,7 @@ func (s *RepositoriesService) GetRelease(ctx context.Context, owner, repo string
 //
 //meta:operation GET /repos/{owner}/{repo}/releases/latest
 func (s *RepositoriesService) GetLatestRelease(ctx context.Context, owner, repo string) (*RepositoryRelease, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/latest", owner, repo)
+	u := fmt.Sprintf("repos/%s/%s/releases/latest", PathEscape(owner), PathEscape(repo))
 	return s.getSingleRelease(ctx, u)
 }
 
@@ -136,7 +162,7 @@ func (s *RepositoriesService) GetLatestRelease(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/releases/tags/{tag}
 func (s *RepositoriesService) GetReleaseByTag(ctx context.Context, owner, repo, tag string) (*RepositoryRelease, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/tags/%s", owner, repo, tag)
+	u := fmt.Sprintf("repos/%s/%s/releases/tags/%s", PathEscape(owner), PathEscape(repo), PathEscape(tag))
 	return s.getSingleRelease(ctx, u)
 }
 
@@ -146,7 +172,7 @@ func (s *RepositoriesService) GetReleaseByTag(ctx context.Context, owner, repo,
 //
 //meta:operation POST /repos/{owner}/{repo}/releases/generate-notes
 func (s *RepositoriesService) GenerateReleaseNotes(ctx context.Context, owner, repo string, opts *GenerateNotesOptions) (*RepositoryReleaseNotes, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/generate-notes", owner, repo)
+	u := fmt.Sprintf("repos/%s/%s/releases/generate-notes", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -202,7 +228,7 @@ type repositoryReleaseRequest struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/releases
 func (s *RepositoriesService) CreateRelease(ctx context.Context, owner, repo string, release *RepositoryRelease) (*RepositoryRelease, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases", owner, repo)
+	u := fmt.Sprintf("repos/%s/%s/releases", PathEscape(owner), PathEscape(repo))
 
 	releaseReq := &repositoryReleaseRequest{
 		TagName:                release.TagName,
@@ -238,7 +264,7 @@ func (s *RepositoriesService) CreateRelease(ctx context.Context, owner, repo str
 //
 //meta:operation PATCH /repos/{owner}/{repo}/releases/{release_id}
 func (s *RepositoriesService) EditRelease(ctx context.Context, owner, repo string, id int64, release *RepositoryRelease) (*RepositoryRelease, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%s/%s/releases/%d", PathEscape(owner), PathEscape(repo), id)
 
 	releaseReq := &repositoryReleaseRequest{
 		TagName:                release.TagName,
@@ -264,13 +290,82 @@ func (s *RepositoriesService) EditRelease(ctx context.Context, owner, repo strin
 	return r, resp, nil
 }
 
+// CreateReleaseWithGeneratedNotes generates release notes for release and
+// then creates it.
+//
+// The notes are generated for release.TagName and release.TargetCommitish
+// unless opts sets them. The generated name is used if release.Name is not
+// set, and the generated body is appended to release.Body after a blank line.
+// release itself is not modified.
+//
+// Unlike setting GenerateReleaseNotes on CreateRelease, this allows choosing
+// the previous tag and configuration file the notes are generated from.
+//
+// GitHub API docs: https://docs.github.com/rest/releases/releases#create-a-release
+// GitHub API docs: https://docs.github.com/rest/releases/releases#generate-release-notes-content-for-a-release
+//
+//meta:operation POST /repos/{owner}/{repo}/releases
+//meta:operation POST /repos/{owner}/{repo}/releases/generate-notes
+func (s *RepositoriesService) CreateReleaseWithGeneratedNotes(ctx context.Context, owner, repo string, release *RepositoryRelease, opts *GenerateNotesOptions) (*RepositoryRelease, *Response, error) {
+	if release == nil {
+		return nil, nil, errors.New("release must be provided")
+	}
+
+	notesOpts := &GenerateNotesOptions{}
+	if opts != nil {
+		*notesOpts = *opts
+	}
+	if notesOpts.TagName == "" {
+		notesOpts.TagName = release.GetTagName()
+	}
+	if notesOpts.TargetCommitish == nil {
+		notesOpts.TargetCommitish = release.TargetCommitish
+	}
+
+	notes, resp, err := s.GenerateReleaseNotes(ctx, owner, repo, notesOpts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	r := *release
+	if r.Name == nil {
+		r.Name = String(notes.Name)
+	}
+	body := notes.Body
+	if r.GetBody() != "" {
+		body = r.GetBody() + "\n\n" + body
+	}
+	r.Body = String(body)
+	// The notes are already in the body, so don't have GitHub add them again.
+	r.GenerateReleaseNotes = nil
+
+	return s.CreateRelease(ctx, owner, repo, &r)
+}
+
+// PublishDraftRelease publishes a draft release by setting draft to false.
+//
+// GitHub API docs: https://docs.github.com/rest/releases/releases#update-a-release
+//
+//meta:operation PATCH /repos/{owner}/{repo}/releases/{release_id}
+func (s *RepositoriesService) PublishDraftRelease(ctx context.Context, owner, repo string, id int64, opts *PublishReleaseOptions) (*RepositoryRelease, *Response, error) {
+	if opts == nil {
+		opts = &PublishReleaseOptions{}
+	}
+
+	return s.EditRelease(ctx, owner, repo, id, &RepositoryRelease{
+		Draft:                  Bool(false),
+		MakeLatest:             opts.MakeLatest,
+		DiscussionCategoryName: opts.DiscussionCategoryName,
+	})
+}
+
 // DeleteRelease delete a single release from a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/releases/releases#delete-a-release
 //
 //meta:operation DELETE /repos/{owner}/{repo}/releases/{release_id}
 func (s *RepositoriesService) DeleteRelease(ctx context.Context, owner, repo string, id int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%s/%s/releases/%d", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -285,7 +380,7 @@ func (s *RepositoriesService) DeleteRelease(ctx context.Context, owner, repo str
 //
 //meta:operation GET /repos/{owner}/{repo}/releases/{release_id}/assets
 func (s *RepositoriesService) ListReleaseAssets(ctx context.Context, owner, repo string, id int64, opts *ListOptions) ([]*ReleaseAsset, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", owner, repo, id)
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", PathEscape(owner), PathEscape(repo), id)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -310,7 +405,7 @@ func (s *RepositoriesService) ListReleaseAssets(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/releases/assets/{asset_id}
 func (s *RepositoriesService) GetReleaseAsset(ctx context.Context, owner, repo string, id int64) (*ReleaseAsset, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -341,7 +436,7 @@ func (s *RepositoriesService) GetReleaseAsset(ctx context.Context, owner, repo s
 //
 //meta:operation GET /repos/{owner}/{repo}/releases/assets/{asset_id}
 func (s *RepositoriesService) DownloadReleaseAsset(ctx context.Context, owner, repo string, id int64, followRedirectsClient *http.Client) (rc io.ReadCloser, redirectURL string, err error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -367,7 +462,7 @@ func (s *RepositoriesService) DownloadReleaseAsset(ctx context.Context, owner, r
 			return nil, "", err
 		}
 		if followRedirectsClient != nil {
-			rc, err := s.downloadReleaseAssetFromURL(ctx, followRedirectsClient, loc)
+			rc, err := s.client.downloadRedirect(ctx, followRedirectsClient, loc)
 			return rc, "", err
 		}
 		return nil, loc, nil // Intentionally return no error with valid redirect URL.
@@ -381,22 +476,27 @@ func (s *RepositoriesService) DownloadReleaseAsset(ctx context.Context, owner, r
 	return resp.Body, "", nil
 }
 
-func (s *RepositoriesService) downloadReleaseAssetFromURL(ctx context.Context, followRedirectsClient *http.Client, url string) (rc io.ReadCloser, err error) {
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-	req = withContext(ctx, req)
-	req.Header.Set("Accept", "*/*")
-	resp, err := followRedirectsClient.Do(req)
+// DownloadReleaseAssetStream returns a reader for the contents of a release
+// asset, following the redirect GitHub may respond with. The redirect is
+// followed with followRedirectsClient, or if it is nil, with a client that
+// does not forward the credentials of this client, as the storage host
+// rejects them. A nil followRedirectsClient is therefore suitable for private
+// repositories as well.
+//
+// It is the caller's responsibility to close the reader.
+//
+// GitHub API docs: https://docs.github.com/rest/releases/assets#get-a-release-asset
+//
+//meta:operation GET /repos/{owner}/{repo}/releases/assets/{asset_id}
+func (s *RepositoriesService) DownloadReleaseAssetStream(ctx context.Context, owner, repo string, id int64, followRedirectsClient *http.Client) (io.ReadCloser, error) {
+	rc, redirectURL, err := s.DownloadReleaseAsset(ctx, owner, repo, id, nil)
 	if err != nil {
 		return nil, err
 	}
-	if err := CheckResponse(resp); err != nil {
-		_ = resp.Body.Close()
-		return nil, err
+	if rc != nil {
+		return rc, nil
 	}
-	return resp.Body, nil
+	return s.client.downloadRedirect(ctx, followRedirectsClient, redirectURL)
 }
 
 // EditReleaseAsset edits a repository release asset.
@@ -405,7 +505,7 @@ func (s *RepositoriesService) downloadReleaseAssetFromURL(ctx context.Context, f
 //
 //meta:operation PATCH /repos/{owner}/{repo}/releases/assets/{asset_id}
 func (s *RepositoriesService) EditReleaseAsset(ctx context.Context, owner, repo string, id int64, release *ReleaseAsset) (*ReleaseAsset, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("PATCH", u, release)
 	if err != nil {
@@ -426,7 +526,7 @@ func (s *RepositoriesService) EditReleaseAsset(ctx context.Context, owner, repo
 //
 //meta:operation DELETE /repos/{owner}/{repo}/releases/assets/{asset_id}
 func (s *RepositoriesService) DeleteReleaseAsset(ctx context.Context, owner, repo string, id int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", owner, repo, id)
+	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", PathEscape(owner), PathEscape(repo), id)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -442,7 +542,7 @@ func (s *RepositoriesService) DeleteReleaseAsset(ctx context.Context, owner, rep
 //
 //meta:operation POST /repos/{owner}/{repo}/releases/{release_id}/assets
 func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, repo string, id int64, opts *UploadOptions, file *os.File) (*ReleaseAsset, *Response, error) {
-	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", owner, repo, id)
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", PathEscape(owner), PathEscape(repo), id)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -473,3 +573,163 @@ func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, rep
 	}
 	return asset, resp, nil
 }
+
+// UploadReleaseAssetOptions specifies the parameters to the
+// RepositoriesService.UploadReleaseAssetFromReader method.
+type UploadReleaseAssetOptions struct {
+	UploadOptions
+
+	// MaxRetries is the number of times a failed upload is retried.
+	MaxRetries int `url:"-"`
+	// VerifySHA256 compares the SHA-256 digest of the content with the digest
+	// GitHub reports for the uploaded asset, if it reports one.
+	VerifySHA256 bool `url:"-"`
+}
+
+// UploadReleaseAssetFromReader creates an asset named opts.Name by uploading
+// size bytes read from reader into a release.
+//
+// An upload interrupted by a network or server error leaves behind an asset
+// in the "starting" state, which blocks further uploads with the same name.
+// Up to opts.MaxRetries times, such an asset is deleted and the upload
+// retried. An upload that conflicts with a complete asset of the same name is
+// not retried.
+//
+// Once uploaded, the asset's size, and with opts.VerifySHA256 its digest, are
+// compared with the content. If they do not match, the asset is deleted and
+// an error wrapping ErrReleaseAssetVerification is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/releases/assets#delete-a-release-asset
+// GitHub API docs: https://docs.github.com/rest/releases/assets#list-release-assets
+// GitHub API docs: https://docs.github.com/rest/releases/assets#upload-a-release-asset
+//
+//meta:operation DELETE /repos/{owner}/{repo}/releases/assets/{asset_id}
+//meta:operation GET /repos/{owner}/{repo}/releases/{release_id}/assets
+//meta:operation POST /repos/{owner}/{repo}/releases/{release_id}/assets
+func (s *RepositoriesService) UploadReleaseAssetFromReader(ctx context.Context, owner, repo string, id int64, opts *UploadReleaseAssetOptions, reader io.ReaderAt, size int64) (*ReleaseAsset, *Response, error) {
+	if opts == nil || opts.Name == "" {
+		return nil, nil, errors.New("the asset name must be provided")
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", PathEscape(owner), PathEscape(repo), id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mediaType := mime.TypeByExtension(filepath.Ext(opts.Name))
+	if opts.MediaType != "" {
+		mediaType = opts.MediaType
+	}
+
+	var digest string
+	if opts.VerifySHA256 {
+		h := sha256.New()
+		if _, err := io.Copy(h, io.NewSectionReader(reader, 0, size)); err != nil {
+			return nil, nil, err
+		}
+		digest = "sha256:" + hex.EncodeToString(h.Sum(nil))
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := s.client.NewUploadRequest(u, io.NewSectionReader(reader, 0, size), size, mediaType)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		asset := new(ReleaseAsset)
+		resp, err := s.client.Do(ctx, req, asset)
+		if err == nil {
+			if err := verifyReleaseAsset(asset, size, digest); err != nil {
+				if _, derr := s.DeleteReleaseAsset(ctx, owner, repo, asset.GetID()); derr != nil {
+					return nil, resp, fmt.Errorf("%w (deleting the asset failed: %v)", err, derr)
+				}
+				return nil, resp, err
+			}
+			return asset, resp, nil
+		}
+
+		// An asset left in the "starting" state by an earlier upload makes
+		// GitHub reject the upload as a conflict; it is retried once the
+		// asset is deleted below.
+		conflict := resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && ctx.Err() == nil
+		if attempt >= opts.MaxRetries || !(conflict || isRetryableUploadError(ctx, err)) {
+			return nil, resp, err
+		}
+
+		deleted, dresp, derr := s.deletePartialReleaseAsset(ctx, owner, repo, id, opts.Name)
+		if derr != nil {
+			return nil, dresp, derr
+		}
+		// A conflict with a complete asset won't go away by retrying.
+		if conflict && !deleted {
+			return nil, resp, err
+		}
+	}
+}
+
+// verifyReleaseAsset checks that asset has the given size and, if digest is
+// not empty and GitHub reported one, the given digest.
+func verifyReleaseAsset(asset *ReleaseAsset, size int64, digest string) error {
+	if asset.Size != nil && int64(*asset.Size) != size {
+		return fmt.Errorf("%w: size is %v, want %v", ErrReleaseAssetVerification, *asset.Size, size)
+	}
+	if digest != "" && asset.Digest != nil && *asset.Digest != digest {
+		return fmt.Errorf("%w: digest is %v, want %v", ErrReleaseAssetVerification, *asset.Digest, digest)
+	}
+	return nil
+}
+
+// isRetryableUploadError reports whether a failed upload may succeed if
+// retried: a network error, a connection closed before the response was
+// complete, or a server error.
+func isRetryableUploadError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp.Response.StatusCode >= http.StatusInternalServerError
+	}
+
+	// Every error of http.Client.Do is a *url.Error, which is a net.Error
+	// itself, so look at the error it wraps.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// deletePartialReleaseAsset deletes the asset called name of the release if
+// its upload has not completed. It reports whether such an asset was deleted.
+func (s *RepositoriesService) deletePartialReleaseAsset(ctx context.Context, owner, repo string, id int64, name string) (bool, *Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	for {
+		assets, resp, err := s.ListReleaseAssets(ctx, owner, repo, id, opts)
+		if err != nil {
+			return false, resp, err
+		}
+
+		for _, asset := range assets {
+			if asset.GetName() != name {
+				continue
+			}
+			if asset.GetState() != "starting" {
+				return false, resp, nil
+			}
+			resp, err := s.DeleteReleaseAsset(ctx, owner, repo, asset.GetID())
+			if err != nil {
+				return false, resp, err
+			}
+			return true, resp, nil
+		}
+
+		if resp.NextPage == 0 {
+			return false, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
@@ -9,9 +9,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
@@ -96,6 +99,33 @@ func TestRepositoriesService_GenerateReleaseNotes(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GenerateReleaseNotes_allOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/generate-notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"tag_name":"v1.0.0","previous_tag_name":"v0.9.0","target_commitish":"main","configuration_file_path":".github/custom_release_config.yml"}`+"\n")
+		fmt.Fprint(w, `{"name":"v1.0.0","body":"notes"}`)
+	})
+
+	opt := &GenerateNotesOptions{
+		TagName:               "v1.0.0",
+		PreviousTagName:       String("v0.9.0"),
+		TargetCommitish:       String("main"),
+		ConfigurationFilePath: String(".github/custom_release_config.yml"),
+	}
+	ctx := context.Background()
+	notes, _, err := client.Repositories.GenerateReleaseNotes(ctx, "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.GenerateReleaseNotes returned error: %v", err)
+	}
+	want := &RepositoryReleaseNotes{Name: "v1.0.0", Body: "notes"}
+	if !cmp.Equal(notes, want) {
+		t.Errorf("Repositories.GenerateReleaseNotes returned %+v, want %+v", notes, want)
+	}
+}
+
 func TestRepositoriesService_GetRelease(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -329,6 +359,124 @@ func TestRepositoriesService_EditRelease(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateReleaseWithGeneratedNotes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/generate-notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"tag_name":"v1.0.0","previous_tag_name":"v0.9.0","target_commitish":"main"}`+"\n")
+		fmt.Fprint(w, `{"name":"Release v1.0.0","body":"## What's Changed"}`)
+	})
+	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"tag_name":"v1.0.0","target_commitish":"main","name":"Release v1.0.0","body":"Intro\n\n## What's Changed","draft":true}`+"\n")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	input := &RepositoryRelease{
+		TagName:              String("v1.0.0"),
+		TargetCommitish:      String("main"),
+		Body:                 String("Intro"),
+		Draft:                Bool(true),
+		GenerateReleaseNotes: Bool(true),
+	}
+	opt := &GenerateNotesOptions{PreviousTagName: String("v0.9.0")}
+	ctx := context.Background()
+	release, _, err := client.Repositories.CreateReleaseWithGeneratedNotes(ctx, "o", "r", input, opt)
+	if err != nil {
+		t.Errorf("Repositories.CreateReleaseWithGeneratedNotes returned error: %v", err)
+	}
+	want := &RepositoryRelease{ID: Int64(1)}
+	if !cmp.Equal(release, want) {
+		t.Errorf("Repositories.CreateReleaseWithGeneratedNotes returned %+v, want %+v", release, want)
+	}
+	if input.Name != nil || input.GetBody() != "Intro" || opt.TagName != "" {
+		t.Errorf("Repositories.CreateReleaseWithGeneratedNotes modified its arguments: %+v, %+v", input, opt)
+	}
+
+	const methodName = "CreateReleaseWithGeneratedNotes"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CreateReleaseWithGeneratedNotes(ctx, "\n", "\n", input, opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CreateReleaseWithGeneratedNotes(ctx, "o", "r", input, opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_CreateReleaseWithGeneratedNotes_nilRelease(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.CreateReleaseWithGeneratedNotes(ctx, "o", "r", nil, nil)
+	if err == nil {
+		t.Error("Expected error to be returned because nil release was passed")
+	}
+}
+
+func TestRepositoriesService_PublishDraftRelease(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		// make_latest is sent as a string, not a bool.
+		testBody(t, r, `{"draft":false,"make_latest":"false","discussion_category_name":"Announcements"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"draft":false}`)
+	})
+
+	opt := &PublishReleaseOptions{
+		MakeLatest:             String("false"),
+		DiscussionCategoryName: String("Announcements"),
+	}
+	ctx := context.Background()
+	release, _, err := client.Repositories.PublishDraftRelease(ctx, "o", "r", 1, opt)
+	if err != nil {
+		t.Errorf("Repositories.PublishDraftRelease returned error: %v", err)
+	}
+	want := &RepositoryRelease{ID: Int64(1), Draft: Bool(false)}
+	if !cmp.Equal(release, want) {
+		t.Errorf("Repositories.PublishDraftRelease returned %+v, want %+v", release, want)
+	}
+
+	const methodName = "PublishDraftRelease"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.PublishDraftRelease(ctx, "\n", "\n", 1, opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.PublishDraftRelease(ctx, "o", "r", 1, opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_PublishDraftRelease_nilOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"draft":false}`+"\n")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.PublishDraftRelease(ctx, "o", "r", 1, nil); err != nil {
+		t.Errorf("Repositories.PublishDraftRelease returned error: %v", err)
+	}
+}
+
 func TestRepositoriesService_DeleteRelease(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -512,6 +660,118 @@ func TestRepositoriesService_DownloadReleaseAsset_FollowRedirect(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_DownloadReleaseAssetStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testHeader(t, r, "Authorization", "Bearer token")
+		http.Redirect(w, r, baseURLPath+"/yo", http.StatusFound)
+	})
+	mux.HandleFunc("/yo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "")
+		fmt.Fprint(w, "Hello World")
+	})
+
+	client = client.WithAuthToken("token")
+	ctx := context.Background()
+	reader, err := client.Repositories.DownloadReleaseAssetStream(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadReleaseAssetStream returned error: %v", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Errorf("Reading Repositories.DownloadReleaseAssetStream returned error: %v", err)
+	}
+	if want := "Hello World"; string(content) != want {
+		t.Errorf("Repositories.DownloadReleaseAssetStream returned %q, want %q", content, want)
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAssetStream_followRedirectsClient(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, baseURLPath+"/yo", http.StatusFound)
+	})
+	mux.HandleFunc("/yo", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "X-Download", "yes")
+		fmt.Fprint(w, "Hello World")
+	})
+
+	var downloads int
+	followRedirectsClient := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		downloads++
+		req.Header.Set("X-Download", "yes")
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+
+	ctx := context.Background()
+	reader, err := client.Repositories.DownloadReleaseAssetStream(ctx, "o", "r", 1, followRedirectsClient)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadReleaseAssetStream returned error: %v", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Errorf("Reading Repositories.DownloadReleaseAssetStream returned error: %v", err)
+	}
+	if want := "Hello World"; string(content) != want {
+		t.Errorf("Repositories.DownloadReleaseAssetStream returned %q, want %q", content, want)
+	}
+	if downloads != 1 {
+		t.Errorf("Repositories.DownloadReleaseAssetStream made %v requests with followRedirectsClient, want 1", downloads)
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAssetStream_noRedirect(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "Hello World")
+	})
+
+	ctx := context.Background()
+	reader, err := client.Repositories.DownloadReleaseAssetStream(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadReleaseAssetStream returned error: %v", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Errorf("Reading Repositories.DownloadReleaseAssetStream returned error: %v", err)
+	}
+	if want := "Hello World"; string(content) != want {
+		t.Errorf("Repositories.DownloadReleaseAssetStream returned %q, want %q", content, want)
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAssetStream_APIError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	})
+
+	ctx := context.Background()
+	reader, err := client.Repositories.DownloadReleaseAssetStream(ctx, "o", "r", 1, nil)
+	if err == nil {
+		t.Error("Repositories.DownloadReleaseAssetStream did not return an error")
+	}
+	if reader != nil {
+		t.Errorf("Repositories.DownloadReleaseAssetStream returned a reader, want nil")
+	}
+}
+
 func TestRepositoriesService_DownloadReleaseAsset_FollowRedirectToError(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -731,6 +991,224 @@ func TestRepositoriesService_UploadReleaseAsset(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_UploadReleaseAssetFromReader_retry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	content := "Upload me !\n"
+	uploads, deletes := 0, 0
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testFormValues(t, r, values{"per_page": "100"})
+			if uploads == 1 && deletes == 0 {
+				fmt.Fprint(w, `[{"id":2,"name":"other","state":"starting"},{"id":7,"name":"n.txt","state":"starting"}]`)
+				return
+			}
+			fmt.Fprint(w, `[]`)
+		case "POST":
+			uploads++
+			testFormValues(t, r, values{"name": "n.txt", "label": "l"})
+			testHeader(t, r, "Content-Type", "text/plain; charset=utf-8")
+			testHeader(t, r, "Content-Length", "12")
+			if uploads == 1 {
+				// Simulate the connection dropping mid-upload.
+				buf := make([]byte, 4)
+				_, _ = io.ReadFull(r.Body, buf)
+				conn, _, err := w.(http.Hijacker).Hijack()
+				if err != nil {
+					t.Errorf("Hijack returned error: %v", err)
+					return
+				}
+				conn.Close()
+				return
+			}
+			testBody(t, r, content)
+			fmt.Fprint(w, `{"id":8,"name":"n.txt","state":"uploaded","size":12,"digest":"sha256:f071e46306ce9db75d20fe38490dca91ee02f35525e6b46e0e5b1c8e7f8e79da"}`)
+		default:
+			t.Errorf("Request method: %v, want GET or POST", r.Method)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/releases/assets/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deletes++
+	})
+
+	opts := &UploadReleaseAssetOptions{
+		UploadOptions: UploadOptions{Name: "n.txt", Label: "l"},
+		MaxRetries:    1,
+		VerifySHA256:  true,
+	}
+	ctx := context.Background()
+	asset, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, opts, strings.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Repositories.UploadReleaseAssetFromReader returned error: %v", err)
+	}
+	want := &ReleaseAsset{
+		ID:     Int64(8),
+		Name:   String("n.txt"),
+		State:  String("uploaded"),
+		Size:   Int(12),
+		Digest: String("sha256:f071e46306ce9db75d20fe38490dca91ee02f35525e6b46e0e5b1c8e7f8e79da"),
+	}
+	if !cmp.Equal(asset, want) {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned %+v, want %+v", asset, want)
+	}
+	if uploads != 2 || deletes != 1 {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader made %v uploads and %v deletes, want 2 and 1", uploads, deletes)
+	}
+
+	const methodName = "UploadReleaseAssetFromReader"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.UploadReleaseAssetFromReader(ctx, "\n", "\n", 1, opts, strings.NewReader(content), int64(len(content)))
+		return err
+	})
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_noRetries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	uploads := 0
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		uploads++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	opts := &UploadReleaseAssetOptions{UploadOptions: UploadOptions{Name: "n"}}
+	ctx := context.Background()
+	_, resp, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, opts, strings.NewReader("x"), 1)
+	if err == nil {
+		t.Fatal("Repositories.UploadReleaseAssetFromReader returned no error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned response %+v, want status 502", resp)
+	}
+	if uploads != 1 {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader made %v uploads, want 1", uploads)
+	}
+}
+
+func TestIsRetryableUploadError(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errorResponse := func(status int) error {
+		return &ErrorResponse{Response: &http.Response{StatusCode: status}}
+	}
+	urlError := func(err error) error {
+		return &url.Error{Op: "Post", URL: "https://uploads.github.com", Err: err}
+	}
+
+	tests := map[string]struct {
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		"server error":        {err: errorResponse(http.StatusBadGateway), want: true},
+		"client error":        {err: errorResponse(http.StatusNotFound)},
+		"conflict":            {err: errorResponse(http.StatusUnprocessableEntity)},
+		"connection closed":   {err: urlError(io.EOF), want: true},
+		"unexpected EOF":      {err: urlError(io.ErrUnexpectedEOF), want: true},
+		"network error":       {err: urlError(&net.OpError{Op: "write", Err: errors.New("broken pipe")}), want: true},
+		"other request error": {err: urlError(errors.New("unsupported protocol scheme"))},
+		"other error":         {err: errors.New("boom")},
+		"rate limit":          {err: &RateLimitError{Response: &http.Response{StatusCode: http.StatusForbidden}}},
+		"canceled context":    {ctx: canceled, err: urlError(io.EOF)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := test.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			if got := isRetryableUploadError(ctx, test.err); got != test.want {
+				t.Errorf("isRetryableUploadError(%v) = %v, want %v", test.err, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_duplicateName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	uploads := 0
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[{"id":7,"name":"n","state":"uploaded"}]`)
+		case "POST":
+			uploads++
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"ReleaseAsset","code":"already_exists","field":"name"}]}`)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/releases/assets/7", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("complete asset should not be deleted")
+	})
+
+	opts := &UploadReleaseAssetOptions{UploadOptions: UploadOptions{Name: "n"}, MaxRetries: 3}
+	ctx := context.Background()
+	_, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, opts, strings.NewReader("x"), 1)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned error %v, want a 422 ErrorResponse", err)
+	}
+	if uploads != 1 {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader made %v uploads, want 1", uploads)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_verification(t *testing.T) {
+	tests := map[string]string{
+		"size":   `{"id":8,"size":3}`,
+		"digest": `{"id":8,"size":1,"digest":"sha256:0000"}`,
+	}
+	for name, response := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				fmt.Fprint(w, response)
+			})
+			deleted := false
+			mux.HandleFunc("/repos/o/r/releases/assets/8", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				deleted = true
+			})
+
+			opts := &UploadReleaseAssetOptions{UploadOptions: UploadOptions{Name: "n"}, VerifySHA256: true}
+			ctx := context.Background()
+			asset, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, opts, strings.NewReader("x"), 1)
+			if !errors.Is(err, ErrReleaseAssetVerification) {
+				t.Errorf("Repositories.UploadReleaseAssetFromReader returned error %v, want ErrReleaseAssetVerification", err)
+			}
+			if asset != nil {
+				t.Errorf("Repositories.UploadReleaseAssetFromReader returned %+v, want nil", asset)
+			}
+			if !deleted {
+				t.Error("Repositories.UploadReleaseAssetFromReader did not delete the mismatched asset")
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_noName(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, nil, strings.NewReader("x"), 1)
+	if err == nil {
+		t.Error("Expected error to be returned because no asset name was passed")
+	}
+}
+
 func TestRepositoryReleaseRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &repositoryReleaseRequest{}, "{}")
 
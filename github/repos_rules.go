@@ -48,12 +48,43 @@ type RulesetRepositoryIDsConditionParameters struct {
 	RepositoryIDs []int64 `json:"repository_ids,omitempty"`
 }
 
+// RulesetRepositoryPropertyTargetParameters represents a custom property
+// condition of a ruleset.
+type RulesetRepositoryPropertyTargetParameters struct {
+	Name   string   `json:"name"`
+	Values []string `json:"property_values"`
+	// Possible values for Source are: custom, system
+	Source *string `json:"source,omitempty"`
+}
+
+// RulesetRepositoryPropertyConditionParameters represents the conditions object for repository_property.
+type RulesetRepositoryPropertyConditionParameters struct {
+	Include []*RulesetRepositoryPropertyTargetParameters `json:"include"`
+	Exclude []*RulesetRepositoryPropertyTargetParameters `json:"exclude"`
+}
+
+// RulesetOrganizationNamesConditionParameters represents the conditions object for organization_name.
+type RulesetOrganizationNamesConditionParameters struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// RulesetOrganizationIDsConditionParameters represents the conditions object for organization_id.
+type RulesetOrganizationIDsConditionParameters struct {
+	OrganizationIDs []int64 `json:"organization_ids,omitempty"`
+}
+
 // RulesetConditions represents the conditions object in a ruleset.
-// Set either RepositoryName or RepositoryID, not both.
+// Set either RepositoryName, RepositoryID or RepositoryProperty, not several
+// of them. OrganizationName and OrganizationID only apply to enterprise
+// rulesets, which must set one of them.
 type RulesetConditions struct {
-	RefName        *RulesetRefConditionParameters             `json:"ref_name,omitempty"`
-	RepositoryName *RulesetRepositoryNamesConditionParameters `json:"repository_name,omitempty"`
-	RepositoryID   *RulesetRepositoryIDsConditionParameters   `json:"repository_id,omitempty"`
+	RefName            *RulesetRefConditionParameters                `json:"ref_name,omitempty"`
+	RepositoryName     *RulesetRepositoryNamesConditionParameters    `json:"repository_name,omitempty"`
+	RepositoryID       *RulesetRepositoryIDsConditionParameters      `json:"repository_id,omitempty"`
+	RepositoryProperty *RulesetRepositoryPropertyConditionParameters `json:"repository_property,omitempty"`
+	OrganizationName   *RulesetOrganizationNamesConditionParameters  `json:"organization_name,omitempty"`
+	OrganizationID     *RulesetOrganizationIDsConditionParameters    `json:"organization_id,omitempty"`
 }
 
 // RulePatternParameters represents the rule pattern parameters.
@@ -110,6 +141,33 @@ type RequiredWorkflowsRuleParameters struct {
 	RequiredWorkflows []*RuleRequiredWorkflow `json:"workflows"`
 }
 
+// The merge methods of a merge queue, as in MergeQueueRuleParameters.MergeMethod.
+const (
+	MergeQueueMergeMethodMerge  = "MERGE"
+	MergeQueueMergeMethodSquash = "SQUASH"
+	MergeQueueMergeMethodRebase = "REBASE"
+)
+
+// The grouping strategies of a merge queue, as in
+// MergeQueueRuleParameters.GroupingStrategy.
+const (
+	MergeQueueGroupingAllGreen  = "ALLGREEN"
+	MergeQueueGroupingHeadGreen = "HEADGREEN"
+)
+
+// MergeQueueRuleParameters represents the merge_queue rule parameters.
+type MergeQueueRuleParameters struct {
+	CheckResponseTimeoutMinutes int `json:"check_response_timeout_minutes"`
+	// Possible values for GroupingStrategy are: ALLGREEN, HEADGREEN
+	GroupingStrategy  string `json:"grouping_strategy"`
+	MaxEntriesToBuild int    `json:"max_entries_to_build"`
+	MaxEntriesToMerge int    `json:"max_entries_to_merge"`
+	// Possible values for MergeMethod are: MERGE, SQUASH, REBASE
+	MergeMethod                  string `json:"merge_method"`
+	MinEntriesToMerge            int    `json:"min_entries_to_merge"`
+	MinEntriesToMergeWaitMinutes int    `json:"min_entries_to_merge_wait_minutes"`
+}
+
 // RepositoryRule represents a GitHub Rule.
 type RepositoryRule struct {
 	Type              string           `json:"type"`
@@ -134,8 +192,22 @@ func (r *RepositoryRule) UnmarshalJSON(data []byte) error {
 	r.Type = RepositoryRule.Type
 
 	switch RepositoryRule.Type {
-	case "creation", "deletion", "merge_queue", "non_fast_forward", "required_linear_history", "required_signatures":
+	case "creation", "deletion", "non_fast_forward", "required_linear_history", "required_signatures":
 		r.Parameters = nil
+	case "merge_queue":
+		if RepositoryRule.Parameters == nil {
+			r.Parameters = nil
+			return nil
+		}
+		params := MergeQueueRuleParameters{}
+		if err := json.Unmarshal(*RepositoryRule.Parameters, &params); err != nil {
+			return err
+		}
+
+		bytes, _ := json.Marshal(params)
+		rawParams := json.RawMessage(bytes)
+
+		r.Parameters = &rawParams
 	case "update":
 		if RepositoryRule.Parameters == nil {
 			r.Parameters = nil
@@ -210,8 +282,19 @@ func (r *RepositoryRule) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// NewMergeQueueRule creates a rule to only allow merges via a merge queue.
-func NewMergeQueueRule() (rule *RepositoryRule) {
+// NewMergeQueueRule creates a rule to only allow merges via a merge queue,
+// configured by params if not nil.
+func NewMergeQueueRule(params *MergeQueueRuleParameters) (rule *RepositoryRule) {
+	if params != nil {
+		bytes, _ := json.Marshal(params)
+
+		rawParams := json.RawMessage(bytes)
+
+		return &RepositoryRule{
+			Type:       "merge_queue",
+			Parameters: &rawParams,
+		}
+	}
 	return &RepositoryRule{
 		Type: "merge_queue",
 	}
@@ -383,7 +466,7 @@ type Ruleset struct {
 	Name string `json:"name"`
 	// Possible values for Target are branch, tag
 	Target *string `json:"target,omitempty"`
-	// Possible values for SourceType are: Repository, Organization
+	// Possible values for SourceType are: Repository, Organization, Enterprise
 	SourceType *string `json:"source_type,omitempty"`
 	Source     string  `json:"source"`
 	// Possible values for Enforcement are: disabled, active, evaluate
@@ -401,7 +484,7 @@ type Ruleset struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/rules/branches/{branch}
 func (s *RepositoriesService) GetRulesForBranch(ctx context.Context, owner, repo, branch string) ([]*RepositoryRule, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/rules/branches/%v", owner, repo, branch)
+	u := fmt.Sprintf("repos/%v/%v/rules/branches/%v", PathEscape(owner), PathEscape(repo), PathEscape(branch))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -424,7 +507,7 @@ func (s *RepositoriesService) GetRulesForBranch(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/rulesets
 func (s *RepositoriesService) GetAllRulesets(ctx context.Context, owner, repo string, includesParents bool) ([]*Ruleset, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/rulesets?includes_parents=%v", owner, repo, includesParents)
+	u := fmt.Sprintf("repos/%v/%v/rulesets?includes_parents=%v", PathEscape(owner), PathEscape(repo), includesParents)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -446,7 +529,7 @@ func (s *RepositoriesService) GetAllRulesets(ctx context.Context, owner, repo st
 //
 //meta:operation POST /repos/{owner}/{repo}/rulesets
 func (s *RepositoriesService) CreateRuleset(ctx context.Context, owner, repo string, rs *Ruleset) (*Ruleset, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/rulesets", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/rulesets", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("POST", u, rs)
 	if err != nil {
@@ -469,7 +552,7 @@ func (s *RepositoriesService) CreateRuleset(ctx context.Context, owner, repo str
 //
 //meta:operation GET /repos/{owner}/{repo}/rulesets/{ruleset_id}
 func (s *RepositoriesService) GetRuleset(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*Ruleset, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/rulesets/%v?includes_parents=%v", owner, repo, rulesetID, includesParents)
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v?includes_parents=%v", PathEscape(owner), PathEscape(repo), rulesetID, includesParents)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -491,7 +574,7 @@ func (s *RepositoriesService) GetRuleset(ctx context.Context, owner, repo string
 //
 //meta:operation PUT /repos/{owner}/{repo}/rulesets/{ruleset_id}
 func (s *RepositoriesService) UpdateRuleset(ctx context.Context, owner, repo string, rulesetID int64, rs *Ruleset) (*Ruleset, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, rulesetID)
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v", PathEscape(owner), PathEscape(repo), rulesetID)
 
 	req, err := s.client.NewRequest("PUT", u, rs)
 	if err != nil {
@@ -513,7 +596,7 @@ func (s *RepositoriesService) UpdateRuleset(ctx context.Context, owner, repo str
 //
 //meta:operation DELETE /repos/{owner}/{repo}/rulesets/{ruleset_id}
 func (s *RepositoriesService) DeleteRuleset(ctx context.Context, owner, repo string, rulesetID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, rulesetID)
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v", PathEscape(owner), PathEscape(repo), rulesetID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
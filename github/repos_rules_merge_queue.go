@@ -0,0 +1,117 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// MergeQueueConfig is the merge queue configuration that applies to a branch,
+// as returned by RepositoriesService.GetMergeQueueConfig.
+type MergeQueueConfig struct {
+	Parameters MergeQueueRuleParameters
+	// The ruleset of the merge_queue rule, as in RepositoryRule.
+	RulesetID         int64
+	RulesetSource     string
+	RulesetSourceType string
+}
+
+// GetMergeQueueConfig gets the merge queue configuration that applies to the
+// specified branch, from the merge_queue rules of the rulesets that target it.
+// It returns a nil MergeQueueConfig if the branch has no merge queue.
+//
+// When several rulesets require a merge queue, the stricter rule wins: one
+// using the ALLGREEN grouping strategy, then the one merging the fewest
+// entries at once, then the one building the fewest entries. A limit of 0 is
+// unset and is less strict than any other. Rules that are equally strict are
+// resolved in the order GitHub returns them.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/rules#get-rules-for-a-branch
+//
+//meta:operation GET /repos/{owner}/{repo}/rules/branches/{branch}
+func (s *RepositoriesService) GetMergeQueueConfig(ctx context.Context, owner, repo, branch string) (*MergeQueueConfig, *Response, error) {
+	rules, resp, err := s.GetRulesForBranch(ctx, owner, repo, branch)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var config *MergeQueueConfig
+	for _, rule := range rules {
+		if rule.Type != "merge_queue" {
+			continue
+		}
+		c := &MergeQueueConfig{
+			RulesetID:         rule.RulesetID,
+			RulesetSource:     rule.RulesetSource,
+			RulesetSourceType: rule.RulesetSourceType,
+		}
+		if rule.Parameters != nil {
+			if err := json.Unmarshal(*rule.Parameters, &c.Parameters); err != nil {
+				return nil, resp, err
+			}
+		}
+		if config == nil || c.Parameters.stricterThan(&config.Parameters) {
+			config = c
+		}
+	}
+	return config, resp, nil
+}
+
+// stricterThan reports whether p is a stricter merge queue configuration
+// than q, as described by GetMergeQueueConfig.
+func (p *MergeQueueRuleParameters) stricterThan(q *MergeQueueRuleParameters) bool {
+	pAllGreen := p.GroupingStrategy == MergeQueueGroupingAllGreen
+	qAllGreen := q.GroupingStrategy == MergeQueueGroupingAllGreen
+	if pAllGreen != qAllGreen {
+		return pAllGreen
+	}
+	if p.MaxEntriesToMerge != q.MaxEntriesToMerge {
+		return lowerLimit(p.MaxEntriesToMerge, q.MaxEntriesToMerge)
+	}
+	return lowerLimit(p.MaxEntriesToBuild, q.MaxEntriesToBuild)
+}
+
+// lowerLimit reports whether the limit a is lower than b, where 0 means the
+// limit is unset.
+func lowerLimit(a, b int) bool {
+	switch {
+	case a == 0:
+		return false
+	case b == 0:
+		return true
+	}
+	return a < b
+}
+
+// UpdateMergeQueueConfig sets the parameters of the merge_queue rule of the
+// specified repository ruleset, adding the rule if the ruleset has none, and
+// returns the updated ruleset. The other rules of the ruleset are kept.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/rules#get-a-repository-ruleset
+// GitHub API docs: https://docs.github.com/rest/repos/rules#update-a-repository-ruleset
+//
+//meta:operation GET /repos/{owner}/{repo}/rulesets/{ruleset_id}
+//meta:operation PUT /repos/{owner}/{repo}/rulesets/{ruleset_id}
+func (s *RepositoriesService) UpdateMergeQueueConfig(ctx context.Context, owner, repo string, rulesetID int64, params *MergeQueueRuleParameters) (*Ruleset, *Response, error) {
+	rs, resp, err := s.GetRuleset(ctx, owner, repo, rulesetID, false)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	rule := NewMergeQueueRule(params)
+	replaced := false
+	for i, r := range rs.Rules {
+		if r.Type == "merge_queue" {
+			rs.Rules[i] = rule
+			replaced = true
+		}
+	}
+	if !replaced {
+		rs.Rules = append(rs.Rules, rule)
+	}
+	return s.UpdateRuleset(ctx, owner, repo, rulesetID, rs)
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_GetMergeQueueConfig(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rules/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"type": "deletion", "ruleset_id": 1, "ruleset_source_type": "Repository", "ruleset_source": "o/r"},
+			{"type": "merge_queue", "ruleset_id": 1, "ruleset_source_type": "Repository", "ruleset_source": "o/r", "parameters": {
+				"check_response_timeout_minutes": 60,
+				"grouping_strategy": "ALLGREEN",
+				"max_entries_to_build": 5,
+				"max_entries_to_merge": 5,
+				"merge_method": "MERGE",
+				"min_entries_to_merge": 1,
+				"min_entries_to_merge_wait_minutes": 5
+			}},
+			{"type": "merge_queue", "ruleset_id": 2, "ruleset_source_type": "Organization", "ruleset_source": "o", "parameters": {
+				"check_response_timeout_minutes": 30,
+				"grouping_strategy": "ALLGREEN",
+				"max_entries_to_build": 10,
+				"max_entries_to_merge": 1,
+				"merge_method": "SQUASH",
+				"min_entries_to_merge": 1,
+				"min_entries_to_merge_wait_minutes": 0
+			}},
+			{"type": "merge_queue", "ruleset_id": 3, "ruleset_source_type": "Organization", "ruleset_source": "o", "parameters": {
+				"check_response_timeout_minutes": 60,
+				"grouping_strategy": "HEADGREEN",
+				"max_entries_to_build": 1,
+				"max_entries_to_merge": 1,
+				"merge_method": "MERGE",
+				"min_entries_to_merge": 1,
+				"min_entries_to_merge_wait_minutes": 5
+			}},
+			{"type": "merge_queue", "ruleset_id": 4, "ruleset_source_type": "Organization", "ruleset_source": "o", "parameters": {
+				"check_response_timeout_minutes": 60,
+				"grouping_strategy": "ALLGREEN",
+				"merge_method": "MERGE"
+			}},
+			{"type": "merge_queue", "ruleset_id": 5, "ruleset_source_type": "Organization", "ruleset_source": "o", "parameters": {
+				"check_response_timeout_minutes": 60,
+				"grouping_strategy": "ALLGREEN",
+				"max_entries_to_merge": 1,
+				"merge_method": "MERGE"
+			}}
+		]`)
+	})
+
+	ctx := context.Background()
+	config, _, err := client.Repositories.GetMergeQueueConfig(ctx, "o", "r", "main")
+	if err != nil {
+		t.Errorf("Repositories.GetMergeQueueConfig returned error: %v", err)
+	}
+
+	want := &MergeQueueConfig{
+		Parameters: MergeQueueRuleParameters{
+			CheckResponseTimeoutMinutes: 30,
+			GroupingStrategy:            MergeQueueGroupingAllGreen,
+			MaxEntriesToBuild:           10,
+			MaxEntriesToMerge:           1,
+			MergeMethod:                 MergeQueueMergeMethodSquash,
+			MinEntriesToMerge:           1,
+		},
+		RulesetID:         2,
+		RulesetSource:     "o",
+		RulesetSourceType: "Organization",
+	}
+	if !cmp.Equal(config, want) {
+		t.Errorf("Repositories.GetMergeQueueConfig returned %+v, want %+v", config, want)
+	}
+
+	const methodName = "GetMergeQueueConfig"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetMergeQueueConfig(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetMergeQueueConfig(ctx, "o", "r", "main")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetMergeQueueConfig_none(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rules/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"type": "deletion", "ruleset_id": 1}]`)
+	})
+
+	ctx := context.Background()
+	config, _, err := client.Repositories.GetMergeQueueConfig(ctx, "o", "r", "main")
+	if err != nil {
+		t.Errorf("Repositories.GetMergeQueueConfig returned error: %v", err)
+	}
+	if config != nil {
+		t.Errorf("Repositories.GetMergeQueueConfig returned %+v, want nil", config)
+	}
+}
+
+func TestRepositoriesService_UpdateMergeQueueConfig(t *testing.T) {
+	tests := map[string]struct {
+		rules    string
+		wantBody string
+	}{
+		"replace": {
+			rules:    `[{"type":"deletion"},{"type":"merge_queue","parameters":{"max_entries_to_merge":5}}]`,
+			wantBody: `{"id":42,"name":"default","source":"o/r","enforcement":"active","rules":[{"type":"deletion","ruleset_source_type":"","ruleset_source":"","ruleset_id":0},{"type":"merge_queue","parameters":{"check_response_timeout_minutes":60,"grouping_strategy":"HEADGREEN","max_entries_to_build":2,"max_entries_to_merge":2,"merge_method":"REBASE","min_entries_to_merge":1,"min_entries_to_merge_wait_minutes":5},"ruleset_source_type":"","ruleset_source":"","ruleset_id":0}]}` + "\n",
+		},
+		"add": {
+			rules:    `[{"type":"deletion"}]`,
+			wantBody: `{"id":42,"name":"default","source":"o/r","enforcement":"active","rules":[{"type":"deletion","ruleset_source_type":"","ruleset_source":"","ruleset_id":0},{"type":"merge_queue","parameters":{"check_response_timeout_minutes":60,"grouping_strategy":"HEADGREEN","max_entries_to_build":2,"max_entries_to_merge":2,"merge_method":"REBASE","min_entries_to_merge":1,"min_entries_to_merge_wait_minutes":5},"ruleset_source_type":"","ruleset_source":"","ruleset_id":0}]}` + "\n",
+		},
+	}
+
+	params := &MergeQueueRuleParameters{
+		CheckResponseTimeoutMinutes:  60,
+		GroupingStrategy:             MergeQueueGroupingHeadGreen,
+		MaxEntriesToBuild:            2,
+		MaxEntriesToMerge:            2,
+		MergeMethod:                  MergeQueueMergeMethodRebase,
+		MinEntriesToMerge:            1,
+		MinEntriesToMergeWaitMinutes: 5,
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "GET":
+					testFormValues(t, r, values{"includes_parents": "false"})
+					fmt.Fprintf(w, `{"id":42,"name":"default","source":"o/r","enforcement":"active","rules":%v}`, tc.rules)
+				case "PUT":
+					testBody(t, r, tc.wantBody)
+					fmt.Fprint(w, `{"id":42,"name":"default"}`)
+				default:
+					t.Errorf("unexpected method %v", r.Method)
+				}
+			})
+
+			ctx := context.Background()
+			rs, _, err := client.Repositories.UpdateMergeQueueConfig(ctx, "o", "r", 42, params)
+			if err != nil {
+				t.Fatalf("Repositories.UpdateMergeQueueConfig returned error: %v", err)
+			}
+			want := &Ruleset{ID: Int64(42), Name: "default"}
+			if !cmp.Equal(rs, want) {
+				t.Errorf("Repositories.UpdateMergeQueueConfig returned %+v, want %+v", rs, want)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_UpdateMergeQueueConfig_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.UpdateMergeQueueConfig(ctx, "o", "r", 42, nil); err == nil {
+		t.Error("Repositories.UpdateMergeQueueConfig returned no error")
+	}
+
+	const methodName = "UpdateMergeQueueConfig"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.UpdateMergeQueueConfig(ctx, "\n", "\n", 42, nil)
+		return err
+	})
+}
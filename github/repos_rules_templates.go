@@ -0,0 +1,289 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// The actions taken by RepositoriesService.ApplyRulesetTemplate, as returned
+// in RulesetChangeSummary.Action.
+const (
+	RulesetActionCreated   = "created"
+	RulesetActionUpdated   = "updated"
+	RulesetActionUnchanged = "unchanged"
+)
+
+// RepositoryRulesetTemplate is a ruleset to apply to repositories with
+// RepositoriesService.ApplyRulesetTemplate, such as the branch protection of
+// every new repository of an organization.
+type RepositoryRulesetTemplate struct {
+	// Name identifies the ruleset on each repository.
+	Name string
+	// Possible values for Target are branch, tag. If nil, the target of an
+	// existing ruleset is left as is, and GitHub's default is used for a new
+	// one.
+	Target *string
+	// Possible values for Enforcement are: disabled, active, evaluate
+	Enforcement  string
+	BypassActors []*BypassActor
+	Conditions   *RulesetConditions
+	Rules        []*RepositoryRule
+
+	// DryRun makes ApplyRulesetTemplate only report the changes it would
+	// make, without making them.
+	DryRun bool
+}
+
+func (t *RepositoryRulesetTemplate) ruleset() *Ruleset {
+	return &Ruleset{
+		Name:         t.Name,
+		Target:       t.Target,
+		Enforcement:  t.Enforcement,
+		BypassActors: t.BypassActors,
+		Conditions:   t.Conditions,
+		Rules:        t.Rules,
+	}
+}
+
+// RulesetChangeSummary describes what RepositoriesService.ApplyRulesetTemplate
+// did, or would do in a dry run.
+type RulesetChangeSummary struct {
+	// Action is one of the RulesetAction constants.
+	Action string
+	// RulesetID is the ID of the ruleset, or 0 for a ruleset that a dry run
+	// would create.
+	RulesetID int64
+	// Changes describes the properties of the ruleset that differ from the
+	// template, such as "enforcement: disabled -> active" or "rule
+	// required_signatures added". It is empty when Action is
+	// RulesetActionUnchanged.
+	Changes []string
+	// DryRun reports whether the changes were only computed.
+	DryRun bool
+	// Ruleset is the ruleset as created or updated, or as it exists for an
+	// unchanged ruleset or a dry run. It is nil for a ruleset that a dry run
+	// would create.
+	Ruleset *Ruleset
+}
+
+// ApplyRulesetTemplate makes the ruleset named template.Name of the specified
+// repository of org match template, creating it if there is none and updating
+// it if it differs, so that it is safe to call again for the same repository,
+// for example when a webhook is delivered twice. Rulesets inherited from the
+// organization are ignored. Rules are compared by type and parameters,
+// irrespective of their order, and bypass actors by type, ID and mode.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/rules#create-a-repository-ruleset
+// GitHub API docs: https://docs.github.com/rest/repos/rules#get-a-repository-ruleset
+// GitHub API docs: https://docs.github.com/rest/repos/rules#get-all-repository-rulesets
+// GitHub API docs: https://docs.github.com/rest/repos/rules#update-a-repository-ruleset
+//
+//meta:operation GET /repos/{owner}/{repo}/rulesets
+//meta:operation POST /repos/{owner}/{repo}/rulesets
+//meta:operation GET /repos/{owner}/{repo}/rulesets/{ruleset_id}
+//meta:operation PUT /repos/{owner}/{repo}/rulesets/{ruleset_id}
+func (s *RepositoriesService) ApplyRulesetTemplate(ctx context.Context, org, repo string, template RepositoryRulesetTemplate) (*RulesetChangeSummary, *Response, error) {
+	rulesets, resp, err := s.GetAllRulesets(ctx, org, repo, false)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var existing *Ruleset
+	for _, rs := range rulesets {
+		if rs.Name == template.Name && (rs.SourceType == nil || *rs.SourceType == "Repository") {
+			existing = rs
+			break
+		}
+	}
+
+	summary := &RulesetChangeSummary{DryRun: template.DryRun}
+	if existing == nil {
+		summary.Action = RulesetActionCreated
+		summary.Changes = []string{fmt.Sprintf("ruleset %q created", template.Name)}
+		if template.DryRun {
+			return summary, resp, nil
+		}
+		created, resp, err := s.CreateRuleset(ctx, org, repo, template.ruleset())
+		if err != nil {
+			return nil, resp, err
+		}
+		summary.RulesetID = created.GetID()
+		summary.Ruleset = created
+		return summary, resp, nil
+	}
+
+	// The listing does not include the rules and conditions.
+	current, resp, err := s.GetRuleset(ctx, org, repo, existing.GetID(), false)
+	if err != nil {
+		return nil, resp, err
+	}
+	summary.RulesetID = current.GetID()
+	summary.Ruleset = current
+	summary.Changes = diffRuleset(current, &template)
+	if len(summary.Changes) == 0 {
+		summary.Action = RulesetActionUnchanged
+		return summary, resp, nil
+	}
+
+	summary.Action = RulesetActionUpdated
+	if template.DryRun {
+		return summary, resp, nil
+	}
+	rs := template.ruleset()
+	if rs.Target == nil {
+		rs.Target = current.Target
+	}
+	updated, resp, err := s.UpdateRuleset(ctx, org, repo, current.GetID(), rs)
+	if err != nil {
+		return nil, resp, err
+	}
+	summary.Ruleset = updated
+	return summary, resp, nil
+}
+
+// ApplyRulesetTemplateForEvent calls ApplyRulesetTemplate for the repository
+// of a repository webhook event, such as a "created" one. It does not check
+// the action of the event.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/rules#create-a-repository-ruleset
+// GitHub API docs: https://docs.github.com/rest/repos/rules#get-a-repository-ruleset
+// GitHub API docs: https://docs.github.com/rest/repos/rules#get-all-repository-rulesets
+// GitHub API docs: https://docs.github.com/rest/repos/rules#update-a-repository-ruleset
+//
+//meta:operation GET /repos/{owner}/{repo}/rulesets
+//meta:operation POST /repos/{owner}/{repo}/rulesets
+//meta:operation GET /repos/{owner}/{repo}/rulesets/{ruleset_id}
+//meta:operation PUT /repos/{owner}/{repo}/rulesets/{ruleset_id}
+func (s *RepositoriesService) ApplyRulesetTemplateForEvent(ctx context.Context, event *RepositoryEvent, template RepositoryRulesetTemplate) (*RulesetChangeSummary, *Response, error) {
+	repo := event.GetRepo()
+	if repo.GetOwner().GetLogin() == "" || repo.GetName() == "" {
+		return nil, nil, errors.New("repository event has no repository owner and name")
+	}
+	return s.ApplyRulesetTemplate(ctx, repo.GetOwner().GetLogin(), repo.GetName(), template)
+}
+
+// diffRuleset describes how rs differs from t.
+func diffRuleset(rs *Ruleset, t *RepositoryRulesetTemplate) []string {
+	var changes []string
+	if t.Target != nil && rs.GetTarget() != *t.Target {
+		changes = append(changes, fmt.Sprintf("target: %v -> %v", rs.GetTarget(), *t.Target))
+	}
+	if rs.Enforcement != t.Enforcement {
+		changes = append(changes, fmt.Sprintf("enforcement: %v -> %v", rs.Enforcement, t.Enforcement))
+	}
+	if !reflect.DeepEqual(normalizeRulesetJSON(rs.Conditions), normalizeRulesetJSON(t.Conditions)) {
+		changes = append(changes, "conditions changed")
+	}
+	if !reflect.DeepEqual(bypassActorKeys(rs.BypassActors), bypassActorKeys(t.BypassActors)) {
+		changes = append(changes, "bypass actors changed")
+	}
+
+	current := rulesByType(rs.Rules)
+	wanted := rulesByType(t.Rules)
+	types := make(map[string]bool)
+	for typ := range current {
+		types[typ] = true
+	}
+	for typ := range wanted {
+		types[typ] = true
+	}
+	sorted := make([]string, 0, len(types))
+	for typ := range types {
+		sorted = append(sorted, typ)
+	}
+	sort.Strings(sorted)
+	for _, typ := range sorted {
+		c, inCurrent := current[typ]
+		w, inWanted := wanted[typ]
+		switch {
+		case !inCurrent:
+			changes = append(changes, fmt.Sprintf("rule %v added", typ))
+		case !inWanted:
+			changes = append(changes, fmt.Sprintf("rule %v removed", typ))
+		case !reflect.DeepEqual(c, w):
+			changes = append(changes, fmt.Sprintf("rule %v changed", typ))
+		}
+	}
+	return changes
+}
+
+// rulesByType returns the normalized parameters of rules by rule type. Rules
+// of a type that appears several times, such as workflows, are grouped so
+// that their order does not matter.
+func rulesByType(rules []*RepositoryRule) map[string][]string {
+	byType := make(map[string][]string)
+	for _, r := range rules {
+		var params interface{}
+		if r.Parameters != nil {
+			params = normalizeRulesetJSON(r.Parameters)
+		}
+		b, _ := json.Marshal(params)
+		byType[r.Type] = append(byType[r.Type], string(b))
+	}
+	for _, params := range byType {
+		sort.Strings(params)
+	}
+	return byType
+}
+
+func bypassActorKeys(actors []*BypassActor) []string {
+	keys := make([]string, 0, len(actors))
+	for _, a := range actors {
+		keys = append(keys, fmt.Sprintf("%v/%v/%v", a.GetActorType(), a.GetActorID(), a.GetBypassMode()))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// normalizeRulesetJSON returns the JSON representation of v as decoded into
+// an interface{}, without the null values and empty arrays and objects that
+// GitHub and callers use interchangeably for unset properties.
+func normalizeRulesetJSON(v interface{}) interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return nil
+	}
+	return pruneJSON(decoded)
+}
+
+func pruneJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e = pruneJSON(e); e == nil {
+				delete(v, k)
+			} else {
+				v[k] = e
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+		return v
+	case []interface{}:
+		pruned := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			if e = pruneJSON(e); e != nil {
+				pruned = append(pruned, e)
+			}
+		}
+		if len(pruned) == 0 {
+			return nil
+		}
+		return pruned
+	}
+	return v
+}
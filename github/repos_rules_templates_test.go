@@ -0,0 +1,249 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testRulesetTemplate() RepositoryRulesetTemplate {
+	return RepositoryRulesetTemplate{
+		Name:        "default",
+		Target:      String("branch"),
+		Enforcement: "active",
+		BypassActors: []*BypassActor{
+			{ActorID: Int64(5), ActorType: String("RepositoryRole"), BypassMode: String("always")},
+		},
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefConditionParameters{Include: []string{"~DEFAULT_BRANCH"}},
+		},
+		Rules: []*RepositoryRule{
+			NewDeletionRule(),
+			NewRequiredSignaturesRule(),
+			NewPullRequestRule(&PullRequestRuleParameters{RequiredApprovingReviewCount: 1}),
+		},
+	}
+}
+
+// testExistingRuleset is the ruleset of testRulesetTemplate as returned by
+// the API, with the rules in another order and the unset condition and
+// parameters in GitHub's representation.
+const testExistingRuleset = `{
+	"id": 42,
+	"name": "default",
+	"target": "branch",
+	"source_type": "Repository",
+	"source": "o/r",
+	"enforcement": "active",
+	"bypass_actors": [{"actor_id": 5, "actor_type": "RepositoryRole", "bypass_mode": "always"}],
+	"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}},
+	"rules": [
+		{"type": "pull_request", "parameters": {
+			"dismiss_stale_reviews_on_push": false,
+			"require_code_owner_review": false,
+			"require_last_push_approval": false,
+			"required_approving_review_count": 1,
+			"required_review_thread_resolution": false
+		}},
+		{"type": "deletion"},
+		{"type": "required_signatures"}
+	]
+}`
+
+func handleRulesetListing(t *testing.T, mux *http.ServeMux, existing bool) {
+	t.Helper()
+	mux.HandleFunc("/repos/o/r/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testFormValues(t, r, values{"includes_parents": "false"})
+			fmt.Fprint(w, `[{"id":7,"name":"other","source_type":"Repository","source":"o/r","enforcement":"active"}`)
+			if existing {
+				fmt.Fprint(w, `,{"id":42,"name":"default","source_type":"Repository","source":"o/r","enforcement":"active"}`)
+			}
+			fmt.Fprint(w, `]`)
+		case "POST":
+			testBody(t, r, `{"name":"default","target":"branch","source":"","enforcement":"active","bypass_actors":[{"actor_id":5,"actor_type":"RepositoryRole","bypass_mode":"always"}],"conditions":{"ref_name":{"include":["~DEFAULT_BRANCH"],"exclude":null}},"rules":[{"type":"deletion","ruleset_source_type":"","ruleset_source":"","ruleset_id":0},{"type":"required_signatures","ruleset_source_type":"","ruleset_source":"","ruleset_id":0},{"type":"pull_request","parameters":{"dismiss_stale_reviews_on_push":false,"require_code_owner_review":false,"require_last_push_approval":false,"required_approving_review_count":1,"required_review_thread_resolution":false},"ruleset_source_type":"","ruleset_source":"","ruleset_id":0}]}`+"\n")
+			fmt.Fprint(w, `{"id":43,"name":"default"}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+}
+
+func TestRepositoriesService_ApplyRulesetTemplate_create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleRulesetListing(t, mux, false)
+
+	ctx := context.Background()
+	summary, _, err := client.Repositories.ApplyRulesetTemplate(ctx, "o", "r", testRulesetTemplate())
+	if err != nil {
+		t.Fatalf("Repositories.ApplyRulesetTemplate returned error: %v", err)
+	}
+
+	want := &RulesetChangeSummary{
+		Action:    RulesetActionCreated,
+		RulesetID: 43,
+		Changes:   []string{`ruleset "default" created`},
+		Ruleset:   &Ruleset{ID: Int64(43), Name: "default"},
+	}
+	if !cmp.Equal(summary, want) {
+		t.Errorf("Repositories.ApplyRulesetTemplate returned %+v, want %+v", summary, want)
+	}
+}
+
+func TestRepositoriesService_ApplyRulesetTemplate_createDryRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[]`)
+	})
+
+	template := testRulesetTemplate()
+	template.DryRun = true
+	ctx := context.Background()
+	summary, _, err := client.Repositories.ApplyRulesetTemplate(ctx, "o", "r", template)
+	if err != nil {
+		t.Fatalf("Repositories.ApplyRulesetTemplate returned error: %v", err)
+	}
+
+	want := &RulesetChangeSummary{
+		Action:  RulesetActionCreated,
+		Changes: []string{`ruleset "default" created`},
+		DryRun:  true,
+	}
+	if !cmp.Equal(summary, want) {
+		t.Errorf("Repositories.ApplyRulesetTemplate returned %+v, want %+v", summary, want)
+	}
+}
+
+func TestRepositoriesService_ApplyRulesetTemplate_unchanged(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleRulesetListing(t, mux, true)
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, testExistingRuleset)
+	})
+
+	ctx := context.Background()
+	summary, _, err := client.Repositories.ApplyRulesetTemplate(ctx, "o", "r", testRulesetTemplate())
+	if err != nil {
+		t.Fatalf("Repositories.ApplyRulesetTemplate returned error: %v", err)
+	}
+
+	if summary.Action != RulesetActionUnchanged || summary.RulesetID != 42 || len(summary.Changes) != 0 {
+		t.Errorf("Repositories.ApplyRulesetTemplate returned %+v, want an unchanged ruleset 42", summary)
+	}
+	if summary.Ruleset.GetID() != 42 {
+		t.Errorf("Repositories.ApplyRulesetTemplate returned ruleset %v, want 42", summary.Ruleset.GetID())
+	}
+}
+
+func TestRepositoriesService_ApplyRulesetTemplate_drift(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleRulesetListing(t, mux, true)
+	var updated bool
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"id": 42,
+				"name": "default",
+				"target": "branch",
+				"source_type": "Repository",
+				"source": "o/r",
+				"enforcement": "evaluate",
+				"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}},
+				"rules": [
+					{"type": "deletion"},
+					{"type": "pull_request", "parameters": {"required_approving_review_count": 0}},
+					{"type": "non_fast_forward"}
+				]
+			}`)
+		case "PUT":
+			updated = true
+			testBody(t, r, `{"name":"default","target":"branch","source":"","enforcement":"active","bypass_actors":[{"actor_id":5,"actor_type":"RepositoryRole","bypass_mode":"always"}],"conditions":{"ref_name":{"include":["~DEFAULT_BRANCH"],"exclude":null}},"rules":[{"type":"deletion","ruleset_source_type":"","ruleset_source":"","ruleset_id":0},{"type":"required_signatures","ruleset_source_type":"","ruleset_source":"","ruleset_id":0},{"type":"pull_request","parameters":{"dismiss_stale_reviews_on_push":false,"require_code_owner_review":false,"require_last_push_approval":false,"required_approving_review_count":1,"required_review_thread_resolution":false},"ruleset_source_type":"","ruleset_source":"","ruleset_id":0}]}`+"\n")
+			fmt.Fprint(w, testExistingRuleset)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	wantChanges := []string{
+		"enforcement: evaluate -> active",
+		"bypass actors changed",
+		"rule non_fast_forward removed",
+		"rule pull_request changed",
+		"rule required_signatures added",
+	}
+
+	ctx := context.Background()
+	dryRun := testRulesetTemplate()
+	dryRun.DryRun = true
+	summary, _, err := client.Repositories.ApplyRulesetTemplate(ctx, "o", "r", dryRun)
+	if err != nil {
+		t.Fatalf("Repositories.ApplyRulesetTemplate returned error: %v", err)
+	}
+	if updated {
+		t.Error("Repositories.ApplyRulesetTemplate updated the ruleset in a dry run")
+	}
+	if summary.Action != RulesetActionUpdated || !summary.DryRun || !cmp.Equal(summary.Changes, wantChanges) {
+		t.Errorf("Repositories.ApplyRulesetTemplate returned %+v, want a dry run update with %q", summary, wantChanges)
+	}
+
+	event := &RepositoryEvent{
+		Action: String("created"),
+		Repo:   &Repository{Name: String("r"), Owner: &User{Login: String("o")}},
+	}
+	summary, _, err = client.Repositories.ApplyRulesetTemplateForEvent(ctx, event, testRulesetTemplate())
+	if err != nil {
+		t.Fatalf("Repositories.ApplyRulesetTemplateForEvent returned error: %v", err)
+	}
+	if !updated {
+		t.Error("Repositories.ApplyRulesetTemplateForEvent did not update the ruleset")
+	}
+	if summary.Action != RulesetActionUpdated || summary.DryRun || !cmp.Equal(summary.Changes, wantChanges) {
+		t.Errorf("Repositories.ApplyRulesetTemplateForEvent returned %+v, want an update with %q", summary, wantChanges)
+	}
+	if summary.Ruleset.Enforcement != "active" {
+		t.Errorf("Repositories.ApplyRulesetTemplateForEvent returned ruleset %+v, want the updated ruleset", summary.Ruleset)
+	}
+}
+
+func TestRepositoriesService_ApplyRulesetTemplate_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.ApplyRulesetTemplate(ctx, "o", "r", testRulesetTemplate()); err == nil {
+		t.Error("Repositories.ApplyRulesetTemplate returned no error")
+	}
+	if _, _, err := client.Repositories.ApplyRulesetTemplateForEvent(ctx, &RepositoryEvent{}, testRulesetTemplate()); err == nil {
+		t.Error("Repositories.ApplyRulesetTemplateForEvent returned no error for an event without a repository")
+	}
+
+	const methodName = "ApplyRulesetTemplate"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ApplyRulesetTemplate(ctx, "\n", "r", testRulesetTemplate())
+		return err
+	})
+}
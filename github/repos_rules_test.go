@@ -74,6 +74,26 @@ func TestRepositoryRule_UnmarshalJSON(t *testing.T) {
 				Parameters: nil,
 			},
 		},
+		"Valid merge_queue params": {
+			data: `{"type":"merge_queue","parameters":{"check_response_timeout_minutes":60,"grouping_strategy":"ALLGREEN","max_entries_to_build":5,"max_entries_to_merge":5,"merge_method":"SQUASH","min_entries_to_merge":1,"min_entries_to_merge_wait_minutes":5}}`,
+			want: NewMergeQueueRule(&MergeQueueRuleParameters{
+				CheckResponseTimeoutMinutes:  60,
+				GroupingStrategy:             MergeQueueGroupingAllGreen,
+				MaxEntriesToBuild:            5,
+				MaxEntriesToMerge:            5,
+				MergeMethod:                  MergeQueueMergeMethodSquash,
+				MinEntriesToMerge:            1,
+				MinEntriesToMergeWaitMinutes: 5,
+			}),
+		},
+		"Invalid merge_queue params": {
+			data: `{"type":"merge_queue","parameters":{"max_entries_to_merge":"5"}}`,
+			want: &RepositoryRule{
+				Type:       "merge_queue",
+				Parameters: nil,
+			},
+			wantErr: true,
+		},
 		"Valid non_fast_forward": {
 			data: `{"type":"non_fast_forward"}`,
 			want: &RepositoryRule{
@@ -336,6 +356,24 @@ func TestRepositoriesService_GetRulesForBranch(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetRulesForBranch_reservedCharacters(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.EscapedPath(), "/repos/o/repo/rules/branches/feat%231"; got != want {
+			t.Errorf("request path = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.GetRulesForBranch(ctx, "o", "repo", "feat#1"); err != nil {
+		t.Errorf("Repositories.GetRulesForBranch returned error: %v", err)
+	}
+}
+
 func TestRepositoriesService_GetRulesForBranchEmptyUpdateRule(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
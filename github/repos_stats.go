@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -49,7 +50,7 @@ func (w WeeklyStats) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/stats/contributors
 func (s *RepositoriesService) ListContributorsStats(ctx context.Context, owner, repo string) ([]*ContributorStats, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/stats/contributors", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/stats/contributors", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -90,7 +91,7 @@ func (w WeeklyCommitActivity) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/stats/commit_activity
 func (s *RepositoriesService) ListCommitActivity(ctx context.Context, owner, repo string) ([]*WeeklyCommitActivity, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/stats/commit_activity", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/stats/commit_activity", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -107,7 +108,8 @@ func (s *RepositoriesService) ListCommitActivity(ctx context.Context, owner, rep
 
 // ListCodeFrequency returns a weekly aggregate of the number of additions and
 // deletions pushed to a repository. Returned WeeklyStats will contain
-// additions and deletions, but not total commits.
+// additions and deletions, but not total commits. The raw response can be
+// decoded into WeeklyCodeFrequency values.
 //
 // If this is the first time these statistics are requested for the given
 // repository, this method will return an *AcceptedError and a status code of
@@ -115,39 +117,80 @@ func (s *RepositoriesService) ListCommitActivity(ctx context.Context, owner, rep
 // it is now computing the requested statistics. A follow up request, after a
 // delay of a second or so, should result in a successful request.
 //
+// Entries that are not a [week, additions, deletions] array of integers are
+// skipped, so that one malformed entry does not fail the whole listing.
+//
 // GitHub API docs: https://docs.github.com/rest/metrics/statistics#get-the-weekly-commit-activity
 //
 //meta:operation GET /repos/{owner}/{repo}/stats/code_frequency
 func (s *RepositoriesService) ListCodeFrequency(ctx context.Context, owner, repo string) ([]*WeeklyStats, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/stats/code_frequency", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/stats/code_frequency", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var weeks [][]int
-	resp, err := s.client.Do(ctx, req, &weeks)
+	var entries []json.RawMessage
+	resp, err := s.client.Do(ctx, req, &entries)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	// convert int slices into WeeklyStats
 	var stats []*WeeklyStats
-	for _, week := range weeks {
-		if len(week) != 3 {
+	for _, entry := range entries {
+		week := new(WeeklyCodeFrequency)
+		if err := json.Unmarshal(entry, week); err != nil {
 			continue
 		}
-		stat := &WeeklyStats{
-			Week:      &Timestamp{time.Unix(int64(week[0]), 0)},
-			Additions: Int(week[1]),
-			Deletions: Int(week[2]),
-		}
-		stats = append(stats, stat)
+		stats = append(stats, &WeeklyStats{
+			Week:      week.Week,
+			Additions: week.Additions,
+			Deletions: week.Deletions,
+		})
 	}
 
 	return stats, resp, nil
 }
 
+// WeeklyCodeFrequency represents the number of additions and deletions
+// pushed to a repository in a given week. Deletions are negative.
+//
+// GitHub encodes it as a [week, additions, deletions] array, with the week
+// in epoch seconds; WeeklyCodeFrequency is decoded from and encoded to that
+// form.
+type WeeklyCodeFrequency struct {
+	Week      *Timestamp
+	Additions *int
+	Deletions *int
+}
+
+func (w WeeklyCodeFrequency) String() string {
+	return Stringify(w)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (w *WeeklyCodeFrequency) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalStatsTriple(data, "code frequency")
+	if err != nil {
+		return err
+	}
+	*w = WeeklyCodeFrequency{
+		Week:      &Timestamp{time.Unix(v[0], 0)},
+		Additions: Int(int(v[1])),
+		Deletions: Int(int(v[2])),
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (w WeeklyCodeFrequency) MarshalJSON() ([]byte, error) {
+	var week int64
+	if w.Week != nil {
+		week = w.Week.Unix()
+	}
+	return json.Marshal([3]int64{week, int64(w.GetAdditions()), int64(w.GetDeletions())})
+}
+
 // RepositoryParticipation is the number of commits by everyone
 // who has contributed to the repository (including the owner)
 // as well as the number of commits by the owner themself.
@@ -177,7 +220,7 @@ func (r RepositoryParticipation) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/stats/participation
 func (s *RepositoriesService) ListParticipation(ctx context.Context, owner, repo string) (*RepositoryParticipation, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/stats/participation", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/stats/participation", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -194,6 +237,9 @@ func (s *RepositoriesService) ListParticipation(ctx context.Context, owner, repo
 
 // PunchCard represents the number of commits made during a given hour of a
 // day of the week.
+//
+// GitHub encodes it as a [day, hour, commits] array; PunchCard is decoded
+// from and encoded to that form.
 type PunchCard struct {
 	Day     *int // Day of the week (0-6: =Sunday - Saturday).
 	Hour    *int // Hour of day (0-23).
@@ -208,35 +254,65 @@ type PunchCard struct {
 // it is now computing the requested statistics. A follow up request, after a
 // delay of a second or so, should result in a successful request.
 //
+// Entries that are not a [day, hour, commits] array of integers are skipped,
+// so that one malformed entry does not fail the whole listing.
+//
 // GitHub API docs: https://docs.github.com/rest/metrics/statistics#get-the-hourly-commit-count-for-each-day
 //
 //meta:operation GET /repos/{owner}/{repo}/stats/punch_card
 func (s *RepositoriesService) ListPunchCard(ctx context.Context, owner, repo string) ([]*PunchCard, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/stats/punch_card", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/stats/punch_card", PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var results [][]int
-	resp, err := s.client.Do(ctx, req, &results)
+	var entries []json.RawMessage
+	resp, err := s.client.Do(ctx, req, &entries)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	// convert int slices into Punchcards
 	var cards []*PunchCard
-	for _, result := range results {
-		if len(result) != 3 {
+	for _, entry := range entries {
+		card := new(PunchCard)
+		if err := json.Unmarshal(entry, card); err != nil {
 			continue
 		}
-		card := &PunchCard{
-			Day:     Int(result[0]),
-			Hour:    Int(result[1]),
-			Commits: Int(result[2]),
-		}
 		cards = append(cards, card)
 	}
 
 	return cards, resp, nil
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *PunchCard) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalStatsTriple(data, "punch card")
+	if err != nil {
+		return err
+	}
+	*p = PunchCard{
+		Day:     Int(int(v[0])),
+		Hour:    Int(int(v[1])),
+		Commits: Int(int(v[2])),
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p PunchCard) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]int{p.GetDay(), p.GetHour(), p.GetCommits()})
+}
+
+// unmarshalStatsTriple decodes the three-integer arrays the statistics
+// endpoints use for their entries.
+func unmarshalStatsTriple(data []byte, kind string) ([]int64, error) {
+	var v []int64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	if len(v) != 3 {
+		return nil, fmt.Errorf("invalid %v entry %s, want 3 integers", kind, data)
+	}
+	return v, nil
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -178,6 +179,46 @@ func TestRepositoriesService_ListCodeFrequency(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListCodeFrequency_invalidEntries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stats/code_frequency", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		fmt.Fprint(w, `[
+		  [1302998400, 1124, -435],
+		  [1303603200, 10],
+		  ["1303603200", 10, -2],
+		  {"week": 1303603200},
+		  [1304208000, 7, -1]
+		]`)
+	})
+
+	ctx := context.Background()
+	code, _, err := client.Repositories.ListCodeFrequency(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("RepositoriesService.ListCodeFrequency returned error: %v", err)
+	}
+
+	want := []*WeeklyStats{
+		{
+			Week:      &Timestamp{time.Date(2011, time.April, 17, 00, 00, 00, 0, time.UTC).Local()},
+			Additions: Int(1124),
+			Deletions: Int(-435),
+		},
+		{
+			Week:      &Timestamp{time.Date(2011, time.May, 1, 00, 00, 00, 0, time.UTC).Local()},
+			Additions: Int(7),
+			Deletions: Int(-1),
+		},
+	}
+
+	if !cmp.Equal(code, want) {
+		t.Errorf("RepositoriesService.ListCodeFrequency returned %+v, want %+v", code, want)
+	}
+}
+
 func TestRepositoriesService_Participation(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -288,6 +329,38 @@ func TestRepositoriesService_ListPunchCard(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListPunchCard_invalidEntries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stats/punch_card", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		fmt.Fprint(w, `[
+		  [0, 0, 5],
+		  [0, 1],
+		  ["0", 2, 21],
+		  {"day": 0},
+		  [6, 23, 21]
+		]`)
+	})
+
+	ctx := context.Background()
+	card, _, err := client.Repositories.ListPunchCard(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("RepositoriesService.ListPunchCard returned error: %v", err)
+	}
+
+	want := []*PunchCard{
+		{Day: Int(0), Hour: Int(0), Commits: Int(5)},
+		{Day: Int(6), Hour: Int(23), Commits: Int(21)},
+	}
+
+	if !cmp.Equal(card, want) {
+		t.Errorf("RepositoriesService.ListPunchCard returned %+v, want %+v", card, want)
+	}
+}
+
 func TestRepositoriesService_AcceptedError(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -417,3 +490,86 @@ func TestContributorStats_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestWeeklyCodeFrequency_UnmarshalJSON(t *testing.T) {
+	var weeks []*WeeklyCodeFrequency
+	data := `[
+	  [1302998400, 1124, -435],
+	  [1303603200, 1930, -1923],
+	  [1304208000, 0, 0]
+	]`
+	if err := json.Unmarshal([]byte(data), &weeks); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	want := []*WeeklyCodeFrequency{
+		{Week: &Timestamp{time.Unix(1302998400, 0)}, Additions: Int(1124), Deletions: Int(-435)},
+		{Week: &Timestamp{time.Unix(1303603200, 0)}, Additions: Int(1930), Deletions: Int(-1923)},
+		{Week: &Timestamp{time.Unix(1304208000, 0)}, Additions: Int(0), Deletions: Int(0)},
+	}
+	if !cmp.Equal(weeks, want) {
+		t.Errorf("json.Unmarshal returned %+v, want %+v", weeks, want)
+	}
+
+	if got, want := weeks[0].GetWeek().UTC(), time.Date(2011, time.April, 17, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Week = %v, want %v", got, want)
+	}
+}
+
+func TestWeeklyCodeFrequency_Marshal(t *testing.T) {
+	testJSONMarshal(t, &WeeklyCodeFrequency{}, "[0, 0, 0]")
+
+	u := &WeeklyCodeFrequency{
+		Week:      &Timestamp{time.Unix(1302998400, 0)},
+		Additions: Int(1124),
+		Deletions: Int(-435),
+	}
+
+	testJSONMarshal(t, u, "[1302998400, 1124, -435]")
+}
+
+func TestPunchCard_UnmarshalJSON(t *testing.T) {
+	var cards []*PunchCard
+	data := `[
+	  [0, 0, 5],
+	  [0, 1, 43],
+	  [6, 23, 21]
+	]`
+	if err := json.Unmarshal([]byte(data), &cards); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	want := []*PunchCard{
+		{Day: Int(0), Hour: Int(0), Commits: Int(5)},
+		{Day: Int(0), Hour: Int(1), Commits: Int(43)},
+		{Day: Int(6), Hour: Int(23), Commits: Int(21)},
+	}
+	if !cmp.Equal(cards, want) {
+		t.Errorf("json.Unmarshal returned %+v, want %+v", cards, want)
+	}
+}
+
+func TestPunchCard_Marshal(t *testing.T) {
+	testJSONMarshal(t, &PunchCard{}, "[0, 0, 0]")
+
+	u := &PunchCard{Day: Int(6), Hour: Int(23), Commits: Int(21)}
+
+	testJSONMarshal(t, u, "[6, 23, 21]")
+}
+
+func TestStats_UnmarshalJSON_invalid(t *testing.T) {
+	tests := map[string]interface{}{
+		"WeeklyCodeFrequency": &WeeklyCodeFrequency{},
+		"PunchCard":           &PunchCard{},
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, data := range []string{`[1, 2]`, `[1, 2, 3, 4]`, `{"day":1}`, `["1", 2, 3]`} {
+				if err := json.Unmarshal([]byte(data), v); err == nil {
+					t.Errorf("json.Unmarshal(%v) returned nil error, want an error", data)
+				}
+			}
+		})
+	}
+}
@@ -49,7 +49,7 @@ func (r RepoStatus) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}/statuses
 func (s *RepositoriesService) ListStatuses(ctx context.Context, owner, repo, ref string, opts *ListOptions) ([]*RepoStatus, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v/statuses", owner, repo, refURLEscape(ref))
+	u := fmt.Sprintf("repos/%v/%v/commits/%v/statuses", PathEscape(owner), PathEscape(repo), refURLEscape(ref))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -76,7 +76,7 @@ func (s *RepositoriesService) ListStatuses(ctx context.Context, owner, repo, ref
 //
 //meta:operation POST /repos/{owner}/{repo}/statuses/{sha}
 func (s *RepositoriesService) CreateStatus(ctx context.Context, owner, repo, ref string, status *RepoStatus) (*RepoStatus, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/statuses/%v", owner, repo, refURLEscape(ref))
+	u := fmt.Sprintf("repos/%v/%v/statuses/%v", PathEscape(owner), PathEscape(repo), refURLEscape(ref))
 	req, err := s.client.NewRequest("POST", u, status)
 	if err != nil {
 		return nil, nil, err
@@ -117,7 +117,7 @@ func (s CombinedStatus) String() string {
 //
 //meta:operation GET /repos/{owner}/{repo}/commits/{ref}/status
 func (s *RepositoriesService) GetCombinedStatus(ctx context.Context, owner, repo, ref string, opts *ListOptions) (*CombinedStatus, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/commits/%v/status", owner, repo, refURLEscape(ref))
+	u := fmt.Sprintf("repos/%v/%v/commits/%v/status", PathEscape(owner), PathEscape(repo), refURLEscape(ref))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
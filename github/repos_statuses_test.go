@@ -59,6 +59,8 @@ func TestRepositoriesService_ListStatuses_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListStatuses(ctx, "%", "r", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListStatuses(ctx, "..", "r", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_CreateStatus(t *testing.T) {
@@ -111,6 +113,8 @@ func TestRepositoriesService_CreateStatus_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.CreateStatus(ctx, "%", "r", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.CreateStatus(ctx, "..", "r", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_GetCombinedStatus(t *testing.T) {
@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
+	"unicode/utf8"
 )
 
 // TagProtection represents a repository tag protection.
@@ -28,7 +30,7 @@ type tagProtectionRequest struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/tags/protection
 func (s *RepositoriesService) ListTagProtection(ctx context.Context, owner, repo string) ([]*TagProtection, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/tags/protection", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/tags/protection", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -50,7 +52,7 @@ func (s *RepositoriesService) ListTagProtection(ctx context.Context, owner, repo
 //
 //meta:operation POST /repos/{owner}/{repo}/tags/protection
 func (s *RepositoriesService) CreateTagProtection(ctx context.Context, owner, repo, pattern string) (*TagProtection, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/tags/protection", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/tags/protection", PathEscape(owner), PathEscape(repo))
 	r := &tagProtectionRequest{Pattern: pattern}
 	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
@@ -72,7 +74,7 @@ func (s *RepositoriesService) CreateTagProtection(ctx context.Context, owner, re
 //
 //meta:operation DELETE /repos/{owner}/{repo}/tags/protection/{tag_protection_id}
 func (s *RepositoriesService) DeleteTagProtection(ctx context.Context, owner, repo string, tagProtectionID int64) (*Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/tags/protection/%v", owner, repo, tagProtectionID)
+	u := fmt.Sprintf("repos/%v/%v/tags/protection/%v", PathEscape(owner), PathEscape(repo), tagProtectionID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -80,3 +82,191 @@ func (s *RepositoriesService) DeleteTagProtection(ctx context.Context, owner, re
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// IsTagProtected reports whether creating, updating or deleting the given tag
+// is restricted by an active ruleset, including rulesets inherited from the
+// organization. The ref_name conditions of the rulesets targeting tags are
+// evaluated client-side against "refs/tags/<tag>", with the same glob
+// semantics as GitHub; see RulesetRefConditionParameters.MatchesRef.
+//
+// Rulesets in "evaluate" or "disabled" enforcement are ignored, as are
+// legacy tag protections; see ListTagProtection for those.
+//
+// The returned Response is that of the last request made.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/rules#get-a-repository-ruleset
+// GitHub API docs: https://docs.github.com/rest/repos/rules#get-all-repository-rulesets
+//
+//meta:operation GET /repos/{owner}/{repo}/rulesets
+//meta:operation GET /repos/{owner}/{repo}/rulesets/{ruleset_id}
+func (s *RepositoriesService) IsTagProtected(ctx context.Context, owner, repo, tag string) (bool, *Response, error) {
+	rulesets, resp, err := s.GetAllRulesets(ctx, owner, repo, true)
+	if err != nil {
+		return false, resp, err
+	}
+
+	ref := "refs/tags/" + tag
+	for _, summary := range rulesets {
+		if summary.GetTarget() != "tag" || summary.Enforcement != "active" {
+			continue
+		}
+
+		// The list endpoint omits conditions, so fetch the ruleset itself.
+		var rs *Ruleset
+		rs, resp, err = s.GetRuleset(ctx, owner, repo, summary.GetID(), true)
+		if err != nil {
+			return false, resp, err
+		}
+
+		if rs.GetConditions().GetRefName().MatchesRef(ref, "") {
+			return true, resp, nil
+		}
+	}
+
+	return false, resp, nil
+}
+
+// MatchesRef reports whether the fully qualified ref, such as
+// "refs/tags/v1.0.0", is targeted by the conditions: it must match at least
+// one of the Include patterns and none of the Exclude patterns.
+//
+// Patterns use fnmatch syntax, as in GitHub rulesets: "*" matches any
+// sequence of characters except "/", "**" matches any sequence including
+// "/", "?" matches a single character except "/", and "[...]" matches a
+// character class, negated with a leading "!" or "^". A backslash escapes
+// the next character. The special pattern "~ALL" matches every ref, and
+// "~DEFAULT_BRANCH" matches "refs/heads/" + defaultBranch if defaultBranch
+// is not empty.
+//
+// It returns false for nil conditions.
+func (p *RulesetRefConditionParameters) MatchesRef(ref, defaultBranch string) bool {
+	if p == nil {
+		return false
+	}
+
+	matches := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			switch pattern {
+			case "~ALL":
+				return true
+			case "~DEFAULT_BRANCH":
+				if defaultBranch != "" && ref == "refs/heads/"+defaultBranch {
+					return true
+				}
+			default:
+				if matchRefPattern(pattern, ref) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	return matches(p.Include) && !matches(p.Exclude)
+}
+
+// matchRefPattern reports whether name matches the fnmatch-style pattern, as
+// documented on RulesetRefConditionParameters.MatchesRef.
+func matchRefPattern(pattern, name string) bool {
+	for pattern != "" {
+		switch pattern[0] {
+		case '*':
+			if strings.HasPrefix(pattern, "**") {
+				rest := strings.TrimLeft(pattern, "*")
+				// "**/" also matches zero directories.
+				if strings.HasPrefix(rest, "/") && matchRefPattern(rest[1:], name) {
+					return true
+				}
+				for i := 0; i <= len(name); i++ {
+					if matchRefPattern(rest, name[i:]) {
+						return true
+					}
+				}
+				return false
+			}
+			rest := pattern[1:]
+			for i := 0; i <= len(name); i++ {
+				if matchRefPattern(rest, name[i:]) {
+					return true
+				}
+				if i < len(name) && name[i] == '/' {
+					break
+				}
+			}
+			return false
+
+		case '?':
+			r, n := utf8.DecodeRuneInString(name)
+			if n == 0 || r == '/' {
+				return false
+			}
+			pattern, name = pattern[1:], name[n:]
+
+		case '[':
+			r, n := utf8.DecodeRuneInString(name)
+			matched, width, ok := matchRefPatternClass(pattern, r)
+			if !ok {
+				// An unterminated class is a literal '['.
+				if !strings.HasPrefix(name, "[") {
+					return false
+				}
+				pattern, name = pattern[1:], name[1:]
+				continue
+			}
+			if n == 0 || r == '/' || !matched {
+				return false
+			}
+			pattern, name = pattern[width:], name[n:]
+
+		default:
+			if pattern[0] == '\\' && len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			pr, pn := utf8.DecodeRuneInString(pattern)
+			r, n := utf8.DecodeRuneInString(name)
+			if n == 0 || r != pr {
+				return false
+			}
+			pattern, name = pattern[pn:], name[n:]
+		}
+	}
+
+	return name == ""
+}
+
+// matchRefPatternClass reports whether r matches the character class at the
+// start of pattern, and the width of the class in bytes. ok is false if the
+// class is not terminated.
+func matchRefPatternClass(pattern string, r rune) (matched bool, width int, ok bool) {
+	i := 1
+	negated := false
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		negated = true
+		i++
+	}
+
+	for first := true; i < len(pattern); first = false {
+		if pattern[i] == ']' && !first {
+			return matched != negated, i + 1, true
+		}
+
+		lo, n := utf8.DecodeRuneInString(pattern[i:])
+		if lo == '\\' && i+n < len(pattern) {
+			i += n
+			lo, n = utf8.DecodeRuneInString(pattern[i:])
+		}
+		i += n
+
+		hi := lo
+		if i+1 < len(pattern) && pattern[i] == '-' && pattern[i+1] != ']' {
+			hi, n = utf8.DecodeRuneInString(pattern[i+1:])
+			i += 1 + n
+		}
+
+		if lo <= r && r <= hi {
+			matched = true
+		}
+	}
+
+	return false, 0, false
+}
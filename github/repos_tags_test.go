@@ -58,6 +58,8 @@ func TestRepositoriesService_ListTagProtection_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListTagProtection(ctx, "%", "r")
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListTagProtection(ctx, "..", "r")
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_CreateTagProtection(t *testing.T) {
@@ -146,3 +148,145 @@ func TestTagProtection_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestMatchRefPattern(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"refs/tags/v*", "refs/tags/v1.2.3", true},
+		{"refs/tags/v*", "refs/tags/v", true},
+		{"refs/tags/v*", "refs/tags/release-1", false},
+		{"refs/tags/v*", "refs/tags/v1/rc1", false},
+		{"refs/tags/*", "refs/tags/a/b", false},
+		{"refs/tags/**", "refs/tags/a/b", true},
+		{"refs/tags/**", "refs/tags/v1", true},
+		{"refs/tags/**/rc", "refs/tags/rc", true},
+		{"refs/tags/**/rc", "refs/tags/v1/2/rc", true},
+		{"refs/tags/**/rc", "refs/tags/v1/rc2", false},
+		{"**", "refs/heads/main", true},
+		{"refs/tags/v?", "refs/tags/v1", true},
+		{"refs/tags/v?", "refs/tags/v12", false},
+		{"refs/tags/v?", "refs/tags/v/", false},
+		{"refs/tags/[0-9]*", "refs/tags/1.0", true},
+		{"refs/tags/[0-9]*", "refs/tags/v1.0", false},
+		{"refs/tags/[!0-9]*", "refs/tags/v1.0", true},
+		{"refs/tags/[!0-9]*", "refs/tags/1.0", false},
+		{"refs/tags/[^0-9]*", "refs/tags/1.0", false},
+		{"refs/tags/[]a]", "refs/tags/]", true},
+		{"refs/tags/[!/]", "refs/tags//", false},
+		{"refs/tags/[v", "refs/tags/[v", true},
+		{`refs/tags/v\*`, "refs/tags/v*", true},
+		{`refs/tags/v\*`, "refs/tags/v1", false},
+		{"refs/tags/é*", "refs/tags/été", true},
+		{"refs/tags/v1", "refs/tags/v1", true},
+		{"refs/tags/v1", "refs/tags/v10", false},
+	}
+
+	for _, test := range tests {
+		if got := matchRefPattern(test.pattern, test.name); got != test.want {
+			t.Errorf("matchRefPattern(%q, %q) = %v, want %v", test.pattern, test.name, got, test.want)
+		}
+	}
+}
+
+func TestRulesetRefConditionParameters_MatchesRef(t *testing.T) {
+	tests := map[string]struct {
+		params        *RulesetRefConditionParameters
+		ref           string
+		defaultBranch string
+		want          bool
+	}{
+		"nil": {
+			ref: "refs/tags/v1",
+		},
+		"included": {
+			params: &RulesetRefConditionParameters{Include: []string{"refs/tags/v*"}},
+			ref:    "refs/tags/v1",
+			want:   true,
+		},
+		"excluded": {
+			params: &RulesetRefConditionParameters{Include: []string{"refs/tags/v*"}, Exclude: []string{"refs/tags/v*-rc*"}},
+			ref:    "refs/tags/v1-rc1",
+		},
+		"not included": {
+			params: &RulesetRefConditionParameters{Include: []string{"refs/tags/v*"}, Exclude: []string{}},
+			ref:    "refs/tags/nightly",
+		},
+		"all": {
+			params: &RulesetRefConditionParameters{Include: []string{"~ALL"}, Exclude: []string{"refs/tags/nightly"}},
+			ref:    "refs/tags/v1",
+			want:   true,
+		},
+		"all but excluded": {
+			params: &RulesetRefConditionParameters{Include: []string{"~ALL"}, Exclude: []string{"refs/tags/nightly"}},
+			ref:    "refs/tags/nightly",
+		},
+		"default branch": {
+			params:        &RulesetRefConditionParameters{Include: []string{"~DEFAULT_BRANCH"}},
+			ref:           "refs/heads/main",
+			defaultBranch: "main",
+			want:          true,
+		},
+		"default branch unknown": {
+			params: &RulesetRefConditionParameters{Include: []string{"~DEFAULT_BRANCH"}},
+			ref:    "refs/heads/main",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.params.MatchesRef(test.ref, test.defaultBranch); got != test.want {
+				t.Errorf("MatchesRef(%q, %q) = %v, want %v", test.ref, test.defaultBranch, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_IsTagProtected(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"includes_parents": "true"})
+		fmt.Fprint(w, `[
+			{"id": 1, "name": "branches", "target": "branch", "enforcement": "active"},
+			{"id": 2, "name": "trial", "target": "tag", "enforcement": "evaluate"},
+			{"id": 3, "name": "releases", "target": "tag", "enforcement": "active"}
+		]`)
+	})
+	mux.HandleFunc("/repos/o/r/rulesets/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"includes_parents": "true"})
+		fmt.Fprint(w, `{
+			"id": 3,
+			"name": "releases",
+			"target": "tag",
+			"enforcement": "active",
+			"conditions": {"ref_name": {"include": ["refs/tags/v*"], "exclude": ["refs/tags/v*-dev"]}}
+		}`)
+	})
+	for _, id := range []string{"1", "2"} {
+		mux.HandleFunc("/repos/o/r/rulesets/"+id, func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request for ruleset %v", r.URL.Path)
+		})
+	}
+
+	ctx := context.Background()
+	for tag, want := range map[string]bool{"v1.0.0": true, "v1.1-dev": false, "nightly": false} {
+		got, _, err := client.Repositories.IsTagProtected(ctx, "o", "r", tag)
+		if err != nil {
+			t.Errorf("Repositories.IsTagProtected(%q) returned error: %v", tag, err)
+		}
+		if got != want {
+			t.Errorf("Repositories.IsTagProtected(%q) = %v, want %v", tag, got, want)
+		}
+	}
+
+	const methodName = "IsTagProtected"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.IsTagProtected(ctx, "\n", "\n", "v1")
+		return err
+	})
+}
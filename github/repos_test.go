@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -128,6 +129,8 @@ func TestRepositoriesService_ListByUser_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListByUser(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListByUser(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_ListByOrg(t *testing.T) {
@@ -182,6 +185,8 @@ func TestRepositoriesService_ListByOrg_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListByOrg(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListByOrg(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_ListAll(t *testing.T) {
@@ -304,6 +309,78 @@ func TestRepositoriesService_Create_org(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_Create_orgMergeSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Repository{
+		Name:                     String("n"),
+		TeamID:                   Int64(7),
+		HasDiscussions:           Bool(true),
+		AllowUpdateBranch:        Bool(true),
+		SquashMergeCommitTitle:   String(SquashMergeCommitTitlePRTitle),
+		SquashMergeCommitMessage: String(SquashMergeCommitMessageCommitMessages),
+		MergeCommitTitle:         String(MergeCommitTitleMergeMessage),
+		MergeCommitMessage:       String(MergeCommitMessageBlank),
+		CustomProperties:         map[string]string{"team": "core"},
+	}
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"n","has_discussions":true,"team_id":7,"allow_update_branch":true,"squash_merge_commit_title":"PR_TITLE","squash_merge_commit_message":"COMMIT_MESSAGES","merge_commit_title":"MERGE_MESSAGE","merge_commit_message":"BLANK","custom_properties":{"team":"core"}}`+"\n")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.Create(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Repositories.Create returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_Create_useSquashPRTitleAsDefault(t *testing.T) {
+	tests := map[string]struct {
+		repo      *Repository
+		wantTitle string
+	}{
+		"true": {
+			repo:      &Repository{Name: String("n"), UseSquashPRTitleAsDefault: Bool(true)},
+			wantTitle: SquashMergeCommitTitlePRTitle,
+		},
+		"false": {
+			repo:      &Repository{Name: String("n"), UseSquashPRTitleAsDefault: Bool(false)},
+			wantTitle: SquashMergeCommitTitleCommitOrPRTitle,
+		},
+		"explicit title wins": {
+			repo: &Repository{
+				Name:                      String("n"),
+				UseSquashPRTitleAsDefault: Bool(true),
+				SquashMergeCommitTitle:    String(SquashMergeCommitTitleCommitOrPRTitle),
+			},
+			wantTitle: SquashMergeCommitTitleCommitOrPRTitle,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/user/repos", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testBody(t, r, `{"name":"n","squash_merge_commit_title":"`+tc.wantTitle+`"}`+"\n")
+				fmt.Fprint(w, `{"id":1}`)
+			})
+
+			ctx := context.Background()
+			_, _, err := client.Repositories.Create(ctx, "", tc.repo)
+			if err != nil {
+				t.Errorf("Repositories.Create returned error: %v", err)
+			}
+		})
+	}
+}
+
 func TestRepositoriesService_CreateFromTemplate(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -352,6 +429,75 @@ func TestRepositoriesService_CreateFromTemplate(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateFromTemplate_owner(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *TemplateRepoRequest
+		wantBody string
+	}{
+		{
+			name:     "authenticated user",
+			req:      &TemplateRepoRequest{Name: String("n"), IncludeAllBranches: Bool(true)},
+			wantBody: `{"name":"n","include_all_branches":true}`,
+		},
+		{
+			name:     "organization",
+			req:      &TemplateRepoRequest{Name: String("n"), Owner: String("org"), Description: String("d"), IncludeAllBranches: Bool(false), Private: Bool(true)},
+			wantBody: `{"name":"n","owner":"org","description":"d","include_all_branches":false,"private":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/to/tr/generate", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testBody(t, r, tt.wantBody+"\n")
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"id":1,"name":"n","owner":{"login":"org"},"template_repository":{"id":2,"name":"tr","is_template":true}}`)
+			})
+
+			ctx := context.Background()
+			got, _, err := client.Repositories.CreateFromTemplate(ctx, "to", "tr", tt.req)
+			if err != nil {
+				t.Fatalf("Repositories.CreateFromTemplate returned error: %v", err)
+			}
+
+			want := &Repository{
+				ID:                 Int64(1),
+				Name:               String("n"),
+				Owner:              &User{Login: String("org")},
+				TemplateRepository: &Repository{ID: Int64(2), Name: String("tr"), IsTemplate: Bool(true)},
+			}
+			if !cmp.Equal(got, want) {
+				t.Errorf("Repositories.CreateFromTemplate returned %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_Edit_isTemplate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"is_template":true}`+"\n")
+		fmt.Fprint(w, `{"id":1,"is_template":true}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.Edit(ctx, "o", "r", &Repository{IsTemplate: Bool(true)})
+	if err != nil {
+		t.Fatalf("Repositories.Edit returned error: %v", err)
+	}
+	if want := (&Repository{ID: Int64(1), IsTemplate: Bool(true)}); !cmp.Equal(got, want) {
+		t.Errorf("Repositories.Edit returned %+v, want %+v", got, want)
+	}
+}
+
 func TestRepositoriesService_Get(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -546,6 +692,8 @@ func TestRepositoriesService_Get_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.Get(ctx, "%", "r")
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.Get(ctx, "..", "r")
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_Edit_invalidOwner(t *testing.T) {
@@ -555,6 +703,8 @@ func TestRepositoriesService_Edit_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.Edit(ctx, "%", "r", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.Edit(ctx, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_GetVulnerabilityAlerts(t *testing.T) {
@@ -729,7 +879,7 @@ func TestRepositoriesService_ListContributors(t *testing.T) {
 		fmt.Fprint(w, `[{"contributions":42}]`)
 	})
 
-	opts := &ListContributorsOptions{Anon: "true", ListOptions: ListOptions{Page: 2}}
+	opts := &ListContributorsOptions{Anon: true, ListOptions: ListOptions{Page: 2}}
 	ctx := context.Background()
 	contributors, _, err := client.Repositories.ListContributors(ctx, "o", "r", opts)
 	if err != nil {
@@ -756,6 +906,141 @@ func TestRepositoriesService_ListContributors(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListContributors_anonymous(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"anon": "true"})
+		fmt.Fprint(w, `[
+			{"login":"octocat","id":1,"type":"User","site_admin":false,"contributions":42},
+			{"email":"mona@example.com","name":"Mona","type":"Anonymous","contributions":7}
+		]`)
+	})
+
+	ctx := context.Background()
+	contributors, _, err := client.Repositories.ListContributors(ctx, "o", "r", &ListContributorsOptions{Anon: true})
+	if err != nil {
+		t.Errorf("Repositories.ListContributors returned error: %v", err)
+	}
+
+	want := []*Contributor{
+		{Login: String("octocat"), ID: Int64(1), Type: String("User"), SiteAdmin: Bool(false), Contributions: Int(42)},
+		{Email: String("mona@example.com"), Name: String("Mona"), Type: String("Anonymous"), Contributions: Int(7)},
+	}
+	if !cmp.Equal(contributors, want) {
+		t.Errorf("Repositories.ListContributors returned %+v, want %+v", contributors, want)
+	}
+
+	for i, wantAnonymous := range []bool{false, true} {
+		if got := contributors[i].IsAnonymous(); got != wantAnonymous {
+			t.Errorf("contributors[%v].IsAnonymous() = %v, want %v", i, got, wantAnonymous)
+		}
+	}
+}
+
+func TestRepositoriesService_ListContributors_accepted(t *testing.T) {
+	tests := map[string]struct {
+		retries      int
+		wantAccepted bool
+		wantRequests int
+	}{
+		"no retries": {
+			wantAccepted: true,
+			wantRequests: 1,
+		},
+		"retried until ready": {
+			retries:      3,
+			wantRequests: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			requests := 0
+			mux.HandleFunc("/repos/o/r/contributors", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testFormValues(t, r, values{})
+				requests++
+				if requests == 1 {
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				fmt.Fprint(w, `[{"contributions":42}]`)
+			})
+
+			opts := &ListContributorsOptions{MaxAcceptedRetries: test.retries, AcceptedRetryDelay: time.Millisecond}
+			ctx := context.Background()
+			contributors, resp, err := client.Repositories.ListContributors(ctx, "o", "r", opts)
+
+			if requests != test.wantRequests {
+				t.Errorf("Repositories.ListContributors sent %v requests, want %v", requests, test.wantRequests)
+			}
+			if test.wantAccepted {
+				var acceptedError *AcceptedError
+				if !errors.As(err, &acceptedError) {
+					t.Errorf("Repositories.ListContributors returned error %v, want an *AcceptedError", err)
+				}
+				if resp.StatusCode != http.StatusAccepted {
+					t.Errorf("Repositories.ListContributors returned status %v, want %v", resp.StatusCode, http.StatusAccepted)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Repositories.ListContributors returned error: %v", err)
+			}
+			want := []*Contributor{{Contributions: Int(42)}}
+			if !cmp.Equal(contributors, want) {
+				t.Errorf("Repositories.ListContributors returned %+v, want %+v", contributors, want)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_ListContributors_acceptedContextCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/repos/o/r/contributors", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	opts := &ListContributorsOptions{MaxAcceptedRetries: 3, AcceptedRetryDelay: time.Hour}
+	_, _, err := client.Repositories.ListContributors(ctx, "o", "r", opts)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Repositories.ListContributors returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRepositoriesService_ListContributors_tooLarge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contributors", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"The history or contributor list is too large to list contributors for this repository via the API.","documentation_url":"https://docs.github.com/rest/repos/repos#list-repository-contributors"}`)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.ListContributors(ctx, "o", "r", nil)
+	if !errors.Is(err, ErrContributorListTooLarge) {
+		t.Errorf("Repositories.ListContributors returned error %v, want %v", err, ErrContributorListTooLarge)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Repositories.ListContributors returned error %v, want an *ErrorResponse", err)
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Repositories.ListContributors returned status %v, want %v", resp.StatusCode, http.StatusForbidden)
+	}
+}
+
 func TestRepositoriesService_ListLanguages(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -771,7 +1056,7 @@ func TestRepositoriesService_ListLanguages(t *testing.T) {
 		t.Errorf("Repositories.ListLanguages returned error: %v", err)
 	}
 
-	want := map[string]int{"go": 1}
+	want := RepositoryLanguages{"go": 1}
 	if !cmp.Equal(languages, want) {
 		t.Errorf("Repositories.ListLanguages returned %+v, want %+v", languages, want)
 	}
@@ -838,7 +1123,7 @@ func TestRepositoriesService_ListTags(t *testing.T) {
 		fmt.Fprint(w, `[{"name":"n", "commit" : {"sha" : "s", "url" : "u"}, "zipball_url": "z", "tarball_url": "t"}]`)
 	})
 
-	opt := &ListOptions{Page: 2}
+	opt := &ListTagsOptions{ListOptions: ListOptions{Page: 2}}
 	ctx := context.Background()
 	tags, _, err := client.Repositories.ListTags(ctx, "o", "r", opt)
 	if err != nil {
@@ -875,6 +1160,71 @@ func TestRepositoriesService_ListTags(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListTags_dereference(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"v2","commit":{"sha":"c2"}},{"name":"v1","commit":{"sha":"c1"}}]`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ref":"refs/tags/v2","object":{"type":"tag","sha":"t2"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ref":"refs/tags/v1","object":{"type":"commit","sha":"c1"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/tags/t2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"t2","tag":"v2","message":"Release 2","verification":{"verified":true,"reason":"valid"}}`)
+	})
+
+	ctx := context.Background()
+	tags, _, err := client.Repositories.ListTags(ctx, "o", "r", &ListTagsOptions{Dereference: true})
+	if err != nil {
+		t.Fatalf("Repositories.ListTags returned error: %v", err)
+	}
+
+	want := []*RepositoryTag{
+		{
+			Name:   String("v2"),
+			Commit: &Commit{SHA: String("c2")},
+			Tag: &Tag{
+				SHA:          String("t2"),
+				Tag:          String("v2"),
+				Message:      String("Release 2"),
+				Verification: &SignatureVerification{Verified: Bool(true), Reason: String("valid")},
+			},
+		},
+		{Name: String("v1"), Commit: &Commit{SHA: String("c1")}},
+	}
+	if !cmp.Equal(tags, want) {
+		t.Errorf("Repositories.ListTags returned %+v, want %+v", tags, want)
+	}
+}
+
+func TestRepositoriesService_ListTags_dereferenceError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/tags", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"v1","commit":{"sha":"c1"}}]`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	tags, resp, err := client.Repositories.ListTags(ctx, "o", "r", &ListTagsOptions{Dereference: true})
+	if err == nil {
+		t.Fatal("Repositories.ListTags returned no error, want one")
+	}
+	if tags != nil {
+		t.Errorf("Repositories.ListTags returned %+v, want nil", tags)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Repositories.ListTags returned response %+v, want 404", resp)
+	}
+}
 func TestRepositoriesService_ListBranches(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1937,6 +2287,63 @@ func TestRepositoriesService_UpdateBranchProtection_Checks(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_UpdateBranchProtection_AnyAppCheckAndLockBranch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &ProtectionRequest{
+		RequiredStatusChecks: &RequiredStatusChecks{
+			Strict: true,
+			Checks: &[]*RequiredStatusCheck{
+				{
+					Context: "continuous-integration",
+					AppID:   Int64(-1),
+				},
+			},
+		},
+		LockBranch:       Bool(true),
+		AllowForkSyncing: Bool(true),
+	}
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"required_status_checks":{"strict":true,"checks":[{"context":"continuous-integration","app_id":-1}]},"required_pull_request_reviews":null,"enforce_admins":false,"restrictions":null,"lock_branch":true,"allow_fork_syncing":true}`+"\n")
+		fmt.Fprint(w, `{
+			"required_status_checks":{
+				"strict":true,
+				"contexts":["continuous-integration"],
+				"checks":[{"context":"continuous-integration","app_id":-1}]
+			},
+			"lock_branch":{"enabled":true},
+			"allow_fork_syncing":{"enabled":true}
+		}`)
+	})
+
+	ctx := context.Background()
+	protection, _, err := client.Repositories.UpdateBranchProtection(ctx, "o", "r", "b", input)
+	if err != nil {
+		t.Errorf("Repositories.UpdateBranchProtection returned error: %v", err)
+	}
+
+	want := &Protection{
+		RequiredStatusChecks: &RequiredStatusChecks{
+			Strict:   true,
+			Contexts: &[]string{"continuous-integration"},
+			Checks: &[]*RequiredStatusCheck{
+				{
+					Context: "continuous-integration",
+					AppID:   Int64(-1),
+				},
+			},
+		},
+		LockBranch:       &LockBranch{Enabled: Bool(true)},
+		AllowForkSyncing: &AllowForkSyncing{Enabled: Bool(true)},
+	}
+	if !cmp.Equal(protection, want) {
+		t.Errorf("Repositories.UpdateBranchProtection returned %+v, want %+v", protection, want)
+	}
+}
+
 func TestRepositoriesService_UpdateBranchProtection_EmptyChecks(t *testing.T) {
 	tests := []struct {
 		branch  string
@@ -2321,6 +2728,8 @@ func TestRepositoriesService_ListLanguages_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Repositories.ListLanguages(ctx, "%", "%")
 	testURLParseError(t, err)
+	_, _, err = client.Repositories.ListLanguages(ctx, "..", "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestRepositoriesService_License(t *testing.T) {
@@ -4108,53 +4517,68 @@ func TestRepositoriesService_Dispatch(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	var input DispatchRequestOptions
+	var wantBody string
 
 	mux.HandleFunc("/repos/o/r/dispatches", func(w http.ResponseWriter, r *http.Request) {
-		var v DispatchRequestOptions
-		assertNilError(t, json.NewDecoder(r.Body).Decode(&v))
-
 		testMethod(t, r, "POST")
-		if !cmp.Equal(v, input) {
-			t.Errorf("Request body = %+v, want %+v", v, input)
-		}
+		testBody(t, r, wantBody+"\n")
 
 		fmt.Fprint(w, `{"owner":{"login":"a"}}`)
 	})
 
 	ctx := context.Background()
 
-	testCases := []interface{}{
-		nil,
-		struct {
-			Foo string
-		}{
-			Foo: "test",
+	rawPayload := json.RawMessage(`{"raw":true}`)
+	testCases := []struct {
+		payload interface{}
+		body    string
+	}{
+		{
+			payload: nil,
+			body:    `{"event_type":"go"}`,
+		},
+		{
+			payload: struct {
+				Foo string `json:"foo"`
+			}{
+				Foo: "test",
+			},
+			body: `{"event_type":"go","client_payload":{"foo":"test"}}`,
+		},
+		{
+			payload: &struct {
+				Foo string `json:"foo"`
+				Bar int    `json:"bar"`
+				Baz bool   `json:"baz"`
+			}{
+				Foo: "test",
+				Bar: 42,
+				Baz: false,
+			},
+			body: `{"event_type":"go","client_payload":{"foo":"test","bar":42,"baz":false}}`,
+		},
+		{
+			payload: map[string]interface{}{"unit": false, "integration": true},
+			body:    `{"event_type":"go","client_payload":{"integration":true,"unit":false}}`,
 		},
-		struct {
-			Bar int
-		}{
-			Bar: 42,
+		{
+			payload: &rawPayload,
+			body:    `{"event_type":"go","client_payload":{"raw":true}}`,
 		},
-		struct {
-			Foo string
-			Bar int
-			Baz bool
-		}{
-			Foo: "test",
-			Bar: 42,
-			Baz: false,
+		{
+			payload: (*json.RawMessage)(nil),
+			body:    `{"event_type":"go"}`,
+		},
+		{
+			payload: map[string]interface{}(nil),
+			body:    `{"event_type":"go"}`,
 		},
 	}
 
+	var input DispatchRequestOptions
 	for _, tc := range testCases {
-		if tc == nil {
-			input = DispatchRequestOptions{EventType: "go"}
-		} else {
-			bytes, _ := json.Marshal(tc)
-			payload := json.RawMessage(bytes)
-			input = DispatchRequestOptions{EventType: "go", ClientPayload: &payload}
-		}
+		input = DispatchRequestOptions{EventType: "go", ClientPayload: tc.payload}
+		wantBody = tc.body
 
 		got, _, err := client.Repositories.Dispatch(ctx, "o", "r", input)
 		if err != nil {
@@ -4182,6 +4606,139 @@ func TestRepositoriesService_Dispatch(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_Dispatch_invalidOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.Dispatch should not make a request for invalid options")
+	})
+
+	tooManyKeys := make(map[string]int)
+	for i := 0; i < 11; i++ {
+		tooManyKeys[fmt.Sprintf("k%v", i)] = i
+	}
+	tenKeys := make(map[string]int)
+	for i := 0; i < 10; i++ {
+		tenKeys[fmt.Sprintf("k%v", i)] = i
+	}
+
+	tests := map[string]struct {
+		opts    DispatchRequestOptions
+		wantErr bool
+	}{
+		"empty event type":    {opts: DispatchRequestOptions{}, wantErr: true},
+		"event type too long": {opts: DispatchRequestOptions{EventType: strings.Repeat("e", 101)}, wantErr: true},
+		"too many keys":       {opts: DispatchRequestOptions{EventType: "go", ClientPayload: tooManyKeys}, wantErr: true},
+		"payload not object":  {opts: DispatchRequestOptions{EventType: "go", ClientPayload: []string{"a"}}, wantErr: true},
+		"payload not JSON":    {opts: DispatchRequestOptions{EventType: "go", ClientPayload: make(chan int)}, wantErr: true},
+		"max event type":      {opts: DispatchRequestOptions{EventType: strings.Repeat("é", 100)}},
+		"max keys":            {opts: DispatchRequestOptions{EventType: "go", ClientPayload: tenKeys}},
+		"nil pointer payload": {opts: DispatchRequestOptions{EventType: "go", ClientPayload: (*json.RawMessage)(nil)}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.opts.validate()
+			if test.wantErr && err == nil {
+				t.Error("validate returned nil error, want error")
+			}
+			if !test.wantErr && err != nil {
+				t.Errorf("validate returned error: %v", err)
+			}
+			if test.wantErr {
+				if _, _, err := client.Repositories.Dispatch(context.Background(), "o", "r", test.opts); err == nil {
+					t.Error("Repositories.Dispatch returned nil error, want error")
+				}
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_DispatchAndWaitForRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { dispatchRunPollInterval = d }(dispatchRunPollInterval)
+	dispatchRunPollInterval = time.Millisecond
+
+	mux.HandleFunc("/repos/o/r/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"event_type":"deploy","client_payload":{"env":"prod"}}`+"\n")
+		w.Header().Set("Date", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	polls := 0
+	mux.HandleFunc("/repos/o/r/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"event": "repository_dispatch", "created": ">=2006-01-02T15:04:05Z"})
+		polls++
+		if polls < 3 {
+			fmt.Fprint(w, `{"total_count":0,"workflow_runs":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"total_count":2,"workflow_runs":[{"id":2,"event":"repository_dispatch"},{"id":1,"event":"repository_dispatch"}]}`)
+	})
+
+	ctx := context.Background()
+	opts := DispatchRequestOptions{EventType: "deploy", ClientPayload: map[string]string{"env": "prod"}}
+	run, err := client.Repositories.DispatchAndWaitForRun(ctx, "o", "r", opts, 0)
+	if err != nil {
+		t.Fatalf("Repositories.DispatchAndWaitForRun returned error: %v", err)
+	}
+
+	want := &WorkflowRun{ID: Int64(1), Event: String("repository_dispatch")}
+	if !cmp.Equal(run, want) {
+		t.Errorf("Repositories.DispatchAndWaitForRun returned %+v, want %+v", run, want)
+	}
+	if polls != 3 {
+		t.Errorf("Repositories.DispatchAndWaitForRun polled %v times, want 3", polls)
+	}
+}
+
+func TestRepositoriesService_DispatchAndWaitForRun_timeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { dispatchRunPollInterval = d }(dispatchRunPollInterval)
+	dispatchRunPollInterval = time.Millisecond
+
+	mux.HandleFunc("/repos/o/r/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/r/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count":0,"workflow_runs":[]}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Repositories.DispatchAndWaitForRun(ctx, "o", "r", DispatchRequestOptions{EventType: "go"}, 20*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Repositories.DispatchAndWaitForRun returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRepositoriesService_DispatchAndWaitForRun_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.Repositories.DispatchAndWaitForRun(ctx, "o", "r", DispatchRequestOptions{}, 0); err == nil {
+		t.Error("Repositories.DispatchAndWaitForRun returned nil error for invalid options, want error")
+	}
+
+	mux.HandleFunc("/repos/o/r/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/r/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	if _, err := client.Repositories.DispatchAndWaitForRun(ctx, "o", "r", DispatchRequestOptions{EventType: "go"}, 0); err == nil {
+		t.Error("Repositories.DispatchAndWaitForRun returned nil error, want error")
+	}
+}
+
 func TestAdvancedSecurity_Marshal(t *testing.T) {
 	testJSONMarshal(t, &AdvancedSecurity{}, "{}")
 
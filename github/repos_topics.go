@@ -0,0 +1,229 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// The limits of the topics of a repository.
+const (
+	MaxRepositoryTopics = 50
+	MaxTopicLength      = 35
+)
+
+var (
+	// ErrTooManyTopics is returned when more than MaxRepositoryTopics topics
+	// would be set on a repository.
+	ErrTooManyTopics = errors.New("too many repository topics")
+	// ErrInvalidTopic is returned for a topic that GitHub would reject.
+	ErrInvalidTopic = errors.New("invalid repository topic")
+)
+
+// topicRegexp matches the topics accepted by GitHub.
+var topicRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// maxTopicsAttempts is the number of times AddTopics and RemoveTopics try to
+// update the topics when they are changed concurrently.
+const maxTopicsAttempts = 3
+
+// TopicsOptions specifies the optional parameters to the methods of
+// RepositoriesService that set repository topics.
+type TopicsOptions struct {
+	// DisableNormalization sends the topics as given, instead of normalizing
+	// them with NormalizeTopics. They are still checked against
+	// MaxRepositoryTopics and MaxTopicLength.
+	DisableNormalization bool
+}
+
+// NormalizeTopics returns topics in the form GitHub accepts: lower case,
+// with surrounding whitespace trimmed and inner whitespace replaced by "-".
+// Empty and duplicate topics are dropped, and the order is preserved.
+func NormalizeTopics(topics []string) []string {
+	normalized := make([]string, 0, len(topics))
+	seen := make(map[string]bool, len(topics))
+	for _, t := range topics {
+		t = strings.Join(strings.FieldsFunc(strings.ToLower(t), unicode.IsSpace), "-")
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		normalized = append(normalized, t)
+	}
+	return normalized
+}
+
+// ValidateTopics reports whether GitHub accepts topics: at most
+// MaxRepositoryTopics topics, each at most MaxTopicLength characters long and
+// made of lower case letters, numbers and hyphens, starting with a letter or
+// a number. The returned error wraps ErrTooManyTopics or ErrInvalidTopic.
+func ValidateTopics(topics []string) error {
+	if err := checkTopicLimits(topics); err != nil {
+		return err
+	}
+	for _, t := range topics {
+		if !topicRegexp.MatchString(t) {
+			return fmt.Errorf("%w: %q must contain only lower case letters, numbers and hyphens, and start with a letter or a number", ErrInvalidTopic, t)
+		}
+	}
+	return nil
+}
+
+func checkTopicLimits(topics []string) error {
+	if len(topics) > MaxRepositoryTopics {
+		return fmt.Errorf("%w: %v topics, the maximum is %v", ErrTooManyTopics, len(topics), MaxRepositoryTopics)
+	}
+	for _, t := range topics {
+		if n := len([]rune(t)); n > MaxTopicLength {
+			return fmt.Errorf("%w: %q is %v characters long, the maximum is %v", ErrInvalidTopic, t, n, MaxTopicLength)
+		}
+	}
+	return nil
+}
+
+// prepareTopics returns the topics to send according to opts.
+func prepareTopics(topics []string, opts *TopicsOptions) ([]string, error) {
+	if opts != nil && opts.DisableNormalization {
+		return topics, checkTopicLimits(topics)
+	}
+	topics = NormalizeTopics(topics)
+	return topics, ValidateTopics(topics)
+}
+
+// AddTopics adds topics to the topics of a repository, and returns all its
+// topics. The topics are read and replaced conditionally on their ETag, and
+// read again if they are changed concurrently, a few times at most. GitHub
+// may not honor the condition, in which case a concurrent change can still
+// be lost.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#get-all-repository-topics
+// GitHub API docs: https://docs.github.com/rest/repos/repos#replace-all-repository-topics
+//
+//meta:operation GET /repos/{owner}/{repo}/topics
+//meta:operation PUT /repos/{owner}/{repo}/topics
+func (s *RepositoriesService) AddTopics(ctx context.Context, owner, repo string, topics []string, opts *TopicsOptions) ([]string, *Response, error) {
+	return s.updateTopics(ctx, owner, repo, opts, func(current []string) []string {
+		return append(current, topics...)
+	})
+}
+
+// RemoveTopics removes topics from the topics of a repository, and returns
+// its remaining topics. Topics are compared after normalization, unless it is
+// disabled by opts. Concurrent changes are handled as by AddTopics.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#get-all-repository-topics
+// GitHub API docs: https://docs.github.com/rest/repos/repos#replace-all-repository-topics
+//
+//meta:operation GET /repos/{owner}/{repo}/topics
+//meta:operation PUT /repos/{owner}/{repo}/topics
+func (s *RepositoriesService) RemoveTopics(ctx context.Context, owner, repo string, topics []string, opts *TopicsOptions) ([]string, *Response, error) {
+	if opts == nil || !opts.DisableNormalization {
+		topics = NormalizeTopics(topics)
+	}
+	remove := make(map[string]bool, len(topics))
+	for _, t := range topics {
+		remove[t] = true
+	}
+	return s.updateTopics(ctx, owner, repo, opts, func(current []string) []string {
+		kept := make([]string, 0, len(current))
+		for _, t := range current {
+			if !remove[t] {
+				kept = append(kept, t)
+			}
+		}
+		return kept
+	})
+}
+
+// updateTopics replaces the topics of a repository with update of its
+// current topics, retrying when they are changed concurrently.
+func (s *RepositoriesService) updateTopics(ctx context.Context, owner, repo string, opts *TopicsOptions, update func([]string) []string) ([]string, *Response, error) {
+	var resp *Response
+	for attempt := 1; ; attempt++ {
+		current, getResp, err := s.ListAllTopics(ctx, owner, repo)
+		if err != nil {
+			return nil, getResp, err
+		}
+
+		var updated []string
+		updated, resp, err = s.replaceTopics(ctx, owner, repo, update(current), opts, getResp.Header.Get("ETag"))
+		if err == nil {
+			return updated, resp, nil
+		}
+		if resp == nil || attempt == maxTopicsAttempts ||
+			(resp.StatusCode != http.StatusPreconditionFailed && resp.StatusCode != http.StatusConflict) {
+			return nil, resp, err
+		}
+	}
+}
+
+// RepositoryLanguages maps the languages of a repository to the number of
+// bytes of code written in each of them, as returned by
+// RepositoriesService.ListLanguages.
+type RepositoryLanguages map[string]int
+
+// Percentages returns the share of each language in l, in percent rounded to
+// precision decimal places. The shares are rounded so that they sum to 100,
+// unless l has no code at all, in which case every share is 0.
+func (l RepositoryLanguages) Percentages(precision int) map[string]float64 {
+	shares := make(map[string]float64, len(l))
+	var total int64
+	for lang, n := range l {
+		shares[lang] = 0
+		if n > 0 {
+			total += int64(n)
+		}
+	}
+	if total == 0 {
+		return shares
+	}
+
+	// Apportion the units of 10^-precision percent by the largest remainder
+	// method, breaking ties by language name for a stable result.
+	if precision < 0 {
+		precision = 0
+	}
+	scale := math.Pow10(precision)
+	units := int64(math.Round(100 * scale))
+	type share struct {
+		lang      string
+		units     int64
+		remainder float64
+	}
+	all := make([]share, 0, len(l))
+	assigned := int64(0)
+	for lang, n := range l {
+		if n <= 0 {
+			continue
+		}
+		exact := float64(n) * float64(units) / float64(total)
+		floor := math.Floor(exact)
+		all = append(all, share{lang: lang, units: int64(floor), remainder: exact - floor})
+		assigned += int64(floor)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].remainder != all[j].remainder {
+			return all[i].remainder > all[j].remainder
+		}
+		return all[i].lang < all[j].lang
+	})
+	for i := 0; assigned < units; i++ {
+		all[i%len(all)].units++
+		assigned++
+	}
+	for _, s := range all {
+		shares[s.lang] = float64(s.units) / scale
+	}
+	return shares
+}
@@ -0,0 +1,314 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNormalizeTopics(t *testing.T) {
+	got := NormalizeTopics([]string{" Go ", "Machine Learning", "go", "", "  ", "go-github", "GO-GitHub", "a\tb  c"})
+	want := []string{"go", "machine-learning", "go-github", "a-b-c"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("NormalizeTopics returned %q, want %q", got, want)
+	}
+}
+
+func TestValidateTopics(t *testing.T) {
+	tooMany := make([]string, MaxRepositoryTopics+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("t%v", i)
+	}
+
+	tests := map[string]struct {
+		topics []string
+		want   error
+	}{
+		"valid":       {[]string{"go", "go-github", "3d"}, nil},
+		"limit":       {tooMany[:MaxRepositoryTopics], nil},
+		"max length":  {[]string{strings.Repeat("a", MaxTopicLength)}, nil},
+		"too many":    {tooMany, ErrTooManyTopics},
+		"too long":    {[]string{strings.Repeat("a", MaxTopicLength+1)}, ErrInvalidTopic},
+		"upper case":  {[]string{"Go"}, ErrInvalidTopic},
+		"space":       {[]string{"machine learning"}, ErrInvalidTopic},
+		"hyphen":      {[]string{"-go"}, ErrInvalidTopic},
+		"empty topic": {[]string{""}, ErrInvalidTopic},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTopics(tc.topics)
+			if tc.want == nil && err != nil {
+				t.Errorf("ValidateTopics returned error: %v", err)
+			}
+			if tc.want != nil && !errors.Is(err, tc.want) {
+				t.Errorf("ValidateTopics returned error %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_ReplaceAllTopics_normalized(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"names":["go","machine-learning"]}`+"\n")
+		fmt.Fprint(w, `{"names":["go","machine-learning"]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.ReplaceAllTopics(ctx, "o", "r", []string{"Go", "Machine Learning", "go"})
+	if err != nil {
+		t.Fatalf("Repositories.ReplaceAllTopics returned error: %v", err)
+	}
+
+	want := []string{"go", "machine-learning"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ReplaceAllTopics returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_ReplaceTopics_disableNormalization(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		testBody(t, r, `{"names":["Go"]}`+"\n")
+		fmt.Fprint(w, `{"names":["go"]}`)
+	})
+
+	ctx := context.Background()
+	opts := &TopicsOptions{DisableNormalization: true}
+	got, _, err := client.Repositories.ReplaceTopics(ctx, "o", "r", []string{"Go"}, opts)
+	if err != nil {
+		t.Fatalf("Repositories.ReplaceTopics returned error: %v", err)
+	}
+
+	want := []string{"go"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ReplaceTopics returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "ReplaceTopics"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ReplaceTopics(ctx, "\n", "\n", []string{"go"}, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ReplaceTopics(ctx, "o", "r", []string{"go"}, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_ReplaceTopics_limits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("ReplaceTopics sent a request for invalid topics")
+	})
+
+	tooMany := make([]string, MaxRepositoryTopics+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("t%v", i)
+	}
+	tooLong := []string{strings.Repeat("a", MaxTopicLength+1)}
+
+	ctx := context.Background()
+	for _, opts := range []*TopicsOptions{nil, {DisableNormalization: true}} {
+		if _, resp, err := client.Repositories.ReplaceTopics(ctx, "o", "r", tooMany, opts); !errors.Is(err, ErrTooManyTopics) || resp != nil {
+			t.Errorf("Repositories.ReplaceTopics(%+v) returned %v and error %v, want ErrTooManyTopics", opts, resp, err)
+		}
+		if _, _, err := client.Repositories.ReplaceTopics(ctx, "o", "r", tooLong, opts); !errors.Is(err, ErrInvalidTopic) {
+			t.Errorf("Repositories.ReplaceTopics(%+v) returned error %v, want ErrInvalidTopic", opts, err)
+		}
+	}
+	if _, _, err := client.Repositories.ReplaceAllTopics(ctx, "o", "r", []string{"go_github"}); !errors.Is(err, ErrInvalidTopic) {
+		t.Errorf("Repositories.ReplaceAllTopics returned error %v, want ErrInvalidTopic", err)
+	}
+}
+
+func TestRepositoriesService_AddTopics(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Header().Set("ETag", `"1"`)
+			fmt.Fprint(w, `{"names":["go","github"]}`)
+		case "PUT":
+			testHeader(t, r, "If-Match", `"1"`)
+			testBody(t, r, `{"names":["go","github","api-client"]}`+"\n")
+			fmt.Fprint(w, `{"names":["go","github","api-client"]}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.AddTopics(ctx, "o", "r", []string{"API Client", "Go"}, nil)
+	if err != nil {
+		t.Fatalf("Repositories.AddTopics returned error: %v", err)
+	}
+
+	want := []string{"go", "github", "api-client"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.AddTopics returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "AddTopics"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.AddTopics(ctx, "\n", "\n", []string{"go"}, nil)
+		return err
+	})
+}
+
+func TestRepositoriesService_RemoveTopics_conflict(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var gets, puts int
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			gets++
+			if gets == 1 {
+				w.Header().Set("ETag", `"1"`)
+				fmt.Fprint(w, `{"names":["go","github"]}`)
+				return
+			}
+			w.Header().Set("ETag", `"2"`)
+			fmt.Fprint(w, `{"names":["go","github","api"]}`)
+		case "PUT":
+			puts++
+			if puts == 1 {
+				testHeader(t, r, "If-Match", `"1"`)
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			testHeader(t, r, "If-Match", `"2"`)
+			testBody(t, r, `{"names":["go","api"]}`+"\n")
+			fmt.Fprint(w, `{"names":["go","api"]}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.RemoveTopics(ctx, "o", "r", []string{"GitHub"}, nil)
+	if err != nil {
+		t.Fatalf("Repositories.RemoveTopics returned error: %v", err)
+	}
+
+	want := []string{"go", "api"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.RemoveTopics returned %+v, want %+v", got, want)
+	}
+	if gets != 2 || puts != 2 {
+		t.Errorf("Repositories.RemoveTopics read the topics %v times and replaced them %v times, want 2 and 2", gets, puts)
+	}
+}
+
+func TestRepositoriesService_RemoveTopics_conflictLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var puts int
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			puts++
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		fmt.Fprint(w, `{"names":["go"]}`)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.RemoveTopics(ctx, "o", "r", []string{"go"}, nil)
+	if err == nil || resp.StatusCode != http.StatusConflict {
+		t.Errorf("Repositories.RemoveTopics returned %v and error %v, want a conflict", resp, err)
+	}
+	if puts != maxTopicsAttempts {
+		t.Errorf("Repositories.RemoveTopics replaced the topics %v times, want %v", puts, maxTopicsAttempts)
+	}
+}
+
+func TestRepositoryLanguages_Percentages(t *testing.T) {
+	tests := map[string]struct {
+		languages RepositoryLanguages
+		precision int
+		want      map[string]float64
+	}{
+		"thirds": {
+			RepositoryLanguages{"Go": 1, "C": 1, "Python": 1},
+			2,
+			map[string]float64{"C": 33.34, "Go": 33.33, "Python": 33.33},
+		},
+		"integers": {
+			RepositoryLanguages{"Go": 78769, "Python": 7769, "Shell": 120},
+			0,
+			map[string]float64{"Go": 91, "Python": 9, "Shell": 0},
+		},
+		"single": {
+			RepositoryLanguages{"Go": 42},
+			1,
+			map[string]float64{"Go": 100},
+		},
+		"no code": {
+			RepositoryLanguages{"Go": 0},
+			2,
+			map[string]float64{"Go": 0},
+		},
+		"empty": {
+			RepositoryLanguages{},
+			2,
+			map[string]float64{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.languages.Percentages(tc.precision)
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("Percentages returned %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRepositoryLanguages_Percentages_sum(t *testing.T) {
+	languages := RepositoryLanguages{}
+	for i := 1; i <= 17; i++ {
+		languages[fmt.Sprintf("lang%v", i)] = i * 7919
+	}
+
+	for precision := 0; precision <= 3; precision++ {
+		var sum float64
+		for _, share := range languages.Percentages(precision) {
+			sum += share
+		}
+		if math.Abs(sum-100) > 1e-9 {
+			t.Errorf("Percentages(%v) sum to %v, want 100", precision, sum)
+		}
+	}
+}
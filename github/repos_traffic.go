@@ -58,7 +58,7 @@ type TrafficBreakdownOptions struct {
 //
 //meta:operation GET /repos/{owner}/{repo}/traffic/popular/referrers
 func (s *RepositoriesService) ListTrafficReferrers(ctx context.Context, owner, repo string) ([]*TrafficReferrer, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/traffic/popular/referrers", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/traffic/popular/referrers", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -80,7 +80,7 @@ func (s *RepositoriesService) ListTrafficReferrers(ctx context.Context, owner, r
 //
 //meta:operation GET /repos/{owner}/{repo}/traffic/popular/paths
 func (s *RepositoriesService) ListTrafficPaths(ctx context.Context, owner, repo string) ([]*TrafficPath, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/traffic/popular/paths", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/traffic/popular/paths", PathEscape(owner), PathEscape(repo))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -102,7 +102,7 @@ func (s *RepositoriesService) ListTrafficPaths(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/traffic/views
 func (s *RepositoriesService) ListTrafficViews(ctx context.Context, owner, repo string, opts *TrafficBreakdownOptions) (*TrafficViews, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/traffic/views", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/traffic/views", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -128,7 +128,7 @@ func (s *RepositoriesService) ListTrafficViews(ctx context.Context, owner, repo
 //
 //meta:operation GET /repos/{owner}/{repo}/traffic/clones
 func (s *RepositoriesService) ListTrafficClones(ctx context.Context, owner, repo string, opts *TrafficBreakdownOptions) (*TrafficClones, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/traffic/clones", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/traffic/clones", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,64 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+)
+
+// auditHeaderPrefix is prepended to the keys of RequestMetadata to build
+// the names of the headers they are sent as.
+const auditHeaderPrefix = "X-Audit-"
+
+// RequestMetadata holds key-value pairs, such as a justification for a
+// change, to be sent along with mutating API requests for audit purposes.
+// Each pair is sent as an "X-Audit-<Key>" header on requests whose method is
+// not GET, HEAD or OPTIONS, and is left out of read-only requests.
+//
+// RequestMetadata is attached to requests through their context, see
+// WithRequestMetadata, so it works with every method of every service. Since
+// the context of the request is the one carrying the metadata, a custom
+// http.RoundTripper can read it back with RequestMetadataFromContext, for
+// example to record it.
+type RequestMetadata map[string]string
+
+// WithRequestMetadata returns a copy of ctx carrying md in addition to any
+// RequestMetadata ctx already carries. Keys in md take precedence.
+func WithRequestMetadata(ctx context.Context, md RequestMetadata) context.Context {
+	if len(md) == 0 {
+		return ctx
+	}
+
+	merged := make(RequestMetadata)
+	for k, v := range RequestMetadataFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range md {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, requestMetadataKey, merged)
+}
+
+// RequestMetadataFromContext returns the RequestMetadata attached to ctx with
+// WithRequestMetadata, or nil if there is none. The returned value must not
+// be modified.
+func RequestMetadataFromContext(ctx context.Context) RequestMetadata {
+	md, _ := ctx.Value(requestMetadataKey).(RequestMetadata)
+	return md
+}
+
+// setAuditHeaders sets the headers of req from md, unless req is read-only.
+func setAuditHeaders(req *http.Request, md RequestMetadata) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return
+	}
+
+	for k, v := range md {
+		req.Header.Set(auditHeaderPrefix+k, v)
+	}
+}
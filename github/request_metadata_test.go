@@ -0,0 +1,96 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWithRequestMetadata(t *testing.T) {
+	ctx := context.Background()
+	if got := RequestMetadataFromContext(ctx); got != nil {
+		t.Errorf("RequestMetadataFromContext = %v, want nil", got)
+	}
+
+	if got := WithRequestMetadata(ctx, nil); got != ctx {
+		t.Errorf("WithRequestMetadata with no metadata returned a new context")
+	}
+
+	ctx = WithRequestMetadata(ctx, RequestMetadata{"Justification": "a", "Ticket": "1"})
+	ctx = WithRequestMetadata(ctx, RequestMetadata{"Justification": "b"})
+
+	want := RequestMetadata{"Justification": "b", "Ticket": "1"}
+	if got := RequestMetadataFromContext(ctx); !cmp.Equal(got, want) {
+		t.Errorf("RequestMetadataFromContext = %v, want %v", got, want)
+	}
+}
+
+func TestProjectsService_UpdateProjectCard_requestMetadata(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/columns/cards/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PATCH":
+			testHeader(t, r, "X-Audit-Justification", "moving to done")
+			testHeader(t, r, "X-Audit-Ticket", "OPS-1")
+			testBody(t, r, `{"archived":true}`+"\n")
+		case "GET":
+			for k := range r.Header {
+				if strings.HasPrefix(k, auditHeaderPrefix) {
+					t.Errorf("GET request has header %v, want no audit headers", k)
+				}
+			}
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := WithRequestMetadata(context.Background(), RequestMetadata{"Ticket": "OPS-1"})
+	opts := &ProjectCardOptions{
+		Archived: Bool(true),
+		Metadata: RequestMetadata{"Justification": "moving to done"},
+	}
+	if _, _, err := client.Projects.UpdateProjectCard(ctx, 1, opts); err != nil {
+		t.Errorf("Projects.UpdateProjectCard returned error: %v", err)
+	}
+
+	if _, _, err := client.Projects.GetProjectCard(ctx, 1); err != nil {
+		t.Errorf("Projects.GetProjectCard returned error: %v", err)
+	}
+}
+
+func TestRequestMetadata_roundTripper(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var recorded RequestMetadata
+	transport := client.client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client.client.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		recorded = RequestMetadataFromContext(r.Context())
+		return transport.RoundTrip(r)
+	})
+
+	md := RequestMetadata{"Justification": "cleanup"}
+	if _, err := client.Projects.DeleteProject(WithRequestMetadata(context.Background(), md), 1); err != nil {
+		t.Errorf("Projects.DeleteProject returned error: %v", err)
+	}
+	if !cmp.Equal(recorded, md) {
+		t.Errorf("recorded metadata = %v, want %v", recorded, md)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// WithRequestTimeout returns a copy of ctx that makes the requests sent with
+// it time out after timeout, instead of after the default timeout of the
+// client set with Client.WithDefaultTimeout. It applies even if the client
+// has no default timeout, and an earlier deadline of ctx still takes
+// precedence. A timeout of zero or less is the same as WithoutRequestTimeout.
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	if timeout < 0 {
+		timeout = 0
+	}
+	return context.WithValue(ctx, requestTimeoutKey, timeout)
+}
+
+// WithoutRequestTimeout returns a copy of ctx that makes the requests sent
+// with it ignore the default timeout of the client, for example for
+// long-running downloads. Any deadline of ctx still applies.
+func WithoutRequestTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey, time.Duration(0))
+}
+
+// requestTimeoutContext returns ctx with the deadline its requests must be
+// sent with, if it needs a new one, and the function canceling it. The
+// returned function is nil if ctx is returned as is.
+func (c *Client) requestTimeoutContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout, override := ctx.Value(requestTimeoutKey).(time.Duration)
+	if !override {
+		if _, ok := ctx.Deadline(); ok {
+			return ctx, nil
+		}
+		timeout = c.defaultTimeout
+	}
+	if timeout <= 0 {
+		return ctx, nil
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// cancelOnCloseBody is a response body canceling the context of its request
+// once closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+	once   sync.Once
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.cancel)
+	return err
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// slowHandler responds after delay, or gives up once the client is gone.
+func slowHandler(delay time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(delay):
+			fmt.Fprint(w, `{"id":1}`)
+		}
+	}
+}
+
+func TestClient_WithDefaultTimeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", slowHandler(time.Second))
+
+	client = client.WithDefaultTimeout(10 * time.Millisecond)
+	ctx := context.Background()
+	start := time.Now()
+	_, _, err := client.Repositories.Get(ctx, "o", "r")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Repositories.Get returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Repositories.Get took %v, want the default timeout to stop it", elapsed)
+	}
+}
+
+func TestClient_WithDefaultTimeout_copied(t *testing.T) {
+	client := NewClient(nil).WithDefaultTimeout(time.Minute).WithAuthToken("token")
+	if got, want := client.defaultTimeout, time.Minute; got != want {
+		t.Errorf("defaultTimeout = %v, want %v", got, want)
+	}
+}
+
+func TestClient_WithDefaultTimeout_callerDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", slowHandler(50*time.Millisecond))
+
+	client = client.WithDefaultTimeout(10 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	repo, _, err := client.Repositories.Get(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if got, want := repo.GetID(), int64(1); got != want {
+		t.Errorf("Repositories.Get returned ID %v, want %v", got, want)
+	}
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	tests := map[string]struct {
+		defaultTimeout time.Duration
+		ctx            func(context.Context) context.Context
+		wantErr        error
+	}{
+		"shorter than the default": {
+			defaultTimeout: 10 * time.Second,
+			ctx: func(ctx context.Context) context.Context {
+				return WithRequestTimeout(ctx, 10*time.Millisecond)
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+		"without a default": {
+			ctx: func(ctx context.Context) context.Context {
+				return WithRequestTimeout(ctx, 10*time.Millisecond)
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+		"longer than the default": {
+			defaultTimeout: 10 * time.Millisecond,
+			ctx: func(ctx context.Context) context.Context {
+				return WithRequestTimeout(ctx, 10*time.Second)
+			},
+		},
+		"opted out": {
+			defaultTimeout: 10 * time.Millisecond,
+			ctx:            WithoutRequestTimeout,
+		},
+		"zero": {
+			defaultTimeout: 10 * time.Millisecond,
+			ctx: func(ctx context.Context) context.Context {
+				return WithRequestTimeout(ctx, 0)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r", slowHandler(50*time.Millisecond))
+
+			client = client.WithDefaultTimeout(test.defaultTimeout)
+			_, _, err := client.Repositories.Get(test.ctx(context.Background()), "o", "r")
+			if test.wantErr == nil && err != nil {
+				t.Errorf("Repositories.Get returned error: %v", err)
+			}
+			if test.wantErr != nil && !errors.Is(err, test.wantErr) {
+				t.Errorf("Repositories.Get returned error %v, want %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_WithDefaultTimeout_streamingDownload(t *testing.T) {
+	tests := map[string]struct {
+		ctx     func(context.Context) context.Context
+		wantErr bool
+	}{
+		"default timeout": {
+			ctx:     func(ctx context.Context) context.Context { return ctx },
+			wantErr: true,
+		},
+		"opted out": {
+			ctx: WithoutRequestTimeout,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, "first chunk,")
+				w.(http.Flusher).Flush()
+				select {
+				case <-r.Context().Done():
+				case <-time.After(100 * time.Millisecond):
+					fmt.Fprint(w, "second chunk")
+				}
+			})
+
+			client = client.WithDefaultTimeout(20 * time.Millisecond)
+			ctx := test.ctx(context.Background())
+			blob, _, err := client.Git.DownloadBlob(ctx, "o", "r", "s")
+			if err != nil {
+				t.Fatalf("Git.DownloadBlob returned error: %v", err)
+			}
+			defer blob.Close()
+
+			content, err := io.ReadAll(blob)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("reading the blob returned %q and nil error, want the default timeout to stop it", content)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("reading the blob returned error: %v", err)
+			}
+			if got, want := string(content), "first chunk,second chunk"; got != want {
+				t.Errorf("reading the blob returned %q, want %q", got, want)
+			}
+		})
+	}
+}
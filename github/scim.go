@@ -85,7 +85,7 @@ type ListSCIMProvisionedIdentitiesOptions struct {
 //
 //meta:operation GET /scim/v2/organizations/{org}/Users
 func (s *SCIMService) ListSCIMProvisionedIdentities(ctx context.Context, org string, opts *ListSCIMProvisionedIdentitiesOptions) (*SCIMProvisionedIdentities, *Response, error) {
-	u := fmt.Sprintf("scim/v2/organizations/%v/Users", org)
+	u := fmt.Sprintf("scim/v2/organizations/%v/Users", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -111,7 +111,7 @@ func (s *SCIMService) ListSCIMProvisionedIdentities(ctx context.Context, org str
 //
 //meta:operation POST /scim/v2/organizations/{org}/Users
 func (s *SCIMService) ProvisionAndInviteSCIMUser(ctx context.Context, org string, opts *SCIMUserAttributes) (*Response, error) {
-	u := fmt.Sprintf("scim/v2/organizations/%v/Users", org)
+	u := fmt.Sprintf("scim/v2/organizations/%v/Users", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, err
@@ -131,7 +131,7 @@ func (s *SCIMService) ProvisionAndInviteSCIMUser(ctx context.Context, org string
 //
 //meta:operation GET /scim/v2/organizations/{org}/Users/{scim_user_id}
 func (s *SCIMService) GetSCIMProvisioningInfoForUser(ctx context.Context, org, scimUserID string) (*SCIMUserAttributes, *Response, error) {
-	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", org, scimUserID)
+	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", PathEscape(org), PathEscape(scimUserID))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -152,7 +152,7 @@ func (s *SCIMService) GetSCIMProvisioningInfoForUser(ctx context.Context, org, s
 //
 //meta:operation PUT /scim/v2/organizations/{org}/Users/{scim_user_id}
 func (s *SCIMService) UpdateProvisionedOrgMembership(ctx context.Context, org, scimUserID string, opts *SCIMUserAttributes) (*Response, error) {
-	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", org, scimUserID)
+	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", PathEscape(org), PathEscape(scimUserID))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, err
@@ -187,7 +187,7 @@ type UpdateAttributeForSCIMUserOperations struct {
 //
 //meta:operation PATCH /scim/v2/organizations/{org}/Users/{scim_user_id}
 func (s *SCIMService) UpdateAttributeForSCIMUser(ctx context.Context, org, scimUserID string, opts *UpdateAttributeForSCIMUserOptions) (*Response, error) {
-	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", org, scimUserID)
+	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", PathEscape(org), PathEscape(scimUserID))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, err
@@ -207,7 +207,7 @@ func (s *SCIMService) UpdateAttributeForSCIMUser(ctx context.Context, org, scimU
 //
 //meta:operation DELETE /scim/v2/organizations/{org}/Users/{scim_user_id}
 func (s *SCIMService) DeleteSCIMUserFromOrg(ctx context.Context, org, scimUserID string) (*Response, error) {
-	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", org, scimUserID)
+	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", PathEscape(org), PathEscape(scimUserID))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -115,7 +115,7 @@ func TestSCIMService_ListSCIMProvisionedIdentities(t *testing.T) {
 		return err
 	})
 
-	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+	testNewRequestAndDoFailureCategory(t, methodName, client, ScimCategory, func() (*Response, error) {
 		_, r, err := client.SCIM.ListSCIMProvisionedIdentities(ctx, "o", opts)
 		return r, err
 	})
@@ -154,7 +154,7 @@ func TestSCIMService_ProvisionAndInviteSCIMUser(t *testing.T) {
 		return err
 	})
 
-	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+	testNewRequestAndDoFailureCategory(t, methodName, client, ScimCategory, func() (*Response, error) {
 		return client.SCIM.ProvisionAndInviteSCIMUser(ctx, "o", opts)
 	})
 }
@@ -246,7 +246,7 @@ func TestSCIMService_GetSCIMProvisioningInfoForUser(t *testing.T) {
 		return err
 	})
 
-	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+	testNewRequestAndDoFailureCategory(t, methodName, client, ScimCategory, func() (*Response, error) {
 		_, r, err := client.SCIM.GetSCIMProvisioningInfoForUser(ctx, "o", "123")
 		return r, err
 	})
@@ -285,7 +285,7 @@ func TestSCIMService_UpdateProvisionedOrgMembership(t *testing.T) {
 		return err
 	})
 
-	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+	testNewRequestAndDoFailureCategory(t, methodName, client, ScimCategory, func() (*Response, error) {
 		return client.SCIM.UpdateProvisionedOrgMembership(ctx, "o", "123", opts)
 	})
 }
@@ -312,7 +312,7 @@ func TestSCIMService_UpdateAttributeForSCIMUser(t *testing.T) {
 		return err
 	})
 
-	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+	testNewRequestAndDoFailureCategory(t, methodName, client, ScimCategory, func() (*Response, error) {
 		return client.SCIM.UpdateAttributeForSCIMUser(ctx, "o", "123", opts)
 	})
 }
@@ -338,7 +338,7 @@ func TestSCIMService_DeleteSCIMUserFromOrg(t *testing.T) {
 		return err
 	})
 
-	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+	testNewRequestAndDoFailureCategory(t, methodName, client, ScimCategory, func() (*Response, error) {
 		return client.SCIM.DeleteSCIMUserFromOrg(ctx, "o", "123")
 	})
 }
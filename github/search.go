@@ -7,7 +7,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -32,9 +34,21 @@ import (
 // For example, querying with "language:c++" and "leveldb", then query should be
 // "language:c++ leveldb" but not "language:c+++leveldb".
 //
+// Search results are capped at SearchResultsLimit per query; requesting a page
+// past the cap returns an error wrapping ErrSearchResultsLimit. Searches that
+// time out return the results found so far with IncompleteResults set.
+//
 // GitHub API docs: https://docs.github.com/rest/search/
 type SearchService service
 
+// SearchResultsLimit is the maximum number of results the search API returns
+// for a query, regardless of its total count.
+const SearchResultsLimit = 1000
+
+// ErrSearchResultsLimit is returned by the SearchService methods when the
+// requested page lies beyond the first SearchResultsLimit results.
+var ErrSearchResultsLimit = errors.New("only the first 1000 search results are available")
+
 // SearchOptions specifies optional parameters to the SearchService methods.
 type SearchOptions struct {
 	// How to sort the search results. Possible values are:
@@ -103,6 +117,9 @@ type TopicResult struct {
 	Featured         *bool      `json:"featured,omitempty"`
 	Curated          *bool      `json:"curated,omitempty"`
 	Score            *float64   `json:"score,omitempty"`
+	// TextMatches is only populated from search results that request text matches
+	// See: search.go and https://docs.github.com/rest/search/#text-match-metadata
+	TextMatches []*TextMatch `json:"text_matches,omitempty"`
 }
 
 // Topics finds topics via various criteria. Results are sorted by best match.
@@ -142,6 +159,9 @@ type CommitResult struct {
 
 	Repository *Repository `json:"repository,omitempty"`
 	Score      *float64    `json:"score,omitempty"`
+	// TextMatches is only populated from search results that request text matches
+	// See: search.go and https://docs.github.com/rest/search/#text-match-metadata
+	TextMatches []*TextMatch `json:"text_matches,omitempty"`
 }
 
 // Commits searches commits via various criteria.
@@ -231,12 +251,19 @@ type CodeSearchResult struct {
 
 // CodeResult represents a single search result.
 type CodeResult struct {
-	Name        *string      `json:"name,omitempty"`
-	Path        *string      `json:"path,omitempty"`
-	SHA         *string      `json:"sha,omitempty"`
-	HTMLURL     *string      `json:"html_url,omitempty"`
-	Repository  *Repository  `json:"repository,omitempty"`
-	TextMatches []*TextMatch `json:"text_matches,omitempty"`
+	Name           *string      `json:"name,omitempty"`
+	Path           *string      `json:"path,omitempty"`
+	SHA            *string      `json:"sha,omitempty"`
+	URL            *string      `json:"url,omitempty"`
+	GitURL         *string      `json:"git_url,omitempty"`
+	HTMLURL        *string      `json:"html_url,omitempty"`
+	Repository     *Repository  `json:"repository,omitempty"`
+	Score          *float64     `json:"score,omitempty"`
+	FileSize       *int         `json:"file_size,omitempty"`
+	Language       *string      `json:"language,omitempty"`
+	LastModifiedAt *Timestamp   `json:"last_modified_at,omitempty"`
+	LineNumbers    []string     `json:"line_numbers,omitempty"`
+	TextMatches    []*TextMatch `json:"text_matches,omitempty"`
 }
 
 func (c CodeResult) String() string {
@@ -274,6 +301,9 @@ type LabelResult struct {
 	Default     *bool    `json:"default,omitempty"`
 	Description *string  `json:"description,omitempty"`
 	Score       *float64 `json:"score,omitempty"`
+	// TextMatches is only populated from search results that request text matches
+	// See: search.go and https://docs.github.com/rest/search/#text-match-metadata
+	TextMatches []*TextMatch `json:"text_matches,omitempty"`
 }
 
 func (l LabelResult) String() string {
@@ -310,7 +340,7 @@ func (s *SearchService) search(ctx context.Context, searchType string, parameter
 		params.Set("repository_id", strconv.FormatInt(*parameters.RepositoryID, 10))
 	}
 	params.Set("q", parameters.Query)
-	u := fmt.Sprintf("search/%s?%s", searchType, params.Encode())
+	u := fmt.Sprintf("search/%s?%s", PathEscape(searchType), params.Encode())
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -343,5 +373,19 @@ func (s *SearchService) search(ctx context.Context, searchType string, parameter
 	}
 	req.Header.Set("Accept", strings.Join(acceptHeaders, ", "))
 
-	return s.client.Do(ctx, req, result)
+	resp, err := s.client.Do(ctx, req, result)
+	if isSearchResultsLimitError(err) {
+		return resp, fmt.Errorf("%w: %w", ErrSearchResultsLimit, err)
+	}
+	return resp, err
+}
+
+// isSearchResultsLimitError reports whether err is the error returned when
+// paging beyond the first SearchResultsLimit results.
+func isSearchResultsLimitError(err error) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	return strings.Contains(errResp.Message, "first 1000 search results")
 }
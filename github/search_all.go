@@ -0,0 +1,275 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SearchAllOptions specifies optional parameters to the SearchService.All*
+// methods.
+type SearchAllOptions struct {
+	// Sort, Order and TextMatch are passed on as in SearchOptions.
+	Sort      string
+	Order     string
+	TextMatch bool
+
+	// PerPage is the number of results fetched per request. Defaults to 100,
+	// the maximum.
+	PerPage int
+
+	// DateField is a date qualifier of the search, such as "created" or
+	// "updated". If set, the search is split into date windows between Since
+	// and Until, which are narrowed until each holds at most SearchResultsLimit
+	// results. This retrieves more results than a single query can return.
+	DateField string
+
+	// Since is the start of the date range searched when DateField is set.
+	// Defaults to the start of 2008, before anything on GitHub was created.
+	Since time.Time
+
+	// Until is the end of the date range searched when DateField is set.
+	// Defaults to the current time.
+	Until time.Time
+}
+
+// githubEpoch precedes the creation of any GitHub resource, so it can be used
+// as the start of an unbounded date window.
+var githubEpoch = time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// AllIssues returns all issues and pull requests matching query, paginating
+// up to SearchResultsLimit results per query. It pauses whenever the search
+// is rate limited, until ctx is done.
+//
+// truncated reports whether matching results were left out because of the
+// limit. Set opts.DateField to split the query into narrower date windows.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#search-issues-and-pull-requests
+//
+//meta:operation GET /search/issues
+func (s *SearchService) AllIssues(ctx context.Context, query string, opts *SearchAllOptions) (issues []*Issue, truncated bool, err error) {
+	return searchAll(ctx, query, opts, func(ctx context.Context, query string, opts *SearchOptions) ([]*Issue, int, error) {
+		result, _, err := s.Issues(ctx, query, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Issues, result.GetTotal(), nil
+	})
+}
+
+// AllRepositories returns all repositories matching query. See AllIssues for
+// how results are paginated and rate limits handled.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#search-repositories
+//
+//meta:operation GET /search/repositories
+func (s *SearchService) AllRepositories(ctx context.Context, query string, opts *SearchAllOptions) (repos []*Repository, truncated bool, err error) {
+	return searchAll(ctx, query, opts, func(ctx context.Context, query string, opts *SearchOptions) ([]*Repository, int, error) {
+		result, _, err := s.Repositories(ctx, query, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Repositories, result.GetTotal(), nil
+	})
+}
+
+// AllCode returns all code matching query. See AllIssues for how results are
+// paginated and rate limits handled. Code search has no date qualifiers, so
+// opts.DateField must not be set.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#search-code
+//
+//meta:operation GET /search/code
+func (s *SearchService) AllCode(ctx context.Context, query string, opts *SearchAllOptions) (code []*CodeResult, truncated bool, err error) {
+	if opts != nil && opts.DateField != "" {
+		return nil, false, errors.New("code search does not support date windows")
+	}
+	return searchAll(ctx, query, opts, func(ctx context.Context, query string, opts *SearchOptions) ([]*CodeResult, int, error) {
+		result, _, err := s.Code(ctx, query, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.CodeResults, result.GetTotal(), nil
+	})
+}
+
+// AllCommits returns all commits matching query. See AllIssues for how
+// results are paginated and rate limits handled.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#search-commits
+//
+//meta:operation GET /search/commits
+func (s *SearchService) AllCommits(ctx context.Context, query string, opts *SearchAllOptions) (commits []*CommitResult, truncated bool, err error) {
+	return searchAll(ctx, query, opts, func(ctx context.Context, query string, opts *SearchOptions) ([]*CommitResult, int, error) {
+		result, _, err := s.Commits(ctx, query, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Commits, result.GetTotal(), nil
+	})
+}
+
+// AllUsers returns all users matching query. See AllIssues for how results
+// are paginated and rate limits handled.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#search-users
+//
+//meta:operation GET /search/users
+func (s *SearchService) AllUsers(ctx context.Context, query string, opts *SearchAllOptions) (users []*User, truncated bool, err error) {
+	return searchAll(ctx, query, opts, func(ctx context.Context, query string, opts *SearchOptions) ([]*User, int, error) {
+		result, _, err := s.Users(ctx, query, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Users, result.GetTotal(), nil
+	})
+}
+
+// searchPageFunc fetches a page of search results and their total count.
+type searchPageFunc[T any] func(ctx context.Context, query string, opts *SearchOptions) ([]T, int, error)
+
+// searchAll collects the results of query, splitting it into date windows if
+// opts.DateField is set.
+func searchAll[T any](ctx context.Context, query string, opts *SearchAllOptions, search searchPageFunc[T]) ([]T, bool, error) {
+	if opts == nil {
+		opts = &SearchAllOptions{}
+	}
+	if opts.DateField == "" {
+		return searchAllPages(ctx, query, opts, search)
+	}
+
+	since, until := opts.Since, opts.Until
+	if since.IsZero() {
+		since = githubEpoch
+	}
+	if until.IsZero() {
+		until = time.Now()
+	}
+	if until.Before(since) {
+		return nil, false, fmt.Errorf("search window ends at %v, before it starts at %v", until, since)
+	}
+
+	var (
+		all       []T
+		truncated bool
+		// pending is a stack of windows still to search, latest first.
+		pending = []searchWindow{newSearchWindow(since, until)}
+	)
+	for len(pending) > 0 {
+		w := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		windowQuery := query + " " + w.qualifier(opts.DateField)
+
+		results, total, err := searchWithRateLimitWait(ctx, windowQuery, newSearchAllPageOptions(opts, 1), search)
+		if err != nil {
+			return nil, false, err
+		}
+		if total > SearchResultsLimit {
+			if earlier, later, ok := w.split(); ok {
+				pending = append(pending, later, earlier)
+				continue
+			}
+		}
+
+		results, windowTruncated, err := searchRemainingPages(ctx, windowQuery, opts, search, results, total)
+		if err != nil {
+			return nil, false, err
+		}
+		all = append(all, results...)
+		truncated = truncated || windowTruncated
+	}
+	return all, truncated, nil
+}
+
+// searchAllPages collects the results of query, up to SearchResultsLimit.
+func searchAllPages[T any](ctx context.Context, query string, opts *SearchAllOptions, search searchPageFunc[T]) ([]T, bool, error) {
+	results, total, err := searchWithRateLimitWait(ctx, query, newSearchAllPageOptions(opts, 1), search)
+	if err != nil {
+		return nil, false, err
+	}
+	return searchRemainingPages(ctx, query, opts, search, results, total)
+}
+
+// searchRemainingPages adds the results of query after its first page, which
+// holds results, to results, up to SearchResultsLimit. It reports whether
+// results were left out because of the limit.
+func searchRemainingPages[T any](ctx context.Context, query string, opts *SearchAllOptions, search searchPageFunc[T], results []T, total int) ([]T, bool, error) {
+	perPage := searchAllPerPage(opts)
+	for page := 2; len(results) < total; page++ {
+		if (page-1)*perPage >= SearchResultsLimit {
+			return results, true, nil
+		}
+
+		pageResults, _, err := searchWithRateLimitWait(ctx, query, newSearchAllPageOptions(opts, page), search)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(pageResults) == 0 {
+			break
+		}
+		results = append(results, pageResults...)
+	}
+	return results, false, nil
+}
+
+func searchAllPerPage(opts *SearchAllOptions) int {
+	if opts.PerPage <= 0 || opts.PerPage > 100 {
+		return 100
+	}
+	return opts.PerPage
+}
+
+func newSearchAllPageOptions(opts *SearchAllOptions, page int) *SearchOptions {
+	return &SearchOptions{
+		Sort:        opts.Sort,
+		Order:       opts.Order,
+		TextMatch:   opts.TextMatch,
+		ListOptions: ListOptions{Page: page, PerPage: searchAllPerPage(opts)},
+	}
+}
+
+// searchWithRateLimitWait calls search, pausing and retrying for as long as
+// it fails because of a rate limit.
+func searchWithRateLimitWait[T any](ctx context.Context, query string, opts *SearchOptions, search searchPageFunc[T]) ([]T, int, error) {
+	for {
+		results, total, err := search(ctx, query, opts)
+		retry, werr := waitForRateLimit(ctx, err)
+		if werr != nil {
+			return nil, 0, werr
+		}
+		if !retry {
+			return results, total, err
+		}
+	}
+}
+
+// searchWindow is an inclusive range of times, with a granularity of one
+// second, to restrict a search to.
+type searchWindow struct {
+	start, end time.Time
+}
+
+func newSearchWindow(start, end time.Time) searchWindow {
+	return searchWindow{start: start.UTC().Truncate(time.Second), end: end.UTC().Truncate(time.Second)}
+}
+
+// qualifier returns the search qualifier restricting field to w.
+func (w searchWindow) qualifier(field string) string {
+	return fmt.Sprintf("%s:%s..%s", field, w.start.Format(time.RFC3339), w.end.Format(time.RFC3339))
+}
+
+// split divides w into two adjacent, non-overlapping halves. It reports false
+// if w is a single second, which can't be split.
+func (w searchWindow) split() (earlier, later searchWindow, ok bool) {
+	if !w.end.After(w.start) {
+		return w, w, false
+	}
+	mid := w.start.Add(w.end.Sub(w.start) / 2).Truncate(time.Second)
+	return searchWindow{start: w.start, end: mid}, searchWindow{start: mid.Add(time.Second), end: w.end}, true
+}
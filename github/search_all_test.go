@@ -0,0 +1,410 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSearchWindow_split(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		window      searchWindow
+		wantEarlier searchWindow
+		wantLater   searchWindow
+		wantOK      bool
+	}{
+		{
+			name:        "day",
+			window:      newSearchWindow(start, start.Add(24*time.Hour-time.Second)),
+			wantEarlier: searchWindow{start, start.Add(12*time.Hour - time.Second)},
+			wantLater:   searchWindow{start.Add(12 * time.Hour), start.Add(24*time.Hour - time.Second)},
+			wantOK:      true,
+		},
+		{
+			name:        "two seconds",
+			window:      newSearchWindow(start, start.Add(time.Second)),
+			wantEarlier: searchWindow{start, start},
+			wantLater:   searchWindow{start.Add(time.Second), start.Add(time.Second)},
+			wantOK:      true,
+		},
+		{
+			name:        "three seconds",
+			window:      newSearchWindow(start, start.Add(2*time.Second)),
+			wantEarlier: searchWindow{start, start.Add(time.Second)},
+			wantLater:   searchWindow{start.Add(2 * time.Second), start.Add(2 * time.Second)},
+			wantOK:      true,
+		},
+		{
+			name:   "single second",
+			window: newSearchWindow(start, start),
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			earlier, later, ok := tt.window.split()
+			if ok != tt.wantOK {
+				t.Fatalf("split() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if earlier != tt.wantEarlier {
+				t.Errorf("split() earlier = %v, want %v", earlier, tt.wantEarlier)
+			}
+			if later != tt.wantLater {
+				t.Errorf("split() later = %v, want %v", later, tt.wantLater)
+			}
+		})
+	}
+}
+
+func TestSearchWindow_splitCoversWindow(t *testing.T) {
+	start := time.Date(2024, time.March, 4, 5, 6, 7, 0, time.UTC)
+	for _, length := range []time.Duration{time.Second, 7 * time.Second, time.Hour + 3*time.Second, 365 * 24 * time.Hour} {
+		w := newSearchWindow(start, start.Add(length))
+		earlier, later, ok := w.split()
+		if !ok {
+			t.Fatalf("%v.split() ok = false, want true", w)
+		}
+		if earlier.start != w.start || later.end != w.end {
+			t.Errorf("%v.split() = %v, %v; want halves spanning the window", w, earlier, later)
+		}
+		if got := later.start.Sub(earlier.end); got != time.Second {
+			t.Errorf("%v.split() = %v, %v; want adjacent halves, got gap %v", w, earlier, later, got)
+		}
+		if earlier.end.Before(earlier.start) || later.end.Before(later.start) {
+			t.Errorf("%v.split() = %v, %v; want non-empty halves", w, earlier, later)
+		}
+	}
+}
+
+func TestSearchWindow_qualifier(t *testing.T) {
+	zone := time.FixedZone("", 2*60*60)
+	w := newSearchWindow(
+		time.Date(2024, time.January, 1, 2, 0, 0, 500, zone),
+		time.Date(2024, time.February, 1, 1, 59, 59, 0, zone),
+	)
+	want := "created:2024-01-01T00:00:00Z..2024-01-31T23:59:59Z"
+	if got := w.qualifier("created"); got != want {
+		t.Errorf("qualifier() = %q, want %q", got, want)
+	}
+}
+
+// fakeIssueSearch serves /search/issues from issues created at the given
+// times, supporting "created:start..end" qualifiers and pagination.
+func fakeIssueSearch(t *testing.T, mux *http.ServeMux, created []time.Time) {
+	t.Helper()
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		start, end := time.Time{}, time.Unix(1<<40, 0)
+		for _, term := range strings.Fields(r.FormValue("q")) {
+			window, ok := strings.CutPrefix(term, "created:")
+			if !ok {
+				continue
+			}
+			from, to, _ := strings.Cut(window, "..")
+			var err error
+			if start, err = time.Parse(time.RFC3339, from); err != nil {
+				t.Errorf("invalid window start %q: %v", from, err)
+			}
+			if end, err = time.Parse(time.RFC3339, to); err != nil {
+				t.Errorf("invalid window end %q: %v", to, err)
+			}
+		}
+
+		var matches []*Issue
+		for i, c := range created {
+			if !c.Before(start) && !c.After(end) {
+				matches = append(matches, &Issue{Number: Int(i)})
+			}
+		}
+
+		page, _ := strconv.Atoi(r.FormValue("page"))
+		perPage, _ := strconv.Atoi(r.FormValue("per_page"))
+		if page*perPage > SearchResultsLimit {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Only the first 1000 search results are available"}`)
+			return
+		}
+		from := min((page-1)*perPage, len(matches))
+		to := min(page*perPage, len(matches))
+		result := &IssuesSearchResult{
+			Total:             Int(len(matches)),
+			IncompleteResults: Bool(false),
+			Issues:            matches[from:to],
+		}
+		assertNilError(t, json.NewEncoder(w).Encode(result))
+	})
+}
+
+func TestSearchService_AllIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	created := make([]time.Time, 250)
+	for i := range created {
+		created[i] = start.Add(time.Duration(i) * time.Hour)
+	}
+	fakeIssueSearch(t, mux, created)
+
+	ctx := context.Background()
+	issues, truncated, err := client.Search.AllIssues(ctx, "is:issue", &SearchAllOptions{PerPage: 30})
+	if err != nil {
+		t.Fatalf("Search.AllIssues returned error: %v", err)
+	}
+	if truncated {
+		t.Error("Search.AllIssues returned truncated results")
+	}
+	if len(issues) != len(created) {
+		t.Errorf("Search.AllIssues returned %v issues, want %v", len(issues), len(created))
+	}
+}
+
+func TestSearchService_AllIssues_truncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	created := make([]time.Time, 1200)
+	fakeIssueSearch(t, mux, created)
+
+	ctx := context.Background()
+	issues, truncated, err := client.Search.AllIssues(ctx, "is:issue", nil)
+	if err != nil {
+		t.Fatalf("Search.AllIssues returned error: %v", err)
+	}
+	if !truncated {
+		t.Error("Search.AllIssues returned untruncated results")
+	}
+	if len(issues) != SearchResultsLimit {
+		t.Errorf("Search.AllIssues returned %v issues, want %v", len(issues), SearchResultsLimit)
+	}
+}
+
+func TestSearchService_AllIssues_dateWindows(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(2500*time.Minute - time.Second)
+	created := make([]time.Time, 2500)
+	for i := range created {
+		created[i] = since.Add(time.Duration(i) * time.Minute)
+	}
+	fakeIssueSearch(t, mux, created)
+
+	ctx := context.Background()
+	opts := &SearchAllOptions{DateField: "created", Since: since, Until: until}
+	issues, truncated, err := client.Search.AllIssues(ctx, "is:issue", opts)
+	if err != nil {
+		t.Fatalf("Search.AllIssues returned error: %v", err)
+	}
+	if truncated {
+		t.Error("Search.AllIssues returned truncated results")
+	}
+
+	// Windows are searched in order, so all issues are returned in order.
+	var got []int
+	for _, issue := range issues {
+		got = append(got, issue.GetNumber())
+	}
+	want := make([]int, len(created))
+	for i := range want {
+		want[i] = i
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Search.AllIssues returned issues %v, want %v", got, want)
+	}
+}
+
+func TestSearchService_AllIssues_dateWindowsTruncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// More issues than can be returned were created in the same second.
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	created := make([]time.Time, 1100)
+	for i := range created {
+		created[i] = since.Add(time.Hour)
+	}
+	created = append(created, since.Add(2*time.Hour))
+	fakeIssueSearch(t, mux, created)
+
+	ctx := context.Background()
+	opts := &SearchAllOptions{DateField: "created", Since: since, Until: since.Add(3 * time.Hour)}
+	issues, truncated, err := client.Search.AllIssues(ctx, "is:issue", opts)
+	if err != nil {
+		t.Fatalf("Search.AllIssues returned error: %v", err)
+	}
+	if !truncated {
+		t.Error("Search.AllIssues returned untruncated results")
+	}
+	if want := SearchResultsLimit + 1; len(issues) != want {
+		t.Errorf("Search.AllIssues returned %v issues, want %v", len(issues), want)
+	}
+}
+
+func TestSearchService_AllIssues_invalidWindow(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	opts := &SearchAllOptions{DateField: "created", Since: since, Until: since.Add(-time.Hour)}
+	ctx := context.Background()
+	if _, _, err := client.Search.AllIssues(ctx, "is:issue", opts); err == nil {
+		t.Error("Expected error to be returned because the window ends before it starts")
+	}
+}
+
+func TestSearchService_AllRepositories_secondaryRateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		if requests == 1 {
+			w.Header().Set(headerRetryAfter, "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit.","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`)
+			return
+		}
+		testFormValues(t, r, values{"q": "go", "page": "1", "per_page": "100"})
+		fmt.Fprint(w, `{"total_count":2,"incomplete_results":false,"items":[{"id":1},{"id":2}]}`)
+	})
+
+	ctx := context.Background()
+	repos, truncated, err := client.Search.AllRepositories(ctx, "go", nil)
+	if err != nil {
+		t.Fatalf("Search.AllRepositories returned error: %v", err)
+	}
+	want := []*Repository{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !cmp.Equal(repos, want) || truncated {
+		t.Errorf("Search.AllRepositories returned %+v, %v; want %+v, false", repos, truncated, want)
+	}
+	if requests != 2 {
+		t.Errorf("Search.AllRepositories made %v requests, want 2", requests)
+	}
+}
+
+func TestSearchService_AllRepositories_rateLimitCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRetryAfter, "60")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit.","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := client.Search.AllRepositories(ctx, "go", nil)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Search.AllRepositories returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestSearchService_AllCode(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "1":
+			fmt.Fprint(w, `{"total_count":3,"items":[{"name":"a"},{"name":"b"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":3,"items":[{"name":"c"}]}`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	code, truncated, err := client.Search.AllCode(ctx, "q", &SearchAllOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("Search.AllCode returned error: %v", err)
+	}
+	want := []*CodeResult{{Name: String("a")}, {Name: String("b")}, {Name: String("c")}}
+	if !cmp.Equal(code, want) || truncated {
+		t.Errorf("Search.AllCode returned %+v, %v; want %+v, false", code, truncated, want)
+	}
+
+	if _, _, err := client.Search.AllCode(ctx, "q", &SearchAllOptions{DateField: "created"}); err == nil {
+		t.Error("Expected error to be returned because code search has no date qualifiers")
+	}
+}
+
+func TestSearchService_AllCommits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "q", "sort": "author-date", "order": "asc", "page": "1", "per_page": "100"})
+		fmt.Fprint(w, `{"total_count":1,"items":[{"sha":"s"}]}`)
+	})
+
+	ctx := context.Background()
+	commits, _, err := client.Search.AllCommits(ctx, "q", &SearchAllOptions{Sort: "author-date", Order: "asc"})
+	if err != nil {
+		t.Fatalf("Search.AllCommits returned error: %v", err)
+	}
+	want := []*CommitResult{{SHA: String("s")}}
+	if !cmp.Equal(commits, want) {
+		t.Errorf("Search.AllCommits returned %+v, want %+v", commits, want)
+	}
+}
+
+func TestSearchService_AllUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":0,"items":[]}`)
+	})
+
+	ctx := context.Background()
+	users, truncated, err := client.Search.AllUsers(ctx, "q", nil)
+	if err != nil {
+		t.Fatalf("Search.AllUsers returned error: %v", err)
+	}
+	if len(users) != 0 || truncated {
+		t.Errorf("Search.AllUsers returned %+v, %v; want no users", users, truncated)
+	}
+}
+
+func TestSearchService_All_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/users", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Search.AllUsers(ctx, "q", nil); err == nil {
+		t.Error("Search.AllUsers returned no error for a failing search")
+	}
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -511,6 +512,256 @@ func TestSearchService_CodeTextMatch(t *testing.T) {
 	}
 }
 
+func TestSearchService_textMatches(t *testing.T) {
+	textMatches := []*TextMatch{
+		{
+			ObjectType: String("Item"),
+			Property:   String("body"),
+			Fragment:   String("a gopher who lives to feed"),
+			Matches:    []*Match{{Text: String("gopher"), Indices: []int{2, 8}}},
+		},
+		{
+			ObjectType: String("Item"),
+			Property:   String("title"),
+			Fragment:   String("gophers everywhere"),
+			Matches:    []*Match{{Text: String("gophers"), Indices: []int{0, 7}}},
+		},
+	}
+	textMatchesJSON := `[
+		{"object_type":"Item","property":"body","fragment":"a gopher who lives to feed","matches":[{"text":"gopher","indices":[2,8]}]},
+		{"object_type":"Item","property":"title","fragment":"gophers everywhere","matches":[{"text":"gophers","indices":[0,7]}]}
+	]`
+
+	tests := []struct {
+		searchType string
+		accept     string
+		search     func(ctx context.Context, client *Client, opts *SearchOptions) ([][]*TextMatch, error)
+	}{
+		{
+			searchType: "repositories",
+			accept:     mediaTypeTopicsPreview,
+			search: func(ctx context.Context, client *Client, opts *SearchOptions) ([][]*TextMatch, error) {
+				result, _, err := client.Search.Repositories(ctx, "q", opts)
+				if err != nil {
+					return nil, err
+				}
+				var got [][]*TextMatch
+				for _, item := range result.Repositories {
+					got = append(got, item.TextMatches)
+				}
+				return got, nil
+			},
+		},
+		{
+			searchType: "topics",
+			accept:     mediaTypeTopicsPreview,
+			search: func(ctx context.Context, client *Client, opts *SearchOptions) ([][]*TextMatch, error) {
+				result, _, err := client.Search.Topics(ctx, "q", opts)
+				if err != nil {
+					return nil, err
+				}
+				var got [][]*TextMatch
+				for _, item := range result.Topics {
+					got = append(got, item.TextMatches)
+				}
+				return got, nil
+			},
+		},
+		{
+			searchType: "commits",
+			accept:     mediaTypeCommitSearchPreview,
+			search: func(ctx context.Context, client *Client, opts *SearchOptions) ([][]*TextMatch, error) {
+				result, _, err := client.Search.Commits(ctx, "q", opts)
+				if err != nil {
+					return nil, err
+				}
+				var got [][]*TextMatch
+				for _, item := range result.Commits {
+					got = append(got, item.TextMatches)
+				}
+				return got, nil
+			},
+		},
+		{
+			searchType: "issues",
+			accept:     mediaTypeReactionsPreview,
+			search: func(ctx context.Context, client *Client, opts *SearchOptions) ([][]*TextMatch, error) {
+				result, _, err := client.Search.Issues(ctx, "q", opts)
+				if err != nil {
+					return nil, err
+				}
+				var got [][]*TextMatch
+				for _, item := range result.Issues {
+					got = append(got, item.TextMatches)
+				}
+				return got, nil
+			},
+		},
+		{
+			searchType: "users",
+			search: func(ctx context.Context, client *Client, opts *SearchOptions) ([][]*TextMatch, error) {
+				result, _, err := client.Search.Users(ctx, "q", opts)
+				if err != nil {
+					return nil, err
+				}
+				var got [][]*TextMatch
+				for _, item := range result.Users {
+					got = append(got, item.TextMatches)
+				}
+				return got, nil
+			},
+		},
+		{
+			searchType: "code",
+			search: func(ctx context.Context, client *Client, opts *SearchOptions) ([][]*TextMatch, error) {
+				result, _, err := client.Search.Code(ctx, "q", opts)
+				if err != nil {
+					return nil, err
+				}
+				var got [][]*TextMatch
+				for _, item := range result.CodeResults {
+					got = append(got, item.TextMatches)
+				}
+				return got, nil
+			},
+		},
+		{
+			searchType: "labels",
+			search: func(ctx context.Context, client *Client, opts *SearchOptions) ([][]*TextMatch, error) {
+				result, _, err := client.Search.Labels(ctx, 1, "q", opts)
+				if err != nil {
+					return nil, err
+				}
+				var got [][]*TextMatch
+				for _, item := range result.Labels {
+					got = append(got, item.TextMatches)
+				}
+				return got, nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.searchType, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/search/"+tt.searchType, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				accept := "application/vnd.github.v3.text-match+json"
+				if tt.accept != "" {
+					accept = tt.accept + ", " + accept
+				}
+				testHeader(t, r, "Accept", accept)
+				fmt.Fprintf(w, `{"total_count":2,"incomplete_results":false,"items":[{"text_matches":%v},{"text_matches":%v}]}`, textMatchesJSON, textMatchesJSON)
+			})
+
+			ctx := context.Background()
+			got, err := tt.search(ctx, client, &SearchOptions{TextMatch: true})
+			if err != nil {
+				t.Fatalf("Search returned error: %v", err)
+			}
+			want := [][]*TextMatch{textMatches, textMatches}
+			if !cmp.Equal(got, want) {
+				t.Errorf("Search returned text matches %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestSearchService_CodeResultFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"incomplete_results":true,"items":[{
+			"name":"main.go",
+			"path":"cmd/main.go",
+			"sha":"s",
+			"url":"https://api.github.com/repositories/1/contents/cmd/main.go?ref=s",
+			"git_url":"https://api.github.com/repositories/1/git/blobs/s",
+			"html_url":"https://github.com/o/r/blob/s/cmd/main.go",
+			"repository":{"id":1,"full_name":"o/r"},
+			"score":1.5,
+			"file_size":42,
+			"language":"Go",
+			"last_modified_at":"2006-01-02T15:04:05Z",
+			"line_numbers":["1","10-12"]
+		}]}`)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.Search.Code(ctx, "q", nil)
+	if err != nil {
+		t.Errorf("Search.Code returned error: %v", err)
+	}
+
+	want := &CodeSearchResult{
+		Total:             Int(1),
+		IncompleteResults: Bool(true),
+		CodeResults: []*CodeResult{{
+			Name:           String("main.go"),
+			Path:           String("cmd/main.go"),
+			SHA:            String("s"),
+			URL:            String("https://api.github.com/repositories/1/contents/cmd/main.go?ref=s"),
+			GitURL:         String("https://api.github.com/repositories/1/git/blobs/s"),
+			HTMLURL:        String("https://github.com/o/r/blob/s/cmd/main.go"),
+			Repository:     &Repository{ID: Int64(1), FullName: String("o/r")},
+			Score:          Float64(1.5),
+			FileSize:       Int(42),
+			Language:       String("Go"),
+			LastModifiedAt: &Timestamp{referenceTime},
+			LineNumbers:    []string{"1", "10-12"},
+		}},
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Search.Code returned %+v, want %+v", result, want)
+	}
+}
+
+func TestSearchService_resultsLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "q", "page": "11", "per_page": "100"})
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Only the first 1000 search results are available","documentation_url":"https://docs.github.com/v3/search/"}`)
+	})
+
+	ctx := context.Background()
+	opts := &SearchOptions{ListOptions: ListOptions{Page: 11, PerPage: 100}}
+	_, resp, err := client.Search.Issues(ctx, "q", opts)
+	if !errors.Is(err, ErrSearchResultsLimit) {
+		t.Errorf("Search.Issues returned error %v, want ErrSearchResultsLimit", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Search.Issues returned error %v, want it to wrap an ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Search.Issues returned response %+v, want status 422", resp)
+	}
+}
+
+func TestSearchService_otherValidationError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"Search","field":"q","code":"invalid"}]}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Search.Issues(ctx, "q", nil)
+	if err == nil || errors.Is(err, ErrSearchResultsLimit) {
+		t.Errorf("Search.Issues returned error %v, want a validation error", err)
+	}
+}
+
 func TestSearchService_Labels(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -99,7 +99,7 @@ type SecretScanningAlertUpdateOptions struct {
 //
 //meta:operation GET /enterprises/{enterprise}/secret-scanning/alerts
 func (s *SecretScanningService) ListAlertsForEnterprise(ctx context.Context, enterprise string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
-	u := fmt.Sprintf("enterprises/%v/secret-scanning/alerts", enterprise)
+	u := fmt.Sprintf("enterprises/%v/secret-scanning/alerts", PathEscape(enterprise))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -128,7 +128,7 @@ func (s *SecretScanningService) ListAlertsForEnterprise(ctx context.Context, ent
 //
 //meta:operation GET /orgs/{org}/secret-scanning/alerts
 func (s *SecretScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/secret-scanning/alerts", org)
+	u := fmt.Sprintf("orgs/%v/secret-scanning/alerts", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -157,7 +157,7 @@ func (s *SecretScanningService) ListAlertsForOrg(ctx context.Context, org string
 //
 //meta:operation GET /repos/{owner}/{repo}/secret-scanning/alerts
 func (s *SecretScanningService) ListAlertsForRepo(ctx context.Context, owner, repo string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts", owner, repo)
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts", PathEscape(owner), PathEscape(repo))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -186,7 +186,7 @@ func (s *SecretScanningService) ListAlertsForRepo(ctx context.Context, owner, re
 //
 //meta:operation GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}
 func (s *SecretScanningService) GetAlert(ctx context.Context, owner, repo string, number int64) (*SecretScanningAlert, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", PathEscape(owner), PathEscape(repo), number)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -211,7 +211,7 @@ func (s *SecretScanningService) GetAlert(ctx context.Context, owner, repo string
 //
 //meta:operation PATCH /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}
 func (s *SecretScanningService) UpdateAlert(ctx context.Context, owner, repo string, number int64, opts *SecretScanningAlertUpdateOptions) (*SecretScanningAlert, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", PathEscape(owner), PathEscape(repo), number)
 
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
@@ -236,7 +236,7 @@ func (s *SecretScanningService) UpdateAlert(ctx context.Context, owner, repo str
 //
 //meta:operation GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}/locations
 func (s *SecretScanningService) ListLocationsForAlert(ctx context.Context, owner, repo string, number int64, opts *ListOptions) ([]*SecretScanningAlertLocation, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v/locations", owner, repo, number)
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v/locations", PathEscape(owner), PathEscape(repo), number)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,128 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The possible values of SecretScanningBypassReview.Status.
+const (
+	BypassReviewApprove = "approve"
+	BypassReviewReject  = "reject"
+)
+
+// SecretScanningBypassReview represents the review of a secret scanning
+// bypass request.
+type SecretScanningBypassReview struct {
+	// Status is BypassReviewApprove or BypassReviewReject.
+	Status string `json:"status"`
+	// Message explains the decision. It is required to reject a request.
+	Message string `json:"message"`
+}
+
+// ListBypassRequestsForOrg lists the secret scanning bypass requests of the
+// repositories of an organization.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/secret-scanning/delegated-bypass#list-bypass-requests-for-secret-scanning-for-an-org
+//
+//meta:operation GET /orgs/{org}/bypass-requests/secret-scanning
+func (s *SecretScanningService) ListBypassRequestsForOrg(ctx context.Context, org string, opts *BypassRequestListOptions) ([]*BypassRequest, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/bypass-requests/secret-scanning", PathEscape(org))
+	return s.listBypassRequests(ctx, u, opts)
+}
+
+// ListBypassRequestsForRepo lists the secret scanning bypass requests of a repository.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/secret-scanning/delegated-bypass#list-bypass-requests-for-secret-scanning-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/bypass-requests/secret-scanning
+func (s *SecretScanningService) ListBypassRequestsForRepo(ctx context.Context, owner, repo string, opts *BypassRequestListOptions) ([]*BypassRequest, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/secret-scanning", PathEscape(owner), PathEscape(repo))
+	return s.listBypassRequests(ctx, u, opts)
+}
+
+func (s *SecretScanningService) listBypassRequests(ctx context.Context, u string, opts *BypassRequestListOptions) ([]*BypassRequest, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*BypassRequest
+	resp, err := s.client.Do(ctx, req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
+
+// GetBypassRequest gets a secret scanning bypass request of a repository by its number.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/secret-scanning/delegated-bypass#get-a-bypass-request-for-secret-scanning
+//
+//meta:operation GET /repos/{owner}/{repo}/bypass-requests/secret-scanning/{bypass_request_number}
+func (s *SecretScanningService) GetBypassRequest(ctx context.Context, owner, repo string, number int64) (*BypassRequest, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/secret-scanning/%v", PathEscape(owner), PathEscape(repo), number)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var request *BypassRequest
+	resp, err := s.client.Do(ctx, req, &request)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return request, resp, nil
+}
+
+// ReviewBypassRequest approves or rejects a secret scanning bypass request of
+// a repository. Rejecting a request requires a message; the review is checked
+// before any request is sent.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/secret-scanning/delegated-bypass#review-a-bypass-request-for-secret-scanning
+//
+//meta:operation PATCH /repos/{owner}/{repo}/bypass-requests/secret-scanning/{bypass_request_number}
+func (s *SecretScanningService) ReviewBypassRequest(ctx context.Context, owner, repo string, number int64, review *SecretScanningBypassReview) (*BypassRequestReview, *Response, error) {
+	if review == nil {
+		return nil, nil, errors.New("review must be provided")
+	}
+	switch review.Status {
+	case BypassReviewApprove:
+	case BypassReviewReject:
+		if strings.TrimSpace(review.Message) == "" {
+			return nil, nil, errors.New("a message is required to reject a bypass request")
+		}
+	default:
+		return nil, nil, fmt.Errorf("invalid bypass review status %q, want %q or %q", review.Status, BypassReviewApprove, BypassReviewReject)
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/secret-scanning/%v", PathEscape(owner), PathEscape(repo), number)
+
+	req, err := s.client.NewRequest("PATCH", u, review)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var r *BypassRequestReview
+	resp, err := s.client.Do(ctx, req, &r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
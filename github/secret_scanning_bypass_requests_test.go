@@ -0,0 +1,195 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSecretScanningService_ListBypassRequestsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/bypass-requests/secret-scanning", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"requester": "octocat"})
+		fmt.Fprint(w, `[{"number": 1, "data": [{"secret_type": "adafruit_io_key", "bypass_reason": "used_in_tests"}]}]`)
+	})
+
+	opts := &BypassRequestListOptions{Requester: "octocat"}
+	ctx := context.Background()
+	requests, _, err := client.SecretScanning.ListBypassRequestsForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListBypassRequestsForOrg returned error: %v", err)
+	}
+
+	want := []*BypassRequest{{
+		Number: Int64(1),
+		Data:   []*BypassRequestData{{SecretType: String("adafruit_io_key"), BypassReason: String("used_in_tests")}},
+	}}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("SecretScanning.ListBypassRequestsForOrg returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListBypassRequestsForOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListBypassRequestsForOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListBypassRequestsForOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ListBypassRequestsForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/secret-scanning", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"requester": "octocat", "request_status": "denied"})
+		fmt.Fprint(w, `[{"number": 1, "status": "denied"}]`)
+	})
+
+	opts := &BypassRequestListOptions{Requester: "octocat", RequestStatus: BypassRequestStatusDenied}
+	ctx := context.Background()
+	requests, _, err := client.SecretScanning.ListBypassRequestsForRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListBypassRequestsForRepo returned error: %v", err)
+	}
+
+	want := []*BypassRequest{{Number: Int64(1), Status: String(BypassRequestStatusDenied)}}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("SecretScanning.ListBypassRequestsForRepo returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListBypassRequestsForRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListBypassRequestsForRepo(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListBypassRequestsForRepo(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_GetBypassRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/secret-scanning/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number": 1, "status": "approved"}`)
+	})
+
+	ctx := context.Background()
+	request, _, err := client.SecretScanning.GetBypassRequest(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("SecretScanning.GetBypassRequest returned error: %v", err)
+	}
+
+	want := &BypassRequest{Number: Int64(1), Status: String(BypassRequestStatusApproved)}
+	if !cmp.Equal(request, want) {
+		t.Errorf("SecretScanning.GetBypassRequest returned %+v, want %+v", request, want)
+	}
+
+	const methodName = "GetBypassRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.GetBypassRequest(ctx, "\n", "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.GetBypassRequest(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ReviewBypassRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/secret-scanning/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"status":"reject","message":"This is a real credential"}`+"\n")
+		fmt.Fprint(w, `{"id": 7, "reviewer": {"actor_id": 4, "actor_name": "hubot"}, "status": "denied"}`)
+	})
+
+	review := &SecretScanningBypassReview{Status: BypassReviewReject, Message: "This is a real credential"}
+	ctx := context.Background()
+	got, _, err := client.SecretScanning.ReviewBypassRequest(ctx, "o", "r", 1, review)
+	if err != nil {
+		t.Errorf("SecretScanning.ReviewBypassRequest returned error: %v", err)
+	}
+
+	want := &BypassRequestReview{
+		ID:       Int64(7),
+		Reviewer: &BypassRequestActor{ActorID: Int64(4), ActorName: String("hubot")},
+		Status:   String(BypassRequestStatusDenied),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("SecretScanning.ReviewBypassRequest returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "ReviewBypassRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ReviewBypassRequest(ctx, "\n", "\n", 1, review)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ReviewBypassRequest(ctx, "o", "r", 1, review)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ReviewBypassRequest_invalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/secret-scanning/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %v %v", r.Method, r.URL)
+	})
+
+	tests := map[string]*SecretScanningBypassReview{
+		"nil review":             nil,
+		"reject without message": {Status: BypassReviewReject, Message: "  "},
+		"unknown status":         {Status: "denied", Message: "no"},
+	}
+	for name, review := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			_, resp, err := client.SecretScanning.ReviewBypassRequest(ctx, "o", "r", 1, review)
+			if err == nil {
+				t.Error("SecretScanning.ReviewBypassRequest returned no error, want error")
+			}
+			if resp != nil {
+				t.Errorf("SecretScanning.ReviewBypassRequest returned response %+v, want nil", resp)
+			}
+		})
+	}
+}
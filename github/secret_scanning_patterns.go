@@ -0,0 +1,127 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// The possible push protection settings of a secret scanning pattern.
+const (
+	PushProtectionSettingNotSet   = "not-set"
+	PushProtectionSettingDisabled = "disabled"
+	PushProtectionSettingEnabled  = "enabled"
+)
+
+// SecretScanningPatternConfigs represents the push protection configuration
+// of the secret scanning patterns of an organization.
+type SecretScanningPatternConfigs struct {
+	// PatternConfigVersion identifies this version of the configuration. It
+	// must be passed back to update it.
+	PatternConfigVersion     *string                          `json:"pattern_config_version,omitempty"`
+	ProviderPatternOverrides []*SecretScanningPatternOverride `json:"provider_pattern_overrides,omitempty"`
+	CustomPatternOverrides   []*SecretScanningPatternOverride `json:"custom_pattern_overrides,omitempty"`
+}
+
+// SecretScanningPatternOverride represents the push protection configuration
+// of a secret scanning pattern, either provided by GitHub or custom.
+type SecretScanningPatternOverride struct {
+	TokenType *string `json:"token_type,omitempty"`
+	// CustomPatternVersion is only set for custom patterns.
+	CustomPatternVersion *string `json:"custom_pattern_version,omitempty"`
+	Slug                 *string `json:"slug,omitempty"`
+	DisplayName          *string `json:"display_name,omitempty"`
+	AlertTotal           *int    `json:"alert_total,omitempty"`
+	AlertTotalPercentage *int    `json:"alert_total_percentage,omitempty"`
+	FalsePositives       *int    `json:"false_positives,omitempty"`
+	FalsePositiveRate    *int    `json:"false_positive_rate,omitempty"`
+	BypassRate           *int    `json:"bypass_rate,omitempty"`
+	// DefaultSetting is GitHub's push protection setting for the pattern,
+	// "disabled" or "enabled".
+	DefaultSetting *string `json:"default_setting,omitempty"`
+	// EnterpriseSetting and Setting are the push protection settings of
+	// the enterprise and of the organization, one of the
+	// PushProtectionSetting constants.
+	EnterpriseSetting *string `json:"enterprise_setting,omitempty"`
+	Setting           *string `json:"setting,omitempty"`
+}
+
+// SecretScanningPatternConfigsUpdateOptions specifies the parameters to the
+// SecretScanningService.UpdatePatternConfigsForOrg method.
+type SecretScanningPatternConfigsUpdateOptions struct {
+	// PatternConfigVersion is the version of the configuration being
+	// updated, as last returned by GitHub. It is required if the
+	// configuration was ever updated.
+	PatternConfigVersion    *string                         `json:"pattern_config_version,omitempty"`
+	ProviderPatternSettings []*SecretScanningPatternSetting `json:"provider_pattern_settings,omitempty"`
+	CustomPatternSettings   []*SecretScanningPatternSetting `json:"custom_pattern_settings,omitempty"`
+}
+
+// SecretScanningPatternSetting is a push protection setting of a secret
+// scanning pattern to update.
+type SecretScanningPatternSetting struct {
+	TokenType string `json:"token_type"`
+	// CustomPatternVersion is required for custom patterns, and must be the
+	// current version of the pattern.
+	CustomPatternVersion *string `json:"custom_pattern_version,omitempty"`
+	// PushProtectionSetting is one of the PushProtectionSetting constants.
+	PushProtectionSetting string `json:"push_protection_setting"`
+}
+
+// SecretScanningPatternConfigsUpdate is the result of updating the pattern
+// configurations of an organization.
+type SecretScanningPatternConfigsUpdate struct {
+	PatternConfigVersion *string `json:"pattern_config_version,omitempty"`
+}
+
+// ListPatternConfigsForOrg lists the push protection configuration of the
+// secret scanning patterns of an organization, provided by GitHub and custom.
+//
+// GitHub API docs: https://docs.github.com/rest/secret-scanning/push-protection#list-organization-pattern-configurations
+//
+//meta:operation GET /orgs/{org}/secret-scanning/pattern-configurations
+func (s *SecretScanningService) ListPatternConfigsForOrg(ctx context.Context, org string) (*SecretScanningPatternConfigs, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/secret-scanning/pattern-configurations", PathEscape(org))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configs := new(SecretScanningPatternConfigs)
+	resp, err := s.client.Do(ctx, req, configs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return configs, resp, nil
+}
+
+// UpdatePatternConfigsForOrg updates the push protection settings of secret
+// scanning patterns of an organization. GitHub rejects the update with a 409
+// if opts.PatternConfigVersion is not the current version, and with a 422 for
+// an unknown pattern or an invalid setting.
+//
+// GitHub API docs: https://docs.github.com/rest/secret-scanning/push-protection#update-organization-pattern-configurations
+//
+//meta:operation PATCH /orgs/{org}/secret-scanning/pattern-configurations
+func (s *SecretScanningService) UpdatePatternConfigsForOrg(ctx context.Context, org string, opts *SecretScanningPatternConfigsUpdateOptions) (*SecretScanningPatternConfigsUpdate, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/secret-scanning/pattern-configurations", PathEscape(org))
+
+	req, err := s.client.NewRequest("PATCH", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	update := new(SecretScanningPatternConfigsUpdate)
+	resp, err := s.client.Do(ctx, req, update)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return update, resp, nil
+}
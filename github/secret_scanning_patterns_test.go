@@ -0,0 +1,179 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSecretScanningService_ListPatternConfigsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/secret-scanning/pattern-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"pattern_config_version": "v1",
+			"provider_pattern_overrides": [{
+				"token_type": "GITHUB_PERSONAL_ACCESS_TOKEN",
+				"slug": "github_personal_access_token_legacy_v2",
+				"display_name": "GitHub Personal Access Token (Legacy v2)",
+				"alert_total": 15,
+				"false_positives": 2,
+				"default_setting": "enabled",
+				"setting": "not-set"
+			}],
+			"custom_pattern_overrides": [{
+				"token_type": "cp_2",
+				"custom_pattern_version": "0ujsswThIGTUYm2K8FjOOfXtY1K",
+				"slug": "custom-api-key",
+				"display_name": "Custom API Key",
+				"default_setting": "disabled",
+				"enterprise_setting": "enabled",
+				"setting": "disabled"
+			}]
+		}`)
+	})
+
+	ctx := context.Background()
+	configs, _, err := client.SecretScanning.ListPatternConfigsForOrg(ctx, "o")
+	if err != nil {
+		t.Errorf("SecretScanning.ListPatternConfigsForOrg returned error: %v", err)
+	}
+
+	want := &SecretScanningPatternConfigs{
+		PatternConfigVersion: String("v1"),
+		ProviderPatternOverrides: []*SecretScanningPatternOverride{{
+			TokenType:      String("GITHUB_PERSONAL_ACCESS_TOKEN"),
+			Slug:           String("github_personal_access_token_legacy_v2"),
+			DisplayName:    String("GitHub Personal Access Token (Legacy v2)"),
+			AlertTotal:     Int(15),
+			FalsePositives: Int(2),
+			DefaultSetting: String("enabled"),
+			Setting:        String(PushProtectionSettingNotSet),
+		}},
+		CustomPatternOverrides: []*SecretScanningPatternOverride{{
+			TokenType:            String("cp_2"),
+			CustomPatternVersion: String("0ujsswThIGTUYm2K8FjOOfXtY1K"),
+			Slug:                 String("custom-api-key"),
+			DisplayName:          String("Custom API Key"),
+			DefaultSetting:       String("disabled"),
+			EnterpriseSetting:    String(PushProtectionSettingEnabled),
+			Setting:              String(PushProtectionSettingDisabled),
+		}},
+	}
+	if !cmp.Equal(configs, want) {
+		t.Errorf("SecretScanning.ListPatternConfigsForOrg returned %+v, want %+v", configs, want)
+	}
+
+	const methodName = "ListPatternConfigsForOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListPatternConfigsForOrg(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListPatternConfigsForOrg(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_UpdatePatternConfigsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/secret-scanning/pattern-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"pattern_config_version":"v1","provider_pattern_settings":[{"token_type":"GITHUB_PERSONAL_ACCESS_TOKEN","push_protection_setting":"enabled"}],"custom_pattern_settings":[{"token_type":"cp_2","custom_pattern_version":"0ujsswThIGTUYm2K8FjOOfXtY1K","push_protection_setting":"disabled"}]}`+"\n")
+		fmt.Fprint(w, `{"pattern_config_version":"v2"}`)
+	})
+
+	ctx := context.Background()
+	opts := &SecretScanningPatternConfigsUpdateOptions{
+		PatternConfigVersion: String("v1"),
+		ProviderPatternSettings: []*SecretScanningPatternSetting{{
+			TokenType:             "GITHUB_PERSONAL_ACCESS_TOKEN",
+			PushProtectionSetting: PushProtectionSettingEnabled,
+		}},
+		CustomPatternSettings: []*SecretScanningPatternSetting{{
+			TokenType:             "cp_2",
+			CustomPatternVersion:  String("0ujsswThIGTUYm2K8FjOOfXtY1K"),
+			PushProtectionSetting: PushProtectionSettingDisabled,
+		}},
+	}
+	update, _, err := client.SecretScanning.UpdatePatternConfigsForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.UpdatePatternConfigsForOrg returned error: %v", err)
+	}
+
+	want := &SecretScanningPatternConfigsUpdate{PatternConfigVersion: String("v2")}
+	if !cmp.Equal(update, want) {
+		t.Errorf("SecretScanning.UpdatePatternConfigsForOrg returned %+v, want %+v", update, want)
+	}
+
+	const methodName = "UpdatePatternConfigsForOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.UpdatePatternConfigsForOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.UpdatePatternConfigsForOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_UpdatePatternConfigsForOrg_invalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/secret-scanning/pattern-configurations", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"PatternConfiguration","field":"token_type","code":"invalid"}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &SecretScanningPatternConfigsUpdateOptions{
+		ProviderPatternSettings: []*SecretScanningPatternSetting{{TokenType: "NOPE", PushProtectionSetting: PushProtectionSettingEnabled}},
+	}
+	_, resp, err := client.SecretScanning.UpdatePatternConfigsForOrg(ctx, "o", opts)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("SecretScanning.UpdatePatternConfigsForOrg returned error %v, want *ErrorResponse", err)
+	}
+	if resp.StatusCode != http.StatusUnprocessableEntity || len(errResp.Errors) != 1 || errResp.Errors[0].Field != "token_type" {
+		t.Errorf("SecretScanning.UpdatePatternConfigsForOrg returned %+v, want the 422 validation errors", errResp)
+	}
+}
+
+func TestSecretScanningPatternConfigs_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SecretScanningPatternConfigs{}, "{}")
+
+	u := &SecretScanningPatternConfigs{
+		PatternConfigVersion: String("v1"),
+		CustomPatternOverrides: []*SecretScanningPatternOverride{{
+			TokenType: String("cp_2"),
+			Setting:   String("enabled"),
+		}},
+	}
+	want := `{
+		"pattern_config_version": "v1",
+		"custom_pattern_overrides": [{"token_type": "cp_2", "setting": "enabled"}]
+	}`
+	testJSONMarshal(t, u, want)
+}
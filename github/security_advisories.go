@@ -25,6 +25,21 @@ type RepoAdvisoryCredit struct {
 	Type  *string `json:"type,omitempty"`
 }
 
+// Types of credit for a security advisory, used in RepoAdvisoryCredit.Type,
+// RepoAdvisoryCreditDetailed.Type and Credit.Type.
+const (
+	AdvisoryCreditTypeAnalyst              = "analyst"
+	AdvisoryCreditTypeFinder               = "finder"
+	AdvisoryCreditTypeReporter             = "reporter"
+	AdvisoryCreditTypeCoordinator          = "coordinator"
+	AdvisoryCreditTypeRemediationDeveloper = "remediation_developer"
+	AdvisoryCreditTypeRemediationReviewer  = "remediation_reviewer"
+	AdvisoryCreditTypeRemediationVerifier  = "remediation_verifier"
+	AdvisoryCreditTypeTool                 = "tool"
+	AdvisoryCreditTypeSponsor              = "sponsor"
+	AdvisoryCreditTypeOther                = "other"
+)
+
 // RepoAdvisoryCreditDetailed represents a credit given to a user for a repository Security Advisory.
 type RepoAdvisoryCreditDetailed struct {
 	User  *User   `json:"user,omitempty"`
@@ -48,6 +63,44 @@ type ListRepositorySecurityAdvisoriesOptions struct {
 	State string `url:"state,omitempty"`
 }
 
+// RepositorySecurityAdvisoryRequest represents a request to create or update a
+// repository security advisory.
+type RepositorySecurityAdvisoryRequest struct {
+	// Summary is required when creating an advisory.
+	Summary *string `json:"summary,omitempty"`
+	// Description is required when creating an advisory.
+	Description *string `json:"description,omitempty"`
+	CVEID       *string `json:"cve_id,omitempty"`
+	// Vulnerabilities is required when creating an advisory.
+	Vulnerabilities []*RepositorySecurityAdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	CWEIDs          []string                                   `json:"cwe_ids,omitempty"`
+	Credits         []*RepoAdvisoryCredit                      `json:"credits,omitempty"`
+	// Severity can be one of: critical, high, medium, low. It can't be set
+	// along with CVSSVectorString.
+	Severity         *string `json:"severity,omitempty"`
+	CVSSVectorString *string `json:"cvss_vector_string,omitempty"`
+
+	// StartPrivateFork creates a temporary private fork along with the
+	// advisory. It is only used when creating an advisory.
+	StartPrivateFork *bool `json:"start_private_fork,omitempty"`
+
+	// State, CollaboratingUsers and CollaboratingTeams are only used when
+	// updating an advisory. State can be one of: published, closed, draft.
+	State              *string  `json:"state,omitempty"`
+	CollaboratingUsers []string `json:"collaborating_users,omitempty"`
+	CollaboratingTeams []string `json:"collaborating_teams,omitempty"`
+}
+
+// RepositorySecurityAdvisoryVulnerability represents a vulnerable package in a
+// RepositorySecurityAdvisoryRequest.
+type RepositorySecurityAdvisoryVulnerability struct {
+	// Package is required, with both its ecosystem and name.
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	PatchedVersions        *string               `json:"patched_versions,omitempty"`
+	VulnerableFunctions    []string              `json:"vulnerable_functions,omitempty"`
+}
+
 // ListGlobalSecurityAdvisoriesOptions specifies the optional parameters to list the global security advisories.
 type ListGlobalSecurityAdvisoriesOptions struct {
 	ListCursorOptions
@@ -107,6 +160,7 @@ type GlobalSecurityAdvisory struct {
 	GithubReviewedAt      *Timestamp                     `json:"github_reviewed_at,omitempty"`
 	NVDPublishedAt        *Timestamp                     `json:"nvd_published_at,omitempty"`
 	Credits               []*Credit                      `json:"credits,omitempty"`
+	EPSS                  []*AdvisoryEPSS                `json:"epss,omitempty"`
 }
 
 // GlobalSecurityVulnerability represents a vulnerability for a global security advisory.
@@ -117,6 +171,40 @@ type GlobalSecurityVulnerability struct {
 	VulnerableFunctions    []string              `json:"vulnerable_functions,omitempty"`
 }
 
+// AdvisoryEPSS represents the Exploit Prediction Scoring System (EPSS) score of
+// a global security advisory: the probability of the vulnerability being
+// exploited in the next 30 days, and its percentile among all scored
+// vulnerabilities.
+type AdvisoryEPSS struct {
+	Percentage float64 `json:"percentage"`
+	Percentile float64 `json:"percentile"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The API may encode either value as a JSON string, such as "0.16001e0".
+func (e *AdvisoryEPSS) UnmarshalJSON(data []byte) error {
+	var epss struct {
+		Percentage json.Number `json:"percentage"`
+		Percentile json.Number `json:"percentile"`
+	}
+	if err := json.Unmarshal(data, &epss); err != nil {
+		return err
+	}
+
+	var err error
+	if epss.Percentage != "" {
+		if e.Percentage, err = epss.Percentage.Float64(); err != nil {
+			return err
+		}
+	}
+	if epss.Percentile != "" {
+		if e.Percentile, err = epss.Percentile.Float64(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Credit represents the credit object for a global security advisory.
 type Credit struct {
 	User *User   `json:"user,omitempty"`
@@ -130,7 +218,7 @@ type Credit struct {
 //
 //meta:operation POST /repos/{owner}/{repo}/security-advisories/{ghsa_id}/cve
 func (s *SecurityAdvisoriesService) RequestCVE(ctx context.Context, owner, repo, ghsaID string) (*Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/security-advisories/%v/cve", owner, repo, ghsaID)
+	url := fmt.Sprintf("repos/%v/%v/security-advisories/%v/cve", PathEscape(owner), PathEscape(repo), PathEscape(ghsaID))
 
 	req, err := s.client.NewRequest("POST", url, nil)
 	if err != nil {
@@ -156,7 +244,7 @@ func (s *SecurityAdvisoriesService) RequestCVE(ctx context.Context, owner, repo,
 //
 //meta:operation POST /repos/{owner}/{repo}/security-advisories/{ghsa_id}/forks
 func (s *SecurityAdvisoriesService) CreateTemporaryPrivateFork(ctx context.Context, owner, repo, ghsaID string) (*Repository, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/security-advisories/%v/forks", owner, repo, ghsaID)
+	url := fmt.Sprintf("repos/%v/%v/security-advisories/%v/forks", PathEscape(owner), PathEscape(repo), PathEscape(ghsaID))
 
 	req, err := s.client.NewRequest("POST", url, nil)
 	if err != nil {
@@ -185,7 +273,7 @@ func (s *SecurityAdvisoriesService) CreateTemporaryPrivateFork(ctx context.Conte
 //
 //meta:operation GET /orgs/{org}/security-advisories
 func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesForOrg(ctx context.Context, org string, opt *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
-	url := fmt.Sprintf("orgs/%v/security-advisories", org)
+	url := fmt.Sprintf("orgs/%v/security-advisories", PathEscape(org))
 	url, err := addOptions(url, opt)
 	if err != nil {
 		return nil, nil, err
@@ -211,7 +299,7 @@ func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesForOrg(ctx c
 //
 //meta:operation GET /repos/{owner}/{repo}/security-advisories
 func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context.Context, owner, repo string, opt *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
-	url := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+	url := fmt.Sprintf("repos/%v/%v/security-advisories", PathEscape(owner), PathEscape(repo))
 	url, err := addOptions(url, opt)
 	if err != nil {
 		return nil, nil, err
@@ -231,6 +319,76 @@ func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context
 	return advisories, resp, nil
 }
 
+// GetRepositorySecurityAdvisory gets a repository security advisory using its
+// GitHub Security Advisory (GHSA) identifier.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/repository-advisories#get-a-repository-security-advisory
+//
+//meta:operation GET /repos/{owner}/{repo}/security-advisories/{ghsa_id}
+func (s *SecurityAdvisoriesService) GetRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string) (*SecurityAdvisory, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/security-advisories/%v", PathEscape(owner), PathEscape(repo), PathEscape(ghsaID))
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	advisory := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisory, resp, nil
+}
+
+// CreateRepositorySecurityAdvisory creates a draft security advisory in a
+// repository.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/repository-advisories#create-a-repository-security-advisory
+//
+//meta:operation POST /repos/{owner}/{repo}/security-advisories
+func (s *SecurityAdvisoriesService) CreateRepositorySecurityAdvisory(ctx context.Context, owner, repo string, advisory *RepositorySecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/security-advisories", PathEscape(owner), PathEscape(repo))
+
+	req, err := s.client.NewRequest("POST", url, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// UpdateRepositorySecurityAdvisory updates a repository security advisory
+// using its GitHub Security Advisory (GHSA) identifier. Set the State of
+// advisory to "published" to publish it.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/repository-advisories#update-a-repository-security-advisory
+//
+//meta:operation PATCH /repos/{owner}/{repo}/security-advisories/{ghsa_id}
+func (s *SecurityAdvisoriesService) UpdateRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string, advisory *RepositorySecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/security-advisories/%v", PathEscape(owner), PathEscape(repo), PathEscape(ghsaID))
+
+	req, err := s.client.NewRequest("PATCH", url, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
 // ListGlobalSecurityAdvisories lists all global security advisories.
 //
 // GitHub API docs: https://docs.github.com/rest/security-advisories/global-advisories#list-global-security-advisories
@@ -257,13 +415,38 @@ func (s *SecurityAdvisoriesService) ListGlobalSecurityAdvisories(ctx context.Con
 	return advisories, resp, nil
 }
 
+// FindAdvisoriesForPackage lists the global security advisories
+// affecting version of the package name in ecosystem, such as "npm",
+// "@octokit/rest" and "18.0.0". An empty version matches advisories affecting
+// any version of the package. Other filters and cursor pagination may be set
+// in opts, whose Ecosystem and Affects fields are overridden.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/global-advisories#list-global-security-advisories
+//
+//meta:operation GET /advisories
+func (s *SecurityAdvisoriesService) FindAdvisoriesForPackage(ctx context.Context, ecosystem, name, version string, opts *ListGlobalSecurityAdvisoriesOptions) ([]*GlobalSecurityAdvisory, *Response, error) {
+	var o ListGlobalSecurityAdvisoriesOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	affects := name
+	if version != "" {
+		affects += "@" + version
+	}
+	o.Ecosystem = &ecosystem
+	o.Affects = &affects
+
+	return s.ListGlobalSecurityAdvisories(ctx, &o)
+}
+
 // GetGlobalSecurityAdvisories gets a global security advisory using its GitHub Security Advisory (GHSA) identifier.
 //
 // GitHub API docs: https://docs.github.com/rest/security-advisories/global-advisories#get-a-global-security-advisory
 //
 //meta:operation GET /advisories/{ghsa_id}
 func (s *SecurityAdvisoriesService) GetGlobalSecurityAdvisories(ctx context.Context, ghsaID string) (*GlobalSecurityAdvisory, *Response, error) {
-	url := fmt.Sprintf("advisories/%s", ghsaID)
+	url := fmt.Sprintf("advisories/%s", PathEscape(ghsaID))
 	req, err := s.client.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, nil, err
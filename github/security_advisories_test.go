@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -520,6 +521,8 @@ func TestSecurityAdvisoriesService_CreateTemporaryPrivateFork_invalidOwner(t *te
 	ctx := context.Background()
 	_, _, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "%", "r", "ghsa_id")
 	testURLParseError(t, err)
+	_, _, err = client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "..", "r", "ghsa_id")
+	testPathForbiddenError(t, err)
 }
 
 func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisoriesForOrg_BadRequest(t *testing.T) {
@@ -784,6 +787,165 @@ func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisories(t *testing.T
 	})
 }
 
+func TestSecurityAdvisoriesService_GetRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xxxx-xxxx-xxxx", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"ghsa_id": "GHSA-xxxx-xxxx-xxxx",
+			"state": "draft",
+			"severity": "high",
+			"cvss_severities": {
+				"cvss_v3": {"vector_string": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:N", "score": 9.1},
+				"cvss_v4": {"vector_string": "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:N/SC:N/SI:N/SA:N", "score": 9.3}
+			},
+			"credits_detailed": [
+				{"user": {"login": "finder"}, "type": "finder", "state": "accepted"},
+				{"user": {"login": "dev"}, "type": "remediation_developer", "state": "pending"}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{
+		GHSAID:   String("GHSA-xxxx-xxxx-xxxx"),
+		State:    String("draft"),
+		Severity: String("high"),
+		CVSSSeverities: &AdvisoryCVSSSeverities{
+			CVSSV3: &AdvisoryCVSS{VectorString: String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:N"), Score: Float64(9.1)},
+			CVSSV4: &AdvisoryCVSS{VectorString: String("CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:N/SC:N/SI:N/SA:N"), Score: Float64(9.3)},
+		},
+		CreditsDetailed: []*RepoAdvisoryCreditDetailed{
+			{User: &User{Login: String("finder")}, Type: String(AdvisoryCreditTypeFinder), State: String("accepted")},
+			{User: &User{Login: String("dev")}, Type: String(AdvisoryCreditTypeRemediationDeveloper), State: String("pending")},
+		},
+	}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "GetRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_CreateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &RepositorySecurityAdvisoryRequest{
+		Summary:     String("s"),
+		Description: String("d"),
+		Vulnerabilities: []*RepositorySecurityAdvisoryVulnerability{
+			{
+				Package:                &VulnerabilityPackage{Ecosystem: String("go"), Name: String("example.com/m")},
+				VulnerableVersionRange: String("< 1.2.3"),
+				PatchedVersions:        String("1.2.3"),
+				VulnerableFunctions:    []string{"m.F"},
+			},
+		},
+		CWEIDs: []string{"CWE-79"},
+		Credits: []*RepoAdvisoryCredit{
+			{Login: String("a"), Type: String(AdvisoryCreditTypeAnalyst)},
+			{Login: String("r"), Type: String(AdvisoryCreditTypeReporter)},
+			{Login: String("t"), Type: String(AdvisoryCreditTypeTool)},
+		},
+		CVSSVectorString: String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:N"),
+		StartPrivateFork: Bool(true),
+	}
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"summary":"s","description":"d","vulnerabilities":[{"package":{"ecosystem":"go","name":"example.com/m"},"vulnerable_version_range":"< 1.2.3","patched_versions":"1.2.3","vulnerable_functions":["m.F"]}],"cwe_ids":["CWE-79"],"credits":[{"login":"a","type":"analyst"},{"login":"r","type":"reporter"},{"login":"t","type":"tool"}],"cvss_vector_string":"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:N","start_private_fork":true}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-xxxx-xxxx-xxxx","state":"draft","private_fork":{"id":1}}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-xxxx-xxxx-xxxx"), State: String("draft"), PrivateFork: &Repository{ID: Int64(1)}}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "CreateRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_UpdateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &RepositorySecurityAdvisoryRequest{
+		State:              String("published"),
+		CollaboratingUsers: []string{"u"},
+		CollaboratingTeams: []string{"t"},
+	}
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xxxx-xxxx-xxxx", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"published","collaborating_users":["u"],"collaborating_teams":["t"]}`+"\n")
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-xxxx-xxxx-xxxx","state":"published"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-xxxx-xxxx-xxxx"), State: String("published")}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "UpdateRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "\n", "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestListGlobalSecurityAdvisories(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -970,6 +1132,98 @@ func TestListGlobalSecurityAdvisories(t *testing.T) {
 	})
 }
 
+func TestSecurityAdvisoriesService_FindAdvisoriesForPackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.RawQuery, "affects=%40octokit%2Frest%4018.0.0&after=c&ecosystem=npm&severity=high"; got != want {
+			t.Errorf("Request query is %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `[{"ghsa_id":"GHSA-xoxo-1234-xoxo","epss":[{"percentage":0.00045,"percentile":"0.16001e0"}]}]`)
+	})
+
+	opts := &ListGlobalSecurityAdvisoriesOptions{
+		ListCursorOptions: ListCursorOptions{After: "c"},
+		Ecosystem:         String("pip"),
+		Severity:          String("high"),
+	}
+	ctx := context.Background()
+	advisories, _, err := client.SecurityAdvisories.FindAdvisoriesForPackage(ctx, "npm", "@octokit/rest", "18.0.0", opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.FindAdvisoriesForPackage returned error: %v", err)
+	}
+
+	want := []*GlobalSecurityAdvisory{{
+		SecurityAdvisory: SecurityAdvisory{GHSAID: String("GHSA-xoxo-1234-xoxo")},
+		EPSS:             []*AdvisoryEPSS{{Percentage: 0.00045, Percentile: 0.16001}},
+	}}
+	if !cmp.Equal(advisories, want) {
+		t.Errorf("SecurityAdvisories.FindAdvisoriesForPackage returned %+v, want %+v", advisories, want)
+	}
+	if got := opts.GetEcosystem(); got != "pip" {
+		t.Errorf("SecurityAdvisories.FindAdvisoriesForPackage changed opts.Ecosystem to %v", got)
+	}
+
+	const methodName = "FindAdvisoriesForPackage"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.FindAdvisoriesForPackage(ctx, "npm", "@octokit/rest", "18.0.0", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_FindAdvisoriesForPackage_anyVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ecosystem": "go", "affects": "golang.org/x/net"})
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.SecurityAdvisories.FindAdvisoriesForPackage(ctx, "go", "golang.org/x/net", "", nil); err != nil {
+		t.Errorf("SecurityAdvisories.FindAdvisoriesForPackage returned error: %v", err)
+	}
+}
+
+func TestAdvisoryEPSS_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		data    string
+		want    *AdvisoryEPSS
+		wantErr bool
+	}{
+		"numbers":   {data: `{"percentage":0.00045,"percentile":0.16001}`, want: &AdvisoryEPSS{Percentage: 0.00045, Percentile: 0.16001}},
+		"strings":   {data: `{"percentage":"0.00045","percentile":"0.16001e0"}`, want: &AdvisoryEPSS{Percentage: 0.00045, Percentile: 0.16001}},
+		"missing":   {data: `{}`, want: &AdvisoryEPSS{}},
+		"not float": {data: `{"percentage":"high"}`, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := &AdvisoryEPSS{}
+			err := json.Unmarshal([]byte(tc.data), got)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("json.Unmarshal(%v) returned nil error, want error", tc.data)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("json.Unmarshal(%v) returned error: %v", tc.data, err)
+			}
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("json.Unmarshal(%v) = %+v, want %+v", tc.data, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestGetGlobalSecurityAdvisories(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -85,7 +85,7 @@ func (i Invitation) String() string {
 //
 //meta:operation GET /orgs/{org}/teams
 func (s *TeamsService) ListTeams(ctx context.Context, org string, opts *ListOptions) ([]*Team, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams", org)
+	u := fmt.Sprintf("orgs/%v/teams", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -132,7 +132,7 @@ func (s *TeamsService) GetTeamByID(ctx context.Context, orgID, teamID int64) (*T
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}
 func (s *TeamsService) GetTeamBySlug(ctx context.Context, org, slug string) (*Team, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v", PathEscape(org), PathEscape(slug))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -187,7 +187,7 @@ func (s NewTeam) String() string {
 //
 //meta:operation POST /orgs/{org}/teams
 func (s *TeamsService) CreateTeam(ctx context.Context, org string, team NewTeam) (*Team, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams", org)
+	u := fmt.Sprintf("orgs/%v/teams", PathEscape(org))
 	req, err := s.client.NewRequest("POST", u, team)
 	if err != nil {
 		return nil, nil, err
@@ -264,7 +264,7 @@ func (s *TeamsService) EditTeamByID(ctx context.Context, orgID, teamID int64, te
 //
 //meta:operation PATCH /orgs/{org}/teams/{team_slug}
 func (s *TeamsService) EditTeamBySlug(ctx context.Context, org, slug string, team NewTeam, removeParent bool) (*Team, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v", PathEscape(org), PathEscape(slug))
 
 	var req *http.Request
 	var err error
@@ -308,7 +308,7 @@ func (s *TeamsService) DeleteTeamByID(ctx context.Context, orgID, teamID int64)
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}
 func (s *TeamsService) DeleteTeamBySlug(ctx context.Context, org, slug string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v", PathEscape(org), PathEscape(slug))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -349,7 +349,7 @@ func (s *TeamsService) ListChildTeamsByParentID(ctx context.Context, orgID, team
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/teams
 func (s *TeamsService) ListChildTeamsByParentSlug(ctx context.Context, org, slug string, opts *ListOptions) ([]*Team, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/teams", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/teams", PathEscape(org), PathEscape(slug))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -405,7 +405,7 @@ func (s *TeamsService) ListTeamReposByID(ctx context.Context, orgID, teamID int6
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/repos
 func (s *TeamsService) ListTeamReposBySlug(ctx context.Context, org, slug string, opts *ListOptions) ([]*Repository, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/repos", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/repos", PathEscape(org), PathEscape(slug))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -437,7 +437,7 @@ func (s *TeamsService) ListTeamReposBySlug(ctx context.Context, org, slug string
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
 func (s *TeamsService) IsTeamRepoByID(ctx context.Context, orgID, teamID int64, owner, repo string) (*Repository, *Response, error) {
-	u := fmt.Sprintf("organizations/%v/team/%v/repos/%v/%v", orgID, teamID, owner, repo)
+	u := fmt.Sprintf("organizations/%v/team/%v/repos/%v/%v", orgID, teamID, PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -463,7 +463,7 @@ func (s *TeamsService) IsTeamRepoByID(ctx context.Context, orgID, teamID int64,
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
 func (s *TeamsService) IsTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*Repository, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/repos/%v/%v", org, slug, owner, repo)
+	u := fmt.Sprintf("orgs/%v/teams/%v/repos/%v/%v", PathEscape(org), PathEscape(slug), PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -481,6 +481,29 @@ func (s *TeamsService) IsTeamRepoBySlug(ctx context.Context, org, slug, owner, r
 	return repository, resp, nil
 }
 
+// CheckTeamRepoPermissions checks the permissions a team, given its slug, has
+// on the specified repository, as IsTeamRepoBySlug does. If the team manages
+// the repository, the returned Repository includes the team's Permissions and RoleName. If GitHub
+// only confirms that the team manages the repository, with a 204 No Content
+// response, the returned Repository is nil. If the team does not manage the
+// repository, an *ErrorResponse with a 404 status is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/teams#check-team-permissions-for-a-repository
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
+func (s *TeamsService) CheckTeamRepoPermissions(ctx context.Context, org, teamSlug, owner, repo string) (*Repository, *Response, error) {
+	repository, resp, err := s.IsTeamRepoBySlug(ctx, org, teamSlug, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
+
+	return repository, resp, nil
+}
+
 // TeamAddTeamRepoOptions specifies the optional parameters to the
 // TeamsService.AddTeamRepoByID and TeamsService.AddTeamRepoBySlug methods.
 type TeamAddTeamRepoOptions struct {
@@ -504,7 +527,7 @@ type TeamAddTeamRepoOptions struct {
 //
 //meta:operation PUT /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
 func (s *TeamsService) AddTeamRepoByID(ctx context.Context, orgID, teamID int64, owner, repo string, opts *TeamAddTeamRepoOptions) (*Response, error) {
-	u := fmt.Sprintf("organizations/%v/team/%v/repos/%v/%v", orgID, teamID, owner, repo)
+	u := fmt.Sprintf("organizations/%v/team/%v/repos/%v/%v", orgID, teamID, PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, err
@@ -521,7 +544,7 @@ func (s *TeamsService) AddTeamRepoByID(ctx context.Context, orgID, teamID int64,
 //
 //meta:operation PUT /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
 func (s *TeamsService) AddTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string, opts *TeamAddTeamRepoOptions) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/repos/%v/%v", org, slug, owner, repo)
+	u := fmt.Sprintf("orgs/%v/teams/%v/repos/%v/%v", PathEscape(org), PathEscape(slug), PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, err
@@ -538,7 +561,7 @@ func (s *TeamsService) AddTeamRepoBySlug(ctx context.Context, org, slug, owner,
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
 func (s *TeamsService) RemoveTeamRepoByID(ctx context.Context, orgID, teamID int64, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("organizations/%v/team/%v/repos/%v/%v", orgID, teamID, owner, repo)
+	u := fmt.Sprintf("organizations/%v/team/%v/repos/%v/%v", orgID, teamID, PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -555,7 +578,7 @@ func (s *TeamsService) RemoveTeamRepoByID(ctx context.Context, orgID, teamID int
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
 func (s *TeamsService) RemoveTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/repos/%v/%v", org, slug, owner, repo)
+	u := fmt.Sprintf("orgs/%v/teams/%v/repos/%v/%v", PathEscape(org), PathEscape(slug), PathEscape(owner), PathEscape(repo))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -622,7 +645,7 @@ func (s *TeamsService) ListTeamProjectsByID(ctx context.Context, orgID, teamID i
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/projects
 func (s *TeamsService) ListTeamProjectsBySlug(ctx context.Context, org, slug string) ([]*Project, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/projects", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/projects", PathEscape(org), PathEscape(slug))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -675,7 +698,7 @@ func (s *TeamsService) ReviewTeamProjectsByID(ctx context.Context, orgID, teamID
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/projects/{project_id}
 func (s *TeamsService) ReviewTeamProjectsBySlug(ctx context.Context, org, slug string, projectID int64) (*Project, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/projects/%v", org, slug, projectID)
+	u := fmt.Sprintf("orgs/%v/teams/%v/projects/%v", PathEscape(org), PathEscape(slug), projectID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -735,7 +758,7 @@ func (s *TeamsService) AddTeamProjectByID(ctx context.Context, orgID, teamID, pr
 //
 //meta:operation PUT /orgs/{org}/teams/{team_slug}/projects/{project_id}
 func (s *TeamsService) AddTeamProjectBySlug(ctx context.Context, org, slug string, projectID int64, opts *TeamProjectOptions) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/projects/%v", org, slug, projectID)
+	u := fmt.Sprintf("orgs/%v/teams/%v/projects/%v", PathEscape(org), PathEscape(slug), projectID)
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, err
@@ -783,7 +806,7 @@ func (s *TeamsService) RemoveTeamProjectByID(ctx context.Context, orgID, teamID,
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/projects/{project_id}
 func (s *TeamsService) RemoveTeamProjectBySlug(ctx context.Context, org, slug string, projectID int64) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/projects/%v", org, slug, projectID)
+	u := fmt.Sprintf("orgs/%v/teams/%v/projects/%v", PathEscape(org), PathEscape(slug), projectID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -814,7 +837,7 @@ type IDPGroup struct {
 //
 //meta:operation GET /orgs/{org}/team-sync/groups
 func (s *TeamsService) ListIDPGroupsInOrganization(ctx context.Context, org string, opts *ListCursorOptions) (*IDPGroupList, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/team-sync/groups", org)
+	u := fmt.Sprintf("orgs/%v/team-sync/groups", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -864,7 +887,7 @@ func (s *TeamsService) ListIDPGroupsForTeamByID(ctx context.Context, orgID, team
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/team-sync/group-mappings
 func (s *TeamsService) ListIDPGroupsForTeamBySlug(ctx context.Context, org, slug string) (*IDPGroupList, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/team-sync/group-mappings", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/team-sync/group-mappings", PathEscape(org), PathEscape(slug))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -910,7 +933,7 @@ func (s *TeamsService) CreateOrUpdateIDPGroupConnectionsByID(ctx context.Context
 //
 //meta:operation PATCH /orgs/{org}/teams/{team_slug}/team-sync/group-mappings
 func (s *TeamsService) CreateOrUpdateIDPGroupConnectionsBySlug(ctx context.Context, org, slug string, opts IDPGroupList) (*IDPGroupList, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/team-sync/group-mappings", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/team-sync/group-mappings", PathEscape(org), PathEscape(slug))
 
 	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
@@ -960,7 +983,7 @@ type ExternalGroupList struct {
 //
 //meta:operation GET /orgs/{org}/external-group/{group_id}
 func (s *TeamsService) GetExternalGroup(ctx context.Context, org string, groupID int64) (*ExternalGroup, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/external-group/%v", org, groupID)
+	u := fmt.Sprintf("orgs/%v/external-group/%v", PathEscape(org), groupID)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -989,7 +1012,7 @@ type ListExternalGroupsOptions struct {
 //
 //meta:operation GET /orgs/{org}/external-groups
 func (s *TeamsService) ListExternalGroups(ctx context.Context, org string, opts *ListExternalGroupsOptions) (*ExternalGroupList, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/external-groups", org)
+	u := fmt.Sprintf("orgs/%v/external-groups", PathEscape(org))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -1015,7 +1038,7 @@ func (s *TeamsService) ListExternalGroups(ctx context.Context, org string, opts
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/external-groups
 func (s *TeamsService) ListExternalGroupsForTeamBySlug(ctx context.Context, org, slug string) (*ExternalGroupList, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/external-groups", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/external-groups", PathEscape(org), PathEscape(slug))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -1037,7 +1060,7 @@ func (s *TeamsService) ListExternalGroupsForTeamBySlug(ctx context.Context, org,
 //
 //meta:operation PATCH /orgs/{org}/teams/{team_slug}/external-groups
 func (s *TeamsService) UpdateConnectedExternalGroup(ctx context.Context, org, slug string, eg *ExternalGroup) (*ExternalGroup, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/external-groups", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/external-groups", PathEscape(org), PathEscape(slug))
 
 	req, err := s.client.NewRequest("PATCH", u, eg)
 	if err != nil {
@@ -1059,7 +1082,7 @@ func (s *TeamsService) UpdateConnectedExternalGroup(ctx context.Context, org, sl
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/external-groups
 func (s *TeamsService) RemoveConnectedExternalGroup(ctx context.Context, org, slug string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/external-groups", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/external-groups", PathEscape(org), PathEscape(slug))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
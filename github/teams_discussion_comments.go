@@ -74,7 +74,7 @@ func (s *TeamsService) ListCommentsByID(ctx context.Context, orgID, teamID int64
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments
 func (s *TeamsService) ListCommentsBySlug(ctx context.Context, org, slug string, discussionNumber int, options *DiscussionCommentListOptions) ([]*DiscussionComment, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments", org, slug, discussionNumber)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments", PathEscape(org), PathEscape(slug), discussionNumber)
 	u, err := addOptions(u, options)
 	if err != nil {
 		return nil, nil, err
@@ -123,7 +123,7 @@ func (s *TeamsService) GetCommentByID(ctx context.Context, orgID, teamID int64,
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}
 func (s *TeamsService) GetCommentBySlug(ctx context.Context, org, slug string, discussionNumber, commentNumber int) (*DiscussionComment, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments/%v", org, slug, discussionNumber, commentNumber)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments/%v", PathEscape(org), PathEscape(slug), discussionNumber, commentNumber)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -168,7 +168,7 @@ func (s *TeamsService) CreateCommentByID(ctx context.Context, orgID, teamID int6
 //
 //meta:operation POST /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments
 func (s *TeamsService) CreateCommentBySlug(ctx context.Context, org, slug string, discsusionNumber int, comment DiscussionComment) (*DiscussionComment, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments", org, slug, discsusionNumber)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments", PathEscape(org), PathEscape(slug), discsusionNumber)
 	req, err := s.client.NewRequest("POST", u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -214,7 +214,7 @@ func (s *TeamsService) EditCommentByID(ctx context.Context, orgID, teamID int64,
 //
 //meta:operation PATCH /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}
 func (s *TeamsService) EditCommentBySlug(ctx context.Context, org, slug string, discussionNumber, commentNumber int, comment DiscussionComment) (*DiscussionComment, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments/%v", org, slug, discussionNumber, commentNumber)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments/%v", PathEscape(org), PathEscape(slug), discussionNumber, commentNumber)
 	req, err := s.client.NewRequest("PATCH", u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -252,7 +252,7 @@ func (s *TeamsService) DeleteCommentByID(ctx context.Context, orgID, teamID int6
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}
 func (s *TeamsService) DeleteCommentBySlug(ctx context.Context, org, slug string, discussionNumber, commentNumber int) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments/%v", org, slug, discussionNumber, commentNumber)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v/comments/%v", PathEscape(org), PathEscape(slug), discussionNumber, commentNumber)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -80,7 +80,7 @@ func (s *TeamsService) ListDiscussionsByID(ctx context.Context, orgID, teamID in
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/discussions
 func (s *TeamsService) ListDiscussionsBySlug(ctx context.Context, org, slug string, opts *DiscussionListOptions) ([]*TeamDiscussion, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions", PathEscape(org), PathEscape(slug))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -129,7 +129,7 @@ func (s *TeamsService) GetDiscussionByID(ctx context.Context, orgID, teamID int6
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}
 func (s *TeamsService) GetDiscussionBySlug(ctx context.Context, org, slug string, discussionNumber int) (*TeamDiscussion, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v", org, slug, discussionNumber)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v", PathEscape(org), PathEscape(slug), discussionNumber)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -173,7 +173,7 @@ func (s *TeamsService) CreateDiscussionByID(ctx context.Context, orgID, teamID i
 //
 //meta:operation POST /orgs/{org}/teams/{team_slug}/discussions
 func (s *TeamsService) CreateDiscussionBySlug(ctx context.Context, org, slug string, discussion TeamDiscussion) (*TeamDiscussion, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions", PathEscape(org), PathEscape(slug))
 	req, err := s.client.NewRequest("POST", u, discussion)
 	if err != nil {
 		return nil, nil, err
@@ -219,7 +219,7 @@ func (s *TeamsService) EditDiscussionByID(ctx context.Context, orgID, teamID int
 //
 //meta:operation PATCH /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}
 func (s *TeamsService) EditDiscussionBySlug(ctx context.Context, org, slug string, discussionNumber int, discussion TeamDiscussion) (*TeamDiscussion, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v", org, slug, discussionNumber)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v", PathEscape(org), PathEscape(slug), discussionNumber)
 	req, err := s.client.NewRequest("PATCH", u, discussion)
 	if err != nil {
 		return nil, nil, err
@@ -257,7 +257,7 @@ func (s *TeamsService) DeleteDiscussionByID(ctx context.Context, orgID, teamID i
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}
 func (s *TeamsService) DeleteDiscussionBySlug(ctx context.Context, org, slug string, discussionNumber int) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v", org, slug, discussionNumber)
+	u := fmt.Sprintf("orgs/%v/teams/%v/discussions/%v", PathEscape(org), PathEscape(slug), discussionNumber)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -54,7 +54,7 @@ func (s *TeamsService) ListTeamMembersByID(ctx context.Context, orgID, teamID in
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/members
 func (s *TeamsService) ListTeamMembersBySlug(ctx context.Context, org, slug string, opts *TeamListTeamMembersOptions) ([]*User, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/members", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/members", PathEscape(org), PathEscape(slug))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -81,7 +81,7 @@ func (s *TeamsService) ListTeamMembersBySlug(ctx context.Context, org, slug stri
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/members
 func (s *TeamsService) GetTeamMembershipByID(ctx context.Context, orgID, teamID int64, user string) (*Membership, *Response, error) {
-	u := fmt.Sprintf("organizations/%v/team/%v/memberships/%v", orgID, teamID, user)
+	u := fmt.Sprintf("organizations/%v/team/%v/memberships/%v", orgID, teamID, PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -103,7 +103,7 @@ func (s *TeamsService) GetTeamMembershipByID(ctx context.Context, orgID, teamID
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/memberships/{username}
 func (s *TeamsService) GetTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*Membership, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/memberships/%v", org, slug, user)
+	u := fmt.Sprintf("orgs/%v/teams/%v/memberships/%v", PathEscape(org), PathEscape(slug), PathEscape(user))
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -139,7 +139,7 @@ type TeamAddTeamMembershipOptions struct {
 //
 //meta:operation PUT /orgs/{org}/teams/{team_slug}/memberships/{username}
 func (s *TeamsService) AddTeamMembershipByID(ctx context.Context, orgID, teamID int64, user string, opts *TeamAddTeamMembershipOptions) (*Membership, *Response, error) {
-	u := fmt.Sprintf("organizations/%v/team/%v/memberships/%v", orgID, teamID, user)
+	u := fmt.Sprintf("organizations/%v/team/%v/memberships/%v", orgID, teamID, PathEscape(user))
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -161,7 +161,7 @@ func (s *TeamsService) AddTeamMembershipByID(ctx context.Context, orgID, teamID
 //
 //meta:operation PUT /orgs/{org}/teams/{team_slug}/memberships/{username}
 func (s *TeamsService) AddTeamMembershipBySlug(ctx context.Context, org, slug, user string, opts *TeamAddTeamMembershipOptions) (*Membership, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/memberships/%v", org, slug, user)
+	u := fmt.Sprintf("orgs/%v/teams/%v/memberships/%v", PathEscape(org), PathEscape(slug), PathEscape(user))
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -183,7 +183,7 @@ func (s *TeamsService) AddTeamMembershipBySlug(ctx context.Context, org, slug, u
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/memberships/{username}
 func (s *TeamsService) RemoveTeamMembershipByID(ctx context.Context, orgID, teamID int64, user string) (*Response, error) {
-	u := fmt.Sprintf("organizations/%v/team/%v/memberships/%v", orgID, teamID, user)
+	u := fmt.Sprintf("organizations/%v/team/%v/memberships/%v", orgID, teamID, PathEscape(user))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -199,7 +199,7 @@ func (s *TeamsService) RemoveTeamMembershipByID(ctx context.Context, orgID, team
 //
 //meta:operation DELETE /orgs/{org}/teams/{team_slug}/memberships/{username}
 func (s *TeamsService) RemoveTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/memberships/%v", org, slug, user)
+	u := fmt.Sprintf("orgs/%v/teams/%v/memberships/%v", PathEscape(org), PathEscape(slug), PathEscape(user))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -242,7 +242,7 @@ func (s *TeamsService) ListPendingTeamInvitationsByID(ctx context.Context, orgID
 //
 //meta:operation GET /orgs/{org}/teams/{team_slug}/invitations
 func (s *TeamsService) ListPendingTeamInvitationsBySlug(ctx context.Context, org, slug string, opts *ListOptions) ([]*Invitation, *Response, error) {
-	u := fmt.Sprintf("orgs/%v/teams/%v/invitations", org, slug)
+	u := fmt.Sprintf("orgs/%v/teams/%v/invitations", PathEscape(org), PathEscape(slug))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
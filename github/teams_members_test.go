@@ -172,6 +172,8 @@ func TestTeamsService__ListTeamMembersBySlug_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Teams.ListTeamMembersBySlug(ctx, "%", "s", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Teams.ListTeamMembersBySlug(ctx, "..", "s", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService__GetTeamMembershipByID(t *testing.T) {
@@ -323,6 +325,8 @@ func TestTeamsService__GetTeamMembershipBySlug_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Teams.GetTeamMembershipBySlug(ctx, "%s", "s", "u")
 	testURLParseError(t, err)
+	_, _, err = client.Teams.GetTeamMembershipBySlug(ctx, "..", "s", "u")
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService__AddTeamMembershipByID(t *testing.T) {
@@ -510,6 +514,8 @@ func TestTeamsService__AddTeamMembershipBySlug_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Teams.AddTeamMembershipBySlug(ctx, "%", "s", "u", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Teams.AddTeamMembershipBySlug(ctx, "..", "s", "u", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService__RemoveTeamMembershipByID(t *testing.T) {
@@ -629,6 +635,8 @@ func TestTeamsService__RemoveTeamMembershipBySlug_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Teams.RemoveTeamMembershipBySlug(ctx, "%", "s", "u")
 	testURLParseError(t, err)
+	_, err = client.Teams.RemoveTeamMembershipBySlug(ctx, "..", "s", "u")
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService__ListPendingTeamInvitationsByID(t *testing.T) {
@@ -788,6 +796,8 @@ func TestTeamsService__ListPendingTeamInvitationsBySlug_invalidOrg(t *testing.T)
 	ctx := context.Background()
 	_, _, err := client.Teams.ListPendingTeamInvitationsBySlug(ctx, "%", "s", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Teams.ListPendingTeamInvitationsBySlug(ctx, "..", "s", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamAddTeamMembershipOptions_Marshal(t *testing.T) {
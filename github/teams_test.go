@@ -62,6 +62,8 @@ func TestTeamsService_ListTeams_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Teams.ListTeams(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Teams.ListTeams(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService_GetTeamByID(t *testing.T) {
@@ -163,6 +165,8 @@ func TestTeamsService_GetTeamBySlug_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Teams.GetTeamBySlug(ctx, "%", "s")
 	testURLParseError(t, err)
+	_, _, err = client.Teams.GetTeamBySlug(ctx, "..", "s")
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService_GetTeamBySlug_notFound(t *testing.T) {
@@ -238,6 +242,8 @@ func TestTeamsService_CreateTeam_invalidOrg(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Teams.CreateTeam(ctx, "%", NewTeam{})
 	testURLParseError(t, err)
+	_, _, err = client.Teams.CreateTeam(ctx, "..", NewTeam{})
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService_EditTeamByID(t *testing.T) {
@@ -684,6 +690,81 @@ func TestTeamsService_IsTeamRepoBySlug_true(t *testing.T) {
 	})
 }
 
+func TestTeamsService_CheckTeamRepoPermissions(t *testing.T) {
+	tests := map[string]struct {
+		status   int
+		body     string
+		want     *Repository
+		wantErr  bool
+		wantCode int
+	}{
+		"no content": {
+			status:   http.StatusNoContent,
+			wantCode: http.StatusNoContent,
+		},
+		"permissions": {
+			status: http.StatusOK,
+			body:   `{"id":1,"full_name":"owner/repo","permissions":{"admin":false,"maintain":false,"push":true,"triage":true,"pull":true},"role_name":"write"}`,
+			want: &Repository{
+				ID:          Int64(1),
+				FullName:    String("owner/repo"),
+				Permissions: map[string]bool{"admin": false, "maintain": false, "push": true, "triage": true, "pull": true},
+				RoleName:    String("write"),
+			},
+			wantCode: http.StatusOK,
+		},
+		"not managed": {
+			status:   http.StatusNotFound,
+			body:     `{"message":"Not Found"}`,
+			wantErr:  true,
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/orgs/org/teams/slug/repos/owner/repo", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testHeader(t, r, "Accept", mediaTypeOrgPermissionRepo)
+				w.WriteHeader(test.status)
+				fmt.Fprint(w, test.body)
+			})
+
+			ctx := context.Background()
+			repo, resp, err := client.Teams.CheckTeamRepoPermissions(ctx, "org", "slug", "owner", "repo")
+			if test.wantErr != (err != nil) {
+				t.Fatalf("Teams.CheckTeamRepoPermissions returned error %v, want error: %v", err, test.wantErr)
+			}
+			if got := resp.StatusCode; got != test.wantCode {
+				t.Errorf("Teams.CheckTeamRepoPermissions returned status %v, want %v", got, test.wantCode)
+			}
+			if !cmp.Equal(repo, test.want) {
+				t.Errorf("Teams.CheckTeamRepoPermissions returned %+v, want %+v", repo, test.want)
+			}
+		})
+	}
+
+	client, _, _, teardown := setup()
+	defer teardown()
+	ctx := context.Background()
+	const methodName = "CheckTeamRepoPermissions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Teams.CheckTeamRepoPermissions(ctx, "\n", "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Teams.CheckTeamRepoPermissions(ctx, "org", "slug", "owner", "repo")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestTeamsService_IsTeamRepoByID_false(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -779,6 +860,8 @@ func TestTeamsService_IsTeamRepoByID_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Teams.IsTeamRepoByID(ctx, 1, 1, "%", "r")
 	testURLParseError(t, err)
+	_, _, err = client.Teams.IsTeamRepoByID(ctx, 1, 1, "..", "r")
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService_IsTeamRepoBySlug_invalidOwner(t *testing.T) {
@@ -788,6 +871,8 @@ func TestTeamsService_IsTeamRepoBySlug_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Teams.IsTeamRepoBySlug(ctx, "o", "s", "%", "r")
 	testURLParseError(t, err)
+	_, _, err = client.Teams.IsTeamRepoBySlug(ctx, "o", "s", "..", "r")
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService_AddTeamRepoByID(t *testing.T) {
@@ -899,6 +984,8 @@ func TestTeamsService_AddTeamRepoByID_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Teams.AddTeamRepoByID(ctx, 1, 1, "%", "r", nil)
 	testURLParseError(t, err)
+	_, err = client.Teams.AddTeamRepoByID(ctx, 1, 1, "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService_AddTeamRepoBySlug_invalidOwner(t *testing.T) {
@@ -908,6 +995,8 @@ func TestTeamsService_AddTeamRepoBySlug_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Teams.AddTeamRepoBySlug(ctx, "o", "s", "%", "r", nil)
 	testURLParseError(t, err)
+	_, err = client.Teams.AddTeamRepoBySlug(ctx, "o", "s", "..", "r", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService_RemoveTeamRepoByID(t *testing.T) {
@@ -969,6 +1058,8 @@ func TestTeamsService_RemoveTeamRepoByID_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Teams.RemoveTeamRepoByID(ctx, 1, 1, "%", "r")
 	testURLParseError(t, err)
+	_, err = client.Teams.RemoveTeamRepoByID(ctx, 1, 1, "..", "r")
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService_RemoveTeamRepoBySlug_invalidOwner(t *testing.T) {
@@ -978,6 +1069,8 @@ func TestTeamsService_RemoveTeamRepoBySlug_invalidOwner(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Teams.RemoveTeamRepoBySlug(ctx, "o", "s", "%", "r")
 	testURLParseError(t, err)
+	_, err = client.Teams.RemoveTeamRepoBySlug(ctx, "o", "s", "..", "r")
+	testPathForbiddenError(t, err)
 }
 
 func TestTeamsService_ListUserTeams(t *testing.T) {
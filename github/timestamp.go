@@ -7,6 +7,7 @@ package github
 
 import (
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -31,21 +32,60 @@ func (t *Timestamp) GetTime() *time.Time {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or Unix format.
+// Time is expected in RFC3339 format, with or without fractional seconds and
+// with any offset, or as a Unix timestamp in seconds or, for values beyond the
+// year 3000 in seconds, in milliseconds. Unix timestamps may have a
+// fractional part and may be quoted. null leaves t unchanged.
+//
+// The fractional seconds and offset of an RFC3339 time are kept, so that
+// marshaling t again, which uses time.RFC3339Nano, gives the same instant.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 	str := string(data)
-	i, err := strconv.ParseInt(str, 10, 64)
-	if err == nil {
-		t.Time = time.Unix(i, 0)
-		if t.Time.Year() > 3000 {
-			t.Time = time.Unix(0, i*1e6)
+	if str == "null" {
+		return nil
+	}
+	if unix, ok := parseUnixTimestamp(str); ok {
+		t.Time = unix
+		return nil
+	}
+	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
+		if unix, ok := parseUnixTimestamp(str[1 : len(str)-1]); ok {
+			t.Time = unix
+			return nil
 		}
-	} else {
-		t.Time, err = time.Parse(`"`+time.RFC3339+`"`, str)
 	}
+	t.Time, err = time.Parse(`"`+time.RFC3339+`"`, str)
 	return
 }
 
+// parseUnixTimestamp parses s as a decimal number of seconds or, if that is
+// beyond the year 3000, milliseconds since the Unix epoch.
+func parseUnixTimestamp(s string) (time.Time, bool) {
+	intPart, frac, hasFrac := strings.Cut(s, ".")
+	i, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || (hasFrac && (frac == "" || strings.Trim(frac, "0123456789") != "")) {
+		return time.Time{}, false
+	}
+
+	// digits is the number of fractional digits of a second or millisecond
+	// that fit in nanoseconds.
+	sec, nsec, digits := i, int64(0), 9
+	if time.Unix(i, 0).Year() > 3000 {
+		sec, nsec, digits = i/1000, i%1000*int64(time.Millisecond), 6
+	}
+	if hasFrac {
+		if len(frac) > digits {
+			frac = frac[:digits]
+		}
+		n, _ := strconv.ParseInt(frac+strings.Repeat("0", digits-len(frac)), 10, 64)
+		if strings.HasPrefix(intPart, "-") {
+			n = -n
+		}
+		nsec += n
+	}
+	return time.Unix(sec, nsec), true
+}
+
 // Equal reports whether t and u are equal based on time.Equal
 func (t Timestamp) Equal(u Timestamp) bool {
 	return t.Time.Equal(u.Time)
@@ -68,6 +68,19 @@ func TestTimestamp_Unmarshal(t *testing.T) {
 		{"MismatchUnix", `0`, Timestamp{}, false, false},
 		{"Invalid", `"asdf"`, Timestamp{referenceTime}, true, false},
 		{"OffByMillisecond", `1136214245001`, Timestamp{referenceTime}, false, false},
+		{"ReferenceMicroseconds", `"2006-01-02T15:04:05.123456Z"`, Timestamp{referenceTime.Add(123456 * time.Microsecond)}, false, true},
+		{"ReferenceOffset", `"2006-01-02T10:04:05-05:00"`, Timestamp{referenceTime}, false, true},
+		{"ReferenceOffsetFractional", `"2006-01-02T20:34:05.5+05:30"`, Timestamp{referenceTime.Add(500 * time.Millisecond)}, false, true},
+		{"ReferenceUnixFractional", `1136214245.25`, Timestamp{referenceTime.Add(250 * time.Millisecond)}, false, true},
+		{"ReferenceUnixMillisecondFractional", `1136214245001.5`, Timestamp{referenceTime.Add(1500 * time.Microsecond)}, false, true},
+		{"ReferenceUnixNanosecondFraction", `1136214245.1234567891`, Timestamp{referenceTime.Add(123456789)}, false, true},
+		{"ReferenceUnixQuoted", `"1136214245"`, Timestamp{referenceTime}, false, true},
+		{"ReferenceUnixMillisecondQuoted", `"1136214245000"`, Timestamp{referenceTime}, false, true},
+		{"BeforeUnixStart", `-1.5`, Timestamp{unixOrigin.Add(-1500 * time.Millisecond)}, false, true},
+		{"Null", `null`, Timestamp{}, false, true},
+		{"InvalidEmptyFraction", `"1136214245."`, Timestamp{referenceTime}, true, false},
+		{"InvalidFraction", `"1136214245.5e3"`, Timestamp{referenceTime}, true, false},
+		{"InvalidDate", `"2006-01-02"`, Timestamp{referenceTime}, true, false},
 	}
 	for _, tc := range testCases {
 		var got Timestamp
@@ -90,6 +103,8 @@ func TestTimestamp_MarshalReflexivity(t *testing.T) {
 	}{
 		{"Reference", Timestamp{referenceTime}},
 		{"Empty", Timestamp{}},
+		{"Fractional", Timestamp{referenceTime.Add(123456 * time.Microsecond)}},
+		{"Offset", Timestamp{referenceTime.In(time.FixedZone("", -5*60*60))}},
 	}
 	for _, tc := range testCases {
 		data, err := json.Marshal(tc.data)
@@ -107,6 +122,28 @@ func TestTimestamp_MarshalReflexivity(t *testing.T) {
 	}
 }
 
+func TestTimestamp_UnmarshalMarshalStability(t *testing.T) {
+	for _, data := range []string{
+		referenceTimeStr,
+		`"2006-01-02T15:04:05.123456Z"`,
+		`"2006-01-02T10:04:05.5-05:00"`,
+		`"2006-01-02T20:34:05.000001+05:30"`,
+	} {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(data), &ts); err != nil {
+			t.Errorf("Unmarshal(%s) err=%v", data, err)
+			continue
+		}
+		got, err := json.Marshal(ts)
+		if err != nil {
+			t.Errorf("Marshal(%v) err=%v", ts, err)
+		}
+		if string(got) != data {
+			t.Errorf("Marshal(Unmarshal(%s)) = %s, want %s", data, got, data)
+		}
+	}
+}
+
 type WrappedTimestamp struct {
 	A    int
 	Time Timestamp
@@ -0,0 +1,156 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// ConnEvent describes the connection obtained by a request, as reported by
+// the callback of Client.WithConnectionStats.
+type ConnEvent struct {
+	// Host is the host, and port if any, of the request URL.
+	Host string
+	// Reused reports whether the connection had served a previous request.
+	Reused bool
+	// WasIdle reports whether the connection was obtained from the idle
+	// pool, and IdleTime for how long it had been idle.
+	WasIdle  bool
+	IdleTime time.Duration
+}
+
+// HostConnStats counts the connections obtained by the requests to a host.
+type HostConnStats struct {
+	// Opened is the number of new connections.
+	Opened int64
+	// Reused is the number of connections that had served a previous
+	// request.
+	Reused int64
+}
+
+// ConnStats counts the connections obtained by the requests of a client
+// returned by Client.WithConnectionStats.
+type ConnStats struct {
+	HostConnStats
+	// PerHost has the counts of each host, as in ConnEvent.Host.
+	PerHost map[string]HostConnStats
+}
+
+// connStatsRecorder accumulates the ConnStats of a client.
+type connStatsRecorder struct {
+	mu    sync.Mutex
+	stats ConnStats
+}
+
+func (r *connStatsRecorder) record(event ConnEvent) ConnStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stats.PerHost == nil {
+		r.stats.PerHost = make(map[string]HostConnStats)
+	}
+	host := r.stats.PerHost[event.Host]
+	if event.Reused {
+		r.stats.Reused++
+		host.Reused++
+	} else {
+		r.stats.Opened++
+		host.Opened++
+	}
+	r.stats.PerHost[event.Host] = host
+
+	snapshot := ConnStats{HostConnStats: r.stats.HostConnStats, PerHost: make(map[string]HostConnStats, len(r.stats.PerHost))}
+	for h, s := range r.stats.PerHost {
+		snapshot.PerHost[h] = s
+	}
+	return snapshot
+}
+
+// WithConnectionStats returns a copy of the client that calls fn each time
+// one of its requests obtains a connection, with a description of the
+// connection and the counts of the connections opened and reused by the copy
+// so far, in total and per host. It helps diagnose connection churn, such as
+// connections that are not reused because response bodies are not closed.
+//
+// The connections are observed with net/http/httptrace, by wrapping the
+// transport of the client, so it works with any transport. fn may be called
+// concurrently, and the ConnStats it receives is its own copy.
+func (c *Client) WithConnectionStats(fn func(ConnEvent, ConnStats)) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	transport := c2.client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	recorder := &connStatsRecorder{}
+	c2.client.Transport = roundTripperFunc(
+		func(req *http.Request) (*http.Response, error) {
+			host := req.URL.Host
+			trace := &httptrace.ClientTrace{
+				GotConn: func(info httptrace.GotConnInfo) {
+					event := ConnEvent{
+						Host:     host,
+						Reused:   info.Reused,
+						WasIdle:  info.WasIdle,
+						IdleTime: info.IdleTime,
+					}
+					fn(event, recorder.record(event))
+				},
+			}
+			ctx := httptrace.WithClientTrace(req.Context(), trace)
+			return transport.RoundTrip(req.WithContext(ctx))
+		},
+	)
+	return c2
+}
+
+// WithHTTP2 returns a copy of the client whose transport attempts HTTP/2, or
+// only uses HTTP/1.1 when enabled is false, for example to work around
+// proxies or servers that misbehave with HTTP/2 under load.
+//
+// This applies to the transport the library uses when the client's
+// http.Client has none, and to an *http.Transport, which is cloned rather
+// than modified. Other transports, including those wrapped by options such as
+// WithAuthToken, cannot be configured and WithHTTP2 returns an error for them,
+// so call WithHTTP2 first.
+func (c *Client) WithHTTP2(enabled bool) (*Client, error) {
+	var transport *http.Transport
+	switch t := c.client.Transport.(type) {
+	case nil:
+		if dt, ok := http.DefaultTransport.(*http.Transport); ok {
+			transport = dt.Clone()
+		} else {
+			transport = &http.Transport{}
+		}
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, fmt.Errorf("cannot configure HTTP/2 of a transport of type %T, want *http.Transport", t)
+	}
+
+	transport.ForceAttemptHTTP2 = enabled
+	if enabled {
+		if len(transport.TLSNextProto) == 0 {
+			// A nil TLSNextProto lets the transport configure HTTP/2.
+			transport.TLSNextProto = nil
+		}
+	} else {
+		// A non-nil, empty TLSNextProto disables HTTP/2.
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		if transport.TLSClientConfig != nil {
+			transport.TLSClientConfig.NextProtos = nil
+		}
+	}
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.client.Transport = transport
+	return c2, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_WithConnectionStats(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	var mu sync.Mutex
+	var events []ConnEvent
+	var last ConnStats
+	client = client.WithConnectionStats(func(event ConnEvent, stats ConnStats) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+		last = stats
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Meta.Get(ctx); err != nil {
+			t.Fatalf("Meta.Get returned error: %v", err)
+		}
+	}
+
+	u, _ := url.Parse(serverURL)
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != 2 {
+		t.Fatalf("WithConnectionStats reported %v connections, want 2", len(events))
+	}
+	if events[0].Host != u.Host || events[0].Reused {
+		t.Errorf("first connection is %+v, want a new connection to %v", events[0], u.Host)
+	}
+	if events[1].Host != u.Host || !events[1].Reused || !events[1].WasIdle {
+		t.Errorf("second connection is %+v, want a reused idle connection to %v", events[1], u.Host)
+	}
+
+	want := ConnStats{
+		HostConnStats: HostConnStats{Opened: 1, Reused: 1},
+		PerHost:       map[string]HostConnStats{u.Host: {Opened: 1, Reused: 1}},
+	}
+	if !cmp.Equal(last, want) {
+		t.Errorf("WithConnectionStats reported %+v, want %+v", last, want)
+	}
+}
+
+func TestClient_WithConnectionStats_wrapsTransport(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	var wrapped int
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		wrapped++
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	var reported int
+	client := NewClient(&http.Client{Transport: transport}).WithConnectionStats(func(ConnEvent, ConnStats) {
+		reported++
+	})
+	client.BaseURL, _ = url.Parse(serverURL + baseURLPath + "/")
+
+	if _, _, err := client.Meta.Get(context.Background()); err != nil {
+		t.Fatalf("Meta.Get returned error: %v", err)
+	}
+	if wrapped != 1 || reported != 1 {
+		t.Errorf("the transport was called %v times and the stats %v times, want 1 and 1", wrapped, reported)
+	}
+}
+
+func TestClient_WithHTTP2(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	disabled, err := client.WithHTTP2(false)
+	if err != nil {
+		t.Fatalf("WithHTTP2(false) returned error: %v", err)
+	}
+	transport, ok := disabled.client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("WithHTTP2(false) transport is %T, want *http.Transport", disabled.client.Transport)
+	}
+	if transport.ForceAttemptHTTP2 || transport.TLSNextProto == nil || len(transport.TLSNextProto) != 0 {
+		t.Errorf("WithHTTP2(false) transport has ForceAttemptHTTP2 %v and TLSNextProto %v, want false and an empty map", transport.ForceAttemptHTTP2, transport.TLSNextProto)
+	}
+	if transport == http.DefaultTransport {
+		t.Error("WithHTTP2(false) modified http.DefaultTransport")
+	}
+	if _, _, err := disabled.Meta.Get(context.Background()); err != nil {
+		t.Errorf("Meta.Get returned error: %v", err)
+	}
+
+	enabled, err := disabled.WithHTTP2(true)
+	if err != nil {
+		t.Fatalf("WithHTTP2(true) returned error: %v", err)
+	}
+	transport = enabled.client.Transport.(*http.Transport)
+	if !transport.ForceAttemptHTTP2 || transport.TLSNextProto != nil {
+		t.Errorf("WithHTTP2(true) transport has ForceAttemptHTTP2 %v and TLSNextProto %v, want true and nil", transport.ForceAttemptHTTP2, transport.TLSNextProto)
+	}
+	if disabled.client.Transport.(*http.Transport).ForceAttemptHTTP2 {
+		t.Error("WithHTTP2(true) modified the transport of the original client")
+	}
+
+}
+
+func TestClient_WithHTTP2_unsupportedTransport(t *testing.T) {
+	custom := roundTripperFunc(http.DefaultTransport.RoundTrip)
+	client := NewClient(&http.Client{Transport: custom})
+	got, err := client.WithHTTP2(false)
+	if err == nil {
+		t.Error("WithHTTP2(false) of a custom transport returned no error")
+	}
+	if got != nil {
+		t.Errorf("WithHTTP2(false) of a custom transport returned %+v, want nil", got)
+	}
+	if _, ok := client.client.Transport.(roundTripperFunc); !ok {
+		t.Errorf("WithHTTP2(false) replaced a custom transport with %T", client.client.Transport)
+	}
+}
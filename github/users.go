@@ -87,7 +87,7 @@ func (u User) String() string {
 func (s *UsersService) Get(ctx context.Context, user string) (*User, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v", user)
+		u = fmt.Sprintf("users/%v", PathEscape(user))
 	} else {
 		u = "user"
 	}
@@ -176,7 +176,7 @@ type UserContext struct {
 //
 //meta:operation GET /users/{username}/hovercard
 func (s *UsersService) GetHovercard(ctx context.Context, user string, opts *HovercardOptions) (*Hovercard, *Response, error) {
-	u := fmt.Sprintf("users/%v/hovercard", user)
+	u := fmt.Sprintf("users/%v/hovercard", PathEscape(user))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
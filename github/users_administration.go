@@ -16,7 +16,7 @@ import (
 //
 //meta:operation PUT /users/{username}/site_admin
 func (s *UsersService) PromoteSiteAdmin(ctx context.Context, user string) (*Response, error) {
-	u := fmt.Sprintf("users/%v/site_admin", user)
+	u := fmt.Sprintf("users/%v/site_admin", PathEscape(user))
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -32,7 +32,7 @@ func (s *UsersService) PromoteSiteAdmin(ctx context.Context, user string) (*Resp
 //
 //meta:operation DELETE /users/{username}/site_admin
 func (s *UsersService) DemoteSiteAdmin(ctx context.Context, user string) (*Response, error) {
-	u := fmt.Sprintf("users/%v/site_admin", user)
+	u := fmt.Sprintf("users/%v/site_admin", PathEscape(user))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -53,7 +53,7 @@ type UserSuspendOptions struct {
 //
 //meta:operation PUT /users/{username}/suspended
 func (s *UsersService) Suspend(ctx context.Context, user string, opts *UserSuspendOptions) (*Response, error) {
-	u := fmt.Sprintf("users/%v/suspended", user)
+	u := fmt.Sprintf("users/%v/suspended", PathEscape(user))
 
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
@@ -69,7 +69,7 @@ func (s *UsersService) Suspend(ctx context.Context, user string, opts *UserSuspe
 //
 //meta:operation DELETE /users/{username}/suspended
 func (s *UsersService) Unsuspend(ctx context.Context, user string) (*Response, error) {
-	u := fmt.Sprintf("users/%v/suspended", user)
+	u := fmt.Sprintf("users/%v/suspended", PathEscape(user))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
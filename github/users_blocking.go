@@ -45,7 +45,7 @@ func (s *UsersService) ListBlockedUsers(ctx context.Context, opts *ListOptions)
 //
 //meta:operation GET /user/blocks/{username}
 func (s *UsersService) IsBlocked(ctx context.Context, user string) (bool, *Response, error) {
-	u := fmt.Sprintf("user/blocks/%v", user)
+	u := fmt.Sprintf("user/blocks/%v", PathEscape(user))
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -66,7 +66,7 @@ func (s *UsersService) IsBlocked(ctx context.Context, user string) (bool, *Respo
 //
 //meta:operation PUT /user/blocks/{username}
 func (s *UsersService) BlockUser(ctx context.Context, user string) (*Response, error) {
-	u := fmt.Sprintf("user/blocks/%v", user)
+	u := fmt.Sprintf("user/blocks/%v", PathEscape(user))
 
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
@@ -85,7 +85,7 @@ func (s *UsersService) BlockUser(ctx context.Context, user string) (*Response, e
 //
 //meta:operation DELETE /user/blocks/{username}
 func (s *UsersService) UnblockUser(ctx context.Context, user string) (*Response, error) {
-	u := fmt.Sprintf("user/blocks/%v", user)
+	u := fmt.Sprintf("user/blocks/%v", PathEscape(user))
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
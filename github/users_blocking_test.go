@@ -83,6 +83,28 @@ func TestUsersService_IsBlocked(t *testing.T) {
 	})
 }
 
+func TestUsersService_IsBlocked_notBlocked(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/blocks/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	isBlocked, resp, err := client.Users.IsBlocked(ctx, "u")
+	if err != nil {
+		t.Errorf("Users.IsBlocked returned error: %v", err)
+	}
+	if want := false; isBlocked != want {
+		t.Errorf("Users.IsBlocked returned %+v, want %+v", isBlocked, want)
+	}
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("Users.IsBlocked returned status %v, want %v", got, want)
+	}
+}
+
 func TestUsersService_BlockUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -5,7 +5,28 @@
 
 package github
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrPrimaryEmailDeletion is wrapped by the error that
+// UsersService.DeleteEmails returns when one of the emails is the primary
+// email address of the user, which GitHub does not allow deleting.
+var ErrPrimaryEmailDeletion = errors.New("the primary email address cannot be deleted")
+
+// ErrNoPrimaryVerifiedEmail is returned by UsersService.GetPrimaryVerifiedEmail
+// when the primary email address of the user is not verified.
+var ErrNoPrimaryVerifiedEmail = errors.New("the user has no verified primary email address")
+
+// The possible values of UserEmail.Visibility.
+const (
+	EmailVisibilityPublic  = "public"
+	EmailVisibilityPrivate = "private"
+)
 
 // UserEmail represents user's email address
 type UserEmail struct {
@@ -62,7 +83,9 @@ func (s *UsersService) AddEmails(ctx context.Context, emails []string) ([]*UserE
 	return e, resp, nil
 }
 
-// DeleteEmails deletes email addresses from authenticated user.
+// DeleteEmails deletes email addresses from authenticated user. If one of
+// them is the primary email address, the error returned wraps
+// ErrPrimaryEmailDeletion.
 //
 // GitHub API docs: https://docs.github.com/rest/users/emails#delete-an-email-address-for-the-authenticated-user
 //
@@ -74,11 +97,62 @@ func (s *UsersService) DeleteEmails(ctx context.Context, emails []string) (*Resp
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	if isPrimaryEmailDeletionError(err) {
+		err = fmt.Errorf("%w: %w", ErrPrimaryEmailDeletion, err)
+	}
+	return resp, err
+}
+
+// isPrimaryEmailDeletionError reports whether err is the 422 Unprocessable
+// Entity response to deleting the primary email address.
+func isPrimaryEmailDeletionError(err error) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	if strings.Contains(strings.ToLower(errResp.Message), "primary") {
+		return true
+	}
+	for _, e := range errResp.Errors {
+		if strings.Contains(strings.ToLower(e.Message), "primary") {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPrimaryVerifiedEmail returns the primary email address of the
+// authenticated user, fetching as many pages of email addresses as needed. If
+// that address is not verified, it returns ErrNoPrimaryVerifiedEmail.
+//
+// GitHub API docs: https://docs.github.com/rest/users/emails#list-email-addresses-for-the-authenticated-user
+//
+//meta:operation GET /user/emails
+func (s *UsersService) GetPrimaryVerifiedEmail(ctx context.Context) (*UserEmail, *Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	for {
+		emails, resp, err := s.ListEmails(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, email := range emails {
+			if email.GetPrimary() && email.GetVerified() {
+				return email, resp, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, resp, ErrNoPrimaryVerifiedEmail
+		}
+		opts.Page = resp.NextPage
+	}
 }
 
 // SetEmailVisibility sets the visibility for the primary email address of the authenticated user.
-// `visibility` can be "private" or "public".
+// `visibility` can be EmailVisibilityPrivate or EmailVisibilityPublic. It
+// returns all the email addresses of the user.
 //
 // GitHub API docs: https://docs.github.com/rest/users/emails#set-primary-email-visibility-for-the-authenticated-user
 //
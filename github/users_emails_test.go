@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -121,6 +122,100 @@ func TestUsersService_DeleteEmails(t *testing.T) {
 	})
 }
 
+func TestUsersService_DeleteEmails_primary(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"UserEmail","code":"custom","field":"email","message":"user@example.com is your primary email address and cannot be deleted"}]}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Users.DeleteEmails(ctx, []string{"user@example.com"})
+	if !errors.Is(err, ErrPrimaryEmailDeletion) {
+		t.Errorf("Users.DeleteEmails returned error %v, want it to wrap ErrPrimaryEmailDeletion", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Users.DeleteEmails returned error %v, want it to wrap *ErrorResponse", err)
+	}
+}
+
+func TestUsersService_DeleteEmails_validationFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"UserEmail","code":"invalid","field":"email"}]}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Users.DeleteEmails(ctx, []string{"bad"})
+	if err == nil || errors.Is(err, ErrPrimaryEmailDeletion) {
+		t.Errorf("Users.DeleteEmails returned error %v, want a validation error not wrapping ErrPrimaryEmailDeletion", err)
+	}
+}
+
+func TestUsersService_GetPrimaryVerifiedEmail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/user/emails?per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"email":"a@example.com","primary":false,"verified":true}]`)
+		case "2":
+			fmt.Fprint(w, `[{"email":"b@example.com","primary":true,"verified":true,"visibility":"private"}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	email, _, err := client.Users.GetPrimaryVerifiedEmail(ctx)
+	if err != nil {
+		t.Fatalf("Users.GetPrimaryVerifiedEmail returned error: %v", err)
+	}
+
+	want := &UserEmail{Email: String("b@example.com"), Primary: Bool(true), Verified: Bool(true), Visibility: String(EmailVisibilityPrivate)}
+	if !cmp.Equal(email, want) {
+		t.Errorf("Users.GetPrimaryVerifiedEmail returned %+v, want %+v", email, want)
+	}
+
+	const methodName = "GetPrimaryVerifiedEmail"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.GetPrimaryVerifiedEmail(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_GetPrimaryVerifiedEmail_unverified(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"email":"a@example.com","primary":true,"verified":false}]`)
+	})
+
+	ctx := context.Background()
+	email, _, err := client.Users.GetPrimaryVerifiedEmail(ctx)
+	if !errors.Is(err, ErrNoPrimaryVerifiedEmail) {
+		t.Errorf("Users.GetPrimaryVerifiedEmail returned error %v, want ErrNoPrimaryVerifiedEmail", err)
+	}
+	if email != nil {
+		t.Errorf("Users.GetPrimaryVerifiedEmail returned %+v, want nil", email)
+	}
+}
+
 func TestUserEmail_Marshal(t *testing.T) {
 	testJSONMarshal(t, &UserEmail{}, "{}")
 
@@ -145,7 +240,7 @@ func TestUsersService_SetEmailVisibility(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &UserEmail{Visibility: String("private")}
+	input := &UserEmail{Visibility: String(EmailVisibilityPrivate)}
 
 	mux.HandleFunc("/user/email/visibility", func(w http.ResponseWriter, r *http.Request) {
 		v := new(UserEmail)
@@ -165,7 +260,7 @@ func TestUsersService_SetEmailVisibility(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	emails, _, err := client.Users.SetEmailVisibility(ctx, "private")
+	emails, _, err := client.Users.SetEmailVisibility(ctx, EmailVisibilityPrivate)
 	if err != nil {
 		t.Errorf("Users.SetEmailVisibility returned error: %v", err)
 	}
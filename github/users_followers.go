@@ -21,7 +21,7 @@ import (
 func (s *UsersService) ListFollowers(ctx context.Context, user string, opts *ListOptions) ([]*User, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/followers", user)
+		u = fmt.Sprintf("users/%v/followers", PathEscape(user))
 	} else {
 		u = "user/followers"
 	}
@@ -55,7 +55,7 @@ func (s *UsersService) ListFollowers(ctx context.Context, user string, opts *Lis
 func (s *UsersService) ListFollowing(ctx context.Context, user string, opts *ListOptions) ([]*User, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/following", user)
+		u = fmt.Sprintf("users/%v/following", PathEscape(user))
 	} else {
 		u = "user/following"
 	}
@@ -89,9 +89,9 @@ func (s *UsersService) ListFollowing(ctx context.Context, user string, opts *Lis
 func (s *UsersService) IsFollowing(ctx context.Context, user, target string) (bool, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/following/%v", user, target)
+		u = fmt.Sprintf("users/%v/following/%v", PathEscape(user), PathEscape(target))
 	} else {
-		u = fmt.Sprintf("user/following/%v", target)
+		u = fmt.Sprintf("user/following/%v", PathEscape(target))
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -110,7 +110,7 @@ func (s *UsersService) IsFollowing(ctx context.Context, user, target string) (bo
 //
 //meta:operation PUT /user/following/{username}
 func (s *UsersService) Follow(ctx context.Context, user string) (*Response, error) {
-	u := fmt.Sprintf("user/following/%v", user)
+	u := fmt.Sprintf("user/following/%v", PathEscape(user))
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
@@ -125,7 +125,7 @@ func (s *UsersService) Follow(ctx context.Context, user string) (*Response, erro
 //
 //meta:operation DELETE /user/following/{username}
 func (s *UsersService) Unfollow(ctx context.Context, user string) (*Response, error) {
-	u := fmt.Sprintf("user/following/%v", user)
+	u := fmt.Sprintf("user/following/%v", PathEscape(user))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -93,6 +93,8 @@ func TestUsersService_ListFollowers_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Users.ListFollowers(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Users.ListFollowers(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestUsersService_ListFollowing_authenticatedUser(t *testing.T) {
@@ -174,6 +176,8 @@ func TestUsersService_ListFollowing_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Users.ListFollowing(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Users.ListFollowing(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestUsersService_IsFollowing_authenticatedUser(t *testing.T) {
@@ -315,6 +319,8 @@ func TestUsersService_IsFollowing_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Users.IsFollowing(ctx, "%", "%")
 	testURLParseError(t, err)
+	_, _, err = client.Users.IsFollowing(ctx, "..", "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestUsersService_Follow(t *testing.T) {
@@ -349,6 +355,8 @@ func TestUsersService_Follow_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Users.Follow(ctx, "%")
 	testURLParseError(t, err)
+	_, err = client.Users.Follow(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestUsersService_Unfollow(t *testing.T) {
@@ -383,4 +391,6 @@ func TestUsersService_Unfollow_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, err := client.Users.Unfollow(ctx, "%")
 	testURLParseError(t, err)
+	_, err = client.Users.Unfollow(ctx, "..")
+	testPathForbiddenError(t, err)
 }
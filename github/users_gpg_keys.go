@@ -52,7 +52,7 @@ type GPGEmail struct {
 func (s *UsersService) ListGPGKeys(ctx context.Context, user string, opts *ListOptions) ([]*GPGKey, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/gpg_keys", user)
+		u = fmt.Sprintf("users/%v/gpg_keys", PathEscape(user))
 	} else {
 		u = "user/gpg_keys"
 	}
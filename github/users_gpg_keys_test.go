@@ -80,6 +80,8 @@ func TestUsersService_ListGPGKeys_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Users.ListGPGKeys(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Users.ListGPGKeys(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestUsersService_GetGPGKey(t *testing.T) {
@@ -38,7 +38,7 @@ func (k Key) String() string {
 func (s *UsersService) ListKeys(ctx context.Context, user string, opts *ListOptions) ([]*Key, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/keys", user)
+		u = fmt.Sprintf("users/%v/keys", PathEscape(user))
 	} else {
 		u = "user/keys"
 	}
@@ -80,6 +80,8 @@ func TestUsersService_ListKeys_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Users.ListKeys(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Users.ListKeys(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestUsersService_GetKey(t *testing.T) {
@@ -16,7 +16,7 @@ import (
 //
 //meta:operation GET /users/{username}/projects
 func (s *UsersService) ListProjects(ctx context.Context, user string, opts *ProjectListOptions) ([]*Project, *Response, error) {
-	u := fmt.Sprintf("users/%v/projects", user)
+	u := fmt.Sprintf("users/%v/projects", PathEscape(user))
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
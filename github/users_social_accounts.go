@@ -0,0 +1,99 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SocialAccount represents a social media account linked to a GitHub user.
+type SocialAccount struct {
+	Provider *string `json:"provider,omitempty"`
+	URL      *string `json:"url,omitempty"`
+}
+
+func (s SocialAccount) String() string {
+	return Stringify(s)
+}
+
+// socialAccountsRequest represents the body of a request adding or deleting
+// social accounts.
+type socialAccountsRequest struct {
+	AccountURLs []string `json:"account_urls"`
+}
+
+// ListSocialAccounts lists the social accounts for a user. Passing an empty
+// username string will fetch social accounts for the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/rest/users/social-accounts#list-social-accounts-for-a-user
+// GitHub API docs: https://docs.github.com/rest/users/social-accounts#list-social-accounts-for-the-authenticated-user
+//
+//meta:operation GET /user/social_accounts
+//meta:operation GET /users/{username}/social_accounts
+func (s *UsersService) ListSocialAccounts(ctx context.Context, user string, opts *ListOptions) ([]*SocialAccount, *Response, error) {
+	var u string
+	if user != "" {
+		u = fmt.Sprintf("users/%v/social_accounts", PathEscape(user))
+	} else {
+		u = "user/social_accounts"
+	}
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accounts []*SocialAccount
+	resp, err := s.client.Do(ctx, req, &accounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return accounts, resp, nil
+}
+
+// AddSocialAccounts adds social accounts, given by their full profile URLs,
+// to the authenticated user's profile. It returns the accounts added.
+//
+// GitHub API docs: https://docs.github.com/rest/users/social-accounts#add-social-accounts-for-the-authenticated-user
+//
+//meta:operation POST /user/social_accounts
+func (s *UsersService) AddSocialAccounts(ctx context.Context, accountURLs []string) ([]*SocialAccount, *Response, error) {
+	body := &socialAccountsRequest{AccountURLs: accountURLs}
+	req, err := s.client.NewRequest("POST", "user/social_accounts", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accounts []*SocialAccount
+	resp, err := s.client.Do(ctx, req, &accounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return accounts, resp, nil
+}
+
+// DeleteSocialAccounts deletes social accounts, given by their full profile
+// URLs, from the authenticated user's profile.
+//
+// GitHub API docs: https://docs.github.com/rest/users/social-accounts#delete-social-accounts-for-the-authenticated-user
+//
+//meta:operation DELETE /user/social_accounts
+func (s *UsersService) DeleteSocialAccounts(ctx context.Context, accountURLs []string) (*Response, error) {
+	body := &socialAccountsRequest{AccountURLs: accountURLs}
+	req, err := s.client.NewRequest("DELETE", "user/social_accounts", body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
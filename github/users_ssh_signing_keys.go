@@ -33,7 +33,7 @@ func (k SSHSigningKey) String() string {
 func (s *UsersService) ListSSHSigningKeys(ctx context.Context, user string, opts *ListOptions) ([]*SSHSigningKey, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/ssh_signing_keys", user)
+		u = fmt.Sprintf("users/%v/ssh_signing_keys", PathEscape(user))
 	} else {
 		u = "user/ssh_signing_keys"
 	}
@@ -80,6 +80,8 @@ func TestUsersService_ListSSHSigningKeys_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Users.ListSSHSigningKeys(ctx, "%", nil)
 	testURLParseError(t, err)
+	_, _, err = client.Users.ListSSHSigningKeys(ctx, "..", nil)
+	testPathForbiddenError(t, err)
 }
 
 func TestUsersService_GetSSHSigningKey(t *testing.T) {
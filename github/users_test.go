@@ -207,6 +207,8 @@ func TestUsersService_Get_invalidUser(t *testing.T) {
 	ctx := context.Background()
 	_, _, err := client.Users.Get(ctx, "%")
 	testURLParseError(t, err)
+	_, _, err = client.Users.Get(ctx, "..")
+	testPathForbiddenError(t, err)
 }
 
 func TestUsersService_GetByID(t *testing.T) {